@@ -199,2469 +199,5032 @@ func (m *AddPicTagsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddPicTagsResponse proto.InternalMessageInfo
 
-type CreateUserRequest struct {
-	// ident is the unique identity of the user being created, usually an email address
-	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
-	// secret is the secret string used to authenticate the user, usually a password
-	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+type CancelBackgroundJobRequest struct {
+	BackgroundJobId      string   `protobuf:"bytes,1,opt,name=background_job_id,json=backgroundJobId,proto3" json:"background_job_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
-func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateUserRequest) ProtoMessage()    {}
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+func (m *CancelBackgroundJobRequest) Reset()         { *m = CancelBackgroundJobRequest{} }
+func (m *CancelBackgroundJobRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelBackgroundJobRequest) ProtoMessage()    {}
+func (*CancelBackgroundJobRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
-func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
+func (m *CancelBackgroundJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelBackgroundJobRequest.Unmarshal(m, b)
 }
-func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
+func (m *CancelBackgroundJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelBackgroundJobRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserRequest.Merge(m, src)
+func (m *CancelBackgroundJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelBackgroundJobRequest.Merge(m, src)
 }
-func (m *CreateUserRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateUserRequest.Size(m)
+func (m *CancelBackgroundJobRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelBackgroundJobRequest.Size(m)
 }
-func (m *CreateUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
+func (m *CancelBackgroundJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelBackgroundJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
-
-func (m *CreateUserRequest) GetIdent() string {
-	if m != nil {
-		return m.Ident
-	}
-	return ""
-}
+var xxx_messageInfo_CancelBackgroundJobRequest proto.InternalMessageInfo
 
-func (m *CreateUserRequest) GetSecret() string {
+func (m *CancelBackgroundJobRequest) GetBackgroundJobId() string {
 	if m != nil {
-		return m.Secret
+		return m.BackgroundJobId
 	}
 	return ""
 }
 
-type CreateUserResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CancelBackgroundJobResponse struct {
+	BackgroundJob        *BackgroundJob `protobuf:"bytes,1,opt,name=background_job,json=backgroundJob,proto3" json:"background_job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
-func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateUserResponse) ProtoMessage()    {}
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+func (m *CancelBackgroundJobResponse) Reset()         { *m = CancelBackgroundJobResponse{} }
+func (m *CancelBackgroundJobResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelBackgroundJobResponse) ProtoMessage()    {}
+func (*CancelBackgroundJobResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
-func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateUserResponse.Unmarshal(m, b)
+func (m *CancelBackgroundJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelBackgroundJobResponse.Unmarshal(m, b)
 }
-func (m *CreateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateUserResponse.Marshal(b, m, deterministic)
+func (m *CancelBackgroundJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelBackgroundJobResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserResponse.Merge(m, src)
+func (m *CancelBackgroundJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelBackgroundJobResponse.Merge(m, src)
 }
-func (m *CreateUserResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateUserResponse.Size(m)
+func (m *CancelBackgroundJobResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelBackgroundJobResponse.Size(m)
 }
-func (m *CreateUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserResponse.DiscardUnknown(m)
+func (m *CancelBackgroundJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelBackgroundJobResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_CancelBackgroundJobResponse proto.InternalMessageInfo
 
-type DeleteTokenRequest struct {
+func (m *CancelBackgroundJobResponse) GetBackgroundJob() *BackgroundJob {
+	if m != nil {
+		return m.BackgroundJob
+	}
+	return nil
+}
+
+type ConfirmTotpRequest struct {
+	// code is the current code from the authenticator app, using the secret from EnrollTotp.
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTokenRequest) Reset()         { *m = DeleteTokenRequest{} }
-func (m *DeleteTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteTokenRequest) ProtoMessage()    {}
-func (*DeleteTokenRequest) Descriptor() ([]byte, []int) {
+func (m *ConfirmTotpRequest) Reset()         { *m = ConfirmTotpRequest{} }
+func (m *ConfirmTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTotpRequest) ProtoMessage()    {}
+func (*ConfirmTotpRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
-func (m *DeleteTokenRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteTokenRequest.Unmarshal(m, b)
+func (m *ConfirmTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTotpRequest.Unmarshal(m, b)
 }
-func (m *DeleteTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteTokenRequest.Marshal(b, m, deterministic)
+func (m *ConfirmTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTotpRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTokenRequest.Merge(m, src)
+func (m *ConfirmTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTotpRequest.Merge(m, src)
 }
-func (m *DeleteTokenRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteTokenRequest.Size(m)
+func (m *ConfirmTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTotpRequest.Size(m)
 }
-func (m *DeleteTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTokenRequest.DiscardUnknown(m)
+func (m *ConfirmTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTotpRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConfirmTotpRequest proto.InternalMessageInfo
 
-type DeleteTokenResponse struct {
+func (m *ConfirmTotpRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type ConfirmTotpResponse struct {
+	// recovery_code may each be used once in place of a code, such as if the authenticator app is
+	// lost.  They are only returned once.
+	RecoveryCode         []string `protobuf:"bytes,1,rep,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTokenResponse) Reset()         { *m = DeleteTokenResponse{} }
-func (m *DeleteTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteTokenResponse) ProtoMessage()    {}
-func (*DeleteTokenResponse) Descriptor() ([]byte, []int) {
+func (m *ConfirmTotpResponse) Reset()         { *m = ConfirmTotpResponse{} }
+func (m *ConfirmTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTotpResponse) ProtoMessage()    {}
+func (*ConfirmTotpResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
-func (m *DeleteTokenResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteTokenResponse.Unmarshal(m, b)
+func (m *ConfirmTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTotpResponse.Unmarshal(m, b)
 }
-func (m *DeleteTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteTokenResponse.Marshal(b, m, deterministic)
+func (m *ConfirmTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTotpResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTokenResponse.Merge(m, src)
+func (m *ConfirmTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTotpResponse.Merge(m, src)
 }
-func (m *DeleteTokenResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteTokenResponse.Size(m)
+func (m *ConfirmTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTotpResponse.Size(m)
 }
-func (m *DeleteTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTokenResponse.DiscardUnknown(m)
+func (m *ConfirmTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTotpResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConfirmTotpResponse proto.InternalMessageInfo
 
-type FindIndexPicsRequest struct {
-	StartPicId           string   `protobuf:"bytes,1,opt,name=start_pic_id,json=startPicId,proto3" json:"start_pic_id,omitempty"`
-	Ascending            bool     `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+func (m *ConfirmTotpResponse) GetRecoveryCode() []string {
+	if m != nil {
+		return m.RecoveryCode
+	}
+	return nil
+}
+
+type CreateApiKeyRequest struct {
+	// name is a note about what the key is for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// capability is the capabilities the key is limited to.  The current user must have each of
+	// them.
+	Capability []Capability_Cap `protobuf:"varint,2,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	// expire_time is when the key stops working.  Optional.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// allowed_remote_range is the CIDR ranges the key may be used from.  Optional.
+	AllowedRemoteRange   []string `protobuf:"bytes,4,rep,name=allowed_remote_range,json=allowedRemoteRange,proto3" json:"allowed_remote_range,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindIndexPicsRequest) Reset()         { *m = FindIndexPicsRequest{} }
-func (m *FindIndexPicsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindIndexPicsRequest) ProtoMessage()    {}
-func (*FindIndexPicsRequest) Descriptor() ([]byte, []int) {
+func (m *CreateApiKeyRequest) Reset()         { *m = CreateApiKeyRequest{} }
+func (m *CreateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyRequest) ProtoMessage()    {}
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
-func (m *FindIndexPicsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindIndexPicsRequest.Unmarshal(m, b)
+func (m *CreateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyRequest.Unmarshal(m, b)
 }
-func (m *FindIndexPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindIndexPicsRequest.Marshal(b, m, deterministic)
+func (m *CreateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *FindIndexPicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindIndexPicsRequest.Merge(m, src)
+func (m *CreateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyRequest.Merge(m, src)
 }
-func (m *FindIndexPicsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindIndexPicsRequest.Size(m)
+func (m *CreateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyRequest.Size(m)
 }
-func (m *FindIndexPicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindIndexPicsRequest.DiscardUnknown(m)
+func (m *CreateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindIndexPicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateApiKeyRequest proto.InternalMessageInfo
 
-func (m *FindIndexPicsRequest) GetStartPicId() string {
+func (m *CreateApiKeyRequest) GetName() string {
 	if m != nil {
-		return m.StartPicId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *FindIndexPicsRequest) GetAscending() bool {
+func (m *CreateApiKeyRequest) GetCapability() []Capability_Cap {
 	if m != nil {
-		return m.Ascending
+		return m.Capability
 	}
-	return false
+	return nil
 }
 
-type FindIndexPicsResponse struct {
-	Pic []*PicAndThumbnail `protobuf:"bytes,4,rep,name=pic,proto3" json:"pic,omitempty"`
-	// if set, this field is the next pic id as a
-	// continuation token.
-	NextPicId string `protobuf:"bytes,2,opt,name=next_pic_id,json=nextPicId,proto3" json:"next_pic_id,omitempty"`
-	// if set, this field is the previous pic id as a
-	// continuation token.
-	PrevPicId            string   `protobuf:"bytes,3,opt,name=prev_pic_id,json=prevPicId,proto3" json:"prev_pic_id,omitempty"`
+func (m *CreateApiKeyRequest) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *CreateApiKeyRequest) GetAllowedRemoteRange() []string {
+	if m != nil {
+		return m.AllowedRemoteRange
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	ApiKey *ApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// token is sent in the auth token header in place of an auth token.  It is only returned once.
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindIndexPicsResponse) Reset()         { *m = FindIndexPicsResponse{} }
-func (m *FindIndexPicsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindIndexPicsResponse) ProtoMessage()    {}
-func (*FindIndexPicsResponse) Descriptor() ([]byte, []int) {
+func (m *CreateApiKeyResponse) Reset()         { *m = CreateApiKeyResponse{} }
+func (m *CreateApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyResponse) ProtoMessage()    {}
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 
-func (m *FindIndexPicsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindIndexPicsResponse.Unmarshal(m, b)
+func (m *CreateApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyResponse.Unmarshal(m, b)
 }
-func (m *FindIndexPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindIndexPicsResponse.Marshal(b, m, deterministic)
+func (m *CreateApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *FindIndexPicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindIndexPicsResponse.Merge(m, src)
+func (m *CreateApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyResponse.Merge(m, src)
 }
-func (m *FindIndexPicsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindIndexPicsResponse.Size(m)
+func (m *CreateApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyResponse.Size(m)
 }
-func (m *FindIndexPicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindIndexPicsResponse.DiscardUnknown(m)
+func (m *CreateApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindIndexPicsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateApiKeyResponse proto.InternalMessageInfo
 
-func (m *FindIndexPicsResponse) GetPic() []*PicAndThumbnail {
+func (m *CreateApiKeyResponse) GetApiKey() *ApiKey {
 	if m != nil {
-		return m.Pic
+		return m.ApiKey
 	}
 	return nil
 }
 
-func (m *FindIndexPicsResponse) GetNextPicId() string {
-	if m != nil {
-		return m.NextPicId
-	}
-	return ""
-}
-
-func (m *FindIndexPicsResponse) GetPrevPicId() string {
+func (m *CreateApiKeyResponse) GetToken() string {
 	if m != nil {
-		return m.PrevPicId
+		return m.Token
 	}
 	return ""
 }
 
-type FindPicCommentVotesRequest struct {
-	// the pic id of pic comment votes.  Must be present.
-	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	// the comment id of pic comment votes.  If present, pic id must be present too.
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// the user_id of the pic votes.  If present, comment id must be present too.
-	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateRoleRequest struct {
+	// name is the unique name of the role being created.
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capability           []Capability_Cap `protobuf:"varint,2,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *FindPicCommentVotesRequest) Reset()         { *m = FindPicCommentVotesRequest{} }
-func (m *FindPicCommentVotesRequest) String() string { return proto.CompactTextString(m) }
-func (*FindPicCommentVotesRequest) ProtoMessage()    {}
-func (*FindPicCommentVotesRequest) Descriptor() ([]byte, []int) {
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
-func (m *FindPicCommentVotesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindPicCommentVotesRequest.Unmarshal(m, b)
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
 }
-func (m *FindPicCommentVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindPicCommentVotesRequest.Marshal(b, m, deterministic)
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *FindPicCommentVotesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindPicCommentVotesRequest.Merge(m, src)
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
 }
-func (m *FindPicCommentVotesRequest) XXX_Size() int {
-	return xxx_messageInfo_FindPicCommentVotesRequest.Size(m)
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
 }
-func (m *FindPicCommentVotesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindPicCommentVotesRequest.DiscardUnknown(m)
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindPicCommentVotesRequest proto.InternalMessageInfo
-
-func (m *FindPicCommentVotesRequest) GetPicId() string {
-	if m != nil {
-		return m.PicId
-	}
-	return ""
-}
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
 
-func (m *FindPicCommentVotesRequest) GetCommentId() string {
+func (m *CreateRoleRequest) GetName() string {
 	if m != nil {
-		return m.CommentId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *FindPicCommentVotesRequest) GetUserId() string {
+func (m *CreateRoleRequest) GetCapability() []Capability_Cap {
 	if m != nil {
-		return m.UserId
+		return m.Capability
 	}
-	return ""
+	return nil
 }
 
-type FindPicCommentVotesResponse struct {
-	// all the votes that matched the prefix, and which the subject user has access to.
-	Vote                 []*PicCommentVote `protobuf:"bytes,1,rep,name=vote,proto3" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type CreateRoleResponse struct {
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindPicCommentVotesResponse) Reset()         { *m = FindPicCommentVotesResponse{} }
-func (m *FindPicCommentVotesResponse) String() string { return proto.CompactTextString(m) }
-func (*FindPicCommentVotesResponse) ProtoMessage()    {}
-func (*FindPicCommentVotesResponse) Descriptor() ([]byte, []int) {
+func (m *CreateRoleResponse) Reset()         { *m = CreateRoleResponse{} }
+func (m *CreateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleResponse) ProtoMessage()    {}
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
-func (m *FindPicCommentVotesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindPicCommentVotesResponse.Unmarshal(m, b)
+func (m *CreateRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleResponse.Unmarshal(m, b)
 }
-func (m *FindPicCommentVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindPicCommentVotesResponse.Marshal(b, m, deterministic)
+func (m *CreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleResponse.Marshal(b, m, deterministic)
 }
-func (m *FindPicCommentVotesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindPicCommentVotesResponse.Merge(m, src)
+func (m *CreateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleResponse.Merge(m, src)
 }
-func (m *FindPicCommentVotesResponse) XXX_Size() int {
-	return xxx_messageInfo_FindPicCommentVotesResponse.Size(m)
+func (m *CreateRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleResponse.Size(m)
 }
-func (m *FindPicCommentVotesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindPicCommentVotesResponse.DiscardUnknown(m)
+func (m *CreateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindPicCommentVotesResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateRoleResponse proto.InternalMessageInfo
 
-func (m *FindPicCommentVotesResponse) GetVote() []*PicCommentVote {
+func (m *CreateRoleResponse) GetRole() *Role {
 	if m != nil {
-		return m.Vote
+		return m.Role
 	}
 	return nil
 }
 
-type FindSchedPicsRequest struct {
+type CreateUserRequest struct {
+	// ident is the unique identity of the user being created, usually an email address
+	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	// secret is the secret string used to authenticate the user, usually a password
+	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindSchedPicsRequest) Reset()         { *m = FindSchedPicsRequest{} }
-func (m *FindSchedPicsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindSchedPicsRequest) ProtoMessage()    {}
-func (*FindSchedPicsRequest) Descriptor() ([]byte, []int) {
+func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
+func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateUserRequest) ProtoMessage()    {}
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
 
-func (m *FindSchedPicsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSchedPicsRequest.Unmarshal(m, b)
+func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
 }
-func (m *FindSchedPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSchedPicsRequest.Marshal(b, m, deterministic)
+func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
 }
-func (m *FindSchedPicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSchedPicsRequest.Merge(m, src)
+func (m *CreateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserRequest.Merge(m, src)
 }
-func (m *FindSchedPicsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindSchedPicsRequest.Size(m)
+func (m *CreateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateUserRequest.Size(m)
 }
-func (m *FindSchedPicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSchedPicsRequest.DiscardUnknown(m)
+func (m *CreateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSchedPicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
 
-type FindSchedPicsResponse struct {
-	Pic                  []*PicAndThumbnail `protobuf:"bytes,2,rep,name=pic,proto3" json:"pic,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *CreateUserRequest) GetIdent() string {
+	if m != nil {
+		return m.Ident
+	}
+	return ""
 }
 
-func (m *FindSchedPicsResponse) Reset()         { *m = FindSchedPicsResponse{} }
-func (m *FindSchedPicsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindSchedPicsResponse) ProtoMessage()    {}
-func (*FindSchedPicsResponse) Descriptor() ([]byte, []int) {
+func (m *CreateUserRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
+func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateUserResponse) ProtoMessage()    {}
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 
-func (m *FindSchedPicsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSchedPicsResponse.Unmarshal(m, b)
+func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserResponse.Unmarshal(m, b)
 }
-func (m *FindSchedPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSchedPicsResponse.Marshal(b, m, deterministic)
+func (m *CreateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserResponse.Marshal(b, m, deterministic)
 }
-func (m *FindSchedPicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSchedPicsResponse.Merge(m, src)
+func (m *CreateUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserResponse.Merge(m, src)
 }
-func (m *FindSchedPicsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindSchedPicsResponse.Size(m)
+func (m *CreateUserResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateUserResponse.Size(m)
 }
-func (m *FindSchedPicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSchedPicsResponse.DiscardUnknown(m)
+func (m *CreateUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSchedPicsResponse proto.InternalMessageInfo
-
-func (m *FindSchedPicsResponse) GetPic() []*PicAndThumbnail {
-	if m != nil {
-		return m.Pic
-	}
-	return nil
-}
+var xxx_messageInfo_CreateUserResponse proto.InternalMessageInfo
 
-type FindSimilarPicsRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+type DeleteTagAliasRequest struct {
+	// alias is the name of the alias to delete.
+	Alias                string   `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindSimilarPicsRequest) Reset()         { *m = FindSimilarPicsRequest{} }
-func (m *FindSimilarPicsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindSimilarPicsRequest) ProtoMessage()    {}
-func (*FindSimilarPicsRequest) Descriptor() ([]byte, []int) {
+func (m *DeleteTagAliasRequest) Reset()         { *m = DeleteTagAliasRequest{} }
+func (m *DeleteTagAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagAliasRequest) ProtoMessage()    {}
+func (*DeleteTagAliasRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
-func (m *FindSimilarPicsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSimilarPicsRequest.Unmarshal(m, b)
+func (m *DeleteTagAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTagAliasRequest.Unmarshal(m, b)
 }
-func (m *FindSimilarPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSimilarPicsRequest.Marshal(b, m, deterministic)
+func (m *DeleteTagAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTagAliasRequest.Marshal(b, m, deterministic)
 }
-func (m *FindSimilarPicsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSimilarPicsRequest.Merge(m, src)
+func (m *DeleteTagAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagAliasRequest.Merge(m, src)
 }
-func (m *FindSimilarPicsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindSimilarPicsRequest.Size(m)
+func (m *DeleteTagAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTagAliasRequest.Size(m)
 }
-func (m *FindSimilarPicsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSimilarPicsRequest.DiscardUnknown(m)
+func (m *DeleteTagAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagAliasRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSimilarPicsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTagAliasRequest proto.InternalMessageInfo
 
-func (m *FindSimilarPicsRequest) GetPicId() string {
+func (m *DeleteTagAliasRequest) GetAlias() string {
 	if m != nil {
-		return m.PicId
+		return m.Alias
 	}
 	return ""
 }
 
-type FindSimilarPicsResponse struct {
-	PicId                []string `protobuf:"bytes,1,rep,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+type DeleteTagAliasResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindSimilarPicsResponse) Reset()         { *m = FindSimilarPicsResponse{} }
-func (m *FindSimilarPicsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindSimilarPicsResponse) ProtoMessage()    {}
-func (*FindSimilarPicsResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteTagAliasResponse) Reset()         { *m = DeleteTagAliasResponse{} }
+func (m *DeleteTagAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagAliasResponse) ProtoMessage()    {}
+func (*DeleteTagAliasResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
-func (m *FindSimilarPicsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindSimilarPicsResponse.Unmarshal(m, b)
+func (m *DeleteTagAliasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTagAliasResponse.Unmarshal(m, b)
 }
-func (m *FindSimilarPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindSimilarPicsResponse.Marshal(b, m, deterministic)
+func (m *DeleteTagAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTagAliasResponse.Marshal(b, m, deterministic)
 }
-func (m *FindSimilarPicsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindSimilarPicsResponse.Merge(m, src)
+func (m *DeleteTagAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagAliasResponse.Merge(m, src)
 }
-func (m *FindSimilarPicsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindSimilarPicsResponse.Size(m)
+func (m *DeleteTagAliasResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTagAliasResponse.Size(m)
 }
-func (m *FindSimilarPicsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindSimilarPicsResponse.DiscardUnknown(m)
+func (m *DeleteTagAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagAliasResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindSimilarPicsResponse proto.InternalMessageInfo
-
-func (m *FindSimilarPicsResponse) GetPicId() []string {
-	if m != nil {
-		return m.PicId
-	}
-	return nil
-}
+var xxx_messageInfo_DeleteTagAliasResponse proto.InternalMessageInfo
 
-type FindUserEventsRequest struct {
-	// Optional.  Uses auth token if not specified.
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Optional.  If present, specifies the starting user event id to scan for
-	StartUserEventId     string   `protobuf:"bytes,2,opt,name=start_user_event_id,json=startUserEventId,proto3" json:"start_user_event_id,omitempty"`
-	Ascending            bool     `protobuf:"varint,3,opt,name=ascending,proto3" json:"ascending,omitempty"`
+type DeleteTagImplicationRequest struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	ImpliedTag           string   `protobuf:"bytes,2,opt,name=implied_tag,json=impliedTag,proto3" json:"implied_tag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindUserEventsRequest) Reset()         { *m = FindUserEventsRequest{} }
-func (m *FindUserEventsRequest) String() string { return proto.CompactTextString(m) }
-func (*FindUserEventsRequest) ProtoMessage()    {}
-func (*FindUserEventsRequest) Descriptor() ([]byte, []int) {
+func (m *DeleteTagImplicationRequest) Reset()         { *m = DeleteTagImplicationRequest{} }
+func (m *DeleteTagImplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagImplicationRequest) ProtoMessage()    {}
+func (*DeleteTagImplicationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
-func (m *FindUserEventsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindUserEventsRequest.Unmarshal(m, b)
+func (m *DeleteTagImplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTagImplicationRequest.Unmarshal(m, b)
 }
-func (m *FindUserEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindUserEventsRequest.Marshal(b, m, deterministic)
+func (m *DeleteTagImplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTagImplicationRequest.Marshal(b, m, deterministic)
 }
-func (m *FindUserEventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindUserEventsRequest.Merge(m, src)
+func (m *DeleteTagImplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagImplicationRequest.Merge(m, src)
 }
-func (m *FindUserEventsRequest) XXX_Size() int {
-	return xxx_messageInfo_FindUserEventsRequest.Size(m)
+func (m *DeleteTagImplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTagImplicationRequest.Size(m)
 }
-func (m *FindUserEventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindUserEventsRequest.DiscardUnknown(m)
+func (m *DeleteTagImplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagImplicationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindUserEventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTagImplicationRequest proto.InternalMessageInfo
 
-func (m *FindUserEventsRequest) GetUserId() string {
+func (m *DeleteTagImplicationRequest) GetTag() string {
 	if m != nil {
-		return m.UserId
+		return m.Tag
 	}
 	return ""
 }
 
-func (m *FindUserEventsRequest) GetStartUserEventId() string {
+func (m *DeleteTagImplicationRequest) GetImpliedTag() string {
 	if m != nil {
-		return m.StartUserEventId
+		return m.ImpliedTag
 	}
 	return ""
 }
 
-func (m *FindUserEventsRequest) GetAscending() bool {
-	if m != nil {
-		return m.Ascending
-	}
-	return false
-}
-
-type FindUserEventsResponse struct {
-	UserEvent            []*UserEvent `protobuf:"bytes,1,rep,name=user_event,json=userEvent,proto3" json:"user_event,omitempty"`
-	NextUserEventId      string       `protobuf:"bytes,2,opt,name=next_user_event_id,json=nextUserEventId,proto3" json:"next_user_event_id,omitempty"`
-	PrevUserEventId      string       `protobuf:"bytes,3,opt,name=prev_user_event_id,json=prevUserEventId,proto3" json:"prev_user_event_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type DeleteTagImplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindUserEventsResponse) Reset()         { *m = FindUserEventsResponse{} }
-func (m *FindUserEventsResponse) String() string { return proto.CompactTextString(m) }
-func (*FindUserEventsResponse) ProtoMessage()    {}
-func (*FindUserEventsResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteTagImplicationResponse) Reset()         { *m = DeleteTagImplicationResponse{} }
+func (m *DeleteTagImplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagImplicationResponse) ProtoMessage()    {}
+func (*DeleteTagImplicationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 
-func (m *FindUserEventsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindUserEventsResponse.Unmarshal(m, b)
+func (m *DeleteTagImplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTagImplicationResponse.Unmarshal(m, b)
 }
-func (m *FindUserEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindUserEventsResponse.Marshal(b, m, deterministic)
+func (m *DeleteTagImplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTagImplicationResponse.Marshal(b, m, deterministic)
 }
-func (m *FindUserEventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindUserEventsResponse.Merge(m, src)
+func (m *DeleteTagImplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagImplicationResponse.Merge(m, src)
 }
-func (m *FindUserEventsResponse) XXX_Size() int {
-	return xxx_messageInfo_FindUserEventsResponse.Size(m)
+func (m *DeleteTagImplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTagImplicationResponse.Size(m)
 }
-func (m *FindUserEventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindUserEventsResponse.DiscardUnknown(m)
+func (m *DeleteTagImplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagImplicationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindUserEventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTagImplicationResponse proto.InternalMessageInfo
 
-func (m *FindUserEventsResponse) GetUserEvent() []*UserEvent {
-	if m != nil {
-		return m.UserEvent
-	}
-	return nil
+type DeleteTokenRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindUserEventsResponse) GetNextUserEventId() string {
-	if m != nil {
-		return m.NextUserEventId
-	}
-	return ""
+func (m *DeleteTokenRequest) Reset()         { *m = DeleteTokenRequest{} }
+func (m *DeleteTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTokenRequest) ProtoMessage()    {}
+func (*DeleteTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 
-func (m *FindUserEventsResponse) GetPrevUserEventId() string {
-	if m != nil {
-		return m.PrevUserEventId
-	}
-	return ""
+func (m *DeleteTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTokenRequest.Unmarshal(m, b)
+}
+func (m *DeleteTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTokenRequest.Merge(m, src)
+}
+func (m *DeleteTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTokenRequest.Size(m)
+}
+func (m *DeleteTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTokenRequest.DiscardUnknown(m)
 }
 
-type GetRefreshTokenRequest struct {
-	// ident is the unique identity of the user being created, usually an email address
-	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
-	// secret is the secret string used to authenticate the user, usually a password
-	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	PreviousAuthToken    string   `protobuf:"bytes,3,opt,name=previous_auth_token,json=previousAuthToken,proto3" json:"previous_auth_token,omitempty"`
+var xxx_messageInfo_DeleteTokenRequest proto.InternalMessageInfo
+
+type DeleteTokenResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRefreshTokenRequest) Reset()         { *m = GetRefreshTokenRequest{} }
-func (m *GetRefreshTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRefreshTokenRequest) ProtoMessage()    {}
-func (*GetRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+func (m *DeleteTokenResponse) Reset()         { *m = DeleteTokenResponse{} }
+func (m *DeleteTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTokenResponse) ProtoMessage()    {}
+func (*DeleteTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
-func (m *GetRefreshTokenRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRefreshTokenRequest.Unmarshal(m, b)
+func (m *DeleteTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTokenResponse.Unmarshal(m, b)
 }
-func (m *GetRefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRefreshTokenRequest.Marshal(b, m, deterministic)
+func (m *DeleteTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTokenResponse.Marshal(b, m, deterministic)
 }
-func (m *GetRefreshTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRefreshTokenRequest.Merge(m, src)
+func (m *DeleteTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTokenResponse.Merge(m, src)
 }
-func (m *GetRefreshTokenRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRefreshTokenRequest.Size(m)
+func (m *DeleteTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTokenResponse.Size(m)
 }
-func (m *GetRefreshTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRefreshTokenRequest.DiscardUnknown(m)
+func (m *DeleteTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRefreshTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTokenResponse proto.InternalMessageInfo
 
-func (m *GetRefreshTokenRequest) GetIdent() string {
-	if m != nil {
-		return m.Ident
-	}
-	return ""
-}
-
-func (m *GetRefreshTokenRequest) GetSecret() string {
-	if m != nil {
-		return m.Secret
-	}
-	return ""
-}
-
-func (m *GetRefreshTokenRequest) GetPreviousAuthToken() string {
-	if m != nil {
-		return m.PreviousAuthToken
-	}
-	return ""
-}
-
-type GetRefreshTokenResponse struct {
-	AuthToken            string      `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
-	PixToken             string      `protobuf:"bytes,5,opt,name=pix_token,json=pixToken,proto3" json:"pix_token,omitempty"`
-	AuthPayload          *PwtPayload `protobuf:"bytes,4,opt,name=auth_payload,json=authPayload,proto3" json:"auth_payload,omitempty"`
-	PixPayload           *PwtPayload `protobuf:"bytes,6,opt,name=pix_payload,json=pixPayload,proto3" json:"pix_payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type DisableTotpRequest struct {
+	// user_id is the user to disable two-factor authentication for, in varint form.  If empty, the
+	// current user is used.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// code is a current code or recovery code.  It is needed to disable the current user's two-factor
+	// authentication, but not another user's.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRefreshTokenResponse) Reset()         { *m = GetRefreshTokenResponse{} }
-func (m *GetRefreshTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRefreshTokenResponse) ProtoMessage()    {}
-func (*GetRefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+func (m *DisableTotpRequest) Reset()         { *m = DisableTotpRequest{} }
+func (m *DisableTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableTotpRequest) ProtoMessage()    {}
+func (*DisableTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
-func (m *GetRefreshTokenResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRefreshTokenResponse.Unmarshal(m, b)
+func (m *DisableTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTotpRequest.Unmarshal(m, b)
 }
-func (m *GetRefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRefreshTokenResponse.Marshal(b, m, deterministic)
+func (m *DisableTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTotpRequest.Marshal(b, m, deterministic)
 }
-func (m *GetRefreshTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRefreshTokenResponse.Merge(m, src)
+func (m *DisableTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTotpRequest.Merge(m, src)
 }
-func (m *GetRefreshTokenResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRefreshTokenResponse.Size(m)
+func (m *DisableTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_DisableTotpRequest.Size(m)
 }
-func (m *GetRefreshTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRefreshTokenResponse.DiscardUnknown(m)
+func (m *DisableTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTotpRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRefreshTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_DisableTotpRequest proto.InternalMessageInfo
 
-func (m *GetRefreshTokenResponse) GetAuthToken() string {
+func (m *DisableTotpRequest) GetUserId() string {
 	if m != nil {
-		return m.AuthToken
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *GetRefreshTokenResponse) GetPixToken() string {
+func (m *DisableTotpRequest) GetCode() string {
 	if m != nil {
-		return m.PixToken
+		return m.Code
 	}
 	return ""
 }
 
-func (m *GetRefreshTokenResponse) GetAuthPayload() *PwtPayload {
-	if m != nil {
-		return m.AuthPayload
-	}
-	return nil
-}
-
-func (m *GetRefreshTokenResponse) GetPixPayload() *PwtPayload {
-	if m != nil {
-		return m.PixPayload
-	}
-	return nil
-}
-
-type IncrementViewCountRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+type DisableTotpResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IncrementViewCountRequest) Reset()         { *m = IncrementViewCountRequest{} }
-func (m *IncrementViewCountRequest) String() string { return proto.CompactTextString(m) }
-func (*IncrementViewCountRequest) ProtoMessage()    {}
-func (*IncrementViewCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+func (m *DisableTotpResponse) Reset()         { *m = DisableTotpResponse{} }
+func (m *DisableTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableTotpResponse) ProtoMessage()    {}
+func (*DisableTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 
-func (m *IncrementViewCountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IncrementViewCountRequest.Unmarshal(m, b)
+func (m *DisableTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableTotpResponse.Unmarshal(m, b)
 }
-func (m *IncrementViewCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IncrementViewCountRequest.Marshal(b, m, deterministic)
+func (m *DisableTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableTotpResponse.Marshal(b, m, deterministic)
 }
-func (m *IncrementViewCountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncrementViewCountRequest.Merge(m, src)
+func (m *DisableTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableTotpResponse.Merge(m, src)
 }
-func (m *IncrementViewCountRequest) XXX_Size() int {
-	return xxx_messageInfo_IncrementViewCountRequest.Size(m)
+func (m *DisableTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_DisableTotpResponse.Size(m)
 }
-func (m *IncrementViewCountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncrementViewCountRequest.DiscardUnknown(m)
+func (m *DisableTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableTotpResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncrementViewCountRequest proto.InternalMessageInfo
-
-func (m *IncrementViewCountRequest) GetPicId() string {
-	if m != nil {
-		return m.PicId
-	}
-	return ""
-}
+var xxx_messageInfo_DisableTotpResponse proto.InternalMessageInfo
 
-type IncrementViewCountResponse struct {
+type EnrollTotpRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IncrementViewCountResponse) Reset()         { *m = IncrementViewCountResponse{} }
-func (m *IncrementViewCountResponse) String() string { return proto.CompactTextString(m) }
-func (*IncrementViewCountResponse) ProtoMessage()    {}
-func (*IncrementViewCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+func (m *EnrollTotpRequest) Reset()         { *m = EnrollTotpRequest{} }
+func (m *EnrollTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*EnrollTotpRequest) ProtoMessage()    {}
+func (*EnrollTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 
-func (m *IncrementViewCountResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IncrementViewCountResponse.Unmarshal(m, b)
+func (m *EnrollTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTotpRequest.Unmarshal(m, b)
 }
-func (m *IncrementViewCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IncrementViewCountResponse.Marshal(b, m, deterministic)
+func (m *EnrollTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTotpRequest.Marshal(b, m, deterministic)
 }
-func (m *IncrementViewCountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncrementViewCountResponse.Merge(m, src)
+func (m *EnrollTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTotpRequest.Merge(m, src)
 }
-func (m *IncrementViewCountResponse) XXX_Size() int {
-	return xxx_messageInfo_IncrementViewCountResponse.Size(m)
+func (m *EnrollTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_EnrollTotpRequest.Size(m)
 }
-func (m *IncrementViewCountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncrementViewCountResponse.DiscardUnknown(m)
+func (m *EnrollTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTotpRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncrementViewCountResponse proto.InternalMessageInfo
+var xxx_messageInfo_EnrollTotpRequest proto.InternalMessageInfo
 
-type LookupPicCommentVoteRequest struct {
-	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// if absent, this comes from the auth token.
-	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type EnrollTotpResponse struct {
+	// secret is the shared secret, in base32 form, for entering into an authenticator app.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// uri is the otpauth:// form of the secret, for making a QR code.
+	Uri                  string   `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicCommentVoteRequest) Reset()         { *m = LookupPicCommentVoteRequest{} }
-func (m *LookupPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicCommentVoteRequest) ProtoMessage()    {}
-func (*LookupPicCommentVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+func (m *EnrollTotpResponse) Reset()         { *m = EnrollTotpResponse{} }
+func (m *EnrollTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*EnrollTotpResponse) ProtoMessage()    {}
+func (*EnrollTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 
-func (m *LookupPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicCommentVoteRequest.Unmarshal(m, b)
+func (m *EnrollTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTotpResponse.Unmarshal(m, b)
 }
-func (m *LookupPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicCommentVoteRequest.Marshal(b, m, deterministic)
+func (m *EnrollTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTotpResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicCommentVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicCommentVoteRequest.Merge(m, src)
+func (m *EnrollTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTotpResponse.Merge(m, src)
 }
-func (m *LookupPicCommentVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicCommentVoteRequest.Size(m)
+func (m *EnrollTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_EnrollTotpResponse.Size(m)
 }
-func (m *LookupPicCommentVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicCommentVoteRequest.DiscardUnknown(m)
+func (m *EnrollTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTotpResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicCommentVoteRequest proto.InternalMessageInfo
-
-func (m *LookupPicCommentVoteRequest) GetPicId() string {
-	if m != nil {
-		return m.PicId
-	}
-	return ""
-}
+var xxx_messageInfo_EnrollTotpResponse proto.InternalMessageInfo
 
-func (m *LookupPicCommentVoteRequest) GetCommentId() string {
+func (m *EnrollTotpResponse) GetSecret() string {
 	if m != nil {
-		return m.CommentId
+		return m.Secret
 	}
 	return ""
 }
 
-func (m *LookupPicCommentVoteRequest) GetUserId() string {
+func (m *EnrollTotpResponse) GetUri() string {
 	if m != nil {
-		return m.UserId
+		return m.Uri
 	}
 	return ""
 }
 
-type LookupPicCommentVoteResponse struct {
-	Vote                 *PicCommentVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ExchangeIdTokenRequest struct {
+	// id_token is the OpenID Connect ID token, in compact JWS form, as returned by the provider's
+	// token endpoint.
+	IdToken string `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	// nonce is the value sent in the authorization request.  It must match the nonce claim.
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// user_agent and remote_address describe the client being authenticated.  They are shown
+	// when listing tokens.
+	UserAgent            string   `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RemoteAddress        string   `protobuf:"bytes,4,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicCommentVoteResponse) Reset()         { *m = LookupPicCommentVoteResponse{} }
-func (m *LookupPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicCommentVoteResponse) ProtoMessage()    {}
-func (*LookupPicCommentVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *ExchangeIdTokenRequest) Reset()         { *m = ExchangeIdTokenRequest{} }
+func (m *ExchangeIdTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeIdTokenRequest) ProtoMessage()    {}
+func (*ExchangeIdTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 
-func (m *LookupPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicCommentVoteResponse.Unmarshal(m, b)
+func (m *ExchangeIdTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeIdTokenRequest.Unmarshal(m, b)
 }
-func (m *LookupPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicCommentVoteResponse.Marshal(b, m, deterministic)
+func (m *ExchangeIdTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeIdTokenRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicCommentVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicCommentVoteResponse.Merge(m, src)
+func (m *ExchangeIdTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeIdTokenRequest.Merge(m, src)
 }
-func (m *LookupPicCommentVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicCommentVoteResponse.Size(m)
+func (m *ExchangeIdTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangeIdTokenRequest.Size(m)
 }
-func (m *LookupPicCommentVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicCommentVoteResponse.DiscardUnknown(m)
+func (m *ExchangeIdTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeIdTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicCommentVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeIdTokenRequest proto.InternalMessageInfo
 
-func (m *LookupPicCommentVoteResponse) GetVote() *PicCommentVote {
+func (m *ExchangeIdTokenRequest) GetIdToken() string {
 	if m != nil {
-		return m.Vote
+		return m.IdToken
 	}
-	return nil
-}
-
-type LookupPicDetailsRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *LookupPicDetailsRequest) Reset()         { *m = LookupPicDetailsRequest{} }
-func (m *LookupPicDetailsRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicDetailsRequest) ProtoMessage()    {}
-func (*LookupPicDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+func (m *ExchangeIdTokenRequest) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
 }
 
-func (m *LookupPicDetailsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicDetailsRequest.Unmarshal(m, b)
-}
-func (m *LookupPicDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicDetailsRequest.Marshal(b, m, deterministic)
-}
-func (m *LookupPicDetailsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicDetailsRequest.Merge(m, src)
-}
-func (m *LookupPicDetailsRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicDetailsRequest.Size(m)
-}
-func (m *LookupPicDetailsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicDetailsRequest.DiscardUnknown(m)
+func (m *ExchangeIdTokenRequest) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
 }
 
-var xxx_messageInfo_LookupPicDetailsRequest proto.InternalMessageInfo
-
-func (m *LookupPicDetailsRequest) GetPicId() string {
+func (m *ExchangeIdTokenRequest) GetRemoteAddress() string {
 	if m != nil {
-		return m.PicId
+		return m.RemoteAddress
 	}
 	return ""
 }
 
-type LookupPicDetailsResponse struct {
-	Pic                  *Pic            `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
-	Derived              []*PicFile      `protobuf:"bytes,4,rep,name=derived,proto3" json:"derived,omitempty"`
-	PicTag               []*PicTag       `protobuf:"bytes,2,rep,name=pic_tag,json=picTag,proto3" json:"pic_tag,omitempty"`
-	PicCommentTree       *PicCommentTree `protobuf:"bytes,3,opt,name=pic_comment_tree,json=picCommentTree,proto3" json:"pic_comment_tree,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ExchangeIdTokenResponse struct {
+	AuthToken            string      `protobuf:"bytes,1,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	PixToken             string      `protobuf:"bytes,2,opt,name=pix_token,json=pixToken,proto3" json:"pix_token,omitempty"`
+	AuthPayload          *PwtPayload `protobuf:"bytes,3,opt,name=auth_payload,json=authPayload,proto3" json:"auth_payload,omitempty"`
+	PixPayload           *PwtPayload `protobuf:"bytes,4,opt,name=pix_payload,json=pixPayload,proto3" json:"pix_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *LookupPicDetailsResponse) Reset()         { *m = LookupPicDetailsResponse{} }
-func (m *LookupPicDetailsResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicDetailsResponse) ProtoMessage()    {}
-func (*LookupPicDetailsResponse) Descriptor() ([]byte, []int) {
+func (m *ExchangeIdTokenResponse) Reset()         { *m = ExchangeIdTokenResponse{} }
+func (m *ExchangeIdTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeIdTokenResponse) ProtoMessage()    {}
+func (*ExchangeIdTokenResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 
-func (m *LookupPicDetailsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicDetailsResponse.Unmarshal(m, b)
+func (m *ExchangeIdTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeIdTokenResponse.Unmarshal(m, b)
 }
-func (m *LookupPicDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicDetailsResponse.Marshal(b, m, deterministic)
+func (m *ExchangeIdTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeIdTokenResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicDetailsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicDetailsResponse.Merge(m, src)
+func (m *ExchangeIdTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeIdTokenResponse.Merge(m, src)
 }
-func (m *LookupPicDetailsResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicDetailsResponse.Size(m)
+func (m *ExchangeIdTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_ExchangeIdTokenResponse.Size(m)
 }
-func (m *LookupPicDetailsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicDetailsResponse.DiscardUnknown(m)
+func (m *ExchangeIdTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeIdTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicDetailsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeIdTokenResponse proto.InternalMessageInfo
 
-func (m *LookupPicDetailsResponse) GetPic() *Pic {
+func (m *ExchangeIdTokenResponse) GetAuthToken() string {
 	if m != nil {
-		return m.Pic
+		return m.AuthToken
 	}
-	return nil
+	return ""
 }
 
-func (m *LookupPicDetailsResponse) GetDerived() []*PicFile {
+func (m *ExchangeIdTokenResponse) GetPixToken() string {
 	if m != nil {
-		return m.Derived
+		return m.PixToken
 	}
-	return nil
+	return ""
 }
 
-func (m *LookupPicDetailsResponse) GetPicTag() []*PicTag {
+func (m *ExchangeIdTokenResponse) GetAuthPayload() *PwtPayload {
 	if m != nil {
-		return m.PicTag
+		return m.AuthPayload
 	}
 	return nil
 }
 
-func (m *LookupPicDetailsResponse) GetPicCommentTree() *PicCommentTree {
+func (m *ExchangeIdTokenResponse) GetPixPayload() *PwtPayload {
 	if m != nil {
-		return m.PicCommentTree
+		return m.PixPayload
 	}
 	return nil
 }
 
-type LookupPicExtensionRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+type FindIndexPicsRequest struct {
+	StartPicId           string   `protobuf:"bytes,1,opt,name=start_pic_id,json=startPicId,proto3" json:"start_pic_id,omitempty"`
+	Ascending            bool     `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicExtensionRequest) Reset()         { *m = LookupPicExtensionRequest{} }
-func (m *LookupPicExtensionRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicExtensionRequest) ProtoMessage()    {}
-func (*LookupPicExtensionRequest) Descriptor() ([]byte, []int) {
+func (m *FindIndexPicsRequest) Reset()         { *m = FindIndexPicsRequest{} }
+func (m *FindIndexPicsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindIndexPicsRequest) ProtoMessage()    {}
+func (*FindIndexPicsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 
-func (m *LookupPicExtensionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicExtensionRequest.Unmarshal(m, b)
+func (m *FindIndexPicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindIndexPicsRequest.Unmarshal(m, b)
 }
-func (m *LookupPicExtensionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicExtensionRequest.Marshal(b, m, deterministic)
+func (m *FindIndexPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindIndexPicsRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicExtensionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicExtensionRequest.Merge(m, src)
+func (m *FindIndexPicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindIndexPicsRequest.Merge(m, src)
 }
-func (m *LookupPicExtensionRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicExtensionRequest.Size(m)
+func (m *FindIndexPicsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindIndexPicsRequest.Size(m)
 }
-func (m *LookupPicExtensionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicExtensionRequest.DiscardUnknown(m)
+func (m *FindIndexPicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindIndexPicsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicExtensionRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindIndexPicsRequest proto.InternalMessageInfo
 
-func (m *LookupPicExtensionRequest) GetPicId() string {
+func (m *FindIndexPicsRequest) GetStartPicId() string {
 	if m != nil {
-		return m.PicId
+		return m.StartPicId
 	}
 	return ""
 }
 
-type LookupPicExtensionResponse struct {
-	Ext                  map[string]*any.Any `protobuf:"bytes,1,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *FindIndexPicsRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
 }
 
-func (m *LookupPicExtensionResponse) Reset()         { *m = LookupPicExtensionResponse{} }
-func (m *LookupPicExtensionResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicExtensionResponse) ProtoMessage()    {}
-func (*LookupPicExtensionResponse) Descriptor() ([]byte, []int) {
+type FindIndexPicsResponse struct {
+	Pic []*PicAndThumbnail `protobuf:"bytes,4,rep,name=pic,proto3" json:"pic,omitempty"`
+	// if set, this field is the next pic id as a
+	// continuation token.
+	NextPicId string `protobuf:"bytes,2,opt,name=next_pic_id,json=nextPicId,proto3" json:"next_pic_id,omitempty"`
+	// if set, this field is the previous pic id as a
+	// continuation token.
+	PrevPicId            string   `protobuf:"bytes,3,opt,name=prev_pic_id,json=prevPicId,proto3" json:"prev_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindIndexPicsResponse) Reset()         { *m = FindIndexPicsResponse{} }
+func (m *FindIndexPicsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindIndexPicsResponse) ProtoMessage()    {}
+func (*FindIndexPicsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 
-func (m *LookupPicExtensionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicExtensionResponse.Unmarshal(m, b)
+func (m *FindIndexPicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindIndexPicsResponse.Unmarshal(m, b)
 }
-func (m *LookupPicExtensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicExtensionResponse.Marshal(b, m, deterministic)
+func (m *FindIndexPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindIndexPicsResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicExtensionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicExtensionResponse.Merge(m, src)
+func (m *FindIndexPicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindIndexPicsResponse.Merge(m, src)
 }
-func (m *LookupPicExtensionResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicExtensionResponse.Size(m)
+func (m *FindIndexPicsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindIndexPicsResponse.Size(m)
 }
-func (m *LookupPicExtensionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicExtensionResponse.DiscardUnknown(m)
+func (m *FindIndexPicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindIndexPicsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicExtensionResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindIndexPicsResponse proto.InternalMessageInfo
 
-func (m *LookupPicExtensionResponse) GetExt() map[string]*any.Any {
+func (m *FindIndexPicsResponse) GetPic() []*PicAndThumbnail {
 	if m != nil {
-		return m.Ext
+		return m.Pic
 	}
 	return nil
 }
 
-type LookupPicFileRequest struct {
-	PicFileId            string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
-	Format               PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *FindIndexPicsResponse) GetNextPicId() string {
+	if m != nil {
+		return m.NextPicId
+	}
+	return ""
 }
 
-func (m *LookupPicFileRequest) Reset()         { *m = LookupPicFileRequest{} }
-func (m *LookupPicFileRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicFileRequest) ProtoMessage()    {}
-func (*LookupPicFileRequest) Descriptor() ([]byte, []int) {
+func (m *FindIndexPicsResponse) GetPrevPicId() string {
+	if m != nil {
+		return m.PrevPicId
+	}
+	return ""
+}
+
+type FindPicCommentVotesRequest struct {
+	// the pic id of pic comment votes.  Must be present.
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// the comment id of pic comment votes.  If present, pic id must be present too.
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// the user_id of the pic votes.  If present, comment id must be present too.
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindPicCommentVotesRequest) Reset()         { *m = FindPicCommentVotesRequest{} }
+func (m *FindPicCommentVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*FindPicCommentVotesRequest) ProtoMessage()    {}
+func (*FindPicCommentVotesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 
-func (m *LookupPicFileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicFileRequest.Unmarshal(m, b)
+func (m *FindPicCommentVotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicCommentVotesRequest.Unmarshal(m, b)
 }
-func (m *LookupPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicFileRequest.Marshal(b, m, deterministic)
+func (m *FindPicCommentVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicCommentVotesRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicFileRequest.Merge(m, src)
+func (m *FindPicCommentVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicCommentVotesRequest.Merge(m, src)
 }
-func (m *LookupPicFileRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicFileRequest.Size(m)
+func (m *FindPicCommentVotesRequest) XXX_Size() int {
+	return xxx_messageInfo_FindPicCommentVotesRequest.Size(m)
 }
-func (m *LookupPicFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicFileRequest.DiscardUnknown(m)
+func (m *FindPicCommentVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicCommentVotesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindPicCommentVotesRequest proto.InternalMessageInfo
 
-func (m *LookupPicFileRequest) GetPicFileId() string {
+func (m *FindPicCommentVotesRequest) GetPicId() string {
 	if m != nil {
-		return m.PicFileId
+		return m.PicId
 	}
 	return ""
 }
 
-func (m *LookupPicFileRequest) GetFormat() PicFile_Format {
+func (m *FindPicCommentVotesRequest) GetCommentId() string {
 	if m != nil {
-		return m.Format
+		return m.CommentId
 	}
-	return PicFile_UNKNOWN
+	return ""
 }
 
-type LookupPicFileResponse struct {
-	PicFile              *PicFile `protobuf:"bytes,1,opt,name=pic_file,json=picFile,proto3" json:"pic_file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FindPicCommentVotesRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
 }
 
-func (m *LookupPicFileResponse) Reset()         { *m = LookupPicFileResponse{} }
-func (m *LookupPicFileResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicFileResponse) ProtoMessage()    {}
-func (*LookupPicFileResponse) Descriptor() ([]byte, []int) {
+type FindPicCommentVotesResponse struct {
+	// all the votes that matched the prefix, and which the subject user has access to.
+	Vote                 []*PicCommentVote `protobuf:"bytes,1,rep,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *FindPicCommentVotesResponse) Reset()         { *m = FindPicCommentVotesResponse{} }
+func (m *FindPicCommentVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*FindPicCommentVotesResponse) ProtoMessage()    {}
+func (*FindPicCommentVotesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 
-func (m *LookupPicFileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicFileResponse.Unmarshal(m, b)
+func (m *FindPicCommentVotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicCommentVotesResponse.Unmarshal(m, b)
 }
-func (m *LookupPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicFileResponse.Marshal(b, m, deterministic)
+func (m *FindPicCommentVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicCommentVotesResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicFileResponse.Merge(m, src)
+func (m *FindPicCommentVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicCommentVotesResponse.Merge(m, src)
 }
-func (m *LookupPicFileResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicFileResponse.Size(m)
+func (m *FindPicCommentVotesResponse) XXX_Size() int {
+	return xxx_messageInfo_FindPicCommentVotesResponse.Size(m)
 }
-func (m *LookupPicFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicFileResponse.DiscardUnknown(m)
+func (m *FindPicCommentVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicCommentVotesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindPicCommentVotesResponse proto.InternalMessageInfo
 
-func (m *LookupPicFileResponse) GetPicFile() *PicFile {
+func (m *FindPicCommentVotesResponse) GetVote() []*PicCommentVote {
 	if m != nil {
-		return m.PicFile
+		return m.Vote
 	}
 	return nil
 }
 
-type LookupPicVoteRequest struct {
-	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	// Optional.  Uses auth token if not specified.
-	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type FindPicsByTagsRequest struct {
+	// pics must have every one of these tags.
+	AllTag []string `protobuf:"bytes,1,rep,name=all_tag,json=allTag,proto3" json:"all_tag,omitempty"`
+	// if present, pics must have at least one of these tags.
+	AnyTag []string `protobuf:"bytes,2,rep,name=any_tag,json=anyTag,proto3" json:"any_tag,omitempty"`
+	// pics must have none of these tags.
+	NoneTag []string `protobuf:"bytes,3,rep,name=none_tag,json=noneTag,proto3" json:"none_tag,omitempty"`
+	// if set, only pics with an id less than or equal to this are returned.
+	StartPicId           string   `protobuf:"bytes,4,opt,name=start_pic_id,json=startPicId,proto3" json:"start_pic_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPicVoteRequest) Reset()         { *m = LookupPicVoteRequest{} }
-func (m *LookupPicVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPicVoteRequest) ProtoMessage()    {}
-func (*LookupPicVoteRequest) Descriptor() ([]byte, []int) {
+func (m *FindPicsByTagsRequest) Reset()         { *m = FindPicsByTagsRequest{} }
+func (m *FindPicsByTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindPicsByTagsRequest) ProtoMessage()    {}
+func (*FindPicsByTagsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 
-func (m *LookupPicVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicVoteRequest.Unmarshal(m, b)
+func (m *FindPicsByTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicsByTagsRequest.Unmarshal(m, b)
 }
-func (m *LookupPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicVoteRequest.Marshal(b, m, deterministic)
+func (m *FindPicsByTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicsByTagsRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPicVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicVoteRequest.Merge(m, src)
+func (m *FindPicsByTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicsByTagsRequest.Merge(m, src)
 }
-func (m *LookupPicVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPicVoteRequest.Size(m)
+func (m *FindPicsByTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindPicsByTagsRequest.Size(m)
 }
-func (m *LookupPicVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicVoteRequest.DiscardUnknown(m)
+func (m *FindPicsByTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicsByTagsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindPicsByTagsRequest proto.InternalMessageInfo
 
-func (m *LookupPicVoteRequest) GetPicId() string {
+func (m *FindPicsByTagsRequest) GetAllTag() []string {
 	if m != nil {
-		return m.PicId
+		return m.AllTag
 	}
-	return ""
+	return nil
 }
 
-func (m *LookupPicVoteRequest) GetUserId() string {
+func (m *FindPicsByTagsRequest) GetAnyTag() []string {
 	if m != nil {
-		return m.UserId
+		return m.AnyTag
 	}
-	return ""
+	return nil
 }
 
-type LookupPicVoteResponse struct {
-	Vote                 *PicVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FindPicsByTagsRequest) GetNoneTag() []string {
+	if m != nil {
+		return m.NoneTag
+	}
+	return nil
 }
 
-func (m *LookupPicVoteResponse) Reset()         { *m = LookupPicVoteResponse{} }
-func (m *LookupPicVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPicVoteResponse) ProtoMessage()    {}
-func (*LookupPicVoteResponse) Descriptor() ([]byte, []int) {
+func (m *FindPicsByTagsRequest) GetStartPicId() string {
+	if m != nil {
+		return m.StartPicId
+	}
+	return ""
+}
+
+type FindPicsByTagsResponse struct {
+	Pic []*PicAndThumbnail `protobuf:"bytes,1,rep,name=pic,proto3" json:"pic,omitempty"`
+	// if set, this field is the next pic id as a
+	// continuation token.
+	NextPicId            string   `protobuf:"bytes,2,opt,name=next_pic_id,json=nextPicId,proto3" json:"next_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindPicsByTagsResponse) Reset()         { *m = FindPicsByTagsResponse{} }
+func (m *FindPicsByTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindPicsByTagsResponse) ProtoMessage()    {}
+func (*FindPicsByTagsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 
-func (m *LookupPicVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPicVoteResponse.Unmarshal(m, b)
+func (m *FindPicsByTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPicsByTagsResponse.Unmarshal(m, b)
 }
-func (m *LookupPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPicVoteResponse.Marshal(b, m, deterministic)
+func (m *FindPicsByTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPicsByTagsResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPicVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPicVoteResponse.Merge(m, src)
+func (m *FindPicsByTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPicsByTagsResponse.Merge(m, src)
 }
-func (m *LookupPicVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPicVoteResponse.Size(m)
+func (m *FindPicsByTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindPicsByTagsResponse.Size(m)
 }
-func (m *LookupPicVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPicVoteResponse.DiscardUnknown(m)
+func (m *FindPicsByTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPicsByTagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPicVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindPicsByTagsResponse proto.InternalMessageInfo
 
-func (m *LookupPicVoteResponse) GetVote() *PicVote {
+func (m *FindPicsByTagsResponse) GetPic() []*PicAndThumbnail {
 	if m != nil {
-		return m.Vote
+		return m.Pic
 	}
 	return nil
 }
 
-type LookupPublicUserInfoRequest struct {
-	// if absent, assumed to come from auth token
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (m *FindPicsByTagsResponse) GetNextPicId() string {
+	if m != nil {
+		return m.NextPicId
+	}
+	return ""
+}
+
+type FindRolesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPublicUserInfoRequest) Reset()         { *m = LookupPublicUserInfoRequest{} }
-func (m *LookupPublicUserInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupPublicUserInfoRequest) ProtoMessage()    {}
-func (*LookupPublicUserInfoRequest) Descriptor() ([]byte, []int) {
+func (m *FindRolesRequest) Reset()         { *m = FindRolesRequest{} }
+func (m *FindRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*FindRolesRequest) ProtoMessage()    {}
+func (*FindRolesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-func (m *LookupPublicUserInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPublicUserInfoRequest.Unmarshal(m, b)
+func (m *FindRolesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindRolesRequest.Unmarshal(m, b)
 }
-func (m *LookupPublicUserInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPublicUserInfoRequest.Marshal(b, m, deterministic)
+func (m *FindRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindRolesRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupPublicUserInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPublicUserInfoRequest.Merge(m, src)
+func (m *FindRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindRolesRequest.Merge(m, src)
 }
-func (m *LookupPublicUserInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupPublicUserInfoRequest.Size(m)
+func (m *FindRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_FindRolesRequest.Size(m)
 }
-func (m *LookupPublicUserInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPublicUserInfoRequest.DiscardUnknown(m)
+func (m *FindRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindRolesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPublicUserInfoRequest proto.InternalMessageInfo
-
-func (m *LookupPublicUserInfoRequest) GetUserId() string {
-	if m != nil {
-		return m.UserId
-	}
-	return ""
-}
+var xxx_messageInfo_FindRolesRequest proto.InternalMessageInfo
 
-type LookupPublicUserInfoResponse struct {
-	UserInfo             *PublicUserInfo `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type FindRolesResponse struct {
+	Role                 []*Role  `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupPublicUserInfoResponse) Reset()         { *m = LookupPublicUserInfoResponse{} }
-func (m *LookupPublicUserInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupPublicUserInfoResponse) ProtoMessage()    {}
-func (*LookupPublicUserInfoResponse) Descriptor() ([]byte, []int) {
+func (m *FindRolesResponse) Reset()         { *m = FindRolesResponse{} }
+func (m *FindRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*FindRolesResponse) ProtoMessage()    {}
+func (*FindRolesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 
-func (m *LookupPublicUserInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupPublicUserInfoResponse.Unmarshal(m, b)
+func (m *FindRolesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindRolesResponse.Unmarshal(m, b)
 }
-func (m *LookupPublicUserInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupPublicUserInfoResponse.Marshal(b, m, deterministic)
+func (m *FindRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindRolesResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupPublicUserInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupPublicUserInfoResponse.Merge(m, src)
+func (m *FindRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindRolesResponse.Merge(m, src)
 }
-func (m *LookupPublicUserInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupPublicUserInfoResponse.Size(m)
+func (m *FindRolesResponse) XXX_Size() int {
+	return xxx_messageInfo_FindRolesResponse.Size(m)
 }
-func (m *LookupPublicUserInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupPublicUserInfoResponse.DiscardUnknown(m)
+func (m *FindRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindRolesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupPublicUserInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindRolesResponse proto.InternalMessageInfo
 
-func (m *LookupPublicUserInfoResponse) GetUserInfo() *PublicUserInfo {
+func (m *FindRolesResponse) GetRole() []*Role {
 	if m != nil {
-		return m.UserInfo
+		return m.Role
 	}
 	return nil
 }
 
-type LookupUserRequest struct {
-	// if absent, assumed to come from auth token
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type FindSchedPicsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupUserRequest) Reset()         { *m = LookupUserRequest{} }
-func (m *LookupUserRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupUserRequest) ProtoMessage()    {}
-func (*LookupUserRequest) Descriptor() ([]byte, []int) {
+func (m *FindSchedPicsRequest) Reset()         { *m = FindSchedPicsRequest{} }
+func (m *FindSchedPicsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindSchedPicsRequest) ProtoMessage()    {}
+func (*FindSchedPicsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *LookupUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupUserRequest.Unmarshal(m, b)
+func (m *FindSchedPicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSchedPicsRequest.Unmarshal(m, b)
 }
-func (m *LookupUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupUserRequest.Marshal(b, m, deterministic)
+func (m *FindSchedPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSchedPicsRequest.Marshal(b, m, deterministic)
 }
-func (m *LookupUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupUserRequest.Merge(m, src)
+func (m *FindSchedPicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSchedPicsRequest.Merge(m, src)
 }
-func (m *LookupUserRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupUserRequest.Size(m)
+func (m *FindSchedPicsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindSchedPicsRequest.Size(m)
 }
-func (m *LookupUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupUserRequest.DiscardUnknown(m)
+func (m *FindSchedPicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSchedPicsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupUserRequest proto.InternalMessageInfo
-
-func (m *LookupUserRequest) GetUserId() string {
-	if m != nil {
-		return m.UserId
-	}
-	return ""
-}
+var xxx_messageInfo_FindSchedPicsRequest proto.InternalMessageInfo
 
-type LookupUserResponse struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FindSchedPicsResponse struct {
+	Pic                  []*PicAndThumbnail `protobuf:"bytes,2,rep,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *LookupUserResponse) Reset()         { *m = LookupUserResponse{} }
-func (m *LookupUserResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupUserResponse) ProtoMessage()    {}
-func (*LookupUserResponse) Descriptor() ([]byte, []int) {
+func (m *FindSchedPicsResponse) Reset()         { *m = FindSchedPicsResponse{} }
+func (m *FindSchedPicsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindSchedPicsResponse) ProtoMessage()    {}
+func (*FindSchedPicsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func (m *LookupUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupUserResponse.Unmarshal(m, b)
+func (m *FindSchedPicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSchedPicsResponse.Unmarshal(m, b)
 }
-func (m *LookupUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupUserResponse.Marshal(b, m, deterministic)
+func (m *FindSchedPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSchedPicsResponse.Marshal(b, m, deterministic)
 }
-func (m *LookupUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupUserResponse.Merge(m, src)
+func (m *FindSchedPicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSchedPicsResponse.Merge(m, src)
 }
-func (m *LookupUserResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupUserResponse.Size(m)
+func (m *FindSchedPicsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindSchedPicsResponse.Size(m)
 }
-func (m *LookupUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupUserResponse.DiscardUnknown(m)
+func (m *FindSchedPicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSchedPicsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindSchedPicsResponse proto.InternalMessageInfo
 
-func (m *LookupUserResponse) GetUser() *User {
+func (m *FindSchedPicsResponse) GetPic() []*PicAndThumbnail {
 	if m != nil {
-		return m.User
+		return m.Pic
 	}
 	return nil
 }
 
-type PurgePicRequest struct {
-	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+type FindSimilarPicsRequest struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// the max Hamming distance between perceptual hashes, from 0 to 64.  If unset, a default radius
+	// is used.
+	Radius int32 `protobuf:"varint,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	// the max number of results.  If unset, a default limit is used.
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PurgePicRequest) Reset()         { *m = PurgePicRequest{} }
-func (m *PurgePicRequest) String() string { return proto.CompactTextString(m) }
-func (*PurgePicRequest) ProtoMessage()    {}
-func (*PurgePicRequest) Descriptor() ([]byte, []int) {
+func (m *FindSimilarPicsRequest) Reset()         { *m = FindSimilarPicsRequest{} }
+func (m *FindSimilarPicsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindSimilarPicsRequest) ProtoMessage()    {}
+func (*FindSimilarPicsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
 
-func (m *PurgePicRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PurgePicRequest.Unmarshal(m, b)
+func (m *FindSimilarPicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSimilarPicsRequest.Unmarshal(m, b)
 }
-func (m *PurgePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PurgePicRequest.Marshal(b, m, deterministic)
+func (m *FindSimilarPicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSimilarPicsRequest.Marshal(b, m, deterministic)
 }
-func (m *PurgePicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgePicRequest.Merge(m, src)
+func (m *FindSimilarPicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSimilarPicsRequest.Merge(m, src)
 }
-func (m *PurgePicRequest) XXX_Size() int {
-	return xxx_messageInfo_PurgePicRequest.Size(m)
+func (m *FindSimilarPicsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindSimilarPicsRequest.Size(m)
 }
-func (m *PurgePicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgePicRequest.DiscardUnknown(m)
+func (m *FindSimilarPicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSimilarPicsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgePicRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindSimilarPicsRequest proto.InternalMessageInfo
 
-func (m *PurgePicRequest) GetPicId() string {
+func (m *FindSimilarPicsRequest) GetPicId() string {
 	if m != nil {
 		return m.PicId
 	}
 	return ""
 }
 
-type PurgePicResponse struct {
+func (m *FindSimilarPicsRequest) GetRadius() int32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *FindSimilarPicsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type FindSimilarPicsResponse struct {
+	// pics are ordered by ascending distance.
+	PicId []string `protobuf:"bytes,1,rep,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// the Hamming distance of each pic in pic_id.
+	Distance             []int32  `protobuf:"varint,2,rep,packed,name=distance,proto3" json:"distance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PurgePicResponse) Reset()         { *m = PurgePicResponse{} }
-func (m *PurgePicResponse) String() string { return proto.CompactTextString(m) }
-func (*PurgePicResponse) ProtoMessage()    {}
-func (*PurgePicResponse) Descriptor() ([]byte, []int) {
+func (m *FindSimilarPicsResponse) Reset()         { *m = FindSimilarPicsResponse{} }
+func (m *FindSimilarPicsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindSimilarPicsResponse) ProtoMessage()    {}
+func (*FindSimilarPicsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 
-func (m *PurgePicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PurgePicResponse.Unmarshal(m, b)
+func (m *FindSimilarPicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindSimilarPicsResponse.Unmarshal(m, b)
 }
-func (m *PurgePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PurgePicResponse.Marshal(b, m, deterministic)
+func (m *FindSimilarPicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindSimilarPicsResponse.Marshal(b, m, deterministic)
 }
-func (m *PurgePicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgePicResponse.Merge(m, src)
+func (m *FindSimilarPicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindSimilarPicsResponse.Merge(m, src)
 }
-func (m *PurgePicResponse) XXX_Size() int {
-	return xxx_messageInfo_PurgePicResponse.Size(m)
+func (m *FindSimilarPicsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindSimilarPicsResponse.Size(m)
 }
-func (m *PurgePicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgePicResponse.DiscardUnknown(m)
+func (m *FindSimilarPicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindSimilarPicsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgePicResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindSimilarPicsResponse proto.InternalMessageInfo
 
-type ReadPicFileRequest struct {
-	PicFileId string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
-	Format    PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
-	// The offset into the file to start reading at.  Required
-	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	// The maximum number of bytes to read.   Maybe be 0.
-	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
+func (m *FindSimilarPicsResponse) GetPicId() []string {
+	if m != nil {
+		return m.PicId
+	}
+	return nil
+}
+
+func (m *FindSimilarPicsResponse) GetDistance() []int32 {
+	if m != nil {
+		return m.Distance
+	}
+	return nil
+}
+
+type FindUserEventsRequest struct {
+	// Optional.  Uses auth token if not specified.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional.  If present, specifies the starting user event id to scan for
+	StartUserEventId     string   `protobuf:"bytes,2,opt,name=start_user_event_id,json=startUserEventId,proto3" json:"start_user_event_id,omitempty"`
+	Ascending            bool     `protobuf:"varint,3,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadPicFileRequest) Reset()         { *m = ReadPicFileRequest{} }
-func (m *ReadPicFileRequest) String() string { return proto.CompactTextString(m) }
-func (*ReadPicFileRequest) ProtoMessage()    {}
-func (*ReadPicFileRequest) Descriptor() ([]byte, []int) {
+func (m *FindUserEventsRequest) Reset()         { *m = FindUserEventsRequest{} }
+func (m *FindUserEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindUserEventsRequest) ProtoMessage()    {}
+func (*FindUserEventsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 
-func (m *ReadPicFileRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadPicFileRequest.Unmarshal(m, b)
+func (m *FindUserEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindUserEventsRequest.Unmarshal(m, b)
 }
-func (m *ReadPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadPicFileRequest.Marshal(b, m, deterministic)
+func (m *FindUserEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindUserEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *ReadPicFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadPicFileRequest.Merge(m, src)
+func (m *FindUserEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindUserEventsRequest.Merge(m, src)
 }
-func (m *ReadPicFileRequest) XXX_Size() int {
-	return xxx_messageInfo_ReadPicFileRequest.Size(m)
+func (m *FindUserEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindUserEventsRequest.Size(m)
 }
-func (m *ReadPicFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadPicFileRequest.DiscardUnknown(m)
+func (m *FindUserEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindUserEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadPicFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindUserEventsRequest proto.InternalMessageInfo
 
-func (m *ReadPicFileRequest) GetPicFileId() string {
+func (m *FindUserEventsRequest) GetUserId() string {
 	if m != nil {
-		return m.PicFileId
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *ReadPicFileRequest) GetFormat() PicFile_Format {
-	if m != nil {
-		return m.Format
-	}
-	return PicFile_UNKNOWN
-}
-
-func (m *ReadPicFileRequest) GetOffset() int64 {
+func (m *FindUserEventsRequest) GetStartUserEventId() string {
 	if m != nil {
-		return m.Offset
+		return m.StartUserEventId
 	}
-	return 0
+	return ""
 }
 
-func (m *ReadPicFileRequest) GetLimit() int64 {
+func (m *FindUserEventsRequest) GetAscending() bool {
 	if m != nil {
-		return m.Limit
+		return m.Ascending
 	}
-	return 0
+	return false
 }
 
-type ReadPicFileResponse struct {
-	// may be empty
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// indicates that the end of the pic has been reached.
-	Eof                  bool     `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FindUserEventsResponse struct {
+	UserEvent            []*UserEvent `protobuf:"bytes,1,rep,name=user_event,json=userEvent,proto3" json:"user_event,omitempty"`
+	NextUserEventId      string       `protobuf:"bytes,2,opt,name=next_user_event_id,json=nextUserEventId,proto3" json:"next_user_event_id,omitempty"`
+	PrevUserEventId      string       `protobuf:"bytes,3,opt,name=prev_user_event_id,json=prevUserEventId,proto3" json:"prev_user_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ReadPicFileResponse) Reset()         { *m = ReadPicFileResponse{} }
-func (m *ReadPicFileResponse) String() string { return proto.CompactTextString(m) }
-func (*ReadPicFileResponse) ProtoMessage()    {}
-func (*ReadPicFileResponse) Descriptor() ([]byte, []int) {
+func (m *FindUserEventsResponse) Reset()         { *m = FindUserEventsResponse{} }
+func (m *FindUserEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindUserEventsResponse) ProtoMessage()    {}
+func (*FindUserEventsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 
-func (m *ReadPicFileResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadPicFileResponse.Unmarshal(m, b)
+func (m *FindUserEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindUserEventsResponse.Unmarshal(m, b)
 }
-func (m *ReadPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadPicFileResponse.Marshal(b, m, deterministic)
+func (m *FindUserEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindUserEventsResponse.Marshal(b, m, deterministic)
 }
-func (m *ReadPicFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadPicFileResponse.Merge(m, src)
+func (m *FindUserEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindUserEventsResponse.Merge(m, src)
 }
-func (m *ReadPicFileResponse) XXX_Size() int {
-	return xxx_messageInfo_ReadPicFileResponse.Size(m)
+func (m *FindUserEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindUserEventsResponse.Size(m)
 }
-func (m *ReadPicFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadPicFileResponse.DiscardUnknown(m)
+func (m *FindUserEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindUserEventsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadPicFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindUserEventsResponse proto.InternalMessageInfo
 
-func (m *ReadPicFileResponse) GetData() []byte {
+func (m *FindUserEventsResponse) GetUserEvent() []*UserEvent {
 	if m != nil {
-		return m.Data
+		return m.UserEvent
 	}
 	return nil
 }
 
-func (m *ReadPicFileResponse) GetEof() bool {
+func (m *FindUserEventsResponse) GetNextUserEventId() string {
 	if m != nil {
-		return m.Eof
+		return m.NextUserEventId
 	}
-	return false
+	return ""
 }
 
-type SoftDeletePicRequest struct {
-	PicId                string               `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	Details              string               `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	Reason               DeletionReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=pixur.api.DeletionReason" json:"reason,omitempty"`
-	DeletionTime         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *FindUserEventsResponse) GetPrevUserEventId() string {
+	if m != nil {
+		return m.PrevUserEventId
+	}
+	return ""
 }
 
-func (m *SoftDeletePicRequest) Reset()         { *m = SoftDeletePicRequest{} }
-func (m *SoftDeletePicRequest) String() string { return proto.CompactTextString(m) }
-func (*SoftDeletePicRequest) ProtoMessage()    {}
-func (*SoftDeletePicRequest) Descriptor() ([]byte, []int) {
+type FindBackgroundJobsRequest struct {
+	// Optional.  Uses auth token if not specified.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// if set, jobs of every user are found, and user_id is ignored.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	// Optional.  If present, only jobs at or before this id are found.
+	StartBackgroundJobId string   `protobuf:"bytes,3,opt,name=start_background_job_id,json=startBackgroundJobId,proto3" json:"start_background_job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindBackgroundJobsRequest) Reset()         { *m = FindBackgroundJobsRequest{} }
+func (m *FindBackgroundJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindBackgroundJobsRequest) ProtoMessage()    {}
+func (*FindBackgroundJobsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (m *SoftDeletePicRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SoftDeletePicRequest.Unmarshal(m, b)
+func (m *FindBackgroundJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindBackgroundJobsRequest.Unmarshal(m, b)
 }
-func (m *SoftDeletePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SoftDeletePicRequest.Marshal(b, m, deterministic)
+func (m *FindBackgroundJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindBackgroundJobsRequest.Marshal(b, m, deterministic)
 }
-func (m *SoftDeletePicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SoftDeletePicRequest.Merge(m, src)
+func (m *FindBackgroundJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindBackgroundJobsRequest.Merge(m, src)
 }
-func (m *SoftDeletePicRequest) XXX_Size() int {
-	return xxx_messageInfo_SoftDeletePicRequest.Size(m)
+func (m *FindBackgroundJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindBackgroundJobsRequest.Size(m)
 }
-func (m *SoftDeletePicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SoftDeletePicRequest.DiscardUnknown(m)
+func (m *FindBackgroundJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindBackgroundJobsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SoftDeletePicRequest proto.InternalMessageInfo
-
-func (m *SoftDeletePicRequest) GetPicId() string {
-	if m != nil {
-		return m.PicId
-	}
-	return ""
-}
+var xxx_messageInfo_FindBackgroundJobsRequest proto.InternalMessageInfo
 
-func (m *SoftDeletePicRequest) GetDetails() string {
+func (m *FindBackgroundJobsRequest) GetUserId() string {
 	if m != nil {
-		return m.Details
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *SoftDeletePicRequest) GetReason() DeletionReason {
+func (m *FindBackgroundJobsRequest) GetAll() bool {
 	if m != nil {
-		return m.Reason
+		return m.All
 	}
-	return DeletionReason_UNKNOWN
+	return false
 }
 
-func (m *SoftDeletePicRequest) GetDeletionTime() *timestamp.Timestamp {
+func (m *FindBackgroundJobsRequest) GetStartBackgroundJobId() string {
 	if m != nil {
-		return m.DeletionTime
+		return m.StartBackgroundJobId
 	}
-	return nil
+	return ""
 }
 
-type SoftDeletePicResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FindBackgroundJobsResponse struct {
+	// newest first.
+	BackgroundJob        []*BackgroundJob `protobuf:"bytes,1,rep,name=background_job,json=backgroundJob,proto3" json:"background_job,omitempty"`
+	NextBackgroundJobId  string           `protobuf:"bytes,2,opt,name=next_background_job_id,json=nextBackgroundJobId,proto3" json:"next_background_job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SoftDeletePicResponse) Reset()         { *m = SoftDeletePicResponse{} }
-func (m *SoftDeletePicResponse) String() string { return proto.CompactTextString(m) }
-func (*SoftDeletePicResponse) ProtoMessage()    {}
-func (*SoftDeletePicResponse) Descriptor() ([]byte, []int) {
+func (m *FindBackgroundJobsResponse) Reset()         { *m = FindBackgroundJobsResponse{} }
+func (m *FindBackgroundJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindBackgroundJobsResponse) ProtoMessage()    {}
+func (*FindBackgroundJobsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
 }
 
-func (m *SoftDeletePicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SoftDeletePicResponse.Unmarshal(m, b)
+func (m *FindBackgroundJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindBackgroundJobsResponse.Unmarshal(m, b)
 }
-func (m *SoftDeletePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SoftDeletePicResponse.Marshal(b, m, deterministic)
+func (m *FindBackgroundJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindBackgroundJobsResponse.Marshal(b, m, deterministic)
 }
-func (m *SoftDeletePicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SoftDeletePicResponse.Merge(m, src)
+func (m *FindBackgroundJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindBackgroundJobsResponse.Merge(m, src)
 }
-func (m *SoftDeletePicResponse) XXX_Size() int {
-	return xxx_messageInfo_SoftDeletePicResponse.Size(m)
+func (m *FindBackgroundJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindBackgroundJobsResponse.Size(m)
 }
-func (m *SoftDeletePicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SoftDeletePicResponse.DiscardUnknown(m)
+func (m *FindBackgroundJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindBackgroundJobsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SoftDeletePicResponse proto.InternalMessageInfo
+var xxx_messageInfo_FindBackgroundJobsResponse proto.InternalMessageInfo
 
-type UpdateUserRequest struct {
-	UserId               string                              `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Version              int64                               `protobuf:"fixed64,2,opt,name=version,proto3" json:"version,omitempty"`
-	Ident                *UpdateUserRequest_ChangeIdent      `protobuf:"bytes,3,opt,name=ident,proto3" json:"ident,omitempty"`
-	Secret               *UpdateUserRequest_ChangeSecret     `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
-	Capability           *UpdateUserRequest_ChangeCapability `protobuf:"bytes,5,opt,name=capability,proto3" json:"capability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_unrecognized     []byte                              `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+func (m *FindBackgroundJobsResponse) GetBackgroundJob() []*BackgroundJob {
+	if m != nil {
+		return m.BackgroundJob
+	}
+	return nil
 }
 
-func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
-func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest) ProtoMessage()    {}
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+func (m *FindBackgroundJobsResponse) GetNextBackgroundJobId() string {
+	if m != nil {
+		return m.NextBackgroundJobId
+	}
+	return ""
+}
+
+type FindTagsRequest struct {
+	// prefix is the start of the tag name to search for.  It is matched case insensitively.  Must
+	// be present unless all is set.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// if set, every tag is listed and prefix is ignored.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	// if set, only tags at or after this key are returned.  It should be considered as an opaque
+	// token, taken from next_tag_key.
+	StartTagKey          string   `protobuf:"bytes,3,opt,name=start_tag_key,json=startTagKey,proto3" json:"start_tag_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindTagsRequest) Reset()         { *m = FindTagsRequest{} }
+func (m *FindTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindTagsRequest) ProtoMessage()    {}
+func (*FindTagsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
 }
 
-func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest.Unmarshal(m, b)
+func (m *FindTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindTagsRequest.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+func (m *FindTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindTagsRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest.Merge(m, src)
+func (m *FindTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindTagsRequest.Merge(m, src)
 }
-func (m *UpdateUserRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest.Size(m)
+func (m *FindTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindTagsRequest.Size(m)
 }
-func (m *UpdateUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+func (m *FindTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindTagsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_FindTagsRequest proto.InternalMessageInfo
 
-func (m *UpdateUserRequest) GetUserId() string {
+func (m *FindTagsRequest) GetPrefix() string {
 	if m != nil {
-		return m.UserId
+		return m.Prefix
 	}
 	return ""
 }
 
-func (m *UpdateUserRequest) GetVersion() int64 {
+func (m *FindTagsRequest) GetAll() bool {
 	if m != nil {
-		return m.Version
+		return m.All
 	}
-	return 0
+	return false
 }
 
-func (m *UpdateUserRequest) GetIdent() *UpdateUserRequest_ChangeIdent {
+func (m *FindTagsRequest) GetStartTagKey() string {
 	if m != nil {
-		return m.Ident
+		return m.StartTagKey
 	}
-	return nil
+	return ""
 }
 
-func (m *UpdateUserRequest) GetSecret() *UpdateUserRequest_ChangeSecret {
-	if m != nil {
-		return m.Secret
-	}
-	return nil
-}
-
-func (m *UpdateUserRequest) GetCapability() *UpdateUserRequest_ChangeCapability {
-	if m != nil {
-		return m.Capability
-	}
-	return nil
-}
-
-type UpdateUserRequest_ChangeIdent struct {
-	Ident                string   `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+type FindTagsResponse struct {
+	// tags are ordered by descending usage count.
+	Tag []*Tag `protobuf:"bytes,1,rep,name=tag,proto3" json:"tag,omitempty"`
+	// if set, this field is the next tag key as a
+	// continuation token.  Prefix searches only rank so many tags, the first ones by name, so a
+	// very short prefix may not find every matching tag.
+	NextTagKey           string   `protobuf:"bytes,2,opt,name=next_tag_key,json=nextTagKey,proto3" json:"next_tag_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserRequest_ChangeIdent) Reset()         { *m = UpdateUserRequest_ChangeIdent{} }
-func (m *UpdateUserRequest_ChangeIdent) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest_ChangeIdent) ProtoMessage()    {}
-func (*UpdateUserRequest_ChangeIdent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42, 0}
+func (m *FindTagsResponse) Reset()         { *m = FindTagsResponse{} }
+func (m *FindTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindTagsResponse) ProtoMessage()    {}
+func (*FindTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
 }
 
-func (m *UpdateUserRequest_ChangeIdent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Unmarshal(m, b)
+func (m *FindTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindTagsResponse.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Marshal(b, m, deterministic)
+func (m *FindTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindTagsResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest_ChangeIdent.Merge(m, src)
+func (m *FindTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindTagsResponse.Merge(m, src)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Size(m)
+func (m *FindTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindTagsResponse.Size(m)
 }
-func (m *UpdateUserRequest_ChangeIdent) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest_ChangeIdent.DiscardUnknown(m)
+func (m *FindTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindTagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest_ChangeIdent proto.InternalMessageInfo
+var xxx_messageInfo_FindTagsResponse proto.InternalMessageInfo
 
-func (m *UpdateUserRequest_ChangeIdent) GetIdent() string {
+func (m *FindTagsResponse) GetTag() []*Tag {
 	if m != nil {
-		return m.Ident
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *FindTagsResponse) GetNextTagKey() string {
+	if m != nil {
+		return m.NextTagKey
 	}
 	return ""
 }
 
-type UpdateUserRequest_ChangeSecret struct {
-	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+type GetRefreshTokenRequest struct {
+	// ident is the unique identity of the user being created, usually an email address
+	Ident string `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	// secret is the secret string used to authenticate the user, usually a password
+	Secret            string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	PreviousAuthToken string `protobuf:"bytes,3,opt,name=previous_auth_token,json=previousAuthToken,proto3" json:"previous_auth_token,omitempty"`
+	// user_agent and remote_address describe the client being authenticated.  They are shown
+	// when listing tokens.
+	UserAgent     string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RemoteAddress string `protobuf:"bytes,5,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	// totp_code is the current code from the user's authenticator app, or one of their recovery
+	// codes.  It is needed with ident and secret if the user has two-factor authentication enabled.
+	TotpCode             string   `protobuf:"bytes,6,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserRequest_ChangeSecret) Reset()         { *m = UpdateUserRequest_ChangeSecret{} }
-func (m *UpdateUserRequest_ChangeSecret) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest_ChangeSecret) ProtoMessage()    {}
-func (*UpdateUserRequest_ChangeSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42, 1}
+func (m *GetRefreshTokenRequest) Reset()         { *m = GetRefreshTokenRequest{} }
+func (m *GetRefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRefreshTokenRequest) ProtoMessage()    {}
+func (*GetRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
 }
 
-func (m *UpdateUserRequest_ChangeSecret) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Unmarshal(m, b)
+func (m *GetRefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRefreshTokenRequest.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Marshal(b, m, deterministic)
+func (m *GetRefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRefreshTokenRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest_ChangeSecret.Merge(m, src)
+func (m *GetRefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRefreshTokenRequest.Merge(m, src)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Size(m)
+func (m *GetRefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRefreshTokenRequest.Size(m)
 }
-func (m *UpdateUserRequest_ChangeSecret) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest_ChangeSecret.DiscardUnknown(m)
+func (m *GetRefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRefreshTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest_ChangeSecret proto.InternalMessageInfo
+var xxx_messageInfo_GetRefreshTokenRequest proto.InternalMessageInfo
 
-func (m *UpdateUserRequest_ChangeSecret) GetSecret() string {
+func (m *GetRefreshTokenRequest) GetIdent() string {
 	if m != nil {
-		return m.Secret
+		return m.Ident
 	}
 	return ""
 }
 
-type UpdateUserRequest_ChangeCapability struct {
-	SetCapability        []Capability_Cap `protobuf:"varint,1,rep,packed,name=set_capability,json=setCapability,proto3,enum=pixur.api.Capability_Cap" json:"set_capability,omitempty"`
-	ClearCapability      []Capability_Cap `protobuf:"varint,2,rep,packed,name=clear_capability,json=clearCapability,proto3,enum=pixur.api.Capability_Cap" json:"clear_capability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
-}
-
-func (m *UpdateUserRequest_ChangeCapability) Reset()         { *m = UpdateUserRequest_ChangeCapability{} }
-func (m *UpdateUserRequest_ChangeCapability) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest_ChangeCapability) ProtoMessage()    {}
-func (*UpdateUserRequest_ChangeCapability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42, 2}
-}
-
-func (m *UpdateUserRequest_ChangeCapability) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Unmarshal(m, b)
-}
-func (m *UpdateUserRequest_ChangeCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Marshal(b, m, deterministic)
-}
-func (m *UpdateUserRequest_ChangeCapability) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest_ChangeCapability.Merge(m, src)
-}
-func (m *UpdateUserRequest_ChangeCapability) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Size(m)
-}
-func (m *UpdateUserRequest_ChangeCapability) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest_ChangeCapability.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UpdateUserRequest_ChangeCapability proto.InternalMessageInfo
-
-func (m *UpdateUserRequest_ChangeCapability) GetSetCapability() []Capability_Cap {
+func (m *GetRefreshTokenRequest) GetSecret() string {
 	if m != nil {
-		return m.SetCapability
+		return m.Secret
 	}
-	return nil
+	return ""
 }
 
-func (m *UpdateUserRequest_ChangeCapability) GetClearCapability() []Capability_Cap {
+func (m *GetRefreshTokenRequest) GetPreviousAuthToken() string {
 	if m != nil {
-		return m.ClearCapability
+		return m.PreviousAuthToken
 	}
-	return nil
-}
-
-type UpdateUserResponse struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
-func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserResponse) ProtoMessage()    {}
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+func (m *GetRefreshTokenRequest) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
 }
 
-func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserResponse.Unmarshal(m, b)
-}
-func (m *UpdateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserResponse.Marshal(b, m, deterministic)
-}
-func (m *UpdateUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserResponse.Merge(m, src)
-}
-func (m *UpdateUserResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserResponse.Size(m)
-}
-func (m *UpdateUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserResponse.DiscardUnknown(m)
+func (m *GetRefreshTokenRequest) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
 }
 
-var xxx_messageInfo_UpdateUserResponse proto.InternalMessageInfo
-
-func (m *UpdateUserResponse) GetUser() *User {
+func (m *GetRefreshTokenRequest) GetTotpCode() string {
 	if m != nil {
-		return m.User
+		return m.TotpCode
 	}
-	return nil
+	return ""
 }
 
-type UpsertPicCommentVoteRequest struct {
-	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// Optional.   Not necessary when creating for the first time.
-	Version              int64               `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
-	Vote                 PicCommentVote_Vote `protobuf:"varint,4,opt,name=vote,proto3,enum=pixur.api.PicCommentVote_Vote" json:"vote,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type GetRefreshTokenResponse struct {
+	AuthToken            string      `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	PixToken             string      `protobuf:"bytes,5,opt,name=pix_token,json=pixToken,proto3" json:"pix_token,omitempty"`
+	AuthPayload          *PwtPayload `protobuf:"bytes,4,opt,name=auth_payload,json=authPayload,proto3" json:"auth_payload,omitempty"`
+	PixPayload           *PwtPayload `protobuf:"bytes,6,opt,name=pix_payload,json=pixPayload,proto3" json:"pix_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *UpsertPicCommentVoteRequest) Reset()         { *m = UpsertPicCommentVoteRequest{} }
-func (m *UpsertPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicCommentVoteRequest) ProtoMessage()    {}
-func (*UpsertPicCommentVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+func (m *GetRefreshTokenResponse) Reset()         { *m = GetRefreshTokenResponse{} }
+func (m *GetRefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRefreshTokenResponse) ProtoMessage()    {}
+func (*GetRefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
 }
 
-func (m *UpsertPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicCommentVoteRequest.Unmarshal(m, b)
+func (m *GetRefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRefreshTokenResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicCommentVoteRequest.Marshal(b, m, deterministic)
+func (m *GetRefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRefreshTokenResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicCommentVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicCommentVoteRequest.Merge(m, src)
+func (m *GetRefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRefreshTokenResponse.Merge(m, src)
 }
-func (m *UpsertPicCommentVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicCommentVoteRequest.Size(m)
+func (m *GetRefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRefreshTokenResponse.Size(m)
 }
-func (m *UpsertPicCommentVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicCommentVoteRequest.DiscardUnknown(m)
+func (m *GetRefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRefreshTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicCommentVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetRefreshTokenResponse proto.InternalMessageInfo
 
-func (m *UpsertPicCommentVoteRequest) GetPicId() string {
+func (m *GetRefreshTokenResponse) GetAuthToken() string {
 	if m != nil {
-		return m.PicId
+		return m.AuthToken
 	}
 	return ""
 }
 
-func (m *UpsertPicCommentVoteRequest) GetCommentId() string {
+func (m *GetRefreshTokenResponse) GetPixToken() string {
 	if m != nil {
-		return m.CommentId
+		return m.PixToken
 	}
 	return ""
 }
 
-func (m *UpsertPicCommentVoteRequest) GetVersion() int64 {
+func (m *GetRefreshTokenResponse) GetAuthPayload() *PwtPayload {
 	if m != nil {
-		return m.Version
+		return m.AuthPayload
 	}
-	return 0
+	return nil
 }
 
-func (m *UpsertPicCommentVoteRequest) GetVote() PicCommentVote_Vote {
+func (m *GetRefreshTokenResponse) GetPixPayload() *PwtPayload {
 	if m != nil {
-		return m.Vote
+		return m.PixPayload
 	}
-	return PicCommentVote_UNKNOWN
+	return nil
 }
 
-type UpsertPicCommentVoteResponse struct {
+type IncrementViewCountRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicCommentVoteResponse) Reset()         { *m = UpsertPicCommentVoteResponse{} }
-func (m *UpsertPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicCommentVoteResponse) ProtoMessage()    {}
-func (*UpsertPicCommentVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+func (m *IncrementViewCountRequest) Reset()         { *m = IncrementViewCountRequest{} }
+func (m *IncrementViewCountRequest) String() string { return proto.CompactTextString(m) }
+func (*IncrementViewCountRequest) ProtoMessage()    {}
+func (*IncrementViewCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
 }
 
-func (m *UpsertPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicCommentVoteResponse.Unmarshal(m, b)
+func (m *IncrementViewCountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IncrementViewCountRequest.Unmarshal(m, b)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicCommentVoteResponse.Marshal(b, m, deterministic)
+func (m *IncrementViewCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IncrementViewCountRequest.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicCommentVoteResponse.Merge(m, src)
+func (m *IncrementViewCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementViewCountRequest.Merge(m, src)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicCommentVoteResponse.Size(m)
+func (m *IncrementViewCountRequest) XXX_Size() int {
+	return xxx_messageInfo_IncrementViewCountRequest.Size(m)
 }
-func (m *UpsertPicCommentVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicCommentVoteResponse.DiscardUnknown(m)
+func (m *IncrementViewCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementViewCountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicCommentVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_IncrementViewCountRequest proto.InternalMessageInfo
 
-// UpsertPicRequest is a request to upload and possibly merge with another
-// picture.
-type UpsertPicRequest struct {
-	// url is an optional field for the Pixur backend to download the pic from.
-	// Either url and/or data must be set.   If the data field is set along
-	// with the URL, the url is treated as regular metadata and not fetched.
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	// referrer is the referrer url used to fetch the resource.  If no data is
-	// set, the url will be used to retrieve the data, along with the referrer
-	// being set in the request.  If data is set, referrer is treated as
-	// additional metadata.  May not be used if url is not set.
-	Referrer string `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
-	// name is an optional field indicating a reasonable file name for the pic.
-	// If name and url are set, the name field will override the name
-	// automatically derived from the URL.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// data is the file contents of the upload.  It must contain a valid pic
-	// if present.  It may be left absent if there is a valid url instead.  This
-	// field takes precedence over the url field if both are specified.
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	// md5_hash is the hash of the pic contents.  It is used to verify the pic
-	// is valid when downloading from url, or when set in data.  If the Pixur
-	// backend already has a pic with a matching md5_hash, it may not use
-	// either data or url (at least one must still be specified, though).
-	Md5Hash []byte `protobuf:"bytes,4,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
-	// Optional extension data.  You must have the correct permissions to set this.
-	Ext                  map[string]*any.Any `protobuf:"bytes,6,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *IncrementViewCountRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
 }
 
-func (m *UpsertPicRequest) Reset()         { *m = UpsertPicRequest{} }
-func (m *UpsertPicRequest) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicRequest) ProtoMessage()    {}
-func (*UpsertPicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+type IncrementViewCountResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicRequest.Unmarshal(m, b)
+func (m *IncrementViewCountResponse) Reset()         { *m = IncrementViewCountResponse{} }
+func (m *IncrementViewCountResponse) String() string { return proto.CompactTextString(m) }
+func (*IncrementViewCountResponse) ProtoMessage()    {}
+func (*IncrementViewCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
 }
-func (m *UpsertPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicRequest.Marshal(b, m, deterministic)
+
+func (m *IncrementViewCountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IncrementViewCountResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicRequest.Merge(m, src)
+func (m *IncrementViewCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IncrementViewCountResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicRequest) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicRequest.Size(m)
+func (m *IncrementViewCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementViewCountResponse.Merge(m, src)
 }
-func (m *UpsertPicRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicRequest.DiscardUnknown(m)
+func (m *IncrementViewCountResponse) XXX_Size() int {
+	return xxx_messageInfo_IncrementViewCountResponse.Size(m)
+}
+func (m *IncrementViewCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementViewCountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicRequest proto.InternalMessageInfo
+var xxx_messageInfo_IncrementViewCountResponse proto.InternalMessageInfo
 
-func (m *UpsertPicRequest) GetUrl() string {
-	if m != nil {
-		return m.Url
-	}
-	return ""
+type ListApiKeysRequest struct {
+	// user_id is the user whose keys to list, in varint form.  If empty, the current user is used.
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicRequest) GetReferrer() string {
-	if m != nil {
-		return m.Referrer
-	}
-	return ""
+func (m *ListApiKeysRequest) Reset()         { *m = ListApiKeysRequest{} }
+func (m *ListApiKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysRequest) ProtoMessage()    {}
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
 }
 
-func (m *UpsertPicRequest) GetName() string {
+func (m *ListApiKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysRequest.Unmarshal(m, b)
+}
+func (m *ListApiKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysRequest.Merge(m, src)
+}
+func (m *ListApiKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysRequest.Size(m)
+}
+func (m *ListApiKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysRequest proto.InternalMessageInfo
+
+func (m *ListApiKeysRequest) GetUserId() string {
 	if m != nil {
-		return m.Name
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *UpsertPicRequest) GetData() []byte {
-	if m != nil {
-		return m.Data
-	}
-	return nil
+type ListApiKeysResponse struct {
+	// api_key is each key of the user, including expired ones.
+	ApiKey               []*ApiKey `protobuf:"bytes,1,rep,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *UpsertPicRequest) GetMd5Hash() []byte {
-	if m != nil {
-		return m.Md5Hash
-	}
-	return nil
+func (m *ListApiKeysResponse) Reset()         { *m = ListApiKeysResponse{} }
+func (m *ListApiKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysResponse) ProtoMessage()    {}
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
 }
 
-func (m *UpsertPicRequest) GetExt() map[string]*any.Any {
+func (m *ListApiKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysResponse.Unmarshal(m, b)
+}
+func (m *ListApiKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysResponse.Merge(m, src)
+}
+func (m *ListApiKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysResponse.Size(m)
+}
+func (m *ListApiKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysResponse proto.InternalMessageInfo
+
+func (m *ListApiKeysResponse) GetApiKey() []*ApiKey {
 	if m != nil {
-		return m.Ext
+		return m.ApiKey
 	}
 	return nil
 }
 
-type UpsertPicResponse struct {
-	// pic is the newly created or updated picture.
-	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+type ListTokensRequest struct {
+	// user_id is the user whose tokens to list, in varint form.  If empty, the current user is used.
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicResponse) Reset()         { *m = UpsertPicResponse{} }
-func (m *UpsertPicResponse) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicResponse) ProtoMessage()    {}
-func (*UpsertPicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+func (m *ListTokensRequest) Reset()         { *m = ListTokensRequest{} }
+func (m *ListTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTokensRequest) ProtoMessage()    {}
+func (*ListTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
 }
 
-func (m *UpsertPicResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicResponse.Unmarshal(m, b)
+func (m *ListTokensRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTokensRequest.Unmarshal(m, b)
 }
-func (m *UpsertPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicResponse.Marshal(b, m, deterministic)
+func (m *ListTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTokensRequest.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicResponse.Merge(m, src)
+func (m *ListTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokensRequest.Merge(m, src)
 }
-func (m *UpsertPicResponse) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicResponse.Size(m)
+func (m *ListTokensRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTokensRequest.Size(m)
 }
-func (m *UpsertPicResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicResponse.DiscardUnknown(m)
+func (m *ListTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokensRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListTokensRequest proto.InternalMessageInfo
 
-func (m *UpsertPicResponse) GetPic() *Pic {
+func (m *ListTokensRequest) GetUserId() string {
 	if m != nil {
-		return m.Pic
+		return m.UserId
 	}
-	return nil
+	return ""
 }
 
-type UpsertPicVoteRequest struct {
-	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	// Optional.   Not necessary when creating for the first time.
-	Version              int64        `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
-	Vote                 PicVote_Vote `protobuf:"varint,2,opt,name=vote,proto3,enum=pixur.api.PicVote_Vote" json:"vote,omitempty"`
+type ListTokensResponse struct {
+	// token is each unexpired token of the user, most recently seen first.
+	Token                []*UserToken `protobuf:"bytes,1,rep,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *UpsertPicVoteRequest) Reset()         { *m = UpsertPicVoteRequest{} }
-func (m *UpsertPicVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicVoteRequest) ProtoMessage()    {}
-func (*UpsertPicVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+func (m *ListTokensResponse) Reset()         { *m = ListTokensResponse{} }
+func (m *ListTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTokensResponse) ProtoMessage()    {}
+func (*ListTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
 }
 
-func (m *UpsertPicVoteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicVoteRequest.Unmarshal(m, b)
+func (m *ListTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTokensResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicVoteRequest.Marshal(b, m, deterministic)
+func (m *ListTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTokensResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicVoteRequest.Merge(m, src)
+func (m *ListTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokensResponse.Merge(m, src)
 }
-func (m *UpsertPicVoteRequest) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicVoteRequest.Size(m)
+func (m *ListTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTokensResponse.Size(m)
 }
-func (m *UpsertPicVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicVoteRequest.DiscardUnknown(m)
+func (m *ListTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokensResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListTokensResponse proto.InternalMessageInfo
 
-func (m *UpsertPicVoteRequest) GetPicId() string {
+func (m *ListTokensResponse) GetToken() []*UserToken {
 	if m != nil {
-		return m.PicId
+		return m.Token
 	}
-	return ""
+	return nil
 }
 
-func (m *UpsertPicVoteRequest) GetVersion() int64 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
+type LookupPicByHashRequest struct {
+	// sha512_256_hash is the SHA-512/256 hash of the pic contents.
+	Sha512_256Hash       []byte   `protobuf:"bytes,1,opt,name=sha512_256_hash,json=sha512256Hash,proto3" json:"sha512_256_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicVoteRequest) GetVote() PicVote_Vote {
+func (m *LookupPicByHashRequest) Reset()         { *m = LookupPicByHashRequest{} }
+func (m *LookupPicByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicByHashRequest) ProtoMessage()    {}
+func (*LookupPicByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *LookupPicByHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicByHashRequest.Unmarshal(m, b)
+}
+func (m *LookupPicByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicByHashRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicByHashRequest.Merge(m, src)
+}
+func (m *LookupPicByHashRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicByHashRequest.Size(m)
+}
+func (m *LookupPicByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicByHashRequest proto.InternalMessageInfo
+
+func (m *LookupPicByHashRequest) GetSha512_256Hash() []byte {
 	if m != nil {
-		return m.Vote
+		return m.Sha512_256Hash
 	}
-	return PicVote_UNKNOWN
+	return nil
 }
 
-type UpsertPicVoteResponse struct {
+type LookupPicByHashResponse struct {
+	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpsertPicVoteResponse) Reset()         { *m = UpsertPicVoteResponse{} }
-func (m *UpsertPicVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*UpsertPicVoteResponse) ProtoMessage()    {}
-func (*UpsertPicVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+func (m *LookupPicByHashResponse) Reset()         { *m = LookupPicByHashResponse{} }
+func (m *LookupPicByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicByHashResponse) ProtoMessage()    {}
+func (*LookupPicByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
 }
 
-func (m *UpsertPicVoteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpsertPicVoteResponse.Unmarshal(m, b)
+func (m *LookupPicByHashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicByHashResponse.Unmarshal(m, b)
 }
-func (m *UpsertPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpsertPicVoteResponse.Marshal(b, m, deterministic)
+func (m *LookupPicByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicByHashResponse.Marshal(b, m, deterministic)
 }
-func (m *UpsertPicVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpsertPicVoteResponse.Merge(m, src)
+func (m *LookupPicByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicByHashResponse.Merge(m, src)
 }
-func (m *UpsertPicVoteResponse) XXX_Size() int {
-	return xxx_messageInfo_UpsertPicVoteResponse.Size(m)
+func (m *LookupPicByHashResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicByHashResponse.Size(m)
 }
-func (m *UpsertPicVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpsertPicVoteResponse.DiscardUnknown(m)
+func (m *LookupPicByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicByHashResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpsertPicVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_LookupPicByHashResponse proto.InternalMessageInfo
 
-type WatchBackendConfigurationRequest struct {
+func (m *LookupPicByHashResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+type LookupPicCommentVoteRequest struct {
+	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// if absent, this comes from the auth token.
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchBackendConfigurationRequest) Reset()         { *m = WatchBackendConfigurationRequest{} }
-func (m *WatchBackendConfigurationRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchBackendConfigurationRequest) ProtoMessage()    {}
-func (*WatchBackendConfigurationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+func (m *LookupPicCommentVoteRequest) Reset()         { *m = LookupPicCommentVoteRequest{} }
+func (m *LookupPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicCommentVoteRequest) ProtoMessage()    {}
+func (*LookupPicCommentVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
 }
 
-func (m *WatchBackendConfigurationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchBackendConfigurationRequest.Unmarshal(m, b)
+func (m *LookupPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicCommentVoteRequest.Unmarshal(m, b)
 }
-func (m *WatchBackendConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchBackendConfigurationRequest.Marshal(b, m, deterministic)
+func (m *LookupPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicCommentVoteRequest.Marshal(b, m, deterministic)
 }
-func (m *WatchBackendConfigurationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchBackendConfigurationRequest.Merge(m, src)
+func (m *LookupPicCommentVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicCommentVoteRequest.Merge(m, src)
 }
-func (m *WatchBackendConfigurationRequest) XXX_Size() int {
-	return xxx_messageInfo_WatchBackendConfigurationRequest.Size(m)
+func (m *LookupPicCommentVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicCommentVoteRequest.Size(m)
 }
-func (m *WatchBackendConfigurationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchBackendConfigurationRequest.DiscardUnknown(m)
+func (m *LookupPicCommentVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicCommentVoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchBackendConfigurationRequest proto.InternalMessageInfo
+var xxx_messageInfo_LookupPicCommentVoteRequest proto.InternalMessageInfo
 
-type WatchBackendConfigurationResponse struct {
-	Version              int64                 `protobuf:"fixed64,1,opt,name=version,proto3" json:"version,omitempty"`
-	BackendConfiguration *BackendConfiguration `protobuf:"bytes,2,opt,name=backend_configuration,json=backendConfiguration,proto3" json:"backend_configuration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *LookupPicCommentVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
 }
 
-func (m *WatchBackendConfigurationResponse) Reset()         { *m = WatchBackendConfigurationResponse{} }
-func (m *WatchBackendConfigurationResponse) String() string { return proto.CompactTextString(m) }
-func (*WatchBackendConfigurationResponse) ProtoMessage()    {}
-func (*WatchBackendConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+func (m *LookupPicCommentVoteRequest) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
 }
 
-func (m *WatchBackendConfigurationResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchBackendConfigurationResponse.Unmarshal(m, b)
+func (m *LookupPicCommentVoteRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
 }
-func (m *WatchBackendConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchBackendConfigurationResponse.Marshal(b, m, deterministic)
+
+type LookupPicCommentVoteResponse struct {
+	Vote                 *PicCommentVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *WatchBackendConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchBackendConfigurationResponse.Merge(m, src)
+
+func (m *LookupPicCommentVoteResponse) Reset()         { *m = LookupPicCommentVoteResponse{} }
+func (m *LookupPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicCommentVoteResponse) ProtoMessage()    {}
+func (*LookupPicCommentVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
 }
-func (m *WatchBackendConfigurationResponse) XXX_Size() int {
-	return xxx_messageInfo_WatchBackendConfigurationResponse.Size(m)
+
+func (m *LookupPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicCommentVoteResponse.Unmarshal(m, b)
 }
-func (m *WatchBackendConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchBackendConfigurationResponse.DiscardUnknown(m)
+func (m *LookupPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicCommentVoteResponse.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_WatchBackendConfigurationResponse proto.InternalMessageInfo
-
-func (m *WatchBackendConfigurationResponse) GetVersion() int64 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
+func (m *LookupPicCommentVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicCommentVoteResponse.Merge(m, src)
+}
+func (m *LookupPicCommentVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicCommentVoteResponse.Size(m)
+}
+func (m *LookupPicCommentVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicCommentVoteResponse.DiscardUnknown(m)
 }
 
-func (m *WatchBackendConfigurationResponse) GetBackendConfiguration() *BackendConfiguration {
+var xxx_messageInfo_LookupPicCommentVoteResponse proto.InternalMessageInfo
+
+func (m *LookupPicCommentVoteResponse) GetVote() *PicCommentVote {
 	if m != nil {
-		return m.BackendConfiguration
+		return m.Vote
 	}
 	return nil
 }
 
-type ServiceOpts struct {
-	// a vaguely defined, but monotonically increasing "version".
-	ApiVersion int64 `protobuf:"varint,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	// The header key used to when using an auth token.
-	AuthTokenHeaderKey string `protobuf:"bytes,2,opt,name=auth_token_header_key,json=authTokenHeaderKey,proto3" json:"auth_token_header_key,omitempty"`
-	// The header key used to when using a pix token.
-	PixTokenHeaderKey string `protobuf:"bytes,3,opt,name=pix_token_header_key,json=pixTokenHeaderKey,proto3" json:"pix_token_header_key,omitempty"`
-	// Used in responses (e.g. ReadPicFile) to indicate suggested http headers.
-	// See pixur.api.HttpHeader
-	HttpHeaderKey        string   `protobuf:"bytes,4,opt,name=http_header_key,json=httpHeaderKey,proto3" json:"http_header_key,omitempty"`
+type LookupPicDetailsRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServiceOpts) Reset()         { *m = ServiceOpts{} }
-func (m *ServiceOpts) String() string { return proto.CompactTextString(m) }
-func (*ServiceOpts) ProtoMessage()    {}
-func (*ServiceOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+func (m *LookupPicDetailsRequest) Reset()         { *m = LookupPicDetailsRequest{} }
+func (m *LookupPicDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicDetailsRequest) ProtoMessage()    {}
+func (*LookupPicDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
 }
 
-func (m *ServiceOpts) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ServiceOpts.Unmarshal(m, b)
+func (m *LookupPicDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicDetailsRequest.Unmarshal(m, b)
 }
-func (m *ServiceOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ServiceOpts.Marshal(b, m, deterministic)
+func (m *LookupPicDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicDetailsRequest.Marshal(b, m, deterministic)
 }
-func (m *ServiceOpts) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServiceOpts.Merge(m, src)
+func (m *LookupPicDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicDetailsRequest.Merge(m, src)
 }
-func (m *ServiceOpts) XXX_Size() int {
-	return xxx_messageInfo_ServiceOpts.Size(m)
+func (m *LookupPicDetailsRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicDetailsRequest.Size(m)
 }
-func (m *ServiceOpts) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServiceOpts.DiscardUnknown(m)
+func (m *LookupPicDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicDetailsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServiceOpts proto.InternalMessageInfo
+var xxx_messageInfo_LookupPicDetailsRequest proto.InternalMessageInfo
 
-func (m *ServiceOpts) GetApiVersion() int64 {
+func (m *LookupPicDetailsRequest) GetPicId() string {
 	if m != nil {
-		return m.ApiVersion
+		return m.PicId
 	}
-	return 0
+	return ""
 }
 
-func (m *ServiceOpts) GetAuthTokenHeaderKey() string {
+type LookupPicDetailsResponse struct {
+	Pic                  *Pic            `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	Derived              []*PicFile      `protobuf:"bytes,4,rep,name=derived,proto3" json:"derived,omitempty"`
+	PicTag               []*PicTag       `protobuf:"bytes,2,rep,name=pic_tag,json=picTag,proto3" json:"pic_tag,omitempty"`
+	PicCommentTree       *PicCommentTree `protobuf:"bytes,3,opt,name=pic_comment_tree,json=picCommentTree,proto3" json:"pic_comment_tree,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LookupPicDetailsResponse) Reset()         { *m = LookupPicDetailsResponse{} }
+func (m *LookupPicDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicDetailsResponse) ProtoMessage()    {}
+func (*LookupPicDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *LookupPicDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicDetailsResponse.Unmarshal(m, b)
+}
+func (m *LookupPicDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicDetailsResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicDetailsResponse.Merge(m, src)
+}
+func (m *LookupPicDetailsResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicDetailsResponse.Size(m)
+}
+func (m *LookupPicDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicDetailsResponse proto.InternalMessageInfo
+
+func (m *LookupPicDetailsResponse) GetPic() *Pic {
 	if m != nil {
-		return m.AuthTokenHeaderKey
+		return m.Pic
 	}
-	return ""
+	return nil
 }
 
-func (m *ServiceOpts) GetPixTokenHeaderKey() string {
+func (m *LookupPicDetailsResponse) GetDerived() []*PicFile {
 	if m != nil {
-		return m.PixTokenHeaderKey
+		return m.Derived
 	}
-	return ""
+	return nil
 }
 
-func (m *ServiceOpts) GetHttpHeaderKey() string {
+func (m *LookupPicDetailsResponse) GetPicTag() []*PicTag {
 	if m != nil {
-		return m.HttpHeaderKey
+		return m.PicTag
 	}
-	return ""
+	return nil
 }
 
-// HttpHeader is a message included as a header on some responses.  It is only a suggestion.
-// See pixur.api.ServiceOpts.http_header_key
-type HttpHeader struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *LookupPicDetailsResponse) GetPicCommentTree() *PicCommentTree {
+	if m != nil {
+		return m.PicCommentTree
+	}
+	return nil
+}
+
+type LookupPicExtensionRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HttpHeader) Reset()         { *m = HttpHeader{} }
-func (m *HttpHeader) String() string { return proto.CompactTextString(m) }
-func (*HttpHeader) ProtoMessage()    {}
-func (*HttpHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+func (m *LookupPicExtensionRequest) Reset()         { *m = LookupPicExtensionRequest{} }
+func (m *LookupPicExtensionRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicExtensionRequest) ProtoMessage()    {}
+func (*LookupPicExtensionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
 }
 
-func (m *HttpHeader) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HttpHeader.Unmarshal(m, b)
+func (m *LookupPicExtensionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicExtensionRequest.Unmarshal(m, b)
 }
-func (m *HttpHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HttpHeader.Marshal(b, m, deterministic)
+func (m *LookupPicExtensionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicExtensionRequest.Marshal(b, m, deterministic)
 }
-func (m *HttpHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HttpHeader.Merge(m, src)
+func (m *LookupPicExtensionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicExtensionRequest.Merge(m, src)
 }
-func (m *HttpHeader) XXX_Size() int {
-	return xxx_messageInfo_HttpHeader.Size(m)
+func (m *LookupPicExtensionRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicExtensionRequest.Size(m)
 }
-func (m *HttpHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_HttpHeader.DiscardUnknown(m)
+func (m *LookupPicExtensionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicExtensionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HttpHeader proto.InternalMessageInfo
+var xxx_messageInfo_LookupPicExtensionRequest proto.InternalMessageInfo
 
-func (m *HttpHeader) GetKey() string {
+func (m *LookupPicExtensionRequest) GetPicId() string {
 	if m != nil {
-		return m.Key
+		return m.PicId
 	}
 	return ""
 }
 
-func (m *HttpHeader) GetValue() string {
-	if m != nil {
-		return m.Value
-	}
-	return ""
+type LookupPicExtensionResponse struct {
+	Ext                  map[string]*any.Any `protobuf:"bytes,1,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-var E_PixurServiceOpts = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.ServiceOptions)(nil),
-	ExtensionType: (*ServiceOpts)(nil),
-	Field:         65537,
-	Name:          "pixur.api.pixur_service_opts",
-	Tag:           "bytes,65537,opt,name=pixur_service_opts",
-	Filename:      "api.proto",
+func (m *LookupPicExtensionResponse) Reset()         { *m = LookupPicExtensionResponse{} }
+func (m *LookupPicExtensionResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicExtensionResponse) ProtoMessage()    {}
+func (*LookupPicExtensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
 }
 
-func init() {
-	proto.RegisterType((*AddPicCommentRequest)(nil), "pixur.api.AddPicCommentRequest")
-	proto.RegisterType((*AddPicCommentResponse)(nil), "pixur.api.AddPicCommentResponse")
-	proto.RegisterType((*AddPicTagsRequest)(nil), "pixur.api.AddPicTagsRequest")
-	proto.RegisterType((*AddPicTagsResponse)(nil), "pixur.api.AddPicTagsResponse")
-	proto.RegisterType((*CreateUserRequest)(nil), "pixur.api.CreateUserRequest")
-	proto.RegisterType((*CreateUserResponse)(nil), "pixur.api.CreateUserResponse")
-	proto.RegisterType((*DeleteTokenRequest)(nil), "pixur.api.DeleteTokenRequest")
-	proto.RegisterType((*DeleteTokenResponse)(nil), "pixur.api.DeleteTokenResponse")
-	proto.RegisterType((*FindIndexPicsRequest)(nil), "pixur.api.FindIndexPicsRequest")
+func (m *LookupPicExtensionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicExtensionResponse.Unmarshal(m, b)
+}
+func (m *LookupPicExtensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicExtensionResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicExtensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicExtensionResponse.Merge(m, src)
+}
+func (m *LookupPicExtensionResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicExtensionResponse.Size(m)
+}
+func (m *LookupPicExtensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicExtensionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicExtensionResponse proto.InternalMessageInfo
+
+func (m *LookupPicExtensionResponse) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+type LookupPicFileRequest struct {
+	PicFileId            string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
+	Format               PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LookupPicFileRequest) Reset()         { *m = LookupPicFileRequest{} }
+func (m *LookupPicFileRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicFileRequest) ProtoMessage()    {}
+func (*LookupPicFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *LookupPicFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicFileRequest.Unmarshal(m, b)
+}
+func (m *LookupPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicFileRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicFileRequest.Merge(m, src)
+}
+func (m *LookupPicFileRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicFileRequest.Size(m)
+}
+func (m *LookupPicFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicFileRequest proto.InternalMessageInfo
+
+func (m *LookupPicFileRequest) GetPicFileId() string {
+	if m != nil {
+		return m.PicFileId
+	}
+	return ""
+}
+
+func (m *LookupPicFileRequest) GetFormat() PicFile_Format {
+	if m != nil {
+		return m.Format
+	}
+	return PicFile_UNKNOWN
+}
+
+type LookupPicFileResponse struct {
+	PicFile              *PicFile `protobuf:"bytes,1,opt,name=pic_file,json=picFile,proto3" json:"pic_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicFileResponse) Reset()         { *m = LookupPicFileResponse{} }
+func (m *LookupPicFileResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicFileResponse) ProtoMessage()    {}
+func (*LookupPicFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *LookupPicFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicFileResponse.Unmarshal(m, b)
+}
+func (m *LookupPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicFileResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicFileResponse.Merge(m, src)
+}
+func (m *LookupPicFileResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicFileResponse.Size(m)
+}
+func (m *LookupPicFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicFileResponse proto.InternalMessageInfo
+
+func (m *LookupPicFileResponse) GetPicFile() *PicFile {
+	if m != nil {
+		return m.PicFile
+	}
+	return nil
+}
+
+type LookupPicVoteRequest struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// Optional.  Uses auth token if not specified.
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicVoteRequest) Reset()         { *m = LookupPicVoteRequest{} }
+func (m *LookupPicVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPicVoteRequest) ProtoMessage()    {}
+func (*LookupPicVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *LookupPicVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicVoteRequest.Unmarshal(m, b)
+}
+func (m *LookupPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPicVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicVoteRequest.Merge(m, src)
+}
+func (m *LookupPicVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPicVoteRequest.Size(m)
+}
+func (m *LookupPicVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicVoteRequest proto.InternalMessageInfo
+
+func (m *LookupPicVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *LookupPicVoteRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LookupPicVoteResponse struct {
+	Vote                 *PicVote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPicVoteResponse) Reset()         { *m = LookupPicVoteResponse{} }
+func (m *LookupPicVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPicVoteResponse) ProtoMessage()    {}
+func (*LookupPicVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *LookupPicVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPicVoteResponse.Unmarshal(m, b)
+}
+func (m *LookupPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPicVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPicVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPicVoteResponse.Merge(m, src)
+}
+func (m *LookupPicVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPicVoteResponse.Size(m)
+}
+func (m *LookupPicVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPicVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPicVoteResponse proto.InternalMessageInfo
+
+func (m *LookupPicVoteResponse) GetVote() *PicVote {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+type LookupPublicUserInfoRequest struct {
+	// if absent, assumed to come from auth token
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupPublicUserInfoRequest) Reset()         { *m = LookupPublicUserInfoRequest{} }
+func (m *LookupPublicUserInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupPublicUserInfoRequest) ProtoMessage()    {}
+func (*LookupPublicUserInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *LookupPublicUserInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPublicUserInfoRequest.Unmarshal(m, b)
+}
+func (m *LookupPublicUserInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPublicUserInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupPublicUserInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPublicUserInfoRequest.Merge(m, src)
+}
+func (m *LookupPublicUserInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupPublicUserInfoRequest.Size(m)
+}
+func (m *LookupPublicUserInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPublicUserInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPublicUserInfoRequest proto.InternalMessageInfo
+
+func (m *LookupPublicUserInfoRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LookupPublicUserInfoResponse struct {
+	UserInfo             *PublicUserInfo `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LookupPublicUserInfoResponse) Reset()         { *m = LookupPublicUserInfoResponse{} }
+func (m *LookupPublicUserInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupPublicUserInfoResponse) ProtoMessage()    {}
+func (*LookupPublicUserInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *LookupPublicUserInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupPublicUserInfoResponse.Unmarshal(m, b)
+}
+func (m *LookupPublicUserInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupPublicUserInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupPublicUserInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupPublicUserInfoResponse.Merge(m, src)
+}
+func (m *LookupPublicUserInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupPublicUserInfoResponse.Size(m)
+}
+func (m *LookupPublicUserInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupPublicUserInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupPublicUserInfoResponse proto.InternalMessageInfo
+
+func (m *LookupPublicUserInfoResponse) GetUserInfo() *PublicUserInfo {
+	if m != nil {
+		return m.UserInfo
+	}
+	return nil
+}
+
+type LookupBackgroundJobRequest struct {
+	BackgroundJobId      string   `protobuf:"bytes,1,opt,name=background_job_id,json=backgroundJobId,proto3" json:"background_job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupBackgroundJobRequest) Reset()         { *m = LookupBackgroundJobRequest{} }
+func (m *LookupBackgroundJobRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupBackgroundJobRequest) ProtoMessage()    {}
+func (*LookupBackgroundJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *LookupBackgroundJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupBackgroundJobRequest.Unmarshal(m, b)
+}
+func (m *LookupBackgroundJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupBackgroundJobRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupBackgroundJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupBackgroundJobRequest.Merge(m, src)
+}
+func (m *LookupBackgroundJobRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupBackgroundJobRequest.Size(m)
+}
+func (m *LookupBackgroundJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupBackgroundJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupBackgroundJobRequest proto.InternalMessageInfo
+
+func (m *LookupBackgroundJobRequest) GetBackgroundJobId() string {
+	if m != nil {
+		return m.BackgroundJobId
+	}
+	return ""
+}
+
+type LookupBackgroundJobResponse struct {
+	BackgroundJob        *BackgroundJob `protobuf:"bytes,1,opt,name=background_job,json=backgroundJob,proto3" json:"background_job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LookupBackgroundJobResponse) Reset()         { *m = LookupBackgroundJobResponse{} }
+func (m *LookupBackgroundJobResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupBackgroundJobResponse) ProtoMessage()    {}
+func (*LookupBackgroundJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *LookupBackgroundJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupBackgroundJobResponse.Unmarshal(m, b)
+}
+func (m *LookupBackgroundJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupBackgroundJobResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupBackgroundJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupBackgroundJobResponse.Merge(m, src)
+}
+func (m *LookupBackgroundJobResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupBackgroundJobResponse.Size(m)
+}
+func (m *LookupBackgroundJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupBackgroundJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupBackgroundJobResponse proto.InternalMessageInfo
+
+func (m *LookupBackgroundJobResponse) GetBackgroundJob() *BackgroundJob {
+	if m != nil {
+		return m.BackgroundJob
+	}
+	return nil
+}
+
+type LookupUserRequest struct {
+	// if absent, assumed to come from auth token
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupUserRequest) Reset()         { *m = LookupUserRequest{} }
+func (m *LookupUserRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupUserRequest) ProtoMessage()    {}
+func (*LookupUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *LookupUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupUserRequest.Unmarshal(m, b)
+}
+func (m *LookupUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupUserRequest.Marshal(b, m, deterministic)
+}
+func (m *LookupUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupUserRequest.Merge(m, src)
+}
+func (m *LookupUserRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupUserRequest.Size(m)
+}
+func (m *LookupUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupUserRequest proto.InternalMessageInfo
+
+func (m *LookupUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type LookupUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LookupUserResponse) Reset()         { *m = LookupUserResponse{} }
+func (m *LookupUserResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupUserResponse) ProtoMessage()    {}
+func (*LookupUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *LookupUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupUserResponse.Unmarshal(m, b)
+}
+func (m *LookupUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupUserResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupUserResponse.Merge(m, src)
+}
+func (m *LookupUserResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupUserResponse.Size(m)
+}
+func (m *LookupUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupUserResponse proto.InternalMessageInfo
+
+func (m *LookupUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type MergePicsRequest struct {
+	// the pic to keep.
+	WinnerPicId string `protobuf:"bytes,1,opt,name=winner_pic_id,json=winnerPicId,proto3" json:"winner_pic_id,omitempty"`
+	// the pic to merge into the winner.  It is soft deleted as a duplicate.
+	LoserPicId           string   `protobuf:"bytes,2,opt,name=loser_pic_id,json=loserPicId,proto3" json:"loser_pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergePicsRequest) Reset()         { *m = MergePicsRequest{} }
+func (m *MergePicsRequest) String() string { return proto.CompactTextString(m) }
+func (*MergePicsRequest) ProtoMessage()    {}
+func (*MergePicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *MergePicsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergePicsRequest.Unmarshal(m, b)
+}
+func (m *MergePicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergePicsRequest.Marshal(b, m, deterministic)
+}
+func (m *MergePicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergePicsRequest.Merge(m, src)
+}
+func (m *MergePicsRequest) XXX_Size() int {
+	return xxx_messageInfo_MergePicsRequest.Size(m)
+}
+func (m *MergePicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergePicsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergePicsRequest proto.InternalMessageInfo
+
+func (m *MergePicsRequest) GetWinnerPicId() string {
+	if m != nil {
+		return m.WinnerPicId
+	}
+	return ""
+}
+
+func (m *MergePicsRequest) GetLoserPicId() string {
+	if m != nil {
+		return m.LoserPicId
+	}
+	return ""
+}
+
+type MergePicsResponse struct {
+	// the winner, after the merge.
+	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergePicsResponse) Reset()         { *m = MergePicsResponse{} }
+func (m *MergePicsResponse) String() string { return proto.CompactTextString(m) }
+func (*MergePicsResponse) ProtoMessage()    {}
+func (*MergePicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *MergePicsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergePicsResponse.Unmarshal(m, b)
+}
+func (m *MergePicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergePicsResponse.Marshal(b, m, deterministic)
+}
+func (m *MergePicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergePicsResponse.Merge(m, src)
+}
+func (m *MergePicsResponse) XXX_Size() int {
+	return xxx_messageInfo_MergePicsResponse.Size(m)
+}
+func (m *MergePicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergePicsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergePicsResponse proto.InternalMessageInfo
+
+func (m *MergePicsResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+type PurgePicRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgePicRequest) Reset()         { *m = PurgePicRequest{} }
+func (m *PurgePicRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgePicRequest) ProtoMessage()    {}
+func (*PurgePicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *PurgePicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgePicRequest.Unmarshal(m, b)
+}
+func (m *PurgePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgePicRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgePicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgePicRequest.Merge(m, src)
+}
+func (m *PurgePicRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgePicRequest.Size(m)
+}
+func (m *PurgePicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgePicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgePicRequest proto.InternalMessageInfo
+
+func (m *PurgePicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+type PurgePicResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgePicResponse) Reset()         { *m = PurgePicResponse{} }
+func (m *PurgePicResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgePicResponse) ProtoMessage()    {}
+func (*PurgePicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *PurgePicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgePicResponse.Unmarshal(m, b)
+}
+func (m *PurgePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgePicResponse.Marshal(b, m, deterministic)
+}
+func (m *PurgePicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgePicResponse.Merge(m, src)
+}
+func (m *PurgePicResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgePicResponse.Size(m)
+}
+func (m *PurgePicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgePicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgePicResponse proto.InternalMessageInfo
+
+type ReadPicFileRequest struct {
+	PicFileId string         `protobuf:"bytes,1,opt,name=pic_file_id,json=picFileId,proto3" json:"pic_file_id,omitempty"`
+	Format    PicFile_Format `protobuf:"varint,2,opt,name=format,proto3,enum=pixur.api.PicFile_Format" json:"format,omitempty"`
+	// The offset into the file to start reading at.  Required
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The maximum number of bytes to read.   Maybe be 0.
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadPicFileRequest) Reset()         { *m = ReadPicFileRequest{} }
+func (m *ReadPicFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadPicFileRequest) ProtoMessage()    {}
+func (*ReadPicFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *ReadPicFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadPicFileRequest.Unmarshal(m, b)
+}
+func (m *ReadPicFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadPicFileRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadPicFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadPicFileRequest.Merge(m, src)
+}
+func (m *ReadPicFileRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadPicFileRequest.Size(m)
+}
+func (m *ReadPicFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadPicFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadPicFileRequest proto.InternalMessageInfo
+
+func (m *ReadPicFileRequest) GetPicFileId() string {
+	if m != nil {
+		return m.PicFileId
+	}
+	return ""
+}
+
+func (m *ReadPicFileRequest) GetFormat() PicFile_Format {
+	if m != nil {
+		return m.Format
+	}
+	return PicFile_UNKNOWN
+}
+
+func (m *ReadPicFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReadPicFileRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ReadPicFileResponse struct {
+	// may be empty
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// indicates that the end of the pic has been reached.
+	Eof                  bool     `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadPicFileResponse) Reset()         { *m = ReadPicFileResponse{} }
+func (m *ReadPicFileResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadPicFileResponse) ProtoMessage()    {}
+func (*ReadPicFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *ReadPicFileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadPicFileResponse.Unmarshal(m, b)
+}
+func (m *ReadPicFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadPicFileResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadPicFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadPicFileResponse.Merge(m, src)
+}
+func (m *ReadPicFileResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadPicFileResponse.Size(m)
+}
+func (m *ReadPicFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadPicFileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadPicFileResponse proto.InternalMessageInfo
+
+func (m *ReadPicFileResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadPicFileResponse) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
+}
+
+type RemovePicTagsRequest struct {
+	PicId                string   `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Tag                  []string `protobuf:"bytes,2,rep,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePicTagsRequest) Reset()         { *m = RemovePicTagsRequest{} }
+func (m *RemovePicTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePicTagsRequest) ProtoMessage()    {}
+func (*RemovePicTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *RemovePicTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePicTagsRequest.Unmarshal(m, b)
+}
+func (m *RemovePicTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePicTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePicTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePicTagsRequest.Merge(m, src)
+}
+func (m *RemovePicTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePicTagsRequest.Size(m)
+}
+func (m *RemovePicTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePicTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePicTagsRequest proto.InternalMessageInfo
+
+func (m *RemovePicTagsRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *RemovePicTagsRequest) GetTag() []string {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+type RemovePicTagsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePicTagsResponse) Reset()         { *m = RemovePicTagsResponse{} }
+func (m *RemovePicTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*RemovePicTagsResponse) ProtoMessage()    {}
+func (*RemovePicTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *RemovePicTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePicTagsResponse.Unmarshal(m, b)
+}
+func (m *RemovePicTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePicTagsResponse.Marshal(b, m, deterministic)
+}
+func (m *RemovePicTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePicTagsResponse.Merge(m, src)
+}
+func (m *RemovePicTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_RemovePicTagsResponse.Size(m)
+}
+func (m *RemovePicTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePicTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePicTagsResponse proto.InternalMessageInfo
+
+type RestorePicRequest struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// why the pic is being restored.
+	Details              string   `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestorePicRequest) Reset()         { *m = RestorePicRequest{} }
+func (m *RestorePicRequest) String() string { return proto.CompactTextString(m) }
+func (*RestorePicRequest) ProtoMessage()    {}
+func (*RestorePicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *RestorePicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestorePicRequest.Unmarshal(m, b)
+}
+func (m *RestorePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestorePicRequest.Marshal(b, m, deterministic)
+}
+func (m *RestorePicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestorePicRequest.Merge(m, src)
+}
+func (m *RestorePicRequest) XXX_Size() int {
+	return xxx_messageInfo_RestorePicRequest.Size(m)
+}
+func (m *RestorePicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestorePicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestorePicRequest proto.InternalMessageInfo
+
+func (m *RestorePicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *RestorePicRequest) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+type RestorePicResponse struct {
+	// the restored pic.
+	Pic                  *Pic     `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestorePicResponse) Reset()         { *m = RestorePicResponse{} }
+func (m *RestorePicResponse) String() string { return proto.CompactTextString(m) }
+func (*RestorePicResponse) ProtoMessage()    {}
+func (*RestorePicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *RestorePicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestorePicResponse.Unmarshal(m, b)
+}
+func (m *RestorePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestorePicResponse.Marshal(b, m, deterministic)
+}
+func (m *RestorePicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestorePicResponse.Merge(m, src)
+}
+func (m *RestorePicResponse) XXX_Size() int {
+	return xxx_messageInfo_RestorePicResponse.Size(m)
+}
+func (m *RestorePicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestorePicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestorePicResponse proto.InternalMessageInfo
+
+func (m *RestorePicResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	// user_id is the user whose key to revoke, in varint form.  If empty, the current user is used.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// api_key_id is the key to revoke, in varint form.
+	ApiKeyId             string   `protobuf:"bytes,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeApiKeyRequest) Reset()         { *m = RevokeApiKeyRequest{} }
+func (m *RevokeApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiKeyRequest) ProtoMessage()    {}
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *RevokeApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiKeyRequest.Merge(m, src)
+}
+func (m *RevokeApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiKeyRequest.Size(m)
+}
+func (m *RevokeApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeApiKeyRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *RevokeApiKeyRequest) GetApiKeyId() string {
+	if m != nil {
+		return m.ApiKeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeApiKeyResponse) Reset()         { *m = RevokeApiKeyResponse{} }
+func (m *RevokeApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiKeyResponse) ProtoMessage()    {}
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *RevokeApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiKeyResponse.Merge(m, src)
+}
+func (m *RevokeApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiKeyResponse.Size(m)
+}
+func (m *RevokeApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiKeyResponse proto.InternalMessageInfo
+
+type RevokeTokenRequest struct {
+	// user_id is the user whose token to revoke, in varint form.  If empty, the current user is
+	// used.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// token_id is the token to revoke, in varint form.  Ignored if all is set.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// all revokes every token of the user, logging them out everywhere.
+	All                  bool     `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenRequest) Reset()         { *m = RevokeTokenRequest{} }
+func (m *RevokeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenRequest) ProtoMessage()    {}
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *RevokeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokenRequest.Unmarshal(m, b)
+}
+func (m *RevokeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenRequest.Merge(m, src)
+}
+func (m *RevokeTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokenRequest.Size(m)
+}
+func (m *RevokeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenRequest proto.InternalMessageInfo
+
+func (m *RevokeTokenRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *RevokeTokenRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *RevokeTokenRequest) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+type RevokeTokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenResponse) Reset()         { *m = RevokeTokenResponse{} }
+func (m *RevokeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenResponse) ProtoMessage()    {}
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *RevokeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokenResponse.Unmarshal(m, b)
+}
+func (m *RevokeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenResponse.Merge(m, src)
+}
+func (m *RevokeTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokenResponse.Size(m)
+}
+func (m *RevokeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenResponse proto.InternalMessageInfo
+
+type SoftDeletePicRequest struct {
+	PicId                string               `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Details              string               `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	Reason               DeletionReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=pixur.api.DeletionReason" json:"reason,omitempty"`
+	DeletionTime         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SoftDeletePicRequest) Reset()         { *m = SoftDeletePicRequest{} }
+func (m *SoftDeletePicRequest) String() string { return proto.CompactTextString(m) }
+func (*SoftDeletePicRequest) ProtoMessage()    {}
+func (*SoftDeletePicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *SoftDeletePicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SoftDeletePicRequest.Unmarshal(m, b)
+}
+func (m *SoftDeletePicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SoftDeletePicRequest.Marshal(b, m, deterministic)
+}
+func (m *SoftDeletePicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftDeletePicRequest.Merge(m, src)
+}
+func (m *SoftDeletePicRequest) XXX_Size() int {
+	return xxx_messageInfo_SoftDeletePicRequest.Size(m)
+}
+func (m *SoftDeletePicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftDeletePicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftDeletePicRequest proto.InternalMessageInfo
+
+func (m *SoftDeletePicRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *SoftDeletePicRequest) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *SoftDeletePicRequest) GetReason() DeletionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return DeletionReason_UNKNOWN
+}
+
+func (m *SoftDeletePicRequest) GetDeletionTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletionTime
+	}
+	return nil
+}
+
+type SoftDeletePicResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SoftDeletePicResponse) Reset()         { *m = SoftDeletePicResponse{} }
+func (m *SoftDeletePicResponse) String() string { return proto.CompactTextString(m) }
+func (*SoftDeletePicResponse) ProtoMessage()    {}
+func (*SoftDeletePicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *SoftDeletePicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SoftDeletePicResponse.Unmarshal(m, b)
+}
+func (m *SoftDeletePicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SoftDeletePicResponse.Marshal(b, m, deterministic)
+}
+func (m *SoftDeletePicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftDeletePicResponse.Merge(m, src)
+}
+func (m *SoftDeletePicResponse) XXX_Size() int {
+	return xxx_messageInfo_SoftDeletePicResponse.Size(m)
+}
+func (m *SoftDeletePicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftDeletePicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftDeletePicResponse proto.InternalMessageInfo
+
+type UpdateRoleRequest struct {
+	RoleId               string                              `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Version              int64                               `protobuf:"fixed64,2,opt,name=version,proto3" json:"version,omitempty"`
+	Name                 *UpdateRoleRequest_ChangeName       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Capability           *UpdateRoleRequest_ChangeCapability `protobuf:"bytes,4,opt,name=capability,proto3" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *UpdateRoleRequest) Reset()         { *m = UpdateRoleRequest{} }
+func (m *UpdateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleRequest) ProtoMessage()    {}
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *UpdateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRoleRequest.Unmarshal(m, b)
+}
+func (m *UpdateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleRequest.Merge(m, src)
+}
+func (m *UpdateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRoleRequest.Size(m)
+}
+func (m *UpdateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleRequest proto.InternalMessageInfo
+
+func (m *UpdateRoleRequest) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *UpdateRoleRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpdateRoleRequest) GetName() *UpdateRoleRequest_ChangeName {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *UpdateRoleRequest) GetCapability() *UpdateRoleRequest_ChangeCapability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+type UpdateRoleRequest_ChangeName struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRoleRequest_ChangeName) Reset()         { *m = UpdateRoleRequest_ChangeName{} }
+func (m *UpdateRoleRequest_ChangeName) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleRequest_ChangeName) ProtoMessage()    {}
+func (*UpdateRoleRequest_ChangeName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86, 0}
+}
+
+func (m *UpdateRoleRequest_ChangeName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRoleRequest_ChangeName.Unmarshal(m, b)
+}
+func (m *UpdateRoleRequest_ChangeName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRoleRequest_ChangeName.Marshal(b, m, deterministic)
+}
+func (m *UpdateRoleRequest_ChangeName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleRequest_ChangeName.Merge(m, src)
+}
+func (m *UpdateRoleRequest_ChangeName) XXX_Size() int {
+	return xxx_messageInfo_UpdateRoleRequest_ChangeName.Size(m)
+}
+func (m *UpdateRoleRequest_ChangeName) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleRequest_ChangeName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleRequest_ChangeName proto.InternalMessageInfo
+
+func (m *UpdateRoleRequest_ChangeName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type UpdateRoleRequest_ChangeCapability struct {
+	SetCapability        []Capability_Cap `protobuf:"varint,1,rep,packed,name=set_capability,json=setCapability,proto3,enum=pixur.api.Capability_Cap" json:"set_capability,omitempty"`
+	ClearCapability      []Capability_Cap `protobuf:"varint,2,rep,packed,name=clear_capability,json=clearCapability,proto3,enum=pixur.api.Capability_Cap" json:"clear_capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateRoleRequest_ChangeCapability) Reset()         { *m = UpdateRoleRequest_ChangeCapability{} }
+func (m *UpdateRoleRequest_ChangeCapability) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleRequest_ChangeCapability) ProtoMessage()    {}
+func (*UpdateRoleRequest_ChangeCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86, 1}
+}
+
+func (m *UpdateRoleRequest_ChangeCapability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRoleRequest_ChangeCapability.Unmarshal(m, b)
+}
+func (m *UpdateRoleRequest_ChangeCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRoleRequest_ChangeCapability.Marshal(b, m, deterministic)
+}
+func (m *UpdateRoleRequest_ChangeCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleRequest_ChangeCapability.Merge(m, src)
+}
+func (m *UpdateRoleRequest_ChangeCapability) XXX_Size() int {
+	return xxx_messageInfo_UpdateRoleRequest_ChangeCapability.Size(m)
+}
+func (m *UpdateRoleRequest_ChangeCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleRequest_ChangeCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleRequest_ChangeCapability proto.InternalMessageInfo
+
+func (m *UpdateRoleRequest_ChangeCapability) GetSetCapability() []Capability_Cap {
+	if m != nil {
+		return m.SetCapability
+	}
+	return nil
+}
+
+func (m *UpdateRoleRequest_ChangeCapability) GetClearCapability() []Capability_Cap {
+	if m != nil {
+		return m.ClearCapability
+	}
+	return nil
+}
+
+type UpdateRoleResponse struct {
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRoleResponse) Reset()         { *m = UpdateRoleResponse{} }
+func (m *UpdateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleResponse) ProtoMessage()    {}
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *UpdateRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRoleResponse.Unmarshal(m, b)
+}
+func (m *UpdateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleResponse.Merge(m, src)
+}
+func (m *UpdateRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateRoleResponse.Size(m)
+}
+func (m *UpdateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleResponse proto.InternalMessageInfo
+
+func (m *UpdateRoleResponse) GetRole() *Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	UserId               string                              `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Version              int64                               `protobuf:"fixed64,2,opt,name=version,proto3" json:"version,omitempty"`
+	Ident                *UpdateUserRequest_ChangeIdent      `protobuf:"bytes,3,opt,name=ident,proto3" json:"ident,omitempty"`
+	Secret               *UpdateUserRequest_ChangeSecret     `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Capability           *UpdateUserRequest_ChangeCapability `protobuf:"bytes,5,opt,name=capability,proto3" json:"capability,omitempty"`
+	Role                 *UpdateUserRequest_ChangeRole       `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
+func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest) ProtoMessage()    {}
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest.Merge(m, src)
+}
+func (m *UpdateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest.Size(m)
+}
+func (m *UpdateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+
+func (m *UpdateUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UpdateUserRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpdateUserRequest) GetIdent() *UpdateUserRequest_ChangeIdent {
+	if m != nil {
+		return m.Ident
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetSecret() *UpdateUserRequest_ChangeSecret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetCapability() *UpdateUserRequest_ChangeCapability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest) GetRole() *UpdateUserRequest_ChangeRole {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type UpdateUserRequest_ChangeIdent struct {
+	Ident                string   `protobuf:"bytes,1,opt,name=ident,proto3" json:"ident,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeIdent) Reset()         { *m = UpdateUserRequest_ChangeIdent{} }
+func (m *UpdateUserRequest_ChangeIdent) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeIdent) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeIdent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88, 0}
+}
+
+func (m *UpdateUserRequest_ChangeIdent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeIdent.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeIdent.Size(m)
+}
+func (m *UpdateUserRequest_ChangeIdent) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeIdent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeIdent proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeIdent) GetIdent() string {
+	if m != nil {
+		return m.Ident
+	}
+	return ""
+}
+
+type UpdateUserRequest_ChangeSecret struct {
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeSecret) Reset()         { *m = UpdateUserRequest_ChangeSecret{} }
+func (m *UpdateUserRequest_ChangeSecret) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeSecret) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88, 1}
+}
+
+func (m *UpdateUserRequest_ChangeSecret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeSecret.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeSecret.Size(m)
+}
+func (m *UpdateUserRequest_ChangeSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeSecret proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeSecret) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type UpdateUserRequest_ChangeCapability struct {
+	SetCapability        []Capability_Cap `protobuf:"varint,1,rep,packed,name=set_capability,json=setCapability,proto3,enum=pixur.api.Capability_Cap" json:"set_capability,omitempty"`
+	ClearCapability      []Capability_Cap `protobuf:"varint,2,rep,packed,name=clear_capability,json=clearCapability,proto3,enum=pixur.api.Capability_Cap" json:"clear_capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeCapability) Reset()         { *m = UpdateUserRequest_ChangeCapability{} }
+func (m *UpdateUserRequest_ChangeCapability) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeCapability) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88, 2}
+}
+
+func (m *UpdateUserRequest_ChangeCapability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeCapability.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeCapability.Size(m)
+}
+func (m *UpdateUserRequest_ChangeCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeCapability proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeCapability) GetSetCapability() []Capability_Cap {
+	if m != nil {
+		return m.SetCapability
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest_ChangeCapability) GetClearCapability() []Capability_Cap {
+	if m != nil {
+		return m.ClearCapability
+	}
+	return nil
+}
+
+type UpdateUserRequest_ChangeRole struct {
+	SetRoleId            []string `protobuf:"bytes,1,rep,name=set_role_id,json=setRoleId,proto3" json:"set_role_id,omitempty"`
+	ClearRoleId          []string `protobuf:"bytes,2,rep,name=clear_role_id,json=clearRoleId,proto3" json:"clear_role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest_ChangeRole) Reset()         { *m = UpdateUserRequest_ChangeRole{} }
+func (m *UpdateUserRequest_ChangeRole) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest_ChangeRole) ProtoMessage()    {}
+func (*UpdateUserRequest_ChangeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88, 3}
+}
+
+func (m *UpdateUserRequest_ChangeRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest_ChangeRole.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest_ChangeRole.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest_ChangeRole.Merge(m, src)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest_ChangeRole.Size(m)
+}
+func (m *UpdateUserRequest_ChangeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest_ChangeRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest_ChangeRole proto.InternalMessageInfo
+
+func (m *UpdateUserRequest_ChangeRole) GetSetRoleId() []string {
+	if m != nil {
+		return m.SetRoleId
+	}
+	return nil
+}
+
+func (m *UpdateUserRequest_ChangeRole) GetClearRoleId() []string {
+	if m != nil {
+		return m.ClearRoleId
+	}
+	return nil
+}
+
+type UpdateUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
+func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserResponse) ProtoMessage()    {}
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserResponse.Unmarshal(m, b)
+}
+func (m *UpdateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserResponse.Merge(m, src)
+}
+func (m *UpdateUserResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserResponse.Size(m)
+}
+func (m *UpdateUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserResponse proto.InternalMessageInfo
+
+func (m *UpdateUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type UpsertPicCommentVoteRequest struct {
+	PicId     string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// Optional.   Not necessary when creating for the first time.
+	Version              int64               `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
+	Vote                 PicCommentVote_Vote `protobuf:"varint,4,opt,name=vote,proto3,enum=pixur.api.PicCommentVote_Vote" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpsertPicCommentVoteRequest) Reset()         { *m = UpsertPicCommentVoteRequest{} }
+func (m *UpsertPicCommentVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicCommentVoteRequest) ProtoMessage()    {}
+func (*UpsertPicCommentVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *UpsertPicCommentVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicCommentVoteRequest.Unmarshal(m, b)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicCommentVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicCommentVoteRequest.Merge(m, src)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicCommentVoteRequest.Size(m)
+}
+func (m *UpsertPicCommentVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicCommentVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicCommentVoteRequest proto.InternalMessageInfo
+
+func (m *UpsertPicCommentVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *UpsertPicCommentVoteRequest) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
+}
+
+func (m *UpsertPicCommentVoteRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpsertPicCommentVoteRequest) GetVote() PicCommentVote_Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return PicCommentVote_UNKNOWN
+}
+
+type UpsertPicCommentVoteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertPicCommentVoteResponse) Reset()         { *m = UpsertPicCommentVoteResponse{} }
+func (m *UpsertPicCommentVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicCommentVoteResponse) ProtoMessage()    {}
+func (*UpsertPicCommentVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+
+func (m *UpsertPicCommentVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicCommentVoteResponse.Unmarshal(m, b)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicCommentVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicCommentVoteResponse.Merge(m, src)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicCommentVoteResponse.Size(m)
+}
+func (m *UpsertPicCommentVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicCommentVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicCommentVoteResponse proto.InternalMessageInfo
+
+// UpsertPicRequest is a request to upload and possibly merge with another
+// picture.
+type UpsertPicRequest struct {
+	// url is an optional field for the Pixur backend to download the pic from.
+	// Either url and/or data must be set.   If the data field is set along
+	// with the URL, the url is treated as regular metadata and not fetched.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// referrer is the referrer url used to fetch the resource.  If no data is
+	// set, the url will be used to retrieve the data, along with the referrer
+	// being set in the request.  If data is set, referrer is treated as
+	// additional metadata.  May not be used if url is not set.
+	Referrer string `protobuf:"bytes,5,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// name is an optional field indicating a reasonable file name for the pic.
+	// If name and url are set, the name field will override the name
+	// automatically derived from the URL.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// data is the file contents of the upload.  It must contain a valid pic
+	// if present.  It may be left absent if there is a valid url instead.  This
+	// field takes precedence over the url field if both are specified.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// md5_hash is the hash of the pic contents.  It is used to verify the pic
+	// is valid when downloading from url, or when set in data.  If the Pixur
+	// backend already has a pic with a matching md5_hash, it may not use
+	// either data or url (at least one must still be specified, though).
+	Md5Hash []byte `protobuf:"bytes,4,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
+	// Optional extension data.  You must have the correct permissions to set this.
+	Ext map[string]*any.Any `protobuf:"bytes,6,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// async makes the backend download url in the background, rather than
+	// during the request.  Only valid if url is set and data is not.  Ext may
+	// not be used with async.
+	Async bool `protobuf:"varint,7,opt,name=async,proto3" json:"async,omitempty"`
+	// visibility restricts who can see the pic.  If absent, the pic is public.
+	// Only used when the pic is new.
+	Visibility           *PicVisibility `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpsertPicRequest) Reset()         { *m = UpsertPicRequest{} }
+func (m *UpsertPicRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicRequest) ProtoMessage()    {}
+func (*UpsertPicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+
+func (m *UpsertPicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicRequest.Unmarshal(m, b)
+}
+func (m *UpsertPicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicRequest.Merge(m, src)
+}
+func (m *UpsertPicRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicRequest.Size(m)
+}
+func (m *UpsertPicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicRequest proto.InternalMessageInfo
+
+func (m *UpsertPicRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *UpsertPicRequest) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *UpsertPicRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpsertPicRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UpsertPicRequest) GetMd5Hash() []byte {
+	if m != nil {
+		return m.Md5Hash
+	}
+	return nil
+}
+
+func (m *UpsertPicRequest) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+func (m *UpsertPicRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *UpsertPicRequest) GetVisibility() *PicVisibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return nil
+}
+
+type UpsertPicResponse struct {
+	// pic is the newly created or updated picture.  Absent if the request was
+	// async.
+	Pic *Pic `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	// the existing pics that are perceptually similar to the uploaded file, ordered by ascending
+	// distance.  If the upload was merged, pic is the first of these.
+	SuspectedDuplicatePicId []string `protobuf:"bytes,2,rep,name=suspected_duplicate_pic_id,json=suspectedDuplicatePicId,proto3" json:"suspected_duplicate_pic_id,omitempty"`
+	// import_id is the id of the background job importing the pic, if the
+	// request was async.  Its progress can be followed with WatchBackgroundJob.
+	ImportId             string   `protobuf:"bytes,3,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertPicResponse) Reset()         { *m = UpsertPicResponse{} }
+func (m *UpsertPicResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicResponse) ProtoMessage()    {}
+func (*UpsertPicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+
+func (m *UpsertPicResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicResponse.Unmarshal(m, b)
+}
+func (m *UpsertPicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicResponse.Merge(m, src)
+}
+func (m *UpsertPicResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicResponse.Size(m)
+}
+func (m *UpsertPicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicResponse proto.InternalMessageInfo
+
+func (m *UpsertPicResponse) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+func (m *UpsertPicResponse) GetSuspectedDuplicatePicId() []string {
+	if m != nil {
+		return m.SuspectedDuplicatePicId
+	}
+	return nil
+}
+
+func (m *UpsertPicResponse) GetImportId() string {
+	if m != nil {
+		return m.ImportId
+	}
+	return ""
+}
+
+type UpsertPicVoteRequest struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// Optional.   Not necessary when creating for the first time.
+	Version              int64        `protobuf:"fixed64,3,opt,name=version,proto3" json:"version,omitempty"`
+	Vote                 PicVote_Vote `protobuf:"varint,2,opt,name=vote,proto3,enum=pixur.api.PicVote_Vote" json:"vote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *UpsertPicVoteRequest) Reset()         { *m = UpsertPicVoteRequest{} }
+func (m *UpsertPicVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicVoteRequest) ProtoMessage()    {}
+func (*UpsertPicVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+
+func (m *UpsertPicVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicVoteRequest.Unmarshal(m, b)
+}
+func (m *UpsertPicVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicVoteRequest.Merge(m, src)
+}
+func (m *UpsertPicVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicVoteRequest.Size(m)
+}
+func (m *UpsertPicVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicVoteRequest proto.InternalMessageInfo
+
+func (m *UpsertPicVoteRequest) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *UpsertPicVoteRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpsertPicVoteRequest) GetVote() PicVote_Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return PicVote_UNKNOWN
+}
+
+type UpsertPicVoteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertPicVoteResponse) Reset()         { *m = UpsertPicVoteResponse{} }
+func (m *UpsertPicVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertPicVoteResponse) ProtoMessage()    {}
+func (*UpsertPicVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+
+func (m *UpsertPicVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertPicVoteResponse.Unmarshal(m, b)
+}
+func (m *UpsertPicVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertPicVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertPicVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertPicVoteResponse.Merge(m, src)
+}
+func (m *UpsertPicVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertPicVoteResponse.Size(m)
+}
+func (m *UpsertPicVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertPicVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertPicVoteResponse proto.InternalMessageInfo
+
+type UpsertTagAliasRequest struct {
+	// alias is the tag name to redirect.  If it is already an alias, it is redirected to the new tag.
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// tag is the name of the canonical tag.  It must already exist.
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertTagAliasRequest) Reset()         { *m = UpsertTagAliasRequest{} }
+func (m *UpsertTagAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertTagAliasRequest) ProtoMessage()    {}
+func (*UpsertTagAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+
+func (m *UpsertTagAliasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertTagAliasRequest.Unmarshal(m, b)
+}
+func (m *UpsertTagAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertTagAliasRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertTagAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertTagAliasRequest.Merge(m, src)
+}
+func (m *UpsertTagAliasRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertTagAliasRequest.Size(m)
+}
+func (m *UpsertTagAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertTagAliasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertTagAliasRequest proto.InternalMessageInfo
+
+func (m *UpsertTagAliasRequest) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *UpsertTagAliasRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type UpsertTagAliasResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertTagAliasResponse) Reset()         { *m = UpsertTagAliasResponse{} }
+func (m *UpsertTagAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertTagAliasResponse) ProtoMessage()    {}
+func (*UpsertTagAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+
+func (m *UpsertTagAliasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertTagAliasResponse.Unmarshal(m, b)
+}
+func (m *UpsertTagAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertTagAliasResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertTagAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertTagAliasResponse.Merge(m, src)
+}
+func (m *UpsertTagAliasResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertTagAliasResponse.Size(m)
+}
+func (m *UpsertTagAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertTagAliasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertTagAliasResponse proto.InternalMessageInfo
+
+type UpsertTagImplicationRequest struct {
+	// tag is the name of the implying tag.  It must already exist.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// implied_tag is the name of the tag added along side tag.  It must already exist.
+	ImpliedTag           string   `protobuf:"bytes,2,opt,name=implied_tag,json=impliedTag,proto3" json:"implied_tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertTagImplicationRequest) Reset()         { *m = UpsertTagImplicationRequest{} }
+func (m *UpsertTagImplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertTagImplicationRequest) ProtoMessage()    {}
+func (*UpsertTagImplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+
+func (m *UpsertTagImplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertTagImplicationRequest.Unmarshal(m, b)
+}
+func (m *UpsertTagImplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertTagImplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertTagImplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertTagImplicationRequest.Merge(m, src)
+}
+func (m *UpsertTagImplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertTagImplicationRequest.Size(m)
+}
+func (m *UpsertTagImplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertTagImplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertTagImplicationRequest proto.InternalMessageInfo
+
+func (m *UpsertTagImplicationRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *UpsertTagImplicationRequest) GetImpliedTag() string {
+	if m != nil {
+		return m.ImpliedTag
+	}
+	return ""
+}
+
+type UpsertTagImplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpsertTagImplicationResponse) Reset()         { *m = UpsertTagImplicationResponse{} }
+func (m *UpsertTagImplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertTagImplicationResponse) ProtoMessage()    {}
+func (*UpsertTagImplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+
+func (m *UpsertTagImplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertTagImplicationResponse.Unmarshal(m, b)
+}
+func (m *UpsertTagImplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertTagImplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *UpsertTagImplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertTagImplicationResponse.Merge(m, src)
+}
+func (m *UpsertTagImplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_UpsertTagImplicationResponse.Size(m)
+}
+func (m *UpsertTagImplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertTagImplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertTagImplicationResponse proto.InternalMessageInfo
+
+type WatchBackendConfigurationRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchBackendConfigurationRequest) Reset()         { *m = WatchBackendConfigurationRequest{} }
+func (m *WatchBackendConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchBackendConfigurationRequest) ProtoMessage()    {}
+func (*WatchBackendConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *WatchBackendConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBackendConfigurationRequest.Unmarshal(m, b)
+}
+func (m *WatchBackendConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBackendConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchBackendConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBackendConfigurationRequest.Merge(m, src)
+}
+func (m *WatchBackendConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchBackendConfigurationRequest.Size(m)
+}
+func (m *WatchBackendConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBackendConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBackendConfigurationRequest proto.InternalMessageInfo
+
+type WatchBackendConfigurationResponse struct {
+	Version              int64                 `protobuf:"fixed64,1,opt,name=version,proto3" json:"version,omitempty"`
+	BackendConfiguration *BackendConfiguration `protobuf:"bytes,2,opt,name=backend_configuration,json=backendConfiguration,proto3" json:"backend_configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WatchBackendConfigurationResponse) Reset()         { *m = WatchBackendConfigurationResponse{} }
+func (m *WatchBackendConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchBackendConfigurationResponse) ProtoMessage()    {}
+func (*WatchBackendConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+
+func (m *WatchBackendConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBackendConfigurationResponse.Unmarshal(m, b)
+}
+func (m *WatchBackendConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBackendConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchBackendConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBackendConfigurationResponse.Merge(m, src)
+}
+func (m *WatchBackendConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchBackendConfigurationResponse.Size(m)
+}
+func (m *WatchBackendConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBackendConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBackendConfigurationResponse proto.InternalMessageInfo
+
+func (m *WatchBackendConfigurationResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WatchBackendConfigurationResponse) GetBackendConfiguration() *BackendConfiguration {
+	if m != nil {
+		return m.BackendConfiguration
+	}
+	return nil
+}
+
+type WatchBackgroundJobRequest struct {
+	BackgroundJobId      string   `protobuf:"bytes,1,opt,name=background_job_id,json=backgroundJobId,proto3" json:"background_job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchBackgroundJobRequest) Reset()         { *m = WatchBackgroundJobRequest{} }
+func (m *WatchBackgroundJobRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchBackgroundJobRequest) ProtoMessage()    {}
+func (*WatchBackgroundJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *WatchBackgroundJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBackgroundJobRequest.Unmarshal(m, b)
+}
+func (m *WatchBackgroundJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBackgroundJobRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchBackgroundJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBackgroundJobRequest.Merge(m, src)
+}
+func (m *WatchBackgroundJobRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchBackgroundJobRequest.Size(m)
+}
+func (m *WatchBackgroundJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBackgroundJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBackgroundJobRequest proto.InternalMessageInfo
+
+func (m *WatchBackgroundJobRequest) GetBackgroundJobId() string {
+	if m != nil {
+		return m.BackgroundJobId
+	}
+	return ""
+}
+
+// WatchBackgroundJobResponse is sent each time the job changes.  The stream
+// ends once the job is finished.
+type WatchBackgroundJobResponse struct {
+	BackgroundJob        *BackgroundJob `protobuf:"bytes,1,opt,name=background_job,json=backgroundJob,proto3" json:"background_job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WatchBackgroundJobResponse) Reset()         { *m = WatchBackgroundJobResponse{} }
+func (m *WatchBackgroundJobResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchBackgroundJobResponse) ProtoMessage()    {}
+func (*WatchBackgroundJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *WatchBackgroundJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchBackgroundJobResponse.Unmarshal(m, b)
+}
+func (m *WatchBackgroundJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchBackgroundJobResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchBackgroundJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchBackgroundJobResponse.Merge(m, src)
+}
+func (m *WatchBackgroundJobResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchBackgroundJobResponse.Size(m)
+}
+func (m *WatchBackgroundJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchBackgroundJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchBackgroundJobResponse proto.InternalMessageInfo
+
+func (m *WatchBackgroundJobResponse) GetBackgroundJob() *BackgroundJob {
+	if m != nil {
+		return m.BackgroundJob
+	}
+	return nil
+}
+
+type ServiceOpts struct {
+	// a vaguely defined, but monotonically increasing "version".
+	ApiVersion int64 `protobuf:"varint,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The header key used to when using an auth token.
+	AuthTokenHeaderKey string `protobuf:"bytes,2,opt,name=auth_token_header_key,json=authTokenHeaderKey,proto3" json:"auth_token_header_key,omitempty"`
+	// The header key used to when using a pix token.
+	PixTokenHeaderKey string `protobuf:"bytes,3,opt,name=pix_token_header_key,json=pixTokenHeaderKey,proto3" json:"pix_token_header_key,omitempty"`
+	// Used in responses (e.g. ReadPicFile) to indicate suggested http headers.
+	// See pixur.api.HttpHeader
+	HttpHeaderKey        string   `protobuf:"bytes,4,opt,name=http_header_key,json=httpHeaderKey,proto3" json:"http_header_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceOpts) Reset()         { *m = ServiceOpts{} }
+func (m *ServiceOpts) String() string { return proto.CompactTextString(m) }
+func (*ServiceOpts) ProtoMessage()    {}
+func (*ServiceOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *ServiceOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceOpts.Unmarshal(m, b)
+}
+func (m *ServiceOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceOpts.Marshal(b, m, deterministic)
+}
+func (m *ServiceOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceOpts.Merge(m, src)
+}
+func (m *ServiceOpts) XXX_Size() int {
+	return xxx_messageInfo_ServiceOpts.Size(m)
+}
+func (m *ServiceOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceOpts proto.InternalMessageInfo
+
+func (m *ServiceOpts) GetApiVersion() int64 {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return 0
+}
+
+func (m *ServiceOpts) GetAuthTokenHeaderKey() string {
+	if m != nil {
+		return m.AuthTokenHeaderKey
+	}
+	return ""
+}
+
+func (m *ServiceOpts) GetPixTokenHeaderKey() string {
+	if m != nil {
+		return m.PixTokenHeaderKey
+	}
+	return ""
+}
+
+func (m *ServiceOpts) GetHttpHeaderKey() string {
+	if m != nil {
+		return m.HttpHeaderKey
+	}
+	return ""
+}
+
+// HttpHeader is a message included as a header on some responses.  It is only a suggestion.
+// See pixur.api.ServiceOpts.http_header_key
+type HttpHeader struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HttpHeader) Reset()         { *m = HttpHeader{} }
+func (m *HttpHeader) String() string { return proto.CompactTextString(m) }
+func (*HttpHeader) ProtoMessage()    {}
+func (*HttpHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
+}
+
+func (m *HttpHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpHeader.Unmarshal(m, b)
+}
+func (m *HttpHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpHeader.Marshal(b, m, deterministic)
+}
+func (m *HttpHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpHeader.Merge(m, src)
+}
+func (m *HttpHeader) XXX_Size() int {
+	return xxx_messageInfo_HttpHeader.Size(m)
+}
+func (m *HttpHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpHeader proto.InternalMessageInfo
+
+func (m *HttpHeader) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HttpHeader) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+var E_PixurServiceOpts = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.ServiceOptions)(nil),
+	ExtensionType: (*ServiceOpts)(nil),
+	Field:         65537,
+	Name:          "pixur.api.pixur_service_opts",
+	Tag:           "bytes,65537,opt,name=pixur_service_opts",
+	Filename:      "api.proto",
+}
+
+func init() {
+	proto.RegisterType((*AddPicCommentRequest)(nil), "pixur.api.AddPicCommentRequest")
+	proto.RegisterType((*AddPicCommentResponse)(nil), "pixur.api.AddPicCommentResponse")
+	proto.RegisterType((*AddPicTagsRequest)(nil), "pixur.api.AddPicTagsRequest")
+	proto.RegisterType((*AddPicTagsResponse)(nil), "pixur.api.AddPicTagsResponse")
+	proto.RegisterType((*CancelBackgroundJobRequest)(nil), "pixur.api.CancelBackgroundJobRequest")
+	proto.RegisterType((*CancelBackgroundJobResponse)(nil), "pixur.api.CancelBackgroundJobResponse")
+	proto.RegisterType((*ConfirmTotpRequest)(nil), "pixur.api.ConfirmTotpRequest")
+	proto.RegisterType((*ConfirmTotpResponse)(nil), "pixur.api.ConfirmTotpResponse")
+	proto.RegisterType((*CreateApiKeyRequest)(nil), "pixur.api.CreateApiKeyRequest")
+	proto.RegisterType((*CreateApiKeyResponse)(nil), "pixur.api.CreateApiKeyResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "pixur.api.CreateRoleRequest")
+	proto.RegisterType((*CreateRoleResponse)(nil), "pixur.api.CreateRoleResponse")
+	proto.RegisterType((*CreateUserRequest)(nil), "pixur.api.CreateUserRequest")
+	proto.RegisterType((*CreateUserResponse)(nil), "pixur.api.CreateUserResponse")
+	proto.RegisterType((*DeleteTagAliasRequest)(nil), "pixur.api.DeleteTagAliasRequest")
+	proto.RegisterType((*DeleteTagAliasResponse)(nil), "pixur.api.DeleteTagAliasResponse")
+	proto.RegisterType((*DeleteTagImplicationRequest)(nil), "pixur.api.DeleteTagImplicationRequest")
+	proto.RegisterType((*DeleteTagImplicationResponse)(nil), "pixur.api.DeleteTagImplicationResponse")
+	proto.RegisterType((*DeleteTokenRequest)(nil), "pixur.api.DeleteTokenRequest")
+	proto.RegisterType((*DeleteTokenResponse)(nil), "pixur.api.DeleteTokenResponse")
+	proto.RegisterType((*DisableTotpRequest)(nil), "pixur.api.DisableTotpRequest")
+	proto.RegisterType((*DisableTotpResponse)(nil), "pixur.api.DisableTotpResponse")
+	proto.RegisterType((*EnrollTotpRequest)(nil), "pixur.api.EnrollTotpRequest")
+	proto.RegisterType((*EnrollTotpResponse)(nil), "pixur.api.EnrollTotpResponse")
+	proto.RegisterType((*ExchangeIdTokenRequest)(nil), "pixur.api.ExchangeIdTokenRequest")
+	proto.RegisterType((*ExchangeIdTokenResponse)(nil), "pixur.api.ExchangeIdTokenResponse")
+	proto.RegisterType((*FindIndexPicsRequest)(nil), "pixur.api.FindIndexPicsRequest")
 	proto.RegisterType((*FindIndexPicsResponse)(nil), "pixur.api.FindIndexPicsResponse")
 	proto.RegisterType((*FindPicCommentVotesRequest)(nil), "pixur.api.FindPicCommentVotesRequest")
 	proto.RegisterType((*FindPicCommentVotesResponse)(nil), "pixur.api.FindPicCommentVotesResponse")
+	proto.RegisterType((*FindPicsByTagsRequest)(nil), "pixur.api.FindPicsByTagsRequest")
+	proto.RegisterType((*FindPicsByTagsResponse)(nil), "pixur.api.FindPicsByTagsResponse")
+	proto.RegisterType((*FindRolesRequest)(nil), "pixur.api.FindRolesRequest")
+	proto.RegisterType((*FindRolesResponse)(nil), "pixur.api.FindRolesResponse")
 	proto.RegisterType((*FindSchedPicsRequest)(nil), "pixur.api.FindSchedPicsRequest")
 	proto.RegisterType((*FindSchedPicsResponse)(nil), "pixur.api.FindSchedPicsResponse")
 	proto.RegisterType((*FindSimilarPicsRequest)(nil), "pixur.api.FindSimilarPicsRequest")
 	proto.RegisterType((*FindSimilarPicsResponse)(nil), "pixur.api.FindSimilarPicsResponse")
 	proto.RegisterType((*FindUserEventsRequest)(nil), "pixur.api.FindUserEventsRequest")
 	proto.RegisterType((*FindUserEventsResponse)(nil), "pixur.api.FindUserEventsResponse")
+	proto.RegisterType((*FindBackgroundJobsRequest)(nil), "pixur.api.FindBackgroundJobsRequest")
+	proto.RegisterType((*FindBackgroundJobsResponse)(nil), "pixur.api.FindBackgroundJobsResponse")
+	proto.RegisterType((*FindTagsRequest)(nil), "pixur.api.FindTagsRequest")
+	proto.RegisterType((*FindTagsResponse)(nil), "pixur.api.FindTagsResponse")
 	proto.RegisterType((*GetRefreshTokenRequest)(nil), "pixur.api.GetRefreshTokenRequest")
 	proto.RegisterType((*GetRefreshTokenResponse)(nil), "pixur.api.GetRefreshTokenResponse")
 	proto.RegisterType((*IncrementViewCountRequest)(nil), "pixur.api.IncrementViewCountRequest")
 	proto.RegisterType((*IncrementViewCountResponse)(nil), "pixur.api.IncrementViewCountResponse")
+	proto.RegisterType((*ListApiKeysRequest)(nil), "pixur.api.ListApiKeysRequest")
+	proto.RegisterType((*ListApiKeysResponse)(nil), "pixur.api.ListApiKeysResponse")
+	proto.RegisterType((*ListTokensRequest)(nil), "pixur.api.ListTokensRequest")
+	proto.RegisterType((*ListTokensResponse)(nil), "pixur.api.ListTokensResponse")
+	proto.RegisterType((*LookupPicByHashRequest)(nil), "pixur.api.LookupPicByHashRequest")
+	proto.RegisterType((*LookupPicByHashResponse)(nil), "pixur.api.LookupPicByHashResponse")
 	proto.RegisterType((*LookupPicCommentVoteRequest)(nil), "pixur.api.LookupPicCommentVoteRequest")
 	proto.RegisterType((*LookupPicCommentVoteResponse)(nil), "pixur.api.LookupPicCommentVoteResponse")
 	proto.RegisterType((*LookupPicDetailsRequest)(nil), "pixur.api.LookupPicDetailsRequest")
@@ -2675,18 +5238,35 @@ func init() {
 	proto.RegisterType((*LookupPicVoteResponse)(nil), "pixur.api.LookupPicVoteResponse")
 	proto.RegisterType((*LookupPublicUserInfoRequest)(nil), "pixur.api.LookupPublicUserInfoRequest")
 	proto.RegisterType((*LookupPublicUserInfoResponse)(nil), "pixur.api.LookupPublicUserInfoResponse")
+	proto.RegisterType((*LookupBackgroundJobRequest)(nil), "pixur.api.LookupBackgroundJobRequest")
+	proto.RegisterType((*LookupBackgroundJobResponse)(nil), "pixur.api.LookupBackgroundJobResponse")
 	proto.RegisterType((*LookupUserRequest)(nil), "pixur.api.LookupUserRequest")
 	proto.RegisterType((*LookupUserResponse)(nil), "pixur.api.LookupUserResponse")
+	proto.RegisterType((*MergePicsRequest)(nil), "pixur.api.MergePicsRequest")
+	proto.RegisterType((*MergePicsResponse)(nil), "pixur.api.MergePicsResponse")
 	proto.RegisterType((*PurgePicRequest)(nil), "pixur.api.PurgePicRequest")
 	proto.RegisterType((*PurgePicResponse)(nil), "pixur.api.PurgePicResponse")
 	proto.RegisterType((*ReadPicFileRequest)(nil), "pixur.api.ReadPicFileRequest")
 	proto.RegisterType((*ReadPicFileResponse)(nil), "pixur.api.ReadPicFileResponse")
+	proto.RegisterType((*RemovePicTagsRequest)(nil), "pixur.api.RemovePicTagsRequest")
+	proto.RegisterType((*RemovePicTagsResponse)(nil), "pixur.api.RemovePicTagsResponse")
+	proto.RegisterType((*RestorePicRequest)(nil), "pixur.api.RestorePicRequest")
+	proto.RegisterType((*RestorePicResponse)(nil), "pixur.api.RestorePicResponse")
+	proto.RegisterType((*RevokeApiKeyRequest)(nil), "pixur.api.RevokeApiKeyRequest")
+	proto.RegisterType((*RevokeApiKeyResponse)(nil), "pixur.api.RevokeApiKeyResponse")
+	proto.RegisterType((*RevokeTokenRequest)(nil), "pixur.api.RevokeTokenRequest")
+	proto.RegisterType((*RevokeTokenResponse)(nil), "pixur.api.RevokeTokenResponse")
 	proto.RegisterType((*SoftDeletePicRequest)(nil), "pixur.api.SoftDeletePicRequest")
 	proto.RegisterType((*SoftDeletePicResponse)(nil), "pixur.api.SoftDeletePicResponse")
+	proto.RegisterType((*UpdateRoleRequest)(nil), "pixur.api.UpdateRoleRequest")
+	proto.RegisterType((*UpdateRoleRequest_ChangeName)(nil), "pixur.api.UpdateRoleRequest.ChangeName")
+	proto.RegisterType((*UpdateRoleRequest_ChangeCapability)(nil), "pixur.api.UpdateRoleRequest.ChangeCapability")
+	proto.RegisterType((*UpdateRoleResponse)(nil), "pixur.api.UpdateRoleResponse")
 	proto.RegisterType((*UpdateUserRequest)(nil), "pixur.api.UpdateUserRequest")
 	proto.RegisterType((*UpdateUserRequest_ChangeIdent)(nil), "pixur.api.UpdateUserRequest.ChangeIdent")
 	proto.RegisterType((*UpdateUserRequest_ChangeSecret)(nil), "pixur.api.UpdateUserRequest.ChangeSecret")
 	proto.RegisterType((*UpdateUserRequest_ChangeCapability)(nil), "pixur.api.UpdateUserRequest.ChangeCapability")
+	proto.RegisterType((*UpdateUserRequest_ChangeRole)(nil), "pixur.api.UpdateUserRequest.ChangeRole")
 	proto.RegisterType((*UpdateUserResponse)(nil), "pixur.api.UpdateUserResponse")
 	proto.RegisterType((*UpsertPicCommentVoteRequest)(nil), "pixur.api.UpsertPicCommentVoteRequest")
 	proto.RegisterType((*UpsertPicCommentVoteResponse)(nil), "pixur.api.UpsertPicCommentVoteResponse")
@@ -2695,8 +5275,14 @@ func init() {
 	proto.RegisterType((*UpsertPicResponse)(nil), "pixur.api.UpsertPicResponse")
 	proto.RegisterType((*UpsertPicVoteRequest)(nil), "pixur.api.UpsertPicVoteRequest")
 	proto.RegisterType((*UpsertPicVoteResponse)(nil), "pixur.api.UpsertPicVoteResponse")
+	proto.RegisterType((*UpsertTagAliasRequest)(nil), "pixur.api.UpsertTagAliasRequest")
+	proto.RegisterType((*UpsertTagAliasResponse)(nil), "pixur.api.UpsertTagAliasResponse")
+	proto.RegisterType((*UpsertTagImplicationRequest)(nil), "pixur.api.UpsertTagImplicationRequest")
+	proto.RegisterType((*UpsertTagImplicationResponse)(nil), "pixur.api.UpsertTagImplicationResponse")
 	proto.RegisterType((*WatchBackendConfigurationRequest)(nil), "pixur.api.WatchBackendConfigurationRequest")
 	proto.RegisterType((*WatchBackendConfigurationResponse)(nil), "pixur.api.WatchBackendConfigurationResponse")
+	proto.RegisterType((*WatchBackgroundJobRequest)(nil), "pixur.api.WatchBackgroundJobRequest")
+	proto.RegisterType((*WatchBackgroundJobResponse)(nil), "pixur.api.WatchBackgroundJobResponse")
 	proto.RegisterType((*ServiceOpts)(nil), "pixur.api.ServiceOpts")
 	proto.RegisterType((*HttpHeader)(nil), "pixur.api.HttpHeader")
 	proto.RegisterExtension(E_PixurServiceOpts)
@@ -2705,153 +5291,255 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x19, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x15, 0x2b, 0xd2, 0x12, 0xf9, 0x28, 0x99, 0xd4, 0x88, 0xb4, 0xe8, 0x95, 0xac, 0xc8, 0xeb, 0xc6,
-	0x55, 0x6d, 0x8b, 0x72, 0x94, 0xda, 0x48, 0x93, 0xa2, 0x8e, 0x22, 0x5b, 0x35, 0x53, 0xa7, 0x15,
-	0xd6, 0xb2, 0x53, 0x04, 0x28, 0xd8, 0x11, 0x77, 0x48, 0x0e, 0x4c, 0xee, 0x6e, 0x77, 0x97, 0x8a,
-	0x74, 0x08, 0x90, 0x06, 0x28, 0x8a, 0xf6, 0x54, 0xa0, 0xe8, 0xa5, 0xb7, 0x9e, 0x7a, 0xe9, 0x2f,
-	0x68, 0xff, 0x44, 0x8f, 0x05, 0xfa, 0x33, 0x7a, 0xe8, 0xb5, 0x98, 0x8f, 0xdd, 0x9d, 0xd9, 0x5d,
-	0x8a, 0x0a, 0x50, 0x9f, 0xb8, 0x33, 0xef, 0x73, 0xde, 0x7b, 0xf3, 0xe6, 0xbd, 0x47, 0xa8, 0x62,
-	0x9f, 0x76, 0xfc, 0xc0, 0x8b, 0x3c, 0x54, 0xf5, 0xe9, 0xf9, 0x34, 0xe8, 0x60, 0x9f, 0x9a, 0x37,
-	0x87, 0x9e, 0x37, 0x1c, 0x93, 0x3d, 0x0e, 0x38, 0x9d, 0x0e, 0xf6, 0xb0, 0x7b, 0x21, 0xb0, 0xcc,
-	0xed, 0x2c, 0xc8, 0x21, 0x61, 0x3f, 0xa0, 0x7e, 0xe4, 0x05, 0x12, 0xe3, 0x9d, 0x2c, 0x46, 0x44,
-	0x27, 0x24, 0x8c, 0xf0, 0xc4, 0x97, 0x08, 0x5b, 0x42, 0x90, 0x17, 0x0c, 0xf7, 0xf8, 0xd7, 0x1e,
-	0xf6, 0xe9, 0x9e, 0x83, 0x23, 0x2c, 0xe0, 0xd6, 0x04, 0x9a, 0x07, 0x8e, 0x73, 0x4c, 0xfb, 0x87,
-	0xde, 0x64, 0x42, 0xdc, 0xc8, 0x26, 0xbf, 0x9a, 0x92, 0x30, 0x42, 0x2d, 0x58, 0xf4, 0x69, 0xbf,
-	0x47, 0x9d, 0xb6, 0xb1, 0x6d, 0xec, 0x54, 0xed, 0x6b, 0x3e, 0xed, 0x77, 0x1d, 0x74, 0x0f, 0x56,
-	0xfb, 0x02, 0xb1, 0xe7, 0xe3, 0x80, 0xfd, 0x50, 0xa7, 0xbd, 0xc0, 0x31, 0xea, 0x12, 0x70, 0xcc,
-	0xf7, 0xbb, 0x0e, 0x42, 0x50, 0x8e, 0xc8, 0x79, 0xd4, 0x2e, 0x71, 0x30, 0xff, 0xb6, 0x9e, 0x43,
-	0x2b, 0x23, 0x2e, 0xf4, 0x3d, 0x37, 0x24, 0x68, 0x0f, 0x96, 0x24, 0x3d, 0x17, 0x58, 0xdb, 0x6f,
-	0x75, 0x12, 0x13, 0x75, 0x14, 0xfc, 0x18, 0xcb, 0xfa, 0x21, 0xac, 0x0a, 0x4e, 0x27, 0x78, 0x18,
-	0xce, 0xd1, 0xba, 0x01, 0xa5, 0x08, 0x0f, 0xdb, 0x0b, 0xdb, 0xa5, 0x9d, 0xaa, 0xcd, 0x3e, 0xad,
-	0x26, 0x20, 0x95, 0x5a, 0x28, 0x61, 0x1d, 0xc0, 0xea, 0x61, 0x40, 0x70, 0x44, 0x5e, 0x85, 0x24,
-	0x88, 0x79, 0x36, 0xe1, 0x1a, 0x75, 0x62, 0xbd, 0xaa, 0xb6, 0x58, 0xa0, 0x1b, 0xb0, 0x18, 0x92,
-	0x7e, 0x40, 0x22, 0x79, 0x7a, 0xb9, 0x62, 0x8c, 0x55, 0x16, 0x92, 0x71, 0x13, 0xd0, 0x53, 0x32,
-	0x26, 0x11, 0x39, 0xf1, 0xde, 0x10, 0x57, 0x72, 0xb6, 0x5a, 0xb0, 0xa6, 0xed, 0x4a, 0xe4, 0xd7,
-	0xd0, 0x3c, 0xa2, 0xae, 0xd3, 0x75, 0x1d, 0x72, 0x7e, 0x4c, 0xfb, 0xc9, 0xe1, 0xb6, 0x61, 0x39,
-	0x8c, 0x70, 0x10, 0xf5, 0xb4, 0x23, 0x02, 0xdf, 0x3b, 0xe6, 0xe7, 0xdc, 0x84, 0x2a, 0x0e, 0xfb,
-	0xc4, 0x75, 0xa8, 0x3b, 0xe4, 0x7a, 0x55, 0xec, 0x74, 0xc3, 0xfa, 0x8d, 0x01, 0xad, 0x0c, 0x63,
-	0x69, 0xfc, 0x07, 0x50, 0xf2, 0x69, 0xbf, 0x5d, 0xde, 0x2e, 0xed, 0xd4, 0xf6, 0x4d, 0xdd, 0xf0,
-	0x07, 0xae, 0x73, 0x32, 0x9a, 0x4e, 0x4e, 0x5d, 0x4c, 0xc7, 0x36, 0x43, 0x43, 0x5b, 0x50, 0x73,
-	0xc9, 0x79, 0xa2, 0x86, 0x38, 0x7f, 0x95, 0x6d, 0x09, 0x2d, 0xb6, 0xa0, 0xe6, 0x07, 0xe4, 0x2c,
-	0x86, 0x0b, 0xf7, 0x57, 0xd9, 0x16, 0x87, 0x5b, 0x6f, 0xc0, 0x64, 0x6a, 0xa4, 0x4e, 0x7d, 0xed,
-	0x45, 0x64, 0x9e, 0x0b, 0x6f, 0x01, 0xc4, 0x81, 0x97, 0xca, 0x94, 0x3b, 0x5d, 0x07, 0xad, 0xc3,
-	0xd2, 0x34, 0x24, 0x41, 0x2a, 0x6f, 0x91, 0x2d, 0xbb, 0x8e, 0xf5, 0x02, 0x36, 0x0a, 0x85, 0xc9,
-	0x93, 0xef, 0x42, 0xf9, 0xcc, 0x8b, 0x48, 0xdb, 0xe0, 0x47, 0xbf, 0x59, 0x18, 0x73, 0x8c, 0xc2,
-	0xe6, 0x68, 0xd6, 0x0d, 0xe1, 0x9a, 0x97, 0xfd, 0x11, 0x71, 0x14, 0xd7, 0x58, 0xcf, 0x84, 0x65,
-	0x95, 0x7d, 0xdd, 0xb2, 0x0b, 0x57, 0xb2, 0xac, 0xb5, 0x07, 0x37, 0x38, 0x1b, 0x3a, 0xa1, 0x63,
-	0x1c, 0xa8, 0xbe, 0x2f, 0xb6, 0x8a, 0xf5, 0x10, 0xd6, 0x73, 0x04, 0x52, 0xb2, 0x4a, 0x51, 0x4a,
-	0x29, 0xbe, 0x12, 0x9a, 0xb2, 0xe8, 0x7c, 0x76, 0x46, 0xdc, 0x28, 0x91, 0xa0, 0x58, 0xd0, 0x50,
-	0x2d, 0x88, 0x76, 0x61, 0x4d, 0x84, 0x1d, 0x07, 0x93, 0x33, 0xcd, 0x05, 0x0d, 0x0e, 0x4a, 0xb8,
-	0x65, 0x63, 0xb0, 0x94, 0x8d, 0xc1, 0xbf, 0x1a, 0xe2, 0x88, 0xaa, 0x7c, 0xa9, 0xf0, 0xfb, 0x00,
-	0xa9, 0x04, 0xe9, 0x90, 0xa6, 0x62, 0xb1, 0x84, 0xc4, 0xae, 0x4e, 0xe3, 0x4f, 0x74, 0x1f, 0x10,
-	0x8f, 0xc5, 0x22, 0xdd, 0xea, 0x0c, 0xa2, 0xaa, 0x76, 0x1f, 0x10, 0x0f, 0x4c, 0x1d, 0x59, 0xc4,
-	0x4b, 0x9d, 0x41, 0x14, 0x64, 0xeb, 0x0c, 0x6e, 0xfc, 0x98, 0x44, 0x36, 0x19, 0x04, 0x24, 0x1c,
-	0xa9, 0xd7, 0xf6, 0xdb, 0x25, 0x04, 0xd4, 0x81, 0x35, 0xc6, 0x9a, 0x7a, 0xd3, 0xb0, 0x87, 0xa7,
-	0xd1, 0xa8, 0x17, 0x31, 0x5e, 0x52, 0xea, 0x6a, 0x0c, 0x3a, 0x98, 0x46, 0x42, 0x88, 0xf5, 0x1f,
-	0x03, 0xd6, 0x73, 0x82, 0xa5, 0x89, 0x6e, 0x01, 0x28, 0x2c, 0xe4, 0x25, 0xc0, 0x31, 0x29, 0xda,
-	0x00, 0xf6, 0xac, 0x48, 0xe8, 0x35, 0x0e, 0xad, 0xf8, 0xf4, 0x5c, 0x00, 0x3f, 0x80, 0x65, 0x4e,
-	0xeb, 0xe3, 0x8b, 0xb1, 0x87, 0x9d, 0x76, 0x39, 0x9f, 0x65, 0xbf, 0x8c, 0x8e, 0x05, 0xd0, 0xae,
-	0x31, 0x54, 0xb9, 0x40, 0x8f, 0xa1, 0xc6, 0xd8, 0xc6, 0x84, 0x8b, 0x97, 0x11, 0x82, 0x4f, 0xcf,
-	0xe5, 0xf7, 0xa7, 0xe5, 0x8a, 0xd1, 0x58, 0xf8, 0xb4, 0x5c, 0x29, 0x35, 0xca, 0xf6, 0x4a, 0x20,
-	0xce, 0x23, 0x94, 0xb3, 0xeb, 0xf1, 0x52, 0x32, 0xb5, 0xf6, 0xe1, 0x66, 0xd7, 0xed, 0x07, 0x84,
-	0xdf, 0x37, 0x4a, 0xbe, 0x3c, 0xf4, 0xa6, 0xf3, 0xde, 0x22, 0x6b, 0x13, 0xcc, 0x22, 0x1a, 0x99,
-	0x45, 0xc7, 0xb0, 0xf1, 0xc2, 0xf3, 0xde, 0x4c, 0xfd, 0xcc, 0x45, 0x7e, 0x3b, 0x69, 0xe6, 0x33,
-	0xd8, 0x2c, 0x96, 0x96, 0xcb, 0x33, 0xc6, 0x55, 0xf2, 0xcc, 0x43, 0x58, 0x4f, 0xd8, 0x3d, 0x25,
-	0x11, 0xa6, 0xe3, 0x79, 0x99, 0xe0, 0xdf, 0x06, 0xb4, 0xf3, 0x24, 0x52, 0xfa, 0xb6, 0xc8, 0x42,
-	0x42, 0xf8, 0x75, 0x5d, 0xb8, 0xc8, 0xe9, 0x0f, 0x60, 0xc9, 0x21, 0x01, 0x3d, 0x23, 0x8e, 0x7c,
-	0x05, 0x90, 0x8e, 0x75, 0x44, 0xc7, 0xc4, 0x8e, 0x51, 0xd0, 0x3d, 0x58, 0x62, 0x3a, 0xc4, 0x6f,
-	0x6a, 0x6d, 0x7f, 0x55, 0xc7, 0x3e, 0xc1, 0x43, 0x9b, 0x69, 0x79, 0x82, 0x87, 0xe8, 0x10, 0x1a,
-	0x0c, 0x37, 0xb6, 0x6a, 0x14, 0x10, 0xc2, 0x6d, 0x37, 0xcb, 0x0a, 0x27, 0x01, 0x21, 0xf6, 0x75,
-	0x5f, 0x5b, 0xb3, 0xf0, 0x48, 0x0e, 0xf7, 0xec, 0x3c, 0x22, 0x6e, 0x48, 0x3d, 0x77, 0x8e, 0x45,
-	0xfe, 0x66, 0x80, 0x59, 0x44, 0x24, 0x6d, 0xf2, 0x31, 0x94, 0x58, 0x71, 0x22, 0xf2, 0x4c, 0x47,
-	0x51, 0x65, 0x36, 0x4d, 0xe7, 0xd9, 0x79, 0xf4, 0xcc, 0x8d, 0x82, 0x0b, 0x9b, 0x91, 0x9a, 0x2f,
-	0xa0, 0x12, 0x6f, 0xb0, 0x0a, 0xe3, 0x0d, 0xb9, 0x90, 0x0a, 0xb0, 0x4f, 0x74, 0x0f, 0xae, 0x9d,
-	0xe1, 0xf1, 0x94, 0xf0, 0x20, 0x62, 0x99, 0x4c, 0x54, 0x6a, 0x9d, 0xb8, 0x52, 0xeb, 0x1c, 0xb8,
-	0x17, 0xb6, 0x40, 0xf9, 0x70, 0xe1, 0x03, 0xc3, 0xa2, 0xd0, 0x4c, 0x24, 0x73, 0x6b, 0xcb, 0xd3,
-	0xb1, 0xd7, 0x94, 0xf6, 0x7b, 0x03, 0x3a, 0x26, 0xe9, 0x11, 0xab, 0xbe, 0x40, 0xea, 0x3a, 0xe8,
-	0x3d, 0x58, 0x1c, 0x78, 0xc1, 0x04, 0x8b, 0xbc, 0x73, 0x3d, 0x6b, 0x55, 0x86, 0xd5, 0x39, 0xe2,
-	0x08, 0xb6, 0x44, 0xb4, 0x8e, 0xa0, 0x95, 0x11, 0x95, 0x44, 0x69, 0x25, 0x96, 0x25, 0x83, 0xa5,
-	0x30, 0x0c, 0xa4, 0x70, 0xeb, 0x48, 0x51, 0xf9, 0x0a, 0x77, 0x4b, 0xb9, 0x3c, 0x0b, 0xda, 0xe5,
-	0x79, 0xa2, 0xe8, 0xa3, 0xdd, 0x9a, 0xbb, 0xda, 0xad, 0xc9, 0xe8, 0xa2, 0x5c, 0x97, 0xc7, 0xc9,
-	0x5d, 0x9f, 0x9e, 0x8e, 0x69, 0x9f, 0xa5, 0xf1, 0xae, 0x3b, 0xf0, 0xe6, 0x3d, 0x6d, 0xd6, 0xeb,
-	0xe4, 0xd6, 0x66, 0xe8, 0xa4, 0xfc, 0xc7, 0x50, 0x15, 0x84, 0xee, 0xc0, 0x2b, 0xba, 0xba, 0x3a,
-	0x55, 0x65, 0x2a, 0xbf, 0xac, 0x07, 0xb0, 0x2a, 0xf8, 0xaa, 0x75, 0xe4, 0x4c, 0x2d, 0x7e, 0x00,
-	0x48, 0xc5, 0x96, 0xb2, 0xef, 0x40, 0x99, 0xc1, 0xa5, 0xd8, 0x7a, 0xe6, 0x21, 0xb4, 0x39, 0xd0,
-	0xda, 0x81, 0xfa, 0xf1, 0x34, 0x18, 0x12, 0x76, 0x8f, 0x2f, 0xbf, 0x0d, 0x08, 0x1a, 0x29, 0xa6,
-	0x4c, 0x91, 0x7f, 0x32, 0x00, 0xd9, 0x04, 0x3b, 0x6f, 0x3d, 0xe2, 0xd8, 0xe3, 0xe8, 0x0d, 0x06,
-	0x21, 0x11, 0xcd, 0x40, 0xc9, 0x96, 0x2b, 0xf6, 0x94, 0x8e, 0xe9, 0x84, 0x46, 0xfc, 0x35, 0x2a,
-	0xd9, 0x62, 0x61, 0x7d, 0x04, 0x6b, 0x9a, 0x5a, 0xd2, 0x22, 0x08, 0xca, 0xac, 0x71, 0xe1, 0x0a,
-	0x2d, 0xdb, 0xfc, 0x9b, 0xdd, 0x3b, 0xe2, 0x0d, 0x64, 0xad, 0xcb, 0x3e, 0xad, 0x7f, 0x18, 0xd0,
-	0x7c, 0xe9, 0x0d, 0x22, 0x51, 0x59, 0xcf, 0x35, 0x0c, 0x6a, 0xb3, 0xcc, 0xc7, 0xd3, 0xa5, 0x8c,
-	0xca, 0x78, 0xc9, 0xce, 0x19, 0x10, 0x1c, 0x7a, 0xe2, 0xb1, 0xd6, 0xcf, 0xc9, 0xb9, 0xf3, 0xd4,
-	0xc0, 0x10, 0x6c, 0x89, 0x88, 0x9e, 0xc0, 0x8a, 0x23, 0x21, 0x3d, 0xd6, 0x89, 0xc9, 0x57, 0xd6,
-	0xcc, 0x5d, 0xfe, 0x93, 0xb8, 0x4d, 0xb3, 0x97, 0x63, 0x02, 0xb6, 0x65, 0xad, 0x43, 0x2b, 0xa3,
-	0xbc, 0xf4, 0xd5, 0x37, 0x65, 0x58, 0x7d, 0xe5, 0x3b, 0x99, 0xde, 0x64, 0x66, 0xd1, 0xd6, 0x86,
-	0xa5, 0x33, 0x12, 0xb0, 0xe4, 0xc5, 0x4f, 0xd5, 0xb0, 0xe3, 0x25, 0xfa, 0x51, 0x5c, 0xbd, 0x88,
-	0x24, 0xbc, 0xa3, 0x06, 0x56, 0x96, 0x7f, 0xe7, 0x70, 0x84, 0xdd, 0x21, 0xe9, 0x32, 0xfc, 0xb8,
-	0xce, 0x39, 0x48, 0xea, 0x1c, 0x71, 0xb6, 0xef, 0x5d, 0x81, 0xc1, 0x4b, 0x4e, 0x90, 0x94, 0x44,
-	0x9f, 0x01, 0xf4, 0xb1, 0x8f, 0x4f, 0xe9, 0x98, 0x46, 0x17, 0xbc, 0x50, 0xa9, 0xed, 0xef, 0x5e,
-	0x81, 0xcd, 0x61, 0x42, 0x64, 0x2b, 0x0c, 0xcc, 0x3b, 0x50, 0x53, 0xf4, 0x2c, 0x2e, 0xcf, 0xcc,
-	0xbb, 0xb0, 0xac, 0xea, 0xa2, 0x94, 0x6b, 0x86, 0x5a, 0xae, 0x99, 0x7f, 0x36, 0xa0, 0x91, 0x95,
-	0x86, 0x3e, 0x86, 0xeb, 0x21, 0x89, 0x7a, 0x8a, 0xd2, 0xec, 0xd9, 0xd0, 0x23, 0x22, 0x45, 0x67,
-	0x9f, 0xf6, 0x4a, 0x48, 0x22, 0x85, 0xc3, 0x53, 0x68, 0xf4, 0xc7, 0x04, 0x07, 0x2a, 0x8f, 0x85,
-	0x79, 0x3c, 0xea, 0x9c, 0x24, 0xdd, 0x64, 0x99, 0x42, 0xb5, 0xcd, 0xb7, 0xc9, 0x14, 0x7f, 0x31,
-	0x60, 0xe3, 0x95, 0x1f, 0x12, 0xde, 0x2a, 0xfe, 0xdf, 0xea, 0x21, 0x25, 0xcc, 0x4a, 0x7a, 0x98,
-	0xed, 0xcb, 0xd4, 0x5d, 0xe6, 0x57, 0x67, 0x6b, 0x66, 0xc1, 0xd3, 0x51, 0xd2, 0xf8, 0x16, 0x6c,
-	0x16, 0xab, 0x28, 0xef, 0xc0, 0xef, 0x16, 0xa0, 0x91, 0x20, 0xc4, 0x8a, 0x37, 0xa0, 0x34, 0x0d,
-	0xc6, 0xf1, 0xcb, 0x3b, 0x0d, 0xc6, 0xc8, 0x84, 0x4a, 0x40, 0x06, 0x24, 0x08, 0x48, 0x10, 0x57,
-	0xc1, 0xf1, 0x9a, 0xe5, 0x10, 0x17, 0x4f, 0x88, 0x3c, 0x09, 0xff, 0x4e, 0xf2, 0x4a, 0x49, 0xc9,
-	0x2b, 0x37, 0xa1, 0x32, 0x71, 0x1e, 0xf5, 0x46, 0x38, 0x1c, 0xf1, 0x23, 0x2c, 0xdb, 0x4b, 0x13,
-	0xe7, 0xd1, 0x73, 0x1c, 0x8e, 0xd0, 0x63, 0x51, 0x38, 0x2c, 0xf2, 0xc2, 0xe1, 0x3b, 0x5a, 0xd8,
-	0xea, 0xaa, 0xbd, 0xd5, 0x72, 0xe1, 0x11, 0x4b, 0x07, 0x89, 0xbc, 0xab, 0xd6, 0x79, 0x56, 0x04,
-	0xcd, 0x84, 0xec, 0x0a, 0xee, 0x9f, 0xed, 0xdf, 0xfb, 0xd2, 0xbf, 0xe2, 0x09, 0x58, 0xcf, 0x3f,
-	0xcd, 0xaa, 0x63, 0xd7, 0xa1, 0x95, 0x91, 0x2a, 0x3d, 0x6a, 0xc1, 0xf6, 0xe7, 0x38, 0xea, 0x8f,
-	0x3e, 0xc1, 0xfd, 0x37, 0xc4, 0x75, 0x0e, 0x3d, 0x77, 0x40, 0x87, 0xd3, 0x00, 0x47, 0x69, 0x79,
-	0x67, 0xfd, 0xd1, 0x80, 0xdb, 0x97, 0x20, 0xc9, 0xa3, 0x2b, 0x9a, 0x1a, 0xba, 0xa6, 0x27, 0xd0,
-	0x3a, 0x15, 0x94, 0xbd, 0xbe, 0x4a, 0x2a, 0x2d, 0xfd, 0x8e, 0xa2, 0x7a, 0xa1, 0x84, 0xe6, 0x69,
-	0xc1, 0xae, 0xf5, 0x77, 0x03, 0x6a, 0x2f, 0x49, 0x70, 0x46, 0xfb, 0xe4, 0x67, 0x7e, 0x14, 0xa2,
-	0x77, 0xa0, 0x86, 0x7d, 0xda, 0x53, 0x75, 0x28, 0xd9, 0x80, 0x7d, 0xfa, 0x5a, 0xaa, 0xf1, 0x1e,
-	0xb4, 0xd2, 0xde, 0xad, 0x37, 0x22, 0xd8, 0x21, 0x41, 0x8f, 0x05, 0x81, 0x08, 0x45, 0x94, 0xb4,
-	0x71, 0xcf, 0x39, 0xe8, 0x27, 0xe4, 0x02, 0xed, 0x41, 0x33, 0xe9, 0xe7, 0x54, 0x8a, 0xb8, 0x77,
-	0x94, 0xad, 0x5d, 0x4a, 0x70, 0x17, 0xea, 0xa3, 0x28, 0xf2, 0x55, 0xdc, 0x32, 0xc7, 0x5d, 0x61,
-	0xdb, 0x09, 0x9e, 0xf5, 0x7d, 0x80, 0xe7, 0xc9, 0x46, 0x41, 0x30, 0x36, 0xd5, 0x60, 0xac, 0xca,
-	0xb0, 0xdb, 0xff, 0xed, 0x1a, 0x2c, 0x1f, 0x33, 0x5b, 0xc9, 0x73, 0x23, 0x1b, 0x56, 0xb4, 0x61,
-	0x1e, 0x52, 0x6d, 0x59, 0x34, 0x55, 0x34, 0xb7, 0x67, 0x23, 0x48, 0x3f, 0x76, 0x01, 0xd2, 0xc1,
-	0x1c, 0xda, 0xcc, 0xe1, 0x2b, 0xd3, 0x3e, 0xf3, 0xd6, 0x0c, 0x68, 0xca, 0x2a, 0x1d, 0xc5, 0x69,
-	0xac, 0x72, 0x43, 0x3e, 0x8d, 0x55, 0x7e, 0x7e, 0x87, 0x5e, 0x40, 0x4d, 0x99, 0xd4, 0xa1, 0x5b,
-	0xd9, 0x4a, 0x40, 0x9b, 0xeb, 0x99, 0x5b, 0xb3, 0xc0, 0x92, 0xdb, 0xe7, 0xb0, 0xa2, 0xcd, 0xe1,
-	0x34, 0xbb, 0x15, 0x8d, 0xfe, 0x34, 0xbb, 0x15, 0x8e, 0xf0, 0xac, 0xd2, 0x1f, 0x16, 0x0c, 0x44,
-	0x61, 0xad, 0x60, 0xd8, 0x85, 0xde, 0xcd, 0x50, 0x17, 0x4f, 0xde, 0xcc, 0xbb, 0xf3, 0xd0, 0x54,
-	0x51, 0xf2, 0x0c, 0xc9, 0xc4, 0x2b, 0x77, 0x86, 0xec, 0x8c, 0x2c, 0x77, 0x86, 0xdc, 0xb0, 0x4c,
-	0x30, 0xfe, 0x05, 0xd4, 0x33, 0x23, 0x2d, 0x74, 0x3b, 0x4b, 0x99, 0x9b, 0x8f, 0x99, 0xd6, 0x65,
-	0x28, 0x2a, 0xfb, 0x2f, 0xe0, 0xba, 0x3e, 0x7f, 0x42, 0x59, 0xbd, 0x72, 0xa3, 0x31, 0xf3, 0xf6,
-	0x25, 0x18, 0x2a, 0xef, 0x9f, 0x43, 0x3d, 0x33, 0xb9, 0xd1, 0x54, 0x2f, 0x1e, 0x27, 0x69, 0xaa,
-	0xcf, 0x1a, 0xfc, 0x60, 0x40, 0xf9, 0x51, 0x07, 0x52, 0x1f, 0x9f, 0x99, 0xd3, 0x13, 0xf3, 0xdd,
-	0x39, 0x58, 0x52, 0xc4, 0x58, 0x69, 0xe6, 0x14, 0xb7, 0xa3, 0xbb, 0x45, 0xad, 0x71, 0xbe, 0x80,
-	0x30, 0xbf, 0x3b, 0x17, 0x4f, 0x35, 0xd5, 0x2f, 0xa1, 0x91, 0x9d, 0x56, 0x20, 0xab, 0x88, 0x83,
-	0x3e, 0xfd, 0x30, 0xef, 0x5c, 0x8a, 0xa3, 0x4a, 0x18, 0xc4, 0x5d, 0x95, 0xda, 0xc9, 0x6b, 0x26,
-	0x9b, 0x39, 0x51, 0xd0, 0x4c, 0x36, 0x7b, 0x1c, 0x90, 0x5c, 0x04, 0xad, 0x99, 0xd6, 0x2e, 0x42,
-	0x51, 0x47, 0xaf, 0x5d, 0x84, 0xc2, 0x3e, 0x3c, 0xcf, 0x98, 0x7b, 0xa2, 0x90, 0xb1, 0xea, 0x82,
-	0xed, 0xd9, 0x08, 0x2a, 0xe3, 0xd4, 0xd3, 0x5a, 0xff, 0x5a, 0xe4, 0xe9, 0xa2, 0x76, 0xba, 0xc8,
-	0xd3, 0x85, 0xed, 0xb3, 0x90, 0xf6, 0x53, 0x80, 0xb4, 0xbb, 0xd5, 0xb2, 0x70, 0xae, 0x45, 0xd6,
-	0xb2, 0x70, 0xbe, 0x25, 0x16, 0xfc, 0x0e, 0xa1, 0x12, 0x37, 0xb2, 0x48, 0x1b, 0xa8, 0xeb, 0x7d,
-	0xb0, 0xb9, 0x51, 0x08, 0x93, 0xc1, 0xfe, 0x0a, 0x6a, 0x4a, 0x87, 0xa9, 0xe5, 0xf3, 0x7c, 0x43,
-	0xac, 0xe5, 0xf3, 0x82, 0xc6, 0x94, 0xeb, 0xb5, 0x63, 0x3c, 0x34, 0xd8, 0x83, 0xa8, 0x75, 0x6f,
-	0x9a, 0xcb, 0x8a, 0x9a, 0x52, 0xcd, 0x65, 0x85, 0x8d, 0x1f, 0x7b, 0xc5, 0xd2, 0x9a, 0x5f, 0xb3,
-	0x5f, 0xae, 0x4d, 0xd2, 0xec, 0x57, 0xd0, 0x28, 0x1c, 0x41, 0x35, 0x29, 0xc3, 0xd0, 0xc6, 0x25,
-	0x95, 0xab, 0xb9, 0x59, 0x0c, 0x94, 0x7c, 0x86, 0x4a, 0x11, 0x39, 0x2b, 0x55, 0x5c, 0xd2, 0x6b,
-	0x68, 0x01, 0x74, 0x59, 0xc1, 0xcf, 0xec, 0xa9, 0xd5, 0x8d, 0x9a, 0x3d, 0x8b, 0xea, 0x58, 0xcd,
-	0x9e, 0x85, 0x25, 0x27, 0xfa, 0x0a, 0x6e, 0xce, 0xac, 0x26, 0xd1, 0x7d, 0x85, 0x7c, 0x5e, 0x61,
-	0x6a, 0x3e, 0xb8, 0x1a, 0xb2, 0x12, 0x24, 0x0f, 0x0d, 0xf3, 0xf0, 0xf7, 0x5f, 0x6f, 0x3f, 0xa9,
-	0x7c, 0xf3, 0xdf, 0x7f, 0x56, 0x51, 0x83, 0x93, 0xef, 0xb2, 0xc2, 0x6f, 0x97, 0xd7, 0x78, 0x66,
-	0x5d, 0xec, 0xf8, 0xf4, 0x5c, 0x6c, 0x58, 0x2d, 0xb1, 0xc1, 0xaa, 0xb7, 0x5d, 0x51, 0xd4, 0xed,
-	0x9e, 0x52, 0xf7, 0xc3, 0x21, 0x20, 0x0e, 0xe8, 0x85, 0xa2, 0x12, 0xeb, 0x79, 0xbc, 0x04, 0xcd,
-	0xf5, 0x0c, 0x69, 0x81, 0x4a, 0x3d, 0x37, 0x6c, 0xff, 0xfa, 0x6b, 0xd1, 0xb2, 0xdf, 0x50, 0x83,
-	0x2e, 0xad, 0x61, 0x6d, 0xa1, 0x90, 0xb2, 0xf3, 0xc9, 0x2e, 0xac, 0x78, 0xc1, 0x30, 0x45, 0x3f,
-	0x36, 0xbe, 0x58, 0x2f, 0xf8, 0x43, 0xf9, 0x23, 0xec, 0xd3, 0x7f, 0x19, 0xc6, 0xe9, 0x22, 0x97,
-	0xfc, 0xfe, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x88, 0x05, 0x5e, 0xe9, 0x1e, 0x00, 0x00,
+	// 3962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4d, 0x73, 0xdc, 0xc6,
+	0x95, 0x05, 0xce, 0x90, 0x9c, 0x79, 0xc3, 0xcf, 0xe6, 0xf0, 0x0b, 0xa4, 0x28, 0x1a, 0xb6, 0x64,
+	0xad, 0x25, 0x52, 0x32, 0xbd, 0x52, 0xc9, 0xd6, 0x96, 0x25, 0x8a, 0x92, 0x2c, 0x5a, 0xb2, 0xcd,
+	0x85, 0x28, 0x59, 0xe5, 0x2a, 0xef, 0x2c, 0x38, 0xe8, 0x19, 0x62, 0x85, 0x01, 0xb0, 0x00, 0x86,
+	0x22, 0x0f, 0xae, 0xf2, 0x6e, 0x55, 0x72, 0xc8, 0xc5, 0x71, 0x52, 0xb9, 0xe4, 0x96, 0x53, 0x2e,
+	0xf9, 0x05, 0xc9, 0x0f, 0xc8, 0x29, 0x55, 0xa9, 0xca, 0x25, 0xae, 0xfc, 0x8c, 0x1c, 0x72, 0x4d,
+	0xf5, 0x07, 0x80, 0x6e, 0xa0, 0x31, 0x33, 0xb2, 0xe5, 0xaa, 0x9c, 0x38, 0xe8, 0xf7, 0xfa, 0x75,
+	0xf7, 0xeb, 0xf7, 0x5e, 0xbf, 0x2f, 0x42, 0xdd, 0x0a, 0x9c, 0xed, 0x20, 0xf4, 0x63, 0x1f, 0xd5,
+	0x03, 0xe7, 0xb4, 0x1f, 0x6e, 0x5b, 0x81, 0xa3, 0xaf, 0x76, 0x7d, 0xbf, 0xeb, 0xe2, 0xab, 0x14,
+	0x70, 0xd4, 0xef, 0x5c, 0xb5, 0xbc, 0x33, 0x86, 0xa5, 0x6f, 0xe6, 0x41, 0x36, 0x8e, 0xda, 0xa1,
+	0x13, 0xc4, 0x7e, 0xc8, 0x31, 0xce, 0xe7, 0x31, 0x62, 0xa7, 0x87, 0xa3, 0xd8, 0xea, 0x05, 0x1c,
+	0x61, 0x83, 0x2d, 0xe4, 0x87, 0xdd, 0xab, 0xf4, 0xd7, 0x55, 0x2b, 0x70, 0xae, 0xda, 0x56, 0x6c,
+	0x31, 0xb8, 0xd1, 0x83, 0xe6, 0xae, 0x6d, 0x1f, 0x38, 0xed, 0x3d, 0xbf, 0xd7, 0xc3, 0x5e, 0x6c,
+	0xe2, 0xff, 0xed, 0xe3, 0x28, 0x46, 0x8b, 0x30, 0x11, 0x38, 0xed, 0x96, 0x63, 0xaf, 0x68, 0x9b,
+	0xda, 0xa5, 0xba, 0x39, 0x1e, 0x38, 0xed, 0x7d, 0x1b, 0xbd, 0x03, 0xf3, 0x6d, 0x86, 0xd8, 0x0a,
+	0xac, 0x90, 0xfc, 0x71, 0xec, 0x95, 0x31, 0x8a, 0x31, 0xcb, 0x01, 0x07, 0x74, 0x7c, 0xdf, 0x46,
+	0x08, 0xaa, 0x31, 0x3e, 0x8d, 0x57, 0x2a, 0x14, 0x4c, 0x7f, 0x1b, 0x0f, 0x61, 0x31, 0xb7, 0x5c,
+	0x14, 0xf8, 0x5e, 0x84, 0xd1, 0x55, 0x98, 0xe4, 0xf3, 0xe9, 0x82, 0x8d, 0x9d, 0xc5, 0xed, 0x94,
+	0x45, 0xdb, 0x02, 0x7e, 0x82, 0x65, 0xfc, 0x07, 0xcc, 0x33, 0x4a, 0x87, 0x56, 0x37, 0x1a, 0xb2,
+	0xeb, 0x39, 0xa8, 0xc4, 0x56, 0x77, 0x65, 0x6c, 0xb3, 0x72, 0xa9, 0x6e, 0x92, 0x9f, 0x46, 0x13,
+	0x90, 0x38, 0x9b, 0x6d, 0xc2, 0x78, 0x08, 0xfa, 0x9e, 0xe5, 0xb5, 0xb1, 0x7b, 0xd7, 0x6a, 0xbf,
+	0xe8, 0x86, 0x7e, 0xdf, 0xb3, 0x3f, 0xf6, 0x8f, 0x12, 0xe2, 0xef, 0xc0, 0xfc, 0x51, 0x3a, 0xde,
+	0xfa, 0x1f, 0xff, 0x28, 0x5b, 0x67, 0xf6, 0x48, 0x9c, 0xb0, 0x6f, 0x1b, 0xff, 0x05, 0x6b, 0x4a,
+	0x4a, 0xfc, 0xb4, 0xb7, 0x61, 0x46, 0x26, 0xc5, 0x0f, 0xbd, 0x22, 0x1c, 0x5a, 0x9e, 0x39, 0x2d,
+	0xad, 0x60, 0x5c, 0x02, 0xb4, 0xe7, 0x7b, 0x1d, 0x27, 0xec, 0x1d, 0xfa, 0x71, 0x90, 0xec, 0x10,
+	0x41, 0xb5, 0xed, 0xdb, 0x98, 0x6f, 0x8a, 0xfe, 0x36, 0x3e, 0x80, 0x05, 0x09, 0x93, 0xef, 0xe0,
+	0x4d, 0x98, 0x0e, 0x71, 0xdb, 0x3f, 0xc1, 0xe1, 0x59, 0x8b, 0xcf, 0x21, 0xcc, 0x99, 0x4a, 0x06,
+	0xf7, 0xc8, 0xdc, 0xbf, 0x68, 0xb0, 0xb0, 0x17, 0x62, 0x2b, 0xc6, 0xbb, 0x81, 0xf3, 0x08, 0x9f,
+	0x09, 0xeb, 0x78, 0x56, 0x2f, 0x5d, 0x87, 0xfc, 0x46, 0xef, 0x03, 0xb4, 0xad, 0xc0, 0x3a, 0x72,
+	0x5c, 0x27, 0x3e, 0xa3, 0xac, 0x9e, 0xd9, 0x59, 0x15, 0x8e, 0xb3, 0x97, 0x02, 0xc9, 0x4f, 0x53,
+	0x40, 0x46, 0xb7, 0xa0, 0x81, 0x4f, 0x03, 0x27, 0xc4, 0x2d, 0x22, 0xbd, 0x54, 0x5e, 0x1a, 0x3b,
+	0xfa, 0x36, 0x13, 0xed, 0xed, 0x44, 0xb4, 0xb7, 0x0f, 0x13, 0xd1, 0x36, 0x81, 0xa1, 0x93, 0x01,
+	0x74, 0x0d, 0x9a, 0x96, 0xeb, 0xfa, 0x2f, 0xb1, 0xdd, 0x0a, 0x71, 0xcf, 0x8f, 0x71, 0x2b, 0xb4,
+	0xbc, 0x2e, 0x5e, 0xa9, 0xd2, 0xf3, 0x20, 0x0e, 0x33, 0x29, 0xc8, 0x24, 0x10, 0xe3, 0x39, 0x34,
+	0xe5, 0x43, 0x71, 0x96, 0xbc, 0x03, 0x93, 0x56, 0xe0, 0xb4, 0x5e, 0xe0, 0x33, 0x7e, 0x1b, 0xf3,
+	0xc2, 0xf6, 0x39, 0xee, 0x84, 0x45, 0xff, 0xa2, 0x26, 0x8c, 0xc7, 0xfe, 0x0b, 0xec, 0x71, 0xd9,
+	0x67, 0x1f, 0xc6, 0x11, 0xcc, 0x33, 0xca, 0xa6, 0xef, 0xe2, 0x1f, 0x87, 0x59, 0xc6, 0xfb, 0x80,
+	0xc4, 0x35, 0xd2, 0xeb, 0xac, 0x86, 0xbe, 0x8b, 0xf9, 0xc6, 0x67, 0x05, 0x52, 0x14, 0x8d, 0x02,
+	0x8d, 0xdd, 0x64, 0x7b, 0x4f, 0x23, 0x1c, 0x26, 0xdb, 0x6b, 0xc2, 0xb8, 0x63, 0x27, 0x6a, 0x57,
+	0x37, 0xd9, 0x07, 0x5a, 0x82, 0x89, 0x08, 0xb7, 0x43, 0x1c, 0xf3, 0x03, 0xf2, 0x2f, 0xa2, 0x37,
+	0x22, 0x09, 0xae, 0x37, 0x5b, 0xb0, 0x78, 0x0f, 0xbb, 0x38, 0xc6, 0x87, 0x56, 0x77, 0xd7, 0x75,
+	0xac, 0x48, 0x20, 0x6e, 0x91, 0xef, 0x84, 0x38, 0xfd, 0x30, 0x56, 0x60, 0x29, 0x8f, 0xce, 0x09,
+	0x1d, 0xc0, 0x5a, 0x0a, 0xd9, 0xef, 0x05, 0xae, 0xd3, 0xb6, 0x62, 0xc7, 0xf7, 0x12, 0x72, 0x5c,
+	0x8f, 0x19, 0x31, 0xf2, 0x13, 0x9d, 0x87, 0x86, 0x43, 0xf0, 0xb0, 0xdd, 0x62, 0x1a, 0x4e, 0x20,
+	0xc0, 0x87, 0x0e, 0xad, 0xae, 0xb1, 0x01, 0xeb, 0x6a, 0x8a, 0x7c, 0xc5, 0x26, 0x20, 0x0e, 0x27,
+	0x37, 0xc8, 0x17, 0x32, 0x16, 0x61, 0x41, 0x1a, 0xe5, 0xc8, 0xbb, 0x80, 0xee, 0x39, 0x91, 0x75,
+	0xe4, 0x62, 0x51, 0xeb, 0x96, 0x61, 0xb2, 0x1f, 0xe1, 0x30, 0xb3, 0x06, 0x13, 0xe4, 0x93, 0x19,
+	0x40, 0xaa, 0x5a, 0x63, 0x82, 0x3a, 0x12, 0xca, 0x22, 0x09, 0x4e, 0x79, 0x01, 0xe6, 0xef, 0x7b,
+	0xa1, 0xef, 0xba, 0x02, 0x61, 0xe3, 0x43, 0x40, 0xe2, 0x20, 0xbf, 0xea, 0xec, 0x6a, 0x34, 0xf1,
+	0x6a, 0x08, 0x73, 0xfa, 0xa1, 0xc3, 0x17, 0x23, 0x3f, 0x8d, 0x6f, 0x34, 0x58, 0xba, 0x7f, 0xda,
+	0x3e, 0x26, 0x52, 0xbf, 0x6f, 0x8b, 0x07, 0x44, 0xab, 0x50, 0x73, 0xec, 0x16, 0x13, 0x61, 0x46,
+	0x66, 0xd2, 0x61, 0x18, 0xe4, 0xce, 0x3c, 0xdf, 0x6b, 0x27, 0xdb, 0x66, 0x1f, 0xe8, 0x1c, 0x00,
+	0x3d, 0xa4, 0xd5, 0x25, 0xb2, 0xc2, 0x4c, 0x7a, 0x9d, 0x8c, 0xec, 0x92, 0x01, 0x74, 0x01, 0x66,
+	0xb8, 0xf6, 0x59, 0xb6, 0x1d, 0xe2, 0x28, 0x5a, 0xa9, 0x52, 0x94, 0x69, 0x36, 0xba, 0xcb, 0x06,
+	0x8d, 0x3f, 0x6a, 0xb0, 0x5c, 0xd8, 0x11, 0x3f, 0xd7, 0x39, 0x00, 0xab, 0x1f, 0x1f, 0x4b, 0x9b,
+	0xaa, 0x93, 0x11, 0xb6, 0xad, 0x35, 0x20, 0x6f, 0x66, 0x4b, 0xd4, 0xba, 0x5a, 0xe0, 0x9c, 0x32,
+	0xe0, 0x4d, 0x98, 0xa2, 0x73, 0x03, 0xeb, 0xcc, 0xf5, 0x2d, 0x9b, 0x9b, 0x10, 0xe9, 0x09, 0x79,
+	0x19, 0x1f, 0x30, 0xa0, 0xd9, 0x20, 0xa8, 0xfc, 0x03, 0xdd, 0x80, 0x06, 0x21, 0x9b, 0x4c, 0xac,
+	0x0e, 0x9a, 0x08, 0x81, 0x73, 0xca, 0x7f, 0x1b, 0xcf, 0xa0, 0xf9, 0xc0, 0xf1, 0xec, 0x7d, 0xcf,
+	0xc6, 0xa7, 0x07, 0x4e, 0x3b, 0x95, 0xf8, 0x4d, 0x98, 0x8a, 0x62, 0x2b, 0x8c, 0x5b, 0xd2, 0x3b,
+	0x04, 0x74, 0xec, 0x80, 0x3e, 0x46, 0xeb, 0x50, 0xb7, 0xa2, 0x36, 0xf6, 0x6c, 0xc7, 0x63, 0x02,
+	0x5b, 0x33, 0xb3, 0x01, 0xe3, 0x27, 0x1a, 0x2c, 0xe6, 0x08, 0x73, 0xfe, 0x5c, 0x81, 0x4a, 0xe0,
+	0xb4, 0xa9, 0x5d, 0x23, 0xd6, 0x51, 0x7a, 0x1d, 0x77, 0x3d, 0xfb, 0xf0, 0xb8, 0xdf, 0x3b, 0xf2,
+	0x2c, 0xc7, 0x35, 0x09, 0x1a, 0xda, 0x80, 0x86, 0x87, 0x4f, 0xd3, 0x6d, 0x30, 0x86, 0xd5, 0xc9,
+	0x10, 0xdb, 0xc5, 0x06, 0x34, 0x82, 0x10, 0x9f, 0x24, 0x70, 0x7e, 0xa1, 0x64, 0x88, 0xc2, 0x8d,
+	0x17, 0xa0, 0x93, 0x6d, 0x64, 0x2f, 0xef, 0x33, 0x3f, 0xc6, 0xc3, 0xde, 0xd9, 0x73, 0x00, 0x89,
+	0x77, 0x90, 0xad, 0xc9, 0x47, 0xf6, 0x6d, 0x51, 0x51, 0x2a, 0xa2, 0xa2, 0x18, 0x8f, 0x61, 0x4d,
+	0xb9, 0x18, 0x3f, 0xf9, 0x16, 0x54, 0x4f, 0xfc, 0x98, 0x3d, 0x51, 0x0d, 0xc9, 0x4e, 0xca, 0x33,
+	0x4c, 0x8a, 0x66, 0xfc, 0x94, 0xb3, 0x90, 0x70, 0xef, 0xee, 0x99, 0xe8, 0x1e, 0x2c, 0xc3, 0xa4,
+	0xe5, 0xba, 0x2d, 0x66, 0x43, 0xc8, 0xf3, 0x30, 0x61, 0xb9, 0xee, 0xa1, 0xd5, 0xa5, 0x00, 0xef,
+	0xac, 0x95, 0x39, 0x09, 0x13, 0x96, 0x47, 0x66, 0x12, 0x3d, 0xf1, 0x7c, 0x0f, 0x53, 0x48, 0x85,
+	0x42, 0x26, 0xc9, 0x37, 0x01, 0xe5, 0x6f, 0xba, 0x9a, 0xbf, 0x69, 0xa3, 0x03, 0x4b, 0xf9, 0x7d,
+	0xc8, 0x77, 0xa9, 0xbd, 0x96, 0xbb, 0x34, 0x10, 0xcc, 0x91, 0x75, 0x88, 0xa5, 0x4f, 0x8e, 0x6a,
+	0xdc, 0x84, 0x79, 0x61, 0xac, 0xf0, 0x4a, 0x54, 0xca, 0x5f, 0x89, 0x25, 0x26, 0xd9, 0x4f, 0xda,
+	0xc7, 0xd8, 0x16, 0x24, 0xdb, 0xb8, 0xcf, 0xb8, 0x2a, 0x8c, 0xcb, 0x87, 0x19, 0x1b, 0xe9, 0x30,
+	0xc6, 0x97, 0x8c, 0x29, 0x4f, 0x9c, 0x9e, 0xe3, 0x5a, 0xa1, 0xa8, 0x3a, 0x25, 0x42, 0xb5, 0x04,
+	0x13, 0xa1, 0x65, 0x3b, 0xfd, 0x88, 0x1e, 0x7c, 0xdc, 0xe4, 0x5f, 0xc4, 0x4e, 0xb9, 0x4e, 0xcf,
+	0x61, 0xc6, 0x68, 0xdc, 0x64, 0x1f, 0xc6, 0x63, 0x58, 0x2e, 0x90, 0xe7, 0xfb, 0x14, 0xe9, 0x57,
+	0x32, 0xfa, 0x3a, 0xd4, 0x6c, 0x27, 0x8a, 0x2d, 0x66, 0xf2, 0x2a, 0x97, 0xc6, 0xcd, 0xf4, 0xdb,
+	0xf8, 0x8a, 0x9d, 0x99, 0x3c, 0x76, 0xf7, 0x4f, 0xb0, 0x17, 0x47, 0x43, 0x6d, 0xfe, 0x16, 0x2c,
+	0x30, 0xa9, 0xa0, 0x60, 0x7c, 0x22, 0xe9, 0xc2, 0x1c, 0x05, 0xa5, 0xd4, 0xf2, 0xc6, 0xa0, 0x92,
+	0x37, 0x06, 0xbf, 0xd5, 0x18, 0xb3, 0xc4, 0xf5, 0xf9, 0x61, 0xde, 0xe3, 0xf6, 0x98, 0xae, 0xc0,
+	0x2f, 0xb4, 0x29, 0xf0, 0x3e, 0x9d, 0xc2, 0xac, 0x34, 0xfd, 0x89, 0x2e, 0x03, 0xa2, 0x82, 0xa4,
+	0xda, 0xdb, 0x2c, 0x81, 0x88, 0x5b, 0xbb, 0x0c, 0x88, 0x5a, 0x08, 0x19, 0x99, 0x29, 0xee, 0x2c,
+	0x81, 0x08, 0xc8, 0xc6, 0x57, 0xb0, 0x4a, 0x36, 0x2a, 0xf9, 0xac, 0xc3, 0x99, 0x35, 0x07, 0x15,
+	0xcb, 0x75, 0xb9, 0x11, 0x24, 0x3f, 0xd1, 0x75, 0x58, 0x66, 0xec, 0x2b, 0x7a, 0xda, 0x6c, 0xe5,
+	0x26, 0x05, 0xdf, 0xcd, 0xb9, 0xdb, 0xbf, 0xd0, 0x98, 0xb9, 0xca, 0xaf, 0x3f, 0xc0, 0xdd, 0xae,
+	0xbc, 0x82, 0xbb, 0x8d, 0xde, 0x83, 0x25, 0xca, 0xb8, 0xe2, 0xae, 0x18, 0xf3, 0x16, 0x08, 0x34,
+	0xbf, 0xa9, 0x16, 0xcc, 0x92, 0x3d, 0x89, 0x06, 0x68, 0x09, 0x26, 0x82, 0x10, 0x77, 0x9c, 0xd3,
+	0x84, 0x11, 0xec, 0x4b, 0xc1, 0x08, 0x03, 0xa6, 0x19, 0x23, 0x62, 0xab, 0x4b, 0x5d, 0x52, 0x76,
+	0xfc, 0x06, 0x1d, 0x3c, 0xb4, 0xba, 0x8f, 0xf0, 0x99, 0xf1, 0x8c, 0xe9, 0xbd, 0x64, 0x59, 0x36,
+	0x13, 0x17, 0x89, 0x9c, 0x6f, 0x46, 0x38, 0xdf, 0xa1, 0xd5, 0x65, 0x2e, 0xd3, 0x26, 0x4c, 0xd1,
+	0xb3, 0x24, 0x84, 0xb9, 0xcf, 0x44, 0xc6, 0x38, 0xdd, 0xef, 0x34, 0x58, 0xfa, 0x08, 0xc7, 0x26,
+	0xee, 0x84, 0x38, 0x3a, 0x96, 0xfc, 0x86, 0x57, 0xf2, 0x16, 0xd1, 0x36, 0x2c, 0x10, 0x41, 0x71,
+	0xfc, 0x7e, 0xd4, 0x12, 0xde, 0x76, 0x76, 0x94, 0xf9, 0x04, 0xb4, 0x9b, 0xbe, 0xf1, 0xb2, 0x93,
+	0x51, 0x1d, 0xee, 0x64, 0x8c, 0x2b, 0x9c, 0x0c, 0xe2, 0x29, 0xc4, 0x7e, 0x1c, 0xb0, 0xb0, 0x66,
+	0x82, 0x79, 0x0a, 0x64, 0x80, 0x86, 0x34, 0x7f, 0xd7, 0x60, 0xb9, 0x70, 0x36, 0xa5, 0x07, 0x32,
+	0x36, 0xd0, 0x03, 0x19, 0x1f, 0xe2, 0x81, 0x54, 0xbf, 0xaf, 0x07, 0x32, 0x31, 0xa2, 0x07, 0xf2,
+	0x71, 0xb5, 0xa6, 0xcd, 0x8d, 0x7d, 0x5c, 0xad, 0x55, 0xe6, 0xaa, 0x84, 0x03, 0xf4, 0x3c, 0x6c,
+	0x73, 0xe6, 0x6c, 0xf2, 0xc9, 0x89, 0x1a, 0x3b, 0xb0, 0xba, 0xef, 0xb5, 0x43, 0x4c, 0x5f, 0x4a,
+	0x07, 0xbf, 0xdc, 0xf3, 0xfb, 0xc3, 0x42, 0x7d, 0x63, 0x1d, 0x74, 0xd5, 0x9c, 0xd4, 0xe5, 0x47,
+	0x8f, 0x9d, 0x28, 0x66, 0x61, 0xd1, 0x50, 0x4d, 0x37, 0x76, 0x61, 0x41, 0x42, 0x57, 0x85, 0x5c,
+	0x95, 0x81, 0x21, 0x97, 0x71, 0x05, 0xe6, 0x09, 0x09, 0xca, 0xee, 0xe1, 0x0b, 0xde, 0x61, 0xfb,
+	0x4b, 0xb0, 0xd3, 0xf5, 0xc6, 0x13, 0xf7, 0x52, 0x65, 0x30, 0x99, 0x38, 0xf0, 0x60, 0xee, 0x0e,
+	0x2c, 0x3d, 0xf6, 0xfd, 0x17, 0xfd, 0xe0, 0xc0, 0x69, 0xdf, 0x3d, 0x7b, 0x68, 0x45, 0xc7, 0xc9,
+	0xa2, 0x17, 0x61, 0x36, 0x3a, 0xb6, 0xae, 0xbf, 0xbb, 0xd3, 0xda, 0xb9, 0x7e, 0xa3, 0x75, 0x6c,
+	0x45, 0xc7, 0x74, 0xf1, 0x29, 0x73, 0x9a, 0x0d, 0xef, 0x5c, 0xbf, 0x41, 0xd0, 0x8d, 0x5b, 0xb0,
+	0x5c, 0xa0, 0x90, 0xa9, 0x29, 0x73, 0x00, 0xb4, 0x9c, 0x9a, 0x1e, 0x38, 0x6d, 0xf6, 0x4e, 0xba,
+	0xb0, 0x96, 0x4e, 0x16, 0x7d, 0x9c, 0x1f, 0xc7, 0x03, 0xfb, 0x04, 0xd6, 0xd5, 0xab, 0x15, 0x5c,
+	0x30, 0x6d, 0x14, 0x17, 0xec, 0x9a, 0x70, 0xf2, 0x7b, 0x38, 0xb6, 0x1c, 0x77, 0xc8, 0x2b, 0x6f,
+	0xfc, 0x4d, 0x83, 0x95, 0xe2, 0x94, 0x51, 0xb9, 0x85, 0xae, 0xc0, 0xa4, 0x8d, 0x43, 0xe7, 0x04,
+	0xdb, 0xdc, 0x41, 0x46, 0x32, 0xd6, 0x03, 0xc7, 0xc5, 0x66, 0x82, 0x42, 0xc4, 0x8e, 0xec, 0x21,
+	0x71, 0xf7, 0x64, 0xb1, 0x63, 0x49, 0x21, 0x93, 0xec, 0x92, 0xb8, 0x79, 0x7b, 0x30, 0x47, 0x70,
+	0x13, 0xae, 0xc6, 0x21, 0x4e, 0x32, 0x14, 0x6a, 0x2e, 0x1c, 0x86, 0x18, 0x9b, 0x33, 0x81, 0xf4,
+	0x4d, 0xf4, 0x2f, 0x3d, 0xdc, 0xfd, 0xd3, 0x18, 0x7b, 0x91, 0x10, 0xd5, 0x96, 0x70, 0xe4, 0x77,
+	0x1a, 0xe8, 0xaa, 0x49, 0x9c, 0x27, 0x77, 0xa0, 0x82, 0x4f, 0x93, 0x97, 0x7f, 0x5b, 0xd8, 0x4a,
+	0xf9, 0x9c, 0xed, 0xfb, 0xa7, 0xf1, 0x7d, 0x2f, 0x0e, 0xcf, 0x4c, 0x32, 0x55, 0x7f, 0x0c, 0xb5,
+	0x64, 0x80, 0x3c, 0x40, 0x49, 0xde, 0xa3, 0x6e, 0x92, 0x9f, 0x44, 0x55, 0x4e, 0x2c, 0xb7, 0xcf,
+	0xc2, 0x40, 0xa2, 0x2a, 0xf9, 0x74, 0xcc, 0xae, 0x77, 0x66, 0x32, 0x94, 0x0f, 0xc6, 0x6e, 0x6a,
+	0x86, 0x03, 0xcd, 0x74, 0x65, 0xca, 0x6d, 0x7e, 0x3a, 0x12, 0x68, 0x38, 0xed, 0x56, 0xc7, 0x71,
+	0x71, 0x76, 0xc4, 0x7a, 0xc0, 0x90, 0xf6, 0x6d, 0xf4, 0x2e, 0x4c, 0x74, 0xfc, 0xb0, 0x67, 0xb1,
+	0xb7, 0x63, 0x26, 0xcf, 0x55, 0x82, 0xb5, 0xfd, 0x80, 0x22, 0x98, 0x1c, 0xd1, 0x78, 0x00, 0x8b,
+	0xb9, 0xa5, 0x52, 0x29, 0xad, 0x25, 0x6b, 0x71, 0x61, 0x51, 0x8a, 0x01, 0x5f, 0xdc, 0x78, 0x20,
+	0x6c, 0x79, 0x04, 0xdd, 0x12, 0x94, 0x67, 0x4c, 0x52, 0x9e, 0xdb, 0xc2, 0x7e, 0x24, 0xad, 0xb9,
+	0x28, 0x69, 0x4d, 0x6e, 0x2f, 0x82, 0xba, 0xdc, 0x48, 0x75, 0xbd, 0x7f, 0xe4, 0x3a, 0x6d, 0x62,
+	0x8a, 0xf6, 0xbd, 0x8e, 0x3f, 0xd4, 0xc8, 0x3d, 0x4b, 0xb5, 0x36, 0x37, 0x8f, 0xaf, 0x7f, 0x03,
+	0xea, 0x6c, 0xa2, 0xd7, 0xf1, 0x55, 0xaa, 0x2b, 0xcf, 0xaa, 0xf5, 0xf9, 0x2f, 0xe3, 0x61, 0x22,
+	0x79, 0xaf, 0x23, 0x0f, 0xaa, 0xa4, 0xf4, 0xba, 0xf2, 0xa0, 0xe4, 0x51, 0xa0, 0xf4, 0xc5, 0x94,
+	0x56, 0x29, 0xbf, 0xde, 0x07, 0x24, 0x62, 0x67, 0x51, 0x11, 0x81, 0x2b, 0x72, 0x67, 0x14, 0x8d,
+	0x02, 0x8d, 0xe7, 0x30, 0xf7, 0x09, 0x0e, 0xbb, 0x58, 0x0c, 0x58, 0x0c, 0x98, 0x7e, 0xe9, 0x78,
+	0x1e, 0x0e, 0xe5, 0x60, 0xbf, 0xc1, 0x06, 0x59, 0x9c, 0xbd, 0x09, 0x53, 0xae, 0x1f, 0x65, 0x28,
+	0xdc, 0xdb, 0xa2, 0x63, 0x2c, 0x7a, 0xbb, 0x0e, 0xf3, 0x02, 0xe5, 0x91, 0xdf, 0x87, 0x4b, 0x30,
+	0x7b, 0xd0, 0x67, 0xd3, 0x86, 0x18, 0x12, 0x04, 0x73, 0x19, 0x26, 0x7f, 0xbe, 0x7f, 0xa5, 0x01,
+	0x32, 0xb1, 0x65, 0xff, 0xe8, 0xca, 0x4a, 0x7c, 0x43, 0xbf, 0xd3, 0x89, 0x30, 0x8b, 0xd3, 0x2a,
+	0x26, 0xff, 0xca, 0xc2, 0xb7, 0x2a, 0x1d, 0xe6, 0xe1, 0xdb, 0x2d, 0x58, 0x90, 0xb6, 0xc5, 0xd9,
+	0x81, 0xa0, 0x6a, 0x5b, 0xb1, 0xc5, 0x9f, 0x59, 0xfa, 0x9b, 0x98, 0x2c, 0xec, 0x77, 0x12, 0x9f,
+	0x19, 0xfb, 0x1d, 0xe3, 0x36, 0x34, 0x4d, 0xdc, 0xf3, 0x4f, 0xf0, 0xf7, 0xad, 0x0a, 0x2c, 0xc3,
+	0x62, 0x8e, 0x00, 0x67, 0xd7, 0x3d, 0x98, 0x37, 0x71, 0x14, 0xfb, 0xe1, 0x70, 0x76, 0xa3, 0x15,
+	0xf2, 0x14, 0xd1, 0xf7, 0x8b, 0x5f, 0x76, 0xf2, 0x69, 0xdc, 0x20, 0x3c, 0xcf, 0xa8, 0x8c, 0x7c,
+	0xd5, 0x8f, 0x09, 0x53, 0x4e, 0xfc, 0x17, 0xb9, 0x2c, 0x7c, 0x69, 0x58, 0xb5, 0x0e, 0xc0, 0xbd,
+	0xaa, 0x4c, 0xe2, 0x6a, 0xcc, 0x8b, 0xda, 0xb7, 0x49, 0x7c, 0x2f, 0x53, 0xe3, 0x67, 0xfc, 0x82,
+	0xec, 0x8e, 0x8c, 0x4b, 0x0e, 0x7f, 0xe9, 0x22, 0xab, 0x50, 0xa3, 0x7e, 0x52, 0xb6, 0xc4, 0x24,
+	0xfd, 0xce, 0xc2, 0xba, 0x4a, 0x1a, 0xcd, 0x18, 0x8b, 0xc9, 0x09, 0xe4, 0x7c, 0xea, 0x1f, 0x34,
+	0x68, 0x3e, 0xf1, 0x3b, 0x31, 0xcb, 0xb5, 0xfe, 0x00, 0xd6, 0x12, 0xc1, 0x0c, 0xb1, 0x15, 0xf9,
+	0x2c, 0xb8, 0x90, 0x05, 0x93, 0x52, 0xa7, 0xcf, 0x20, 0x41, 0x30, 0x39, 0x22, 0xba, 0x0d, 0xd3,
+	0x36, 0x87, 0xb0, 0xba, 0x43, 0x75, 0x68, 0xdd, 0x61, 0x2a, 0x99, 0x40, 0x86, 0x88, 0xb4, 0xe4,
+	0x36, 0xcf, 0x8f, 0xf5, 0x6d, 0x05, 0xe6, 0x9f, 0x06, 0x76, 0xae, 0x0e, 0xb0, 0x0c, 0x93, 0xa1,
+	0x2f, 0xea, 0xd5, 0x04, 0xf9, 0x64, 0xa7, 0x3a, 0xc1, 0x21, 0x79, 0xa8, 0xe9, 0xa9, 0xe6, 0xcc,
+	0xe4, 0x13, 0xdd, 0xe2, 0xa5, 0x03, 0xe6, 0x6f, 0xbc, 0x2d, 0x5a, 0xa6, 0x3c, 0xf9, 0xed, 0x3d,
+	0x9a, 0x54, 0xfd, 0xd4, 0xea, 0x61, 0x5e, 0x63, 0xf8, 0x44, 0xaa, 0x31, 0xb0, 0xc3, 0x6d, 0x8d,
+	0x40, 0x22, 0xab, 0x3d, 0x88, 0x75, 0x07, 0x7d, 0x13, 0x20, 0x5b, 0x42, 0x55, 0xd4, 0xd0, 0x7f,
+	0xad, 0xc1, 0x5c, 0x9e, 0x04, 0xba, 0x03, 0x33, 0x11, 0x8e, 0x5b, 0xc2, 0x4e, 0xb4, 0x61, 0xd5,
+	0x8e, 0xe9, 0x08, 0xc7, 0x02, 0x85, 0x7b, 0x30, 0xd7, 0x76, 0xb1, 0x15, 0xb6, 0x5e, 0xa5, 0x62,
+	0x32, 0x4b, 0xa7, 0xec, 0x49, 0x65, 0x13, 0xf1, 0xc0, 0xaf, 0x52, 0x36, 0xf9, 0x76, 0x3c, 0xb9,
+	0xce, 0x51, 0x1e, 0x99, 0x01, 0xd7, 0xf9, 0x61, 0x12, 0x3c, 0xb3, 0xfb, 0xbc, 0x54, 0xb8, 0x0c,
+	0x81, 0x3e, 0xbf, 0x8c, 0x7d, 0x82, 0x9f, 0x84, 0xd9, 0xbb, 0x69, 0x98, 0xcd, 0x6e, 0xf3, 0xdf,
+	0x46, 0x20, 0xf0, 0x84, 0x4e, 0x48, 0x23, 0x72, 0x59, 0x28, 0xc6, 0x4b, 0x84, 0xa2, 0x48, 0x46,
+	0x2d, 0x14, 0x44, 0x40, 0x29, 0xff, 0x26, 0x4a, 0x04, 0xb4, 0x48, 0x28, 0xe3, 0xab, 0xfe, 0x26,
+	0x34, 0x84, 0x43, 0xaa, 0x53, 0x0b, 0xfa, 0x45, 0x98, 0x12, 0x0f, 0x52, 0x56, 0xfd, 0xf8, 0x97,
+	0x16, 0x3e, 0xfd, 0x20, 0xd1, 0x1d, 0x72, 0x7a, 0xf2, 0xc8, 0x92, 0x5d, 0x65, 0xc6, 0x80, 0xbc,
+	0x3f, 0xf5, 0x08, 0xc7, 0x26, 0xb3, 0x07, 0x06, 0x4c, 0xb3, 0x35, 0x13, 0x0c, 0xf6, 0x42, 0x35,
+	0xe8, 0x20, 0xc3, 0xc9, 0xc4, 0xf9, 0xd5, 0x3d, 0x99, 0xdf, 0x68, 0xb0, 0xf6, 0x34, 0x88, 0x30,
+	0xcd, 0x52, 0xbf, 0xb6, 0xc8, 0x52, 0x90, 0xfa, 0x8a, 0x2c, 0xf5, 0x3b, 0xdc, 0x09, 0xae, 0x52,
+	0xc3, 0xbc, 0x51, 0x1a, 0x3a, 0x6e, 0x0b, 0x0e, 0xf1, 0x06, 0xac, 0xab, 0xb7, 0xc8, 0x2d, 0xec,
+	0x77, 0x63, 0x30, 0x97, 0x22, 0x08, 0xd5, 0xc1, 0x7e, 0xe8, 0x26, 0x31, 0x4c, 0x3f, 0x74, 0x91,
+	0x0e, 0xb5, 0x10, 0x77, 0x70, 0x18, 0xe2, 0x30, 0x49, 0xd8, 0x24, 0xdf, 0xa9, 0x05, 0x1b, 0x13,
+	0xca, 0xb2, 0x89, 0x9b, 0x51, 0x11, 0xdc, 0x8c, 0x55, 0xa8, 0xf5, 0xec, 0xeb, 0x2c, 0xca, 0xaf,
+	0xd2, 0xf1, 0xc9, 0x9e, 0x7d, 0x9d, 0x04, 0xf3, 0xe8, 0x06, 0x0b, 0xc1, 0x26, 0x68, 0x08, 0xf6,
+	0x96, 0x24, 0xfc, 0xf2, 0xd6, 0xe4, 0xc0, 0x8b, 0x56, 0x45, 0xa3, 0x33, 0xaf, 0xbd, 0x32, 0x49,
+	0x5f, 0x48, 0xf6, 0x81, 0x6e, 0x02, 0x9c, 0x38, 0x91, 0xc3, 0x85, 0xac, 0x56, 0xf0, 0x83, 0x49,
+	0xc8, 0x90, 0xc2, 0x4d, 0x01, 0xf7, 0x35, 0x07, 0x72, 0xdf, 0x68, 0xc4, 0xdc, 0xa5, 0x07, 0x18,
+	0x39, 0x04, 0xbf, 0x05, 0x7a, 0xd4, 0x8f, 0x02, 0xdc, 0x8e, 0xb1, 0xdd, 0xb2, 0xfb, 0xac, 0xd4,
+	0x8a, 0x33, 0xbf, 0x97, 0xc8, 0xf0, 0x72, 0x8a, 0x71, 0x2f, 0x41, 0x60, 0x6e, 0xf2, 0x1a, 0xd4,
+	0x9d, 0x5e, 0xe0, 0x87, 0x42, 0x8e, 0xb9, 0xc6, 0x06, 0xf6, 0x6d, 0x23, 0x86, 0x66, 0xba, 0xa1,
+	0x11, 0x24, 0xb5, 0x5c, 0x14, 0x2f, 0x73, 0x51, 0x64, 0xce, 0xeb, 0x72, 0x31, 0x1e, 0x13, 0x65,
+	0x70, 0x19, 0x16, 0x73, 0xab, 0x72, 0xe1, 0xbb, 0x9d, 0x00, 0x46, 0xaa, 0x76, 0x67, 0x6e, 0x66,
+	0x52, 0xb4, 0x36, 0x56, 0x60, 0x29, 0x4f, 0x20, 0xab, 0x7f, 0xa7, 0x90, 0xd7, 0x56, 0xff, 0x56,
+	0x53, 0xe4, 0x2b, 0x1a, 0xb0, 0xf9, 0xb9, 0x15, 0xb7, 0x8f, 0x49, 0x94, 0x85, 0x3d, 0x9b, 0xb6,
+	0x8a, 0x74, 0xfb, 0xa1, 0xb8, 0xac, 0xf1, 0x4b, 0x0d, 0xde, 0x18, 0x80, 0xc4, 0x25, 0x44, 0x60,
+	0xbb, 0x26, 0xb3, 0xfd, 0x10, 0x16, 0x8f, 0xd8, 0xcc, 0x56, 0x5b, 0x9c, 0xca, 0x25, 0xf2, 0x7c,
+	0x2e, 0xd8, 0x2b, 0xac, 0xd0, 0x3c, 0x52, 0x8c, 0x1a, 0x1f, 0xc1, 0x6a, 0xba, 0xa9, 0x1f, 0x14,
+	0xa3, 0x7e, 0x09, 0xba, 0x8a, 0xd0, 0xeb, 0x0a, 0x51, 0x7f, 0xaf, 0x41, 0xe3, 0x09, 0x0e, 0x4f,
+	0x9c, 0x36, 0xfe, 0x2c, 0x88, 0x23, 0x72, 0x65, 0xc4, 0x3b, 0x17, 0x79, 0x55, 0x31, 0x89, 0xc3,
+	0xfe, 0x8c, 0xb3, 0xeb, 0x5d, 0x58, 0xcc, 0xd2, 0xd0, 0xad, 0x63, 0x6c, 0xd9, 0x38, 0x14, 0x32,
+	0xf5, 0x28, 0xcd, 0x48, 0x3f, 0xa4, 0xa0, 0x47, 0xf8, 0x0c, 0x5d, 0x85, 0x66, 0x9a, 0x9a, 0x16,
+	0x67, 0x24, 0x99, 0x76, 0x9e, 0xa5, 0xce, 0x26, 0x5c, 0x84, 0xd9, 0xe3, 0x38, 0x0e, 0x44, 0x5c,
+	0x5e, 0xb0, 0x27, 0xc3, 0x29, 0x9e, 0xf1, 0xef, 0x00, 0x0f, 0xd3, 0x01, 0x85, 0x71, 0x69, 0x8a,
+	0xc6, 0xa5, 0xce, 0xcd, 0xc8, 0xce, 0x9f, 0xde, 0x82, 0xa9, 0x03, 0xc2, 0x1d, 0x7e, 0x6e, 0x64,
+	0xc2, 0xb4, 0xd4, 0xf6, 0x85, 0xc4, 0x3b, 0x57, 0xf5, 0x9f, 0xe9, 0x9b, 0xe5, 0x08, 0xfc, 0x62,
+	0xf6, 0x01, 0xb2, 0x16, 0x2e, 0xb4, 0x5e, 0xc0, 0x17, 0x22, 0x40, 0xfd, 0x5c, 0x09, 0x94, 0x93,
+	0xb2, 0x61, 0x41, 0xd1, 0xad, 0x85, 0x2e, 0x48, 0x4f, 0x7c, 0x59, 0x5f, 0x98, 0x7e, 0x71, 0x18,
+	0x1a, 0x5f, 0xe5, 0x31, 0x34, 0x84, 0x4e, 0x2c, 0x24, 0xee, 0xa9, 0xd8, 0xcb, 0xa5, 0x6f, 0x94,
+	0x81, 0x39, 0xb5, 0xcf, 0x60, 0x4a, 0xec, 0x62, 0x42, 0x12, 0x7e, 0xb1, 0x67, 0x4b, 0x3f, 0x5f,
+	0x0a, 0xcf, 0xf8, 0x99, 0x35, 0x16, 0x49, 0xfc, 0x2c, 0xf4, 0x34, 0x49, 0xfc, 0x54, 0x74, 0x23,
+	0xa5, 0xa4, 0x88, 0xdb, 0xa1, 0x20, 0x25, 0xb8, 0x85, 0x0a, 0x52, 0x92, 0x4b, 0xf3, 0x14, 0x66,
+	0xe4, 0x5e, 0x21, 0xb4, 0x99, 0x0f, 0xed, 0xf2, 0x5d, 0x47, 0xfa, 0x1b, 0x03, 0x30, 0x38, 0xd9,
+	0x2e, 0x34, 0x55, 0x6d, 0x41, 0xe8, 0xa2, 0x6a, 0x6a, 0xd1, 0x12, 0xeb, 0x6f, 0x0f, 0xc5, 0xcb,
+	0x2e, 0x5d, 0xe8, 0x24, 0x92, 0x2e, 0xbd, 0xd8, 0x77, 0x24, 0x5d, 0xba, 0xa2, 0x01, 0x89, 0x52,
+	0xcb, 0xba, 0x87, 0x64, 0x6a, 0x85, 0xc6, 0x24, 0x99, 0x5a, 0xb1, 0xe9, 0x88, 0x5c, 0x53, 0xd6,
+	0x5f, 0x24, 0x5d, 0x53, 0xa1, 0x17, 0x49, 0xba, 0x26, 0x45, 0x53, 0xd2, 0x73, 0x98, 0xcd, 0xf5,
+	0xf5, 0x20, 0xf1, 0x16, 0xd4, 0x5d, 0x48, 0xba, 0x31, 0x08, 0x85, 0x53, 0xee, 0x00, 0x2a, 0x56,
+	0x76, 0x91, 0xe8, 0x6d, 0x95, 0x16, 0x9e, 0xf5, 0x0b, 0x43, 0xb0, 0xf8, 0x1b, 0x58, 0xf9, 0xf9,
+	0x98, 0x86, 0x3e, 0x87, 0x69, 0xa9, 0xef, 0x46, 0x32, 0x51, 0xaa, 0x56, 0x1f, 0xc9, 0x44, 0x29,
+	0x5b, 0x76, 0x18, 0x61, 0x07, 0x16, 0x14, 0xcd, 0x2d, 0x28, 0xbf, 0x37, 0x75, 0xa7, 0x8d, 0x64,
+	0x5c, 0x06, 0xf4, 0xc8, 0xb0, 0xa5, 0xbe, 0x80, 0x19, 0xb9, 0xe1, 0x04, 0x6d, 0x16, 0xa7, 0xcb,
+	0x3d, 0x31, 0x92, 0xb2, 0xa8, 0xbb, 0x55, 0x18, 0xed, 0x47, 0x50, 0x4f, 0x1b, 0x4a, 0xd0, 0x5a,
+	0x6e, 0x92, 0xd8, 0x7a, 0xa2, 0xaf, 0xab, 0x81, 0x0a, 0x66, 0xa7, 0xbd, 0x24, 0x05, 0x66, 0xe7,
+	0xbb, 0x4f, 0x0a, 0xcc, 0x2e, 0xb4, 0xa1, 0x30, 0xc2, 0x5f, 0xb2, 0x9a, 0xbb, 0xd0, 0xfe, 0x81,
+	0xf2, 0x07, 0x2c, 0x76, 0x9e, 0x48, 0x72, 0x58, 0xd2, 0x3d, 0x22, 0x31, 0x38, 0xeb, 0xc7, 0x28,
+	0x30, 0xb8, 0xd0, 0x2a, 0x52, 0x60, 0x70, 0xb1, 0x99, 0x83, 0xd1, 0x7e, 0x08, 0xb5, 0xa4, 0x9a,
+	0x8f, 0xf4, 0xdc, 0x1c, 0xf1, 0xc2, 0xd6, 0x94, 0x30, 0x91, 0xd2, 0x73, 0x98, 0xcd, 0x95, 0xb8,
+	0x25, 0x26, 0xa8, 0x4b, 0xfb, 0x12, 0x13, 0xca, 0x2a, 0xe4, 0x16, 0xa0, 0x62, 0x4d, 0x58, 0x52,
+	0xc6, 0xd2, 0x32, 0xb3, 0xa4, 0x8c, 0xe5, 0x85, 0x65, 0xf4, 0x9f, 0xd0, 0x10, 0x2a, 0xc5, 0x92,
+	0x89, 0x2b, 0x16, 0x9c, 0x25, 0x13, 0xa7, 0x28, 0x30, 0x33, 0x7e, 0x7c, 0x0a, 0x90, 0xd5, 0x82,
+	0x25, 0x3b, 0x57, 0x28, 0x28, 0xeb, 0xe7, 0x4a, 0xa0, 0x39, 0x8d, 0x56, 0x14, 0x35, 0x24, 0x8d,
+	0x2e, 0x2f, 0x9f, 0x48, 0x1a, 0x3d, 0xa0, 0x36, 0x92, 0xca, 0x73, 0xae, 0x84, 0x2c, 0x5d, 0xa5,
+	0xba, 0x40, 0x2d, 0x5d, 0x65, 0x49, 0x05, 0x9a, 0x91, 0x77, 0x85, 0x0a, 0x98, 0x60, 0x56, 0xd0,
+	0x45, 0x15, 0x81, 0x62, 0xae, 0x40, 0x7a, 0x06, 0x07, 0xd5, 0x8f, 0xd9, 0x6a, 0xff, 0x0d, 0x73,
+	0xf9, 0x12, 0x2f, 0x52, 0x6e, 0x55, 0x2e, 0x19, 0xeb, 0x6f, 0x0e, 0xc4, 0x11, 0x57, 0xe8, 0x24,
+	0x05, 0x1e, 0xb1, 0xfc, 0x29, 0xc9, 0x67, 0x69, 0x19, 0x56, 0xbf, 0x30, 0x52, 0x0d, 0x35, 0xb5,
+	0x5f, 0x52, 0x05, 0x52, 0xb2, 0x5f, 0xaa, 0x32, 0xa8, 0x64, 0xbf, 0x94, 0xc5, 0xcb, 0x22, 0x61,
+	0x7a, 0x13, 0x4a, 0xc2, 0xe2, 0x15, 0x6c, 0x96, 0x23, 0xa8, 0x6f, 0x5a, 0x2a, 0xfa, 0xa9, 0x6e,
+	0x5a, 0x55, 0x83, 0x54, 0xdd, 0xb4, 0xb2, 0xe6, 0x98, 0x69, 0x5c, 0x5a, 0x68, 0x93, 0x35, 0x2e,
+	0x5f, 0xad, 0x93, 0x35, 0xae, 0x50, 0x9d, 0x63, 0xf4, 0x1e, 0x40, 0x3d, 0xad, 0x91, 0x49, 0x8f,
+	0x4f, 0xbe, 0x26, 0x27, 0x3d, 0x3e, 0xc5, 0xb2, 0xda, 0x1e, 0xd4, 0x92, 0x52, 0x98, 0x64, 0x63,
+	0x73, 0x95, 0x34, 0xc9, 0xc6, 0xe6, 0x6b, 0x67, 0xe8, 0x29, 0x34, 0x84, 0x1a, 0x95, 0x64, 0xa1,
+	0x8a, 0x25, 0x35, 0xc9, 0x42, 0x29, 0x4a, 0x5b, 0xf4, 0x7c, 0x97, 0xb4, 0x6b, 0x1a, 0x89, 0x91,
+	0xa4, 0xe2, 0x93, 0x74, 0xf5, 0xaa, 0xba, 0x96, 0x74, 0xf5, 0xca, 0xba, 0x15, 0xf1, 0xf0, 0xb2,
+	0x8a, 0x93, 0x74, 0x0f, 0x85, 0x72, 0x96, 0x7e, 0xae, 0x04, 0x9a, 0xc5, 0x1b, 0x62, 0xd9, 0x08,
+	0xc9, 0xe7, 0x2a, 0x54, 0xa7, 0xf4, 0xf3, 0xa5, 0xf0, 0xcc, 0x97, 0x15, 0x6a, 0x42, 0x39, 0x36,
+	0xe6, 0xeb, 0x50, 0xfa, 0x46, 0x19, 0x98, 0x53, 0x33, 0x61, 0x5a, 0x2a, 0xc6, 0x48, 0xdc, 0x53,
+	0xd5, 0x98, 0x24, 0xee, 0x29, 0xeb, 0x38, 0x84, 0x7b, 0x59, 0xcd, 0x40, 0xe2, 0x5e, 0xa1, 0x76,
+	0x22, 0x71, 0x4f, 0x51, 0x68, 0x48, 0x49, 0x15, 0x14, 0xa2, 0x90, 0x28, 0x57, 0x90, 0x92, 0x22,
+	0xa2, 0x07, 0x50, 0x4f, 0xf3, 0x52, 0x92, 0x2e, 0xe4, 0xb3, 0x8e, 0xfa, 0xba, 0x1a, 0x98, 0x85,
+	0x40, 0xaa, 0x1c, 0xab, 0x64, 0x11, 0x06, 0xe4, 0x89, 0xf5, 0xb7, 0x87, 0xe2, 0x65, 0x57, 0x23,
+	0x25, 0xd2, 0xa4, 0xab, 0x51, 0x25, 0xf6, 0xa4, 0xab, 0x51, 0xe6, 0xe0, 0x48, 0x58, 0x28, 0xa7,
+	0xd0, 0x50, 0x71, 0xce, 0xa0, 0xb0, 0x50, 0x9d, 0x7f, 0xcb, 0x78, 0x32, 0x20, 0x2c, 0x1c, 0x90,
+	0xa0, 0x53, 0xf0, 0xa4, 0x24, 0x2c, 0xfc, 0x4a, 0x48, 0x5e, 0xe5, 0x33, 0x5b, 0xe8, 0xb2, 0x40,
+	0x65, 0x58, 0x72, 0x4e, 0xbf, 0x32, 0x1a, 0xb2, 0x60, 0x6d, 0xae, 0x69, 0xe8, 0x18, 0x50, 0x31,
+	0xe5, 0x25, 0xbd, 0x93, 0xa5, 0xa9, 0x35, 0xe9, 0x9d, 0x2c, 0xcf, 0x9b, 0xf1, 0x95, 0xf4, 0xbd,
+	0x9f, 0x7d, 0xbd, 0x79, 0x1b, 0xcd, 0xd1, 0x29, 0x5b, 0x56, 0x3f, 0x3e, 0xde, 0xa2, 0x49, 0x2a,
+	0x7d, 0x96, 0x8d, 0x04, 0xce, 0x29, 0x1b, 0x30, 0x16, 0xd9, 0xc0, 0x71, 0x1c, 0x07, 0x5b, 0x2c,
+	0x2b, 0xb5, 0x75, 0xe4, 0x78, 0xb5, 0xff, 0xff, 0xc7, 0x9f, 0xeb, 0x1f, 0x74, 0x01, 0x51, 0x68,
+	0x2b, 0x62, 0xf9, 0xa4, 0x96, 0x4f, 0x13, 0x69, 0x85, 0x4c, 0x76, 0x96, 0x66, 0x73, 0x7c, 0x2f,
+	0x5a, 0xf9, 0xbf, 0xaf, 0x59, 0x9d, 0x6c, 0x49, 0xd4, 0xf4, 0x2c, 0x13, 0x67, 0xb2, 0x5d, 0x09,
+	0x23, 0x77, 0xb7, 0x60, 0xda, 0x0f, 0xbb, 0x19, 0xfa, 0x81, 0xf6, 0xc5, 0xb2, 0xe2, 0x1f, 0x28,
+	0x6f, 0x59, 0x81, 0xf3, 0x57, 0x4d, 0x3b, 0x9a, 0xa0, 0x2b, 0xbf, 0xf7, 0xcf, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xf9, 0x6c, 0xb7, 0x90, 0xd9, 0x39, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2868,15 +5556,32 @@ const _ = grpc.SupportPackageIsVersion4
 type PixurServiceClient interface {
 	AddPicComment(ctx context.Context, in *AddPicCommentRequest, opts ...grpc.CallOption) (*AddPicCommentResponse, error)
 	AddPicTags(ctx context.Context, in *AddPicTagsRequest, opts ...grpc.CallOption) (*AddPicTagsResponse, error)
+	CancelBackgroundJob(ctx context.Context, in *CancelBackgroundJobRequest, opts ...grpc.CallOption) (*CancelBackgroundJobResponse, error)
+	ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	DeleteTagAlias(ctx context.Context, in *DeleteTagAliasRequest, opts ...grpc.CallOption) (*DeleteTagAliasResponse, error)
+	DeleteTagImplication(ctx context.Context, in *DeleteTagImplicationRequest, opts ...grpc.CallOption) (*DeleteTagImplicationResponse, error)
 	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error)
+	DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error)
+	EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error)
+	ExchangeIdToken(ctx context.Context, in *ExchangeIdTokenRequest, opts ...grpc.CallOption) (*ExchangeIdTokenResponse, error)
+	FindBackgroundJobs(ctx context.Context, in *FindBackgroundJobsRequest, opts ...grpc.CallOption) (*FindBackgroundJobsResponse, error)
 	FindIndexPics(ctx context.Context, in *FindIndexPicsRequest, opts ...grpc.CallOption) (*FindIndexPicsResponse, error)
 	FindPicCommentVotes(ctx context.Context, in *FindPicCommentVotesRequest, opts ...grpc.CallOption) (*FindPicCommentVotesResponse, error)
+	FindPicsByTags(ctx context.Context, in *FindPicsByTagsRequest, opts ...grpc.CallOption) (*FindPicsByTagsResponse, error)
+	FindRoles(ctx context.Context, in *FindRolesRequest, opts ...grpc.CallOption) (*FindRolesResponse, error)
 	FindSchedPics(ctx context.Context, in *FindSchedPicsRequest, opts ...grpc.CallOption) (*FindSchedPicsResponse, error)
 	FindSimilarPics(ctx context.Context, in *FindSimilarPicsRequest, opts ...grpc.CallOption) (*FindSimilarPicsResponse, error)
 	FindUserEvents(ctx context.Context, in *FindUserEventsRequest, opts ...grpc.CallOption) (*FindUserEventsResponse, error)
+	FindTags(ctx context.Context, in *FindTagsRequest, opts ...grpc.CallOption) (*FindTagsResponse, error)
 	GetRefreshToken(ctx context.Context, in *GetRefreshTokenRequest, opts ...grpc.CallOption) (*GetRefreshTokenResponse, error)
 	IncrementViewCount(ctx context.Context, in *IncrementViewCountRequest, opts ...grpc.CallOption) (*IncrementViewCountResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error)
+	LookupBackgroundJob(ctx context.Context, in *LookupBackgroundJobRequest, opts ...grpc.CallOption) (*LookupBackgroundJobResponse, error)
+	LookupPicByHash(ctx context.Context, in *LookupPicByHashRequest, opts ...grpc.CallOption) (*LookupPicByHashResponse, error)
 	LookupPicCommentVote(ctx context.Context, in *LookupPicCommentVoteRequest, opts ...grpc.CallOption) (*LookupPicCommentVoteResponse, error)
 	LookupPicDetails(ctx context.Context, in *LookupPicDetailsRequest, opts ...grpc.CallOption) (*LookupPicDetailsResponse, error)
 	LookupPicExtension(ctx context.Context, in *LookupPicExtensionRequest, opts ...grpc.CallOption) (*LookupPicExtensionResponse, error)
@@ -2884,14 +5589,23 @@ type PixurServiceClient interface {
 	LookupPicVote(ctx context.Context, in *LookupPicVoteRequest, opts ...grpc.CallOption) (*LookupPicVoteResponse, error)
 	LookupPublicUserInfo(ctx context.Context, in *LookupPublicUserInfoRequest, opts ...grpc.CallOption) (*LookupPublicUserInfoResponse, error)
 	LookupUser(ctx context.Context, in *LookupUserRequest, opts ...grpc.CallOption) (*LookupUserResponse, error)
+	MergePics(ctx context.Context, in *MergePicsRequest, opts ...grpc.CallOption) (*MergePicsResponse, error)
 	PurgePic(ctx context.Context, in *PurgePicRequest, opts ...grpc.CallOption) (*PurgePicResponse, error)
 	ReadPicFile(ctx context.Context, opts ...grpc.CallOption) (PixurService_ReadPicFileClient, error)
+	RemovePicTags(ctx context.Context, in *RemovePicTagsRequest, opts ...grpc.CallOption) (*RemovePicTagsResponse, error)
+	RestorePic(ctx context.Context, in *RestorePicRequest, opts ...grpc.CallOption) (*RestorePicResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
 	SoftDeletePic(ctx context.Context, in *SoftDeletePicRequest, opts ...grpc.CallOption) (*SoftDeletePicResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	UpsertPic(ctx context.Context, in *UpsertPicRequest, opts ...grpc.CallOption) (*UpsertPicResponse, error)
 	UpsertPicCommentVote(ctx context.Context, in *UpsertPicCommentVoteRequest, opts ...grpc.CallOption) (*UpsertPicCommentVoteResponse, error)
 	UpsertPicVote(ctx context.Context, in *UpsertPicVoteRequest, opts ...grpc.CallOption) (*UpsertPicVoteResponse, error)
+	UpsertTagAlias(ctx context.Context, in *UpsertTagAliasRequest, opts ...grpc.CallOption) (*UpsertTagAliasResponse, error)
+	UpsertTagImplication(ctx context.Context, in *UpsertTagImplicationRequest, opts ...grpc.CallOption) (*UpsertTagImplicationResponse, error)
 	WatchBackendConfiguration(ctx context.Context, in *WatchBackendConfigurationRequest, opts ...grpc.CallOption) (PixurService_WatchBackendConfigurationClient, error)
+	WatchBackgroundJob(ctx context.Context, in *WatchBackgroundJobRequest, opts ...grpc.CallOption) (PixurService_WatchBackgroundJobClient, error)
 }
 
 type pixurServiceClient struct {
@@ -2920,6 +5634,42 @@ func (c *pixurServiceClient) AddPicTags(ctx context.Context, in *AddPicTagsReque
 	return out, nil
 }
 
+func (c *pixurServiceClient) CancelBackgroundJob(ctx context.Context, in *CancelBackgroundJobRequest, opts ...grpc.CallOption) (*CancelBackgroundJobResponse, error) {
+	out := new(CancelBackgroundJobResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CancelBackgroundJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error) {
+	out := new(ConfirmTotpResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/ConfirmTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
 	out := new(CreateUserResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/CreateUser", in, out, opts...)
@@ -2929,6 +5679,24 @@ func (c *pixurServiceClient) CreateUser(ctx context.Context, in *CreateUserReque
 	return out, nil
 }
 
+func (c *pixurServiceClient) DeleteTagAlias(ctx context.Context, in *DeleteTagAliasRequest, opts ...grpc.CallOption) (*DeleteTagAliasResponse, error) {
+	out := new(DeleteTagAliasResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteTagAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) DeleteTagImplication(ctx context.Context, in *DeleteTagImplicationRequest, opts ...grpc.CallOption) (*DeleteTagImplicationResponse, error) {
+	out := new(DeleteTagImplicationResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteTagImplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error) {
 	out := new(DeleteTokenResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DeleteToken", in, out, opts...)
@@ -2938,6 +5706,42 @@ func (c *pixurServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenReq
 	return out, nil
 }
 
+func (c *pixurServiceClient) DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...grpc.CallOption) (*DisableTotpResponse, error) {
+	out := new(DisableTotpResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/DisableTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error) {
+	out := new(EnrollTotpResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/EnrollTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) ExchangeIdToken(ctx context.Context, in *ExchangeIdTokenRequest, opts ...grpc.CallOption) (*ExchangeIdTokenResponse, error) {
+	out := new(ExchangeIdTokenResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/ExchangeIdToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindBackgroundJobs(ctx context.Context, in *FindBackgroundJobsRequest, opts ...grpc.CallOption) (*FindBackgroundJobsResponse, error) {
+	out := new(FindBackgroundJobsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindBackgroundJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) FindIndexPics(ctx context.Context, in *FindIndexPicsRequest, opts ...grpc.CallOption) (*FindIndexPicsResponse, error) {
 	out := new(FindIndexPicsResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindIndexPics", in, out, opts...)
@@ -2956,6 +5760,24 @@ func (c *pixurServiceClient) FindPicCommentVotes(ctx context.Context, in *FindPi
 	return out, nil
 }
 
+func (c *pixurServiceClient) FindPicsByTags(ctx context.Context, in *FindPicsByTagsRequest, opts ...grpc.CallOption) (*FindPicsByTagsResponse, error) {
+	out := new(FindPicsByTagsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindPicsByTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) FindRoles(ctx context.Context, in *FindRolesRequest, opts ...grpc.CallOption) (*FindRolesResponse, error) {
+	out := new(FindRolesResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) FindSchedPics(ctx context.Context, in *FindSchedPicsRequest, opts ...grpc.CallOption) (*FindSchedPicsResponse, error) {
 	out := new(FindSchedPicsResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindSchedPics", in, out, opts...)
@@ -2983,6 +5805,15 @@ func (c *pixurServiceClient) FindUserEvents(ctx context.Context, in *FindUserEve
 	return out, nil
 }
 
+func (c *pixurServiceClient) FindTags(ctx context.Context, in *FindTagsRequest, opts ...grpc.CallOption) (*FindTagsResponse, error) {
+	out := new(FindTagsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/FindTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) GetRefreshToken(ctx context.Context, in *GetRefreshTokenRequest, opts ...grpc.CallOption) (*GetRefreshTokenResponse, error) {
 	out := new(GetRefreshTokenResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/GetRefreshToken", in, out, opts...)
@@ -3001,6 +5832,42 @@ func (c *pixurServiceClient) IncrementViewCount(ctx context.Context, in *Increme
 	return out, nil
 }
 
+func (c *pixurServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error) {
+	out := new(ListTokensResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/ListTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupBackgroundJob(ctx context.Context, in *LookupBackgroundJobRequest, opts ...grpc.CallOption) (*LookupBackgroundJobResponse, error) {
+	out := new(LookupBackgroundJobResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupBackgroundJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) LookupPicByHash(ctx context.Context, in *LookupPicByHashRequest, opts ...grpc.CallOption) (*LookupPicByHashResponse, error) {
+	out := new(LookupPicByHashResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) LookupPicCommentVote(ctx context.Context, in *LookupPicCommentVoteRequest, opts ...grpc.CallOption) (*LookupPicCommentVoteResponse, error) {
 	out := new(LookupPicCommentVoteResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/LookupPicCommentVote", in, out, opts...)
@@ -3064,6 +5931,15 @@ func (c *pixurServiceClient) LookupUser(ctx context.Context, in *LookupUserReque
 	return out, nil
 }
 
+func (c *pixurServiceClient) MergePics(ctx context.Context, in *MergePicsRequest, opts ...grpc.CallOption) (*MergePicsResponse, error) {
+	out := new(MergePicsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/MergePics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) PurgePic(ctx context.Context, in *PurgePicRequest, opts ...grpc.CallOption) (*PurgePicResponse, error) {
 	out := new(PurgePicResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/PurgePic", in, out, opts...)
@@ -3104,6 +5980,42 @@ func (x *pixurServiceReadPicFileClient) Recv() (*ReadPicFileResponse, error) {
 	return m, nil
 }
 
+func (c *pixurServiceClient) RemovePicTags(ctx context.Context, in *RemovePicTagsRequest, opts ...grpc.CallOption) (*RemovePicTagsResponse, error) {
+	out := new(RemovePicTagsResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RemovePicTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RestorePic(ctx context.Context, in *RestorePicRequest, opts ...grpc.CallOption) (*RestorePicResponse, error) {
+	out := new(RestorePicResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RestorePic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/RevokeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) SoftDeletePic(ctx context.Context, in *SoftDeletePicRequest, opts ...grpc.CallOption) (*SoftDeletePicResponse, error) {
 	out := new(SoftDeletePicResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/SoftDeletePic", in, out, opts...)
@@ -3113,6 +6025,15 @@ func (c *pixurServiceClient) SoftDeletePic(ctx context.Context, in *SoftDeletePi
 	return out, nil
 }
 
+func (c *pixurServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error) {
+	out := new(UpdateRoleResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpdateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
 	out := new(UpdateUserResponse)
 	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpdateUser", in, out, opts...)
@@ -3149,6 +6070,24 @@ func (c *pixurServiceClient) UpsertPicVote(ctx context.Context, in *UpsertPicVot
 	return out, nil
 }
 
+func (c *pixurServiceClient) UpsertTagAlias(ctx context.Context, in *UpsertTagAliasRequest, opts ...grpc.CallOption) (*UpsertTagAliasResponse, error) {
+	out := new(UpsertTagAliasResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertTagAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pixurServiceClient) UpsertTagImplication(ctx context.Context, in *UpsertTagImplicationRequest, opts ...grpc.CallOption) (*UpsertTagImplicationResponse, error) {
+	out := new(UpsertTagImplicationResponse)
+	err := c.cc.Invoke(ctx, "/pixur.api.PixurService/UpsertTagImplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pixurServiceClient) WatchBackendConfiguration(ctx context.Context, in *WatchBackendConfigurationRequest, opts ...grpc.CallOption) (PixurService_WatchBackendConfigurationClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[1], "/pixur.api.PixurService/WatchBackendConfiguration", opts...)
 	if err != nil {
@@ -3181,19 +6120,68 @@ func (x *pixurServiceWatchBackendConfigurationClient) Recv() (*WatchBackendConfi
 	return m, nil
 }
 
+func (c *pixurServiceClient) WatchBackgroundJob(ctx context.Context, in *WatchBackgroundJobRequest, opts ...grpc.CallOption) (PixurService_WatchBackgroundJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PixurService_serviceDesc.Streams[2], "/pixur.api.PixurService/WatchBackgroundJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pixurServiceWatchBackgroundJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PixurService_WatchBackgroundJobClient interface {
+	Recv() (*WatchBackgroundJobResponse, error)
+	grpc.ClientStream
+}
+
+type pixurServiceWatchBackgroundJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *pixurServiceWatchBackgroundJobClient) Recv() (*WatchBackgroundJobResponse, error) {
+	m := new(WatchBackgroundJobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PixurServiceServer is the server API for PixurService service.
 type PixurServiceServer interface {
 	AddPicComment(context.Context, *AddPicCommentRequest) (*AddPicCommentResponse, error)
 	AddPicTags(context.Context, *AddPicTagsRequest) (*AddPicTagsResponse, error)
+	CancelBackgroundJob(context.Context, *CancelBackgroundJobRequest) (*CancelBackgroundJobResponse, error)
+	ConfirmTotp(context.Context, *ConfirmTotpRequest) (*ConfirmTotpResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	DeleteTagAlias(context.Context, *DeleteTagAliasRequest) (*DeleteTagAliasResponse, error)
+	DeleteTagImplication(context.Context, *DeleteTagImplicationRequest) (*DeleteTagImplicationResponse, error)
 	DeleteToken(context.Context, *DeleteTokenRequest) (*DeleteTokenResponse, error)
+	DisableTotp(context.Context, *DisableTotpRequest) (*DisableTotpResponse, error)
+	EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error)
+	ExchangeIdToken(context.Context, *ExchangeIdTokenRequest) (*ExchangeIdTokenResponse, error)
+	FindBackgroundJobs(context.Context, *FindBackgroundJobsRequest) (*FindBackgroundJobsResponse, error)
 	FindIndexPics(context.Context, *FindIndexPicsRequest) (*FindIndexPicsResponse, error)
 	FindPicCommentVotes(context.Context, *FindPicCommentVotesRequest) (*FindPicCommentVotesResponse, error)
+	FindPicsByTags(context.Context, *FindPicsByTagsRequest) (*FindPicsByTagsResponse, error)
+	FindRoles(context.Context, *FindRolesRequest) (*FindRolesResponse, error)
 	FindSchedPics(context.Context, *FindSchedPicsRequest) (*FindSchedPicsResponse, error)
 	FindSimilarPics(context.Context, *FindSimilarPicsRequest) (*FindSimilarPicsResponse, error)
 	FindUserEvents(context.Context, *FindUserEventsRequest) (*FindUserEventsResponse, error)
+	FindTags(context.Context, *FindTagsRequest) (*FindTagsResponse, error)
 	GetRefreshToken(context.Context, *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error)
 	IncrementViewCount(context.Context, *IncrementViewCountRequest) (*IncrementViewCountResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error)
+	LookupBackgroundJob(context.Context, *LookupBackgroundJobRequest) (*LookupBackgroundJobResponse, error)
+	LookupPicByHash(context.Context, *LookupPicByHashRequest) (*LookupPicByHashResponse, error)
 	LookupPicCommentVote(context.Context, *LookupPicCommentVoteRequest) (*LookupPicCommentVoteResponse, error)
 	LookupPicDetails(context.Context, *LookupPicDetailsRequest) (*LookupPicDetailsResponse, error)
 	LookupPicExtension(context.Context, *LookupPicExtensionRequest) (*LookupPicExtensionResponse, error)
@@ -3201,14 +6189,23 @@ type PixurServiceServer interface {
 	LookupPicVote(context.Context, *LookupPicVoteRequest) (*LookupPicVoteResponse, error)
 	LookupPublicUserInfo(context.Context, *LookupPublicUserInfoRequest) (*LookupPublicUserInfoResponse, error)
 	LookupUser(context.Context, *LookupUserRequest) (*LookupUserResponse, error)
+	MergePics(context.Context, *MergePicsRequest) (*MergePicsResponse, error)
 	PurgePic(context.Context, *PurgePicRequest) (*PurgePicResponse, error)
 	ReadPicFile(PixurService_ReadPicFileServer) error
+	RemovePicTags(context.Context, *RemovePicTagsRequest) (*RemovePicTagsResponse, error)
+	RestorePic(context.Context, *RestorePicRequest) (*RestorePicResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
 	SoftDeletePic(context.Context, *SoftDeletePicRequest) (*SoftDeletePicResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	UpsertPic(context.Context, *UpsertPicRequest) (*UpsertPicResponse, error)
 	UpsertPicCommentVote(context.Context, *UpsertPicCommentVoteRequest) (*UpsertPicCommentVoteResponse, error)
 	UpsertPicVote(context.Context, *UpsertPicVoteRequest) (*UpsertPicVoteResponse, error)
+	UpsertTagAlias(context.Context, *UpsertTagAliasRequest) (*UpsertTagAliasResponse, error)
+	UpsertTagImplication(context.Context, *UpsertTagImplicationRequest) (*UpsertTagImplicationResponse, error)
 	WatchBackendConfiguration(*WatchBackendConfigurationRequest, PixurService_WatchBackendConfigurationServer) error
+	WatchBackgroundJob(*WatchBackgroundJobRequest, PixurService_WatchBackgroundJobServer) error
 }
 
 // UnimplementedPixurServiceServer can be embedded to have forward compatible implementations.
@@ -3221,18 +6218,54 @@ func (*UnimplementedPixurServiceServer) AddPicComment(ctx context.Context, req *
 func (*UnimplementedPixurServiceServer) AddPicTags(ctx context.Context, req *AddPicTagsRequest) (*AddPicTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPicTags not implemented")
 }
+func (*UnimplementedPixurServiceServer) CancelBackgroundJob(ctx context.Context, req *CancelBackgroundJobRequest) (*CancelBackgroundJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBackgroundJob not implemented")
+}
+func (*UnimplementedPixurServiceServer) ConfirmTotp(ctx context.Context, req *ConfirmTotpRequest) (*ConfirmTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotp not implemented")
+}
+func (*UnimplementedPixurServiceServer) CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (*UnimplementedPixurServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
 func (*UnimplementedPixurServiceServer) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
+func (*UnimplementedPixurServiceServer) DeleteTagAlias(ctx context.Context, req *DeleteTagAliasRequest) (*DeleteTagAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagAlias not implemented")
+}
+func (*UnimplementedPixurServiceServer) DeleteTagImplication(ctx context.Context, req *DeleteTagImplicationRequest) (*DeleteTagImplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagImplication not implemented")
+}
 func (*UnimplementedPixurServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*DeleteTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (*UnimplementedPixurServiceServer) DisableTotp(ctx context.Context, req *DisableTotpRequest) (*DisableTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
+func (*UnimplementedPixurServiceServer) EnrollTotp(ctx context.Context, req *EnrollTotpRequest) (*EnrollTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (*UnimplementedPixurServiceServer) ExchangeIdToken(ctx context.Context, req *ExchangeIdTokenRequest) (*ExchangeIdTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeIdToken not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindBackgroundJobs(ctx context.Context, req *FindBackgroundJobsRequest) (*FindBackgroundJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBackgroundJobs not implemented")
+}
 func (*UnimplementedPixurServiceServer) FindIndexPics(ctx context.Context, req *FindIndexPicsRequest) (*FindIndexPicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindIndexPics not implemented")
 }
 func (*UnimplementedPixurServiceServer) FindPicCommentVotes(ctx context.Context, req *FindPicCommentVotesRequest) (*FindPicCommentVotesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindPicCommentVotes not implemented")
 }
+func (*UnimplementedPixurServiceServer) FindPicsByTags(ctx context.Context, req *FindPicsByTagsRequest) (*FindPicsByTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPicsByTags not implemented")
+}
+func (*UnimplementedPixurServiceServer) FindRoles(ctx context.Context, req *FindRolesRequest) (*FindRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRoles not implemented")
+}
 func (*UnimplementedPixurServiceServer) FindSchedPics(ctx context.Context, req *FindSchedPicsRequest) (*FindSchedPicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindSchedPics not implemented")
 }
@@ -3242,12 +6275,27 @@ func (*UnimplementedPixurServiceServer) FindSimilarPics(ctx context.Context, req
 func (*UnimplementedPixurServiceServer) FindUserEvents(ctx context.Context, req *FindUserEventsRequest) (*FindUserEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserEvents not implemented")
 }
+func (*UnimplementedPixurServiceServer) FindTags(ctx context.Context, req *FindTagsRequest) (*FindTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTags not implemented")
+}
 func (*UnimplementedPixurServiceServer) GetRefreshToken(ctx context.Context, req *GetRefreshTokenRequest) (*GetRefreshTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRefreshToken not implemented")
 }
 func (*UnimplementedPixurServiceServer) IncrementViewCount(ctx context.Context, req *IncrementViewCountRequest) (*IncrementViewCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncrementViewCount not implemented")
 }
+func (*UnimplementedPixurServiceServer) ListApiKeys(ctx context.Context, req *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (*UnimplementedPixurServiceServer) ListTokens(ctx context.Context, req *ListTokensRequest) (*ListTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokens not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupBackgroundJob(ctx context.Context, req *LookupBackgroundJobRequest) (*LookupBackgroundJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupBackgroundJob not implemented")
+}
+func (*UnimplementedPixurServiceServer) LookupPicByHash(ctx context.Context, req *LookupPicByHashRequest) (*LookupPicByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPicByHash not implemented")
+}
 func (*UnimplementedPixurServiceServer) LookupPicCommentVote(ctx context.Context, req *LookupPicCommentVoteRequest) (*LookupPicCommentVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupPicCommentVote not implemented")
 }
@@ -3269,15 +6317,33 @@ func (*UnimplementedPixurServiceServer) LookupPublicUserInfo(ctx context.Context
 func (*UnimplementedPixurServiceServer) LookupUser(ctx context.Context, req *LookupUserRequest) (*LookupUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupUser not implemented")
 }
+func (*UnimplementedPixurServiceServer) MergePics(ctx context.Context, req *MergePicsRequest) (*MergePicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePics not implemented")
+}
 func (*UnimplementedPixurServiceServer) PurgePic(ctx context.Context, req *PurgePicRequest) (*PurgePicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgePic not implemented")
 }
 func (*UnimplementedPixurServiceServer) ReadPicFile(srv PixurService_ReadPicFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method ReadPicFile not implemented")
 }
+func (*UnimplementedPixurServiceServer) RemovePicTags(ctx context.Context, req *RemovePicTagsRequest) (*RemovePicTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePicTags not implemented")
+}
+func (*UnimplementedPixurServiceServer) RestorePic(ctx context.Context, req *RestorePicRequest) (*RestorePicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePic not implemented")
+}
+func (*UnimplementedPixurServiceServer) RevokeApiKey(ctx context.Context, req *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (*UnimplementedPixurServiceServer) RevokeToken(ctx context.Context, req *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
 func (*UnimplementedPixurServiceServer) SoftDeletePic(ctx context.Context, req *SoftDeletePicRequest) (*SoftDeletePicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SoftDeletePic not implemented")
 }
+func (*UnimplementedPixurServiceServer) UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
 func (*UnimplementedPixurServiceServer) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UpdateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
@@ -3290,208 +6356,523 @@ func (*UnimplementedPixurServiceServer) UpsertPicCommentVote(ctx context.Context
 func (*UnimplementedPixurServiceServer) UpsertPicVote(ctx context.Context, req *UpsertPicVoteRequest) (*UpsertPicVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertPicVote not implemented")
 }
+func (*UnimplementedPixurServiceServer) UpsertTagAlias(ctx context.Context, req *UpsertTagAliasRequest) (*UpsertTagAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTagAlias not implemented")
+}
+func (*UnimplementedPixurServiceServer) UpsertTagImplication(ctx context.Context, req *UpsertTagImplicationRequest) (*UpsertTagImplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTagImplication not implemented")
+}
 func (*UnimplementedPixurServiceServer) WatchBackendConfiguration(req *WatchBackendConfigurationRequest, srv PixurService_WatchBackendConfigurationServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchBackendConfiguration not implemented")
 }
+func (*UnimplementedPixurServiceServer) WatchBackgroundJob(req *WatchBackgroundJobRequest, srv PixurService_WatchBackgroundJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBackgroundJob not implemented")
+}
 
 func RegisterPixurServiceServer(s *grpc.Server, srv PixurServiceServer) {
 	s.RegisterService(&_PixurService_serviceDesc, srv)
 }
 
-func _PixurService_AddPicComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPicCommentRequest)
+func _PixurService_AddPicComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPicCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddPicComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddPicComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddPicComment(ctx, req.(*AddPicCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_AddPicTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPicTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).AddPicTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/AddPicTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).AddPicTags(ctx, req.(*AddPicTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CancelBackgroundJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBackgroundJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CancelBackgroundJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CancelBackgroundJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CancelBackgroundJob(ctx, req.(*CancelBackgroundJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_ConfirmTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).ConfirmTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/ConfirmTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).ConfirmTotp(ctx, req.(*ConfirmTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_DeleteTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeleteTagAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeleteTagAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeleteTagAlias(ctx, req.(*DeleteTagAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_DeleteTagImplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagImplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeleteTagImplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeleteTagImplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeleteTagImplication(ctx, req.(*DeleteTagImplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DeleteToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DeleteToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DeleteToken(ctx, req.(*DeleteTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/DisableTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).DisableTotp(ctx, req.(*DisableTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/EnrollTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).EnrollTotp(ctx, req.(*EnrollTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_ExchangeIdToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeIdTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).ExchangeIdToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/ExchangeIdToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).ExchangeIdToken(ctx, req.(*ExchangeIdTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindBackgroundJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBackgroundJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindBackgroundJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindBackgroundJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindBackgroundJobs(ctx, req.(*FindBackgroundJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindIndexPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindIndexPicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindIndexPics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindIndexPics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindIndexPics(ctx, req.(*FindIndexPicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindPicCommentVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPicCommentVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindPicCommentVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, req.(*FindPicCommentVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindPicsByTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPicsByTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).FindPicsByTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/FindPicsByTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).FindPicsByTags(ctx, req.(*FindPicsByTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_FindRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).AddPicComment(ctx, in)
+		return srv.(PixurServiceServer).FindRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/AddPicComment",
+		FullMethod: "/pixur.api.PixurService/FindRoles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).AddPicComment(ctx, req.(*AddPicCommentRequest))
+		return srv.(PixurServiceServer).FindRoles(ctx, req.(*FindRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_AddPicTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPicTagsRequest)
+func _PixurService_FindSchedPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSchedPicsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).AddPicTags(ctx, in)
+		return srv.(PixurServiceServer).FindSchedPics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/AddPicTags",
+		FullMethod: "/pixur.api.PixurService/FindSchedPics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).AddPicTags(ctx, req.(*AddPicTagsRequest))
+		return srv.(PixurServiceServer).FindSchedPics(ctx, req.(*FindSchedPicsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _PixurService_FindSimilarPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSimilarPicsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).CreateUser(ctx, in)
+		return srv.(PixurServiceServer).FindSimilarPics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/CreateUser",
+		FullMethod: "/pixur.api.PixurService/FindSimilarPics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(PixurServiceServer).FindSimilarPics(ctx, req.(*FindSimilarPicsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTokenRequest)
+func _PixurService_FindUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).DeleteToken(ctx, in)
+		return srv.(PixurServiceServer).FindUserEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/DeleteToken",
+		FullMethod: "/pixur.api.PixurService/FindUserEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).DeleteToken(ctx, req.(*DeleteTokenRequest))
+		return srv.(PixurServiceServer).FindUserEvents(ctx, req.(*FindUserEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindIndexPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindIndexPicsRequest)
+func _PixurService_FindTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindIndexPics(ctx, in)
+		return srv.(PixurServiceServer).FindTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindIndexPics",
+		FullMethod: "/pixur.api.PixurService/FindTags",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindIndexPics(ctx, req.(*FindIndexPicsRequest))
+		return srv.(PixurServiceServer).FindTags(ctx, req.(*FindTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindPicCommentVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindPicCommentVotesRequest)
+func _PixurService_GetRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefreshTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, in)
+		return srv.(PixurServiceServer).GetRefreshToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindPicCommentVotes",
+		FullMethod: "/pixur.api.PixurService/GetRefreshToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindPicCommentVotes(ctx, req.(*FindPicCommentVotesRequest))
+		return srv.(PixurServiceServer).GetRefreshToken(ctx, req.(*GetRefreshTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindSchedPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindSchedPicsRequest)
+func _PixurService_IncrementViewCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementViewCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindSchedPics(ctx, in)
+		return srv.(PixurServiceServer).IncrementViewCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindSchedPics",
+		FullMethod: "/pixur.api.PixurService/IncrementViewCount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindSchedPics(ctx, req.(*FindSchedPicsRequest))
+		return srv.(PixurServiceServer).IncrementViewCount(ctx, req.(*IncrementViewCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindSimilarPics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindSimilarPicsRequest)
+func _PixurService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindSimilarPics(ctx, in)
+		return srv.(PixurServiceServer).ListApiKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindSimilarPics",
+		FullMethod: "/pixur.api.PixurService/ListApiKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindSimilarPics(ctx, req.(*FindSimilarPicsRequest))
+		return srv.(PixurServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_FindUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserEventsRequest)
+func _PixurService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).FindUserEvents(ctx, in)
+		return srv.(PixurServiceServer).ListTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/FindUserEvents",
+		FullMethod: "/pixur.api.PixurService/ListTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).FindUserEvents(ctx, req.(*FindUserEventsRequest))
+		return srv.(PixurServiceServer).ListTokens(ctx, req.(*ListTokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_GetRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRefreshTokenRequest)
+func _PixurService_LookupBackgroundJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupBackgroundJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).GetRefreshToken(ctx, in)
+		return srv.(PixurServiceServer).LookupBackgroundJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/GetRefreshToken",
+		FullMethod: "/pixur.api.PixurService/LookupBackgroundJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).GetRefreshToken(ctx, req.(*GetRefreshTokenRequest))
+		return srv.(PixurServiceServer).LookupBackgroundJob(ctx, req.(*LookupBackgroundJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PixurService_IncrementViewCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncrementViewCountRequest)
+func _PixurService_LookupPicByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPicByHashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PixurServiceServer).IncrementViewCount(ctx, in)
+		return srv.(PixurServiceServer).LookupPicByHash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pixur.api.PixurService/IncrementViewCount",
+		FullMethod: "/pixur.api.PixurService/LookupPicByHash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PixurServiceServer).IncrementViewCount(ctx, req.(*IncrementViewCountRequest))
+		return srv.(PixurServiceServer).LookupPicByHash(ctx, req.(*LookupPicByHashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3622,6 +7003,24 @@ func _PixurService_LookupUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PixurService_MergePics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).MergePics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/MergePics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).MergePics(ctx, req.(*MergePicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PixurService_PurgePic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgePicRequest)
 	if err := dec(in); err != nil {
@@ -3666,6 +7065,78 @@ func (x *pixurServiceReadPicFileServer) Recv() (*ReadPicFileRequest, error) {
 	return m, nil
 }
 
+func _PixurService_RemovePicTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePicTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).RemovePicTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/RemovePicTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).RemovePicTags(ctx, req.(*RemovePicTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_RestorePic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).RestorePic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/RestorePic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).RestorePic(ctx, req.(*RestorePicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/RevokeApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PixurService_SoftDeletePic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SoftDeletePicRequest)
 	if err := dec(in); err != nil {
@@ -3684,6 +7155,24 @@ func _PixurService_SoftDeletePic_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PixurService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/UpdateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PixurService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
@@ -3756,6 +7245,42 @@ func _PixurService_UpsertPicVote_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PixurService_UpsertTagAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTagAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).UpsertTagAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/UpsertTagAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).UpsertTagAlias(ctx, req.(*UpsertTagAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PixurService_UpsertTagImplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTagImplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PixurServiceServer).UpsertTagImplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pixur.api.PixurService/UpsertTagImplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PixurServiceServer).UpsertTagImplication(ctx, req.(*UpsertTagImplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PixurService_WatchBackendConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(WatchBackendConfigurationRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3777,6 +7302,27 @@ func (x *pixurServiceWatchBackendConfigurationServer) Send(m *WatchBackendConfig
 	return x.ServerStream.SendMsg(m)
 }
 
+func _PixurService_WatchBackgroundJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBackgroundJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PixurServiceServer).WatchBackgroundJob(m, &pixurServiceWatchBackgroundJobServer{stream})
+}
+
+type PixurService_WatchBackgroundJobServer interface {
+	Send(*WatchBackgroundJobResponse) error
+	grpc.ServerStream
+}
+
+type pixurServiceWatchBackgroundJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *pixurServiceWatchBackgroundJobServer) Send(m *WatchBackgroundJobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _PixurService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pixur.api.PixurService",
 	HandlerType: (*PixurServiceServer)(nil),
@@ -3789,14 +7335,54 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddPicTags",
 			Handler:    _PixurService_AddPicTags_Handler,
 		},
+		{
+			MethodName: "CancelBackgroundJob",
+			Handler:    _PixurService_CancelBackgroundJob_Handler,
+		},
+		{
+			MethodName: "ConfirmTotp",
+			Handler:    _PixurService_ConfirmTotp_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _PixurService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _PixurService_CreateRole_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _PixurService_CreateUser_Handler,
 		},
+		{
+			MethodName: "DeleteTagAlias",
+			Handler:    _PixurService_DeleteTagAlias_Handler,
+		},
+		{
+			MethodName: "DeleteTagImplication",
+			Handler:    _PixurService_DeleteTagImplication_Handler,
+		},
 		{
 			MethodName: "DeleteToken",
 			Handler:    _PixurService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _PixurService_DisableTotp_Handler,
+		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _PixurService_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "ExchangeIdToken",
+			Handler:    _PixurService_ExchangeIdToken_Handler,
+		},
+		{
+			MethodName: "FindBackgroundJobs",
+			Handler:    _PixurService_FindBackgroundJobs_Handler,
+		},
 		{
 			MethodName: "FindIndexPics",
 			Handler:    _PixurService_FindIndexPics_Handler,
@@ -3805,6 +7391,14 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindPicCommentVotes",
 			Handler:    _PixurService_FindPicCommentVotes_Handler,
 		},
+		{
+			MethodName: "FindPicsByTags",
+			Handler:    _PixurService_FindPicsByTags_Handler,
+		},
+		{
+			MethodName: "FindRoles",
+			Handler:    _PixurService_FindRoles_Handler,
+		},
 		{
 			MethodName: "FindSchedPics",
 			Handler:    _PixurService_FindSchedPics_Handler,
@@ -3817,6 +7411,10 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserEvents",
 			Handler:    _PixurService_FindUserEvents_Handler,
 		},
+		{
+			MethodName: "FindTags",
+			Handler:    _PixurService_FindTags_Handler,
+		},
 		{
 			MethodName: "GetRefreshToken",
 			Handler:    _PixurService_GetRefreshToken_Handler,
@@ -3825,6 +7423,22 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IncrementViewCount",
 			Handler:    _PixurService_IncrementViewCount_Handler,
 		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _PixurService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _PixurService_ListTokens_Handler,
+		},
+		{
+			MethodName: "LookupBackgroundJob",
+			Handler:    _PixurService_LookupBackgroundJob_Handler,
+		},
+		{
+			MethodName: "LookupPicByHash",
+			Handler:    _PixurService_LookupPicByHash_Handler,
+		},
 		{
 			MethodName: "LookupPicCommentVote",
 			Handler:    _PixurService_LookupPicCommentVote_Handler,
@@ -3853,14 +7467,38 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupUser",
 			Handler:    _PixurService_LookupUser_Handler,
 		},
+		{
+			MethodName: "MergePics",
+			Handler:    _PixurService_MergePics_Handler,
+		},
 		{
 			MethodName: "PurgePic",
 			Handler:    _PixurService_PurgePic_Handler,
 		},
+		{
+			MethodName: "RemovePicTags",
+			Handler:    _PixurService_RemovePicTags_Handler,
+		},
+		{
+			MethodName: "RestorePic",
+			Handler:    _PixurService_RestorePic_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _PixurService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _PixurService_RevokeToken_Handler,
+		},
 		{
 			MethodName: "SoftDeletePic",
 			Handler:    _PixurService_SoftDeletePic_Handler,
 		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _PixurService_UpdateRole_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _PixurService_UpdateUser_Handler,
@@ -3877,6 +7515,14 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertPicVote",
 			Handler:    _PixurService_UpsertPicVote_Handler,
 		},
+		{
+			MethodName: "UpsertTagAlias",
+			Handler:    _PixurService_UpsertTagAlias_Handler,
+		},
+		{
+			MethodName: "UpsertTagImplication",
+			Handler:    _PixurService_UpsertTagImplication_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3890,6 +7536,11 @@ var _PixurService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _PixurService_WatchBackendConfiguration_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchBackgroundJob",
+			Handler:       _PixurService_WatchBackgroundJob_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
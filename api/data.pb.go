@@ -33,18 +33,22 @@ const (
 	DeletionReason_NONE DeletionReason = 1
 	// The pic is in violation of the rules.
 	DeletionReason_RULE_VIOLATION DeletionReason = 2
+	// The pic is a duplicate of another pic, and was merged into it.
+	DeletionReason_DUPLICATE DeletionReason = 3
 )
 
 var DeletionReason_name = map[int32]string{
 	0: "UNKNOWN",
 	1: "NONE",
 	2: "RULE_VIOLATION",
+	3: "DUPLICATE",
 }
 
 var DeletionReason_value = map[string]int32{
 	"UNKNOWN":        0,
 	"NONE":           1,
 	"RULE_VIOLATION": 2,
+	"DUPLICATE":      3,
 }
 
 func (x DeletionReason) String() string {
@@ -55,6 +59,44 @@ func (DeletionReason) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_871986018790d2fd, []int{0}
 }
 
+type BackendConfiguration_NearDuplicatePolicy_Action int32
+
+const (
+	BackendConfiguration_NearDuplicatePolicy_UNKNOWN BackendConfiguration_NearDuplicatePolicy_Action = 0
+	// near duplicates are uploaded as new pics.
+	BackendConfiguration_NearDuplicatePolicy_ALLOW BackendConfiguration_NearDuplicatePolicy_Action = 1
+	// near duplicates are rejected.
+	BackendConfiguration_NearDuplicatePolicy_REJECT BackendConfiguration_NearDuplicatePolicy_Action = 2
+	// near duplicates are added as a file source of the closest existing pic.
+	BackendConfiguration_NearDuplicatePolicy_MERGE BackendConfiguration_NearDuplicatePolicy_Action = 3
+	// near duplicates are uploaded as new pics, and flagged for moderator review.
+	BackendConfiguration_NearDuplicatePolicy_FLAG BackendConfiguration_NearDuplicatePolicy_Action = 4
+)
+
+var BackendConfiguration_NearDuplicatePolicy_Action_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ALLOW",
+	2: "REJECT",
+	3: "MERGE",
+	4: "FLAG",
+}
+
+var BackendConfiguration_NearDuplicatePolicy_Action_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ALLOW":   1,
+	"REJECT":  2,
+	"MERGE":   3,
+	"FLAG":    4,
+}
+
+func (x BackendConfiguration_NearDuplicatePolicy_Action) String() string {
+	return proto.EnumName(BackendConfiguration_NearDuplicatePolicy_Action_name, int32(x))
+}
+
+func (BackendConfiguration_NearDuplicatePolicy_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 1, 0}
+}
+
 type Capability_Cap int32
 
 const (
@@ -123,6 +165,25 @@ const (
 	Capability_PIC_COMMENT_VOTE_CREATE Capability_Cap = 28
 	// Can this user create arbitrary extension data on a comment vote?
 	Capability_PIC_COMMENT_VOTE_EXTENSION_CREATE Capability_Cap = 29
+	// Can this user remove tags from pics?
+	Capability_PIC_TAG_DELETE Capability_Cap = 30
+	// Can this user create and delete tag aliases?
+	Capability_TAG_ALIAS_UPDATE Capability_Cap = 31
+	// Can this user create and delete tag implications?
+	Capability_TAG_IMPLICATION_UPDATE Capability_Cap = 32
+	// Can this user merge duplicate pics?
+	Capability_PIC_MERGE Capability_Cap = 33
+	// Can this user restore soft deleted pics?
+	Capability_PIC_RESTORE Capability_Cap = 34
+	// Can this user see background jobs created by other users?
+	Capability_BACKGROUND_JOB_READ Capability_Cap = 35
+	// Can this user cancel background jobs created by other users?
+	Capability_BACKGROUND_JOB_CANCEL Capability_Cap = 36
+	// Can this user create and modify roles?  Assigning roles to users requires
+	// USER_UPDATE_CAPABILITY.
+	Capability_ROLE_UPDATE Capability_Cap = 37
+	// Can this user see private pics of other users?
+	Capability_PIC_READ_PRIVATE Capability_Cap = 38
 )
 
 var Capability_Cap_name = map[int32]string{
@@ -156,6 +217,15 @@ var Capability_Cap_name = map[int32]string{
 	27: "USER_READ_PIC_VOTE",
 	28: "PIC_COMMENT_VOTE_CREATE",
 	29: "PIC_COMMENT_VOTE_EXTENSION_CREATE",
+	30: "PIC_TAG_DELETE",
+	31: "TAG_ALIAS_UPDATE",
+	32: "TAG_IMPLICATION_UPDATE",
+	33: "PIC_MERGE",
+	34: "PIC_RESTORE",
+	35: "BACKGROUND_JOB_READ",
+	36: "BACKGROUND_JOB_CANCEL",
+	37: "ROLE_UPDATE",
+	38: "PIC_READ_PRIVATE",
 }
 
 var Capability_Cap_value = map[string]int32{
@@ -189,6 +259,15 @@ var Capability_Cap_value = map[string]int32{
 	"USER_READ_PIC_VOTE":                27,
 	"PIC_COMMENT_VOTE_CREATE":           28,
 	"PIC_COMMENT_VOTE_EXTENSION_CREATE": 29,
+	"PIC_TAG_DELETE":                    30,
+	"TAG_ALIAS_UPDATE":                  31,
+	"TAG_IMPLICATION_UPDATE":            32,
+	"PIC_MERGE":                         33,
+	"PIC_RESTORE":                       34,
+	"BACKGROUND_JOB_READ":               35,
+	"BACKGROUND_JOB_CANCEL":             36,
+	"ROLE_UPDATE":                       37,
+	"PIC_READ_PRIVATE":                  38,
 }
 
 func (x Capability_Cap) String() string {
@@ -199,6 +278,129 @@ func (Capability_Cap) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_871986018790d2fd, []int{1, 0}
 }
 
+type PicVisibility_Mode int32
+
+const (
+	// Anyone who can index pics can see the pic.
+	PicVisibility_PUBLIC PicVisibility_Mode = 0
+	// Only the uploader, and the listed users and roles can see the pic.
+	PicVisibility_PRIVATE PicVisibility_Mode = 1
+)
+
+var PicVisibility_Mode_name = map[int32]string{
+	0: "PUBLIC",
+	1: "PRIVATE",
+}
+
+var PicVisibility_Mode_value = map[string]int32{
+	"PUBLIC":  0,
+	"PRIVATE": 1,
+}
+
+func (x PicVisibility_Mode) String() string {
+	return proto.EnumName(PicVisibility_Mode_name, int32(x))
+}
+
+func (PicVisibility_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{3, 0}
+}
+
+type BackgroundJob_Type int32
+
+const (
+	BackgroundJob_UNKNOWN         BackgroundJob_Type = 0
+	BackgroundJob_THUMBNAIL       BackgroundJob_Type = 1
+	BackgroundJob_FETCH_URL       BackgroundJob_Type = 2
+	BackgroundJob_HARD_DELETE_PIC BackgroundJob_Type = 3
+)
+
+var BackgroundJob_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "THUMBNAIL",
+	2: "FETCH_URL",
+	3: "HARD_DELETE_PIC",
+}
+
+var BackgroundJob_Type_value = map[string]int32{
+	"UNKNOWN":         0,
+	"THUMBNAIL":       1,
+	"FETCH_URL":       2,
+	"HARD_DELETE_PIC": 3,
+}
+
+func (x BackgroundJob_Type) String() string {
+	return proto.EnumName(BackgroundJob_Type_name, int32(x))
+}
+
+func (BackgroundJob_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{4, 0}
+}
+
+type BackgroundJob_Status_State int32
+
+const (
+	BackgroundJob_Status_UNKNOWN   BackgroundJob_Status_State = 0
+	BackgroundJob_Status_PENDING   BackgroundJob_Status_State = 1
+	BackgroundJob_Status_RUNNING   BackgroundJob_Status_State = 2
+	BackgroundJob_Status_SUCCEEDED BackgroundJob_Status_State = 3
+	BackgroundJob_Status_FAILED    BackgroundJob_Status_State = 4
+	BackgroundJob_Status_CANCELLED BackgroundJob_Status_State = 5
+)
+
+var BackgroundJob_Status_State_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PENDING",
+	2: "RUNNING",
+	3: "SUCCEEDED",
+	4: "FAILED",
+	5: "CANCELLED",
+}
+
+var BackgroundJob_Status_State_value = map[string]int32{
+	"UNKNOWN":   0,
+	"PENDING":   1,
+	"RUNNING":   2,
+	"SUCCEEDED": 3,
+	"FAILED":    4,
+	"CANCELLED": 5,
+}
+
+func (x BackgroundJob_Status_State) String() string {
+	return proto.EnumName(BackgroundJob_Status_State_name, int32(x))
+}
+
+func (BackgroundJob_Status_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{4, 0, 0}
+}
+
+type BackgroundJob_Progress_Stage int32
+
+const (
+	BackgroundJob_Progress_UNKNOWN     BackgroundJob_Progress_Stage = 0
+	BackgroundJob_Progress_DOWNLOADING BackgroundJob_Progress_Stage = 1
+	BackgroundJob_Progress_PROCESSING  BackgroundJob_Progress_Stage = 2
+)
+
+var BackgroundJob_Progress_Stage_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DOWNLOADING",
+	2: "PROCESSING",
+}
+
+var BackgroundJob_Progress_Stage_value = map[string]int32{
+	"UNKNOWN":     0,
+	"DOWNLOADING": 1,
+	"PROCESSING":  2,
+}
+
+func (x BackgroundJob_Progress_Stage) String() string {
+	return proto.EnumName(BackgroundJob_Progress_Stage_name, int32(x))
+}
+
+func (BackgroundJob_Progress_Stage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{4, 1, 0}
+}
+
 type PicCommentVote_Vote int32
 
 const (
@@ -227,7 +429,7 @@ func (x PicCommentVote_Vote) String() string {
 }
 
 func (PicCommentVote_Vote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{6, 0}
+	return fileDescriptor_871986018790d2fd, []int{8, 0}
 }
 
 type PicFile_Format int32
@@ -239,6 +441,9 @@ const (
 	PicFile_PNG     PicFile_Format = 3
 	PicFile_WEBM    PicFile_Format = 4
 	PicFile_MP4     PicFile_Format = 5
+	PicFile_WEBP    PicFile_Format = 6
+	PicFile_AVIF    PicFile_Format = 7
+	PicFile_HEIC    PicFile_Format = 8
 )
 
 var PicFile_Format_name = map[int32]string{
@@ -248,6 +453,9 @@ var PicFile_Format_name = map[int32]string{
 	3: "PNG",
 	4: "WEBM",
 	5: "MP4",
+	6: "WEBP",
+	7: "AVIF",
+	8: "HEIC",
 }
 
 var PicFile_Format_value = map[string]int32{
@@ -257,6 +465,9 @@ var PicFile_Format_value = map[string]int32{
 	"PNG":     3,
 	"WEBM":    4,
 	"MP4":     5,
+	"WEBP":    6,
+	"AVIF":    7,
+	"HEIC":    8,
 }
 
 func (x PicFile_Format) String() string {
@@ -264,7 +475,7 @@ func (x PicFile_Format) String() string {
 }
 
 func (PicFile_Format) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{7, 0}
+	return fileDescriptor_871986018790d2fd, []int{9, 0}
 }
 
 type PicVote_Vote int32
@@ -295,7 +506,7 @@ func (x PicVote_Vote) String() string {
 }
 
 func (PicVote_Vote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{10, 0}
+	return fileDescriptor_871986018790d2fd, []int{12, 0}
 }
 
 type PwtHeader_Algorithm int32
@@ -326,7 +537,7 @@ func (x PwtHeader_Algorithm) String() string {
 }
 
 func (PwtHeader_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{12, 0}
+	return fileDescriptor_871986018790d2fd, []int{14, 0}
 }
 
 type PwtPayload_Type int32
@@ -335,18 +546,23 @@ const (
 	PwtPayload_UNKNOWN PwtPayload_Type = 0
 	PwtPayload_AUTH    PwtPayload_Type = 2
 	PwtPayload_PIX     PwtPayload_Type = 3
+	// API_KEY tokens stand in for AUTH tokens, but can't be refreshed.  The
+	// token_id is the id of the key.
+	PwtPayload_API_KEY PwtPayload_Type = 4
 )
 
 var PwtPayload_Type_name = map[int32]string{
 	0: "UNKNOWN",
 	2: "AUTH",
 	3: "PIX",
+	4: "API_KEY",
 }
 
 var PwtPayload_Type_value = map[string]int32{
 	"UNKNOWN": 0,
 	"AUTH":    2,
 	"PIX":     3,
+	"API_KEY": 4,
 }
 
 func (x PwtPayload_Type) String() string {
@@ -354,7 +570,7 @@ func (x PwtPayload_Type) String() string {
 }
 
 func (PwtPayload_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{13, 0}
+	return fileDescriptor_871986018790d2fd, []int{15, 0}
 }
 
 // BackendConfiguration is the backend configuration used by Pixur.  All fields are optional
@@ -397,10 +613,23 @@ type BackendConfiguration struct {
 	// the default number of user events to return
 	DefaultFindUserEvents *wrappers.Int64Value `protobuf:"bytes,18,opt,name=default_find_user_events,json=defaultFindUserEvents,proto3" json:"default_find_user_events,omitempty"`
 	// the max number of user events to return
-	MaxFindUserEvents    *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	MaxFindUserEvents *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
+	// the default number of tags to return
+	DefaultFindTags *wrappers.Int64Value `protobuf:"bytes,20,opt,name=default_find_tags,json=defaultFindTags,proto3" json:"default_find_tags,omitempty"`
+	// the max number of tags to return
+	MaxFindTags *wrappers.Int64Value `protobuf:"bytes,21,opt,name=max_find_tags,json=maxFindTags,proto3" json:"max_find_tags,omitempty"`
+	// how uploads that are perceptually similar to an existing pic are handled.
+	NearDuplicatePolicy *BackendConfiguration_NearDuplicatePolicy `protobuf:"bytes,22,opt,name=near_duplicate_policy,json=nearDuplicatePolicy,proto3" json:"near_duplicate_policy,omitempty"`
+	// the max size of an uploaded or downloaded pic file in bytes.
+	MaxFileSize *wrappers.Int64Value `protobuf:"bytes,23,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	// how long an auth token may go unused before it is removed.
+	UserTokenTtl *duration.Duration `protobuf:"bytes,24,opt,name=user_token_ttl,json=userTokenTtl,proto3" json:"user_token_ttl,omitempty"`
+	// users with any of these capabilities must use two-factor authentication
+	// to log in with their secret.
+	TotpRequiredCapability *BackendConfiguration_CapabilitySet `protobuf:"bytes,25,opt,name=totp_required_capability,json=totpRequiredCapability,proto3" json:"totp_required_capability,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                            `json:"-"`
+	XXX_unrecognized       []byte                              `json:"-"`
+	XXX_sizecache          int32                               `json:"-"`
 }
 
 func (m *BackendConfiguration) Reset()         { *m = BackendConfiguration{} }
@@ -561,6 +790,48 @@ func (m *BackendConfiguration) GetMaxFindUserEvents() *wrappers.Int64Value {
 	return nil
 }
 
+func (m *BackendConfiguration) GetDefaultFindTags() *wrappers.Int64Value {
+	if m != nil {
+		return m.DefaultFindTags
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxFindTags() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFindTags
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetNearDuplicatePolicy() *BackendConfiguration_NearDuplicatePolicy {
+	if m != nil {
+		return m.NearDuplicatePolicy
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetMaxFileSize() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFileSize
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetUserTokenTtl() *duration.Duration {
+	if m != nil {
+		return m.UserTokenTtl
+	}
+	return nil
+}
+
+func (m *BackendConfiguration) GetTotpRequiredCapability() *BackendConfiguration_CapabilitySet {
+	if m != nil {
+		return m.TotpRequiredCapability
+	}
+	return nil
+}
+
 type BackendConfiguration_CapabilitySet struct {
 	Capability           []Capability_Cap `protobuf:"varint,1,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -600,6 +871,56 @@ func (m *BackendConfiguration_CapabilitySet) GetCapability() []Capability_Cap {
 	return nil
 }
 
+type BackendConfiguration_NearDuplicatePolicy struct {
+	Action BackendConfiguration_NearDuplicatePolicy_Action `protobuf:"varint,1,opt,name=action,proto3,enum=pixur.api.BackendConfiguration_NearDuplicatePolicy_Action" json:"action,omitempty"`
+	// the max Hamming distance between the perceptual hashes of near duplicates.
+	MaxDistance          int64    `protobuf:"varint,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackendConfiguration_NearDuplicatePolicy) Reset() {
+	*m = BackendConfiguration_NearDuplicatePolicy{}
+}
+func (m *BackendConfiguration_NearDuplicatePolicy) String() string { return proto.CompactTextString(m) }
+func (*BackendConfiguration_NearDuplicatePolicy) ProtoMessage()    {}
+func (*BackendConfiguration_NearDuplicatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{0, 1}
+}
+
+func (m *BackendConfiguration_NearDuplicatePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackendConfiguration_NearDuplicatePolicy.Unmarshal(m, b)
+}
+func (m *BackendConfiguration_NearDuplicatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackendConfiguration_NearDuplicatePolicy.Marshal(b, m, deterministic)
+}
+func (m *BackendConfiguration_NearDuplicatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackendConfiguration_NearDuplicatePolicy.Merge(m, src)
+}
+func (m *BackendConfiguration_NearDuplicatePolicy) XXX_Size() int {
+	return xxx_messageInfo_BackendConfiguration_NearDuplicatePolicy.Size(m)
+}
+func (m *BackendConfiguration_NearDuplicatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackendConfiguration_NearDuplicatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackendConfiguration_NearDuplicatePolicy proto.InternalMessageInfo
+
+func (m *BackendConfiguration_NearDuplicatePolicy) GetAction() BackendConfiguration_NearDuplicatePolicy_Action {
+	if m != nil {
+		return m.Action
+	}
+	return BackendConfiguration_NearDuplicatePolicy_UNKNOWN
+}
+
+func (m *BackendConfiguration_NearDuplicatePolicy) GetMaxDistance() int64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
 type Capability struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -651,10 +972,14 @@ type Pic struct {
 	File    *PicFile     `protobuf:"bytes,16,opt,name=file,proto3" json:"file,omitempty"`
 	Source  []*PicSource `protobuf:"bytes,18,rep,name=source,proto3" json:"source,omitempty"`
 	// The user id of the first user who uploading this pic.  May be absent.
-	FirstUserId          *wrappers.StringValue `protobuf:"bytes,19,opt,name=first_user_id,json=firstUserId,proto3" json:"first_user_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	FirstUserId *wrappers.StringValue `protobuf:"bytes,19,opt,name=first_user_id,json=firstUserId,proto3" json:"first_user_id,omitempty"`
+	// If set, this pic was merged into the pic with this id, which should be shown instead.
+	DuplicateOfPicId string `protobuf:"bytes,20,opt,name=duplicate_of_pic_id,json=duplicateOfPicId,proto3" json:"duplicate_of_pic_id,omitempty"`
+	// visibility is who can see the pic.  Absent if the pic is public.
+	Visibility           *PicVisibility `protobuf:"bytes,21,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *Pic) Reset()         { *m = Pic{} }
@@ -759,188 +1084,496 @@ func (m *Pic) GetFirstUserId() *wrappers.StringValue {
 	return nil
 }
 
-type PicAndThumbnail struct {
-	Pic                  *Pic       `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
-	Thumbnail            []*PicFile `protobuf:"bytes,2,rep,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *Pic) GetDuplicateOfPicId() string {
+	if m != nil {
+		return m.DuplicateOfPicId
+	}
+	return ""
 }
 
-func (m *PicAndThumbnail) Reset()         { *m = PicAndThumbnail{} }
-func (m *PicAndThumbnail) String() string { return proto.CompactTextString(m) }
-func (*PicAndThumbnail) ProtoMessage()    {}
-func (*PicAndThumbnail) Descriptor() ([]byte, []int) {
+func (m *Pic) GetVisibility() *PicVisibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return nil
+}
+
+type PicVisibility struct {
+	Mode PicVisibility_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=pixur.api.PicVisibility_Mode" json:"mode,omitempty"`
+	// user_id is each user other than the uploader who can see a private pic, in varint form.
+	UserId []string `protobuf:"bytes,2,rep,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// role_id is each role whose users can see a private pic, in varint form.
+	RoleId               []string `protobuf:"bytes,3,rep,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PicVisibility) Reset()         { *m = PicVisibility{} }
+func (m *PicVisibility) String() string { return proto.CompactTextString(m) }
+func (*PicVisibility) ProtoMessage()    {}
+func (*PicVisibility) Descriptor() ([]byte, []int) {
 	return fileDescriptor_871986018790d2fd, []int{3}
 }
 
-func (m *PicAndThumbnail) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PicAndThumbnail.Unmarshal(m, b)
+func (m *PicVisibility) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicVisibility.Unmarshal(m, b)
 }
-func (m *PicAndThumbnail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PicAndThumbnail.Marshal(b, m, deterministic)
+func (m *PicVisibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicVisibility.Marshal(b, m, deterministic)
 }
-func (m *PicAndThumbnail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PicAndThumbnail.Merge(m, src)
+func (m *PicVisibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicVisibility.Merge(m, src)
 }
-func (m *PicAndThumbnail) XXX_Size() int {
-	return xxx_messageInfo_PicAndThumbnail.Size(m)
+func (m *PicVisibility) XXX_Size() int {
+	return xxx_messageInfo_PicVisibility.Size(m)
 }
-func (m *PicAndThumbnail) XXX_DiscardUnknown() {
-	xxx_messageInfo_PicAndThumbnail.DiscardUnknown(m)
+func (m *PicVisibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicVisibility.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PicAndThumbnail proto.InternalMessageInfo
+var xxx_messageInfo_PicVisibility proto.InternalMessageInfo
 
-func (m *PicAndThumbnail) GetPic() *Pic {
+func (m *PicVisibility) GetMode() PicVisibility_Mode {
 	if m != nil {
-		return m.Pic
+		return m.Mode
+	}
+	return PicVisibility_PUBLIC
+}
+
+func (m *PicVisibility) GetUserId() []string {
+	if m != nil {
+		return m.UserId
 	}
 	return nil
 }
 
-func (m *PicAndThumbnail) GetThumbnail() []*PicFile {
+func (m *PicVisibility) GetRoleId() []string {
 	if m != nil {
-		return m.Thumbnail
+		return m.RoleId
 	}
 	return nil
 }
 
-type PicComment struct {
-	// pic_id is the unique identifier for the pic, in varint form
-	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	// comment_id is the unique identifier for the comment, in varint form
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// comment_parent_id is the unique identifier for the parent comment, in varint form
-	CommentParentId string `protobuf:"bytes,3,opt,name=comment_parent_id,json=commentParentId,proto3" json:"comment_parent_id,omitempty"`
-	Text            string `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
-	// created_time is when the tag was created.
-	CreatedTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	// modified_time is when the tag was last modified.
-	ModifiedTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
-	// version is the version of the tag.  It is used when updating the tag.
-	Version int64 `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
-	// The user id of comment author.  May be absent.
-	UserId               *wrappers.StringValue `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type BackgroundJob struct {
+	// id is the unique identifier for the job, in varint form
+	Id   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type BackgroundJob_Type `protobuf:"varint,2,opt,name=type,proto3,enum=pixur.api.BackgroundJob_Type" json:"type,omitempty"`
+	// the user who created the job.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the pic the job acts on, if any.
+	PicId                string                `protobuf:"bytes,4,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Url                  string                `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	CreatedTime          *timestamp.Timestamp  `protobuf:"bytes,6,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	ModifiedTime         *timestamp.Timestamp  `protobuf:"bytes,7,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	Status               *BackgroundJob_Status `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *PicComment) Reset()         { *m = PicComment{} }
-func (m *PicComment) String() string { return proto.CompactTextString(m) }
-func (*PicComment) ProtoMessage()    {}
-func (*PicComment) Descriptor() ([]byte, []int) {
+func (m *BackgroundJob) Reset()         { *m = BackgroundJob{} }
+func (m *BackgroundJob) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJob) ProtoMessage()    {}
+func (*BackgroundJob) Descriptor() ([]byte, []int) {
 	return fileDescriptor_871986018790d2fd, []int{4}
 }
 
-func (m *PicComment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PicComment.Unmarshal(m, b)
+func (m *BackgroundJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJob.Unmarshal(m, b)
 }
-func (m *PicComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PicComment.Marshal(b, m, deterministic)
+func (m *BackgroundJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJob.Marshal(b, m, deterministic)
 }
-func (m *PicComment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PicComment.Merge(m, src)
+func (m *BackgroundJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJob.Merge(m, src)
 }
-func (m *PicComment) XXX_Size() int {
-	return xxx_messageInfo_PicComment.Size(m)
+func (m *BackgroundJob) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJob.Size(m)
 }
-func (m *PicComment) XXX_DiscardUnknown() {
-	xxx_messageInfo_PicComment.DiscardUnknown(m)
+func (m *BackgroundJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJob.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PicComment proto.InternalMessageInfo
+var xxx_messageInfo_BackgroundJob proto.InternalMessageInfo
 
-func (m *PicComment) GetPicId() string {
+func (m *BackgroundJob) GetId() string {
 	if m != nil {
-		return m.PicId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *PicComment) GetCommentId() string {
+func (m *BackgroundJob) GetType() BackgroundJob_Type {
 	if m != nil {
-		return m.CommentId
+		return m.Type
 	}
-	return ""
+	return BackgroundJob_UNKNOWN
 }
 
-func (m *PicComment) GetCommentParentId() string {
+func (m *BackgroundJob) GetUserId() string {
 	if m != nil {
-		return m.CommentParentId
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *PicComment) GetText() string {
+func (m *BackgroundJob) GetPicId() string {
 	if m != nil {
-		return m.Text
+		return m.PicId
 	}
 	return ""
 }
 
-func (m *PicComment) GetCreatedTime() *timestamp.Timestamp {
+func (m *BackgroundJob) GetUrl() string {
 	if m != nil {
-		return m.CreatedTime
+		return m.Url
 	}
-	return nil
+	return ""
 }
 
-func (m *PicComment) GetModifiedTime() *timestamp.Timestamp {
+func (m *BackgroundJob) GetCreatedTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.ModifiedTime
+		return m.CreatedTime
 	}
 	return nil
 }
 
-func (m *PicComment) GetVersion() int64 {
+func (m *BackgroundJob) GetModifiedTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.Version
+		return m.ModifiedTime
 	}
-	return 0
+	return nil
 }
 
-func (m *PicComment) GetUserId() *wrappers.StringValue {
+func (m *BackgroundJob) GetStatus() *BackgroundJob_Status {
 	if m != nil {
-		return m.UserId
+		return m.Status
 	}
 	return nil
 }
 
-type PicCommentTree struct {
-	// All comments, ordered by a post order traversal.  Protobuf does not handle deeply nested
-	// messages well.
-	Comment              []*PicComment `protobuf:"bytes,1,rep,name=comment,proto3" json:"comment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type BackgroundJob_Status struct {
+	State BackgroundJob_Status_State `protobuf:"varint,1,opt,name=state,proto3,enum=pixur.api.BackgroundJob_Status_State" json:"state,omitempty"`
+	// how many times the job has been started.
+	Attempts int64 `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// why the last attempt failed.
+	LastError string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// when the job will next be run, if it is pending.
+	NextRunTime  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	FinishedTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=finished_time,json=finishedTime,proto3" json:"finished_time,omitempty"`
+	// how far the current attempt has gotten, if the job reports progress.
+	Progress             *BackgroundJob_Progress `protobuf:"bytes,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *PicCommentTree) Reset()         { *m = PicCommentTree{} }
-func (m *PicCommentTree) String() string { return proto.CompactTextString(m) }
-func (*PicCommentTree) ProtoMessage()    {}
-func (*PicCommentTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{5}
+func (m *BackgroundJob_Status) Reset()         { *m = BackgroundJob_Status{} }
+func (m *BackgroundJob_Status) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJob_Status) ProtoMessage()    {}
+func (*BackgroundJob_Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{4, 0}
 }
 
-func (m *PicCommentTree) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PicCommentTree.Unmarshal(m, b)
+func (m *BackgroundJob_Status) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJob_Status.Unmarshal(m, b)
 }
-func (m *PicCommentTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PicCommentTree.Marshal(b, m, deterministic)
+func (m *BackgroundJob_Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJob_Status.Marshal(b, m, deterministic)
 }
-func (m *PicCommentTree) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PicCommentTree.Merge(m, src)
+func (m *BackgroundJob_Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJob_Status.Merge(m, src)
 }
-func (m *PicCommentTree) XXX_Size() int {
-	return xxx_messageInfo_PicCommentTree.Size(m)
+func (m *BackgroundJob_Status) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJob_Status.Size(m)
 }
-func (m *PicCommentTree) XXX_DiscardUnknown() {
-	xxx_messageInfo_PicCommentTree.DiscardUnknown(m)
+func (m *BackgroundJob_Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJob_Status.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PicCommentTree proto.InternalMessageInfo
+var xxx_messageInfo_BackgroundJob_Status proto.InternalMessageInfo
+
+func (m *BackgroundJob_Status) GetState() BackgroundJob_Status_State {
+	if m != nil {
+		return m.State
+	}
+	return BackgroundJob_Status_UNKNOWN
+}
+
+func (m *BackgroundJob_Status) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *BackgroundJob_Status) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *BackgroundJob_Status) GetNextRunTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRunTime
+	}
+	return nil
+}
+
+func (m *BackgroundJob_Status) GetFinishedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.FinishedTime
+	}
+	return nil
+}
+
+func (m *BackgroundJob_Status) GetProgress() *BackgroundJob_Progress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type BackgroundJob_Progress struct {
+	Stage           BackgroundJob_Progress_Stage `protobuf:"varint,1,opt,name=stage,proto3,enum=pixur.api.BackgroundJob_Progress_Stage" json:"stage,omitempty"`
+	DownloadedBytes int64                        `protobuf:"varint,2,opt,name=downloaded_bytes,json=downloadedBytes,proto3" json:"downloaded_bytes,omitempty"`
+	// 0 if the size isn't known ahead of time.
+	TotalBytes           int64    `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackgroundJob_Progress) Reset()         { *m = BackgroundJob_Progress{} }
+func (m *BackgroundJob_Progress) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJob_Progress) ProtoMessage()    {}
+func (*BackgroundJob_Progress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{4, 1}
+}
+
+func (m *BackgroundJob_Progress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJob_Progress.Unmarshal(m, b)
+}
+func (m *BackgroundJob_Progress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJob_Progress.Marshal(b, m, deterministic)
+}
+func (m *BackgroundJob_Progress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJob_Progress.Merge(m, src)
+}
+func (m *BackgroundJob_Progress) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJob_Progress.Size(m)
+}
+func (m *BackgroundJob_Progress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJob_Progress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackgroundJob_Progress proto.InternalMessageInfo
+
+func (m *BackgroundJob_Progress) GetStage() BackgroundJob_Progress_Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return BackgroundJob_Progress_UNKNOWN
+}
+
+func (m *BackgroundJob_Progress) GetDownloadedBytes() int64 {
+	if m != nil {
+		return m.DownloadedBytes
+	}
+	return 0
+}
+
+func (m *BackgroundJob_Progress) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+type PicAndThumbnail struct {
+	Pic                  *Pic       `protobuf:"bytes,1,opt,name=pic,proto3" json:"pic,omitempty"`
+	Thumbnail            []*PicFile `protobuf:"bytes,2,rep,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *PicAndThumbnail) Reset()         { *m = PicAndThumbnail{} }
+func (m *PicAndThumbnail) String() string { return proto.CompactTextString(m) }
+func (*PicAndThumbnail) ProtoMessage()    {}
+func (*PicAndThumbnail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{5}
+}
+
+func (m *PicAndThumbnail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicAndThumbnail.Unmarshal(m, b)
+}
+func (m *PicAndThumbnail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicAndThumbnail.Marshal(b, m, deterministic)
+}
+func (m *PicAndThumbnail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicAndThumbnail.Merge(m, src)
+}
+func (m *PicAndThumbnail) XXX_Size() int {
+	return xxx_messageInfo_PicAndThumbnail.Size(m)
+}
+func (m *PicAndThumbnail) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicAndThumbnail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicAndThumbnail proto.InternalMessageInfo
+
+func (m *PicAndThumbnail) GetPic() *Pic {
+	if m != nil {
+		return m.Pic
+	}
+	return nil
+}
+
+func (m *PicAndThumbnail) GetThumbnail() []*PicFile {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+type PicComment struct {
+	// pic_id is the unique identifier for the pic, in varint form
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// comment_id is the unique identifier for the comment, in varint form
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// comment_parent_id is the unique identifier for the parent comment, in varint form
+	CommentParentId string `protobuf:"bytes,3,opt,name=comment_parent_id,json=commentParentId,proto3" json:"comment_parent_id,omitempty"`
+	Text            string `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	// created_time is when the tag was created.
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// modified_time is when the tag was last modified.
+	ModifiedTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	// version is the version of the tag.  It is used when updating the tag.
+	Version int64 `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
+	// The user id of comment author.  May be absent.
+	UserId               *wrappers.StringValue `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PicComment) Reset()         { *m = PicComment{} }
+func (m *PicComment) String() string { return proto.CompactTextString(m) }
+func (*PicComment) ProtoMessage()    {}
+func (*PicComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{6}
+}
+
+func (m *PicComment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicComment.Unmarshal(m, b)
+}
+func (m *PicComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicComment.Marshal(b, m, deterministic)
+}
+func (m *PicComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicComment.Merge(m, src)
+}
+func (m *PicComment) XXX_Size() int {
+	return xxx_messageInfo_PicComment.Size(m)
+}
+func (m *PicComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicComment proto.InternalMessageInfo
+
+func (m *PicComment) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *PicComment) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
+}
+
+func (m *PicComment) GetCommentParentId() string {
+	if m != nil {
+		return m.CommentParentId
+	}
+	return ""
+}
+
+func (m *PicComment) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *PicComment) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *PicComment) GetModifiedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTime
+	}
+	return nil
+}
+
+func (m *PicComment) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PicComment) GetUserId() *wrappers.StringValue {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+type PicCommentTree struct {
+	// All comments, ordered by a post order traversal.  Protobuf does not handle deeply nested
+	// messages well.
+	Comment              []*PicComment `protobuf:"bytes,1,rep,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PicCommentTree) Reset()         { *m = PicCommentTree{} }
+func (m *PicCommentTree) String() string { return proto.CompactTextString(m) }
+func (*PicCommentTree) ProtoMessage()    {}
+func (*PicCommentTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{7}
+}
+
+func (m *PicCommentTree) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicCommentTree.Unmarshal(m, b)
+}
+func (m *PicCommentTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicCommentTree.Marshal(b, m, deterministic)
+}
+func (m *PicCommentTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicCommentTree.Merge(m, src)
+}
+func (m *PicCommentTree) XXX_Size() int {
+	return xxx_messageInfo_PicCommentTree.Size(m)
+}
+func (m *PicCommentTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicCommentTree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicCommentTree proto.InternalMessageInfo
 
 func (m *PicCommentTree) GetComment() []*PicComment {
 	if m != nil {
@@ -967,7 +1600,7 @@ func (m *PicCommentVote) Reset()         { *m = PicCommentVote{} }
 func (m *PicCommentVote) String() string { return proto.CompactTextString(m) }
 func (*PicCommentVote) ProtoMessage()    {}
 func (*PicCommentVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{6}
+	return fileDescriptor_871986018790d2fd, []int{8}
 }
 
 func (m *PicCommentVote) XXX_Unmarshal(b []byte) error {
@@ -1065,7 +1698,7 @@ func (m *PicFile) Reset()         { *m = PicFile{} }
 func (m *PicFile) String() string { return proto.CompactTextString(m) }
 func (*PicFile) ProtoMessage()    {}
 func (*PicFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{7}
+	return fileDescriptor_871986018790d2fd, []int{9}
 }
 
 func (m *PicFile) XXX_Unmarshal(b []byte) error {
@@ -1167,7 +1800,7 @@ func (m *PicSource) Reset()         { *m = PicSource{} }
 func (m *PicSource) String() string { return proto.CompactTextString(m) }
 func (*PicSource) ProtoMessage()    {}
 func (*PicSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{8}
+	return fileDescriptor_871986018790d2fd, []int{10}
 }
 
 func (m *PicSource) XXX_Unmarshal(b []byte) error {
@@ -1231,7 +1864,7 @@ func (m *PicTag) Reset()         { *m = PicTag{} }
 func (m *PicTag) String() string { return proto.CompactTextString(m) }
 func (*PicTag) ProtoMessage()    {}
 func (*PicTag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{9}
+	return fileDescriptor_871986018790d2fd, []int{11}
 }
 
 func (m *PicTag) XXX_Unmarshal(b []byte) error {
@@ -1311,7 +1944,7 @@ func (m *PicVote) Reset()         { *m = PicVote{} }
 func (m *PicVote) String() string { return proto.CompactTextString(m) }
 func (*PicVote) ProtoMessage()    {}
 func (*PicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{10}
+	return fileDescriptor_871986018790d2fd, []int{12}
 }
 
 func (m *PicVote) XXX_Unmarshal(b []byte) error {
@@ -1391,7 +2024,7 @@ func (m *PublicUserInfo) Reset()         { *m = PublicUserInfo{} }
 func (m *PublicUserInfo) String() string { return proto.CompactTextString(m) }
 func (*PublicUserInfo) ProtoMessage()    {}
 func (*PublicUserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{11}
+	return fileDescriptor_871986018790d2fd, []int{13}
 }
 
 func (m *PublicUserInfo) XXX_Unmarshal(b []byte) error {
@@ -1445,7 +2078,7 @@ func (m *PwtHeader) Reset()         { *m = PwtHeader{} }
 func (m *PwtHeader) String() string { return proto.CompactTextString(m) }
 func (*PwtHeader) ProtoMessage()    {}
 func (*PwtHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{12}
+	return fileDescriptor_871986018790d2fd, []int{14}
 }
 
 func (m *PwtHeader) XXX_Unmarshal(b []byte) error {
@@ -1498,7 +2131,7 @@ func (m *PwtPayload) Reset()         { *m = PwtPayload{} }
 func (m *PwtPayload) String() string { return proto.CompactTextString(m) }
 func (*PwtPayload) ProtoMessage()    {}
 func (*PwtPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{13}
+	return fileDescriptor_871986018790d2fd, []int{15}
 }
 
 func (m *PwtPayload) XXX_Unmarshal(b []byte) error {
@@ -1568,6 +2201,91 @@ func (m *PwtPayload) GetType() PwtPayload_Type {
 	return PwtPayload_UNKNOWN
 }
 
+type Tag struct {
+	// tag_id is the unique identifier for the tag, in varint form
+	TagId string `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	// name is the tag name in utf8 form
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// usage_count is the number of pics that have this tag.
+	UsageCount int64 `protobuf:"varint,3,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	// created_time is when the tag was created.
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// modified_time is when the tag was last modified.
+	ModifiedTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	// version is the version of the tag.
+	Version              int64    `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tag) Reset()         { *m = Tag{} }
+func (m *Tag) String() string { return proto.CompactTextString(m) }
+func (*Tag) ProtoMessage()    {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{16}
+}
+
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tag.Unmarshal(m, b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return xxx_messageInfo_Tag.Size(m)
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetTagId() string {
+	if m != nil {
+		return m.TagId
+	}
+	return ""
+}
+
+func (m *Tag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tag) GetUsageCount() int64 {
+	if m != nil {
+		return m.UsageCount
+	}
+	return 0
+}
+
+func (m *Tag) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *Tag) GetModifiedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTime
+	}
+	return nil
+}
+
+func (m *Tag) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type User struct {
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Ident  string `protobuf:"bytes,2,opt,name=ident,proto3" json:"ident,omitempty"`
@@ -1578,18 +2296,21 @@ type User struct {
 	// modified_time is when the user was last modified.
 	LastSeenTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
 	// version is the version of the user.  It is used when updating the user.
-	Version              int64            `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
-	Capability           []Capability_Cap `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Version    int64            `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
+	Capability []Capability_Cap `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	// role_id is the id of each role the user is a member of, in varint form.  The capabilities
+	// of the roles are not included in capability.
+	RoleId               []string `protobuf:"bytes,8,rep,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{14}
+	return fileDescriptor_871986018790d2fd, []int{17}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -1659,6 +2380,267 @@ func (m *User) GetCapability() []Capability_Cap {
 	return nil
 }
 
+func (m *User) GetRoleId() []string {
+	if m != nil {
+		return m.RoleId
+	}
+	return nil
+}
+
+type UserToken struct {
+	// token_id identifies the token among the tokens of its user, in varint form.
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// created_time is when the user logged in with the token.
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// last_seen_time is roughly when the token was last used.
+	LastSeenTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
+	// user_agent and remote_address describe the client the token was issued to.
+	UserAgent     string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RemoteAddress string `protobuf:"bytes,5,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	// current is true if the token is the one used to make the request.
+	Current              bool     `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserToken) Reset()         { *m = UserToken{} }
+func (m *UserToken) String() string { return proto.CompactTextString(m) }
+func (*UserToken) ProtoMessage()    {}
+func (*UserToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{18}
+}
+
+func (m *UserToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserToken.Unmarshal(m, b)
+}
+func (m *UserToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserToken.Marshal(b, m, deterministic)
+}
+func (m *UserToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserToken.Merge(m, src)
+}
+func (m *UserToken) XXX_Size() int {
+	return xxx_messageInfo_UserToken.Size(m)
+}
+func (m *UserToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserToken proto.InternalMessageInfo
+
+func (m *UserToken) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *UserToken) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *UserToken) GetLastSeenTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeenTime
+	}
+	return nil
+}
+
+func (m *UserToken) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *UserToken) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *UserToken) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ApiKey struct {
+	// api_key_id identifies the key among the keys of its user, in varint form.
+	ApiKeyId string `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	// name is a note about what the key is for.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// created_time is when the key was created.
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// expire_time is when the key stops working.  If unset, the key doesn't expire.
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// capability is the capabilities the key is limited to.
+	Capability []Capability_Cap `protobuf:"varint,5,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	// allowed_remote_range is the CIDR ranges the key may be used from.  If empty, the key may be
+	// used from anywhere.
+	AllowedRemoteRange   []string `protobuf:"bytes,6,rep,name=allowed_remote_range,json=allowedRemoteRange,proto3" json:"allowed_remote_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiKey) Reset()         { *m = ApiKey{} }
+func (m *ApiKey) String() string { return proto.CompactTextString(m) }
+func (*ApiKey) ProtoMessage()    {}
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{19}
+}
+
+func (m *ApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKey.Unmarshal(m, b)
+}
+func (m *ApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKey.Marshal(b, m, deterministic)
+}
+func (m *ApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKey.Merge(m, src)
+}
+func (m *ApiKey) XXX_Size() int {
+	return xxx_messageInfo_ApiKey.Size(m)
+}
+func (m *ApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKey proto.InternalMessageInfo
+
+func (m *ApiKey) GetApiKeyId() string {
+	if m != nil {
+		return m.ApiKeyId
+	}
+	return ""
+}
+
+func (m *ApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKey) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *ApiKey) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *ApiKey) GetCapability() []Capability_Cap {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+func (m *ApiKey) GetAllowedRemoteRange() []string {
+	if m != nil {
+		return m.AllowedRemoteRange
+	}
+	return nil
+}
+
+type Role struct {
+	// role_id is the unique identifier for the role, in varint form
+	RoleId string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// name is the display name of the role.
+	Name       string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capability []Capability_Cap `protobuf:"varint,3,rep,packed,name=capability,proto3,enum=pixur.api.Capability_Cap" json:"capability,omitempty"`
+	// created_time is when the role was created.
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// modified_time is when the role was last modified.
+	ModifiedTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_time,json=modifiedTime,proto3" json:"modified_time,omitempty"`
+	// version is the version of the role.  It is used when updating the role.
+	Version              int64    `protobuf:"fixed64,6,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{20}
+}
+
+func (m *Role) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Role.Unmarshal(m, b)
+}
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+}
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
+}
+func (m *Role) XXX_Size() int {
+	return xxx_messageInfo_Role.Size(m)
+}
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Role proto.InternalMessageInfo
+
+func (m *Role) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Role) GetCapability() []Capability_Cap {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+func (m *Role) GetCreatedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return nil
+}
+
+func (m *Role) GetModifiedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTime
+	}
+	return nil
+}
+
+func (m *Role) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type UserEvent struct {
 	// user_id is the id of the user this event applies to.
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1673,6 +2655,9 @@ type UserEvent struct {
 	//	*UserEvent_OutgoingPicComment_
 	//	*UserEvent_IncomingPicComment_
 	//	*UserEvent_UpsertPic_
+	//	*UserEvent_RemovePicTags_
+	//	*UserEvent_UpdateRole_
+	//	*UserEvent_UpdateUserRoles_
 	Evt                  isUserEvent_Evt `protobuf_oneof:"evt"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
@@ -1683,7 +2668,7 @@ func (m *UserEvent) Reset()         { *m = UserEvent{} }
 func (m *UserEvent) String() string { return proto.CompactTextString(m) }
 func (*UserEvent) ProtoMessage()    {}
 func (*UserEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15}
+	return fileDescriptor_871986018790d2fd, []int{21}
 }
 
 func (m *UserEvent) XXX_Unmarshal(b []byte) error {
@@ -1749,6 +2734,18 @@ type UserEvent_UpsertPic_ struct {
 	UpsertPic *UserEvent_UpsertPic `protobuf:"bytes,8,opt,name=upsert_pic,json=upsertPic,proto3,oneof"`
 }
 
+type UserEvent_RemovePicTags_ struct {
+	RemovePicTags *UserEvent_RemovePicTags `protobuf:"bytes,9,opt,name=remove_pic_tags,json=removePicTags,proto3,oneof"`
+}
+
+type UserEvent_UpdateRole_ struct {
+	UpdateRole *UserEvent_UpdateRole `protobuf:"bytes,10,opt,name=update_role,json=updateRole,proto3,oneof"`
+}
+
+type UserEvent_UpdateUserRoles_ struct {
+	UpdateUserRoles *UserEvent_UpdateUserRoles `protobuf:"bytes,11,opt,name=update_user_roles,json=updateUserRoles,proto3,oneof"`
+}
+
 func (*UserEvent_OutgoingUpsertPicVote_) isUserEvent_Evt() {}
 
 func (*UserEvent_IncomingUpsertPicVote_) isUserEvent_Evt() {}
@@ -1759,6 +2756,12 @@ func (*UserEvent_IncomingPicComment_) isUserEvent_Evt() {}
 
 func (*UserEvent_UpsertPic_) isUserEvent_Evt() {}
 
+func (*UserEvent_RemovePicTags_) isUserEvent_Evt() {}
+
+func (*UserEvent_UpdateRole_) isUserEvent_Evt() {}
+
+func (*UserEvent_UpdateUserRoles_) isUserEvent_Evt() {}
+
 func (m *UserEvent) GetEvt() isUserEvent_Evt {
 	if m != nil {
 		return m.Evt
@@ -1801,6 +2804,27 @@ func (m *UserEvent) GetUpsertPic() *UserEvent_UpsertPic {
 	return nil
 }
 
+func (m *UserEvent) GetRemovePicTags() *UserEvent_RemovePicTags {
+	if x, ok := m.GetEvt().(*UserEvent_RemovePicTags_); ok {
+		return x.RemovePicTags
+	}
+	return nil
+}
+
+func (m *UserEvent) GetUpdateRole() *UserEvent_UpdateRole {
+	if x, ok := m.GetEvt().(*UserEvent_UpdateRole_); ok {
+		return x.UpdateRole
+	}
+	return nil
+}
+
+func (m *UserEvent) GetUpdateUserRoles() *UserEvent_UpdateUserRoles {
+	if x, ok := m.GetEvt().(*UserEvent_UpdateUserRoles_); ok {
+		return x.UpdateUserRoles
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*UserEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1809,6 +2833,9 @@ func (*UserEvent) XXX_OneofWrappers() []interface{} {
 		(*UserEvent_OutgoingPicComment_)(nil),
 		(*UserEvent_IncomingPicComment_)(nil),
 		(*UserEvent_UpsertPic_)(nil),
+		(*UserEvent_RemovePicTags_)(nil),
+		(*UserEvent_UpdateRole_)(nil),
+		(*UserEvent_UpdateUserRoles_)(nil),
 	}
 }
 
@@ -1825,7 +2852,7 @@ func (m *UserEvent_OutgoingUpsertPicVote) Reset()         { *m = UserEvent_Outgo
 func (m *UserEvent_OutgoingUpsertPicVote) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_OutgoingUpsertPicVote) ProtoMessage()    {}
 func (*UserEvent_OutgoingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 0}
+	return fileDescriptor_871986018790d2fd, []int{21, 0}
 }
 
 func (m *UserEvent_OutgoingUpsertPicVote) XXX_Unmarshal(b []byte) error {
@@ -1868,7 +2895,7 @@ func (m *UserEvent_IncomingUpsertPicVote) Reset()         { *m = UserEvent_Incom
 func (m *UserEvent_IncomingUpsertPicVote) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_IncomingUpsertPicVote) ProtoMessage()    {}
 func (*UserEvent_IncomingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 1}
+	return fileDescriptor_871986018790d2fd, []int{21, 1}
 }
 
 func (m *UserEvent_IncomingUpsertPicVote) XXX_Unmarshal(b []byte) error {
@@ -1918,7 +2945,7 @@ func (m *UserEvent_OutgoingPicComment) Reset()         { *m = UserEvent_Outgoing
 func (m *UserEvent_OutgoingPicComment) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_OutgoingPicComment) ProtoMessage()    {}
 func (*UserEvent_OutgoingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 2}
+	return fileDescriptor_871986018790d2fd, []int{21, 2}
 }
 
 func (m *UserEvent_OutgoingPicComment) XXX_Unmarshal(b []byte) error {
@@ -1970,7 +2997,7 @@ func (m *UserEvent_IncomingPicComment) Reset()         { *m = UserEvent_Incoming
 func (m *UserEvent_IncomingPicComment) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_IncomingPicComment) ProtoMessage()    {}
 func (*UserEvent_IncomingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 3}
+	return fileDescriptor_871986018790d2fd, []int{21, 3}
 }
 
 func (m *UserEvent_IncomingPicComment) XXX_Unmarshal(b []byte) error {
@@ -2023,7 +3050,7 @@ func (m *UserEvent_UpsertPic) Reset()         { *m = UserEvent_UpsertPic{} }
 func (m *UserEvent_UpsertPic) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_UpsertPic) ProtoMessage()    {}
 func (*UserEvent_UpsertPic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871986018790d2fd, []int{15, 4}
+	return fileDescriptor_871986018790d2fd, []int{21, 4}
 }
 
 func (m *UserEvent_UpsertPic) XXX_Unmarshal(b []byte) error {
@@ -2051,9 +3078,194 @@ func (m *UserEvent_UpsertPic) GetPicId() string {
 	return ""
 }
 
+// RemovePicTags represents removing tags from a pic.
+type UserEvent_RemovePicTags struct {
+	PicId string `protobuf:"bytes,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// tag_name is the name of each removed tag, at the time it was removed.
+	TagName              []string `protobuf:"bytes,2,rep,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_RemovePicTags) Reset()         { *m = UserEvent_RemovePicTags{} }
+func (m *UserEvent_RemovePicTags) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_RemovePicTags) ProtoMessage()    {}
+func (*UserEvent_RemovePicTags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{21, 5}
+}
+
+func (m *UserEvent_RemovePicTags) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_RemovePicTags.Unmarshal(m, b)
+}
+func (m *UserEvent_RemovePicTags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_RemovePicTags.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_RemovePicTags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_RemovePicTags.Merge(m, src)
+}
+func (m *UserEvent_RemovePicTags) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_RemovePicTags.Size(m)
+}
+func (m *UserEvent_RemovePicTags) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_RemovePicTags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_RemovePicTags proto.InternalMessageInfo
+
+func (m *UserEvent_RemovePicTags) GetPicId() string {
+	if m != nil {
+		return m.PicId
+	}
+	return ""
+}
+
+func (m *UserEvent_RemovePicTags) GetTagName() []string {
+	if m != nil {
+		return m.TagName
+	}
+	return nil
+}
+
+// UpdateRole represents creating or changing a role.
+type UserEvent_UpdateRole struct {
+	RoleId string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// name is the name of the role after the change.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// created is true if the role was newly created.
+	Created              bool             `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	SetCapability        []Capability_Cap `protobuf:"varint,4,rep,packed,name=set_capability,json=setCapability,proto3,enum=pixur.api.Capability_Cap" json:"set_capability,omitempty"`
+	ClearCapability      []Capability_Cap `protobuf:"varint,5,rep,packed,name=clear_capability,json=clearCapability,proto3,enum=pixur.api.Capability_Cap" json:"clear_capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UserEvent_UpdateRole) Reset()         { *m = UserEvent_UpdateRole{} }
+func (m *UserEvent_UpdateRole) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_UpdateRole) ProtoMessage()    {}
+func (*UserEvent_UpdateRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{21, 6}
+}
+
+func (m *UserEvent_UpdateRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_UpdateRole.Unmarshal(m, b)
+}
+func (m *UserEvent_UpdateRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_UpdateRole.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_UpdateRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_UpdateRole.Merge(m, src)
+}
+func (m *UserEvent_UpdateRole) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_UpdateRole.Size(m)
+}
+func (m *UserEvent_UpdateRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_UpdateRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_UpdateRole proto.InternalMessageInfo
+
+func (m *UserEvent_UpdateRole) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *UserEvent_UpdateRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserEvent_UpdateRole) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *UserEvent_UpdateRole) GetSetCapability() []Capability_Cap {
+	if m != nil {
+		return m.SetCapability
+	}
+	return nil
+}
+
+func (m *UserEvent_UpdateRole) GetClearCapability() []Capability_Cap {
+	if m != nil {
+		return m.ClearCapability
+	}
+	return nil
+}
+
+// UpdateUserRoles represents adding or removing roles of a user.
+type UserEvent_UpdateUserRoles struct {
+	// object_user_id is the user whose roles were changed.
+	ObjectUserId         string   `protobuf:"bytes,1,opt,name=object_user_id,json=objectUserId,proto3" json:"object_user_id,omitempty"`
+	SetRoleId            []string `protobuf:"bytes,2,rep,name=set_role_id,json=setRoleId,proto3" json:"set_role_id,omitempty"`
+	ClearRoleId          []string `protobuf:"bytes,3,rep,name=clear_role_id,json=clearRoleId,proto3" json:"clear_role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_UpdateUserRoles) Reset()         { *m = UserEvent_UpdateUserRoles{} }
+func (m *UserEvent_UpdateUserRoles) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_UpdateUserRoles) ProtoMessage()    {}
+func (*UserEvent_UpdateUserRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871986018790d2fd, []int{21, 7}
+}
+
+func (m *UserEvent_UpdateUserRoles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_UpdateUserRoles.Unmarshal(m, b)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_UpdateUserRoles.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_UpdateUserRoles.Merge(m, src)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_UpdateUserRoles.Size(m)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_UpdateUserRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_UpdateUserRoles proto.InternalMessageInfo
+
+func (m *UserEvent_UpdateUserRoles) GetObjectUserId() string {
+	if m != nil {
+		return m.ObjectUserId
+	}
+	return ""
+}
+
+func (m *UserEvent_UpdateUserRoles) GetSetRoleId() []string {
+	if m != nil {
+		return m.SetRoleId
+	}
+	return nil
+}
+
+func (m *UserEvent_UpdateUserRoles) GetClearRoleId() []string {
+	if m != nil {
+		return m.ClearRoleId
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pixur.api.DeletionReason", DeletionReason_name, DeletionReason_value)
+	proto.RegisterEnum("pixur.api.BackendConfiguration_NearDuplicatePolicy_Action", BackendConfiguration_NearDuplicatePolicy_Action_name, BackendConfiguration_NearDuplicatePolicy_Action_value)
 	proto.RegisterEnum("pixur.api.Capability_Cap", Capability_Cap_name, Capability_Cap_value)
+	proto.RegisterEnum("pixur.api.PicVisibility_Mode", PicVisibility_Mode_name, PicVisibility_Mode_value)
+	proto.RegisterEnum("pixur.api.BackgroundJob_Type", BackgroundJob_Type_name, BackgroundJob_Type_value)
+	proto.RegisterEnum("pixur.api.BackgroundJob_Status_State", BackgroundJob_Status_State_name, BackgroundJob_Status_State_value)
+	proto.RegisterEnum("pixur.api.BackgroundJob_Progress_Stage", BackgroundJob_Progress_Stage_name, BackgroundJob_Progress_Stage_value)
 	proto.RegisterEnum("pixur.api.PicCommentVote_Vote", PicCommentVote_Vote_name, PicCommentVote_Vote_value)
 	proto.RegisterEnum("pixur.api.PicFile_Format", PicFile_Format_name, PicFile_Format_value)
 	proto.RegisterEnum("pixur.api.PicVote_Vote", PicVote_Vote_name, PicVote_Vote_value)
@@ -2061,8 +3273,13 @@ func init() {
 	proto.RegisterEnum("pixur.api.PwtPayload_Type", PwtPayload_Type_name, PwtPayload_Type_value)
 	proto.RegisterType((*BackendConfiguration)(nil), "pixur.api.BackendConfiguration")
 	proto.RegisterType((*BackendConfiguration_CapabilitySet)(nil), "pixur.api.BackendConfiguration.CapabilitySet")
+	proto.RegisterType((*BackendConfiguration_NearDuplicatePolicy)(nil), "pixur.api.BackendConfiguration.NearDuplicatePolicy")
 	proto.RegisterType((*Capability)(nil), "pixur.api.Capability")
 	proto.RegisterType((*Pic)(nil), "pixur.api.Pic")
+	proto.RegisterType((*PicVisibility)(nil), "pixur.api.PicVisibility")
+	proto.RegisterType((*BackgroundJob)(nil), "pixur.api.BackgroundJob")
+	proto.RegisterType((*BackgroundJob_Status)(nil), "pixur.api.BackgroundJob.Status")
+	proto.RegisterType((*BackgroundJob_Progress)(nil), "pixur.api.BackgroundJob.Progress")
 	proto.RegisterType((*PicAndThumbnail)(nil), "pixur.api.PicAndThumbnail")
 	proto.RegisterType((*PicComment)(nil), "pixur.api.PicComment")
 	proto.RegisterType((*PicCommentTree)(nil), "pixur.api.PicCommentTree")
@@ -2074,159 +3291,243 @@ func init() {
 	proto.RegisterType((*PublicUserInfo)(nil), "pixur.api.PublicUserInfo")
 	proto.RegisterType((*PwtHeader)(nil), "pixur.api.PwtHeader")
 	proto.RegisterType((*PwtPayload)(nil), "pixur.api.PwtPayload")
+	proto.RegisterType((*Tag)(nil), "pixur.api.Tag")
 	proto.RegisterType((*User)(nil), "pixur.api.User")
+	proto.RegisterType((*UserToken)(nil), "pixur.api.UserToken")
+	proto.RegisterType((*ApiKey)(nil), "pixur.api.ApiKey")
+	proto.RegisterType((*Role)(nil), "pixur.api.Role")
 	proto.RegisterType((*UserEvent)(nil), "pixur.api.UserEvent")
 	proto.RegisterType((*UserEvent_OutgoingUpsertPicVote)(nil), "pixur.api.UserEvent.OutgoingUpsertPicVote")
 	proto.RegisterType((*UserEvent_IncomingUpsertPicVote)(nil), "pixur.api.UserEvent.IncomingUpsertPicVote")
 	proto.RegisterType((*UserEvent_OutgoingPicComment)(nil), "pixur.api.UserEvent.OutgoingPicComment")
 	proto.RegisterType((*UserEvent_IncomingPicComment)(nil), "pixur.api.UserEvent.IncomingPicComment")
 	proto.RegisterType((*UserEvent_UpsertPic)(nil), "pixur.api.UserEvent.UpsertPic")
+	proto.RegisterType((*UserEvent_RemovePicTags)(nil), "pixur.api.UserEvent.RemovePicTags")
+	proto.RegisterType((*UserEvent_UpdateRole)(nil), "pixur.api.UserEvent.UpdateRole")
+	proto.RegisterType((*UserEvent_UpdateUserRoles)(nil), "pixur.api.UserEvent.UpdateUserRoles")
 }
 
 func init() { proto.RegisterFile("data.proto", fileDescriptor_871986018790d2fd) }
 
 var fileDescriptor_871986018790d2fd = []byte{
-	// 2264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x72, 0xe3, 0x48,
-	0xf5, 0x1f, 0x5b, 0xb2, 0x2d, 0x1f, 0x27, 0x8e, 0xd2, 0x49, 0x26, 0x8e, 0x37, 0x33, 0xff, 0xfc,
-	0x5d, 0xc5, 0x32, 0x0c, 0xac, 0x87, 0x0d, 0x3b, 0x4b, 0x51, 0xcb, 0xd6, 0x8e, 0x93, 0x28, 0x89,
-	0x8c, 0xc7, 0x51, 0xc9, 0x76, 0x66, 0xf8, 0x2a, 0xa1, 0x58, 0x6d, 0xa7, 0x59, 0x59, 0x72, 0x49,
-	0x72, 0xe2, 0x70, 0xc1, 0x1b, 0xf0, 0x12, 0xdc, 0xf1, 0x12, 0xdc, 0x72, 0xb3, 0x57, 0x70, 0x43,
-	0x15, 0xb7, 0xbc, 0x03, 0x97, 0x50, 0xdd, 0xfa, 0x8e, 0x3c, 0x89, 0x33, 0x53, 0x6c, 0x71, 0xe3,
-	0x72, 0x9f, 0x8f, 0x5f, 0x9f, 0x3e, 0xa7, 0xfb, 0xd7, 0xa7, 0x05, 0x60, 0xe8, 0x9e, 0xde, 0x9c,
-	0x3a, 0xb6, 0x67, 0xa3, 0xf2, 0x94, 0xcc, 0x67, 0x4e, 0x53, 0x9f, 0x92, 0xfa, 0xd3, 0xb1, 0x6d,
-	0x8f, 0x4d, 0xfc, 0x82, 0x29, 0x2e, 0x66, 0xa3, 0x17, 0xc6, 0xcc, 0xd1, 0x3d, 0x62, 0x5b, 0xbe,
-	0x69, 0xfd, 0xff, 0x6e, 0xeb, 0x3d, 0x32, 0xc1, 0xae, 0xa7, 0x4f, 0xa6, 0x81, 0x41, 0x06, 0xe0,
-	0xda, 0xd1, 0xa7, 0x53, 0xec, 0xb8, 0xbe, 0xbe, 0xf1, 0xe7, 0x15, 0xd8, 0x3c, 0xd0, 0x87, 0x5f,
-	0x63, 0xcb, 0x38, 0xb4, 0xad, 0x11, 0x19, 0x07, 0xf8, 0x48, 0x06, 0x34, 0x21, 0x96, 0x36, 0xb4,
-	0x27, 0x13, 0x6c, 0x79, 0x9a, 0x89, 0xad, 0xb1, 0x77, 0x59, 0xcb, 0xed, 0xe5, 0x9e, 0x55, 0xf6,
-	0x3f, 0x6a, 0xfa, 0xa8, 0xcd, 0x10, 0xb5, 0x29, 0x5b, 0xde, 0xe7, 0x9f, 0x9d, 0xeb, 0xe6, 0x0c,
-	0xab, 0xe2, 0x84, 0x58, 0x87, 0xbe, 0x57, 0x87, 0x39, 0x31, 0x28, 0x7d, 0x7e, 0x1b, 0x2a, 0xbf,
-	0x0c, 0x94, 0x3e, 0x4f, 0x43, 0x49, 0x40, 0xe1, 0x35, 0x62, 0x24, 0x80, 0xb8, 0xfb, 0x81, 0xaa,
-	0x13, 0x62, 0xc9, 0x46, 0x1a, 0x46, 0x9f, 0xa7, 0x61, 0xf8, 0x65, 0x60, 0xf4, 0x79, 0x12, 0xa6,
-	0x03, 0x9b, 0x34, 0x9a, 0x11, 0x31, 0xb1, 0x66, 0xe9, 0x13, 0x1c, 0x42, 0x15, 0xee, 0x87, 0x5a,
-	0x9f, 0x10, 0xeb, 0x98, 0x98, 0xb8, 0xab, 0x4f, 0x70, 0x02, 0x4d, 0x9f, 0x67, 0xd1, 0x8a, 0xcb,
-	0xa0, 0xe9, 0xf3, 0x5b, 0x68, 0x2d, 0xa0, 0x8b, 0xd6, 0x66, 0x8e, 0x19, 0xe2, 0x94, 0xee, 0xc7,
-	0x59, 0x99, 0x10, 0x6b, 0xe0, 0x98, 0x09, 0x08, 0x7d, 0x9e, 0x84, 0x10, 0x96, 0x81, 0xd0, 0xe7,
-	0x69, 0x08, 0x62, 0x69, 0x9e, 0x3e, 0x0e, 0x21, 0xca, 0xcb, 0x45, 0xd1, 0xd7, 0xc7, 0xe9, 0x28,
-	0x12, 0x10, 0xb0, 0x5c, 0x14, 0x31, 0xc4, 0x6f, 0x60, 0x53, 0xb7, 0x6c, 0xeb, 0x66, 0x62, 0xcf,
-	0x5c, 0x6d, 0xa8, 0x4f, 0xf5, 0x0b, 0x62, 0x12, 0xef, 0xa6, 0x56, 0x61, 0x40, 0x9f, 0x34, 0xa3,
-	0xf3, 0xd6, 0x5c, 0x74, 0x14, 0x9a, 0x87, 0x91, 0x47, 0x0f, 0x7b, 0xea, 0x46, 0x04, 0x15, 0xcb,
-	0xd1, 0xaf, 0x61, 0xc3, 0xc2, 0xd7, 0xda, 0xcc, 0xc5, 0x4e, 0x72, 0x82, 0x95, 0xf7, 0x99, 0x60,
-	0xdd, 0xc2, 0xd7, 0x03, 0x17, 0x3b, 0x09, 0x78, 0x15, 0xb6, 0x0d, 0x3c, 0xd2, 0x67, 0xa6, 0xa7,
-	0x8d, 0x88, 0x65, 0x68, 0xc4, 0x32, 0xf0, 0x5c, 0x9b, 0x92, 0xa1, 0x5b, 0x5b, 0xbd, 0x3f, 0x19,
-	0x9b, 0x81, 0xef, 0x31, 0xb1, 0x0c, 0x99, 0x7a, 0x2a, 0x64, 0xe8, 0xa2, 0x36, 0x6c, 0xf8, 0xdb,
-	0x2d, 0x8d, 0x57, 0x5d, 0xee, 0x58, 0xa6, 0xb1, 0x4e, 0xfc, 0x13, 0x7e, 0x45, 0x0c, 0x6c, 0x6b,
-	0x21, 0x45, 0xd5, 0xd6, 0x18, 0xd4, 0x4e, 0x06, 0xea, 0x28, 0x30, 0x60, 0x40, 0xe7, 0xd4, 0x27,
-	0x94, 0xa0, 0x5f, 0xc1, 0x13, 0x6c, 0xe9, 0x17, 0x26, 0xa6, 0xc1, 0x44, 0x8c, 0xe1, 0x62, 0x73,
-	0xa4, 0x39, 0x78, 0x6a, 0xde, 0xd4, 0x44, 0x86, 0x59, 0xcf, 0x60, 0x1e, 0xd8, 0xb6, 0xe9, 0x47,
-	0xb7, 0xe3, 0x03, 0x28, 0x64, 0x18, 0x50, 0x47, 0x0f, 0x9b, 0x23, 0x95, 0x3a, 0xa3, 0x0b, 0xd8,
-	0x5b, 0x84, 0x4e, 0x2e, 0x4c, 0x62, 0x8d, 0x83, 0x09, 0xd6, 0xef, 0x9d, 0x60, 0x37, 0x33, 0x81,
-	0x0f, 0xe0, 0xcf, 0xd1, 0x87, 0x5a, 0xaa, 0x54, 0x6c, 0x4b, 0xe0, 0x2b, 0x6c, 0x79, 0x6e, 0x0d,
-	0xdd, 0x9f, 0xdb, 0xad, 0x44, 0xad, 0xe8, 0x26, 0x90, 0x98, 0x67, 0xcc, 0x0d, 0xb7, 0x10, 0x37,
-	0x96, 0xe5, 0x86, 0x24, 0x5a, 0xbd, 0x0d, 0xab, 0xa9, 0x2d, 0x87, 0x7e, 0x02, 0x90, 0xd8, 0xb5,
-	0xb9, 0x3d, 0xee, 0x59, 0x75, 0x7f, 0x27, 0xb1, 0x6b, 0x63, 0x6b, 0xfa, 0x57, 0x4d, 0x18, 0x37,
-	0xfe, 0x51, 0x00, 0x88, 0xd5, 0x8d, 0x6f, 0x0a, 0xc0, 0x1d, 0xea, 0x53, 0x54, 0x81, 0xd2, 0xa0,
-	0xfb, 0xb3, 0xee, 0xd9, 0x9b, 0xae, 0xf8, 0x08, 0x55, 0x01, 0x14, 0xf9, 0x50, 0x3b, 0x54, 0xa5,
-	0x56, 0x5f, 0x12, 0x73, 0x68, 0x05, 0x04, 0x3a, 0x56, 0xa5, 0xd6, 0x91, 0x98, 0x47, 0xab, 0x50,
-	0xa6, 0x23, 0xb9, 0x7b, 0x24, 0xbd, 0x15, 0x39, 0xb4, 0x01, 0x6b, 0x74, 0xd8, 0x3b, 0x3b, 0xee,
-	0x6b, 0x47, 0x52, 0x47, 0xea, 0x4b, 0x62, 0x21, 0x14, 0x9e, 0xb6, 0xd4, 0xa3, 0x50, 0x58, 0x0c,
-	0x1d, 0x95, 0x81, 0x7a, 0x22, 0x89, 0x25, 0xf4, 0x11, 0x6c, 0xd3, 0xe1, 0x40, 0x39, 0x6a, 0xf5,
-	0x25, 0xed, 0x5c, 0x96, 0xde, 0x68, 0x87, 0x67, 0x83, 0x6e, 0x5f, 0x52, 0x45, 0x01, 0x21, 0xa8,
-	0x52, 0x65, 0xbf, 0x75, 0x12, 0x86, 0x51, 0x46, 0x8f, 0x01, 0xb1, 0xb0, 0xce, 0x5e, 0xbf, 0x96,
-	0xba, 0xfd, 0x50, 0x0e, 0xe1, 0x64, 0xe7, 0x67, 0x7d, 0x29, 0x14, 0x56, 0xd0, 0x1a, 0x54, 0x06,
-	0x3d, 0x49, 0x0d, 0x05, 0x3c, 0xaa, 0xc3, 0x63, 0x26, 0x08, 0xe6, 0x3b, 0x6c, 0x29, 0xad, 0x03,
-	0xb9, 0x23, 0xf7, 0x7f, 0x2e, 0xae, 0xd0, 0xd9, 0x98, 0x8e, 0xae, 0x50, 0xeb, 0x49, 0x9d, 0x63,
-	0x71, 0x15, 0xad, 0xc3, 0x6a, 0x2c, 0x6b, 0x75, 0x3a, 0x62, 0x15, 0xd5, 0x60, 0x93, 0x4e, 0x24,
-	0xbd, 0xed, 0x4b, 0xdd, 0x9e, 0x7c, 0xd6, 0x0d, 0xc1, 0xd7, 0xc2, 0xd0, 0x62, 0x0d, 0xcb, 0x95,
-	0x88, 0xf6, 0x60, 0x37, 0x19, 0x72, 0xc6, 0x73, 0x1d, 0x3d, 0x85, 0xfa, 0x62, 0x0b, 0x86, 0x80,
-	0xd0, 0x2e, 0xd4, 0xc2, 0x44, 0x64, 0xbc, 0x37, 0xe8, 0xa2, 0xb2, 0x5a, 0xe6, 0xb9, 0x89, 0x9e,
-	0xc0, 0x4e, 0x94, 0x96, 0x8c, 0xeb, 0x56, 0x98, 0xfe, 0x5b, 0x6a, 0xe6, 0xfb, 0x18, 0x6d, 0x82,
-	0x18, 0x2f, 0x5e, 0x19, 0x1c, 0x74, 0xe4, 0x43, 0x71, 0x3b, 0x9d, 0x26, 0x45, 0x3e, 0xec, 0x89,
-	0x35, 0xb4, 0x05, 0xeb, 0x29, 0x19, 0x8d, 0x45, 0xdc, 0x41, 0x3b, 0xb0, 0x95, 0x16, 0x07, 0x0b,
-	0x14, 0xeb, 0x34, 0x57, 0x69, 0x15, 0x0d, 0x41, 0xfc, 0x28, 0x0c, 0x28, 0xcc, 0x44, 0xb2, 0x9c,
-	0xbb, 0xe8, 0x3b, 0xf0, 0xff, 0x19, 0x65, 0x66, 0x51, 0x4f, 0x1a, 0xff, 0xe2, 0x80, 0x53, 0xc8,
-	0x10, 0x55, 0x21, 0x4f, 0x0c, 0xd6, 0xfd, 0x94, 0xd5, 0x3c, 0x31, 0x50, 0x0d, 0x4a, 0x57, 0xd8,
-	0x71, 0x29, 0xcb, 0xd1, 0xbe, 0x41, 0x54, 0xc3, 0x21, 0xfa, 0x12, 0x56, 0x86, 0x0e, 0xd6, 0x3d,
-	0x6c, 0x68, 0xb4, 0x17, 0x0b, 0xf8, 0x34, 0xcb, 0x27, 0xfd, 0xb0, 0x51, 0x53, 0x2b, 0x81, 0x3d,
-	0x95, 0xa0, 0xaf, 0x60, 0x75, 0x62, 0x1b, 0x64, 0x44, 0x42, 0xff, 0xb5, 0x7b, 0xfd, 0x57, 0x42,
-	0x07, 0x06, 0xf0, 0x3d, 0x10, 0xa7, 0xd8, 0x32, 0x28, 0xa1, 0x19, 0xd8, 0xc4, 0x8c, 0x88, 0xe9,
-	0x9d, 0x2b, 0xa8, 0x6b, 0x81, 0xfc, 0x28, 0x10, 0xa3, 0x27, 0x00, 0x57, 0x04, 0x5f, 0x6b, 0x43,
-	0x7b, 0x66, 0x79, 0xec, 0x56, 0xe5, 0xd4, 0x32, 0x95, 0x1c, 0x52, 0x01, 0xda, 0x01, 0xc1, 0x1d,
-	0xda, 0x0e, 0xd6, 0x4c, 0x9b, 0x5d, 0x64, 0x39, 0xb5, 0xc4, 0xc6, 0x1d, 0x3b, 0x56, 0x5d, 0x12,
-	0x76, 0x01, 0x85, 0xaa, 0x53, 0x82, 0x3e, 0x06, 0x9e, 0x76, 0x30, 0x01, 0x51, 0xa3, 0x04, 0x89,
-	0x28, 0x64, 0x48, 0x7b, 0x14, 0x95, 0xe9, 0xd1, 0x0f, 0xa0, 0xe8, 0xda, 0x33, 0x67, 0x88, 0x6b,
-	0x68, 0x8f, 0x7b, 0x56, 0xd9, 0xdf, 0x4c, 0x5b, 0xf6, 0x98, 0x4e, 0x0d, 0x6c, 0xd0, 0x2b, 0x58,
-	0x1d, 0x11, 0xc7, 0xf5, 0x7c, 0xf2, 0x23, 0x46, 0x40, 0x7c, 0xbb, 0x99, 0xb4, 0xf4, 0x3c, 0x87,
-	0x58, 0x63, 0x9f, 0xf9, 0x2a, 0xcc, 0x85, 0xf2, 0x9e, 0x6c, 0xb4, 0x79, 0x21, 0x2f, 0x72, 0x6d,
-	0x5e, 0xe0, 0x44, 0xbe, 0xcd, 0x0b, 0x05, 0xb1, 0xd8, 0xe6, 0x85, 0xa2, 0x58, 0x6a, 0xf3, 0x42,
-	0x49, 0x14, 0xda, 0xbc, 0x20, 0x88, 0xe5, 0x36, 0x2f, 0x54, 0xc4, 0x95, 0x36, 0x2f, 0xac, 0x8b,
-	0xa8, 0x81, 0x61, 0x4d, 0x21, 0xc3, 0x96, 0x65, 0xf4, 0x2f, 0x67, 0x93, 0x0b, 0x4b, 0x27, 0x26,
-	0xda, 0x03, 0x6e, 0x4a, 0x86, 0x41, 0x0f, 0x5c, 0x4d, 0xc7, 0xab, 0x52, 0x15, 0xfa, 0x21, 0x94,
-	0xbd, 0xd0, 0xbc, 0x96, 0x67, 0xeb, 0x5a, 0x94, 0x81, 0xd8, 0xa8, 0xf1, 0xb7, 0x3c, 0x40, 0x7c,
-	0x93, 0xa0, 0x2d, 0x28, 0xd2, 0xab, 0x29, 0xda, 0x6b, 0x85, 0x29, 0x19, 0xca, 0x06, 0xad, 0x54,
-	0x78, 0x5b, 0x11, 0x83, 0x75, 0xce, 0x65, 0xb5, 0x1c, 0x48, 0x64, 0x03, 0x3d, 0x87, 0xf5, 0x50,
-	0x3d, 0xd5, 0x9d, 0xc0, 0x8a, 0x63, 0x56, 0x6b, 0x81, 0x42, 0x61, 0x72, 0xd9, 0x40, 0x08, 0x78,
-	0x0f, 0xcf, 0x3d, 0xd6, 0x0d, 0x96, 0x55, 0xf6, 0x3f, 0xb3, 0x67, 0xf9, 0x0f, 0xdc, 0xb3, 0x85,
-	0x07, 0xee, 0xd9, 0xc4, 0x69, 0x2a, 0xa6, 0x4f, 0xd3, 0x4b, 0x28, 0x85, 0x15, 0x17, 0x96, 0xa8,
-	0x78, 0x71, 0xc6, 0x8a, 0xdd, 0x68, 0x41, 0x35, 0x4e, 0x6a, 0xdf, 0xc1, 0x18, 0xbd, 0x80, 0x52,
-	0x90, 0x09, 0x76, 0xbd, 0x55, 0xf6, 0xb7, 0xd2, 0x75, 0x09, 0x6c, 0xd5, 0xd0, 0xaa, 0xf1, 0xef,
-	0x7c, 0x12, 0xe3, 0xdc, 0xf6, 0xf0, 0x7b, 0x16, 0x27, 0xb1, 0x04, 0x6e, 0xf9, 0x25, 0xa0, 0x7d,
-	0xe0, 0xaf, 0x6c, 0xcf, 0xaf, 0x45, 0x75, 0xff, 0xe9, 0xc2, 0x68, 0x69, 0x54, 0x4d, 0xfa, 0xa3,
-	0x32, 0xdb, 0x64, 0x1e, 0x0b, 0x77, 0xb3, 0x52, 0xf1, 0x03, 0x2b, 0x5c, 0x7a, 0x58, 0x85, 0x1b,
-	0xfb, 0xc0, 0xb3, 0x14, 0xa6, 0xda, 0x82, 0x22, 0xe4, 0x07, 0x8a, 0x98, 0x43, 0x02, 0xf0, 0x47,
-	0x54, 0x92, 0xa7, 0xea, 0xae, 0x34, 0xe8, 0xab, 0xad, 0x8e, 0xc8, 0x35, 0xfe, 0xc4, 0x41, 0x29,
-	0x38, 0x31, 0x19, 0xfe, 0xfd, 0x14, 0x8a, 0x23, 0xdb, 0x99, 0xe8, 0x1e, 0xcb, 0x77, 0xba, 0x59,
-	0x09, 0x7c, 0x9a, 0xc7, 0xcc, 0x40, 0x0d, 0x0c, 0xd1, 0x26, 0x14, 0xae, 0x89, 0x11, 0xbc, 0x17,
-	0x0b, 0xaa, 0x3f, 0x40, 0x8f, 0xa1, 0x78, 0x89, 0xc9, 0xf8, 0xd2, 0x63, 0x89, 0x2e, 0xa8, 0xc1,
-	0x08, 0xbd, 0x04, 0x21, 0xea, 0x63, 0x0b, 0xf7, 0xf5, 0xb1, 0x91, 0x29, 0xda, 0x4d, 0x12, 0x40,
-	0x91, 0xd1, 0x6e, 0x2c, 0xc8, 0x54, 0xa1, 0xf4, 0x81, 0x55, 0x10, 0x1e, 0x78, 0xce, 0x10, 0xf0,
-	0x2e, 0xf9, 0x1d, 0x66, 0xf7, 0x01, 0xa7, 0xb2, 0xff, 0x8d, 0x23, 0x28, 0xfa, 0x89, 0x4a, 0xd7,
-	0x46, 0x00, 0xbe, 0xad, 0x48, 0x27, 0x62, 0x0e, 0x95, 0x80, 0x3b, 0x91, 0x8f, 0xc5, 0x3c, 0xfd,
-	0xa3, 0x74, 0x4f, 0x44, 0x8e, 0xea, 0xde, 0x48, 0x07, 0xaf, 0x45, 0x9e, 0x8a, 0x5e, 0x2b, 0x9f,
-	0x89, 0x85, 0xc6, 0x6b, 0x28, 0x47, 0xa4, 0x8d, 0x44, 0xe0, 0x66, 0x8e, 0x19, 0x54, 0x8b, 0xfe,
-	0x45, 0x75, 0x10, 0x1c, 0x3c, 0xc2, 0x8e, 0x83, 0x9d, 0x80, 0x97, 0xa2, 0x31, 0x0d, 0x8a, 0xbe,
-	0x76, 0x83, 0x83, 0xc3, 0xfe, 0x37, 0xfe, 0x99, 0x83, 0xa2, 0x42, 0x86, 0x7d, 0x7d, 0xfc, 0xae,
-	0x43, 0xb7, 0x05, 0x45, 0xfa, 0x22, 0x8c, 0x0e, 0x5c, 0xc1, 0xd3, 0xc7, 0x3e, 0xbb, 0x31, 0x30,
-	0x2e, 0x06, 0xfb, 0xdf, 0x65, 0xb7, 0xc6, 0x5f, 0xf3, 0x6c, 0x87, 0xdf, 0x45, 0x2e, 0x09, 0xf6,
-	0x28, 0x3d, 0x80, 0x3d, 0xbe, 0x1f, 0xb0, 0x07, 0xc7, 0x4e, 0xc7, 0x76, 0xfa, 0x74, 0xdc, 0x41,
-	0x1b, 0xf7, 0x34, 0x33, 0x85, 0x0f, 0x4c, 0x5d, 0xf1, 0x5b, 0xa0, 0x8d, 0xdf, 0x43, 0x55, 0x99,
-	0x5d, 0x98, 0x64, 0xc8, 0x2e, 0x7e, 0x6b, 0x64, 0xa3, 0xed, 0x38, 0x87, 0x7e, 0x6e, 0xc3, 0x2c,
-	0x6d, 0x42, 0x81, 0x7d, 0x02, 0x0a, 0xf7, 0x10, 0x1b, 0x64, 0x16, 0xcd, 0x3d, 0x68, 0xd1, 0x8d,
-	0x3f, 0xe6, 0xa0, 0xac, 0x5c, 0x7b, 0xa7, 0x58, 0x37, 0xb0, 0x83, 0x7e, 0x0a, 0x65, 0xdd, 0x1c,
-	0xdb, 0x0e, 0xf1, 0x2e, 0x27, 0x6c, 0xf6, 0x5b, 0x5c, 0x1e, 0x1a, 0x36, 0x5b, 0xa1, 0x95, 0x1a,
-	0x3b, 0x24, 0x2b, 0x93, 0x67, 0x67, 0x36, 0xda, 0x3a, 0x5f, 0x42, 0x39, 0xf2, 0x48, 0xa7, 0xa7,
-	0x0c, 0x85, 0xd3, 0xde, 0xfe, 0xcb, 0xcf, 0xc5, 0x1c, 0xfd, 0xab, 0xb2, 0xbf, 0xec, 0x91, 0x75,
-	0xda, 0x7b, 0xf9, 0xe9, 0xbe, 0x46, 0x87, 0x5c, 0xe3, 0x0f, 0x1c, 0x80, 0x72, 0xed, 0x29, 0xfa,
-	0x8d, 0x69, 0xeb, 0xac, 0x9d, 0x75, 0x67, 0x17, 0xbf, 0xc5, 0x43, 0x2f, 0xc8, 0x50, 0x38, 0xa4,
-	0x2f, 0x43, 0xcb, 0xf6, 0xb4, 0x0b, 0x3c, 0xb2, 0x1d, 0x1c, 0x7c, 0xb3, 0xbb, 0x2b, 0x15, 0x65,
-	0xcb, 0xf6, 0x0e, 0x98, 0x31, 0xfa, 0x31, 0xd0, 0x81, 0xa6, 0x8f, 0xbc, 0xe0, 0xd4, 0xdf, 0xed,
-	0x29, 0x58, 0xb6, 0xd7, 0xa2, 0xb6, 0xe8, 0x15, 0x54, 0x5d, 0x7b, 0xe4, 0x69, 0xb1, 0xf7, 0x12,
-	0xfb, 0x86, 0x7a, 0x74, 0x43, 0x84, 0xc7, 0x50, 0x24, 0xae, 0x3b, 0xc3, 0x0e, 0xdb, 0xd0, 0x65,
-	0x35, 0x18, 0xd1, 0xbe, 0xd5, 0xb3, 0xbf, 0xc6, 0x16, 0xdd, 0x0a, 0x05, 0x3f, 0xa1, 0x6c, 0x2c,
-	0x1b, 0xa8, 0x09, 0xbc, 0x77, 0x33, 0xf5, 0x39, 0xb9, 0xba, 0x5f, 0x4f, 0xd7, 0x28, 0xc8, 0x53,
-	0xb3, 0x7f, 0x33, 0xc5, 0x2a, 0xb3, 0x6b, 0xbc, 0x04, 0x9e, 0x8e, 0x32, 0xac, 0xd9, 0x1a, 0xf4,
-	0x4f, 0x03, 0xb2, 0x94, 0xdf, 0x8a, 0x5c, 0x83, 0x17, 0x72, 0x62, 0xee, 0x79, 0x49, 0x95, 0x8e,
-	0x55, 0xa9, 0x77, 0xea, 0x37, 0x9a, 0xea, 0x9a, 0x1f, 0x45, 0xd4, 0xac, 0x35, 0xbe, 0xc9, 0x03,
-	0x4f, 0xf7, 0xeb, 0xb7, 0xbb, 0x57, 0xb3, 0x07, 0x94, 0x7f, 0x20, 0xb7, 0xbd, 0x82, 0xaa, 0xa9,
-	0xbb, 0x9e, 0xe6, 0x62, 0x6c, 0x2d, 0xcd, 0x8e, 0xd4, 0xa3, 0x87, 0xb1, 0x75, 0x4f, 0xef, 0x97,
-	0xfe, 0x28, 0x51, 0x7a, 0xc8, 0x47, 0x89, 0xbf, 0x94, 0xa0, 0x1c, 0x7d, 0xef, 0x78, 0x77, 0x4e,
-	0x1b, 0xb0, 0x1a, 0x7f, 0x4c, 0x89, 0xef, 0x92, 0xca, 0x2c, 0x74, 0x95, 0x8d, 0x0f, 0xcd, 0x30,
-	0x86, 0x9a, 0x3d, 0xf3, 0xc6, 0x36, 0x7d, 0x8f, 0xcd, 0xa6, 0x2e, 0x76, 0x3c, 0xf6, 0xed, 0x29,
-	0x6a, 0xed, 0x2a, 0xfb, 0xcf, 0x13, 0x4b, 0x8a, 0x62, 0x6e, 0x9e, 0x05, 0x4e, 0x03, 0xe6, 0x13,
-	0x90, 0xf6, 0xe9, 0x23, 0x75, 0xcb, 0x5e, 0xa4, 0xa0, 0xd3, 0x10, 0x6b, 0x68, 0x4f, 0x16, 0x4d,
-	0x53, 0xb8, 0x63, 0x1a, 0x39, 0x70, 0xca, 0x4c, 0x43, 0x16, 0x29, 0xd0, 0x2f, 0x61, 0x33, 0x5a,
-	0x4d, 0xe2, 0x13, 0x5a, 0x70, 0x3e, 0xbf, 0x7b, 0xe7, 0x4a, 0xe2, 0xb6, 0xf5, 0xf4, 0x91, 0x8a,
-	0xec, 0x8c, 0x94, 0x82, 0x47, 0x6b, 0x48, 0x82, 0x97, 0xee, 0x00, 0x0f, 0xe3, 0x4f, 0x83, 0x93,
-	0x8c, 0x14, 0x7d, 0x05, 0x10, 0xe7, 0x25, 0x68, 0x9c, 0x9e, 0x2e, 0x84, 0x8c, 0x56, 0x7c, 0xfa,
-	0x48, 0x2d, 0xcf, 0xc2, 0x41, 0xbd, 0x09, 0x5b, 0x0b, 0x6b, 0xf2, 0x8e, 0x8b, 0xbb, 0x7e, 0x0e,
-	0x5b, 0x0b, 0x93, 0xfb, 0xae, 0x8b, 0xfe, 0x63, 0x58, 0x0b, 0x38, 0x37, 0x7a, 0xe3, 0xfa, 0xbb,
-	0x71, 0x35, 0x10, 0xfb, 0xef, 0xd8, 0x7a, 0x1b, 0x50, 0x36, 0xa3, 0xef, 0xf7, 0x34, 0xa9, 0x5f,
-	0x01, 0xca, 0x26, 0xf0, 0xbf, 0xff, 0x06, 0xad, 0x37, 0xa0, 0x1c, 0xe5, 0xe4, 0x1d, 0xd3, 0x1d,
-	0x14, 0x80, 0xc3, 0x57, 0xde, 0xf3, 0x2f, 0xa0, 0x1a, 0x7e, 0xaf, 0x50, 0xb1, 0xee, 0xda, 0x56,
-	0x86, 0x70, 0xbb, 0x67, 0x5d, 0x49, 0xcc, 0x21, 0x04, 0x55, 0x75, 0xd0, 0x91, 0xb4, 0x73, 0xf9,
-	0xac, 0xd3, 0xea, 0xcb, 0x67, 0x5d, 0x31, 0x7f, 0xf0, 0x09, 0xac, 0xda, 0xce, 0x38, 0xae, 0xb2,
-	0x92, 0xfb, 0xc5, 0xb6, 0x3f, 0xb0, 0x9d, 0xf1, 0x0b, 0xf6, 0xef, 0x85, 0x3e, 0x25, 0x5f, 0xe8,
-	0x53, 0xf2, 0xf7, 0x5c, 0xee, 0xa2, 0xc8, 0x0e, 0xf3, 0x8f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0xc9, 0xe8, 0x69, 0x61, 0x8c, 0x1b, 0x00, 0x00,
+	// 3503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0xe3, 0x46,
+	0x76, 0x1f, 0x12, 0xe0, 0xd7, 0xa3, 0x48, 0x42, 0x2d, 0x69, 0x44, 0xd1, 0xf3, 0x65, 0xc4, 0xf6,
+	0x3a, 0x4e, 0xcc, 0x59, 0x6b, 0x77, 0x76, 0xb3, 0x71, 0x1c, 0x9b, 0x22, 0x21, 0x89, 0x34, 0x45,
+	0xb2, 0x9a, 0xa4, 0xc6, 0x9b, 0x8f, 0x42, 0x20, 0xa2, 0xc9, 0xe9, 0x18, 0x04, 0x18, 0x00, 0x1c,
+	0x49, 0x9b, 0xaa, 0x54, 0xe5, 0x94, 0x5c, 0x72, 0xcc, 0xc5, 0x95, 0x53, 0xf2, 0xc7, 0xe4, 0x90,
+	0x54, 0xa5, 0x2a, 0xb9, 0xa4, 0x2a, 0x97, 0x1c, 0x92, 0x53, 0xaa, 0xf2, 0x2f, 0x24, 0xd5, 0x8d,
+	0x06, 0x09, 0x88, 0xd4, 0x50, 0x9a, 0xa9, 0x75, 0xf9, 0x22, 0xa1, 0xdf, 0xc7, 0xaf, 0x5f, 0xbf,
+	0x7e, 0xef, 0xf5, 0x6b, 0x80, 0x00, 0xa6, 0xe1, 0x1b, 0xd5, 0x99, 0xeb, 0xf8, 0x0e, 0xca, 0xcd,
+	0xe8, 0xd5, 0xdc, 0xad, 0x1a, 0x33, 0x5a, 0x79, 0x32, 0x71, 0x9c, 0x89, 0x45, 0x9e, 0x73, 0xc6,
+	0xc5, 0x7c, 0xfc, 0xdc, 0x9c, 0xbb, 0x86, 0x4f, 0x1d, 0x3b, 0x10, 0xad, 0x3c, 0xbd, 0xc9, 0xf7,
+	0xe9, 0x94, 0x78, 0xbe, 0x31, 0x9d, 0x09, 0x81, 0x15, 0x80, 0x4b, 0xd7, 0x98, 0xcd, 0x88, 0xeb,
+	0x05, 0x7c, 0xf5, 0x7f, 0xb7, 0x61, 0xf7, 0xc8, 0x18, 0x7d, 0x4b, 0x6c, 0xb3, 0xee, 0xd8, 0x63,
+	0x3a, 0x11, 0xf8, 0xa8, 0x09, 0x68, 0x4a, 0x6d, 0x7d, 0xe4, 0x4c, 0xa7, 0xc4, 0xf6, 0x75, 0x8b,
+	0xd8, 0x13, 0xff, 0x55, 0x39, 0xf1, 0x2c, 0xf1, 0x71, 0xfe, 0xf0, 0xbd, 0x6a, 0x80, 0x5a, 0x0d,
+	0x51, 0xab, 0x4d, 0xdb, 0xff, 0xd9, 0x4f, 0xcf, 0x0d, 0x6b, 0x4e, 0xb0, 0x32, 0xa5, 0x76, 0x3d,
+	0xd0, 0x6a, 0x73, 0x25, 0x0e, 0x65, 0x5c, 0xdd, 0x84, 0x4a, 0xde, 0x05, 0xca, 0xb8, 0x8a, 0x43,
+	0x69, 0xc0, 0xe0, 0x75, 0x6a, 0x46, 0x80, 0xa4, 0xcd, 0x40, 0xc5, 0x29, 0xb5, 0x9b, 0x66, 0x1c,
+	0xc6, 0xb8, 0x8a, 0xc3, 0xc8, 0x77, 0x81, 0x31, 0xae, 0xa2, 0x30, 0x6d, 0xd8, 0x65, 0xd6, 0x8c,
+	0xa9, 0x45, 0x74, 0xdb, 0x98, 0x92, 0x10, 0x2a, 0xb5, 0x19, 0x6a, 0x7b, 0x4a, 0xed, 0x63, 0x6a,
+	0x91, 0x8e, 0x31, 0x25, 0x11, 0x34, 0xe3, 0x6a, 0x15, 0x2d, 0x7d, 0x17, 0x34, 0xe3, 0xea, 0x06,
+	0x5a, 0x0d, 0xd8, 0xa2, 0xf5, 0xb9, 0x6b, 0x85, 0x38, 0x99, 0xcd, 0x38, 0x5b, 0x53, 0x6a, 0x0f,
+	0x5d, 0x2b, 0x02, 0x61, 0x5c, 0x45, 0x21, 0xb2, 0x77, 0x81, 0x30, 0xae, 0xe2, 0x10, 0xd4, 0xd6,
+	0x7d, 0x63, 0x12, 0x42, 0xe4, 0xee, 0x66, 0xc5, 0xc0, 0x98, 0xc4, 0xad, 0x88, 0x40, 0xc0, 0xdd,
+	0xac, 0x58, 0x42, 0xfc, 0x09, 0xec, 0x1a, 0xb6, 0x63, 0x5f, 0x4f, 0x9d, 0xb9, 0xa7, 0x8f, 0x8c,
+	0x99, 0x71, 0x41, 0x2d, 0xea, 0x5f, 0x97, 0xf3, 0x1c, 0xe8, 0xd3, 0xea, 0x22, 0xdf, 0xaa, 0xeb,
+	0x52, 0xa1, 0x5a, 0x5f, 0x68, 0xf4, 0x89, 0x8f, 0x77, 0x16, 0x50, 0x4b, 0x3a, 0xfa, 0x63, 0xd8,
+	0xb1, 0xc9, 0xa5, 0x3e, 0xf7, 0x88, 0x1b, 0x9d, 0x60, 0xeb, 0x6d, 0x26, 0xd8, 0xb6, 0xc9, 0xe5,
+	0xd0, 0x23, 0x6e, 0x04, 0x1e, 0xc3, 0xbe, 0x49, 0xc6, 0xc6, 0xdc, 0xf2, 0xf5, 0x31, 0xb5, 0x4d,
+	0x9d, 0xda, 0x26, 0xb9, 0xd2, 0x67, 0x74, 0xe4, 0x95, 0x0b, 0x9b, 0x9d, 0xb1, 0x2b, 0x74, 0x8f,
+	0xa9, 0x6d, 0x36, 0x99, 0x66, 0x8f, 0x8e, 0x3c, 0xd4, 0x82, 0x9d, 0x20, 0xdc, 0xe2, 0x78, 0xc5,
+	0xbb, 0xa5, 0x65, 0x1c, 0xeb, 0x24, 0xc8, 0xf0, 0xd7, 0xd4, 0x24, 0x8e, 0x1e, 0x96, 0xa8, 0x72,
+	0x89, 0x43, 0x1d, 0xac, 0x40, 0x35, 0x84, 0x00, 0x07, 0x3a, 0x67, 0x3a, 0x21, 0x05, 0xfd, 0x11,
+	0x3c, 0x26, 0xb6, 0x71, 0x61, 0x11, 0x66, 0xcc, 0xa2, 0x62, 0x78, 0xc4, 0x1a, 0xeb, 0x2e, 0x99,
+	0x59, 0xd7, 0x65, 0x85, 0x63, 0x56, 0x56, 0x30, 0x8f, 0x1c, 0xc7, 0x0a, 0xac, 0x3b, 0x08, 0x00,
+	0x7a, 0x74, 0x24, 0x4a, 0x47, 0x9f, 0x58, 0x63, 0xcc, 0x94, 0xd1, 0x05, 0x3c, 0x5b, 0x87, 0x4e,
+	0x2f, 0x2c, 0x6a, 0x4f, 0xc4, 0x04, 0xdb, 0x1b, 0x27, 0x78, 0xb4, 0x32, 0x41, 0x00, 0x10, 0xcc,
+	0x31, 0x80, 0x72, 0x6c, 0xab, 0x78, 0x48, 0x90, 0xd7, 0xc4, 0xf6, 0xbd, 0x32, 0xda, 0xec, 0xdb,
+	0xbd, 0xc8, 0x5e, 0xb1, 0x20, 0xd0, 0xb8, 0xe6, 0xb2, 0x36, 0xdc, 0x40, 0xdc, 0xb9, 0x6b, 0x6d,
+	0x88, 0xa1, 0x9d, 0xc0, 0x76, 0xcc, 0x46, 0xdf, 0x98, 0x78, 0xe5, 0xdd, 0xcd, 0x50, 0xa5, 0x88,
+	0x71, 0x03, 0x63, 0xe2, 0xa1, 0x2f, 0xa1, 0xb0, 0x30, 0x8b, 0x83, 0xec, 0x6d, 0x06, 0xc9, 0x0b,
+	0x7b, 0x38, 0xc0, 0x04, 0xf6, 0x6c, 0x62, 0xb8, 0xba, 0x39, 0x9f, 0x59, 0x74, 0x64, 0xf8, 0x44,
+	0x9f, 0x39, 0x16, 0x1d, 0x5d, 0x97, 0x1f, 0x72, 0xa0, 0x9f, 0x6c, 0xca, 0x9c, 0x0e, 0x31, 0xdc,
+	0x46, 0xa8, 0xdb, 0xe3, 0xaa, 0x78, 0xc7, 0x5e, 0x25, 0x2e, 0x2d, 0xb5, 0x88, 0xee, 0xd1, 0x5f,
+	0x91, 0xf2, 0xfe, 0x5d, 0x2d, 0xb5, 0x48, 0x9f, 0xfe, 0x8a, 0xa0, 0x2f, 0xa1, 0xc8, 0x1d, 0xef,
+	0x3b, 0xdf, 0x12, 0x5b, 0xf7, 0x7d, 0xab, 0x5c, 0xde, 0x14, 0xde, 0x5b, 0x4c, 0x61, 0xc0, 0xe4,
+	0x07, 0xbe, 0x85, 0x26, 0x50, 0xf6, 0x1d, 0x7f, 0xa6, 0xbb, 0xe4, 0xcf, 0xe6, 0xd4, 0x25, 0x66,
+	0xb4, 0x4e, 0x1c, 0xbc, 0x4d, 0x9d, 0x78, 0xc8, 0xe0, 0xb0, 0x40, 0x5b, 0xb2, 0x2a, 0x2d, 0x28,
+	0xc4, 0x04, 0xd1, 0x2f, 0x00, 0x22, 0x73, 0x25, 0x9e, 0x49, 0x1f, 0x17, 0x0f, 0x0f, 0x22, 0x73,
+	0x2d, 0xa5, 0xd9, 0x23, 0x8e, 0x08, 0x57, 0xfe, 0x25, 0x01, 0x3b, 0x6b, 0x7c, 0x8c, 0x30, 0xa4,
+	0x8d, 0x11, 0x4f, 0x72, 0xd6, 0x11, 0x14, 0x0f, 0x7f, 0xf7, 0x2d, 0x36, 0xaa, 0x5a, 0xe3, 0x08,
+	0x58, 0x20, 0xa1, 0xf7, 0x81, 0x55, 0x6d, 0xdd, 0xa4, 0x9e, 0x6f, 0xd8, 0x23, 0xc2, 0x1b, 0x04,
+	0x89, 0x6f, 0x42, 0x43, 0x90, 0xd4, 0x1a, 0xa4, 0x03, 0x25, 0x94, 0x87, 0xcc, 0xb0, 0xf3, 0x75,
+	0xa7, 0xfb, 0xb2, 0xa3, 0x3c, 0x40, 0x39, 0x48, 0xd5, 0xda, 0xed, 0xee, 0x4b, 0x25, 0x81, 0x00,
+	0xd2, 0x58, 0x6b, 0x69, 0xf5, 0x81, 0x92, 0x64, 0xe4, 0x33, 0x0d, 0x9f, 0x68, 0x8a, 0x84, 0xb2,
+	0x20, 0x1f, 0xb7, 0x6b, 0x27, 0x8a, 0xac, 0xfe, 0x5d, 0x06, 0x60, 0xb9, 0x60, 0xf5, 0x6f, 0x32,
+	0x20, 0xd5, 0x8d, 0x59, 0x1c, 0xaf, 0x08, 0xd0, 0x6b, 0xd6, 0xf5, 0x3a, 0xd6, 0x6a, 0x03, 0x4d,
+	0x49, 0xa0, 0x2d, 0xc8, 0xb2, 0x31, 0xd6, 0x6a, 0x0d, 0x25, 0x89, 0x0a, 0x90, 0x63, 0xa3, 0x66,
+	0xa7, 0xa1, 0x7d, 0xa3, 0x48, 0x68, 0x07, 0x4a, 0x6c, 0xd8, 0xef, 0x1e, 0x0f, 0xf4, 0x86, 0xd6,
+	0xd6, 0x06, 0x9a, 0x92, 0x0a, 0x89, 0xa7, 0x35, 0xdc, 0x08, 0x89, 0xe9, 0x50, 0xb1, 0x37, 0x64,
+	0x36, 0x65, 0xd0, 0x7b, 0xb0, 0xcf, 0x86, 0xc3, 0x5e, 0xa3, 0x36, 0xd0, 0xf4, 0xf3, 0xa6, 0xf6,
+	0x52, 0xaf, 0x77, 0x87, 0x9d, 0x81, 0x86, 0x95, 0x2c, 0x42, 0x50, 0x64, 0xcc, 0x41, 0xed, 0x24,
+	0x34, 0x23, 0x87, 0x1e, 0x02, 0xe2, 0x66, 0x75, 0xcf, 0xce, 0xb4, 0xce, 0x20, 0xa4, 0x43, 0x38,
+	0xd9, 0x79, 0x77, 0xa0, 0x85, 0xc4, 0x3c, 0x2a, 0x41, 0x7e, 0xd8, 0xd7, 0x70, 0x48, 0x90, 0x51,
+	0x05, 0x1e, 0x72, 0x82, 0x98, 0xaf, 0x5e, 0xeb, 0xd5, 0x8e, 0x9a, 0xed, 0xe6, 0xe0, 0x97, 0xca,
+	0x16, 0x9b, 0x8d, 0xf3, 0xd8, 0x0a, 0xf5, 0xbe, 0xd6, 0x3e, 0x56, 0x0a, 0x68, 0x1b, 0x0a, 0x4b,
+	0x5a, 0xad, 0xdd, 0x56, 0x8a, 0xa8, 0x0c, 0xbb, 0x6c, 0x22, 0xed, 0x9b, 0x81, 0xd6, 0xe9, 0x37,
+	0xbb, 0x9d, 0x10, 0xbc, 0x14, 0x9a, 0xb6, 0xe4, 0x70, 0x5f, 0x29, 0xe8, 0x19, 0x3c, 0x8a, 0x9a,
+	0xbc, 0xa2, 0xb9, 0x8d, 0x9e, 0x40, 0x65, 0xbd, 0x04, 0x47, 0x40, 0xe8, 0x11, 0x94, 0x43, 0x47,
+	0xac, 0x68, 0xef, 0xb0, 0x45, 0xad, 0x72, 0xb9, 0xe6, 0x2e, 0x7a, 0x0c, 0x07, 0x0b, 0xb7, 0xac,
+	0xa8, 0xee, 0x85, 0xee, 0xbf, 0xc1, 0xe6, 0xba, 0x0f, 0xd1, 0x2e, 0x28, 0xcb, 0xc5, 0xf7, 0x86,
+	0x47, 0xed, 0x66, 0x5d, 0xd9, 0x8f, 0xbb, 0xa9, 0xd7, 0xac, 0xf7, 0x95, 0x32, 0xda, 0x83, 0xed,
+	0x18, 0x8d, 0xd9, 0xa2, 0x1c, 0xa0, 0x03, 0xd8, 0x8b, 0x93, 0xc5, 0x02, 0x95, 0x0a, 0xf3, 0x55,
+	0x9c, 0xc5, 0x4c, 0x50, 0xde, 0x0b, 0x0d, 0x0a, 0x3d, 0x11, 0xdd, 0xce, 0x47, 0xe8, 0x43, 0x78,
+	0x7f, 0x85, 0xb9, 0xb2, 0xa8, 0xc7, 0xd1, 0xb0, 0x11, 0x61, 0xf7, 0x84, 0xad, 0x85, 0x8d, 0x6b,
+	0xed, 0x66, 0xad, 0x2f, 0x76, 0x5f, 0x79, 0xca, 0x3c, 0xc7, 0xa8, 0xcd, 0xb3, 0x5e, 0xbb, 0x59,
+	0xaf, 0x0d, 0x18, 0x8a, 0xe0, 0x3d, 0x0b, 0x03, 0x35, 0x48, 0x9e, 0xf7, 0x59, 0x28, 0x05, 0xe1,
+	0xdf, 0x1f, 0x74, 0xb1, 0xa6, 0xa8, 0x68, 0x1f, 0x76, 0x8e, 0x6a, 0xf5, 0xaf, 0x4f, 0x70, 0x77,
+	0xd8, 0x69, 0xe8, 0xad, 0xee, 0x51, 0xe0, 0xb6, 0xdf, 0x60, 0xab, 0xbe, 0xc1, 0xa8, 0xd7, 0x3a,
+	0x75, 0xad, 0xad, 0x7c, 0xc0, 0x40, 0x70, 0xb7, 0xad, 0x85, 0x93, 0x7c, 0xc8, 0xcc, 0x0a, 0x93,
+	0x4a, 0xef, 0xe1, 0xe6, 0x39, 0xa3, 0x7e, 0xa4, 0xfe, 0xa7, 0x0c, 0x52, 0x8f, 0x8e, 0x50, 0x11,
+	0x92, 0xd4, 0xe4, 0xc5, 0x25, 0x87, 0x93, 0xd4, 0x44, 0x65, 0xc8, 0xbc, 0x26, 0xae, 0xc7, 0x2a,
+	0x0e, 0x6b, 0xd4, 0x15, 0x1c, 0x0e, 0xd1, 0x17, 0xb0, 0x35, 0x72, 0x89, 0xe1, 0x13, 0x53, 0x67,
+	0x97, 0x1f, 0xd1, 0xc0, 0xac, 0x1e, 0xe0, 0x83, 0xf0, 0x66, 0x84, 0xf3, 0x42, 0x9e, 0x51, 0xf8,
+	0xc1, 0xe0, 0x98, 0x74, 0x4c, 0x43, 0xfd, 0xd2, 0x46, 0xfd, 0xad, 0x50, 0x81, 0x03, 0xfc, 0x26,
+	0x28, 0x33, 0x62, 0x9b, 0xac, 0x83, 0x30, 0x89, 0x45, 0x78, 0x51, 0x64, 0x4d, 0x6e, 0x16, 0x97,
+	0x04, 0xbd, 0x21, 0xc8, 0xe8, 0x31, 0xc0, 0x6b, 0x4a, 0x2e, 0xf5, 0x91, 0x33, 0xb7, 0x7d, 0xde,
+	0xc6, 0x4a, 0x38, 0xc7, 0x28, 0x75, 0x46, 0x40, 0x07, 0x90, 0xf5, 0x46, 0x8e, 0x4b, 0x74, 0xcb,
+	0xe1, 0x9d, 0x63, 0x02, 0x67, 0xf8, 0xb8, 0xed, 0x2c, 0x59, 0xaf, 0x28, 0xef, 0xf8, 0x42, 0xd6,
+	0x29, 0x45, 0x1f, 0x81, 0xcc, 0x4e, 0x35, 0xd1, 0x19, 0xa1, 0x48, 0x21, 0xee, 0xd1, 0x11, 0x3b,
+	0xbe, 0x30, 0xe7, 0xa3, 0xdf, 0x86, 0xb4, 0xe7, 0xcc, 0xdd, 0x11, 0x29, 0xa3, 0x67, 0xd2, 0xc7,
+	0xf9, 0xc3, 0xdd, 0xb8, 0x64, 0x9f, 0xf3, 0xb0, 0x90, 0x41, 0x5f, 0x41, 0x61, 0x4c, 0x5d, 0xcf,
+	0x0f, 0xba, 0x0d, 0x6a, 0x8a, 0x4e, 0xe3, 0xd1, 0x8a, 0x5b, 0xfa, 0xbe, 0x4b, 0xed, 0x89, 0x38,
+	0x30, 0xb9, 0x0a, 0x6b, 0x34, 0x9a, 0x26, 0xfa, 0x14, 0x76, 0x96, 0xa7, 0xba, 0x33, 0xe6, 0x2d,
+	0x17, 0x35, 0x79, 0x9b, 0x91, 0xc3, 0xca, 0x82, 0xd5, 0x1d, 0xf7, 0xe8, 0xa8, 0x69, 0xa2, 0xdf,
+	0x61, 0xbe, 0xf1, 0xa8, 0x38, 0xa4, 0x82, 0x3e, 0xa2, 0x1c, 0x37, 0xf1, 0x7c, 0xc1, 0xc7, 0x11,
+	0xd9, 0x96, 0x9c, 0x4d, 0x2a, 0x52, 0x4b, 0xce, 0x4a, 0x8a, 0xdc, 0x92, 0xb3, 0x29, 0x25, 0xdd,
+	0x92, 0xb3, 0x69, 0x25, 0xd3, 0x92, 0xb3, 0x19, 0x25, 0xdb, 0x92, 0xb3, 0x59, 0x25, 0xd7, 0x92,
+	0xb3, 0x79, 0x65, 0xab, 0x25, 0x67, 0xb7, 0x15, 0xa4, 0xfe, 0x6d, 0x02, 0x0a, 0x31, 0x34, 0xf4,
+	0x19, 0xc8, 0x53, 0xc7, 0x24, 0xe2, 0x2c, 0x7b, 0x7c, 0xdb, 0xac, 0xd5, 0x33, 0xc7, 0x24, 0x98,
+	0x8b, 0xa2, 0x7d, 0xc8, 0x84, 0x9e, 0x49, 0x3e, 0x93, 0x3e, 0xce, 0xe1, 0xf4, 0x3c, 0x58, 0xf6,
+	0x3e, 0x64, 0x5c, 0xc7, 0x22, 0x8c, 0x21, 0x05, 0x0c, 0x36, 0x6c, 0x9a, 0xea, 0x53, 0x90, 0x99,
+	0x3e, 0x3b, 0xa1, 0x44, 0x41, 0x79, 0xc0, 0x4e, 0x9d, 0x30, 0xf4, 0x13, 0xea, 0x5f, 0x66, 0xa1,
+	0xc0, 0xce, 0xce, 0x89, 0xeb, 0xcc, 0x6d, 0xb3, 0xe5, 0x5c, 0xac, 0x24, 0xc1, 0x67, 0x20, 0xfb,
+	0xd7, 0xb3, 0xe0, 0x64, 0x8c, 0xdb, 0x19, 0xd3, 0xab, 0x0e, 0xae, 0x67, 0x04, 0x73, 0xd1, 0xa8,
+	0x9d, 0x12, 0xc7, 0x09, 0xed, 0xdc, 0x83, 0xb4, 0xd8, 0x11, 0x99, 0xd3, 0x53, 0x33, 0xbe, 0x0d,
+	0x0a, 0x48, 0x73, 0xd7, 0xe2, 0x37, 0xd8, 0x1c, 0x66, 0x8f, 0x2b, 0xf9, 0x95, 0x7e, 0xc7, 0xfc,
+	0xca, 0xdc, 0x33, 0xbf, 0x7e, 0x0e, 0x69, 0xcf, 0x37, 0xfc, 0xb9, 0x27, 0x6e, 0x9f, 0x4f, 0x6f,
+	0x5d, 0x76, 0x9f, 0x8b, 0x61, 0x21, 0x5e, 0xf9, 0x4e, 0x82, 0x74, 0x40, 0x42, 0x9f, 0x43, 0x8a,
+	0x11, 0xc3, 0x1d, 0xfe, 0x70, 0x03, 0x04, 0xff, 0x47, 0x70, 0xa0, 0x83, 0x2a, 0x90, 0x35, 0x7c,
+	0x9f, 0x4c, 0x67, 0xbe, 0x27, 0x7a, 0x92, 0xc5, 0x98, 0x65, 0xb4, 0x65, 0x78, 0xbe, 0x4e, 0x5c,
+	0xd7, 0x71, 0x85, 0x87, 0x73, 0x8c, 0xa2, 0x31, 0x02, 0xfa, 0x7d, 0x28, 0xd8, 0xe4, 0xca, 0xd7,
+	0xdd, 0xb9, 0x1d, 0x2c, 0x5e, 0xde, 0xec, 0x3c, 0xa6, 0x80, 0xe7, 0x76, 0xe8, 0xbc, 0x31, 0xb5,
+	0xa9, 0xf7, 0x2a, 0x74, 0x5e, 0x6a, 0xb3, 0xf3, 0x42, 0x05, 0x0e, 0xf0, 0x05, 0x64, 0x67, 0xae,
+	0x33, 0x71, 0x89, 0xe7, 0x89, 0x8d, 0x7b, 0xff, 0xd6, 0xb5, 0xf7, 0x84, 0x20, 0x5e, 0xa8, 0xa8,
+	0xdf, 0x40, 0x8a, 0xbb, 0x22, 0xde, 0x1e, 0xb1, 0xa8, 0xd5, 0x3a, 0x8d, 0x66, 0xe7, 0x44, 0x49,
+	0xb0, 0x01, 0x1e, 0x76, 0x3a, 0x6c, 0xc0, 0x5b, 0xa3, 0xfe, 0xb0, 0x5e, 0xd7, 0xb4, 0x86, 0xd6,
+	0x50, 0x24, 0x16, 0xea, 0xc7, 0xb5, 0x66, 0x5b, 0x6b, 0x28, 0x32, 0x63, 0x05, 0x67, 0x01, 0x1b,
+	0xa6, 0x2a, 0xff, 0x9c, 0x80, 0x6c, 0x38, 0x21, 0xfa, 0x82, 0x6f, 0xcf, 0x24, 0xdc, 0x9e, 0x1f,
+	0x6d, 0x34, 0x91, 0x6d, 0xd0, 0x24, 0xd8, 0xa0, 0x09, 0xaf, 0xc0, 0xa6, 0x73, 0x69, 0x5b, 0x8e,
+	0x61, 0x12, 0x53, 0xbf, 0xb8, 0xf6, 0x49, 0xb8, 0x51, 0xa5, 0x25, 0xfd, 0x88, 0x91, 0xd1, 0x53,
+	0xc8, 0xfb, 0x8e, 0x6f, 0x58, 0x42, 0x4a, 0xe2, 0x52, 0xc0, 0x49, 0x5c, 0x40, 0x7d, 0xc1, 0x57,
+	0x3c, 0xb9, 0xb1, 0xe2, 0x12, 0xe4, 0x1b, 0xdd, 0x97, 0x9d, 0x76, 0xb7, 0x26, 0x56, 0xcd, 0x3a,
+	0x44, 0xdc, 0xad, 0x6b, 0xfd, 0x3e, 0x5f, 0xb8, 0x7a, 0x0c, 0x32, 0x4b, 0xba, 0xb8, 0x56, 0x01,
+	0x72, 0x83, 0xd3, 0xe1, 0xd9, 0x51, 0xa7, 0xd6, 0x6c, 0x2b, 0x09, 0x36, 0x3c, 0xd6, 0x06, 0xf5,
+	0x53, 0x7d, 0x88, 0xdb, 0x4a, 0x92, 0x75, 0x6d, 0x91, 0xf6, 0x90, 0x35, 0x02, 0x8a, 0xa4, 0x12,
+	0x28, 0xf5, 0xe8, 0xa8, 0x66, 0x9b, 0x83, 0x57, 0xf3, 0xe9, 0x85, 0x6d, 0x50, 0x0b, 0x3d, 0x03,
+	0x69, 0x46, 0x47, 0xe2, 0xcd, 0x5b, 0x31, 0x5e, 0x9b, 0x30, 0x63, 0xa1, 0x1f, 0x43, 0xce, 0x0f,
+	0xc5, 0x79, 0x35, 0x5a, 0x7f, 0x0c, 0x2c, 0x85, 0xd4, 0x7f, 0x4b, 0x02, 0x2c, 0xef, 0xaf, 0x91,
+	0x5a, 0x90, 0x88, 0xd6, 0x82, 0xc7, 0x00, 0xe1, 0x1d, 0x99, 0x97, 0x39, 0x1e, 0xdc, 0x82, 0xd2,
+	0x34, 0xd1, 0x27, 0xb0, 0x1d, 0xb2, 0x67, 0x86, 0x2b, 0xa4, 0x82, 0x14, 0x28, 0x09, 0x46, 0x8f,
+	0xd3, 0x9b, 0x26, 0x42, 0x20, 0xfb, 0xe4, 0xca, 0xe7, 0xc9, 0x9f, 0xc3, 0xfc, 0x79, 0xa5, 0xb0,
+	0xc8, 0xef, 0x58, 0x58, 0x52, 0xf7, 0x2c, 0x2c, 0x91, 0x96, 0x22, 0x1d, 0x6f, 0x29, 0x5e, 0x2c,
+	0x8b, 0x66, 0xf6, 0x0e, 0xc7, 0x9e, 0x28, 0xa9, 0x6a, 0x0d, 0x8a, 0x4b, 0xa7, 0x0e, 0x5c, 0x42,
+	0xd0, 0x73, 0xc8, 0x08, 0x4f, 0xf0, 0x6b, 0x57, 0xfe, 0x70, 0x2f, 0xbe, 0x2f, 0x42, 0x16, 0x87,
+	0x52, 0xea, 0xff, 0x25, 0xa3, 0x18, 0xe7, 0x8e, 0x4f, 0xde, 0x72, 0x73, 0x5e, 0xc4, 0xeb, 0xfe,
+	0x1d, 0x97, 0x80, 0x0e, 0x41, 0x7e, 0xed, 0xf8, 0xc1, 0x5e, 0x14, 0x0f, 0x9f, 0xac, 0xb5, 0x96,
+	0x59, 0x55, 0x65, 0x7f, 0x30, 0x97, 0x8d, 0xfa, 0x31, 0xf5, 0xe6, 0xd6, 0xec, 0x7b, 0x3e, 0x3a,
+	0xd4, 0x43, 0x90, 0xb9, 0x0b, 0x63, 0x59, 0x99, 0x86, 0xe4, 0xb0, 0xa7, 0x24, 0xd8, 0x95, 0x90,
+	0xe5, 0xb4, 0x92, 0x64, 0xec, 0x8e, 0x36, 0x1c, 0xe0, 0x5a, 0x5b, 0x91, 0xd4, 0x7f, 0x94, 0x20,
+	0x23, 0x32, 0x66, 0xcd, 0xf9, 0x9b, 0x1e, 0x3b, 0xee, 0xd4, 0xf0, 0xc5, 0x09, 0x7c, 0xb0, 0x9a,
+	0x65, 0xd5, 0x63, 0x2e, 0x80, 0x85, 0x20, 0xda, 0x85, 0xd4, 0x25, 0x35, 0xc5, 0x5b, 0xea, 0x14,
+	0x0e, 0x06, 0xe8, 0x21, 0xa4, 0x5f, 0x11, 0x3a, 0x79, 0xe5, 0x73, 0x47, 0xa7, 0xb0, 0x18, 0xa1,
+	0x17, 0x90, 0x5d, 0xbc, 0x3d, 0x4b, 0x6d, 0x7a, 0xbd, 0xb0, 0x10, 0x45, 0x8f, 0xa2, 0x05, 0x20,
+	0xcd, 0x7b, 0xcf, 0x25, 0x61, 0x65, 0x17, 0x32, 0xef, 0xb8, 0x0b, 0xd9, 0x7b, 0xe6, 0x19, 0x02,
+	0x99, 0xbf, 0x71, 0xc9, 0xf1, 0x62, 0xcb, 0x9f, 0xd5, 0x0b, 0x48, 0x07, 0x8e, 0x8a, 0xef, 0x4d,
+	0x16, 0xe4, 0x56, 0x4f, 0x63, 0x05, 0x36, 0x03, 0xd2, 0x49, 0xf3, 0x58, 0x49, 0xb2, 0x87, 0x5e,
+	0xe7, 0x24, 0xb8, 0xc2, 0xbf, 0xd4, 0x8e, 0xce, 0x14, 0x99, 0x91, 0xce, 0x7a, 0x3f, 0x55, 0x52,
+	0x82, 0xd4, 0x53, 0xd2, 0xec, 0xa9, 0x76, 0xde, 0x3c, 0x56, 0x32, 0xec, 0xe9, 0x54, 0x6b, 0xd6,
+	0x95, 0xac, 0x7a, 0x06, 0xb9, 0x45, 0x5f, 0x1b, 0xf6, 0x35, 0x89, 0x65, 0x5f, 0x53, 0x81, 0xac,
+	0x4b, 0xc6, 0xc4, 0x75, 0x49, 0x78, 0x70, 0x2f, 0xc6, 0xcc, 0x64, 0xdb, 0x98, 0x12, 0x91, 0x56,
+	0xfc, 0x59, 0xfd, 0xaf, 0x04, 0xa4, 0x7b, 0x74, 0x34, 0x30, 0x26, 0xb7, 0xa5, 0xe4, 0x1e, 0xa4,
+	0x7d, 0x63, 0xb2, 0x4c, 0xc7, 0x94, 0x6f, 0x4c, 0x82, 0xda, 0xc7, 0xc1, 0xa4, 0x25, 0xd8, 0x0f,
+	0xb7, 0xf6, 0xa9, 0xff, 0x9a, 0xe4, 0xf1, 0xff, 0xa6, 0xd2, 0x13, 0xa9, 0x2d, 0x99, 0x7b, 0xd4,
+	0x96, 0xdf, 0x12, 0xb5, 0x45, 0xe2, 0xb9, 0xb3, 0x7f, 0xa3, 0xcb, 0xbe, 0xbd, 0xa8, 0x6c, 0xb8,
+	0xef, 0xa5, 0xde, 0xd1, 0x75, 0xe9, 0xef, 0xa1, 0xa8, 0xfc, 0x05, 0x14, 0x7b, 0xf3, 0x0b, 0x8b,
+	0x8e, 0xf8, 0xdd, 0xc8, 0x1e, 0x3b, 0xd1, 0xbe, 0x3c, 0x11, 0xeb, 0xcb, 0x77, 0x21, 0xc5, 0x3f,
+	0x4b, 0x85, 0x31, 0xc4, 0x07, 0x2b, 0x8b, 0x96, 0xee, 0xb5, 0x68, 0xf5, 0xef, 0x13, 0x90, 0xeb,
+	0x5d, 0xfa, 0xa7, 0xc4, 0x30, 0x89, 0x8b, 0x7e, 0x0f, 0x72, 0x86, 0x35, 0x71, 0x5c, 0xea, 0xbf,
+	0x9a, 0x8a, 0x96, 0x2b, 0x56, 0xe9, 0x43, 0xc1, 0x6a, 0x2d, 0x94, 0xc2, 0x4b, 0x85, 0xe8, 0xce,
+	0x04, 0x4d, 0xd6, 0x22, 0x74, 0xbe, 0x80, 0xdc, 0x42, 0x63, 0xe5, 0x05, 0xdd, 0x69, 0xff, 0xf0,
+	0xc5, 0xcf, 0x94, 0x04, 0x7b, 0xc4, 0xfc, 0x91, 0x77, 0x8b, 0xa7, 0xfd, 0x17, 0x9f, 0x1d, 0xea,
+	0x6c, 0x28, 0xa9, 0xdf, 0x49, 0x00, 0xbd, 0x4b, 0xbf, 0x67, 0x5c, 0xb3, 0x8e, 0x8d, 0xcd, 0xe3,
+	0xcd, 0x2f, 0xfe, 0x94, 0x8c, 0x7c, 0xe1, 0xa1, 0x70, 0x88, 0x7e, 0x01, 0x60, 0x3b, 0xbe, 0x7e,
+	0x41, 0xc6, 0x8e, 0x4b, 0xc4, 0x77, 0xc4, 0x37, 0xb9, 0x22, 0x67, 0x3b, 0xfe, 0x11, 0x17, 0x46,
+	0x3f, 0x07, 0x36, 0xd0, 0x8d, 0xb1, 0x2f, 0xb2, 0xfe, 0xcd, 0x9a, 0x59, 0xdb, 0xf1, 0x6b, 0x4c,
+	0x16, 0x7d, 0x05, 0x45, 0xcf, 0x19, 0xfb, 0xfa, 0x52, 0xfb, 0x0e, 0x71, 0xc3, 0x34, 0x3a, 0x21,
+	0xc2, 0x43, 0x48, 0x53, 0xcf, 0x9b, 0x13, 0x57, 0x5c, 0xb8, 0xc4, 0x88, 0x5d, 0xed, 0x83, 0x77,
+	0xca, 0xd4, 0xe4, 0xb1, 0x2c, 0xe1, 0x0c, 0x1f, 0x37, 0x4d, 0x54, 0x15, 0xf7, 0xbd, 0x0c, 0xdf,
+	0xa3, 0x4a, 0x7c, 0x8f, 0x84, 0x9f, 0x22, 0x97, 0x3d, 0xf5, 0x68, 0x5d, 0x17, 0xca, 0x4a, 0xe3,
+	0x70, 0x70, 0x2a, 0x4a, 0x69, 0xf3, 0x1b, 0x45, 0x62, 0xfc, 0x5a, 0xaf, 0xa9, 0x7f, 0xad, 0xfd,
+	0x52, 0x91, 0x55, 0x39, 0x9b, 0x50, 0x12, 0x9f, 0x64, 0xb0, 0x76, 0x8c, 0xb5, 0xfe, 0x69, 0x70,
+	0x5f, 0xc6, 0xa5, 0xc0, 0xa4, 0x45, 0x5f, 0xa7, 0xfe, 0x4f, 0x02, 0x24, 0x51, 0xfa, 0x44, 0x8d,
+	0x4b, 0xac, 0xab, 0x71, 0x91, 0x82, 0xc9, 0x7a, 0xed, 0xb9, 0x67, 0x4c, 0x88, 0x78, 0xdd, 0x21,
+	0x7a, 0x6d, 0x4e, 0x0a, 0xde, 0x77, 0xfc, 0x70, 0x8b, 0xe0, 0x7f, 0x27, 0x41, 0x66, 0xa9, 0xfa,
+	0xfd, 0xa6, 0xe9, 0xea, 0x8a, 0xe4, 0x7b, 0xae, 0xe8, 0x2b, 0x28, 0xf2, 0xeb, 0xa8, 0x47, 0x88,
+	0x7d, 0x67, 0x9f, 0x30, 0x8d, 0x3e, 0x21, 0xf6, 0x86, 0xa6, 0x38, 0xfe, 0x15, 0x21, 0x73, 0x8f,
+	0xaf, 0x08, 0xd1, 0x77, 0x22, 0xd9, 0xd8, 0x3b, 0x91, 0xbf, 0x4a, 0x42, 0x6e, 0x18, 0x7e, 0x24,
+	0x89, 0x65, 0x82, 0x48, 0xf9, 0x30, 0x13, 0x6e, 0x3a, 0x36, 0x79, 0x3f, 0xc7, 0xae, 0xfa, 0x45,
+	0xba, 0xa7, 0x5f, 0x1e, 0x03, 0xf0, 0x40, 0x30, 0x26, 0x6c, 0xd3, 0x83, 0x0c, 0xce, 0x31, 0x4a,
+	0x8d, 0x11, 0xd0, 0x87, 0x50, 0x74, 0xc9, 0xd4, 0xf1, 0x89, 0x6e, 0x98, 0x26, 0xbf, 0x6d, 0x07,
+	0x6f, 0x50, 0x0a, 0x01, 0xb5, 0x16, 0x10, 0x99, 0x77, 0x47, 0x73, 0x97, 0xe5, 0x94, 0x68, 0xd3,
+	0xc2, 0xa1, 0xfa, 0x0f, 0x49, 0x48, 0xd7, 0x66, 0xf4, 0x6b, 0x72, 0x8d, 0x1e, 0x01, 0x18, 0x33,
+	0xaa, 0x7f, 0x4b, 0xae, 0x97, 0x8e, 0xc8, 0x1a, 0x9c, 0x77, 0x4b, 0xa6, 0xbd, 0x63, 0xd8, 0x7d,
+	0x0e, 0x79, 0x72, 0x35, 0xa3, 0x2e, 0xb9, 0x6b, 0xd0, 0x41, 0x20, 0xce, 0x95, 0xe3, 0x61, 0x91,
+	0xba, 0x4f, 0x58, 0xfc, 0x18, 0x76, 0x0d, 0xcb, 0x72, 0x2e, 0x89, 0xa9, 0x0b, 0xe7, 0xb9, 0x86,
+	0x3d, 0x61, 0x27, 0x32, 0x8b, 0x11, 0x24, 0x78, 0x98, 0xb3, 0x30, 0xe3, 0xa8, 0x7f, 0x9d, 0x04,
+	0x19, 0x3b, 0x16, 0x89, 0x46, 0x94, 0xc8, 0xcb, 0x20, 0xa2, 0xd6, 0xba, 0x27, 0x6e, 0xa2, 0x74,
+	0x1f, 0x13, 0x7f, 0xb8, 0x25, 0xea, 0x9f, 0xb6, 0x82, 0xd4, 0xe1, 0x9f, 0x74, 0x6f, 0xaf, 0x53,
+	0x2a, 0x14, 0x96, 0xdf, 0x8b, 0x97, 0xad, 0x69, 0x7e, 0x1e, 0xaa, 0xae, 0x49, 0xae, 0x7b, 0x86,
+	0x0f, 0x81, 0xb2, 0x33, 0xf7, 0x27, 0x0e, 0xb5, 0x27, 0xfa, 0x7c, 0xe6, 0x11, 0xd7, 0xe7, 0xef,
+	0x7a, 0x17, 0xf7, 0xc8, 0xfc, 0xe1, 0x27, 0x11, 0x67, 0x2f, 0x6c, 0xae, 0x76, 0x85, 0xd2, 0x90,
+	0xeb, 0x88, 0x1e, 0xf0, 0xf4, 0x01, 0xde, 0x73, 0xd6, 0x31, 0xd8, 0x34, 0xd4, 0x1e, 0x39, 0xd3,
+	0x75, 0xd3, 0xa4, 0xde, 0x30, 0x4d, 0x53, 0x28, 0xad, 0x4c, 0x43, 0xd7, 0x31, 0xd0, 0x1f, 0xc2,
+	0xee, 0x62, 0x35, 0x91, 0x5f, 0x09, 0x88, 0xe3, 0xfe, 0x47, 0x6f, 0x5c, 0xc9, 0xf2, 0x8e, 0x7c,
+	0xfa, 0x00, 0x23, 0x67, 0x85, 0xca, 0xc0, 0x17, 0x6b, 0x88, 0x82, 0x67, 0xde, 0x00, 0x1e, 0xda,
+	0x1f, 0x07, 0xa7, 0x2b, 0x54, 0xf4, 0x25, 0xc0, 0xd2, 0x2f, 0xe2, 0x96, 0xf6, 0x64, 0x2d, 0xe4,
+	0x62, 0xc5, 0xa7, 0x0f, 0x70, 0x6e, 0x1e, 0x0e, 0x50, 0x1b, 0x4a, 0x2c, 0x0f, 0x5f, 0x07, 0x3f,
+	0x8f, 0xe0, 0xdf, 0xf3, 0x83, 0x5f, 0xeb, 0xa8, 0x6b, 0x51, 0x30, 0x97, 0x0d, 0xae, 0x44, 0xde,
+	0xe9, 0x83, 0xa0, 0xd6, 0x2d, 0x08, 0xe8, 0x08, 0xf2, 0xf3, 0x99, 0x69, 0xb0, 0xac, 0x76, 0x2c,
+	0x22, 0x7e, 0xb4, 0xf3, 0xf4, 0x16, 0x7b, 0x98, 0x1c, 0x4b, 0xec, 0xd3, 0x07, 0x18, 0xe6, 0x8b,
+	0x11, 0xc2, 0xb0, 0x2d, 0x30, 0x78, 0x10, 0x33, 0x20, 0x4f, 0xfc, 0x6a, 0xe7, 0x83, 0x37, 0x20,
+	0xb1, 0x31, 0xd3, 0x67, 0x56, 0x95, 0xe6, 0x71, 0x52, 0xa5, 0x0a, 0x7b, 0x6b, 0x23, 0xef, 0x96,
+	0xdb, 0x4e, 0xe5, 0x1c, 0xf6, 0xd6, 0x86, 0xd0, 0x6d, 0xb7, 0xa3, 0x8f, 0xa0, 0x24, 0x1a, 0x55,
+	0x7d, 0xf9, 0x85, 0x80, 0x9f, 0x05, 0x82, 0x1c, 0x7c, 0x1f, 0xa9, 0xb4, 0x00, 0xad, 0xc6, 0xcd,
+	0xdb, 0xbd, 0xed, 0xa9, 0xbc, 0x06, 0xb4, 0x1a, 0x26, 0xbf, 0xfe, 0xd7, 0x7a, 0x15, 0x15, 0x72,
+	0x0b, 0x9f, 0xdc, 0xe6, 0xbf, 0x1a, 0x14, 0x62, 0x91, 0x72, 0x9b, 0x59, 0xec, 0xf4, 0x37, 0x26,
+	0xba, 0xa8, 0xde, 0x12, 0x3f, 0xfd, 0x8d, 0x49, 0xc7, 0x98, 0x92, 0xca, 0x7f, 0x24, 0x00, 0x96,
+	0x31, 0x72, 0xbf, 0xe2, 0xcf, 0x8e, 0xdc, 0xa0, 0x58, 0xf1, 0x45, 0xb0, 0x23, 0x37, 0x18, 0xf2,
+	0x96, 0x9e, 0xf8, 0xd1, 0x9f, 0x61, 0xc8, 0x9b, 0x8e, 0x86, 0x82, 0x47, 0xfc, 0xc8, 0xcf, 0xb2,
+	0x1a, 0xa0, 0x8c, 0x2c, 0x62, 0xc4, 0x7e, 0xf2, 0xb5, 0xf1, 0x04, 0x2c, 0x71, 0x95, 0xc8, 0xef,
+	0x35, 0xfe, 0x1c, 0x4a, 0x37, 0xc2, 0x16, 0x7d, 0x00, 0x45, 0x27, 0x1e, 0x42, 0xc1, 0x42, 0xb7,
+	0x9c, 0x48, 0x04, 0xa1, 0x27, 0x90, 0x67, 0x0b, 0x08, 0x7d, 0x11, 0x38, 0x2d, 0xe7, 0x11, 0x1f,
+	0x07, 0xee, 0x50, 0xa1, 0x10, 0x98, 0x17, 0xff, 0x20, 0x95, 0xe7, 0xc4, 0x40, 0xe6, 0x28, 0x05,
+	0x12, 0x79, 0xed, 0x7f, 0xd2, 0x82, 0x62, 0xf8, 0x95, 0x12, 0x13, 0xc3, 0xbb, 0xf9, 0x03, 0x8b,
+	0x2c, 0xc8, 0x9d, 0x6e, 0x47, 0x53, 0x12, 0x08, 0x41, 0x11, 0x0f, 0xdb, 0x9a, 0x7e, 0xde, 0xec,
+	0xb6, 0xf9, 0x57, 0xe3, 0xe0, 0x1e, 0xd7, 0x18, 0x06, 0x9f, 0x91, 0x35, 0x45, 0x3a, 0xfa, 0x14,
+	0x0a, 0x8e, 0x3b, 0x59, 0x3a, 0xa0, 0x97, 0xf8, 0x83, 0xfd, 0x60, 0xe0, 0xb8, 0x93, 0xe7, 0xfc,
+	0xe9, 0xb9, 0x31, 0xa3, 0x9f, 0x1b, 0x33, 0xfa, 0xef, 0x89, 0xc4, 0x45, 0x9a, 0x9f, 0x2f, 0x3f,
+	0xf9, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xed, 0x8a, 0x47, 0x02, 0x2b, 0x00, 0x00,
 }
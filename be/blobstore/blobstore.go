@@ -0,0 +1,79 @@
+// Package blobstore stores the contents of pic files.
+package blobstore // import "pixur.org/pixur/be/blobstore"
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+// Key identifies a single pic file.
+type Key struct {
+	PicId int64
+	// Derived is set for thumbnails and other files derived from the pic, which are further
+	// identified by Index.
+	Derived bool
+	Index   int64
+	Mime    schema.Pic_File_Mime
+}
+
+// PicFileKey is the key of the main file of a pic.
+func PicFileKey(picId int64, mime schema.Pic_File_Mime) Key {
+	return Key{
+		PicId: picId,
+		Mime:  mime,
+	}
+}
+
+// PicFileDerivedKey is the key of a thumbnail or derived file of a pic.
+func PicFileDerivedKey(picId, index int64, mime schema.Pic_File_Mime) Key {
+	return Key{
+		PicId:   picId,
+		Derived: true,
+		Index:   index,
+		Mime:    mime,
+	}
+}
+
+// Path returns the location of the key under the pix path layout.
+func (k Key) Path(pixPath string) (string, status.S) {
+	if k.Derived {
+		return schema.PicFileDerivedPath(pixPath, k.PicId, k.Index, k.Mime)
+	}
+	return schema.PicFilePath(pixPath, k.PicId, k.Mime)
+}
+
+// Info describes a stored blob.
+type Info struct {
+	Key     Key
+	Size    int64
+	ModTime time.Time
+}
+
+// BlobStore reads and writes pic files.  Implementations must be safe for concurrent use.
+// Operations on missing blobs return a NotFound status.
+type BlobStore interface {
+	// Put stores the contents of r under key, replacing any existing blob.  A partially
+	// written blob is never visible.
+	Put(ctx context.Context, key Key, r io.Reader) status.S
+	// Get opens the blob for reading.  The caller must close the returned reader.
+	Get(ctx context.Context, key Key) (io.ReadCloser, status.S)
+	// GetRange opens the blob for reading at most length bytes, starting at offset.  The caller
+	// must close the returned reader.
+	GetRange(ctx context.Context, key Key, offset, length int64) (io.ReadCloser, status.S)
+	// Stat describes the blob.
+	Stat(ctx context.Context, key Key) (*Info, status.S)
+	// Delete removes the blob.
+	Delete(ctx context.Context, key Key) status.S
+	// List calls fn for each stored blob, in no particular order.  Listing stops at the first
+	// error returned by fn.
+	List(ctx context.Context, fn func(*Info) status.S) status.S
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,216 @@
+package blobstore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "blobstoretest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func readAll(t *testing.T, s BlobStore, key Key) string {
+	t.Helper()
+	r, sts := s.Get(context.Background(), key)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func testBlobStore(t *testing.T, s BlobStore) {
+	ctx := context.Background()
+	k1 := PicFileKey(17, schema.Pic_File_JPEG)
+	k2 := PicFileDerivedKey(17, 1, schema.Pic_File_PNG)
+
+	if _, sts := s.Get(ctx, k1); sts == nil || sts.Code() != codes.NotFound {
+		t.Error("have", sts, "want", codes.NotFound)
+	}
+
+	if sts := s.Put(ctx, k1, strings.NewReader("hello world")); sts != nil {
+		t.Fatal(sts)
+	}
+	if sts := s.Put(ctx, k2, strings.NewReader("thumb")); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := readAll(t, s, k1), "hello world"; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	r, sts := s.GetRange(ctx, k1, 6, 3)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	data, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(data), "wor"; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	info, sts := s.Stat(ctx, k1)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := info.Size, int64(len("hello world")); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// Replace it.
+	if sts := s.Put(ctx, k1, strings.NewReader("bye")); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := readAll(t, s, k1), "bye"; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	found := make(map[Key]int64)
+	sts = s.List(ctx, func(info *Info) status.S {
+		found[info.Key] = info.Size
+		return nil
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(found) != 2 || found[k1] != 3 || found[k2] != 5 {
+		t.Error("have", found, "want", k1, k2)
+	}
+
+	if sts := s.Delete(ctx, k1); sts != nil {
+		t.Fatal(sts)
+	}
+	if _, sts := s.Stat(ctx, k1); sts == nil || sts.Code() != codes.NotFound {
+		t.Error("have", sts, "want", codes.NotFound)
+	}
+	if sts := s.Delete(ctx, k1); sts == nil || sts.Code() != codes.NotFound {
+		t.Error("have", sts, "want", codes.NotFound)
+	}
+	if have, want := readAll(t, s, k2), "thumb"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestPixPathStore(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	testBlobStore(t, NewPixPathStore(dir))
+}
+
+func TestPixPathStore_Layout(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	s := NewPixPathStore(dir)
+	key := PicFileKey(17, schema.Pic_File_GIF)
+	if sts := s.Put(context.Background(), key, strings.NewReader("a")); sts != nil {
+		t.Fatal(sts)
+	}
+	path, sts := schema.PicFilePath(dir, 17, schema.Pic_File_GIF)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Error("missing file", err)
+	}
+}
+
+func TestContentAddressedStore(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	testBlobStore(t, NewContentAddressedStore(dir))
+}
+
+func TestContentAddressedStore_Dedupes(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	s := NewContentAddressedStore(dir)
+	k1 := PicFileKey(1, schema.Pic_File_JPEG)
+	k2 := PicFileKey(2, schema.Pic_File_JPEG)
+	if sts := s.Put(ctx, k1, strings.NewReader("same")); sts != nil {
+		t.Fatal(sts)
+	}
+	if sts := s.Put(ctx, k2, strings.NewReader("same")); sts != nil {
+		t.Fatal(sts)
+	}
+
+	objects := func() []string {
+		paths, err := filepath.Glob(filepath.Join(dir, "objects", "*", "*[^s]"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return paths
+	}
+	if have := objects(); len(have) != 1 {
+		t.Fatal("have", have, "want 1 object")
+	}
+
+	if sts := s.Delete(ctx, k1); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := readAll(t, s, k2), "same"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if sts := s.Delete(ctx, k2); sts != nil {
+		t.Fatal(sts)
+	}
+	if have := objects(); len(have) != 0 {
+		t.Error("have", have, "want no objects")
+	}
+}
+
+func TestContentAddressedStore_LockSharedBetweenStores(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	// Each store opens the lock file separately, like separate processes would.
+	s1 := NewContentAddressedStore(dir).(*contentAddressedStore)
+	s2 := NewContentAddressedStore(dir)
+	k := PicFileKey(1, schema.Pic_File_JPEG)
+
+	unlock, sts := s1.lock()
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	done := make(chan status.S, 1)
+	go func() {
+		done <- s2.Put(ctx, k, strings.NewReader("same"))
+	}()
+	select {
+	case sts := <-done:
+		t.Fatal("put finished while locked", sts)
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	if sts := <-done; sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := readAll(t, s1, k), "same"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
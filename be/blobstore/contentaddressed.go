@@ -0,0 +1,285 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/status"
+)
+
+var _ BlobStore = &contentAddressedStore{}
+
+// contentAddressedStore keeps each distinct blob once, named by the SHA-256 of its contents.  The
+// directory is laid out as:
+//
+//	objects/ab/abcdef...         the blob contents
+//	objects/ab/abcdef....refs/   one empty file per key referring to the blob
+//	refs/                        the pix path layout, each file holding the hash of its blob
+//
+// A blob is removed once its last reference is removed.  Adding and removing references is done
+// while holding an flock on root/lock, so that several processes on the same host, such as the
+// server and fsck, can share the store.  Network filesystems may not honor the lock, so the root
+// must not be shared between hosts.
+type contentAddressedStore struct {
+	root string
+
+	// mu protects reference counting of objects within this process, alongside the file lock.
+	mu sync.Mutex
+}
+
+// NewContentAddressedStore stores blobs as files under root, named by the hash of their contents.
+// Identical blobs are only stored once.
+func NewContentAddressedStore(root string) BlobStore {
+	return &contentAddressedStore{root: root}
+}
+
+// lock takes the store lock, blocking until other processes release it.  The returned func
+// releases it.
+func (s *contentAddressedStore) lock() (func(), status.S) {
+	s.mu.Lock()
+	if err := os.MkdirAll(s.root, 0770); err != nil {
+		s.mu.Unlock()
+		return nil, status.Internal(err, "can't prepare dir", s.root)
+	}
+	lockPath := filepath.Join(s.root, "lock")
+	f, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0660)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, status.Internal(err, "can't open lock", lockPath)
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		s.mu.Unlock()
+		return nil, status.Internal(err, "can't lock", lockPath)
+	}
+	return func() {
+		// Closing the file releases the lock.
+		f.Close()
+		s.mu.Unlock()
+	}, nil
+}
+
+func (s *contentAddressedStore) refsPath() string {
+	return filepath.Join(s.root, "refs")
+}
+
+func (s *contentAddressedStore) objectPath(hash string) string {
+	return filepath.Join(s.root, "objects", hash[:2], hash)
+}
+
+func (s *contentAddressedStore) objectRefsPath(hash string) string {
+	return s.objectPath(hash) + ".refs"
+}
+
+func (s *contentAddressedStore) readRef(key Key) (string, status.S) {
+	refPath, sts := key.Path(s.refsPath())
+	if sts != nil {
+		return "", sts
+	}
+	data, err := ioutil.ReadFile(refPath)
+	if os.IsNotExist(err) {
+		return "", status.NotFound(err, "can't find blob")
+	} else if err != nil {
+		return "", status.Internal(err, "can't read blob ref", refPath)
+	}
+	hash := strings.TrimSpace(string(data))
+	if len(hash) != 2*sha256.Size {
+		return "", status.DataLoss(nil, "bad blob ref", refPath)
+	}
+	return hash, nil
+}
+
+func (s *contentAddressedStore) Put(ctx context.Context, key Key, r io.Reader) (stscap status.S) {
+	refPath, sts := key.Path(s.refsPath())
+	if sts != nil {
+		return sts
+	}
+	objectsPath := filepath.Join(s.root, "objects")
+	if err := os.MkdirAll(objectsPath, 0770); err != nil {
+		return status.Internal(err, "can't prepare dir", objectsPath)
+	}
+	f, err := ioutil.TempFile(objectsPath, tempFilePrefix)
+	if err != nil {
+		return status.Internal(err, "can't create tempfile")
+	}
+	destroy := true
+	defer func() {
+		if destroy {
+			if err := os.Remove(f.Name()); err != nil {
+				status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", f.Name()))
+			}
+		}
+	}()
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), r); err != nil {
+		f.Close()
+		return status.Internal(err, "can't copy file")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return status.Internal(err, "can't sync file")
+	}
+	if err := f.Close(); err != nil {
+		return status.Internal(err, "can't close", f.Name())
+	}
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	unlock, sts := s.lock()
+	if sts != nil {
+		return sts
+	}
+	defer unlock()
+
+	objectPath := s.objectPath(hash)
+	if _, err := os.Stat(objectPath); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(objectPath), 0770); err != nil {
+			return status.Internal(err, "can't prepare dir", filepath.Dir(objectPath))
+		}
+		if err := os.Rename(f.Name(), objectPath); err != nil {
+			return status.Internalf(err, "can't rename %v to %v", f.Name(), objectPath)
+		}
+		destroy = false
+	} else if err != nil {
+		return status.Internal(err, "can't stat blob", objectPath)
+	}
+
+	objectRefsPath := s.objectRefsPath(hash)
+	if err := os.MkdirAll(objectRefsPath, 0770); err != nil {
+		return status.Internal(err, "can't prepare dir", objectRefsPath)
+	}
+	marker := filepath.Join(objectRefsPath, filepath.Base(refPath))
+	if err := ioutil.WriteFile(marker, nil, 0660); err != nil {
+		return status.Internal(err, "can't write blob ref", marker)
+	}
+
+	oldHash, sts := s.readRef(key)
+	if sts != nil && sts.Code() != codes.NotFound {
+		return sts
+	}
+	if sts := writeFileAtomic(refPath, strings.NewReader(hash)); sts != nil {
+		return sts
+	}
+	if oldHash != "" && oldHash != hash {
+		return s.release(oldHash, refPath)
+	}
+	return nil
+}
+
+// release removes the reference from refPath to the object, and removes the object if it was the
+// last reference.  The store lock must be held.
+func (s *contentAddressedStore) release(hash, refPath string) status.S {
+	objectRefsPath := s.objectRefsPath(hash)
+	marker := filepath.Join(objectRefsPath, filepath.Base(refPath))
+	if err := os.Remove(marker); err != nil && !os.IsNotExist(err) {
+		return status.Internal(err, "can't remove blob ref", marker)
+	}
+	// Removing the directory only succeeds if there are no other references.
+	if err := os.Remove(objectRefsPath); err != nil {
+		return nil
+	}
+	objectPath := s.objectPath(hash)
+	if err := os.Remove(objectPath); err != nil && !os.IsNotExist(err) {
+		return status.Internal(err, "can't remove blob", objectPath)
+	}
+	return nil
+}
+
+func (s *contentAddressedStore) Get(ctx context.Context, key Key) (io.ReadCloser, status.S) {
+	hash, sts := s.readRef(key)
+	if sts != nil {
+		return nil, sts
+	}
+	return openFile(s.objectPath(hash))
+}
+
+func (s *contentAddressedStore) GetRange(ctx context.Context, key Key, offset, length int64) (
+	io.ReadCloser, status.S) {
+	hash, sts := s.readRef(key)
+	if sts != nil {
+		return nil, sts
+	}
+	return openFileRange(s.objectPath(hash), offset, length)
+}
+
+func (s *contentAddressedStore) Stat(ctx context.Context, key Key) (*Info, status.S) {
+	refPath, sts := key.Path(s.refsPath())
+	if sts != nil {
+		return nil, sts
+	}
+	hash, sts := s.readRef(key)
+	if sts != nil {
+		return nil, sts
+	}
+	return s.stat(key, refPath, hash)
+}
+
+func (s *contentAddressedStore) stat(key Key, refPath, hash string) (*Info, status.S) {
+	// The object may be shared, so use the ref for the modified time.
+	rfi, err := os.Stat(refPath)
+	if err != nil {
+		return nil, status.Internal(err, "can't stat blob ref", refPath)
+	}
+	objectPath := s.objectPath(hash)
+	ofi, err := os.Stat(objectPath)
+	if os.IsNotExist(err) {
+		return nil, status.DataLoss(err, "missing blob", objectPath)
+	} else if err != nil {
+		return nil, status.Internal(err, "can't stat blob", objectPath)
+	}
+	return &Info{
+		Key:     key,
+		Size:    ofi.Size(),
+		ModTime: rfi.ModTime(),
+	}, nil
+}
+
+func (s *contentAddressedStore) Delete(ctx context.Context, key Key) status.S {
+	refPath, sts := key.Path(s.refsPath())
+	if sts != nil {
+		return sts
+	}
+
+	unlock, sts := s.lock()
+	if sts != nil {
+		return sts
+	}
+	defer unlock()
+
+	hash, sts := s.readRef(key)
+	if sts != nil {
+		return sts
+	}
+	if err := os.Remove(refPath); err != nil {
+		return status.Internal(err, "can't remove blob ref", refPath)
+	}
+	return s.release(hash, refPath)
+}
+
+func (s *contentAddressedStore) List(ctx context.Context, fn func(*Info) status.S) status.S {
+	refsPath := s.refsPath()
+	return walkPixPath(ctx, refsPath, func(key Key, _ os.FileInfo) status.S {
+		refPath, sts := key.Path(refsPath)
+		if sts != nil {
+			return sts
+		}
+		hash, sts := s.readRef(key)
+		if sts != nil {
+			return sts
+		}
+		info, sts := s.stat(key, refPath, hash)
+		if sts != nil {
+			return sts
+		}
+		return fn(info)
+	})
+}
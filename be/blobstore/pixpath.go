@@ -0,0 +1,200 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+// tempFilePrefix marks files that are still being written.  They are skipped when listing.
+const tempFilePrefix = "__"
+
+var _ BlobStore = &pixPathStore{}
+
+// pixPathStore keeps each blob in its own file under a directory, at the path from Key.Path.
+type pixPathStore struct {
+	pixPath string
+}
+
+// NewPixPathStore stores blobs as files under pixPath, named by pic id.
+func NewPixPathStore(pixPath string) BlobStore {
+	return &pixPathStore{pixPath: pixPath}
+}
+
+func (s *pixPathStore) Put(ctx context.Context, key Key, r io.Reader) status.S {
+	path, sts := key.Path(s.pixPath)
+	if sts != nil {
+		return sts
+	}
+	return writeFileAtomic(path, r)
+}
+
+// writeFileAtomic writes r to a temporary file beside path, and renames it into place.
+func writeFileAtomic(path string, r io.Reader) (stscap status.S) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0770); err != nil {
+		return status.Internal(err, "can't prepare dir", dir)
+	}
+	f, err := ioutil.TempFile(dir, tempFilePrefix)
+	if err != nil {
+		return status.Internal(err, "can't create tempfile")
+	}
+	destroy := true
+	defer func() {
+		if destroy {
+			if err := os.Remove(f.Name()); err != nil {
+				status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", f.Name()))
+			}
+		}
+	}()
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return status.Internal(err, "can't copy file")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return status.Internal(err, "can't sync file")
+	}
+	if err := f.Close(); err != nil {
+		return status.Internal(err, "can't close", f.Name())
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return status.Internalf(err, "can't rename %v to %v", f.Name(), path)
+	}
+	destroy = false
+	return nil
+}
+
+func (s *pixPathStore) Get(ctx context.Context, key Key) (io.ReadCloser, status.S) {
+	path, sts := key.Path(s.pixPath)
+	if sts != nil {
+		return nil, sts
+	}
+	return openFile(path)
+}
+
+func (s *pixPathStore) GetRange(ctx context.Context, key Key, offset, length int64) (
+	io.ReadCloser, status.S) {
+	path, sts := key.Path(s.pixPath)
+	if sts != nil {
+		return nil, sts
+	}
+	return openFileRange(path, offset, length)
+}
+
+func openFile(path string) (*os.File, status.S) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, status.NotFound(err, "can't find blob")
+	} else if err != nil {
+		return nil, status.Internal(err, "can't open blob")
+	}
+	return f, nil
+}
+
+func openFileRange(path string, offset, length int64) (io.ReadCloser, status.S) {
+	if offset < 0 || length < 0 {
+		return nil, status.InvalidArgument(nil, "bad range", offset, length)
+	}
+	f, sts := openFile(path)
+	if sts != nil {
+		return nil, sts
+	}
+	return &limitedReadCloser{
+		Reader: io.NewSectionReader(f, offset, length),
+		Closer: f,
+	}, nil
+}
+
+func (s *pixPathStore) Stat(ctx context.Context, key Key) (*Info, status.S) {
+	path, sts := key.Path(s.pixPath)
+	if sts != nil {
+		return nil, sts
+	}
+	fi, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, status.NotFound(err, "can't find blob")
+	} else if err != nil {
+		return nil, status.Internal(err, "can't stat blob")
+	}
+	return &Info{
+		Key:     key,
+		Size:    fi.Size(),
+		ModTime: fi.ModTime(),
+	}, nil
+}
+
+func (s *pixPathStore) Delete(ctx context.Context, key Key) status.S {
+	path, sts := key.Path(s.pixPath)
+	if sts != nil {
+		return sts
+	}
+	if err := os.Remove(path); os.IsNotExist(err) {
+		return status.NotFound(err, "can't find blob")
+	} else if err != nil {
+		return status.Internal(err, "can't remove blob", path)
+	}
+	return nil
+}
+
+func (s *pixPathStore) List(ctx context.Context, fn func(*Info) status.S) status.S {
+	return walkPixPath(ctx, s.pixPath, func(key Key, fi os.FileInfo) status.S {
+		return fn(&Info{
+			Key:     key,
+			Size:    fi.Size(),
+			ModTime: fi.ModTime(),
+		})
+	})
+}
+
+// walkPixPath calls fn for each pic file under pixPath.  Files that don't follow the pix path
+// layout are skipped.
+func walkPixPath(ctx context.Context, pixPath string, fn func(Key, os.FileInfo) status.S) status.S {
+	var stscap status.S
+	err := filepath.Walk(pixPath, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == pixPath {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if fi.IsDir() || strings.HasPrefix(fi.Name(), tempFilePrefix) {
+			return nil
+		}
+		picId, index, derived, mime, sts := schema.ParsePicFileName(fi.Name())
+		if sts != nil {
+			return nil
+		}
+		key := Key{
+			PicId:   picId,
+			Derived: derived,
+			Index:   index,
+			Mime:    mime,
+		}
+		// Make sure the file is where it would be expected.
+		if expected, sts := key.Path(pixPath); sts != nil || expected != path {
+			return nil
+		}
+		if sts := fn(key, fi); sts != nil {
+			stscap = sts
+			return sts
+		}
+		return nil
+	})
+	if stscap != nil {
+		return stscap
+	}
+	if err != nil {
+		return status.Internal(err, "can't list blobs")
+	}
+	return nil
+}
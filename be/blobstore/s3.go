@@ -0,0 +1,483 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+)
+
+// S3Options describes an S3 compatible bucket.
+type S3Options struct {
+	// Endpoint is the base URL of the service.  Buckets are addressed by path.
+	Endpoint string
+	// Region is used to sign requests.
+	Region string
+	Bucket string
+	// Prefix is prepended to the name of every object.
+	Prefix string
+
+	AccessKeyId     string
+	SecretAccessKey string
+
+	// Client makes requests to the service.  If nil, http.DefaultClient is used.
+	Client *http.Client
+	// Now is used to sign requests.  If nil, time.Now is used.
+	Now func() time.Time
+}
+
+var _ BlobStore = &s3Store{}
+
+// s3Store keeps each blob as an object in a bucket, named by the pix path layout.
+type s3Store struct {
+	endpoint *url.URL
+	opts     S3Options
+}
+
+// NewS3Store stores blobs as objects in an S3 compatible bucket.
+func NewS3Store(opts *S3Options) (BlobStore, status.S) {
+	if opts.Bucket == "" {
+		return nil, status.InvalidArgument(nil, "missing bucket")
+	}
+	if opts.Region == "" {
+		return nil, status.InvalidArgument(nil, "missing region")
+	}
+	u, err := url.Parse(opts.Endpoint)
+	if err != nil {
+		return nil, status.InvalidArgument(err, "bad endpoint")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, status.InvalidArgument(nil, "bad endpoint scheme", u.Scheme)
+	}
+	s := &s3Store{
+		endpoint: u,
+		opts:     *opts,
+	}
+	if s.opts.Client == nil {
+		s.opts.Client = http.DefaultClient
+	}
+	if s.opts.Now == nil {
+		s.opts.Now = time.Now
+	}
+	return s, nil
+}
+
+func (s *s3Store) objectName(key Key) (string, status.S) {
+	relPath, sts := key.Path("")
+	if sts != nil {
+		return "", sts
+	}
+	return s.opts.Prefix + filepath.ToSlash(relPath), nil
+}
+
+func (s *s3Store) objectURL(name string, query url.Values) *url.URL {
+	u := *s.endpoint
+	u.Path = path.Join("/", u.Path, s.opts.Bucket, name)
+	u.RawQuery = query.Encode()
+	return &u
+}
+
+// unsignedPayload is used in place of the payload hash when the body isn't signed.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+func (s *s3Store) do(ctx context.Context, method string, u *url.URL, hdr http.Header,
+	body io.ReadSeeker, size int64, payloadHash string) (*http.Response, status.S) {
+	req, err := http.NewRequest(method, u.String(), nil)
+	if err != nil {
+		return nil, status.Internal(err, "can't create request")
+	}
+	req = req.WithContext(ctx)
+	for k, v := range hdr {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Body = ioutil.NopCloser(body)
+		req.ContentLength = size
+	}
+	signS3Request(req, payloadHash, s.opts.AccessKeyId, s.opts.SecretAccessKey, s.opts.Region,
+		s.opts.Now())
+	resp, err := s.opts.Client.Do(req)
+	if err != nil {
+		return nil, status.Unavailable(err, "can't send request", method, u.Path)
+	}
+	return resp, nil
+}
+
+// s3Error is the body of a failed response.
+type s3Error struct {
+	Code    string
+	Message string
+}
+
+// responseStatus converts an unsuccessful response into a status, and closes the body.
+func responseStatus(resp *http.Response, args ...interface{}) status.S {
+	defer resp.Body.Close()
+	var s3err s3Error
+	data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if len(data) != 0 {
+		// Not all responses have a body, so the code is best effort.
+		_ = xml.Unmarshal(data, &s3err)
+	}
+	err := fmt.Errorf("%s: %s %s", resp.Status, s3err.Code, s3err.Message)
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return status.NotFound(err, "can't find blob")
+	case http.StatusForbidden, http.StatusUnauthorized:
+		return status.PermissionDenied(err, args...)
+	case http.StatusServiceUnavailable, http.StatusTooManyRequests:
+		return status.Unavailable(err, args...)
+	default:
+		return status.Internal(err, args...)
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key Key, r io.Reader) (stscap status.S) {
+	name, sts := s.objectName(key)
+	if sts != nil {
+		return sts
+	}
+	// The length and hash of the body must be known before sending, so spool it to disk first.
+	f, err := ioutil.TempFile("", tempFilePrefix)
+	if err != nil {
+		return status.Internal(err, "can't create tempfile")
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil {
+			status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", f.Name()))
+		}
+	}()
+	defer f.Close()
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		return status.Internal(err, "can't copy file")
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return status.Internal(err, "can't seek file")
+	}
+	hdr := http.Header{}
+	hdr.Set("Content-Type", "application/octet-stream")
+	resp, sts := s.do(ctx, http.MethodPut, s.objectURL(name, nil), hdr, f, size,
+		hex.EncodeToString(h.Sum(nil)))
+	if sts != nil {
+		return sts
+	}
+	if resp.StatusCode != http.StatusOK {
+		return responseStatus(resp, "can't put blob", name)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, key Key) (io.ReadCloser, status.S) {
+	name, sts := s.objectName(key)
+	if sts != nil {
+		return nil, sts
+	}
+	resp, sts := s.do(ctx, http.MethodGet, s.objectURL(name, nil), nil, nil, 0, unsignedPayload)
+	if sts != nil {
+		return nil, sts
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseStatus(resp, "can't get blob", name)
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Store) GetRange(ctx context.Context, key Key, offset, length int64) (
+	io.ReadCloser, status.S) {
+	if offset < 0 || length < 0 {
+		return nil, status.InvalidArgument(nil, "bad range", offset, length)
+	}
+	if length == 0 {
+		// Ranges can't be empty, but the blob still needs to exist.
+		if _, sts := s.Stat(ctx, key); sts != nil {
+			return nil, sts
+		}
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	}
+	name, sts := s.objectName(key)
+	if sts != nil {
+		return nil, sts
+	}
+	hdr := http.Header{}
+	hdr.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	resp, sts := s.do(ctx, http.MethodGet, s.objectURL(name, nil), hdr, nil, 0, unsignedPayload)
+	if sts != nil {
+		return nil, sts
+	}
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusOK:
+		// The server ignored the range, so skip to it.
+		if _, err := io.CopyN(ioutil.Discard, resp.Body, offset); err != nil && err != io.EOF {
+			resp.Body.Close()
+			return nil, status.Internal(err, "can't skip to range", name)
+		}
+		return &limitedReadCloser{
+			Reader: io.LimitReader(resp.Body, length),
+			Closer: resp.Body,
+		}, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The range starts after the end of the blob.
+		resp.Body.Close()
+		return ioutil.NopCloser(strings.NewReader("")), nil
+	default:
+		return nil, responseStatus(resp, "can't get blob range", name)
+	}
+}
+
+func (s *s3Store) Stat(ctx context.Context, key Key) (*Info, status.S) {
+	name, sts := s.objectName(key)
+	if sts != nil {
+		return nil, sts
+	}
+	resp, sts := s.do(ctx, http.MethodHead, s.objectURL(name, nil), nil, nil, 0, unsignedPayload)
+	if sts != nil {
+		return nil, sts
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseStatus(resp, "can't stat blob", name)
+	}
+	resp.Body.Close()
+	if resp.ContentLength < 0 {
+		return nil, status.Internal(nil, "missing blob size", name)
+	}
+	mod, err := http.ParseTime(resp.Header.Get("Last-Modified"))
+	if err != nil {
+		return nil, status.Internal(err, "bad blob modified time", name)
+	}
+	return &Info{
+		Key:     key,
+		Size:    resp.ContentLength,
+		ModTime: mod,
+	}, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key Key) status.S {
+	name, sts := s.objectName(key)
+	if sts != nil {
+		return sts
+	}
+	// Deleting a missing object succeeds, so check that it's there first.
+	if _, sts := s.Stat(ctx, key); sts != nil {
+		return sts
+	}
+	resp, sts := s.do(ctx, http.MethodDelete, s.objectURL(name, nil), nil, nil, 0, unsignedPayload)
+	if sts != nil {
+		return sts
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return responseStatus(resp, "can't delete blob", name)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+type s3Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// s3ListResult is the body of a ListObjectsV2 response.
+type s3ListResult struct {
+	Contents              []s3Object
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (s *s3Store) List(ctx context.Context, fn func(*Info) status.S) status.S {
+	var token string
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if s.opts.Prefix != "" {
+			query.Set("prefix", s.opts.Prefix)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, sts := s.do(ctx, http.MethodGet, s.objectURL("", query), nil, nil, 0, unsignedPayload)
+		if sts != nil {
+			return sts
+		}
+		if resp.StatusCode != http.StatusOK {
+			return responseStatus(resp, "can't list blobs")
+		}
+		var res s3ListResult
+		err := xml.NewDecoder(resp.Body).Decode(&res)
+		resp.Body.Close()
+		if err != nil {
+			return status.Internal(err, "can't decode blob list")
+		}
+		for _, c := range res.Contents {
+			key, ok := s.parseObjectName(c.Key)
+			if !ok {
+				continue
+			}
+			sts := fn(&Info{
+				Key:     key,
+				Size:    c.Size,
+				ModTime: c.LastModified,
+			})
+			if sts != nil {
+				return sts
+			}
+		}
+		if !res.IsTruncated {
+			return nil
+		}
+		if res.NextContinuationToken == "" {
+			return status.Internal(nil, "missing continuation token")
+		}
+		token = res.NextContinuationToken
+	}
+}
+
+// parseObjectName finds the key of an object.  Objects that don't follow the pix path layout are
+// skipped.
+func (s *s3Store) parseObjectName(name string) (Key, bool) {
+	picId, index, derived, mime, sts := schema.ParsePicFileName(path.Base(name))
+	if sts != nil {
+		return Key{}, false
+	}
+	key := Key{
+		PicId:   picId,
+		Derived: derived,
+		Index:   index,
+		Mime:    mime,
+	}
+	// Make sure the object is where it would be expected.
+	if expected, sts := s.objectName(key); sts != nil || expected != name {
+		return Key{}, false
+	}
+	return key, true
+}
+
+const (
+	s3Algorithm  = "AWS4-HMAC-SHA256"
+	s3DateFormat = "20060102T150405Z"
+)
+
+// signS3Request adds AWS Signature Version 4 headers to req.
+func signS3Request(req *http.Request, payloadHash, accessKeyId, secretAccessKey, region string,
+	now time.Time) {
+	amzDate := now.UTC().Format(s3DateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	signedHeaders, sig := s3Signature(req, secretAccessKey, region)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, accessKeyId, s3Scope(amzDate, region), signedHeaders, sig))
+}
+
+func s3Scope(amzDate, region string) string {
+	return amzDate[:8] + "/" + region + "/s3/aws4_request"
+}
+
+// s3Signature signs the host, range, and X-Amz-* headers of req, using the time in the X-Amz-Date
+// header.  It returns the signed header names and the signature.
+func s3Signature(req *http.Request, secretAccessKey, region string) (string, string) {
+	amzDate := req.Header.Get("X-Amz-Date")
+	if len(amzDate) < 8 {
+		return "", ""
+	}
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	if rng := req.Header.Get("Range"); rng != "" {
+		headers["range"] = strings.TrimSpace(rng)
+	}
+	for k, v := range req.Header {
+		if lk := strings.ToLower(k); strings.HasPrefix(lk, "x-amz-") {
+			headers[lk] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3Escape(req.URL.EscapedPath(), false),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	crh := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		s3Scope(amzDate, region),
+		hex.EncodeToString(crh[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{amzDate[:8], region, "s3", "aws4_request", stringToSign} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+	return signedHeaders, hex.EncodeToString(key)
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	var parts []string
+	for k, vs := range query {
+		for _, v := range vs {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, "&")
+}
+
+// s3Escape percent encodes everything but unreserved characters.  If all is false, '/' is kept,
+// and existing escapes are left alone.
+func s3Escape(s string, all bool) string {
+	if !all {
+		if u, err := url.PathUnescape(s); err == nil {
+			s = u
+		}
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !all:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
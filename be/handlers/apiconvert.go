@@ -15,6 +15,10 @@ func apiPics(dst []*api.Pic, srcs ...*schema.Pic) []*api.Pic {
 }
 
 func apiPic(src *schema.Pic) *api.Pic {
+	// Filtered pics are nil if the user can't see them.
+	if src == nil {
+		return nil
+	}
 	scorelo, scorehi := src.WilsonScoreInterval(schema.Z_99)
 	dst := &api.Pic{
 		Id:              src.GetVarPicId(),
@@ -26,6 +30,10 @@ func apiPic(src *schema.Pic) *api.Pic {
 		CreatedTime:     src.CreatedTs,
 		ModifiedTime:    src.ModifiedTs,
 		File:            apiPicFile(src.PicId, false, src.File),
+		Visibility:      apiPicVisibility(src.Visibility),
+	}
+	if ds := src.DeletionStatus; ds != nil && ds.Reason == schema.Pic_DeletionStatus_DUPLICATE {
+		dst.DuplicateOfPicId = schema.Varint(ds.DuplicateOfPicId).Encode()
 	}
 	// hack to remove the 0 at the end of the id
 	dst.File.Id = dst.File.Id[:len(dst.File.Id)-1]
@@ -46,6 +54,17 @@ func apiPic(src *schema.Pic) *api.Pic {
 	return dst
 }
 
+func apiPicVisibility(src *schema.Pic_Visibility) *api.PicVisibility {
+	if src == nil {
+		return nil
+	}
+	return &api.PicVisibility{
+		Mode:   api.PicVisibility_Mode(src.Mode),
+		UserId: apiIds(nil, src.UserId),
+		RoleId: apiIds(nil, src.RoleId),
+	}
+}
+
 func apiPicFiles(dst []*api.PicFile, picId int64, thumb bool, srcs ...*schema.Pic_File) []*api.PicFile {
 	for _, src := range srcs {
 		dst = append(dst, apiPicFile(picId, thumb, src))
@@ -99,6 +118,31 @@ func apiPicTag(src *schema.PicTag) *api.PicTag {
 	}
 }
 
+func apiTags(dst []*api.Tag, srcs ...*schema.Tag) []*api.Tag {
+	for _, src := range srcs {
+		dst = append(dst, apiTag(src))
+	}
+	return dst
+}
+
+func apiTag(src *schema.Tag) *api.Tag {
+	return &api.Tag{
+		TagId:        schema.Varint(src.TagId).Encode(),
+		Name:         src.Name,
+		UsageCount:   src.UsageCount,
+		CreatedTime:  src.CreatedTs,
+		ModifiedTime: src.ModifiedTs,
+		Version:      src.Version(),
+	}
+}
+
+func apiTagKey(usageCount, tagId int64) string {
+	var b []byte
+	b = schema.Varint(usageCount).Append(b)
+	b = schema.Varint(tagId).Append(b)
+	return string(b)
+}
+
 func apiPicCommentTree(dst []*api.PicComment, srcs ...*schema.PicComment) *api.PicCommentTree {
 	for _, src := range srcs {
 		dst = append(dst, apiPicComment(src))
@@ -135,6 +179,68 @@ func apiUser(src *schema.User) *api.User {
 		LastSeenTime: src.LastSeenTs,
 		Version:      src.Version(),
 		Capability:   apiCaps(nil, src.Capability),
+		RoleId:       apiIds(nil, src.RoleId),
+	}
+}
+
+func apiApiKeys(dst []*api.ApiKey, srcs ...*schema.UserApiKey) []*api.ApiKey {
+	for _, src := range srcs {
+		dst = append(dst, apiApiKey(src))
+	}
+	return dst
+}
+
+func apiApiKey(src *schema.UserApiKey) *api.ApiKey {
+	return &api.ApiKey{
+		ApiKeyId:           schema.Varint(src.KeyId).Encode(),
+		Name:               src.Name,
+		CreatedTime:        src.CreatedTs,
+		ExpireTime:         src.ExpireTs,
+		Capability:         apiCaps(nil, src.Capability),
+		AllowedRemoteRange: src.AllowedRemoteRange,
+	}
+}
+
+func apiUserTokens(dst []*api.UserToken, currentTokenId int64, srcs ...*schema.UserToken) []*api.UserToken {
+	for _, src := range srcs {
+		dst = append(dst, apiUserToken(src, src.TokenId == currentTokenId))
+	}
+	return dst
+}
+
+func apiUserToken(src *schema.UserToken, current bool) *api.UserToken {
+	return &api.UserToken{
+		TokenId:       schema.Varint(src.TokenId).Encode(),
+		CreatedTime:   src.CreatedTs,
+		LastSeenTime:  src.LastSeenTs,
+		UserAgent:     src.UserAgent,
+		RemoteAddress: src.RemoteAddress,
+		Current:       current,
+	}
+}
+
+func apiIds(dst []string, srcs []int64) []string {
+	for _, src := range srcs {
+		dst = append(dst, schema.Varint(src).Encode())
+	}
+	return dst
+}
+
+func apiRoles(dst []*api.Role, srcs ...*schema.Role) []*api.Role {
+	for _, src := range srcs {
+		dst = append(dst, apiRole(src))
+	}
+	return dst
+}
+
+func apiRole(src *schema.Role) *api.Role {
+	return &api.Role{
+		RoleId:       schema.Varint(src.RoleId).Encode(),
+		Name:         src.Name,
+		Capability:   apiCaps(nil, src.Capability),
+		CreatedTime:  src.CreatedTs,
+		ModifiedTime: src.ModifiedTs,
+		Version:      src.Version(),
 	}
 }
 
@@ -230,6 +336,31 @@ func apiUserEvent(
 				PicId: schema.Varint(evt.UpsertPic.PicId).Encode(),
 			},
 		}
+	case *schema.UserEvent_RemovePicTags_:
+		dst.Evt = &api.UserEvent_RemovePicTags_{
+			RemovePicTags: &api.UserEvent_RemovePicTags{
+				PicId:   schema.Varint(evt.RemovePicTags.PicId).Encode(),
+				TagName: evt.RemovePicTags.TagName,
+			},
+		}
+	case *schema.UserEvent_UpdateRole_:
+		dst.Evt = &api.UserEvent_UpdateRole_{
+			UpdateRole: &api.UserEvent_UpdateRole{
+				RoleId:          schema.Varint(evt.UpdateRole.RoleId).Encode(),
+				Name:            evt.UpdateRole.Name,
+				Created:         evt.UpdateRole.Created,
+				SetCapability:   apiCaps(nil, evt.UpdateRole.SetCapability),
+				ClearCapability: apiCaps(nil, evt.UpdateRole.ClearCapability),
+			},
+		}
+	case *schema.UserEvent_UpdateUserRoles_:
+		dst.Evt = &api.UserEvent_UpdateUserRoles_{
+			UpdateUserRoles: &api.UserEvent_UpdateUserRoles{
+				ObjectUserId: schema.Varint(evt.UpdateUserRoles.ObjectUserId).Encode(),
+				SetRoleId:    apiIds(nil, evt.UpdateUserRoles.SetRoleId),
+				ClearRoleId:  apiIds(nil, evt.UpdateUserRoles.ClearRoleId),
+			},
+		}
 	}
 	return dst
 }
@@ -242,9 +373,50 @@ func apiUserEvents(dst []*api.UserEvent, srcs []*schema.UserEvent,
 	return dst
 }
 
+func apiBackgroundJob(src *schema.BackgroundJob) *api.BackgroundJob {
+	dst := &api.BackgroundJob{
+		Id:           schema.Varint(src.JobId).Encode(),
+		Type:         api.BackgroundJob_Type(src.Type),
+		UserId:       schema.Varint(src.UserId).Encode(),
+		Url:          src.Url,
+		CreatedTime:  src.CreatedTs,
+		ModifiedTime: src.ModifiedTs,
+	}
+	if src.PicId != 0 {
+		dst.PicId = schema.Varint(src.PicId).Encode()
+	}
+	if st := src.Status; st != nil {
+		dst.Status = &api.BackgroundJob_Status{
+			State:        api.BackgroundJob_Status_State(st.State),
+			Attempts:     st.Attempts,
+			LastError:    st.LastError,
+			FinishedTime: st.FinishedTs,
+		}
+		if st.State == schema.BackgroundJob_Status_PENDING {
+			dst.Status.NextRunTime = st.NextRunTs
+		}
+		if pr := st.Progress; pr != nil {
+			dst.Status.Progress = &api.BackgroundJob_Progress{
+				Stage:           api.BackgroundJob_Progress_Stage(pr.Stage),
+				DownloadedBytes: pr.DownloadedBytes,
+				TotalBytes:      pr.TotalBytes,
+			}
+		}
+	}
+	return dst
+}
+
+func apiBackgroundJobs(dst []*api.BackgroundJob, srcs []*schema.BackgroundJob) []*api.BackgroundJob {
+	for _, src := range srcs {
+		dst = append(dst, apiBackgroundJob(src))
+	}
+	return dst
+}
+
 // TODO: test this
 func apiConfig(src *schema.Configuration) *api.BackendConfiguration {
 	var anonymousCapability, newUserCapability *api.BackendConfiguration_CapabilitySet
+	var totpRequiredCapability *api.BackendConfiguration_CapabilitySet
 	if src.AnonymousCapability != nil {
 		anonymousCapability = &api.BackendConfiguration_CapabilitySet{
 			Capability: apiCaps(nil, src.AnonymousCapability.Capability),
@@ -255,6 +427,19 @@ func apiConfig(src *schema.Configuration) *api.BackendConfiguration {
 			Capability: apiCaps(nil, src.NewUserCapability.Capability),
 		}
 	}
+	if src.TotpRequiredCapability != nil {
+		totpRequiredCapability = &api.BackendConfiguration_CapabilitySet{
+			Capability: apiCaps(nil, src.TotpRequiredCapability.Capability),
+		}
+	}
+	var nearDuplicatePolicy *api.BackendConfiguration_NearDuplicatePolicy
+	if src.NearDuplicatePolicy != nil {
+		nearDuplicatePolicy = &api.BackendConfiguration_NearDuplicatePolicy{
+			Action: api.BackendConfiguration_NearDuplicatePolicy_Action(
+				src.NearDuplicatePolicy.Action),
+			MaxDistance: src.NearDuplicatePolicy.MaxDistance,
+		}
+	}
 
 	return &api.BackendConfiguration{
 		MinCommentLength:             src.MinCommentLength,
@@ -276,12 +461,19 @@ func apiConfig(src *schema.Configuration) *api.BackendConfiguration {
 		EnablePicCommentSiblingReply: src.EnablePicCommentSiblingReply,
 		DefaultFindUserEvents:        src.DefaultFindUserEvents,
 		MaxFindUserEvents:            src.MaxFindUserEvents,
+		DefaultFindTags:              src.DefaultFindTags,
+		MaxFindTags:                  src.MaxFindTags,
+		NearDuplicatePolicy:          nearDuplicatePolicy,
+		MaxFileSize:                  src.MaxFileSize,
+		UserTokenTtl:                 src.UserTokenTtl,
+		TotpRequiredCapability:       totpRequiredCapability,
 	}
 }
 
 // TODO: test this
 func beConfig(src *api.BackendConfiguration) *schema.Configuration {
 	var anonymousCapability, newUserCapability *schema.Configuration_CapabilitySet
+	var totpRequiredCapability *schema.Configuration_CapabilitySet
 	if src.AnonymousCapability != nil {
 		anonymousCapability = &schema.Configuration_CapabilitySet{
 			Capability: beCaps(nil, src.AnonymousCapability.Capability),
@@ -292,6 +484,19 @@ func beConfig(src *api.BackendConfiguration) *schema.Configuration {
 			Capability: beCaps(nil, src.NewUserCapability.Capability),
 		}
 	}
+	if src.TotpRequiredCapability != nil {
+		totpRequiredCapability = &schema.Configuration_CapabilitySet{
+			Capability: beCaps(nil, src.TotpRequiredCapability.Capability),
+		}
+	}
+	var nearDuplicatePolicy *schema.Configuration_NearDuplicatePolicy
+	if src.NearDuplicatePolicy != nil {
+		nearDuplicatePolicy = &schema.Configuration_NearDuplicatePolicy{
+			Action: schema.Configuration_NearDuplicatePolicy_Action(
+				src.NearDuplicatePolicy.Action),
+			MaxDistance: src.NearDuplicatePolicy.MaxDistance,
+		}
+	}
 
 	return &schema.Configuration{
 		MinCommentLength:             src.MinCommentLength,
@@ -313,6 +518,12 @@ func beConfig(src *api.BackendConfiguration) *schema.Configuration {
 		EnablePicCommentSiblingReply: src.EnablePicCommentSiblingReply,
 		DefaultFindUserEvents:        src.DefaultFindUserEvents,
 		MaxFindUserEvents:            src.MaxFindUserEvents,
+		DefaultFindTags:              src.DefaultFindTags,
+		MaxFindTags:                  src.MaxFindTags,
+		NearDuplicatePolicy:          nearDuplicatePolicy,
+		MaxFileSize:                  src.MaxFileSize,
+		UserTokenTtl:                 src.UserTokenTtl,
+		TotpRequiredCapability:       totpRequiredCapability,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"pixur.org/pixur/api"
 	"pixur.org/pixur/be/schema"
@@ -19,13 +20,22 @@ func authTokenFromMD(md metadata.MD) (string, bool) {
 	return tokens[0], true
 }
 
+// fillUserIdAndTokenFromCtx adds the user of the auth token to the context.  API keys are
+// accepted in place of auth tokens.
 func fillUserIdAndTokenFromCtx(ctx context.Context) (context.Context, status.S) {
 	if token, ok := tasks.AuthTokenFromCtx(ctx); ok {
-		payload, sts := decodeAuthToken(token)
+		payload, sts := defaultPwtCoder.decode([]byte(token))
 		if sts != nil {
 			return nil, sts
 		}
-		ctx, sts = addUserTokenToCtx(ctx, payload)
+		switch payload.Type {
+		case api.PwtPayload_AUTH:
+			ctx, sts = addUserTokenToCtx(ctx, payload)
+		case api.PwtPayload_API_KEY:
+			ctx, sts = addApiKeyToCtx(ctx, payload)
+		default:
+			sts = status.Unauthenticated(nil, errNotAuthMsg)
+		}
 		if sts != nil {
 			return nil, sts
 		}
@@ -33,6 +43,20 @@ func fillUserIdAndTokenFromCtx(ctx context.Context) (context.Context, status.S)
 	return ctx, nil
 }
 
+// addApiKeyToCtx adds the key to the context, along with the address of the caller so that the
+// key's allowed ranges can be checked.
+func addApiKeyToCtx(ctx context.Context, pwt *api.PwtPayload) (context.Context, status.S) {
+	userId, keyId, sts := extractUserToken(pwt)
+	if sts != nil {
+		return nil, sts
+	}
+	var remoteAddress string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddress = p.Addr.String()
+	}
+	return tasks.CtxFromApiKey(ctx, userId, keyId, remoteAddress), nil
+}
+
 func addUserTokenToCtx(ctx context.Context, pwt *api.PwtPayload) (context.Context, status.S) {
 	if pwt == nil {
 		return ctx, nil
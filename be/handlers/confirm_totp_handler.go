@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleConfirmTotp(ctx context.Context, req *api.ConfirmTotpRequest) (
+	*api.ConfirmTotpResponse, status.S) {
+	var task = &tasks.ConfirmTotpTask{
+		Beg:  s.db,
+		Now:  s.now,
+		Rand: s.rand,
+		Code: req.Code,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.ConfirmTotpResponse{
+		RecoveryCode: task.RecoveryCodes,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleCreateApiKey(ctx context.Context, req *api.CreateApiKeyRequest) (
+	*api.CreateApiKeyResponse, status.S) {
+	caps, sts := beCapsChecked(req.Capability)
+	if sts != nil {
+		return nil, sts
+	}
+	var expireTime time.Time
+	if req.ExpireTime != nil {
+		var err error
+		if expireTime, err = ptypes.Timestamp(req.ExpireTime); err != nil {
+			return nil, status.InvalidArgument(err, "bad expire time")
+		}
+	}
+
+	var task = &tasks.CreateApiKeyTask{
+		Beg: s.db,
+		Now: s.now,
+
+		Name:               req.Name,
+		Capability:         caps,
+		ExpireTime:         expireTime,
+		AllowedRemoteRange: req.AllowedRemoteRange,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	now := s.now()
+	notBefore, err := ptypes.TimestampProto(time.Unix(now.Add(-1*time.Minute).Unix(), 0))
+	if err != nil {
+		return nil, status.Internal(err, "can't build notbefore")
+	}
+	// Keys without an expiry last as long as auth tokens.  They are checked against the user's
+	// keys on each use, so revoked keys stop working right away.
+	if expireTime.IsZero() {
+		expireTime = now.Add(authPwtDuration)
+	}
+	notAfter, err := ptypes.TimestampProto(time.Unix(expireTime.Unix(), 0))
+	if err != nil {
+		return nil, status.Internal(err, "can't build notafter")
+	}
+	token, err := defaultPwtCoder.encode(&api.PwtPayload{
+		Subject:   schema.Varint(task.User.UserId).Encode(),
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+		TokenId:   task.ApiKey.KeyId,
+		Type:      api.PwtPayload_API_KEY,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't build api key token")
+	}
+
+	return &api.CreateApiKeyResponse{
+		ApiKey: apiApiKey(task.ApiKey),
+		Token:  string(token),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestCreateApiKeyFailsOnBadCap(t *testing.T) {
+	s := &serv{}
+
+	_, sts := s.handleCreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+		Capability: []api.Capability_Cap{-1},
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateApiKeyFailsOnTaskError(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Internal(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleCreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+		Capability: []api.Capability_Cap{api.Capability_PIC_READ},
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad things"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateApiKeySuccess(t *testing.T) {
+	now := time.Now()
+	expire := now.Add(time.Hour)
+	var taskCap *tasks.CreateApiKeyTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.CreateApiKeyTask)
+		taskCap.User = &schema.User{UserId: 2}
+		taskCap.ApiKey = &schema.UserApiKey{
+			KeyId:      7,
+			Name:       taskCap.Name,
+			Capability: taskCap.Capability,
+			ExpireTs:   schema.ToTspb(taskCap.ExpireTime),
+		}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    func() time.Time { return now },
+	}
+
+	expirets, _ := ptypes.TimestampProto(expire)
+	resp, sts := s.handleCreateApiKey(context.Background(), &api.CreateApiKeyRequest{
+		Name:               "backups",
+		Capability:         []api.Capability_Cap{api.Capability_PIC_READ},
+		ExpireTime:         expirets,
+		AllowedRemoteRange: []string{"10.0.0.0/8"},
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.Name != "backups" || !taskCap.ExpireTime.Equal(expire) ||
+		len(taskCap.AllowedRemoteRange) != 1 {
+		t.Error("wrong task input", taskCap)
+	}
+	if resp.ApiKey.ApiKeyId != schema.Varint(7).Encode() || resp.ApiKey.Name != "backups" {
+		t.Error("wrong key", resp.ApiKey)
+	}
+
+	payload, sts := defaultPwtCoder.decode([]byte(resp.Token))
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if payload.Type != api.PwtPayload_API_KEY || payload.Subject != "2" || payload.TokenId != 7 {
+		t.Error("wrong payload", payload)
+	}
+	if have, want := payload.NotAfter.Seconds, expire.Unix(); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// The token is accepted in place of an auth token.
+	ctx := tasks.CtxFromAuthToken(context.Background(), resp.Token)
+	ctx = peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234},
+	})
+	ctx, sts = fillUserIdAndTokenFromCtx(ctx)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	key, ok := tasks.ApiKeyFromCtx(ctx)
+	if !ok {
+		t.Fatal("missing api key")
+	}
+	if key.UserId != 2 || key.KeyId != 7 || key.RemoteAddress != "10.0.0.1:1234" {
+		t.Error("wrong key", key)
+	}
+	if _, ok := tasks.UserTokenFromCtx(ctx); ok {
+		t.Error("unexpected user token")
+	}
+}
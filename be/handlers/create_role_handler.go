@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleCreateRole(ctx context.Context, req *api.CreateRoleRequest) (
+	*api.CreateRoleResponse, status.S) {
+	caps, sts := beCapsChecked(req.Capability)
+	if sts != nil {
+		return nil, sts
+	}
+
+	var task = &tasks.CreateRoleTask{
+		Beg: s.db,
+		Now: s.now,
+
+		Name:       req.Name,
+		Capability: caps,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.CreateRoleResponse{
+		Role: apiRole(task.CreatedRole),
+	}, nil
+}
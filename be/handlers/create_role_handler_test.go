@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestCreateRoleFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Internal(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleCreateRole(context.Background(), &api.CreateRoleRequest{})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad things"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateRoleFailsOnUnknownCap(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task ran")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleCreateRole(context.Background(), &api.CreateRoleRequest{
+		Name:       "Moderators",
+		Capability: []api.Capability_Cap{api.Capability_UNKNOWN},
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateRole(t *testing.T) {
+	var taskCap *tasks.CreateRoleTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.CreateRoleTask)
+		taskCap.CreatedRole = &schema.Role{
+			RoleId:     2,
+			Name:       "Moderators",
+			Capability: taskCap.Capability,
+			ModifiedTs: schema.ToTspb(time.Now()),
+		}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	res, sts := s.handleCreateRole(context.Background(), &api.CreateRoleRequest{
+		Name:       "Moderators",
+		Capability: []api.Capability_Cap{api.Capability_PIC_SOFT_DELETE},
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.Name, "Moderators"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := len(taskCap.Capability), 1; have != want || taskCap.Capability[0] != schema.User_PIC_SOFT_DELETE {
+		t.Error("have", taskCap.Capability, "want", schema.User_PIC_SOFT_DELETE)
+	}
+	if have, want := res.Role.RoleId, schema.Varint(2).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.Role.Capability, []api.Capability_Cap{api.Capability_PIC_SOFT_DELETE}; len(have) != 1 || have[0] != want[0] {
+		t.Error("have", have, "want", want)
+	}
+}
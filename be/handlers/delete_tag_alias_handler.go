@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleDeleteTagAlias(ctx context.Context, req *api.DeleteTagAliasRequest) (
+	*api.DeleteTagAliasResponse, status.S) {
+	var task = &tasks.DeleteTagAliasTask{
+		Beg: s.db,
+		Now: s.now,
+
+		Alias: req.Alias,
+	}
+	if err := s.runner.Run(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteTagAliasResponse{}, nil
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleDeleteTagImplication(ctx context.Context, req *api.DeleteTagImplicationRequest) (
+	*api.DeleteTagImplicationResponse, status.S) {
+	var task = &tasks.DeleteTagImplicationTask{
+		Beg: s.db,
+		Now: s.now,
+
+		TagName:        req.Tag,
+		ImpliedTagName: req.ImpliedTag,
+	}
+	if err := s.runner.Run(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteTagImplicationResponse{}, nil
+}
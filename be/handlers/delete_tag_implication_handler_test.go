@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestDeleteTagImplicationFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Internal(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleDeleteTagImplication(context.Background(), &api.DeleteTagImplicationRequest{})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad things"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeleteTagImplication(t *testing.T) {
+	var taskCap *tasks.DeleteTagImplicationTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.DeleteTagImplicationTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	res, sts := s.handleDeleteTagImplication(context.Background(), &api.DeleteTagImplicationRequest{
+		Tag:        "cat",
+		ImpliedTag: "animal",
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := res, (&api.DeleteTagImplicationResponse{}); !proto.Equal(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.TagName, "cat"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.ImpliedTagName, "animal"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleDisableTotp(ctx context.Context, req *api.DisableTotpRequest) (
+	*api.DisableTotpResponse, status.S) {
+	var objectUserId schema.Varint
+	if req.UserId != "" {
+		if err := objectUserId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+
+	var task = &tasks.DisableTotpTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(objectUserId),
+		Code:         req.Code,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.DisableTotpResponse{}, nil
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base32"
+	"net/url"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+const totpIssuer = "Pixur"
+
+func (s *serv) handleEnrollTotp(ctx context.Context, req *api.EnrollTotpRequest) (
+	*api.EnrollTotpResponse, status.S) {
+	var task = &tasks.EnrollTotpTask{
+		Beg:  s.db,
+		Now:  s.now,
+		Rand: s.rand,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(task.Secret)
+	// See https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+	uri := url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + totpIssuer + ":" + task.User.Ident,
+		RawQuery: url.Values{
+			"secret": {secret},
+			"issuer": {totpIssuer},
+		}.Encode(),
+	}
+
+	return &api.EnrollTotpResponse{
+		Secret: secret,
+		Uri:    uri.String(),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestEnrollTotpSuccess(t *testing.T) {
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap := task.(*tasks.EnrollTotpTask)
+		taskCap.User = &schema.User{Ident: "a b"}
+		taskCap.Secret = []byte("12345678901234567890")
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleEnrollTotp(context.Background(), &api.EnrollTotpRequest{})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+
+	if have, want := resp.Secret, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	want := "otpauth://totp/Pixur:a%20b?issuer=Pixur&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	if have := resp.Uri; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+
+	"google.golang.org/grpc/peer"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleExchangeIdToken(ctx context.Context, req *api.ExchangeIdTokenRequest) (
+	*api.ExchangeIdTokenResponse, status.S) {
+	if s.oidc == nil {
+		return nil, status.FailedPrecondition(nil, "openid connect login not configured")
+	}
+	if req.IdToken == "" {
+		return nil, status.InvalidArgument(nil, "missing id token")
+	}
+	claims, sts := s.oidc.verify(ctx, req.IdToken, req.Nonce)
+	if sts != nil {
+		return nil, sts
+	}
+
+	// The ident is only used when creating a user, so it doesn't need to be verified.
+	ident := claims.Email
+	if ident == "" {
+		ident = claims.PreferredUsername
+	}
+	if ident == "" {
+		ident = claims.Subject
+	}
+	var task = &tasks.AuthExternalUserTask{
+		Beg:           s.db,
+		Now:           s.now,
+		Issuer:        claims.Issuer,
+		Subject:       claims.Subject,
+		Ident:         ident,
+		UserAgent:     req.UserAgent,
+		RemoteAddress: req.RemoteAddress,
+	}
+	// Clients calling the backend directly don't say where they are.
+	if task.RemoteAddress == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			task.RemoteAddress = p.Addr.String()
+		}
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	res, sts := s.loginTokens(task.User, task.NewTokenId, task.ResolvedCapability)
+	if sts != nil {
+		return nil, sts
+	}
+	return &api.ExchangeIdTokenResponse{
+		AuthToken:   res.AuthToken,
+		PixToken:    res.PixToken,
+		AuthPayload: res.AuthPayload,
+		PixPayload:  res.PixPayload,
+	}, nil
+}
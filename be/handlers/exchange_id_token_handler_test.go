@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestExchangeIdTokenSucceeds(t *testing.T) {
+	iss := newTestOidcIssuer(t)
+	defer iss.Close()
+
+	var taskCap *tasks.AuthExternalUserTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AuthExternalUserTask)
+		taskCap.NewTokenId = 3
+		taskCap.User = &schema.User{
+			UserId: 2,
+		}
+		taskCap.ResolvedCapability = []schema.User_Capability{schema.User_PIC_READ}
+		return nil
+	}
+
+	s := serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+		oidc:   iss.verifier(),
+	}
+	resp, sts := s.handleExchangeIdToken(context.Background(), &api.ExchangeIdTokenRequest{
+		IdToken:       iss.sign(iss.claims()),
+		Nonce:         "nonce",
+		UserAgent:     "agent",
+		RemoteAddress: "1.2.3.4",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if resp.AuthToken == "" || resp.PixToken == "" {
+		t.Error("tokens should be present", resp)
+	}
+	if resp.AuthPayload.Subject != "2" || resp.AuthPayload.TokenId != 3 {
+		t.Error("wrong token ids", resp)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if taskCap.Issuer != iss.srv.URL || taskCap.Subject != "1234" ||
+		taskCap.Ident != "bob@example.com" {
+		t.Error("wrong task input", taskCap.Issuer, taskCap.Subject, taskCap.Ident)
+	}
+	if taskCap.UserAgent != "agent" || taskCap.RemoteAddress != "1.2.3.4" {
+		t.Error("wrong client info", taskCap.UserAgent, taskCap.RemoteAddress)
+	}
+}
+
+func TestExchangeIdTokenFailsOnBadToken(t *testing.T) {
+	iss := newTestOidcIssuer(t)
+	defer iss.Close()
+
+	s := serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Fatal("task shouldn't run")
+			return nil
+		}),
+		now:  time.Now,
+		oidc: iss.verifier(),
+	}
+	claims := iss.claims()
+	claims["aud"] = "other"
+	_, sts := s.handleExchangeIdToken(context.Background(), &api.ExchangeIdTokenRequest{
+		IdToken: iss.sign(claims),
+		Nonce:   "nonce",
+	})
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestExchangeIdTokenFailsIfNotConfigured(t *testing.T) {
+	s := serv{
+		now: time.Now,
+	}
+	_, sts := s.handleExchangeIdToken(context.Background(), &api.ExchangeIdTokenRequest{
+		IdToken: "a.b.c",
+	})
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.FailedPrecondition; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "not configured"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
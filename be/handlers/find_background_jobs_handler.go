@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleFindBackgroundJobs(ctx context.Context, req *api.FindBackgroundJobsRequest) (
+	*api.FindBackgroundJobsResponse, status.S) {
+
+	var userId schema.Varint
+	if req.UserId != "" {
+		if err := userId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+	var startJobId schema.Varint
+	if req.StartBackgroundJobId != "" {
+		if err := startJobId.DecodeAll(req.StartBackgroundJobId); err != nil {
+			return nil, status.InvalidArgument(err, "bad background job id")
+		}
+	}
+
+	var task = &tasks.FindBackgroundJobsTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(userId),
+		All:          req.All,
+		StartJobId:   int64(startJobId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindBackgroundJobsResponse{
+		BackgroundJob: apiBackgroundJobs(nil, task.BackgroundJobs),
+	}
+	if task.NextJobId != 0 {
+		resp.NextBackgroundJobId = schema.Varint(task.NextJobId).Encode()
+	}
+	return resp, nil
+}
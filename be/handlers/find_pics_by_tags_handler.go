@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleFindPicsByTags(ctx context.Context, req *api.FindPicsByTagsRequest) (
+	*api.FindPicsByTagsResponse, status.S) {
+	var picId schema.Varint
+	if req.StartPicId != "" {
+		if err := picId.DecodeAll(req.StartPicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.FindPicsByTagsTask{
+		Beg:          s.db,
+		Now:          s.now,
+		AllTagNames:  req.AllTag,
+		AnyTagNames:  req.AnyTag,
+		NoneTagNames: req.NoneTag,
+		StartId:      int64(picId),
+	}
+
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindPicsByTagsResponse{
+		Pic: apiPicAndThumbnails(nil, task.Pics...),
+	}
+
+	if task.NextId != 0 {
+		resp.NextPicId = schema.Varint(task.NextId).Encode()
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestFindPicsByTagsFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindPicsByTags(context.Background(), &api.FindPicsByTagsRequest{
+		StartPicId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPicsByTags(t *testing.T) {
+	var taskCap *tasks.FindPicsByTagsTask
+	now := time.Now()
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindPicsByTagsTask)
+		p := &schema.Pic{}
+		p.PicId = 6
+		p.SetModifiedTime(now)
+		p.SetCreatedTime(now)
+		p.File = &schema.Pic_File{
+			Mime: schema.Pic_File_JPEG,
+		}
+		p.Thumbnail = []*schema.Pic_File{{
+			Mime: schema.Pic_File_JPEG,
+		}}
+		taskCap.Pics = append(taskCap.Pics, p)
+		taskCap.NextId = 5
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+
+	res, sts := s.handleFindPicsByTags(context.Background(), &api.FindPicsByTagsRequest{
+		AllTag:     []string{"a"},
+		AnyTag:     []string{"b", "c"},
+		NoneTag:    []string{"d"},
+		StartPicId: "8",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.StartId, int64(8); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := strings.Join(taskCap.AllTagNames, ","), "a"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := strings.Join(taskCap.AnyTagNames, ","), "b,c"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := strings.Join(taskCap.NoneTagNames, ","), "d"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(res.Pic) != 1 {
+		t.Error("wrong number of pics", res.Pic)
+	}
+	if res.NextPicId != "5" {
+		t.Error("expected next pic id", res.NextPicId)
+	}
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleFindRoles(ctx context.Context, req *api.FindRolesRequest) (
+	*api.FindRolesResponse, status.S) {
+	var task = &tasks.FindRolesTask{
+		Beg: s.db,
+		Now: s.now,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.FindRolesResponse{
+		Role: apiRoles(nil, task.Roles...),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestFindRolesFailsOnTaskFailure(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			return status.PermissionDenied(nil, "nope")
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleFindRoles(context.Background(), &api.FindRolesRequest{})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindRoles(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			nowts := schema.ToTspb(time.Now())
+			task.(*tasks.FindRolesTask).Roles = []*schema.Role{
+				{RoleId: 1, ModifiedTs: nowts},
+				{RoleId: 2, ModifiedTs: nowts},
+			}
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	res, sts := s.handleFindRoles(context.Background(), &api.FindRolesRequest{})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := len(res.Role), 2; have != want {
+		t.Fatal("have", have, "want", want)
+	}
+	if have, want := res.Role[1].RoleId, schema.Varint(2).Encode(); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
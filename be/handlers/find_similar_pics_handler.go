@@ -19,17 +19,21 @@ func (s *serv) handleFindSimilarPics(ctx context.Context, req *api.FindSimilarPi
 	}
 
 	var task = &tasks.FindSimilarPicsTask{
-		Beg:   s.db,
-		Now:   s.now,
-		PicId: int64(requestedPicId),
+		Beg:    s.db,
+		Now:    s.now,
+		Index:  s.similarity,
+		PicId:  int64(requestedPicId),
+		Radius: int(req.Radius),
+		Limit:  int(req.Limit),
 	}
 	if sts := s.runner.Run(ctx, task); sts != nil {
 		return nil, sts
 	}
 
 	resp := api.FindSimilarPicsResponse{}
-	for _, id := range task.SimilarPicIds {
+	for i, id := range task.SimilarPicIds {
 		resp.PicId = append(resp.PicId, schema.Varint(id).Encode())
+		resp.Distance = append(resp.Distance, int32(task.Distances[i]))
 	}
 
 	return &resp, nil
@@ -36,13 +36,16 @@ func TestFindSimilarPics(t *testing.T) {
 		ctxCap = ctx
 		taskCap = task.(*tasks.FindSimilarPicsTask)
 		taskCap.SimilarPicIds = append(taskCap.SimilarPicIds, 2)
+		taskCap.Distances = append(taskCap.Distances, 3)
 		return nil
 	}
 	s := &serv{
 		runner: tasks.TestTaskRunner(successRunner),
 	}
 	res, sts := s.handleFindSimilarPics(context.Background(), &api.FindSimilarPicsRequest{
-		PicId: "1",
+		PicId:  "1",
+		Radius: 5,
+		Limit:  7,
 	})
 	if sts != nil {
 		t.Fatal(sts)
@@ -53,10 +56,19 @@ func TestFindSimilarPics(t *testing.T) {
 	if have, want := taskCap.PicId, int64(1); have != want {
 		t.Error("have", have, "want", want)
 	}
+	if have, want := taskCap.Radius, 5; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.Limit, 7; have != want {
+		t.Error("have", have, "want", want)
+	}
 	if have, want := ctxCap, context.Background(); have != want {
 		t.Error("have", have, "want", want)
 	}
-	if have, want := res, (&api.FindSimilarPicsResponse{PicId: []string{"2"}}); !proto.Equal(have, want) {
+	if have, want := res, (&api.FindSimilarPicsResponse{
+		PicId:    []string{"2"},
+		Distance: []int32{3},
+	}); !proto.Equal(have, want) {
 		t.Error("have", have, "want", want)
 	}
 }
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleFindTags(ctx context.Context, req *api.FindTagsRequest) (
+	*api.FindTagsResponse, status.S) {
+	var keyUsageCount, keyTagId schema.Varint
+	if req.StartTagKey != "" {
+		var i int
+		if n, err := keyUsageCount.Decode(req.StartTagKey[i:]); err != nil {
+			return nil, status.InvalidArgument(err, "bad tag key")
+		} else {
+			i += int(n)
+		}
+		if err := keyTagId.DecodeAll(req.StartTagKey[i:]); err != nil {
+			return nil, status.InvalidArgument(err, "bad tag key")
+		}
+	}
+
+	var task = &tasks.FindTagsTask{
+		Beg:             s.db,
+		Now:             s.now,
+		Prefix:          req.Prefix,
+		All:             req.All,
+		StartUsageCount: int64(keyUsageCount),
+		StartTagId:      int64(keyTagId),
+	}
+
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	resp := &api.FindTagsResponse{
+		Tag: apiTags(nil, task.Tags...),
+	}
+	if task.NextTagId != 0 {
+		resp.NextTagKey = apiTagKey(task.NextUsageCount, task.NextTagId)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestFindTagsFailsOnBadTagKey(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleFindTags(context.Background(), &api.FindTagsRequest{
+		StartTagKey: "1x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad tag key"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindTags(t *testing.T) {
+	var taskCap *tasks.FindTagsTask
+	now := time.Now()
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindTagsTask)
+		tag := &schema.Tag{
+			TagId:      9,
+			Name:       "cat",
+			UsageCount: 4,
+		}
+		tag.SetCreatedTime(now)
+		tag.SetModifiedTime(now)
+		taskCap.Tags = append(taskCap.Tags, tag)
+		taskCap.NextUsageCount = 3
+		taskCap.NextTagId = 7
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+
+	res, sts := s.handleFindTags(context.Background(), &api.FindTagsRequest{
+		Prefix:      "ca",
+		StartTagKey: apiTagKey(4, 8),
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.Prefix, "ca"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.StartUsageCount, int64(4); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.StartTagId, int64(8); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(res.Tag) != 1 || res.Tag[0].Name != "cat" || res.Tag[0].UsageCount != 4 {
+		t.Error("wrong tags", res.Tag)
+	}
+	if have, want := res.NextTagKey, apiTagKey(3, 7); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/peer"
 
 	"pixur.org/pixur/api"
 	"pixur.org/pixur/be/schema"
@@ -54,6 +55,15 @@ func (s *serv) handleGetRefreshToken(
 		CompareHashAndPassword: compareHashAndPassword,
 		Ident:                  req.Ident,
 		Secret:                 req.Secret,
+		TotpCode:               req.TotpCode,
+		UserAgent:              req.UserAgent,
+		RemoteAddress:          req.RemoteAddress,
+	}
+	// Clients calling the backend directly don't say where they are.
+	if task.RemoteAddress == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			task.RemoteAddress = p.Addr.String()
+		}
 	}
 
 	if req.PreviousAuthToken != "" {
@@ -77,8 +87,15 @@ func (s *serv) handleGetRefreshToken(
 		return nil, sts
 	}
 
-	subject := schema.Varint(task.User.UserId).Encode()
-	authTokenId := task.NewTokenId
+	return s.loginTokens(task.User, task.NewTokenId, task.ResolvedCapability)
+}
+
+// loginTokens builds the auth token for a newly issued user token, and a pix token if the user can
+// read pix.
+func (s *serv) loginTokens(
+	user *schema.User, authTokenId int64, resolvedCaps []schema.User_Capability) (
+	*api.GetRefreshTokenResponse, status.S) {
+	subject := schema.Varint(user.UserId).Encode()
 
 	now := s.now()
 	notBefore, err := ptypes.TimestampProto(time.Unix(now.Add(-1*time.Minute).Unix(), 0))
@@ -110,7 +127,10 @@ func (s *serv) handleGetRefreshToken(
 
 	var pixPayload *api.PwtPayload
 	var pixToken []byte
-	cshave := schema.CapSetOf(task.User.Capability...)
+	cshave := schema.CapSetOf(user.Capability...)
+	for _, c := range resolvedCaps {
+		cshave.Add(c)
+	}
 	cswant := schema.CapSetOf(schema.User_PIC_READ)
 	_, _, missing := schema.CapIntersect(cshave, cswant)
 	if missing.Size() == 0 {
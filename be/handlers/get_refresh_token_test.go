@@ -58,6 +58,40 @@ func TestGetRefreshTokenSucceedsOnIdentSecret(t *testing.T) {
 	}
 }
 
+func TestGetRefreshTokenPassesClientInfo(t *testing.T) {
+	var taskCap *tasks.AuthUserTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.AuthUserTask)
+		taskCap.NewTokenId = 3
+		taskCap.User = &schema.User{
+			UserId: 2,
+		}
+		return nil
+	}
+
+	s := serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleGetRefreshToken(context.Background(), &api.GetRefreshTokenRequest{
+		Ident:         "a",
+		Secret:        "b",
+		UserAgent:     "Mozilla/5.0",
+		RemoteAddress: "127.0.0.1:1234",
+		TotpCode:      "123456",
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.UserAgent != "Mozilla/5.0" || taskCap.RemoteAddress != "127.0.0.1:1234" {
+		t.Error("wrong task input", taskCap.UserAgent, taskCap.RemoteAddress)
+	}
+	if taskCap.TotpCode != "123456" {
+		t.Error("wrong task input", taskCap.TotpCode)
+	}
+}
+
 func TestGetRefreshTokenSucceedsOnRefreshToken(t *testing.T) {
 	var taskCap *tasks.AuthUserTask
 	successRunner := func(_ context.Context, task tasks.Task) status.S {
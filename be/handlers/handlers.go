@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/golang/glog"
@@ -15,8 +16,10 @@ import (
 	gstatus "google.golang.org/grpc/status"
 
 	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
+	"pixur.org/pixur/be/similarity"
 	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/tasks"
 )
@@ -34,7 +37,7 @@ func (si *serverInterceptor) intercept(
 			// Check the request type rather than the handler because it's wrapped.
 			var sts status.S
 			switch req.(type) {
-			case *api.GetRefreshTokenRequest:
+			case *api.GetRefreshTokenRequest, *api.ExchangeIdTokenRequest:
 			default:
 				ctx, sts = fillUserIdAndTokenFromCtx(ctx)
 				if sts != nil {
@@ -53,11 +56,45 @@ func (si *serverInterceptor) intercept(
 	return resp, err
 }
 
+// authedServerStream replaces the context of a stream with one that has the auth token.
+type authedServerStream struct {
+	grpc.ServerStream
+	ctx oldctx.Context
+}
+
+func (ss *authedServerStream) Context() oldctx.Context {
+	return ss.ctx
+}
+
+func (si *serverInterceptor) interceptStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx := ss.Context()
+	if md, present := metadata.FromIncomingContext(ctx); present {
+		if token, present := authTokenFromMD(md); present {
+			ctx = tasks.CtxFromAuthToken(ctx, token)
+			var sts status.S
+			ctx, sts = fillUserIdAndTokenFromCtx(ctx)
+			if sts != nil {
+				return gstatus.Error(sts.Code(), sts.Message())
+			}
+		}
+	}
+
+	err := handler(srv, &authedServerStream{ServerStream: ss, ctx: ctx})
+	if sts, ok := err.(status.S); ok && sts != nil {
+		glog.Info(sts.String())
+		err = gstatus.Error(sts.Code(), sts.Message())
+	}
+	return err
+}
+
 var _ api.PixurServiceServer = &serv{}
 
 type serv struct {
 	db          db.DB
-	pixpath     string
+	blobs       blobstore.BlobStore
+	similarity  *similarity.Index
 	tokenSecret []byte
 	privkey     *rsa.PrivateKey
 	pubkey      *rsa.PublicKey
@@ -65,6 +102,8 @@ type serv struct {
 	runner      *tasks.TaskRunner
 	now         func() time.Time
 	rand        io.Reader
+	// oidc verifies ID tokens for ExchangeIdToken.  Nil if OpenID Connect login is disabled.
+	oidc *oidcVerifier
 }
 
 func (s *serv) AddPicComment(ctx oldctx.Context, req *api.AddPicCommentRequest) (*api.AddPicCommentResponse, error) {
@@ -75,14 +114,54 @@ func (s *serv) AddPicTags(ctx oldctx.Context, req *api.AddPicTagsRequest) (*api.
 	return s.handleAddPicTags(ctx, req)
 }
 
+func (s *serv) CancelBackgroundJob(ctx oldctx.Context, req *api.CancelBackgroundJobRequest) (*api.CancelBackgroundJobResponse, error) {
+	return s.handleCancelBackgroundJob(ctx, req)
+}
+
+func (s *serv) ConfirmTotp(ctx oldctx.Context, req *api.ConfirmTotpRequest) (*api.ConfirmTotpResponse, error) {
+	return s.handleConfirmTotp(ctx, req)
+}
+
+func (s *serv) CreateApiKey(ctx oldctx.Context, req *api.CreateApiKeyRequest) (*api.CreateApiKeyResponse, error) {
+	return s.handleCreateApiKey(ctx, req)
+}
+
+func (s *serv) CreateRole(ctx oldctx.Context, req *api.CreateRoleRequest) (*api.CreateRoleResponse, error) {
+	return s.handleCreateRole(ctx, req)
+}
+
 func (s *serv) CreateUser(ctx oldctx.Context, req *api.CreateUserRequest) (*api.CreateUserResponse, error) {
 	return s.handleCreateUser(ctx, req)
 }
 
+func (s *serv) DeleteTagAlias(ctx oldctx.Context, req *api.DeleteTagAliasRequest) (*api.DeleteTagAliasResponse, error) {
+	return s.handleDeleteTagAlias(ctx, req)
+}
+
+func (s *serv) DeleteTagImplication(ctx oldctx.Context, req *api.DeleteTagImplicationRequest) (*api.DeleteTagImplicationResponse, error) {
+	return s.handleDeleteTagImplication(ctx, req)
+}
+
 func (s *serv) DeleteToken(ctx oldctx.Context, req *api.DeleteTokenRequest) (*api.DeleteTokenResponse, error) {
 	return s.handleDeleteToken(ctx, req)
 }
 
+func (s *serv) DisableTotp(ctx oldctx.Context, req *api.DisableTotpRequest) (*api.DisableTotpResponse, error) {
+	return s.handleDisableTotp(ctx, req)
+}
+
+func (s *serv) EnrollTotp(ctx oldctx.Context, req *api.EnrollTotpRequest) (*api.EnrollTotpResponse, error) {
+	return s.handleEnrollTotp(ctx, req)
+}
+
+func (s *serv) ExchangeIdToken(ctx oldctx.Context, req *api.ExchangeIdTokenRequest) (*api.ExchangeIdTokenResponse, error) {
+	return s.handleExchangeIdToken(ctx, req)
+}
+
+func (s *serv) FindBackgroundJobs(ctx oldctx.Context, req *api.FindBackgroundJobsRequest) (*api.FindBackgroundJobsResponse, error) {
+	return s.handleFindBackgroundJobs(ctx, req)
+}
+
 func (s *serv) FindIndexPics(ctx oldctx.Context, req *api.FindIndexPicsRequest) (*api.FindIndexPicsResponse, error) {
 	return s.handleFindIndexPics(ctx, req)
 }
@@ -91,6 +170,14 @@ func (s *serv) FindPicCommentVotes(ctx oldctx.Context, req *api.FindPicCommentVo
 	return s.handleFindPicCommentVotes(ctx, req)
 }
 
+func (s *serv) FindPicsByTags(ctx oldctx.Context, req *api.FindPicsByTagsRequest) (*api.FindPicsByTagsResponse, error) {
+	return s.handleFindPicsByTags(ctx, req)
+}
+
+func (s *serv) FindRoles(ctx oldctx.Context, req *api.FindRolesRequest) (*api.FindRolesResponse, error) {
+	return s.handleFindRoles(ctx, req)
+}
+
 func (s *serv) FindSchedPics(ctx oldctx.Context, req *api.FindSchedPicsRequest) (*api.FindSchedPicsResponse, error) {
 	return s.handleFindSchedPics(ctx, req)
 }
@@ -103,6 +190,10 @@ func (s *serv) FindUserEvents(ctx oldctx.Context, req *api.FindUserEventsRequest
 	return s.handleFindUserEvents(ctx, req)
 }
 
+func (s *serv) FindTags(ctx oldctx.Context, req *api.FindTagsRequest) (*api.FindTagsResponse, error) {
+	return s.handleFindTags(ctx, req)
+}
+
 func (s *serv) GetRefreshToken(ctx oldctx.Context, req *api.GetRefreshTokenRequest) (*api.GetRefreshTokenResponse, error) {
 	return s.handleGetRefreshToken(ctx, req)
 }
@@ -111,6 +202,22 @@ func (s *serv) IncrementViewCount(ctx oldctx.Context, req *api.IncrementViewCoun
 	return s.handleIncrementViewCount(ctx, req)
 }
 
+func (s *serv) ListApiKeys(ctx oldctx.Context, req *api.ListApiKeysRequest) (*api.ListApiKeysResponse, error) {
+	return s.handleListApiKeys(ctx, req)
+}
+
+func (s *serv) ListTokens(ctx oldctx.Context, req *api.ListTokensRequest) (*api.ListTokensResponse, error) {
+	return s.handleListTokens(ctx, req)
+}
+
+func (s *serv) LookupBackgroundJob(ctx oldctx.Context, req *api.LookupBackgroundJobRequest) (*api.LookupBackgroundJobResponse, error) {
+	return s.handleLookupBackgroundJob(ctx, req)
+}
+
+func (s *serv) LookupPicByHash(ctx oldctx.Context, req *api.LookupPicByHashRequest) (*api.LookupPicByHashResponse, error) {
+	return s.handleLookupPicByHash(ctx, req)
+}
+
 func (s *serv) LookupPicCommentVote(ctx oldctx.Context, req *api.LookupPicCommentVoteRequest) (*api.LookupPicCommentVoteResponse, error) {
 	return s.handleLookupPicCommentVote(ctx, req)
 }
@@ -140,14 +247,38 @@ func (s *serv) LookupPublicUserInfo(ctx oldctx.Context, req *api.LookupPublicUse
 	return s.handleLookupPublicUserInfo(ctx, req)
 }
 
+func (s *serv) MergePics(ctx oldctx.Context, req *api.MergePicsRequest) (*api.MergePicsResponse, error) {
+	return s.handleMergePics(ctx, req)
+}
+
 func (s *serv) PurgePic(ctx oldctx.Context, req *api.PurgePicRequest) (*api.PurgePicResponse, error) {
 	return s.handlePurgePic(ctx, req)
 }
 
+func (s *serv) RemovePicTags(ctx oldctx.Context, req *api.RemovePicTagsRequest) (*api.RemovePicTagsResponse, error) {
+	return s.handleRemovePicTags(ctx, req)
+}
+
+func (s *serv) RestorePic(ctx oldctx.Context, req *api.RestorePicRequest) (*api.RestorePicResponse, error) {
+	return s.handleRestorePic(ctx, req)
+}
+
+func (s *serv) RevokeApiKey(ctx oldctx.Context, req *api.RevokeApiKeyRequest) (*api.RevokeApiKeyResponse, error) {
+	return s.handleRevokeApiKey(ctx, req)
+}
+
+func (s *serv) RevokeToken(ctx oldctx.Context, req *api.RevokeTokenRequest) (*api.RevokeTokenResponse, error) {
+	return s.handleRevokeToken(ctx, req)
+}
+
 func (s *serv) SoftDeletePic(ctx oldctx.Context, req *api.SoftDeletePicRequest) (*api.SoftDeletePicResponse, error) {
 	return s.handleSoftDeletePic(ctx, req)
 }
 
+func (s *serv) UpdateRole(ctx oldctx.Context, req *api.UpdateRoleRequest) (*api.UpdateRoleResponse, error) {
+	return s.handleUpdateRole(ctx, req)
+}
+
 func (s *serv) UpdateUser(ctx oldctx.Context, req *api.UpdateUserRequest) (*api.UpdateUserResponse, error) {
 	return s.handleUpdateUser(ctx, req)
 }
@@ -164,6 +295,14 @@ func (s *serv) UpsertPicVote(ctx oldctx.Context, req *api.UpsertPicVoteRequest)
 	return s.handleUpsertPicVote(ctx, req)
 }
 
+func (s *serv) UpsertTagAlias(ctx oldctx.Context, req *api.UpsertTagAliasRequest) (*api.UpsertTagAliasResponse, error) {
+	return s.handleUpsertTagAlias(ctx, req)
+}
+
+func (s *serv) UpsertTagImplication(ctx oldctx.Context, req *api.UpsertTagImplicationRequest) (*api.UpsertTagImplicationResponse, error) {
+	return s.handleUpsertTagImplication(ctx, req)
+}
+
 func (s *serv) ReadPicFile(rps api.PixurService_ReadPicFileServer) error {
 	return s.handleReadPicFile(rps)
 }
@@ -173,14 +312,24 @@ func (s *serv) WatchBackendConfiguration(req *api.WatchBackendConfigurationReque
 	return s.handleWatchBackendConfiguration(req, wbcs)
 }
 
+func (s *serv) WatchBackgroundJob(req *api.WatchBackgroundJobRequest,
+	wbjs api.PixurService_WatchBackgroundJobServer) error {
+	return s.handleWatchBackgroundJob(req, wbjs)
+}
+
 type ServerConfig struct {
 	DB                   db.DB
-	PixPath              string
+	BlobStore            blobstore.BlobStore
+	SimilarityIndex      *similarity.Index
 	TokenSecret          []byte
 	PrivateKey           *rsa.PrivateKey
 	PublicKey            *rsa.PublicKey
 	Secure               bool
 	BackendConfiguration *api.BackendConfiguration
+	// OidcIssuer and OidcClientId describe the OpenID Connect provider trusted for login.  If
+	// OidcIssuer is empty, ExchangeIdToken is disabled.
+	OidcIssuer   string
+	OidcClientId string
 }
 
 func HandlersInit(ctx context.Context, c *ServerConfig) ([]grpc.ServerOption, func(*grpc.Server)) {
@@ -206,12 +355,18 @@ func HandlersInit(ctx context.Context, c *ServerConfig) ([]grpc.ServerOption, fu
 
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor((&serverInterceptor{}).intercept),
+		grpc.StreamInterceptor((&serverInterceptor{}).interceptStream),
 		grpc.MaxRecvMsgSize(512 * 1024 * 1024),
 	}
+	var oidc *oidcVerifier
+	if c.OidcIssuer != "" {
+		oidc = newOidcVerifier(c.OidcIssuer, c.OidcClientId, http.DefaultClient, now)
+	}
 	return opts, func(s *grpc.Server) {
 		api.RegisterPixurServiceServer(s, &serv{
 			db:          c.DB,
-			pixpath:     c.PixPath,
+			blobs:       c.BlobStore,
+			similarity:  c.SimilarityIndex,
 			tokenSecret: c.TokenSecret,
 			privkey:     c.PrivateKey,
 			pubkey:      c.PublicKey,
@@ -219,6 +374,7 @@ func HandlersInit(ctx context.Context, c *ServerConfig) ([]grpc.ServerOption, fu
 			runner:      nil,
 			now:         now,
 			rand:        rand.Reader,
+			oidc:        oidc,
 		})
 	}
 }
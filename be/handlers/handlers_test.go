@@ -66,7 +66,7 @@ func TestServerInterceptorIgnoresOnAuthHandlers(t *testing.T) {
 
 	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authPwtHeaderKey, "bogus"))
 
-	for _, req := range []interface{}{&api.GetRefreshTokenRequest{}} {
+	for _, req := range []interface{}{&api.GetRefreshTokenRequest{}, &api.ExchangeIdTokenRequest{}} {
 		_, err := si.intercept(ctx, req, nil, handler)
 
 		if err != nil {
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleListApiKeys(ctx context.Context, req *api.ListApiKeysRequest) (
+	*api.ListApiKeysResponse, status.S) {
+	var objectUserId schema.Varint
+	if req.UserId != "" {
+		if err := objectUserId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+
+	var task = &tasks.FindUserApiKeysTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(objectUserId),
+	}
+
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.ListApiKeysResponse{
+		ApiKey: apiApiKeys(nil, task.ApiKeys...),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleListTokens(ctx context.Context, req *api.ListTokensRequest) (
+	*api.ListTokensResponse, status.S) {
+	var objectUserId schema.Varint
+	if req.UserId != "" {
+		if err := objectUserId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+
+	var task = &tasks.FindUserTokensTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(objectUserId),
+	}
+
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	// Mark the token used to make this request, so clients don't revoke it by accident.
+	var currentTokenId int64
+	if tok, ok := tasks.UserTokenFromCtx(ctx); ok {
+		if objectUserId == 0 || int64(objectUserId) == tok.UserId {
+			currentTokenId = tok.TokenId
+		}
+	}
+
+	return &api.ListTokensResponse{
+		Token: apiUserTokens(nil, currentTokenId, task.UserTokens...),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestListTokensFailsOnBadUserId(t *testing.T) {
+	s := &serv{}
+
+	_, sts := s.handleListTokens(context.Background(), &api.ListTokensRequest{
+		UserId: "bogus",
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad user id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestListTokensFailsOnTaskError(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Internal(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleListTokens(context.Background(), &api.ListTokensRequest{})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad things"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestListTokensSuccess(t *testing.T) {
+	var taskCap *tasks.FindUserTokensTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.FindUserTokensTask)
+		taskCap.UserTokens = []*schema.UserToken{{
+			TokenId:   testUserToken,
+			UserAgent: "Mozilla/5.0",
+		}, {
+			TokenId: testUserToken + 1,
+		}}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	ctx := tasks.CtxFromUserToken(context.Background(), testAuthSubject, testUserToken)
+	resp, sts := s.handleListTokens(ctx, &api.ListTokensRequest{})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := taskCap.ObjectUserId, int64(0); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(resp.Token) != 2 {
+		t.Fatal("wrong tokens", resp.Token)
+	}
+	if !resp.Token[0].Current || resp.Token[0].UserAgent != "Mozilla/5.0" {
+		t.Error("wrong token", resp.Token[0])
+	}
+	if resp.Token[1].Current {
+		t.Error("wrong token", resp.Token[1])
+	}
+}
+
+func TestListTokensOtherUserHasNoCurrent(t *testing.T) {
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		task.(*tasks.FindUserTokensTask).UserTokens = []*schema.UserToken{{
+			TokenId: testUserToken,
+		}}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	ctx := tasks.CtxFromUserToken(context.Background(), testAuthSubject, testUserToken)
+	resp, sts := s.handleListTokens(ctx, &api.ListTokensRequest{
+		UserId: schema.Varint(testAuthSubject + 1).Encode(),
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(resp.Token) != 1 || resp.Token[0].Current {
+		t.Error("wrong tokens", resp.Token)
+	}
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleLookupBackgroundJob(ctx context.Context, req *api.LookupBackgroundJobRequest) (
+	*api.LookupBackgroundJobResponse, status.S) {
+
+	var jobId schema.Varint
+	if req.BackgroundJobId != "" {
+		if err := jobId.DecodeAll(req.BackgroundJobId); err != nil {
+			return nil, status.InvalidArgument(err, "bad background job id")
+		}
+	}
+
+	var task = &tasks.LookupBackgroundJobTask{
+		Beg:   s.db,
+		Now:   s.now,
+		JobId: int64(jobId),
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.LookupBackgroundJobResponse{
+		BackgroundJob: apiBackgroundJob(task.BackgroundJob),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestLookupBackgroundJobFailsOnBadJobId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleLookupBackgroundJob(context.Background(), &api.LookupBackgroundJobRequest{
+		BackgroundJobId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupBackgroundJob(t *testing.T) {
+	var taskCap *tasks.LookupBackgroundJobTask
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.LookupBackgroundJobTask)
+		taskCap.BackgroundJob = &schema.BackgroundJob{
+			JobId: taskCap.JobId,
+			Type:  schema.BackgroundJob_THUMBNAIL,
+			Status: &schema.BackgroundJob_Status{
+				State: schema.BackgroundJob_Status_PENDING,
+			},
+		}
+		taskCap.BackgroundJob.SetCreatedTime(time.Now())
+		taskCap.BackgroundJob.SetModifiedTime(time.Now())
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+	res, sts := s.handleLookupBackgroundJob(context.Background(), &api.LookupBackgroundJobRequest{
+		BackgroundJobId: "1",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.JobId, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.BackgroundJob.Id, "1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.BackgroundJob.Status.State, api.BackgroundJob_Status_PENDING; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleLookupPicByHash(ctx context.Context, req *api.LookupPicByHashRequest) (
+	*api.LookupPicByHashResponse, status.S) {
+
+	var task = &tasks.LookupPicByHashTask{
+		Beg:            s.db,
+		Now:            s.now,
+		Sha512_256Hash: req.Sha512_256Hash,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.LookupPicByHashResponse{
+		Pic: apiPic(task.Pic),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestLookupPicByHash(t *testing.T) {
+	now := time.Now()
+	var taskCap *tasks.LookupPicByHashTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.LookupPicByHashTask)
+		taskCap.Pic = &schema.Pic{
+			PicId: 1,
+			File: &schema.Pic_File{
+				Mime: schema.Pic_File_JPEG,
+			},
+		}
+		taskCap.Pic.SetCreatedTime(now)
+		taskCap.Pic.SetModifiedTime(now)
+		return nil
+	}
+	s := &serv{
+		now:    time.Now,
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+
+	hash := []byte("12345678901234567890123456789012")
+	res, sts := s.handleLookupPicByHash(context.Background(), &api.LookupPicByHashRequest{
+		Sha512_256Hash: hash,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if !bytes.Equal(taskCap.Sha512_256Hash, hash) {
+		t.Error("have", taskCap.Sha512_256Hash, "want", hash)
+	}
+	if have, want := res.Pic.Id, "1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupPicByHashFailsOnTaskError(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.NotFound(nil, "can't find pic with hash")
+	}
+	s := &serv{
+		now:    time.Now,
+		runner: tasks.TestTaskRunner(failureRunner),
+	}
+
+	_, sts := s.handleLookupPicByHash(context.Background(), &api.LookupPicByHashRequest{})
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleMergePics(
+	ctx context.Context, req *api.MergePicsRequest) (*api.MergePicsResponse, status.S) {
+
+	var winnerPicId, loserPicId schema.Varint
+	if req.WinnerPicId != "" {
+		if err := winnerPicId.DecodeAll(req.WinnerPicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad winner pic id")
+		}
+	}
+	if req.LoserPicId != "" {
+		if err := loserPicId.DecodeAll(req.LoserPicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad loser pic id")
+		}
+	}
+
+	var task = &tasks.MergePicsTask{
+		Beg:         s.db,
+		Now:         s.now,
+		WinnerPicId: int64(winnerPicId),
+		LoserPicId:  int64(loserPicId),
+
+		SimilarityIndex: s.similarity,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.MergePicsResponse{
+		Pic: apiPic(task.Pic),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestMergePicsFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleMergePics(context.Background(), &api.MergePicsRequest{
+		WinnerPicId: "1",
+		LoserPicId:  "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad loser pic id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestMergePics(t *testing.T) {
+	var taskCap *tasks.MergePicsTask
+	var ctxCap context.Context
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		ctxCap = ctx
+		taskCap = task.(*tasks.MergePicsTask)
+		taskCap.Pic = &schema.Pic{
+			PicId: taskCap.WinnerPicId,
+			File: &schema.Pic_File{
+				Mime: schema.Pic_File_JPEG,
+			},
+		}
+		taskCap.Pic.SetCreatedTime(time.Now())
+		taskCap.Pic.SetModifiedTime(time.Now())
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+	res, sts := s.handleMergePics(context.Background(), &api.MergePicsRequest{
+		WinnerPicId: "1",
+		LoserPicId:  "2",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.WinnerPicId, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.LoserPicId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ctxCap, context.Background(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.Pic.Id, "1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"pixur.org/pixur/be/status"
+)
+
+const (
+	// oidcKeyRefreshInterval limits how often the provider's keys are fetched when an ID token is
+	// signed by an unknown key.
+	oidcKeyRefreshInterval = time.Minute
+	// oidcClockSkew is how far past expiry an ID token is still accepted.
+	oidcClockSkew = time.Minute
+)
+
+// oidcVerifier checks ID tokens issued by a single OpenID Connect provider.  The provider's
+// signing keys are found through its discovery document, and cached.
+type oidcVerifier struct {
+	issuer   string
+	clientId string
+	client   *http.Client
+	now      func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	lastFetch time.Time
+}
+
+// oidcClaims is the subset of ID token claims Pixur uses.
+type oidcClaims struct {
+	Issuer            string       `json:"iss"`
+	Subject           string       `json:"sub"`
+	Audience          oidcAudience `json:"aud"`
+	AuthorizedParty   string       `json:"azp"`
+	Expiry            int64        `json:"exp"`
+	Nonce             string       `json:"nonce"`
+	Email             string       `json:"email"`
+	PreferredUsername string       `json:"preferred_username"`
+}
+
+// oidcAudience is the "aud" claim, which may be a single string or a list of them.
+type oidcAudience []string
+
+func (a *oidcAudience) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = oidcAudience{s}
+		return nil
+	}
+	var ss []string
+	if err := json.Unmarshal(data, &ss); err != nil {
+		return err
+	}
+	*a = oidcAudience(ss)
+	return nil
+}
+
+func (a oidcAudience) contains(aud string) bool {
+	for _, s := range a {
+		if s == aud {
+			return true
+		}
+	}
+	return false
+}
+
+type oidcHeader struct {
+	Algorithm string `json:"alg"`
+	KeyId     string `json:"kid"`
+}
+
+type oidcDiscovery struct {
+	Issuer  string `json:"issuer"`
+	JwksUri string `json:"jwks_uri"`
+}
+
+type oidcJwks struct {
+	Keys []struct {
+		KeyType string `json:"kty"`
+		KeyId   string `json:"kid"`
+		Use     string `json:"use"`
+		N       string `json:"n"`
+		E       string `json:"e"`
+	} `json:"keys"`
+}
+
+func newOidcVerifier(
+	issuer, clientId string, client *http.Client, now func() time.Time) *oidcVerifier {
+	return &oidcVerifier{
+		issuer:   strings.TrimSuffix(issuer, "/"),
+		clientId: clientId,
+		client:   client,
+		now:      now,
+	}
+}
+
+// verify checks the signature and claims of rawIdToken.  nonce is the value sent in the
+// authorization request, and must match the nonce claim so that tokens can't be replayed.
+func (v *oidcVerifier) verify(ctx context.Context, rawIdToken, nonce string) (
+	*oidcClaims, status.S) {
+	if nonce == "" {
+		return nil, status.Unauthenticated(nil, "missing id token nonce")
+	}
+	parts := strings.Split(rawIdToken, ".")
+	if len(parts) != 3 {
+		return nil, status.Unauthenticated(nil, "malformed id token")
+	}
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, status.Unauthenticated(err, "can't decode id token header")
+	}
+	var header oidcHeader
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return nil, status.Unauthenticated(err, "can't parse id token header")
+	}
+	// Only RS256 is required by the spec, and it avoids "none" and HMAC key confusion.
+	if header.Algorithm != "RS256" {
+		return nil, status.Unauthenticatedf(nil, "unsupported id token algorithm %q", header.Algorithm)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, status.Unauthenticated(err, "can't decode id token signature")
+	}
+	key, sts := v.key(ctx, header.KeyId)
+	if sts != nil {
+		return nil, sts
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, status.Unauthenticated(err, "bad id token signature")
+	}
+
+	rawClaims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, status.Unauthenticated(err, "can't decode id token claims")
+	}
+	claims := new(oidcClaims)
+	if err := json.Unmarshal(rawClaims, claims); err != nil {
+		return nil, status.Unauthenticated(err, "can't parse id token claims")
+	}
+	if claims.Issuer != v.issuer {
+		return nil, status.Unauthenticatedf(nil, "wrong id token issuer %q", claims.Issuer)
+	}
+	if !claims.Audience.contains(v.clientId) {
+		return nil, status.Unauthenticated(nil, "id token not issued to this client")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != v.clientId {
+		return nil, status.Unauthenticated(nil, "id token not authorized for this client")
+	}
+	if !v.now().Before(time.Unix(claims.Expiry, 0).Add(oidcClockSkew)) {
+		return nil, status.Unauthenticated(nil, "id token expired")
+	}
+	if claims.Nonce != nonce {
+		return nil, status.Unauthenticated(nil, "wrong id token nonce")
+	}
+	if claims.Subject == "" {
+		return nil, status.Unauthenticated(nil, "missing id token subject")
+	}
+	return claims, nil
+}
+
+// key finds the signing key with the given id, fetching the provider's keys if it isn't known.
+func (v *oidcVerifier) key(ctx context.Context, keyId string) (*rsa.PublicKey, status.S) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.lookupKey(keyId); ok {
+		return key, nil
+	}
+	if !v.lastFetch.IsZero() && v.now().Sub(v.lastFetch) < oidcKeyRefreshInterval {
+		return nil, status.Unauthenticated(nil, "unknown id token key")
+	}
+	keys, sts := v.fetchKeys(ctx)
+	if sts != nil {
+		return nil, sts
+	}
+	v.keys = keys
+	v.lastFetch = v.now()
+	if key, ok := v.lookupKey(keyId); ok {
+		return key, nil
+	}
+	return nil, status.Unauthenticated(nil, "unknown id token key")
+}
+
+func (v *oidcVerifier) lookupKey(keyId string) (*rsa.PublicKey, bool) {
+	// Providers with a single key may leave out the key id.
+	if keyId == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+	key, ok := v.keys[keyId]
+	return key, ok
+}
+
+func (v *oidcVerifier) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, status.S) {
+	var disc oidcDiscovery
+	if sts := v.getJson(ctx, v.issuer+"/.well-known/openid-configuration", &disc); sts != nil {
+		return nil, sts
+	}
+	if disc.Issuer != v.issuer {
+		return nil, status.Internalf(nil, "discovery issuer %q doesn't match", disc.Issuer)
+	}
+	if disc.JwksUri == "" {
+		return nil, status.Internal(nil, "missing jwks uri")
+	}
+	var jwks oidcJwks
+	if sts := v.getJson(ctx, disc.JwksUri, &jwks); sts != nil {
+		return nil, sts
+	}
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.KeyType != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, status.Internal(err, "can't decode key modulus")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, status.Internal(err, "can't decode key exponent")
+		}
+		ebig := new(big.Int).SetBytes(e)
+		if !ebig.IsInt64() || ebig.Int64() > 1<<31-1 {
+			return nil, status.Internal(nil, "key exponent too large")
+		}
+		keys[k.KeyId] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(ebig.Int64()),
+		}
+	}
+	return keys, nil
+}
+
+func (v *oidcVerifier) getJson(ctx context.Context, url string, dst interface{}) status.S {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return status.Internal(err, "can't build request")
+	}
+	resp, err := v.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return status.Unavailable(err, "can't reach provider")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return status.Unavailablef(nil, "bad provider response %v for %s", resp.Status, url)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return status.Internal(err, "can't parse provider response")
+	}
+	return nil
+}
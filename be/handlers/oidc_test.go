@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// testOidcIssuer is a stub OpenID Connect provider, serving discovery and keys.
+type testOidcIssuer struct {
+	t          *testing.T
+	srv        *httptest.Server
+	key        *rsa.PrivateKey
+	keyId      string
+	keyFetches int
+}
+
+func newTestOidcIssuer(t *testing.T) *testOidcIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := &testOidcIssuer{
+		t:     t,
+		key:   key,
+		keyId: "k1",
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   iss.srv.URL,
+			"jwks_uri": iss.srv.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		iss.keyFetches++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": iss.keyId,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(iss.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(iss.key.E)).Bytes()),
+			}},
+		})
+	})
+	iss.srv = httptest.NewServer(mux)
+	return iss
+}
+
+func (iss *testOidcIssuer) Close() {
+	iss.srv.Close()
+}
+
+// claims returns valid claims for client "client", which tests can modify.
+func (iss *testOidcIssuer) claims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":   iss.srv.URL,
+		"sub":   "1234",
+		"aud":   "client",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": "nonce",
+		"email": "bob@example.com",
+	}
+}
+
+func (iss *testOidcIssuer) sign(claims map[string]interface{}) string {
+	return iss.signWith(iss.key, "RS256", claims)
+}
+
+func (iss *testOidcIssuer) signWith(
+	key *rsa.PrivateKey, alg string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": iss.keyId})
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func (iss *testOidcIssuer) verifier() *oidcVerifier {
+	return newOidcVerifier(iss.srv.URL, "client", iss.srv.Client(), time.Now)
+}
+
+func TestOidcVerifierSucceeds(t *testing.T) {
+	iss := newTestOidcIssuer(t)
+	defer iss.Close()
+	v := iss.verifier()
+
+	claims := iss.claims()
+	claims["aud"] = []string{"client", "other"}
+	claims["azp"] = "client"
+	idToken := iss.sign(claims)
+	for i := 0; i < 2; i++ {
+		c, sts := v.verify(context.Background(), idToken, "nonce")
+		if sts != nil {
+			t.Fatal(sts)
+		}
+		if c.Issuer != iss.srv.URL || c.Subject != "1234" || c.Email != "bob@example.com" {
+			t.Error("wrong claims", c)
+		}
+	}
+	if have, want := iss.keyFetches, 1; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestOidcVerifierFailsOnBadTokens(t *testing.T) {
+	iss := newTestOidcIssuer(t)
+	defer iss.Close()
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		modify  func(map[string]interface{})
+		key     *rsa.PrivateKey
+		alg     string
+		message string
+	}{{
+		name:    "issuer",
+		modify:  func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+		message: "wrong id token issuer",
+	}, {
+		name:    "audience",
+		modify:  func(c map[string]interface{}) { c["aud"] = "other" },
+		message: "id token not issued to this client",
+	}, {
+		name:    "authorized party",
+		modify:  func(c map[string]interface{}) { c["aud"] = []string{"client", "other"} },
+		message: "id token not authorized for this client",
+	}, {
+		name:    "expired",
+		modify:  func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		message: "id token expired",
+	}, {
+		name:    "nonce",
+		modify:  func(c map[string]interface{}) { c["nonce"] = "other" },
+		message: "wrong id token nonce",
+	}, {
+		name:    "signature",
+		key:     otherKey,
+		message: "bad id token signature",
+	}, {
+		name:    "algorithm",
+		alg:     "HS256",
+		message: "unsupported id token algorithm",
+	}}
+
+	for _, c := range cases {
+		claims := iss.claims()
+		if c.modify != nil {
+			c.modify(claims)
+		}
+		key, alg := iss.key, "RS256"
+		if c.key != nil {
+			key = c.key
+		}
+		if c.alg != "" {
+			alg = c.alg
+		}
+		_, sts := iss.verifier().verify(context.Background(), iss.signWith(key, alg, claims), "nonce")
+		if sts == nil {
+			t.Error(c.name, "expected non-nil status")
+			continue
+		}
+		if have, want := sts.Code(), codes.Unauthenticated; have != want {
+			t.Error(c.name, "have", have, "want", want)
+		}
+		if have, want := sts.Message(), c.message; !strings.Contains(have, want) {
+			t.Error(c.name, "have", have, "want", want)
+		}
+	}
+}
+
+func TestOidcVerifierFailsWithoutNonce(t *testing.T) {
+	iss := newTestOidcIssuer(t)
+	defer iss.Close()
+
+	claims := iss.claims()
+	delete(claims, "nonce")
+	_, sts := iss.verifier().verify(context.Background(), iss.sign(claims), "")
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing id token nonce"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestOidcVerifierFailsOnUnknownKey(t *testing.T) {
+	iss := newTestOidcIssuer(t)
+	defer iss.Close()
+	v := iss.verifier()
+
+	idToken := iss.sign(iss.claims())
+	iss.keyId = "k2"
+
+	for i := 0; i < 2; i++ {
+		_, sts := v.verify(context.Background(), idToken, "nonce")
+		if sts == nil {
+			t.Fatal("expected non-nil status")
+		}
+		if have, want := sts.Message(), "unknown id token key"; !strings.Contains(have, want) {
+			t.Error("have", have, "want", want)
+		}
+	}
+	// Unknown keys don't cause a fetch every time.
+	if have, want := iss.keyFetches, 1; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"context"
-	"os"
 
 	"pixur.org/pixur/api"
 	"pixur.org/pixur/be/schema"
@@ -21,11 +20,12 @@ func (s *serv) handlePurgePic(
 	}
 
 	var task = &tasks.PurgePicTask{
-		Beg:     s.db,
-		Now:     s.now,
-		PixPath: s.pixpath,
-		Remove:  os.Remove,
-		PicId:   int64(picId),
+		Beg:       s.db,
+		Now:       s.now,
+		BlobStore: s.blobs,
+		PicId:     int64(picId),
+
+		SimilarityIndex: s.similarity,
 	}
 	if sts := s.runner.Run(ctx, task); sts != nil {
 		return nil, sts
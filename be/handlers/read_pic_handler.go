@@ -3,7 +3,6 @@ package handlers
 import (
 	"context"
 	"io"
-	"os"
 	"strconv"
 	"time"
 
@@ -13,6 +12,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/tasks"
@@ -32,49 +32,55 @@ func apiFormatToSchemaMime(format api.PicFile_Format) (schema.Pic_File_Mime, sta
 	return schema.Pic_File_Mime(schemaValue), nil
 }
 
-// TODO: add tests
-func (s *serv) handleLookupPicFile(ctx context.Context, req *api.LookupPicFileRequest) (
-	*api.LookupPicFileResponse, status.S) {
-	if sts := authReadPicRequest(ctx); sts != nil {
-		return nil, sts
+// picFileKey finds the blob key of a pic file id, which is the pic id followed by an optional
+// derived index.
+func picFileKey(picFileId string, format api.PicFile_Format) (blobstore.Key, status.S) {
+	mime, sts := apiFormatToSchemaMime(format)
+	if sts != nil {
+		return blobstore.Key{}, sts
 	}
-
 	var picId schema.Varint
 	var picDerivedIndex schema.Varint
-	n, err := picId.Decode(req.PicFileId)
+	n, err := picId.Decode(picFileId)
 	if err != nil {
-		return nil, status.InvalidArgument(err, "can't decode pic id")
+		return blobstore.Key{}, status.InvalidArgument(err, "can't decode pic id")
 	}
-	if len(req.PicFileId) != n {
-		if err := picDerivedIndex.DecodeAll(req.PicFileId[n:]); err != nil {
-			return nil, status.InvalidArgument(err, "can't decode pic index")
+	if len(picFileId) != n {
+		if err := picDerivedIndex.DecodeAll(picFileId[n:]); err != nil {
+			return blobstore.Key{}, status.InvalidArgument(err, "can't decode pic index")
 		}
+		return blobstore.PicFileDerivedKey(int64(picId), int64(picDerivedIndex), mime), nil
 	}
+	return blobstore.PicFileKey(int64(picId), mime), nil
+}
 
-	mime, sts := apiFormatToSchemaMime(req.Format)
+// TODO: add tests
+func (s *serv) handleLookupPicFile(ctx context.Context, req *api.LookupPicFileRequest) (
+	*api.LookupPicFileResponse, status.S) {
+	userId, sts := authReadPicRequest(ctx)
 	if sts != nil {
 		return nil, sts
 	}
-	var path string
-	if len(req.PicFileId) != n {
-		path, sts = schema.PicFileDerivedPath(s.pixpath, int64(picId), int64(picDerivedIndex), mime)
-	} else {
-		path, sts = schema.PicFilePath(s.pixpath, int64(picId), mime)
+
+	key, sts := picFileKey(req.PicFileId, req.Format)
+	if sts != nil {
+		return nil, sts
 	}
+	private, sts := s.authReadPic(ctx, key.PicId, userId)
 	if sts != nil {
 		return nil, sts
 	}
-	fi, err := os.Stat(path)
-	if err != nil {
-		return nil, status.NotFound(err, "can't open pic")
+	info, sts := s.blobs.Stat(ctx, key)
+	if sts != nil {
+		return nil, sts
 	}
 
-	mts, err := ptypes.TimestampProto(fi.ModTime())
+	mts, err := ptypes.TimestampProto(info.ModTime)
 	if err != nil {
 		return nil, status.Internal(err, "bad ts")
 	}
 
-	md, sts := readPicHeaders(ctx)
+	md, sts := readPicHeaders(ctx, private)
 	if sts != nil {
 		return nil, sts
 	}
@@ -87,42 +93,62 @@ func (s *serv) handleLookupPicFile(ctx context.Context, req *api.LookupPicFileRe
 			// TODO: return right value
 			CreatedTime:  mts,
 			ModifiedTime: mts,
-			Size:         fi.Size(),
+			Size:         info.Size,
 			// TODO: include the rest of the values
 		},
 	}, nil
 }
 
-func authReadPicRequest(ctx context.Context) status.S {
+// authReadPicRequest checks the pix token of the request, and returns the user it was issued to,
+// or AnonymousUserId if there is no token.
+func authReadPicRequest(ctx context.Context) (int64, status.S) {
 	if md, present := metadata.FromIncomingContext(ctx); present {
 		if tokens, ok := md[pixPwtHeaderKey]; !ok || len(tokens) == 0 {
 			conf, sts := tasks.GetConfiguration(ctx)
 			if sts != nil {
-				return sts
+				return 0, sts
 			}
 			cs := schema.CapSetOf(conf.AnonymousCapability.Capability...)
 			_, _, missing := schema.CapIntersect(cs, schema.CapSetOf(schema.User_PIC_READ))
 			if missing.Size() != 0 {
-				return status.Unauthenticated(nil, "missing pix token")
+				return 0, status.Unauthenticated(nil, "missing pix token")
 			}
+			return schema.AnonymousUserId, nil
 		} else if len(tokens) > 1 {
-			return status.Unauthenticated(nil, "too many tokens")
+			return 0, status.Unauthenticated(nil, "too many tokens")
 		} else {
 			pixPayload, err := defaultPwtCoder.decode([]byte(tokens[0]))
 			if err != nil {
-				return status.Unauthenticated(err, err.Error())
+				return 0, status.Unauthenticated(err, err.Error())
 			}
 			if pixPayload.Type != api.PwtPayload_PIX {
-				return status.Unauthenticated(nil, "not pix token")
+				return 0, status.Unauthenticated(nil, "not pix token")
 			}
+			userId, _, sts := extractUserToken(pixPayload)
+			if sts != nil {
+				return 0, sts
+			}
+			return userId, nil
 		}
 	} else {
-		return status.Internal(nil, "missing MD")
+		return 0, status.Internal(nil, "missing MD")
+	}
+}
+
+// authReadPic checks that the user can see the pic, and returns if the pic is private.
+func (s *serv) authReadPic(ctx context.Context, picId, userId int64) (bool, status.S) {
+	var task = &tasks.AuthReadPicTask{
+		Beg:    s.db,
+		PicId:  picId,
+		UserId: userId,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return false, sts
 	}
-	return nil
+	return task.Private, nil
 }
 
-func readPicHeaders(ctx context.Context) (metadata.MD, status.S) {
+func readPicHeaders(ctx context.Context, private bool) (metadata.MD, status.S) {
 	h1 := &api.HttpHeader{
 		Key: "Cache-Control",
 	}
@@ -132,8 +158,9 @@ func readPicHeaders(ctx context.Context) (metadata.MD, status.S) {
 	}
 	h1.Value = "private"
 
+	// Shared caches must not keep private pics, even if anyone can read public ones.
 	for _, c := range conf.AnonymousCapability.Capability {
-		if c == schema.User_PIC_READ {
+		if c == schema.User_PIC_READ && !private {
 			h1.Value = "public"
 			break
 		}
@@ -155,13 +182,14 @@ func readPicHeaders(ctx context.Context) (metadata.MD, status.S) {
 
 // TODO: add tests
 func (s *serv) handleReadPicFile(rps api.PixurService_ReadPicFileServer) status.S {
-	if sts := authReadPicRequest(rps.Context()); sts != nil {
+	userId, sts := authReadPicRequest(rps.Context())
+	if sts != nil {
 		return sts
 	}
 
 	// ok, authed!
 
-	var f *os.File
+	var key *blobstore.Key
 	for {
 		req, err := rps.Recv()
 		if err == io.EOF {
@@ -169,39 +197,15 @@ func (s *serv) handleReadPicFile(rps api.PixurService_ReadPicFileServer) status.
 		} else if err != nil {
 			return status.Internal(err, "can't recv")
 		}
-		if f == nil {
-			mime, sts := apiFormatToSchemaMime(req.Format)
+		if key == nil {
+			k, sts := picFileKey(req.PicFileId, req.Format)
 			if sts != nil {
 				return sts
 			}
-
-			var picId schema.Varint
-			var picDerivedIndex schema.Varint
-			n, err := picId.Decode(req.PicFileId)
-			if err != nil {
-				return status.InvalidArgument(err, "can't decode pic id")
-			}
-			if len(req.PicFileId) != n {
-				if err := picDerivedIndex.DecodeAll(req.PicFileId[n:]); err != nil {
-					return status.InvalidArgument(err, "can't decode pic index")
-				}
-			}
-
-			var path string
-			if len(req.PicFileId) != n {
-				path, sts = schema.PicFileDerivedPath(
-					s.pixpath, int64(picId), int64(picDerivedIndex), mime)
-			} else {
-				path, sts = schema.PicFilePath(s.pixpath, int64(picId), mime)
-			}
-			if sts != nil {
+			if _, sts := s.authReadPic(rps.Context(), k.PicId, userId); sts != nil {
 				return sts
 			}
-			f, err = os.Open(path)
-			if err != nil {
-				return status.NotFound(err, "can't open pic")
-			}
-			defer f.Close()
+			key = &k
 		}
 
 		resp := &api.ReadPicFileResponse{}
@@ -212,8 +216,15 @@ func (s *serv) handleReadPicFile(rps api.PixurService_ReadPicFileServer) status.
 		} else {
 			resp.Data = make([]byte, int(req.Limit))
 		}
-		n, err := f.ReadAt(resp.Data, req.Offset)
-		if err == io.EOF {
+		r, sts := s.blobs.GetRange(rps.Context(), *key, req.Offset, int64(len(resp.Data)))
+		if sts != nil {
+			return sts
+		}
+		n, err := io.ReadFull(r, resp.Data)
+		if closeErr := r.Close(); closeErr != nil {
+			return status.Internal(closeErr, "can't close")
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
 			resp.Eof = true
 		} else if err != nil {
 			return status.Internal(err, "can't read")
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleRestorePic(
+	ctx context.Context, req *api.RestorePicRequest) (*api.RestorePicResponse, status.S) {
+
+	var picId schema.Varint
+	if req.PicId != "" {
+		if err := picId.DecodeAll(req.PicId); err != nil {
+			return nil, status.InvalidArgument(err, "bad pic id")
+		}
+	}
+
+	var task = &tasks.RestorePicTask{
+		Beg:       s.db,
+		BlobStore: s.blobs,
+		Now:       s.now,
+		PicId:     int64(picId),
+		Details:   req.Details,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RestorePicResponse{
+		Pic: apiPic(task.Pic),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestRestorePicFailsOnBadPicId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleRestorePic(context.Background(), &api.RestorePicRequest{
+		PicId: "x",
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRestorePic(t *testing.T) {
+	var taskCap *tasks.RestorePicTask
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RestorePicTask)
+		taskCap.Pic = &schema.Pic{
+			PicId: taskCap.PicId,
+			File: &schema.Pic_File{
+				Mime: schema.Pic_File_JPEG,
+			},
+		}
+		taskCap.Pic.SetCreatedTime(time.Now())
+		taskCap.Pic.SetModifiedTime(time.Now())
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+	res, sts := s.handleRestorePic(context.Background(), &api.RestorePicRequest{
+		PicId:   "1",
+		Details: "oops",
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.PicId, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.Details, "oops"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.Pic.Id, "1"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
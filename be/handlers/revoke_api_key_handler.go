@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleRevokeApiKey(ctx context.Context, req *api.RevokeApiKeyRequest) (
+	*api.RevokeApiKeyResponse, status.S) {
+	var objectUserId schema.Varint
+	if req.UserId != "" {
+		if err := objectUserId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+	var keyId schema.Varint
+	if err := keyId.DecodeAll(req.ApiKeyId); err != nil {
+		return nil, status.InvalidArgument(err, "bad api key id")
+	}
+
+	var task = &tasks.RevokeApiKeyTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(objectUserId),
+		KeyId:        int64(keyId),
+	}
+
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RevokeApiKeyResponse{}, nil
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestRevokeApiKeyFailsOnBadKeyId(t *testing.T) {
+	s := &serv{}
+
+	_, sts := s.handleRevokeApiKey(context.Background(), &api.RevokeApiKeyRequest{
+		ApiKeyId: "bogus",
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad api key id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeApiKeySuccess(t *testing.T) {
+	var taskCap *tasks.RevokeApiKeyTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RevokeApiKeyTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleRevokeApiKey(context.Background(), &api.RevokeApiKeyRequest{
+		UserId:   schema.Varint(2).Encode(),
+		ApiKeyId: schema.Varint(7).Encode(),
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if want := new(api.RevokeApiKeyResponse); !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+	if taskCap.ObjectUserId != 2 || taskCap.KeyId != 7 {
+		t.Error("wrong task input", taskCap)
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleRevokeToken(ctx context.Context, req *api.RevokeTokenRequest) (
+	*api.RevokeTokenResponse, status.S) {
+	var objectUserId schema.Varint
+	if req.UserId != "" {
+		if err := objectUserId.DecodeAll(req.UserId); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+	}
+	var tokenId schema.Varint
+	if !req.All {
+		if err := tokenId.DecodeAll(req.TokenId); err != nil {
+			return nil, status.InvalidArgument(err, "bad token id")
+		}
+	}
+
+	var task = &tasks.RevokeUserTokenTask{
+		Beg:          s.db,
+		Now:          s.now,
+		ObjectUserId: int64(objectUserId),
+		TokenId:      int64(tokenId),
+		All:          req.All,
+	}
+
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.RevokeTokenResponse{}, nil
+}
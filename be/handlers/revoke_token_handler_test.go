@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestRevokeTokenFailsOnBadTokenId(t *testing.T) {
+	s := &serv{}
+
+	_, sts := s.handleRevokeToken(context.Background(), &api.RevokeTokenRequest{
+		TokenId: "bogus",
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad token id"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeTokenFailsOnTaskError(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Internal(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleRevokeToken(context.Background(), &api.RevokeTokenRequest{
+		TokenId: schema.Varint(testUserToken).Encode(),
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad things"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeTokenSuccess(t *testing.T) {
+	var taskCap *tasks.RevokeUserTokenTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RevokeUserTokenTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	resp, sts := s.handleRevokeToken(context.Background(), &api.RevokeTokenRequest{
+		UserId:  schema.Varint(2).Encode(),
+		TokenId: schema.Varint(testUserToken).Encode(),
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if want := new(api.RevokeTokenResponse); !proto.Equal(resp, want) {
+		t.Error("have", resp, "want", want)
+	}
+	if taskCap.ObjectUserId != 2 || taskCap.TokenId != testUserToken || taskCap.All {
+		t.Error("wrong task input", taskCap)
+	}
+}
+
+func TestRevokeTokenAll(t *testing.T) {
+	var taskCap *tasks.RevokeUserTokenTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.RevokeUserTokenTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleRevokeToken(context.Background(), &api.RevokeTokenRequest{
+		UserId: schema.Varint(2).Encode(),
+		All:    true,
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap.ObjectUserId != 2 || !taskCap.All {
+		t.Error("wrong task input", taskCap)
+	}
+}
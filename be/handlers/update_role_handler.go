@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleUpdateRole(ctx context.Context, req *api.UpdateRoleRequest) (
+	*api.UpdateRoleResponse, status.S) {
+	var roleId schema.Varint
+	if err := roleId.DecodeAll(req.RoleId); err != nil {
+		return nil, status.InvalidArgument(err, "bad role id")
+	}
+
+	var name string
+	if req.Name != nil {
+		if req.Name.Name == "" {
+			return nil, status.InvalidArgument(nil, "missing name")
+		}
+		name = req.Name.Name
+	}
+
+	var newcaps, oldcaps []schema.User_Capability
+	if req.Capability != nil {
+		var sts status.S
+		if newcaps, sts = beCapsChecked(req.Capability.SetCapability); sts != nil {
+			return nil, sts
+		}
+		if oldcaps, sts = beCapsChecked(req.Capability.ClearCapability); sts != nil {
+			return nil, sts
+		}
+	}
+
+	var task = &tasks.UpdateRoleTask{
+		Beg: s.db,
+		Now: s.now,
+
+		RoleId:          int64(roleId),
+		Version:         req.Version,
+		Name:            name,
+		SetCapability:   newcaps,
+		ClearCapability: oldcaps,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.UpdateRoleResponse{
+		Role: apiRole(task.Role),
+	}, nil
+}
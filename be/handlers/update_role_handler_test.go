@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestUpdateRoleFailsOnBadRoleId(t *testing.T) {
+	s := &serv{
+		runner: tasks.TestTaskRunner(func(_ context.Context, task tasks.Task) status.S {
+			t.Error("task ran")
+			return nil
+		}),
+		now: time.Now,
+	}
+
+	_, sts := s.handleUpdateRole(context.Background(), &api.UpdateRoleRequest{
+		RoleId: "bogus",
+	})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateRole(t *testing.T) {
+	var taskCap *tasks.UpdateRoleTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UpdateRoleTask)
+		taskCap.Role = &schema.Role{
+			RoleId:     taskCap.RoleId,
+			Name:       taskCap.Name,
+			ModifiedTs: schema.ToTspb(time.Now()),
+		}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	res, sts := s.handleUpdateRole(context.Background(), &api.UpdateRoleRequest{
+		RoleId:  schema.Varint(2).Encode(),
+		Version: 3,
+		Name:    &api.UpdateRoleRequest_ChangeName{Name: "Moderators"},
+		Capability: &api.UpdateRoleRequest_ChangeCapability{
+			SetCapability:   []api.Capability_Cap{api.Capability_PIC_MERGE},
+			ClearCapability: []api.Capability_Cap{api.Capability_PIC_PURGE},
+		},
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.RoleId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.Version, int64(3); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.SetCapability, []schema.User_Capability{schema.User_PIC_MERGE}; len(have) != 1 || have[0] != want[0] {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.ClearCapability, []schema.User_Capability{schema.User_PIC_PURGE}; len(have) != 1 || have[0] != want[0] {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.Role.Name, "Moderators"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -27,6 +27,30 @@ func init() {
 	}
 }
 
+// beCapsChecked converts api caps to schema caps, failing on unknown caps.
+func beCapsChecked(srcs []api.Capability_Cap) ([]schema.User_Capability, status.S) {
+	var dst []schema.User_Capability
+	for _, c := range srcs {
+		if _, ok := apischemacapmap[c]; !ok || c == api.Capability_UNKNOWN {
+			return nil, status.InvalidArgumentf(nil, "unknown cap %v", c)
+		}
+		dst = append(dst, schema.User_Capability(c))
+	}
+	return dst, nil
+}
+
+func beRoleIds(srcs []string) ([]int64, status.S) {
+	var dst []int64
+	for _, src := range srcs {
+		var roleId schema.Varint
+		if err := roleId.DecodeAll(src); err != nil {
+			return nil, status.InvalidArgument(err, "bad role id")
+		}
+		dst = append(dst, int64(roleId))
+	}
+	return dst, nil
+}
+
 // TODO: add tests
 
 func (s *serv) handleUpdateUser(ctx context.Context, req *api.UpdateUserRequest) (
@@ -39,19 +63,24 @@ func (s *serv) handleUpdateUser(ctx context.Context, req *api.UpdateUserRequest)
 	}
 
 	var newcaps, oldcaps []schema.User_Capability
-
 	if req.Capability != nil {
-		for _, c := range req.Capability.SetCapability {
-			if _, ok := apischemacapmap[c]; !ok || c == api.Capability_UNKNOWN {
-				return nil, status.InvalidArgumentf(nil, "unknown cap %v", c)
-			}
-			newcaps = append(newcaps, schema.User_Capability(c))
+		var sts status.S
+		if newcaps, sts = beCapsChecked(req.Capability.SetCapability); sts != nil {
+			return nil, sts
+		}
+		if oldcaps, sts = beCapsChecked(req.Capability.ClearCapability); sts != nil {
+			return nil, sts
+		}
+	}
+
+	var newroles, oldroles []int64
+	if req.Role != nil {
+		var sts status.S
+		if newroles, sts = beRoleIds(req.Role.SetRoleId); sts != nil {
+			return nil, sts
 		}
-		for _, c := range req.Capability.ClearCapability {
-			if _, ok := apischemacapmap[c]; !ok || c == api.Capability_UNKNOWN {
-				return nil, status.InvalidArgumentf(nil, "unknown cap %v", c)
-			}
-			oldcaps = append(oldcaps, schema.User_Capability(c))
+		if oldroles, sts = beRoleIds(req.Role.ClearRoleId); sts != nil {
+			return nil, sts
 		}
 	}
 
@@ -62,6 +91,8 @@ func (s *serv) handleUpdateUser(ctx context.Context, req *api.UpdateUserRequest)
 		Version:         req.Version,
 		SetCapability:   newcaps,
 		ClearCapability: oldcaps,
+		SetRoleId:       newroles,
+		ClearRoleId:     oldroles,
 	}
 
 	if sts := s.runner.Run(ctx, task); sts != nil {
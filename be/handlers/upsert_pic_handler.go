@@ -10,6 +10,7 @@ import (
 	"os"
 
 	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/tasks"
 )
@@ -39,29 +40,95 @@ func (s *serv) handleUpsertPic(ctx context.Context, req *api.UpsertPicRequest) (
 		return nil, status.InvalidArgument(nil, "bad md5 hash")
 	}
 
+	visibility, sts := bePicVisibility(req.Visibility)
+	if sts != nil {
+		return nil, sts
+	}
+
+	if req.Async {
+		return s.handleUpsertPicAsync(ctx, req, visibility)
+	}
+
 	var task = &tasks.UpsertPicTask{
-		PixPath:    s.pixpath,
+		BlobStore:  s.blobs,
 		Beg:        s.db,
 		HTTPClient: http.DefaultClient,
 		TempFile:   ioutil.TempFile,
-		Rename:     os.Rename,
-		MkdirAll:   os.MkdirAll,
 		Now:        s.now,
 		Remove:     os.Remove,
 
+		SimilarityIndex: s.similarity,
+
 		FileURL:         req.Url,
 		FileURLReferrer: req.Referrer,
 		File:            file,
 		Md5Hash:         req.Md5Hash,
 		FileName:        req.Name,
 		Ext:             req.Ext,
+		Visibility:      visibility,
 	}
 
 	if sts := s.runner.Run(ctx, task); sts != nil {
 		return nil, sts
 	}
 
-	return &api.UpsertPicResponse{
+	resp := &api.UpsertPicResponse{
 		Pic: apiPic(task.CreatedPic),
+	}
+	for _, id := range task.SuspectedDuplicatePicIds {
+		resp.SuspectedDuplicatePicId = append(resp.SuspectedDuplicatePicId, schema.Varint(id).Encode())
+	}
+	return resp, nil
+}
+
+// handleUpsertPicAsync queues the url to be downloaded by a background job.
+func (s *serv) handleUpsertPicAsync(
+	ctx context.Context, req *api.UpsertPicRequest, visibility *schema.Pic_Visibility) (
+	*api.UpsertPicResponse, status.S) {
+	if req.Url == "" || len(req.Data) != 0 {
+		return nil, status.InvalidArgument(nil, "async requires url and no data")
+	}
+	if len(req.Ext) != 0 {
+		return nil, status.InvalidArgument(nil, "can't use ext with async")
+	}
+
+	var task = &tasks.CreateBackgroundJobTask{
+		Beg:        s.db,
+		Now:        s.now,
+		Type:       schema.BackgroundJob_FETCH_URL,
+		Url:        req.Url,
+		Referrer:   req.Referrer,
+		FileName:   req.Name,
+		Md5Hash:    req.Md5Hash,
+		Visibility: visibility,
+	}
+	if sts := s.runner.Run(ctx, task); sts != nil {
+		return nil, sts
+	}
+
+	return &api.UpsertPicResponse{
+		ImportId: schema.Varint(task.BackgroundJob.JobId).Encode(),
 	}, nil
 }
+
+func bePicVisibility(src *api.PicVisibility) (*schema.Pic_Visibility, status.S) {
+	if src == nil {
+		return nil, nil
+	}
+	dst := &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_Mode(src.Mode),
+	}
+	for _, id := range src.UserId {
+		var userId schema.Varint
+		if err := userId.DecodeAll(id); err != nil {
+			return nil, status.InvalidArgument(err, "bad user id")
+		}
+		dst.UserId = append(dst.UserId, int64(userId))
+	}
+	roleIds, sts := beRoleIds(src.RoleId)
+	if sts != nil {
+		return nil, sts
+	}
+	dst.RoleId = roleIds
+	return dst, nil
+}
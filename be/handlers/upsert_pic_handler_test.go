@@ -6,9 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 
 	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/tasks"
@@ -47,12 +49,14 @@ func TestUpsertPic(t *testing.T) {
 		taskCap.CreatedPic.Thumbnail = []*schema.Pic_File{{
 			Mime: schema.Pic_File_JPEG,
 		}}
+		taskCap.SuspectedDuplicatePicIds = []int64{2}
 
 		return nil
 	}
 	s := &serv{
 		runner: tasks.TestTaskRunner(successRunner),
 		now:    time.Now,
+		blobs:  blobstore.NewPixPathStore("pix"),
 	}
 	res, sts := s.handleUpsertPic(context.Background(), &api.UpsertPicRequest{
 		Url:      "http://foo/",
@@ -76,8 +80,8 @@ func TestUpsertPic(t *testing.T) {
 	if taskCap.File == nil {
 		t.Error("file is nil")
 	}
-	if taskCap.HTTPClient == nil || taskCap.TempFile == nil || taskCap.Rename == nil ||
-		taskCap.MkdirAll == nil || taskCap.Now == nil {
+	if taskCap.HTTPClient == nil || taskCap.TempFile == nil ||
+		taskCap.BlobStore == nil || taskCap.Now == nil {
 		t.Error("deps are nil", taskCap)
 	}
 	if have, want := ctxCap, context.Background(); have != want {
@@ -87,6 +91,120 @@ func TestUpsertPic(t *testing.T) {
 		t.Error("bad md5 hash", taskCap.Md5Hash)
 	}
 	if res == nil {
-		t.Error("bad response")
+		t.Fatal("bad response")
+	}
+	if have, want := res.SuspectedDuplicatePicId, []string{"2"}; len(have) != 1 || have[0] != want[0] {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicAsync(t *testing.T) {
+	var taskCap *tasks.CreateBackgroundJobTask
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.CreateBackgroundJobTask)
+		taskCap.BackgroundJob = &schema.BackgroundJob{
+			JobId: 3,
+		}
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	res, sts := s.handleUpsertPic(context.Background(), &api.UpsertPicRequest{
+		Url:      "http://foo/bar.jpg",
+		Referrer: "http://foo/",
+		Name:     "baz.jpg",
+		Async:    true,
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.Type, schema.BackgroundJob_FETCH_URL; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.Url, "http://foo/bar.jpg"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.Referrer, "http://foo/"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.FileName, "baz.jpg"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := res.ImportId, "3"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if res.Pic != nil {
+		t.Error("unexpected pic", res.Pic)
+	}
+}
+
+func TestUpsertPicAsyncFailsOnData(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleUpsertPic(context.Background(), &api.UpsertPicRequest{
+		Url:   "http://foo/bar.jpg",
+		Data:  []byte("data"),
+		Async: true,
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicVisibility(t *testing.T) {
+	var taskCap *tasks.UpsertPicTask
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UpsertPicTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+	_, sts := s.handleUpsertPic(context.Background(), &api.UpsertPicRequest{
+		Data: []byte("a"),
+		Visibility: &api.PicVisibility{
+			Mode:   api.PicVisibility_PRIVATE,
+			UserId: []string{"3"},
+			RoleId: []string{"4"},
+		},
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	want := &schema.Pic_Visibility{
+		Mode:   schema.Pic_Visibility_PRIVATE,
+		UserId: []int64{3},
+		RoleId: []int64{4},
+	}
+	if have := taskCap.Visibility; !proto.Equal(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicVisibilityFailsOnBadUserId(t *testing.T) {
+	s := &serv{}
+	_, sts := s.handleUpsertPic(context.Background(), &api.UpsertPicRequest{
+		Data: []byte("a"),
+		Visibility: &api.PicVisibility{
+			Mode:   api.PicVisibility_PRIVATE,
+			UserId: []string{"!"},
+		},
+	})
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
 	}
 }
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleUpsertTagAlias(ctx context.Context, req *api.UpsertTagAliasRequest) (
+	*api.UpsertTagAliasResponse, status.S) {
+	var task = &tasks.UpsertTagAliasTask{
+		Beg: s.db,
+		Now: s.now,
+
+		Alias:   req.Alias,
+		TagName: req.Tag,
+	}
+	if err := s.runner.Run(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return &api.UpsertTagAliasResponse{}, nil
+}
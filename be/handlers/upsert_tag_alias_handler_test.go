@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestUpsertTagAliasFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Internal(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleUpsertTagAlias(context.Background(), &api.UpsertTagAliasRequest{})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad things"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagAlias(t *testing.T) {
+	var taskCap *tasks.UpsertTagAliasTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UpsertTagAliasTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	res, sts := s.handleUpsertTagAlias(context.Background(), &api.UpsertTagAliasRequest{
+		Alias: "kitty",
+		Tag:   "cat",
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := res, (&api.UpsertTagAliasResponse{}); !proto.Equal(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.Alias, "kitty"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.TagName, "cat"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"context"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func (s *serv) handleUpsertTagImplication(ctx context.Context, req *api.UpsertTagImplicationRequest) (
+	*api.UpsertTagImplicationResponse, status.S) {
+	var task = &tasks.UpsertTagImplicationTask{
+		Beg: s.db,
+		Now: s.now,
+
+		TagName:        req.Tag,
+		ImpliedTagName: req.ImpliedTag,
+	}
+	if err := s.runner.Run(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return &api.UpsertTagImplicationResponse{}, nil
+}
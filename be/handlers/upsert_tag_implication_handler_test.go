@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+func TestUpsertTagImplicationFailsOnTaskFailure(t *testing.T) {
+	failureRunner := func(_ context.Context, task tasks.Task) status.S {
+		return status.Internal(nil, "bad things")
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(failureRunner),
+		now:    time.Now,
+	}
+
+	_, sts := s.handleUpsertTagImplication(context.Background(), &api.UpsertTagImplicationRequest{})
+
+	if sts == nil {
+		t.Fatal("didn't fail")
+	}
+	if have, want := sts.Code(), codes.Internal; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad things"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagImplication(t *testing.T) {
+	var taskCap *tasks.UpsertTagImplicationTask
+	successRunner := func(_ context.Context, task tasks.Task) status.S {
+		taskCap = task.(*tasks.UpsertTagImplicationTask)
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+		now:    time.Now,
+	}
+
+	res, sts := s.handleUpsertTagImplication(context.Background(), &api.UpsertTagImplicationRequest{
+		Tag:        "cat",
+		ImpliedTag: "animal",
+	})
+
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := res, (&api.UpsertTagImplicationResponse{}); !proto.Equal(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if taskCap == nil {
+		t.Fatal("task didn't run")
+	}
+	if have, want := taskCap.TagName, "cat"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := taskCap.ImpliedTagName, "animal"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+// watchBackgroundJobPollInterval is how often the job is checked for changes.
+var watchBackgroundJobPollInterval = time.Second / 2
+
+func (s *serv) handleWatchBackgroundJob(
+	req *api.WatchBackgroundJobRequest,
+	wbjs api.PixurService_WatchBackgroundJobServer) status.S {
+
+	ctx := wbjs.Context()
+
+	var jobId schema.Varint
+	if req.BackgroundJobId != "" {
+		if err := jobId.DecodeAll(req.BackgroundJobId); err != nil {
+			return status.InvalidArgument(err, "bad background job id")
+		}
+	}
+
+	var last *api.BackgroundJob
+	for {
+		var task = &tasks.LookupBackgroundJobTask{
+			Beg:   s.db,
+			Now:   s.now,
+			JobId: int64(jobId),
+		}
+		if sts := s.runner.Run(ctx, task); sts != nil {
+			return sts
+		}
+		bj := apiBackgroundJob(task.BackgroundJob)
+		if !proto.Equal(bj, last) {
+			if err := wbjs.Send(&api.WatchBackgroundJobResponse{BackgroundJob: bj}); err != nil {
+				return status.Unavailable(err, "can't send background job")
+			}
+			last = bj
+		}
+		if task.BackgroundJob.Finished() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.From(ctx.Err())
+		case <-time.After(watchBackgroundJobPollInterval):
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+type testWatchBackgroundJobServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*api.WatchBackgroundJobResponse
+}
+
+func (s *testWatchBackgroundJobServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchBackgroundJobServer) Send(resp *api.WatchBackgroundJobResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestWatchBackgroundJobFailsOnBadJobId(t *testing.T) {
+	s := &serv{}
+	wbjs := &testWatchBackgroundJobServer{ctx: context.Background()}
+	sts := s.handleWatchBackgroundJob(&api.WatchBackgroundJobRequest{
+		BackgroundJobId: "x",
+	}, wbjs)
+	if sts == nil {
+		t.Fatal("nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestWatchBackgroundJob(t *testing.T) {
+	defer func(old time.Duration) {
+		watchBackgroundJobPollInterval = old
+	}(watchBackgroundJobPollInterval)
+	watchBackgroundJobPollInterval = time.Millisecond
+
+	states := []schema.BackgroundJob_Status_State{
+		schema.BackgroundJob_Status_RUNNING,
+		schema.BackgroundJob_Status_RUNNING,
+		schema.BackgroundJob_Status_SUCCEEDED,
+	}
+	now := time.Now()
+	var runs int
+	successRunner := func(ctx context.Context, task tasks.Task) status.S {
+		taskCap := task.(*tasks.LookupBackgroundJobTask)
+		taskCap.BackgroundJob = &schema.BackgroundJob{
+			JobId: taskCap.JobId,
+			Type:  schema.BackgroundJob_FETCH_URL,
+			Status: &schema.BackgroundJob_Status{
+				State: states[runs],
+			},
+		}
+		taskCap.BackgroundJob.SetCreatedTime(now)
+		taskCap.BackgroundJob.SetModifiedTime(now)
+		runs++
+		return nil
+	}
+	s := &serv{
+		runner: tasks.TestTaskRunner(successRunner),
+	}
+	wbjs := &testWatchBackgroundJobServer{ctx: context.Background()}
+	sts := s.handleWatchBackgroundJob(&api.WatchBackgroundJobRequest{
+		BackgroundJobId: "1",
+	}, wbjs)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+
+	if have, want := runs, 3; have != want {
+		t.Error("have", have, "want", want)
+	}
+	// Unchanged jobs aren't sent again.
+	if have, want := len(wbjs.sent), 2; have != want {
+		t.Fatal("have", have, "want", want)
+	}
+	if have, want := wbjs.sent[0].BackgroundJob.Status.State, api.BackgroundJob_Status_RUNNING; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := wbjs.sent[1].BackgroundJob.Status.State, api.BackgroundJob_Status_SUCCEEDED; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -178,6 +178,10 @@ func ConvertVideo(
 	}, nil
 }
 
+func (im *ffmpegImage) Convert(ImageFormat) (PixurImage, status.S) {
+	return nil, status.Unimplemented(nil, "convert not supported")
+}
+
 func (im *ffmpegImage) Write(io.Writer) status.S {
 	return status.Unimplemented(nil, "write not supported")
 }
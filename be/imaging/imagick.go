@@ -7,6 +7,7 @@ import (
 	"io"
 	"math"
 	"os"
+	"sync"
 	"time"
 
 	// this is the only outside pixur package dependency.  Avoid depending too much on schema.
@@ -127,7 +128,7 @@ func (pi *imagickImage) Thumbnail() (PixurImage, status.S) {
 		return nil, status.Internal(err, "unable to crop thumbnail")
 	}
 
-	side := uint(thumbnailSquareSize)
+	side := uint(ThumbnailSquareSize)
 	newmw.TransformImageColorspace(imagick.COLORSPACE_RGB)
 	if err := newmw.ResizeImage(side, side, imagick.FILTER_CATROM, 1); err != nil {
 		return nil, status.Internal(err, "unable to resize thumbnail")
@@ -220,6 +221,82 @@ func (pi *imagickImage) Thumbnail() (PixurImage, status.S) {
 	return newpi, nil
 }
 
+// extraThumbnailFormats are the formats thumbnails are also encoded in, if they can be written.
+var extraThumbnailFormats = []ImageFormat{DefaultWebpFormat, DefaultAvifFormat}
+
+var (
+	writableFormatsLock sync.Mutex
+	writableFormats     = make(map[ImageFormat]bool)
+)
+
+// canWriteFormat checks if the image library has an encoder for format.  Listed formats may only
+// be decodable, so each one is checked once by encoding a small image.
+func canWriteFormat(format ImageFormat) bool {
+	writableFormatsLock.Lock()
+	defer writableFormatsLock.Unlock()
+	if ok, present := writableFormats[format]; present {
+		return ok
+	}
+	ok := probeWriteFormat(format)
+	writableFormats[format] = ok
+	return ok
+}
+
+func probeWriteFormat(format ImageFormat) bool {
+	mw := imagick.NewMagickWand()
+	defer mw.Destroy()
+	pw := imagick.NewPixelWand()
+	defer pw.Destroy()
+	pw.SetColor("white")
+	if err := mw.NewImage(1, 1, pw); err != nil {
+		return false
+	}
+	if err := mw.SetImageFormat(string(format)); err != nil {
+		return false
+	}
+	return len(mw.GetImageBlob()) != 0
+}
+
+// ExtraThumbnailFormats returns the formats that thumbnails should be converted to, in addition
+// to the format returned by Thumbnail.  Formats the image library doesn't support are left out.
+func ExtraThumbnailFormats() []ImageFormat {
+	var formats []ImageFormat
+	for _, f := range extraThumbnailFormats {
+		if canWriteFormat(f) {
+			formats = append(formats, f)
+		}
+	}
+	return formats
+}
+
+func (pi *imagickImage) Convert(format ImageFormat) (PixurImage, status.S) {
+	if !canWriteFormat(format) {
+		return nil, status.Unimplemented(nil, "can't write format", format)
+	}
+	defer pi.mw.ResetIterator()
+	newmw := pi.mw.Clone()
+	destroy := true
+	defer func() {
+		if destroy {
+			newmw.Destroy()
+		}
+	}()
+	if err := newmw.SetImageFormat(string(format)); err != nil {
+		return nil, status.Internal(err, "unable to set format")
+	}
+	switch {
+	case format.IsWebp():
+		newmw.SetImageCompressionQuality(85)
+	case format.IsAvif():
+		newmw.SetImageCompressionQuality(60)
+	}
+	newpi := &imagickImage{
+		mw: newmw,
+	}
+	destroy = false
+	return newpi, nil
+}
+
 func (pi *imagickImage) Format() ImageFormat {
 	return ImageFormat(pi.mw.GetImageFormat())
 }
@@ -229,6 +306,9 @@ func (pi *imagickImage) Dimensions() (w uint, h uint) {
 }
 
 func (pi *imagickImage) Duration() (*time.Duration, status.S) {
+	if pi.Format().IsWebp() {
+		return pi.webpDuration()
+	}
 	if !pi.Format().IsGif() {
 		return nil, nil
 	}
@@ -272,6 +352,34 @@ func (pi *imagickImage) Duration() (*time.Duration, status.S) {
 	return &d, nil
 }
 
+// webpDuration adds up the frame delays of an animated WEBP.  Unlike GIF, browsers show short
+// frames as is.
+func (pi *imagickImage) webpDuration() (*time.Duration, status.S) {
+	switch pi.mw.GetNumberImages() {
+	case 1:
+		return nil, nil
+	case 0:
+		return nil, status.InvalidArgument(nil, "no images")
+	}
+	tps := int64(pi.mw.GetImageTicksPerSecond())
+	if tps <= 0 {
+		return nil, status.InvalidArgument(nil, "bad ticks per second", tps)
+	}
+	var d time.Duration
+	defer pi.mw.ResetIterator()
+	for pi.mw.NextImage() {
+		delayTicks := int64(pi.mw.GetImageDelay())
+		if delayTicks < 0 || delayTicks > math.MaxInt64/int64(time.Second) {
+			return nil, status.InvalidArgument(nil, "delayTicks would overflow", delayTicks)
+		}
+		if d += time.Duration(delayTicks) * time.Second / time.Duration(tps); d < 0 {
+			return nil, status.InvalidArgument(nil, "duration overflow", d)
+		}
+	}
+
+	return &d, nil
+}
+
 func (pi *imagickImage) Close() {
 	if pi.mw != nil {
 		pi.mw.Destroy()
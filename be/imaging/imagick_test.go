@@ -195,7 +195,7 @@ func TestThumbnail_png_offset(t *testing.T) {
 	}
 	defer thumb.Close()
 
-	if x, y := thumb.Dimensions(); x != thumbnailSquareSize || y != thumbnailSquareSize {
+	if x, y := thumb.Dimensions(); x != ThumbnailSquareSize || y != ThumbnailSquareSize {
 		t.Error("bad dimensions", x, y)
 	}
 }
@@ -253,12 +253,86 @@ func TestWriteThumbnail(t *testing.T) {
 	}
 	defer thumb2.Close()
 
-	if w, h := thumb2.Dimensions(); w != thumbnailSquareSize || h != thumbnailSquareSize {
+	if w, h := thumb2.Dimensions(); w != ThumbnailSquareSize || h != ThumbnailSquareSize {
 		t.Error("bad dims", w, h)
 	}
 	// I have experimentally confirmed there is no offset in the thumbnail.
 }
 
+func TestConvertThumbnail(t *testing.T) {
+	mw := imagick.NewMagickWand()
+	defer mw.Destroy()
+	pw := imagick.NewPixelWand()
+	defer pw.Destroy()
+
+	mw.NewImage(100, 200, pw)
+	mw.SetImageFormat(string(DefaultPngFormat))
+	pi := &imagickImage{mw: mw.Clone()}
+	defer pi.Close()
+
+	thumb, sts := pi.Thumbnail()
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	defer thumb.Close()
+
+	for _, format := range append(ExtraThumbnailFormats(), DefaultPngFormat) {
+		converted, sts := thumb.Convert(format)
+		if sts != nil {
+			t.Fatal(sts)
+		}
+		defer converted.Close()
+		if have, want := converted.Format(), format; have != want {
+			t.Error("have", have, "want", want)
+		}
+		if w, h := converted.Dimensions(); w != ThumbnailSquareSize || h != ThumbnailSquareSize {
+			t.Error("bad dims", w, h)
+		}
+		var buf bytes.Buffer
+		if sts := converted.Write(&buf); sts != nil {
+			t.Fatal(sts)
+		}
+		if buf.Len() == 0 {
+			t.Error("nothing written for", format)
+		}
+	}
+	// The original is unchanged.
+	if have, want := thumb.Format(), DefaultJpegFormat; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCanWriteFormat(t *testing.T) {
+	for _, format := range []ImageFormat{DefaultJpegFormat, DefaultPngFormat, DefaultGifFormat} {
+		if !canWriteFormat(format) {
+			t.Error("can't write", format)
+		}
+	}
+	if canWriteFormat("NOTAFORMAT") {
+		t.Error("shouldn't write unknown format")
+	}
+}
+
+func TestConvertFailsOnUnknownFormat(t *testing.T) {
+	mw := imagick.NewMagickWand()
+	defer mw.Destroy()
+	pw := imagick.NewPixelWand()
+	defer pw.Destroy()
+
+	mw.NewImage(10, 10, pw)
+	mw.SetImageFormat(string(DefaultPngFormat))
+	pi := &imagickImage{mw: mw.Clone()}
+	defer pi.Close()
+
+	_, sts := pi.Convert("NOTAFORMAT")
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.Unimplemented; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
 func TestReadImage_gif_singleframe(t *testing.T) {
 	mw := imagick.NewMagickWand()
 	defer mw.Destroy()
@@ -405,6 +479,62 @@ func TestReadImage_gif_multiframe(t *testing.T) {
 	}
 }
 
+func TestDuration_webp_multiframe(t *testing.T) {
+	mw := imagick.NewMagickWand()
+	defer mw.Destroy()
+	pw := imagick.NewPixelWand()
+	defer pw.Destroy()
+
+	mw.NewImage(1, 2, pw)
+	mw.SetImageFormat(string(DefaultWebpFormat))
+	tps := mw.GetImageTicksPerSecond()
+	mw.SetImageDelay(tps)
+
+	tmp := mw.GetImage()
+	// Short frames are not rounded up, unlike GIF.
+	tmp.SetImageDelay(1)
+	mw.AddImage(tmp)
+	tmp.Destroy()
+
+	pi := &imagickImage{mw: mw.Clone()}
+	defer pi.Close()
+
+	if !pi.Format().IsWebp() {
+		t.Error("not a webp", pi.Format())
+	}
+	dur, sts := pi.Duration()
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if dur == nil {
+		t.Fatal("missing duration", dur)
+	}
+	if have, want := *dur, time.Second+time.Second/time.Duration(tps); have != want {
+		t.Error("wrong duration", have, want)
+	}
+}
+
+func TestDuration_webp_singleframe(t *testing.T) {
+	mw := imagick.NewMagickWand()
+	defer mw.Destroy()
+	pw := imagick.NewPixelWand()
+	defer pw.Destroy()
+
+	mw.NewImage(1, 2, pw)
+	mw.SetImageFormat(string(DefaultWebpFormat))
+
+	pi := &imagickImage{mw: mw.Clone()}
+	defer pi.Close()
+
+	dur, sts := pi.Duration()
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if dur != nil {
+		t.Error("have", *dur, "want nil")
+	}
+}
+
 func TestReadImage_gif_shortFrameLengthRoundsUp(t *testing.T) {
 	mw := imagick.NewMagickWand()
 	defer mw.Destroy()
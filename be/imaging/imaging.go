@@ -15,10 +15,14 @@ const (
 	DefaultPngFormat  ImageFormat = "PNG"
 	DefaultWebmFormat ImageFormat = "WEBM"
 	DefaultMp4Format  ImageFormat = "MP4"
+	DefaultWebpFormat ImageFormat = "WEBP"
+	DefaultAvifFormat ImageFormat = "AVIF"
+	DefaultHeicFormat ImageFormat = "HEIC"
 )
 
 const (
-	thumbnailSquareSize = 192
+	// ThumbnailSquareSize is the width and height of thumbnails.
+	ThumbnailSquareSize = 192
 )
 
 const gifTicksPerSecond = 100
@@ -29,8 +33,34 @@ const (
 
 	// MP4 header
 	movHeader = "\x00\x00\x00\x20"
+
+	// ISO base media files, like MP4 and HEIC, start with a box of this type, followed by the
+	// major brand.
+	ftypBox = "ftyp"
 )
 
+// heifBrands are the major brands of ISO base media files that hold images rather than video.
+var heifBrands = map[string]bool{
+	"heic": true,
+	"heix": true,
+	"heim": true,
+	"heis": true,
+	"hevc": true,
+	"hevx": true,
+	"mif1": true,
+	"msf1": true,
+	"avif": true,
+	"avis": true,
+}
+
+// isHeif checks if the header is from a HEIF image, such as HEIC or AVIF.
+func isHeif(header []byte) bool {
+	if len(header) < 12 || string(header[4:8]) != ftypBox {
+		return false
+	}
+	return heifBrands[string(header[8:12])]
+}
+
 // ImageFormat is the string format of the image
 type ImageFormat string
 
@@ -61,6 +91,21 @@ func (f ImageFormat) IsMp4() bool {
 	return f == "MP4"
 }
 
+// IsWebp returns true if the type of this image is a WEBP.
+func (f ImageFormat) IsWebp() bool {
+	return f == "WEBP"
+}
+
+// IsAvif returns true if the type of this image is an AVIF.
+func (f ImageFormat) IsAvif() bool {
+	return f == "AVIF"
+}
+
+// IsHeic returns true if the type of this image is a HEIC.
+func (f ImageFormat) IsHeic() bool {
+	return f == "HEIC" || f == "HEIF"
+}
+
 type PixurImage interface {
 	Format() ImageFormat
 	Dimensions() (width, height uint)
@@ -69,6 +114,9 @@ type PixurImage interface {
 	Duration() (*time.Duration, status.S)
 
 	Thumbnail() (PixurImage, status.S)
+	// Convert re-encodes the image in another format.  It returns Unimplemented if the format
+	// can't be written.
+	Convert(ImageFormat) (PixurImage, status.S)
 
 	PerceptualHash0() ([]byte, []float32, status.S)
 
@@ -97,10 +145,17 @@ func ReadImage(ctx context.Context, r io.Reader) (PixurImage, status.S) {
 		}
 		ra = bytes.NewReader(b.Bytes())
 	}
-	firstfour := make([]byte, 4)
-	if _, err := ra.ReadAt(firstfour, 0); err != nil {
+	header := make([]byte, 12)
+	n, err := ra.ReadAt(header, 0)
+	if n < 4 {
 		return nil, status.InvalidArgument(err, "unable to read first 4 bytes")
 	}
+	header = header[:n]
+	// HEIF images can have the same header as MP4, so check them first.
+	if isHeif(header) {
+		return defaultimagereader(ctx, ra)
+	}
+	firstfour := header[:4]
 	if bytes.Equal(firstfour, []byte(ebmlHeader)) || bytes.Equal(firstfour, []byte(movHeader)) {
 		return defaultvideoreader(ctx, ra)
 	}
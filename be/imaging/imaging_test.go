@@ -0,0 +1,26 @@
+package imaging
+
+import (
+	"testing"
+)
+
+func TestIsHeif(t *testing.T) {
+	cases := []struct {
+		header string
+		want   bool
+	}{
+		{"\x00\x00\x00\x18ftypheic", true},
+		{"\x00\x00\x00\x20ftypavif", true},
+		{"\x00\x00\x00\x1cftypmif1", true},
+		// Same header as MP4.
+		{"\x00\x00\x00\x20ftypisom", false},
+		{"\x00\x00\x00\x20ftyp", false},
+		{"RIFF\x00\x00\x00\x00WEBP", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if have := isHeif([]byte(c.header)); have != c.want {
+			t.Error("have", have, "want", c.want, "for", []byte(c.header))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func (bj *BackgroundJob) IdCol() int64 {
+	return bj.JobId
+}
+
+func (bj *BackgroundJob) StateCol() BackgroundJob_Status_State {
+	return bj.Status.GetState()
+}
+
+func (bj *BackgroundJob) NextRunTsCol() int64 {
+	return BackgroundJobNextRunTsCol(bj.Status.GetNextRunTs())
+}
+
+func (bj *BackgroundJob) UserIdCol() int64 {
+	return bj.UserId
+}
+
+// BackgroundJobNextRunTsCol converts a time to the next_run_ts column.  Jobs without a time sort
+// first.
+func BackgroundJobNextRunTsCol(nextRunTs *tspb.Timestamp) int64 {
+	if nextRunTs == nil {
+		return 0
+	}
+	return ToTime(nextRunTs).UnixNano()
+}
+
+func (bj *BackgroundJob) SetCreatedTime(now time.Time) {
+	bj.CreatedTs = ToTspb(now)
+}
+
+func (bj *BackgroundJob) SetModifiedTime(now time.Time) {
+	bj.ModifiedTs = ToTspb(now)
+}
+
+func (bj *BackgroundJob) GetCreatedTime() time.Time {
+	return ToTime(bj.CreatedTs)
+}
+
+func (bj *BackgroundJob) GetModifiedTime() time.Time {
+	return ToTime(bj.ModifiedTs)
+}
+
+// Finished returns if the job will never run again.
+func (bj *BackgroundJob) Finished() bool {
+	switch bj.Status.GetState() {
+	case BackgroundJob_Status_SUCCEEDED, BackgroundJob_Status_FAILED,
+		BackgroundJob_Status_CANCELLED:
+		return true
+	}
+	return false
+}
@@ -118,4 +118,22 @@ var defaultConfiguration = &Configuration{
 	MaxFindUserEvents: &wpb.Int64Value{
 		Value: 100,
 	},
+	DefaultFindTags: &wpb.Int64Value{
+		Value: 10,
+	},
+	MaxFindTags: &wpb.Int64Value{
+		Value: 100,
+	},
+	NearDuplicatePolicy: &Configuration_NearDuplicatePolicy{
+		Action:      Configuration_NearDuplicatePolicy_ALLOW,
+		MaxDistance: 4,
+	},
+	// Matches the largest request the backend accepts.
+	MaxFileSize: &wpb.Int64Value{
+		Value: 512 * 1024 * 1024,
+	},
+	// Active users refresh their tokens daily, so this only logs out users who have been away.
+	UserTokenTtl: ptypes.DurationProto(30 * 24 * time.Hour),
+	// Empty, since requiring it would lock out admins who haven't enrolled yet.
+	TotpRequiredCapability: &Configuration_CapabilitySet{},
 }
@@ -1,5 +1,11 @@
 package schema
 
+// Key types of CustomData used by Pixur.
+const (
+	// LeaseKeyType is the key type of leases.  Key1 is the lease id.  The data is a Lease.
+	LeaseKeyType int64 = 1
+)
+
 func (cd *CustomData) KeyTypeCol() int64 {
 	return cd.KeyType
 }
@@ -45,6 +45,18 @@ func (p *Pic) IndexOrder() int64 {
 	return p.NonHiddenIndexOrder()
 }
 
+// PendingDeletionOrderCol is the time the pic can be hard deleted, in nanoseconds, or 0 if it
+// isn't waiting to be hard deleted.
+func (p *Pic) PendingDeletionOrderCol() int64 {
+	if p.HardDeleted() {
+		return 0
+	}
+	if ts := p.GetDeletionStatus().GetPendingDeletedTs(); ts != nil {
+		return ToTime(ts).UnixNano()
+	}
+	return 0
+}
+
 func (p *Pic) GetVarPicId() string {
 	return Varint(p.PicId).Encode()
 }
@@ -72,6 +84,23 @@ func (p *Pic) HardDeleted() bool {
 	return p.GetDeletionStatus().GetActualDeletedTs() != nil
 }
 
+// Private returns true if only the uploader, and the users and roles in the visibility can see
+// the pic.
+func (p *Pic) Private() bool {
+	return p.GetVisibility().GetMode() == Pic_Visibility_PRIVATE
+}
+
+// UploaderUserId returns the first non anonymous user to upload the pic, or AnonymousUserId if
+// there is none.
+func (p *Pic) UploaderUserId() int64 {
+	for _, s := range p.Source {
+		if s.UserId != AnonymousUserId {
+			return s.UserId
+		}
+	}
+	return AnonymousUserId
+}
+
 const (
 	Z_99        = 1.95996398612
 	PicScoreMin = 0
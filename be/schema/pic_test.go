@@ -20,3 +20,16 @@ func TestPicPath(t *testing.T) {
 		t.Fatalf("%v != %v", out, "/foo/k/1/5/m/k15m6.jpg")
 	}
 }
+
+func TestPicUploaderUserId(t *testing.T) {
+	p := &Pic{
+		Source: []*Pic_FileSource{{UserId: AnonymousUserId}, {UserId: 4}, {UserId: 5}},
+	}
+	if have, want := p.UploaderUserId(), int64(4); have != want {
+		t.Error("have", have, "want", want)
+	}
+	p.Source = p.Source[:1]
+	if have, want := p.UploaderUserId(), AnonymousUserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
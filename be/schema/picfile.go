@@ -12,6 +12,9 @@ var picFileMimeExt = map[Pic_File_Mime]string{
 	Pic_File_PNG:  ".png",
 	Pic_File_WEBM: ".webm",
 	Pic_File_MP4:  ".mp4",
+	Pic_File_WEBP: ".webp",
+	Pic_File_AVIF: ".avif",
+	Pic_File_HEIC: ".heic",
 }
 
 var picFileMimeTypes = map[string]Pic_File_Mime{
@@ -20,6 +23,9 @@ var picFileMimeTypes = map[string]Pic_File_Mime{
 	".png":  Pic_File_PNG,
 	".webm": Pic_File_WEBM,
 	".mp4":  Pic_File_MP4,
+	".webp": Pic_File_WEBP,
+	".avif": Pic_File_AVIF,
+	".heic": Pic_File_HEIC,
 }
 
 func init() {
@@ -59,3 +65,27 @@ func PicFileDerivedPath(
 		PicBaseDir(pixPath, picId),
 		Varint(picId).Encode()+Varint(index).Encode()+ext), nil
 }
+
+// ParsePicFileName is the inverse of the file names from PicFilePath and PicFileDerivedPath.
+// derived is set if the name includes an index.
+func ParsePicFileName(name string) (picId, index int64, derived bool, mime Pic_File_Mime, _ status.S) {
+	ext := filepath.Ext(name)
+	mime, present := picFileMimeTypes[ext]
+	if !present {
+		return 0, 0, false, Pic_File_UNKNOWN, status.InvalidArgument(nil, "unknown ext", ext)
+	}
+	base := name[:len(name)-len(ext)]
+	var vid, vindex Varint
+	n, err := vid.Decode(base)
+	if err != nil {
+		return 0, 0, false, Pic_File_UNKNOWN, status.InvalidArgument(err, "can't decode pic id", name)
+	}
+	if n != len(base) {
+		if err := vindex.DecodeAll(base[n:]); err != nil {
+			return 0, 0, false, Pic_File_UNKNOWN,
+				status.InvalidArgument(err, "can't decode pic index", name)
+		}
+		derived = true
+	}
+	return int64(vid), int64(vindex), derived, mime, nil
+}
@@ -58,6 +58,18 @@ func TestPicFilePath_png(t *testing.T) {
 	}
 }
 
+func TestPicFilePath_webp(t *testing.T) {
+	if have, want := mustPicFilePath(t, "foo", 17, Pic_File_WEBP), "foo/g/g1.webp"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestPicFilePath_avif(t *testing.T) {
+	if have, want := mustPicFilePath(t, "foo", 17, Pic_File_AVIF), "foo/g/g1.avif"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
 func TestPicFilePath_unknown(t *testing.T) {
 	_, sts := PicFilePath("foo", 1, Pic_File_UNKNOWN)
 	if sts == nil {
@@ -113,3 +125,33 @@ func TestPicFileDerivedPath_unknown(t *testing.T) {
 		t.Error("have", have, "want", want)
 	}
 }
+
+func TestParsePicFileName(t *testing.T) {
+	picId, index, derived, mime, sts := ParsePicFileName("g1.gif")
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if picId != 17 || index != 0 || derived || mime != Pic_File_GIF {
+		t.Error("have", picId, index, derived, mime, "want", 17, 0, false, Pic_File_GIF)
+	}
+}
+
+func TestParsePicFileName_derived(t *testing.T) {
+	picId, index, derived, mime, sts := ParsePicFileName("g1g1.png")
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if picId != 17 || index != 17 || !derived || mime != Pic_File_PNG {
+		t.Error("have", picId, index, derived, mime, "want", 17, 17, true, Pic_File_PNG)
+	}
+}
+
+func TestParsePicFileName_unknown(t *testing.T) {
+	_, _, _, _, sts := ParsePicFileName("g1.txt")
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -33,18 +33,22 @@ const (
 	Pic_DeletionStatus_NONE Pic_DeletionStatus_Reason = 1
 	// The pic is in violation of the rules.
 	Pic_DeletionStatus_RULE_VIOLATION Pic_DeletionStatus_Reason = 2
+	// The pic is a duplicate of another pic, and was merged into it.
+	Pic_DeletionStatus_DUPLICATE Pic_DeletionStatus_Reason = 3
 )
 
 var Pic_DeletionStatus_Reason_name = map[int32]string{
 	0: "UNKNOWN",
 	1: "NONE",
 	2: "RULE_VIOLATION",
+	3: "DUPLICATE",
 }
 
 var Pic_DeletionStatus_Reason_value = map[string]int32{
 	"UNKNOWN":        0,
 	"NONE":           1,
 	"RULE_VIOLATION": 2,
+	"DUPLICATE":      3,
 }
 
 func (x Pic_DeletionStatus_Reason) String() string {
@@ -64,6 +68,9 @@ const (
 	Pic_File_PNG     Pic_File_Mime = 3
 	Pic_File_WEBM    Pic_File_Mime = 4
 	Pic_File_MP4     Pic_File_Mime = 5
+	Pic_File_WEBP    Pic_File_Mime = 6
+	Pic_File_AVIF    Pic_File_Mime = 7
+	Pic_File_HEIC    Pic_File_Mime = 8
 )
 
 var Pic_File_Mime_name = map[int32]string{
@@ -73,6 +80,9 @@ var Pic_File_Mime_name = map[int32]string{
 	3: "PNG",
 	4: "WEBM",
 	5: "MP4",
+	6: "WEBP",
+	7: "AVIF",
+	8: "HEIC",
 }
 
 var Pic_File_Mime_value = map[string]int32{
@@ -82,6 +92,9 @@ var Pic_File_Mime_value = map[string]int32{
 	"PNG":     3,
 	"WEBM":    4,
 	"MP4":     5,
+	"WEBP":    6,
+	"AVIF":    7,
+	"HEIC":    8,
 }
 
 func (x Pic_File_Mime) String() string {
@@ -92,6 +105,33 @@ func (Pic_File_Mime) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_962aa63430fd1f4b, []int{0, 3, 0}
 }
 
+type Pic_Visibility_Mode int32
+
+const (
+	// Anyone who can index pics can see the pic.
+	Pic_Visibility_PUBLIC Pic_Visibility_Mode = 0
+	// Only the uploader, and the listed users and roles can see the pic.
+	Pic_Visibility_PRIVATE Pic_Visibility_Mode = 1
+)
+
+var Pic_Visibility_Mode_name = map[int32]string{
+	0: "PUBLIC",
+	1: "PRIVATE",
+}
+
+var Pic_Visibility_Mode_value = map[string]int32{
+	"PUBLIC":  0,
+	"PRIVATE": 1,
+}
+
+func (x Pic_Visibility_Mode) String() string {
+	return proto.EnumName(Pic_Visibility_Mode_name, int32(x))
+}
+
+func (Pic_Visibility_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{0, 6, 0}
+}
+
 type PicIdent_Type int32
 
 const (
@@ -154,7 +194,7 @@ func (x PicVote_Vote) String() string {
 }
 
 func (PicVote_Vote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{6, 0}
+	return fileDescriptor_962aa63430fd1f4b, []int{8, 0}
 }
 
 type PicCommentVote_Vote int32
@@ -185,7 +225,7 @@ func (x PicCommentVote_Vote) String() string {
 }
 
 func (PicCommentVote_Vote) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{7, 0}
+	return fileDescriptor_962aa63430fd1f4b, []int{9, 0}
 }
 
 type User_Capability int32
@@ -260,6 +300,25 @@ const (
 	User_PIC_COMMENT_VOTE_CREATE User_Capability = 28
 	// Can this user create arbitrary extension data on a comment vote?
 	User_PIC_COMMENT_VOTE_EXTENSION_CREATE User_Capability = 29
+	// Can this user remove tags from pics?
+	User_PIC_TAG_DELETE User_Capability = 30
+	// Can this user create and delete tag aliases?
+	User_TAG_ALIAS_UPDATE User_Capability = 31
+	// Can this user create and delete tag implications?
+	User_TAG_IMPLICATION_UPDATE User_Capability = 32
+	// Can this user merge duplicate pics?
+	User_PIC_MERGE User_Capability = 33
+	// Can this user restore soft deleted pics?
+	User_PIC_RESTORE User_Capability = 34
+	// Can this user see background jobs created by other users?
+	User_BACKGROUND_JOB_READ User_Capability = 35
+	// Can this user cancel background jobs created by other users?
+	User_BACKGROUND_JOB_CANCEL User_Capability = 36
+	// Can this user create and modify roles?  Assigning roles to users requires
+	// USER_UPDATE_CAPABILITY.
+	User_ROLE_UPDATE User_Capability = 37
+	// Can this user see private pics of other users?
+	User_PIC_READ_PRIVATE User_Capability = 38
 )
 
 var User_Capability_name = map[int32]string{
@@ -293,6 +352,15 @@ var User_Capability_name = map[int32]string{
 	27: "USER_READ_PIC_VOTE",
 	28: "PIC_COMMENT_VOTE_CREATE",
 	29: "PIC_COMMENT_VOTE_EXTENSION_CREATE",
+	30: "PIC_TAG_DELETE",
+	31: "TAG_ALIAS_UPDATE",
+	32: "TAG_IMPLICATION_UPDATE",
+	33: "PIC_MERGE",
+	34: "PIC_RESTORE",
+	35: "BACKGROUND_JOB_READ",
+	36: "BACKGROUND_JOB_CANCEL",
+	37: "ROLE_UPDATE",
+	38: "PIC_READ_PRIVATE",
 }
 
 var User_Capability_value = map[string]int32{
@@ -326,6 +394,15 @@ var User_Capability_value = map[string]int32{
 	"USER_READ_PIC_VOTE":                27,
 	"PIC_COMMENT_VOTE_CREATE":           28,
 	"PIC_COMMENT_VOTE_EXTENSION_CREATE": 29,
+	"PIC_TAG_DELETE":                    30,
+	"TAG_ALIAS_UPDATE":                  31,
+	"TAG_IMPLICATION_UPDATE":            32,
+	"PIC_MERGE":                         33,
+	"PIC_RESTORE":                       34,
+	"BACKGROUND_JOB_READ":               35,
+	"BACKGROUND_JOB_CANCEL":             36,
+	"ROLE_UPDATE":                       37,
+	"PIC_READ_PRIVATE":                  38,
 }
 
 func (x User_Capability) String() string {
@@ -333,7 +410,147 @@ func (x User_Capability) String() string {
 }
 
 func (User_Capability) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{9, 0}
+	return fileDescriptor_962aa63430fd1f4b, []int{11, 0}
+}
+
+type Configuration_NearDuplicatePolicy_Action int32
+
+const (
+	Configuration_NearDuplicatePolicy_UNKNOWN Configuration_NearDuplicatePolicy_Action = 0
+	// near duplicates are uploaded as new pics.
+	Configuration_NearDuplicatePolicy_ALLOW Configuration_NearDuplicatePolicy_Action = 1
+	// near duplicates are rejected.
+	Configuration_NearDuplicatePolicy_REJECT Configuration_NearDuplicatePolicy_Action = 2
+	// near duplicates are added as a file source of the closest existing pic.
+	Configuration_NearDuplicatePolicy_MERGE Configuration_NearDuplicatePolicy_Action = 3
+	// near duplicates are uploaded as new pics, and flagged for moderator review.
+	Configuration_NearDuplicatePolicy_FLAG Configuration_NearDuplicatePolicy_Action = 4
+)
+
+var Configuration_NearDuplicatePolicy_Action_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ALLOW",
+	2: "REJECT",
+	3: "MERGE",
+	4: "FLAG",
+}
+
+var Configuration_NearDuplicatePolicy_Action_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ALLOW":   1,
+	"REJECT":  2,
+	"MERGE":   3,
+	"FLAG":    4,
+}
+
+func (x Configuration_NearDuplicatePolicy_Action) String() string {
+	return proto.EnumName(Configuration_NearDuplicatePolicy_Action_name, int32(x))
+}
+
+func (Configuration_NearDuplicatePolicy_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{17, 1, 0}
+}
+
+type BackgroundJob_Type int32
+
+const (
+	BackgroundJob_UNKNOWN BackgroundJob_Type = 0
+	// Regenerates the thumbnails of pic_id.
+	BackgroundJob_THUMBNAIL BackgroundJob_Type = 1
+	// Creates a pic from url, or adds url as a source of an existing pic.
+	BackgroundJob_FETCH_URL BackgroundJob_Type = 2
+	// Hard deletes pic_id.
+	BackgroundJob_HARD_DELETE_PIC BackgroundJob_Type = 3
+)
+
+var BackgroundJob_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "THUMBNAIL",
+	2: "FETCH_URL",
+	3: "HARD_DELETE_PIC",
+}
+
+var BackgroundJob_Type_value = map[string]int32{
+	"UNKNOWN":         0,
+	"THUMBNAIL":       1,
+	"FETCH_URL":       2,
+	"HARD_DELETE_PIC": 3,
+}
+
+func (x BackgroundJob_Type) String() string {
+	return proto.EnumName(BackgroundJob_Type_name, int32(x))
+}
+
+func (BackgroundJob_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{19, 0}
+}
+
+type BackgroundJob_Status_State int32
+
+const (
+	BackgroundJob_Status_UNKNOWN BackgroundJob_Status_State = 0
+	// Waiting to be run after next_run_ts.
+	BackgroundJob_Status_PENDING BackgroundJob_Status_State = 1
+	// Claimed by a worker until next_run_ts.  If the worker dies, the job is run again.
+	BackgroundJob_Status_RUNNING   BackgroundJob_Status_State = 2
+	BackgroundJob_Status_SUCCEEDED BackgroundJob_Status_State = 3
+	BackgroundJob_Status_FAILED    BackgroundJob_Status_State = 4
+	BackgroundJob_Status_CANCELLED BackgroundJob_Status_State = 5
+)
+
+var BackgroundJob_Status_State_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PENDING",
+	2: "RUNNING",
+	3: "SUCCEEDED",
+	4: "FAILED",
+	5: "CANCELLED",
+}
+
+var BackgroundJob_Status_State_value = map[string]int32{
+	"UNKNOWN":   0,
+	"PENDING":   1,
+	"RUNNING":   2,
+	"SUCCEEDED": 3,
+	"FAILED":    4,
+	"CANCELLED": 5,
+}
+
+func (x BackgroundJob_Status_State) String() string {
+	return proto.EnumName(BackgroundJob_Status_State_name, int32(x))
+}
+
+func (BackgroundJob_Status_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{19, 0, 0}
+}
+
+type BackgroundJob_Progress_Stage int32
+
+const (
+	BackgroundJob_Progress_UNKNOWN     BackgroundJob_Progress_Stage = 0
+	BackgroundJob_Progress_DOWNLOADING BackgroundJob_Progress_Stage = 1
+	// Decoding and thumbnailing the file, and creating the pic.
+	BackgroundJob_Progress_PROCESSING BackgroundJob_Progress_Stage = 2
+)
+
+var BackgroundJob_Progress_Stage_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DOWNLOADING",
+	2: "PROCESSING",
+}
+
+var BackgroundJob_Progress_Stage_value = map[string]int32{
+	"UNKNOWN":     0,
+	"DOWNLOADING": 1,
+	"PROCESSING":  2,
+}
+
+func (x BackgroundJob_Progress_Stage) String() string {
+	return proto.EnumName(BackgroundJob_Progress_Stage_name, int32(x))
+}
+
+func (BackgroundJob_Progress_Stage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{19, 1, 0}
 }
 
 type Pic struct {
@@ -356,10 +573,17 @@ type Pic struct {
 	// represents thumbnails for this pic
 	Thumbnail []*Pic_File `protobuf:"bytes,21,rep,name=thumbnail,proto3" json:"thumbnail,omitempty"`
 	// alternate but equivalent forms of this file.
-	Derived              []*Pic_File `protobuf:"bytes,23,rep,name=derived,proto3" json:"derived,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Derived []*Pic_File `protobuf:"bytes,23,rep,name=derived,proto3" json:"derived,omitempty"`
+	// If present, the pic was uploaded while perceptually similar to other pics,
+	// and should be reviewed by a moderator.
+	DuplicateReview *Pic_DuplicateReview `protobuf:"bytes,24,opt,name=duplicate_review,json=duplicateReview,proto3" json:"duplicate_review,omitempty"`
+	// Every time this pic was restored after being soft deleted, oldest first.
+	Restoration []*Pic_Restoration `protobuf:"bytes,25,rep,name=restoration,proto3" json:"restoration,omitempty"`
+	// Who can see this pic.  If absent, the pic is public.
+	Visibility           *Pic_Visibility `protobuf:"bytes,26,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Pic) Reset()         { *m = Pic{} }
@@ -471,6 +695,27 @@ func (m *Pic) GetDerived() []*Pic_File {
 	return nil
 }
 
+func (m *Pic) GetDuplicateReview() *Pic_DuplicateReview {
+	if m != nil {
+		return m.DuplicateReview
+	}
+	return nil
+}
+
+func (m *Pic) GetRestoration() []*Pic_Restoration {
+	if m != nil {
+		return m.Restoration
+	}
+	return nil
+}
+
+func (m *Pic) GetVisibility() *Pic_Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return nil
+}
+
 type Pic_DeletionStatus struct {
 	// Represents when this Pic was marked for deletion
 	MarkedDeletedTs *timestamp.Timestamp `protobuf:"bytes,1,opt,name=marked_deleted_ts,json=markedDeletedTs,proto3" json:"marked_deleted_ts,omitempty"`
@@ -486,7 +731,10 @@ type Pic_DeletionStatus struct {
 	Reason Pic_DeletionStatus_Reason `protobuf:"varint,5,opt,name=reason,proto3,enum=pixur.be.schema.Pic_DeletionStatus_Reason" json:"reason,omitempty"`
 	// Determines if this pic can be undeleted if re uploaded.  Currently the
 	// only reason is due to disk space concerns.
-	Temporary            bool     `protobuf:"varint,6,opt,name=temporary,proto3" json:"temporary,omitempty"`
+	Temporary bool `protobuf:"varint,6,opt,name=temporary,proto3" json:"temporary,omitempty"`
+	// The pic this pic was merged into.  Only present if the reason is
+	// DUPLICATE.
+	DuplicateOfPicId     int64    `protobuf:"varint,7,opt,name=duplicate_of_pic_id,json=duplicateOfPicId,proto3" json:"duplicate_of_pic_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -559,6 +807,13 @@ func (m *Pic_DeletionStatus) GetTemporary() bool {
 	return false
 }
 
+func (m *Pic_DeletionStatus) GetDuplicateOfPicId() int64 {
+	if m != nil {
+		return m.DuplicateOfPicId
+	}
+	return 0
+}
+
 type Pic_FileSource struct {
 	// url is optional and is the location the pic came from.
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -733,6 +988,177 @@ func (m *Pic_File) GetAnimationInfo() *AnimationInfo {
 	return nil
 }
 
+type Pic_DuplicateReview struct {
+	// the pics this pic may be a duplicate of.
+	SuspectedPicId       []int64              `protobuf:"varint,1,rep,packed,name=suspected_pic_id,json=suspectedPicId,proto3" json:"suspected_pic_id,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Pic_DuplicateReview) Reset()         { *m = Pic_DuplicateReview{} }
+func (m *Pic_DuplicateReview) String() string { return proto.CompactTextString(m) }
+func (*Pic_DuplicateReview) ProtoMessage()    {}
+func (*Pic_DuplicateReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{0, 4}
+}
+
+func (m *Pic_DuplicateReview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pic_DuplicateReview.Unmarshal(m, b)
+}
+func (m *Pic_DuplicateReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pic_DuplicateReview.Marshal(b, m, deterministic)
+}
+func (m *Pic_DuplicateReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pic_DuplicateReview.Merge(m, src)
+}
+func (m *Pic_DuplicateReview) XXX_Size() int {
+	return xxx_messageInfo_Pic_DuplicateReview.Size(m)
+}
+func (m *Pic_DuplicateReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pic_DuplicateReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pic_DuplicateReview proto.InternalMessageInfo
+
+func (m *Pic_DuplicateReview) GetSuspectedPicId() []int64 {
+	if m != nil {
+		return m.SuspectedPicId
+	}
+	return nil
+}
+
+func (m *Pic_DuplicateReview) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+type Pic_Restoration struct {
+	// the user who restored the pic.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// why the pic was restored.
+	Details   string               `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	CreatedTs *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// the deletion status before the pic was restored.
+	PreviousDeletionStatus *Pic_DeletionStatus `protobuf:"bytes,4,opt,name=previous_deletion_status,json=previousDeletionStatus,proto3" json:"previous_deletion_status,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}            `json:"-"`
+	XXX_unrecognized       []byte              `json:"-"`
+	XXX_sizecache          int32               `json:"-"`
+}
+
+func (m *Pic_Restoration) Reset()         { *m = Pic_Restoration{} }
+func (m *Pic_Restoration) String() string { return proto.CompactTextString(m) }
+func (*Pic_Restoration) ProtoMessage()    {}
+func (*Pic_Restoration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{0, 5}
+}
+
+func (m *Pic_Restoration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pic_Restoration.Unmarshal(m, b)
+}
+func (m *Pic_Restoration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pic_Restoration.Marshal(b, m, deterministic)
+}
+func (m *Pic_Restoration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pic_Restoration.Merge(m, src)
+}
+func (m *Pic_Restoration) XXX_Size() int {
+	return xxx_messageInfo_Pic_Restoration.Size(m)
+}
+func (m *Pic_Restoration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pic_Restoration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pic_Restoration proto.InternalMessageInfo
+
+func (m *Pic_Restoration) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *Pic_Restoration) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *Pic_Restoration) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *Pic_Restoration) GetPreviousDeletionStatus() *Pic_DeletionStatus {
+	if m != nil {
+		return m.PreviousDeletionStatus
+	}
+	return nil
+}
+
+type Pic_Visibility struct {
+	Mode Pic_Visibility_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=pixur.be.schema.Pic_Visibility_Mode" json:"mode,omitempty"`
+	// users other than the uploader who can see a private pic.
+	UserId []int64 `protobuf:"varint,2,rep,packed,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// users in any of these roles can see a private pic.
+	RoleId               []int64  `protobuf:"varint,3,rep,packed,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Pic_Visibility) Reset()         { *m = Pic_Visibility{} }
+func (m *Pic_Visibility) String() string { return proto.CompactTextString(m) }
+func (*Pic_Visibility) ProtoMessage()    {}
+func (*Pic_Visibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{0, 6}
+}
+
+func (m *Pic_Visibility) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pic_Visibility.Unmarshal(m, b)
+}
+func (m *Pic_Visibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pic_Visibility.Marshal(b, m, deterministic)
+}
+func (m *Pic_Visibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pic_Visibility.Merge(m, src)
+}
+func (m *Pic_Visibility) XXX_Size() int {
+	return xxx_messageInfo_Pic_Visibility.Size(m)
+}
+func (m *Pic_Visibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pic_Visibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pic_Visibility proto.InternalMessageInfo
+
+func (m *Pic_Visibility) GetMode() Pic_Visibility_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return Pic_Visibility_PUBLIC
+}
+
+func (m *Pic_Visibility) GetUserId() []int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
+}
+
+func (m *Pic_Visibility) GetRoleId() []int64 {
+	if m != nil {
+		return m.RoleId
+	}
+	return nil
+}
+
 // A picture identifier
 type PicIdent struct {
 	PicId int64         `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
@@ -928,101 +1354,249 @@ func (m *Tag) GetExt() map[string]*any.Any {
 	return nil
 }
 
-type PicTag struct {
-	PicId int64  `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	TagId int64  `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// The user who originally created this tag.  optional.
-	UserId     int64                `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,7,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
-	// Extra information that may not fit into the schema
-	Ext                  map[string]*any.Any `protobuf:"bytes,9,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// TagAlias redirects a tag name to a canonical tag.
+type TagAlias struct {
+	// name is the alias.  Pics are never tagged with it directly.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// tag_id is the canonical tag that the alias redirects to.
+	TagId int64 `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	// The user who created this alias.  optional.
+	UserId               int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *PicTag) Reset()         { *m = PicTag{} }
-func (m *PicTag) String() string { return proto.CompactTextString(m) }
-func (*PicTag) ProtoMessage()    {}
-func (*PicTag) Descriptor() ([]byte, []int) {
+func (m *TagAlias) Reset()         { *m = TagAlias{} }
+func (m *TagAlias) String() string { return proto.CompactTextString(m) }
+func (*TagAlias) ProtoMessage()    {}
+func (*TagAlias) Descriptor() ([]byte, []int) {
 	return fileDescriptor_962aa63430fd1f4b, []int{4}
 }
 
-func (m *PicTag) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PicTag.Unmarshal(m, b)
+func (m *TagAlias) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagAlias.Unmarshal(m, b)
 }
-func (m *PicTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PicTag.Marshal(b, m, deterministic)
+func (m *TagAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagAlias.Marshal(b, m, deterministic)
 }
-func (m *PicTag) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PicTag.Merge(m, src)
+func (m *TagAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagAlias.Merge(m, src)
 }
-func (m *PicTag) XXX_Size() int {
-	return xxx_messageInfo_PicTag.Size(m)
+func (m *TagAlias) XXX_Size() int {
+	return xxx_messageInfo_TagAlias.Size(m)
 }
-func (m *PicTag) XXX_DiscardUnknown() {
-	xxx_messageInfo_PicTag.DiscardUnknown(m)
+func (m *TagAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagAlias.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PicTag proto.InternalMessageInfo
+var xxx_messageInfo_TagAlias proto.InternalMessageInfo
 
-func (m *PicTag) GetPicId() int64 {
+func (m *TagAlias) GetName() string {
 	if m != nil {
-		return m.PicId
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *PicTag) GetTagId() int64 {
+func (m *TagAlias) GetTagId() int64 {
 	if m != nil {
 		return m.TagId
 	}
 	return 0
 }
 
-func (m *PicTag) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *PicTag) GetUserId() int64 {
+func (m *TagAlias) GetUserId() int64 {
 	if m != nil {
 		return m.UserId
 	}
 	return 0
 }
 
-func (m *PicTag) GetCreatedTs() *timestamp.Timestamp {
+func (m *TagAlias) GetCreatedTs() *timestamp.Timestamp {
 	if m != nil {
 		return m.CreatedTs
 	}
 	return nil
 }
 
-func (m *PicTag) GetModifiedTs() *timestamp.Timestamp {
+func (m *TagAlias) GetModifiedTs() *timestamp.Timestamp {
 	if m != nil {
 		return m.ModifiedTs
 	}
 	return nil
 }
 
-func (m *PicTag) GetExt() map[string]*any.Any {
+// TagImplication causes pics tagged with tag_id to also be tagged with implied_tag_id.
+type TagImplication struct {
+	TagId        int64 `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	ImpliedTagId int64 `protobuf:"varint,2,opt,name=implied_tag_id,json=impliedTagId,proto3" json:"implied_tag_id,omitempty"`
+	// The user who created this implication.  optional.
+	UserId               int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TagImplication) Reset()         { *m = TagImplication{} }
+func (m *TagImplication) String() string { return proto.CompactTextString(m) }
+func (*TagImplication) ProtoMessage()    {}
+func (*TagImplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{5}
+}
+
+func (m *TagImplication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagImplication.Unmarshal(m, b)
+}
+func (m *TagImplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagImplication.Marshal(b, m, deterministic)
+}
+func (m *TagImplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagImplication.Merge(m, src)
+}
+func (m *TagImplication) XXX_Size() int {
+	return xxx_messageInfo_TagImplication.Size(m)
+}
+func (m *TagImplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagImplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagImplication proto.InternalMessageInfo
+
+func (m *TagImplication) GetTagId() int64 {
 	if m != nil {
-		return m.Ext
+		return m.TagId
 	}
-	return nil
+	return 0
 }
 
-type PicComment struct {
-	PicId     int64 `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
-	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	// parent id of this comment.  0 if root.
-	CommentParentId int64 `protobuf:"varint,3,opt,name=comment_parent_id,json=commentParentId,proto3" json:"comment_parent_id,omitempty"`
-	// author
+func (m *TagImplication) GetImpliedTagId() int64 {
+	if m != nil {
+		return m.ImpliedTagId
+	}
+	return 0
+}
+
+func (m *TagImplication) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *TagImplication) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *TagImplication) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+type PicTag struct {
+	PicId int64  `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	TagId int64  `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The user who originally created this tag.  optional.
+	UserId     int64                `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,7,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	// Extra information that may not fit into the schema
+	Ext                  map[string]*any.Any `protobuf:"bytes,9,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PicTag) Reset()         { *m = PicTag{} }
+func (m *PicTag) String() string { return proto.CompactTextString(m) }
+func (*PicTag) ProtoMessage()    {}
+func (*PicTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{6}
+}
+
+func (m *PicTag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PicTag.Unmarshal(m, b)
+}
+func (m *PicTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PicTag.Marshal(b, m, deterministic)
+}
+func (m *PicTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PicTag.Merge(m, src)
+}
+func (m *PicTag) XXX_Size() int {
+	return xxx_messageInfo_PicTag.Size(m)
+}
+func (m *PicTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_PicTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PicTag proto.InternalMessageInfo
+
+func (m *PicTag) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+func (m *PicTag) GetTagId() int64 {
+	if m != nil {
+		return m.TagId
+	}
+	return 0
+}
+
+func (m *PicTag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PicTag) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *PicTag) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *PicTag) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+func (m *PicTag) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+type PicComment struct {
+	PicId     int64 `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// parent id of this comment.  0 if root.
+	CommentParentId int64 `protobuf:"varint,3,opt,name=comment_parent_id,json=commentParentId,proto3" json:"comment_parent_id,omitempty"`
+	// author
 	UserId int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Text   string `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
 	// The denormalized sum of upvotes for this comment
@@ -1042,7 +1616,7 @@ func (m *PicComment) Reset()         { *m = PicComment{} }
 func (m *PicComment) String() string { return proto.CompactTextString(m) }
 func (*PicComment) ProtoMessage()    {}
 func (*PicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{5}
+	return fileDescriptor_962aa63430fd1f4b, []int{7}
 }
 
 func (m *PicComment) XXX_Unmarshal(b []byte) error {
@@ -1153,7 +1727,7 @@ func (m *PicVote) Reset()         { *m = PicVote{} }
 func (m *PicVote) String() string { return proto.CompactTextString(m) }
 func (*PicVote) ProtoMessage()    {}
 func (*PicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{6}
+	return fileDescriptor_962aa63430fd1f4b, []int{8}
 }
 
 func (m *PicVote) XXX_Unmarshal(b []byte) error {
@@ -1244,7 +1818,7 @@ func (m *PicCommentVote) Reset()         { *m = PicCommentVote{} }
 func (m *PicCommentVote) String() string { return proto.CompactTextString(m) }
 func (*PicCommentVote) ProtoMessage()    {}
 func (*PicCommentVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{7}
+	return fileDescriptor_962aa63430fd1f4b, []int{9}
 }
 
 func (m *PicCommentVote) XXX_Unmarshal(b []byte) error {
@@ -1334,6 +1908,9 @@ type UserEvent struct {
 	//	*UserEvent_OutgoingPicComment_
 	//	*UserEvent_IncomingPicComment_
 	//	*UserEvent_UpsertPic_
+	//	*UserEvent_RemovePicTags_
+	//	*UserEvent_UpdateRole_
+	//	*UserEvent_UpdateUserRoles_
 	Evt                  isUserEvent_Evt `protobuf_oneof:"evt"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
@@ -1344,7 +1921,7 @@ func (m *UserEvent) Reset()         { *m = UserEvent{} }
 func (m *UserEvent) String() string { return proto.CompactTextString(m) }
 func (*UserEvent) ProtoMessage()    {}
 func (*UserEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8}
+	return fileDescriptor_962aa63430fd1f4b, []int{10}
 }
 
 func (m *UserEvent) XXX_Unmarshal(b []byte) error {
@@ -1417,6 +1994,18 @@ type UserEvent_UpsertPic_ struct {
 	UpsertPic *UserEvent_UpsertPic `protobuf:"bytes,9,opt,name=upsert_pic,json=upsertPic,proto3,oneof"`
 }
 
+type UserEvent_RemovePicTags_ struct {
+	RemovePicTags *UserEvent_RemovePicTags `protobuf:"bytes,10,opt,name=remove_pic_tags,json=removePicTags,proto3,oneof"`
+}
+
+type UserEvent_UpdateRole_ struct {
+	UpdateRole *UserEvent_UpdateRole `protobuf:"bytes,11,opt,name=update_role,json=updateRole,proto3,oneof"`
+}
+
+type UserEvent_UpdateUserRoles_ struct {
+	UpdateUserRoles *UserEvent_UpdateUserRoles `protobuf:"bytes,12,opt,name=update_user_roles,json=updateUserRoles,proto3,oneof"`
+}
+
 func (*UserEvent_OutgoingUpsertPicVote_) isUserEvent_Evt() {}
 
 func (*UserEvent_IncomingUpsertPicVote_) isUserEvent_Evt() {}
@@ -1427,6 +2016,12 @@ func (*UserEvent_IncomingPicComment_) isUserEvent_Evt() {}
 
 func (*UserEvent_UpsertPic_) isUserEvent_Evt() {}
 
+func (*UserEvent_RemovePicTags_) isUserEvent_Evt() {}
+
+func (*UserEvent_UpdateRole_) isUserEvent_Evt() {}
+
+func (*UserEvent_UpdateUserRoles_) isUserEvent_Evt() {}
+
 func (m *UserEvent) GetEvt() isUserEvent_Evt {
 	if m != nil {
 		return m.Evt
@@ -1469,6 +2064,27 @@ func (m *UserEvent) GetUpsertPic() *UserEvent_UpsertPic {
 	return nil
 }
 
+func (m *UserEvent) GetRemovePicTags() *UserEvent_RemovePicTags {
+	if x, ok := m.GetEvt().(*UserEvent_RemovePicTags_); ok {
+		return x.RemovePicTags
+	}
+	return nil
+}
+
+func (m *UserEvent) GetUpdateRole() *UserEvent_UpdateRole {
+	if x, ok := m.GetEvt().(*UserEvent_UpdateRole_); ok {
+		return x.UpdateRole
+	}
+	return nil
+}
+
+func (m *UserEvent) GetUpdateUserRoles() *UserEvent_UpdateUserRoles {
+	if x, ok := m.GetEvt().(*UserEvent_UpdateUserRoles_); ok {
+		return x.UpdateUserRoles
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*UserEvent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1477,6 +2093,9 @@ func (*UserEvent) XXX_OneofWrappers() []interface{} {
 		(*UserEvent_OutgoingPicComment_)(nil),
 		(*UserEvent_IncomingPicComment_)(nil),
 		(*UserEvent_UpsertPic_)(nil),
+		(*UserEvent_RemovePicTags_)(nil),
+		(*UserEvent_UpdateRole_)(nil),
+		(*UserEvent_UpdateUserRoles_)(nil),
 	}
 }
 
@@ -1493,7 +2112,7 @@ func (m *UserEvent_OutgoingUpsertPicVote) Reset()         { *m = UserEvent_Outgo
 func (m *UserEvent_OutgoingUpsertPicVote) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_OutgoingUpsertPicVote) ProtoMessage()    {}
 func (*UserEvent_OutgoingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 0}
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 0}
 }
 
 func (m *UserEvent_OutgoingUpsertPicVote) XXX_Unmarshal(b []byte) error {
@@ -1536,7 +2155,7 @@ func (m *UserEvent_IncomingUpsertPicVote) Reset()         { *m = UserEvent_Incom
 func (m *UserEvent_IncomingUpsertPicVote) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_IncomingUpsertPicVote) ProtoMessage()    {}
 func (*UserEvent_IncomingUpsertPicVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 1}
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 1}
 }
 
 func (m *UserEvent_IncomingUpsertPicVote) XXX_Unmarshal(b []byte) error {
@@ -1586,7 +2205,7 @@ func (m *UserEvent_OutgoingPicComment) Reset()         { *m = UserEvent_Outgoing
 func (m *UserEvent_OutgoingPicComment) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_OutgoingPicComment) ProtoMessage()    {}
 func (*UserEvent_OutgoingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 2}
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 2}
 }
 
 func (m *UserEvent_OutgoingPicComment) XXX_Unmarshal(b []byte) error {
@@ -1636,7 +2255,7 @@ func (m *UserEvent_IncomingPicComment) Reset()         { *m = UserEvent_Incoming
 func (m *UserEvent_IncomingPicComment) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_IncomingPicComment) ProtoMessage()    {}
 func (*UserEvent_IncomingPicComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 3}
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 3}
 }
 
 func (m *UserEvent_IncomingPicComment) XXX_Unmarshal(b []byte) error {
@@ -1682,7 +2301,7 @@ func (m *UserEvent_UpsertPic) Reset()         { *m = UserEvent_UpsertPic{} }
 func (m *UserEvent_UpsertPic) String() string { return proto.CompactTextString(m) }
 func (*UserEvent_UpsertPic) ProtoMessage()    {}
 func (*UserEvent_UpsertPic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{8, 4}
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 4}
 }
 
 func (m *UserEvent_UpsertPic) XXX_Unmarshal(b []byte) error {
@@ -1710,6 +2329,186 @@ func (m *UserEvent_UpsertPic) GetPicId() int64 {
 	return 0
 }
 
+// RemovePicTags represents removing tags from a pic.
+type UserEvent_RemovePicTags struct {
+	PicId int64 `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	// tag_name is the name of each removed tag, at the time it was removed.
+	TagName              []string `protobuf:"bytes,2,rep,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_RemovePicTags) Reset()         { *m = UserEvent_RemovePicTags{} }
+func (m *UserEvent_RemovePicTags) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_RemovePicTags) ProtoMessage()    {}
+func (*UserEvent_RemovePicTags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 5}
+}
+
+func (m *UserEvent_RemovePicTags) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_RemovePicTags.Unmarshal(m, b)
+}
+func (m *UserEvent_RemovePicTags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_RemovePicTags.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_RemovePicTags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_RemovePicTags.Merge(m, src)
+}
+func (m *UserEvent_RemovePicTags) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_RemovePicTags.Size(m)
+}
+func (m *UserEvent_RemovePicTags) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_RemovePicTags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_RemovePicTags proto.InternalMessageInfo
+
+func (m *UserEvent_RemovePicTags) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+func (m *UserEvent_RemovePicTags) GetTagName() []string {
+	if m != nil {
+		return m.TagName
+	}
+	return nil
+}
+
+// UpdateRole represents creating or changing a role.
+type UserEvent_UpdateRole struct {
+	RoleId int64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// name is the name of the role after the change.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// created is true if the role was newly created.
+	Created              bool              `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	SetCapability        []User_Capability `protobuf:"varint,4,rep,packed,name=set_capability,json=setCapability,proto3,enum=pixur.be.schema.User_Capability" json:"set_capability,omitempty"`
+	ClearCapability      []User_Capability `protobuf:"varint,5,rep,packed,name=clear_capability,json=clearCapability,proto3,enum=pixur.be.schema.User_Capability" json:"clear_capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UserEvent_UpdateRole) Reset()         { *m = UserEvent_UpdateRole{} }
+func (m *UserEvent_UpdateRole) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_UpdateRole) ProtoMessage()    {}
+func (*UserEvent_UpdateRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 6}
+}
+
+func (m *UserEvent_UpdateRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_UpdateRole.Unmarshal(m, b)
+}
+func (m *UserEvent_UpdateRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_UpdateRole.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_UpdateRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_UpdateRole.Merge(m, src)
+}
+func (m *UserEvent_UpdateRole) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_UpdateRole.Size(m)
+}
+func (m *UserEvent_UpdateRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_UpdateRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_UpdateRole proto.InternalMessageInfo
+
+func (m *UserEvent_UpdateRole) GetRoleId() int64 {
+	if m != nil {
+		return m.RoleId
+	}
+	return 0
+}
+
+func (m *UserEvent_UpdateRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserEvent_UpdateRole) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *UserEvent_UpdateRole) GetSetCapability() []User_Capability {
+	if m != nil {
+		return m.SetCapability
+	}
+	return nil
+}
+
+func (m *UserEvent_UpdateRole) GetClearCapability() []User_Capability {
+	if m != nil {
+		return m.ClearCapability
+	}
+	return nil
+}
+
+// UpdateUserRoles represents adding or removing roles of a user.
+type UserEvent_UpdateUserRoles struct {
+	// The user whose roles were changed.
+	ObjectUserId         int64    `protobuf:"varint,1,opt,name=object_user_id,json=objectUserId,proto3" json:"object_user_id,omitempty"`
+	SetRoleId            []int64  `protobuf:"varint,2,rep,packed,name=set_role_id,json=setRoleId,proto3" json:"set_role_id,omitempty"`
+	ClearRoleId          []int64  `protobuf:"varint,3,rep,packed,name=clear_role_id,json=clearRoleId,proto3" json:"clear_role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserEvent_UpdateUserRoles) Reset()         { *m = UserEvent_UpdateUserRoles{} }
+func (m *UserEvent_UpdateUserRoles) String() string { return proto.CompactTextString(m) }
+func (*UserEvent_UpdateUserRoles) ProtoMessage()    {}
+func (*UserEvent_UpdateUserRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{10, 7}
+}
+
+func (m *UserEvent_UpdateUserRoles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserEvent_UpdateUserRoles.Unmarshal(m, b)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserEvent_UpdateUserRoles.Marshal(b, m, deterministic)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserEvent_UpdateUserRoles.Merge(m, src)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_Size() int {
+	return xxx_messageInfo_UserEvent_UpdateUserRoles.Size(m)
+}
+func (m *UserEvent_UpdateUserRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserEvent_UpdateUserRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserEvent_UpdateUserRoles proto.InternalMessageInfo
+
+func (m *UserEvent_UpdateUserRoles) GetObjectUserId() int64 {
+	if m != nil {
+		return m.ObjectUserId
+	}
+	return 0
+}
+
+func (m *UserEvent_UpdateUserRoles) GetSetRoleId() []int64 {
+	if m != nil {
+		return m.SetRoleId
+	}
+	return nil
+}
+
+func (m *UserEvent_UpdateUserRoles) GetClearRoleId() []int64 {
+	if m != nil {
+		return m.ClearRoleId
+	}
+	return nil
+}
+
 type User struct {
 	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Hashed secret token
@@ -1720,9 +2519,19 @@ type User struct {
 	ModifiedTs *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
 	LastSeenTs *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
 	Capability []User_Capability    `protobuf:"varint,7,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
+	// The roles this user is a member of.  The capabilities of each role are
+	// added to the user's own when checking permissions.
+	RoleId []int64 `protobuf:"varint,11,rep,packed,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
 	// Always increment-then-get
 	NextTokenId int64        `protobuf:"varint,8,opt,name=next_token_id,json=nextTokenId,proto3" json:"next_token_id,omitempty"`
 	UserToken   []*UserToken `protobuf:"bytes,9,rep,name=user_token,json=userToken,proto3" json:"user_token,omitempty"`
+	// Always increment-then-get
+	NextApiKeyId int64 `protobuf:"varint,12,opt,name=next_api_key_id,json=nextApiKeyId,proto3" json:"next_api_key_id,omitempty"`
+	// Long lived keys for scripts.  These are kept apart from user_token so that
+	// revoking one doesn't end any login sessions.
+	ApiKey []*UserApiKey `protobuf:"bytes,13,rep,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Two-factor authentication settings.  Unset if the user never enrolled.
+	Totp *UserTotp `protobuf:"bytes,14,opt,name=totp,proto3" json:"totp,omitempty"`
 	// Extra information that may not fit into the schema
 	Ext                  map[string]*any.Any `protobuf:"bytes,10,rep,name=ext,proto3" json:"ext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -1734,7 +2543,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{9}
+	return fileDescriptor_962aa63430fd1f4b, []int{11}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -1804,6 +2613,13 @@ func (m *User) GetCapability() []User_Capability {
 	return nil
 }
 
+func (m *User) GetRoleId() []int64 {
+	if m != nil {
+		return m.RoleId
+	}
+	return nil
+}
+
 func (m *User) GetNextTokenId() int64 {
 	if m != nil {
 		return m.NextTokenId
@@ -1818,29 +2634,193 @@ func (m *User) GetUserToken() []*UserToken {
 	return nil
 }
 
-func (m *User) GetExt() map[string]*any.Any {
+func (m *User) GetNextApiKeyId() int64 {
 	if m != nil {
-		return m.Ext
+		return m.NextApiKeyId
 	}
-	return nil
+	return 0
 }
 
-// Represent the valid auth tokens.  When a user logs out, these will be
-// deleted.
-type UserToken struct {
-	TokenId              int64                `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	LastSeenTs           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
+func (m *User) GetApiKey() []*UserApiKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *User) GetTotp() *UserTotp {
+	if m != nil {
+		return m.Totp
+	}
+	return nil
+}
+
+func (m *User) GetExt() map[string]*any.Any {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
+}
+
+// Role is a named group of capabilities that can be given to users.
+type Role struct {
+	RoleId int64 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// The display name of the role.  Unique after normalization.
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capability           []User_Capability    `protobuf:"varint,3,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{12}
+}
+
+func (m *Role) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Role.Unmarshal(m, b)
+}
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+}
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
+}
+func (m *Role) XXX_Size() int {
+	return xxx_messageInfo_Role.Size(m)
+}
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Role proto.InternalMessageInfo
+
+func (m *Role) GetRoleId() int64 {
+	if m != nil {
+		return m.RoleId
+	}
+	return 0
+}
+
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Role) GetCapability() []User_Capability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+func (m *Role) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *Role) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+// Links a user to an account at an external OpenID Connect provider.  The
+// issuer and subject together identify the external account.
+type UserIdentity struct {
+	// The issuer url, as found in the "iss" claim of the ID token.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The "sub" claim of the ID token.  Stable and unique within the issuer.
+	Subject              string               `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	UserId               int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedTs            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
+func (m *UserIdentity) Reset()         { *m = UserIdentity{} }
+func (m *UserIdentity) String() string { return proto.CompactTextString(m) }
+func (*UserIdentity) ProtoMessage()    {}
+func (*UserIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{13}
+}
+
+func (m *UserIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserIdentity.Unmarshal(m, b)
+}
+func (m *UserIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserIdentity.Marshal(b, m, deterministic)
+}
+func (m *UserIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserIdentity.Merge(m, src)
+}
+func (m *UserIdentity) XXX_Size() int {
+	return xxx_messageInfo_UserIdentity.Size(m)
+}
+func (m *UserIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserIdentity proto.InternalMessageInfo
+
+func (m *UserIdentity) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *UserIdentity) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *UserIdentity) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UserIdentity) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+// Represent the valid auth tokens.  When a user logs out, these will be
+// deleted.
+type UserToken struct {
+	TokenId    int64                `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	CreatedTs  *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	LastSeenTs *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
+	// The client the token was issued to, as reported by the frontend.
+	UserAgent            string   `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RemoteAddress        string   `protobuf:"bytes,5,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
 func (m *UserToken) Reset()         { *m = UserToken{} }
 func (m *UserToken) String() string { return proto.CompactTextString(m) }
 func (*UserToken) ProtoMessage()    {}
 func (*UserToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{10}
+	return fileDescriptor_962aa63430fd1f4b, []int{14}
 }
 
 func (m *UserToken) XXX_Unmarshal(b []byte) error {
@@ -1882,6 +2862,194 @@ func (m *UserToken) GetLastSeenTs() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *UserToken) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *UserToken) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+type UserApiKey struct {
+	KeyId int64 `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// A note from the user about what the key is for.
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedTs *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// When the key stops working.  If unset, the key doesn't expire.
+	ExpireTs *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expire_ts,json=expireTs,proto3" json:"expire_ts,omitempty"`
+	// The capabilities the key is limited to.  Requests made with the key have
+	// only the capabilities both the key and the user have.
+	Capability []User_Capability `protobuf:"varint,5,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
+	// CIDR ranges, such as "10.0.0.0/8", the key may be used from.  If empty,
+	// the key may be used from anywhere.
+	AllowedRemoteRange   []string `protobuf:"bytes,6,rep,name=allowed_remote_range,json=allowedRemoteRange,proto3" json:"allowed_remote_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserApiKey) Reset()         { *m = UserApiKey{} }
+func (m *UserApiKey) String() string { return proto.CompactTextString(m) }
+func (*UserApiKey) ProtoMessage()    {}
+func (*UserApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{15}
+}
+
+func (m *UserApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserApiKey.Unmarshal(m, b)
+}
+func (m *UserApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserApiKey.Marshal(b, m, deterministic)
+}
+func (m *UserApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserApiKey.Merge(m, src)
+}
+func (m *UserApiKey) XXX_Size() int {
+	return xxx_messageInfo_UserApiKey.Size(m)
+}
+func (m *UserApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserApiKey proto.InternalMessageInfo
+
+func (m *UserApiKey) GetKeyId() int64 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *UserApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserApiKey) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *UserApiKey) GetExpireTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTs
+	}
+	return nil
+}
+
+func (m *UserApiKey) GetCapability() []User_Capability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+func (m *UserApiKey) GetAllowedRemoteRange() []string {
+	if m != nil {
+		return m.AllowedRemoteRange
+	}
+	return nil
+}
+
+type UserTotp struct {
+	// The shared secret for RFC 6238 codes.
+	Secret []byte `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Set once the user has confirmed a code from the secret.  Until then, the
+	// secret isn't needed to log in.
+	EnabledTs *timestamp.Timestamp `protobuf:"bytes,2,opt,name=enabled_ts,json=enabledTs,proto3" json:"enabled_ts,omitempty"`
+	// The time step of the last accepted code, so codes can't be reused.
+	LastUsedStep int64 `protobuf:"varint,3,opt,name=last_used_step,json=lastUsedStep,proto3" json:"last_used_step,omitempty"`
+	// SHA-256 hashes of the unused recovery codes.  Each may be used once in
+	// place of a code.
+	RecoveryCodeHash [][]byte `protobuf:"bytes,4,rep,name=recovery_code_hash,json=recoveryCodeHash,proto3" json:"recovery_code_hash,omitempty"`
+	// The number of bad codes given in a row when logging in.  Reset by a good
+	// code.
+	FailedAttempts int64 `protobuf:"varint,5,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	// The time of the last bad code.  After too many, logging in is refused
+	// until a while after this.
+	LastFailedTs         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_failed_ts,json=lastFailedTs,proto3" json:"last_failed_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserTotp) Reset()         { *m = UserTotp{} }
+func (m *UserTotp) String() string { return proto.CompactTextString(m) }
+func (*UserTotp) ProtoMessage()    {}
+func (*UserTotp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{16}
+}
+
+func (m *UserTotp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserTotp.Unmarshal(m, b)
+}
+func (m *UserTotp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserTotp.Marshal(b, m, deterministic)
+}
+func (m *UserTotp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserTotp.Merge(m, src)
+}
+func (m *UserTotp) XXX_Size() int {
+	return xxx_messageInfo_UserTotp.Size(m)
+}
+func (m *UserTotp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserTotp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserTotp proto.InternalMessageInfo
+
+func (m *UserTotp) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *UserTotp) GetEnabledTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.EnabledTs
+	}
+	return nil
+}
+
+func (m *UserTotp) GetLastUsedStep() int64 {
+	if m != nil {
+		return m.LastUsedStep
+	}
+	return 0
+}
+
+func (m *UserTotp) GetRecoveryCodeHash() [][]byte {
+	if m != nil {
+		return m.RecoveryCodeHash
+	}
+	return nil
+}
+
+func (m *UserTotp) GetFailedAttempts() int64 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *UserTotp) GetLastFailedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastFailedTs
+	}
+	return nil
+}
+
 type Configuration struct {
 	// the minimum comment length in bytes.
 	MinCommentLength *wrappers.Int64Value `protobuf:"bytes,1,opt,name=min_comment_length,json=minCommentLength,proto3" json:"min_comment_length,omitempty"`
@@ -1920,17 +3088,31 @@ type Configuration struct {
 	// the default number of user events to return
 	DefaultFindUserEvents *wrappers.Int64Value `protobuf:"bytes,18,opt,name=default_find_user_events,json=defaultFindUserEvents,proto3" json:"default_find_user_events,omitempty"`
 	// the max number of user events to return
-	MaxFindUserEvents    *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	MaxFindUserEvents *wrappers.Int64Value `protobuf:"bytes,19,opt,name=max_find_user_events,json=maxFindUserEvents,proto3" json:"max_find_user_events,omitempty"`
+	// the default number of tags to return
+	DefaultFindTags *wrappers.Int64Value `protobuf:"bytes,20,opt,name=default_find_tags,json=defaultFindTags,proto3" json:"default_find_tags,omitempty"`
+	// the max number of tags to return
+	MaxFindTags *wrappers.Int64Value `protobuf:"bytes,21,opt,name=max_find_tags,json=maxFindTags,proto3" json:"max_find_tags,omitempty"`
+	// how uploads that are perceptually similar to an existing pic are handled.
+	NearDuplicatePolicy *Configuration_NearDuplicatePolicy `protobuf:"bytes,22,opt,name=near_duplicate_policy,json=nearDuplicatePolicy,proto3" json:"near_duplicate_policy,omitempty"`
+	// the max size of an uploaded or downloaded pic file in bytes.
+	MaxFileSize *wrappers.Int64Value `protobuf:"bytes,23,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	// how long an auth token may go unused before it is removed.
+	UserTokenTtl *duration.Duration `protobuf:"bytes,24,opt,name=user_token_ttl,json=userTokenTtl,proto3" json:"user_token_ttl,omitempty"`
+	// users with any of these capabilities must use two-factor authentication
+	// to log in with their secret, and can't log in through an OpenID Connect
+	// provider.
+	TotpRequiredCapability *Configuration_CapabilitySet `protobuf:"bytes,25,opt,name=totp_required_capability,json=totpRequiredCapability,proto3" json:"totp_required_capability,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                     `json:"-"`
+	XXX_unrecognized       []byte                       `json:"-"`
+	XXX_sizecache          int32                        `json:"-"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
 func (m *Configuration) String() string { return proto.CompactTextString(m) }
 func (*Configuration) ProtoMessage()    {}
 func (*Configuration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{11}
+	return fileDescriptor_962aa63430fd1f4b, []int{17}
 }
 
 func (m *Configuration) XXX_Unmarshal(b []byte) error {
@@ -2084,6 +3266,48 @@ func (m *Configuration) GetMaxFindUserEvents() *wrappers.Int64Value {
 	return nil
 }
 
+func (m *Configuration) GetDefaultFindTags() *wrappers.Int64Value {
+	if m != nil {
+		return m.DefaultFindTags
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxFindTags() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFindTags
+	}
+	return nil
+}
+
+func (m *Configuration) GetNearDuplicatePolicy() *Configuration_NearDuplicatePolicy {
+	if m != nil {
+		return m.NearDuplicatePolicy
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxFileSize() *wrappers.Int64Value {
+	if m != nil {
+		return m.MaxFileSize
+	}
+	return nil
+}
+
+func (m *Configuration) GetUserTokenTtl() *duration.Duration {
+	if m != nil {
+		return m.UserTokenTtl
+	}
+	return nil
+}
+
+func (m *Configuration) GetTotpRequiredCapability() *Configuration_CapabilitySet {
+	if m != nil {
+		return m.TotpRequiredCapability
+	}
+	return nil
+}
+
 type Configuration_CapabilitySet struct {
 	Capability           []User_Capability `protobuf:"varint,1,rep,packed,name=capability,proto3,enum=pixur.be.schema.User_Capability" json:"capability,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -2095,7 +3319,7 @@ func (m *Configuration_CapabilitySet) Reset()         { *m = Configuration_Capab
 func (m *Configuration_CapabilitySet) String() string { return proto.CompactTextString(m) }
 func (*Configuration_CapabilitySet) ProtoMessage()    {}
 func (*Configuration_CapabilitySet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{11, 0}
+	return fileDescriptor_962aa63430fd1f4b, []int{17, 0}
 }
 
 func (m *Configuration_CapabilitySet) XXX_Unmarshal(b []byte) error {
@@ -2123,6 +3347,54 @@ func (m *Configuration_CapabilitySet) GetCapability() []User_Capability {
 	return nil
 }
 
+type Configuration_NearDuplicatePolicy struct {
+	Action Configuration_NearDuplicatePolicy_Action `protobuf:"varint,1,opt,name=action,proto3,enum=pixur.be.schema.Configuration_NearDuplicatePolicy_Action" json:"action,omitempty"`
+	// the max Hamming distance between the perceptual hashes of near duplicates.
+	MaxDistance          int64    `protobuf:"varint,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Configuration_NearDuplicatePolicy) Reset()         { *m = Configuration_NearDuplicatePolicy{} }
+func (m *Configuration_NearDuplicatePolicy) String() string { return proto.CompactTextString(m) }
+func (*Configuration_NearDuplicatePolicy) ProtoMessage()    {}
+func (*Configuration_NearDuplicatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{17, 1}
+}
+
+func (m *Configuration_NearDuplicatePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configuration_NearDuplicatePolicy.Unmarshal(m, b)
+}
+func (m *Configuration_NearDuplicatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configuration_NearDuplicatePolicy.Marshal(b, m, deterministic)
+}
+func (m *Configuration_NearDuplicatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration_NearDuplicatePolicy.Merge(m, src)
+}
+func (m *Configuration_NearDuplicatePolicy) XXX_Size() int {
+	return xxx_messageInfo_Configuration_NearDuplicatePolicy.Size(m)
+}
+func (m *Configuration_NearDuplicatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration_NearDuplicatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration_NearDuplicatePolicy proto.InternalMessageInfo
+
+func (m *Configuration_NearDuplicatePolicy) GetAction() Configuration_NearDuplicatePolicy_Action {
+	if m != nil {
+		return m.Action
+	}
+	return Configuration_NearDuplicatePolicy_UNKNOWN
+}
+
+func (m *Configuration_NearDuplicatePolicy) GetMaxDistance() int64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
 // CustomData is a free form message that can be used for experimental data.  It contains a 5-tuple
 // of long keys which are indexed as a prefix.  The keys must be unique.
 type CustomData struct {
@@ -2145,7 +3417,7 @@ func (m *CustomData) Reset()         { *m = CustomData{} }
 func (m *CustomData) String() string { return proto.CompactTextString(m) }
 func (*CustomData) ProtoMessage()    {}
 func (*CustomData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_962aa63430fd1f4b, []int{12}
+	return fileDescriptor_962aa63430fd1f4b, []int{18}
 }
 
 func (m *CustomData) XXX_Unmarshal(b []byte) error {
@@ -2229,23 +3501,363 @@ func (m *CustomData) GetData() *any.Any {
 	return nil
 }
 
+// BackgroundJob is work done outside of a request, by the server's workers.
+type BackgroundJob struct {
+	JobId int64              `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Type  BackgroundJob_Type `protobuf:"varint,2,opt,name=type,proto3,enum=pixur.be.schema.BackgroundJob_Type" json:"type,omitempty"`
+	// The user who created the job.  The job is run as this user.
+	UserId int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The pic the job acts on.  For FETCH_URL jobs, the pic created once the job succeeds.
+	PicId int64  `protobuf:"varint,4,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
+	Url   string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	// For FETCH_URL jobs, passed along when downloading url.
+	Referrer string `protobuf:"bytes,9,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// For FETCH_URL jobs, the name of the file, if it shouldn't be derived from url.
+	FileName string `protobuf:"bytes,10,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// For FETCH_URL jobs, the expected md5 hash of the downloaded file.
+	Md5Hash []byte `protobuf:"bytes,11,opt,name=md5_hash,json=md5Hash,proto3" json:"md5_hash,omitempty"`
+	// For FETCH_URL jobs, the visibility of the pic, if it is new.
+	Visibility           *Pic_Visibility       `protobuf:"bytes,12,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	CreatedTs            *timestamp.Timestamp  `protobuf:"bytes,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	ModifiedTs           *timestamp.Timestamp  `protobuf:"bytes,7,opt,name=modified_ts,json=modifiedTs,proto3" json:"modified_ts,omitempty"`
+	Status               *BackgroundJob_Status `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BackgroundJob) Reset()         { *m = BackgroundJob{} }
+func (m *BackgroundJob) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJob) ProtoMessage()    {}
+func (*BackgroundJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{19}
+}
+
+func (m *BackgroundJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJob.Unmarshal(m, b)
+}
+func (m *BackgroundJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJob.Marshal(b, m, deterministic)
+}
+func (m *BackgroundJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJob.Merge(m, src)
+}
+func (m *BackgroundJob) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJob.Size(m)
+}
+func (m *BackgroundJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackgroundJob proto.InternalMessageInfo
+
+func (m *BackgroundJob) GetJobId() int64 {
+	if m != nil {
+		return m.JobId
+	}
+	return 0
+}
+
+func (m *BackgroundJob) GetType() BackgroundJob_Type {
+	if m != nil {
+		return m.Type
+	}
+	return BackgroundJob_UNKNOWN
+}
+
+func (m *BackgroundJob) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *BackgroundJob) GetPicId() int64 {
+	if m != nil {
+		return m.PicId
+	}
+	return 0
+}
+
+func (m *BackgroundJob) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *BackgroundJob) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *BackgroundJob) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *BackgroundJob) GetMd5Hash() []byte {
+	if m != nil {
+		return m.Md5Hash
+	}
+	return nil
+}
+
+func (m *BackgroundJob) GetVisibility() *Pic_Visibility {
+	if m != nil {
+		return m.Visibility
+	}
+	return nil
+}
+
+func (m *BackgroundJob) GetCreatedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTs
+	}
+	return nil
+}
+
+func (m *BackgroundJob) GetModifiedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedTs
+	}
+	return nil
+}
+
+func (m *BackgroundJob) GetStatus() *BackgroundJob_Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type BackgroundJob_Status struct {
+	State BackgroundJob_Status_State `protobuf:"varint,1,opt,name=state,proto3,enum=pixur.be.schema.BackgroundJob_Status_State" json:"state,omitempty"`
+	// How many times the job has been started.
+	Attempts int64 `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Why the last attempt failed.
+	LastError  string               `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextRunTs  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=next_run_ts,json=nextRunTs,proto3" json:"next_run_ts,omitempty"`
+	FinishedTs *timestamp.Timestamp `protobuf:"bytes,5,opt,name=finished_ts,json=finishedTs,proto3" json:"finished_ts,omitempty"`
+	// How far the current attempt has gotten.  Only some job types report progress.
+	Progress             *BackgroundJob_Progress `protobuf:"bytes,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *BackgroundJob_Status) Reset()         { *m = BackgroundJob_Status{} }
+func (m *BackgroundJob_Status) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJob_Status) ProtoMessage()    {}
+func (*BackgroundJob_Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{19, 0}
+}
+
+func (m *BackgroundJob_Status) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJob_Status.Unmarshal(m, b)
+}
+func (m *BackgroundJob_Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJob_Status.Marshal(b, m, deterministic)
+}
+func (m *BackgroundJob_Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJob_Status.Merge(m, src)
+}
+func (m *BackgroundJob_Status) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJob_Status.Size(m)
+}
+func (m *BackgroundJob_Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJob_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackgroundJob_Status proto.InternalMessageInfo
+
+func (m *BackgroundJob_Status) GetState() BackgroundJob_Status_State {
+	if m != nil {
+		return m.State
+	}
+	return BackgroundJob_Status_UNKNOWN
+}
+
+func (m *BackgroundJob_Status) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *BackgroundJob_Status) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *BackgroundJob_Status) GetNextRunTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRunTs
+	}
+	return nil
+}
+
+func (m *BackgroundJob_Status) GetFinishedTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.FinishedTs
+	}
+	return nil
+}
+
+func (m *BackgroundJob_Status) GetProgress() *BackgroundJob_Progress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type BackgroundJob_Progress struct {
+	Stage           BackgroundJob_Progress_Stage `protobuf:"varint,1,opt,name=stage,proto3,enum=pixur.be.schema.BackgroundJob_Progress_Stage" json:"stage,omitempty"`
+	DownloadedBytes int64                        `protobuf:"varint,2,opt,name=downloaded_bytes,json=downloadedBytes,proto3" json:"downloaded_bytes,omitempty"`
+	// 0 if the size isn't known ahead of time.
+	TotalBytes           int64    `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackgroundJob_Progress) Reset()         { *m = BackgroundJob_Progress{} }
+func (m *BackgroundJob_Progress) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJob_Progress) ProtoMessage()    {}
+func (*BackgroundJob_Progress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{19, 1}
+}
+
+func (m *BackgroundJob_Progress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJob_Progress.Unmarshal(m, b)
+}
+func (m *BackgroundJob_Progress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJob_Progress.Marshal(b, m, deterministic)
+}
+func (m *BackgroundJob_Progress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJob_Progress.Merge(m, src)
+}
+func (m *BackgroundJob_Progress) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJob_Progress.Size(m)
+}
+func (m *BackgroundJob_Progress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJob_Progress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackgroundJob_Progress proto.InternalMessageInfo
+
+func (m *BackgroundJob_Progress) GetStage() BackgroundJob_Progress_Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return BackgroundJob_Progress_UNKNOWN
+}
+
+func (m *BackgroundJob_Progress) GetDownloadedBytes() int64 {
+	if m != nil {
+		return m.DownloadedBytes
+	}
+	return 0
+}
+
+func (m *BackgroundJob_Progress) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+// Lease is stored as CustomData, and lets one of several servers do some work exclusively.
+type Lease struct {
+	// identifies the server holding the lease.
+	Holder     string               `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	AcquiredTs *timestamp.Timestamp `protobuf:"bytes,2,opt,name=acquired_ts,json=acquiredTs,proto3" json:"acquired_ts,omitempty"`
+	// after this time, any server may take the lease.
+	ExpiresTs            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expires_ts,json=expiresTs,proto3" json:"expires_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Lease) Reset()         { *m = Lease{} }
+func (m *Lease) String() string { return proto.CompactTextString(m) }
+func (*Lease) ProtoMessage()    {}
+func (*Lease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962aa63430fd1f4b, []int{20}
+}
+
+func (m *Lease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Lease.Unmarshal(m, b)
+}
+func (m *Lease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Lease.Marshal(b, m, deterministic)
+}
+func (m *Lease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lease.Merge(m, src)
+}
+func (m *Lease) XXX_Size() int {
+	return xxx_messageInfo_Lease.Size(m)
+}
+func (m *Lease) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lease proto.InternalMessageInfo
+
+func (m *Lease) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *Lease) GetAcquiredTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.AcquiredTs
+	}
+	return nil
+}
+
+func (m *Lease) GetExpiresTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresTs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pixur.be.schema.Pic_DeletionStatus_Reason", Pic_DeletionStatus_Reason_name, Pic_DeletionStatus_Reason_value)
 	proto.RegisterEnum("pixur.be.schema.Pic_File_Mime", Pic_File_Mime_name, Pic_File_Mime_value)
+	proto.RegisterEnum("pixur.be.schema.Pic_Visibility_Mode", Pic_Visibility_Mode_name, Pic_Visibility_Mode_value)
 	proto.RegisterEnum("pixur.be.schema.PicIdent_Type", PicIdent_Type_name, PicIdent_Type_value)
 	proto.RegisterEnum("pixur.be.schema.PicVote_Vote", PicVote_Vote_name, PicVote_Vote_value)
 	proto.RegisterEnum("pixur.be.schema.PicCommentVote_Vote", PicCommentVote_Vote_name, PicCommentVote_Vote_value)
 	proto.RegisterEnum("pixur.be.schema.User_Capability", User_Capability_name, User_Capability_value)
+	proto.RegisterEnum("pixur.be.schema.Configuration_NearDuplicatePolicy_Action", Configuration_NearDuplicatePolicy_Action_name, Configuration_NearDuplicatePolicy_Action_value)
+	proto.RegisterEnum("pixur.be.schema.BackgroundJob_Type", BackgroundJob_Type_name, BackgroundJob_Type_value)
+	proto.RegisterEnum("pixur.be.schema.BackgroundJob_Status_State", BackgroundJob_Status_State_name, BackgroundJob_Status_State_value)
+	proto.RegisterEnum("pixur.be.schema.BackgroundJob_Progress_Stage", BackgroundJob_Progress_Stage_name, BackgroundJob_Progress_Stage_value)
 	proto.RegisterType((*Pic)(nil), "pixur.be.schema.Pic")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.Pic.ExtEntry")
 	proto.RegisterType((*Pic_DeletionStatus)(nil), "pixur.be.schema.Pic.DeletionStatus")
 	proto.RegisterType((*Pic_FileSource)(nil), "pixur.be.schema.Pic.FileSource")
 	proto.RegisterType((*Pic_File)(nil), "pixur.be.schema.Pic.File")
+	proto.RegisterType((*Pic_DuplicateReview)(nil), "pixur.be.schema.Pic.DuplicateReview")
+	proto.RegisterType((*Pic_Restoration)(nil), "pixur.be.schema.Pic.Restoration")
+	proto.RegisterType((*Pic_Visibility)(nil), "pixur.be.schema.Pic.Visibility")
 	proto.RegisterType((*PicIdent)(nil), "pixur.be.schema.PicIdent")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.PicIdent.ExtEntry")
 	proto.RegisterType((*AnimationInfo)(nil), "pixur.be.schema.AnimationInfo")
 	proto.RegisterType((*Tag)(nil), "pixur.be.schema.Tag")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.Tag.ExtEntry")
+	proto.RegisterType((*TagAlias)(nil), "pixur.be.schema.TagAlias")
+	proto.RegisterType((*TagImplication)(nil), "pixur.be.schema.TagImplication")
 	proto.RegisterType((*PicTag)(nil), "pixur.be.schema.PicTag")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.PicTag.ExtEntry")
 	proto.RegisterType((*PicComment)(nil), "pixur.be.schema.PicComment")
@@ -2260,175 +3872,289 @@ func init() {
 	proto.RegisterType((*UserEvent_OutgoingPicComment)(nil), "pixur.be.schema.UserEvent.OutgoingPicComment")
 	proto.RegisterType((*UserEvent_IncomingPicComment)(nil), "pixur.be.schema.UserEvent.IncomingPicComment")
 	proto.RegisterType((*UserEvent_UpsertPic)(nil), "pixur.be.schema.UserEvent.UpsertPic")
+	proto.RegisterType((*UserEvent_RemovePicTags)(nil), "pixur.be.schema.UserEvent.RemovePicTags")
+	proto.RegisterType((*UserEvent_UpdateRole)(nil), "pixur.be.schema.UserEvent.UpdateRole")
+	proto.RegisterType((*UserEvent_UpdateUserRoles)(nil), "pixur.be.schema.UserEvent.UpdateUserRoles")
 	proto.RegisterType((*User)(nil), "pixur.be.schema.User")
 	proto.RegisterMapType((map[string]*any.Any)(nil), "pixur.be.schema.User.ExtEntry")
+	proto.RegisterType((*Role)(nil), "pixur.be.schema.Role")
+	proto.RegisterType((*UserIdentity)(nil), "pixur.be.schema.UserIdentity")
 	proto.RegisterType((*UserToken)(nil), "pixur.be.schema.UserToken")
+	proto.RegisterType((*UserApiKey)(nil), "pixur.be.schema.UserApiKey")
+	proto.RegisterType((*UserTotp)(nil), "pixur.be.schema.UserTotp")
 	proto.RegisterType((*Configuration)(nil), "pixur.be.schema.Configuration")
 	proto.RegisterType((*Configuration_CapabilitySet)(nil), "pixur.be.schema.Configuration.CapabilitySet")
+	proto.RegisterType((*Configuration_NearDuplicatePolicy)(nil), "pixur.be.schema.Configuration.NearDuplicatePolicy")
 	proto.RegisterType((*CustomData)(nil), "pixur.be.schema.CustomData")
+	proto.RegisterType((*BackgroundJob)(nil), "pixur.be.schema.BackgroundJob")
+	proto.RegisterType((*BackgroundJob_Status)(nil), "pixur.be.schema.BackgroundJob.Status")
+	proto.RegisterType((*BackgroundJob_Progress)(nil), "pixur.be.schema.BackgroundJob.Progress")
+	proto.RegisterType((*Lease)(nil), "pixur.be.schema.Lease")
 }
 
 func init() { proto.RegisterFile("pixur.proto", fileDescriptor_962aa63430fd1f4b) }
 
 var fileDescriptor_962aa63430fd1f4b = []byte{
-	// 2541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x73, 0xe2, 0xc8,
-	0x15, 0x1f, 0x90, 0x00, 0xf1, 0x30, 0x58, 0x6e, 0xff, 0x93, 0x99, 0xf1, 0x8c, 0x97, 0x4d, 0x52,
-	0xae, 0xa9, 0x2c, 0x9e, 0x61, 0xc6, 0xb3, 0x9b, 0x4d, 0x0e, 0xc1, 0x20, 0xdb, 0x38, 0x18, 0x13,
-	0x21, 0x3c, 0x9b, 0xd4, 0x56, 0xa9, 0x64, 0x68, 0xb0, 0x62, 0x24, 0x51, 0x92, 0xb0, 0x21, 0xdf,
-	0x23, 0x95, 0x43, 0x0e, 0xa9, 0xca, 0x3d, 0x87, 0xdc, 0xf3, 0x01, 0x72, 0xcb, 0x21, 0xe7, 0xdc,
-	0xb2, 0x5f, 0x22, 0xa7, 0x54, 0xb7, 0x24, 0x90, 0xf8, 0x63, 0xec, 0x9d, 0x4c, 0x26, 0x17, 0x4a,
-	0xfd, 0xfa, 0xbd, 0xdf, 0x7b, 0xfd, 0x7e, 0xdd, 0xef, 0xb5, 0x04, 0xa4, 0xfa, 0xda, 0x70, 0x60,
-	0xe5, 0xfb, 0x96, 0xe9, 0x98, 0x68, 0xd5, 0x1d, 0x5c, 0xe1, 0xbc, 0xdd, 0xba, 0xc6, 0xba, 0x9a,
-	0xdd, 0xe9, 0x9a, 0x66, 0xb7, 0x87, 0x0f, 0xe8, 0xf4, 0xd5, 0xa0, 0x73, 0xa0, 0x1a, 0x23, 0x57,
-	0x37, 0xfb, 0x7c, 0x7a, 0xaa, 0x3d, 0xb0, 0x54, 0x47, 0x33, 0x0d, 0x6f, 0xfe, 0xc5, 0xf4, 0xbc,
-	0xa3, 0xe9, 0xd8, 0x76, 0x54, 0xbd, 0xbf, 0x08, 0xe0, 0xce, 0x52, 0xfb, 0x7d, 0x6c, 0xd9, 0xee,
-	0x7c, 0xee, 0xf7, 0x69, 0x60, 0xea, 0x5a, 0x0b, 0x6d, 0x42, 0xbc, 0xaf, 0xb5, 0x14, 0xad, 0x2d,
-	0x44, 0xf6, 0x22, 0xfb, 0x8c, 0x14, 0xeb, 0x6b, 0xad, 0x4a, 0x1b, 0x7d, 0x01, 0x6c, 0x47, 0xeb,
-	0x61, 0x61, 0x6b, 0x2f, 0xb2, 0x9f, 0x2a, 0xec, 0xe4, 0xa7, 0x42, 0xcf, 0xd7, 0xb5, 0x56, 0xfe,
-	0x58, 0xeb, 0x61, 0x89, 0xaa, 0xa1, 0x9f, 0x00, 0xb4, 0x2c, 0xac, 0x3a, 0xb8, 0xad, 0x38, 0xb6,
-	0x00, 0xd4, 0x28, 0x9b, 0x77, 0x43, 0xc8, 0xfb, 0x21, 0xe4, 0x65, 0x3f, 0x46, 0x29, 0xe9, 0x69,
-	0xcb, 0x36, 0xfa, 0x29, 0xa4, 0x74, 0xb3, 0xad, 0x75, 0x34, 0xd7, 0x36, 0xb5, 0xd4, 0x16, 0x7c,
-	0x75, 0xd9, 0x46, 0x55, 0x58, 0x6d, 0xe3, 0x1e, 0x26, 0x89, 0x51, 0x6c, 0x47, 0x75, 0x06, 0xb6,
-	0xb0, 0x42, 0x01, 0x3e, 0x9f, 0x1b, 0x71, 0xd9, 0xd3, 0x6d, 0x50, 0x55, 0x29, 0xd3, 0x0e, 0x8d,
-	0xd1, 0x2e, 0xc0, 0xad, 0x86, 0xef, 0x94, 0x96, 0x39, 0x30, 0x1c, 0x21, 0x43, 0xf3, 0x91, 0x24,
-	0x92, 0x12, 0x11, 0xa0, 0x2f, 0x21, 0x6e, 0x9b, 0x03, 0xab, 0x85, 0x85, 0xd5, 0x3d, 0x66, 0x3f,
-	0x55, 0x78, 0xb1, 0x30, 0x2b, 0x0d, 0xaa, 0x26, 0x79, 0xea, 0x68, 0x1b, 0x12, 0xb7, 0xa6, 0x83,
-	0x95, 0x41, 0x5f, 0x58, 0xa3, 0xa0, 0x71, 0x32, 0x6c, 0xf6, 0xd1, 0x53, 0x48, 0xd2, 0x89, 0xb6,
-	0x79, 0x67, 0x08, 0x88, 0x4e, 0x71, 0x44, 0x50, 0x36, 0xef, 0x0c, 0x74, 0x00, 0x0c, 0x1e, 0x3a,
-	0xc2, 0x3a, 0xf5, 0xb5, 0x3b, 0xd7, 0x97, 0x38, 0x74, 0x44, 0xc3, 0xb1, 0x46, 0x12, 0xd1, 0x44,
-	0x5f, 0x42, 0xd2, 0xb9, 0x1e, 0xe8, 0x57, 0x86, 0xaa, 0xf5, 0x84, 0x4d, 0x6a, 0x76, 0x0f, 0x71,
-	0x13, 0x5d, 0xf4, 0x06, 0x12, 0x6d, 0x6c, 0x69, 0xb7, 0xb8, 0x2d, 0x6c, 0x2f, 0x33, 0xf3, 0x35,
-	0xb3, 0x7f, 0x60, 0x20, 0x13, 0xce, 0x27, 0x3a, 0x86, 0x35, 0x5d, 0xb5, 0x6e, 0x70, 0x5b, 0xa1,
-	0x89, 0x75, 0x09, 0x8d, 0x2c, 0x25, 0x74, 0xd5, 0x35, 0x2a, 0xbb, 0x36, 0xb2, 0x8d, 0x4e, 0x01,
-	0xf5, 0xb1, 0xd1, 0xd6, 0x8c, 0x6e, 0x10, 0x28, 0xba, 0x14, 0x88, 0xf7, 0xac, 0x26, 0x48, 0xc7,
-	0xb0, 0xa6, 0xb6, 0x9c, 0x81, 0xda, 0x0b, 0x02, 0x31, 0xcb, 0x23, 0x72, 0x8d, 0x26, 0x38, 0x02,
-	0xc9, 0x90, 0xa3, 0x6a, 0x3d, 0x5b, 0x60, 0xf7, 0x22, 0xfb, 0x49, 0xc9, 0x1f, 0xa2, 0x23, 0x88,
-	0x5b, 0x58, 0xb5, 0x4d, 0x43, 0x88, 0xed, 0x45, 0xf6, 0x33, 0x85, 0x97, 0x0f, 0xd8, 0x78, 0x79,
-	0x89, 0x5a, 0x48, 0x9e, 0x25, 0x7a, 0x06, 0x49, 0x07, 0xeb, 0x7d, 0xd3, 0x52, 0xad, 0x91, 0x10,
-	0xdf, 0x8b, 0xec, 0x73, 0xd2, 0x44, 0x90, 0x7b, 0x03, 0x71, 0x57, 0x1f, 0xa5, 0x20, 0xd1, 0xac,
-	0xfd, 0xa2, 0x76, 0xf1, 0xbe, 0xc6, 0x3f, 0x41, 0x1c, 0xb0, 0xb5, 0x8b, 0x9a, 0xc8, 0x47, 0x10,
-	0x82, 0x8c, 0xd4, 0xac, 0x8a, 0xca, 0x65, 0xe5, 0xa2, 0x5a, 0x94, 0x2b, 0x17, 0x35, 0x3e, 0x9a,
-	0xfd, 0x53, 0x04, 0x60, 0xb2, 0x13, 0x11, 0x0f, 0xcc, 0xc0, 0xea, 0x51, 0x2e, 0x92, 0x12, 0x79,
-	0x44, 0x59, 0xe0, 0x2c, 0xdc, 0xc1, 0x96, 0x85, 0x2d, 0x9a, 0xd9, 0xa4, 0x34, 0x1e, 0x4f, 0x9d,
-	0x66, 0xe6, 0x31, 0xa7, 0x79, 0x1b, 0x12, 0x03, 0x1b, 0x5b, 0xa4, 0x9e, 0xb0, 0xee, 0x56, 0x27,
-	0xc3, 0x4a, 0x1b, 0x21, 0x60, 0x0d, 0x55, 0xc7, 0x34, 0x4b, 0x49, 0x89, 0x3e, 0x67, 0xab, 0xc0,
-	0xf9, 0x3b, 0x98, 0x44, 0x78, 0x83, 0x47, 0x7e, 0x84, 0x37, 0x78, 0x84, 0x5e, 0x42, 0xec, 0x56,
-	0xed, 0x0d, 0xb0, 0x47, 0xfc, 0xc6, 0x4c, 0x00, 0x45, 0x63, 0x24, 0xb9, 0x2a, 0x5f, 0x47, 0xbf,
-	0x8a, 0x64, 0x7f, 0xc7, 0x00, 0x4b, 0x96, 0x8c, 0x36, 0x20, 0xa6, 0x19, 0x6d, 0x3c, 0xf4, 0x2b,
-	0x1a, 0x1d, 0x90, 0x00, 0x6c, 0xed, 0xb7, 0x2e, 0x1a, 0x23, 0xd1, 0x67, 0x54, 0x00, 0x56, 0xd7,
-	0x74, 0x4c, 0x97, 0x98, 0x29, 0x3c, 0x5f, 0xb8, 0xeb, 0xf3, 0xe7, 0x9a, 0x8e, 0x25, 0xaa, 0x4b,
-	0xd0, 0xef, 0xb4, 0xb6, 0x73, 0xed, 0xad, 0xcf, 0x1d, 0xa0, 0x2d, 0x88, 0x5f, 0x63, 0xad, 0x7b,
-	0xed, 0xd0, 0x05, 0x32, 0x92, 0x37, 0x9a, 0x4a, 0x65, 0xfc, 0x03, 0x0a, 0x63, 0xe2, 0x51, 0x85,
-	0x51, 0x84, 0x8c, 0x6a, 0x68, 0x3a, 0x6d, 0x19, 0x8a, 0x66, 0x74, 0x4c, 0x81, 0xa3, 0xf6, 0xb3,
-	0x6b, 0x2c, 0xfa, 0x6a, 0x15, 0xa3, 0x63, 0x4a, 0x69, 0x35, 0x38, 0xcc, 0x1d, 0x01, 0x4b, 0x96,
-	0x3e, 0xb3, 0xf3, 0xce, 0xea, 0xe2, 0x09, 0x1f, 0x41, 0x09, 0x60, 0x4e, 0x2a, 0xc7, 0x7c, 0x94,
-	0x3c, 0xd4, 0x6b, 0x27, 0x3c, 0x43, 0xe6, 0xde, 0x8b, 0x47, 0xe7, 0x3c, 0x4b, 0x44, 0xe7, 0xf5,
-	0xb7, 0x7c, 0xec, 0x8c, 0xe5, 0xa2, 0x3c, 0x73, 0xc6, 0x72, 0x0c, 0xcf, 0x9e, 0xb1, 0x1c, 0x4b,
-	0x25, 0x31, 0x3e, 0x7e, 0xc6, 0x72, 0x49, 0x1e, 0xce, 0x58, 0x2e, 0xcd, 0x67, 0xce, 0x58, 0x8e,
-	0xe7, 0xd7, 0xce, 0x58, 0x6e, 0x83, 0xdf, 0xcc, 0x7d, 0x17, 0x05, 0xae, 0x4e, 0x9a, 0x10, 0x36,
-	0x9c, 0x45, 0xed, 0xa9, 0x00, 0xac, 0x33, 0xea, 0xbb, 0x64, 0x2e, 0x20, 0x8e, 0xda, 0xe7, 0xe5,
-	0x51, 0x1f, 0x4b, 0x54, 0x97, 0x10, 0xe7, 0xee, 0x27, 0xc2, 0xf6, 0x8a, 0xb7, 0x73, 0xd0, 0xe7,
-	0x90, 0x6a, 0xb7, 0x9c, 0x57, 0x0a, 0x1d, 0x91, 0xd3, 0xcd, 0xec, 0x47, 0x8f, 0xa2, 0x7c, 0x44,
-	0x02, 0x22, 0xbe, 0xa4, 0x52, 0xf4, 0xd6, 0x2d, 0xc5, 0x31, 0x5a, 0x1c, 0x73, 0x8b, 0xbd, 0x85,
-	0xea, 0xf1, 0x7f, 0x77, 0x7b, 0xe7, 0x2e, 0x80, 0x25, 0x8b, 0x99, 0xa1, 0xa2, 0x71, 0x5a, 0x7c,
-	0xed, 0x32, 0x70, 0x5e, 0x3e, 0xe4, 0x19, 0x94, 0x84, 0x58, 0xb9, 0x24, 0x2b, 0xaf, 0x78, 0x16,
-	0x65, 0x00, 0x1a, 0xa7, 0xc5, 0xc3, 0xd7, 0x05, 0xa5, 0x70, 0xf8, 0x8e, 0x8f, 0xe5, 0x58, 0x2e,
-	0xc2, 0x47, 0x5e, 0xc6, 0x1b, 0xa7, 0xc5, 0xc2, 0xe1, 0xbb, 0xdc, 0x31, 0xa4, 0x43, 0xdc, 0xa3,
-	0x43, 0xe0, 0xfc, 0x5b, 0x86, 0x57, 0xb5, 0x77, 0x66, 0x82, 0x2a, 0x7b, 0x0a, 0xd2, 0x58, 0x35,
-	0xf7, 0xb7, 0x28, 0x30, 0xb2, 0xda, 0x25, 0x54, 0x39, 0x6a, 0x37, 0x40, 0x95, 0xa3, 0x76, 0x03,
-	0x07, 0x3f, 0x3a, 0x39, 0xf8, 0xe8, 0x05, 0xa4, 0x06, 0xb6, 0xda, 0xc5, 0x5e, 0xa7, 0x65, 0xa8,
-	0x3e, 0x50, 0x91, 0xdb, 0x6a, 0x3f, 0xd5, 0xb1, 0xf1, 0x7a, 0x2e, 0xb7, 0xa0, 0xe7, 0xca, 0x6a,
-	0xf7, 0xa3, 0x72, 0xfc, 0xcf, 0x28, 0xc4, 0xeb, 0x5a, 0xcb, 0xcb, 0xe6, 0xbc, 0x8d, 0x3f, 0x49,
-	0x72, 0x74, 0x5e, 0x92, 0x99, 0x40, 0x92, 0x03, 0xa5, 0x98, 0x0b, 0x95, 0xe2, 0x4f, 0x95, 0xdc,
-	0x82, 0x9b, 0xdc, 0x24, 0x4d, 0xee, 0xde, 0xbc, 0x53, 0xf4, 0xb1, 0xf3, 0xfb, 0x77, 0x06, 0xa0,
-	0xae, 0xb5, 0x4a, 0xa6, 0xae, 0xdf, 0x53, 0x5c, 0x76, 0x01, 0x5a, 0xae, 0xc6, 0x24, 0xcf, 0x49,
-	0x4f, 0x52, 0x69, 0xa3, 0x97, 0xb0, 0xe6, 0x4f, 0xf7, 0x55, 0xcb, 0xd3, 0x72, 0xb7, 0xf0, 0xaa,
-	0x37, 0x51, 0xa7, 0xf2, 0x4a, 0xfb, 0xde, 0x76, 0xe8, 0x90, 0x64, 0x24, 0x5c, 0xc2, 0xc8, 0x73,
-	0xf0, 0x9a, 0x98, 0x5c, 0x7c, 0x4d, 0x84, 0xa9, 0x6b, 0x62, 0x98, 0xcd, 0xd8, 0x07, 0xb0, 0x19,
-	0x7f, 0x14, 0x9b, 0xef, 0x82, 0x47, 0xe5, 0x07, 0xf3, 0xd8, 0xf4, 0xd2, 0xfc, 0x51, 0x19, 0xfd,
-	0x0b, 0x03, 0x89, 0xba, 0xd6, 0xba, 0x34, 0x1d, 0xbc, 0x88, 0xce, 0x00, 0x07, 0xd1, 0x10, 0x07,
-	0xe3, 0x7b, 0x42, 0x22, 0x78, 0x4f, 0x78, 0x0d, 0x2c, 0xc9, 0xad, 0x77, 0x27, 0x98, 0x7b, 0xef,
-	0x26, 0xde, 0xf2, 0xe4, 0x47, 0xa2, 0xaa, 0x53, 0x14, 0xb0, 0x1f, 0x40, 0x41, 0xec, 0x51, 0x14,
-	0xbc, 0x71, 0x29, 0x88, 0x53, 0x0a, 0x3e, 0x5b, 0x18, 0xe9, 0xc7, 0xcc, 0x7f, 0x01, 0x58, 0x9a,
-	0xfb, 0x50, 0x57, 0x8a, 0x43, 0xb4, 0x59, 0xe7, 0x23, 0xa4, 0x3b, 0x95, 0x89, 0x24, 0x4a, 0xa6,
-	0x6b, 0x62, 0x53, 0x96, 0x8a, 0x55, 0x9e, 0xc9, 0x7d, 0xc7, 0x40, 0x66, 0xb2, 0x3d, 0xee, 0xa3,
-	0x6e, 0xc9, 0x49, 0x0c, 0x30, 0xcb, 0xcc, 0x67, 0x96, 0x0d, 0x32, 0xfb, 0x95, 0xc7, 0xac, 0x7b,
-	0x51, 0xbf, 0x6f, 0xcb, 0xde, 0x4f, 0xf0, 0xff, 0xae, 0x62, 0x7e, 0x1d, 0x3c, 0x63, 0xfb, 0xcb,
-	0x02, 0xfe, 0x7f, 0xe3, 0xf9, 0x5f, 0x09, 0x48, 0x36, 0x6d, 0x6c, 0x89, 0xb7, 0xa4, 0xd8, 0x06,
-	0xc8, 0x8a, 0xcc, 0x27, 0x2b, 0x1a, 0x24, 0xeb, 0x03, 0xde, 0x41, 0xa6, 0x52, 0xce, 0x3e, 0x2a,
-	0xe5, 0x37, 0x20, 0x98, 0x03, 0xa7, 0x6b, 0x92, 0x97, 0xcf, 0x41, 0xdf, 0xc6, 0x96, 0xa3, 0x90,
-	0x9d, 0x39, 0xde, 0x38, 0xa9, 0xc2, 0xab, 0x19, 0x1e, 0xc6, 0x8b, 0xcc, 0x5f, 0x78, 0xa6, 0x4d,
-	0x6a, 0xe9, 0x1d, 0xc0, 0xd3, 0x27, 0xd2, 0xa6, 0x39, 0x6f, 0x82, 0x38, 0xd3, 0x8c, 0x96, 0xa9,
-	0xcf, 0x73, 0x16, 0x5f, 0xea, 0xac, 0xe2, 0x99, 0xce, 0x38, 0xd3, 0xe6, 0x4d, 0x20, 0x15, 0x36,
-	0xc6, 0x2b, 0x23, 0x5e, 0xbc, 0x73, 0xe4, 0x6d, 0xc9, 0x2f, 0x1e, 0xb0, 0xaa, 0xc9, 0x7e, 0x3b,
-	0x7d, 0x22, 0x21, 0x73, 0x46, 0x4a, 0x5c, 0x8c, 0xd7, 0x13, 0x74, 0xc1, 0x2d, 0x75, 0xe1, 0xaf,
-	0x25, 0xec, 0x42, 0x9b, 0x91, 0x22, 0x11, 0x60, 0x92, 0x29, 0xda, 0x27, 0xe7, 0x75, 0x9f, 0x09,
-	0xf0, 0x38, 0x07, 0xa7, 0x4f, 0xa4, 0xe4, 0xc0, 0x1f, 0x64, 0xf3, 0xb0, 0x39, 0x97, 0xab, 0x05,
-	0x95, 0x28, 0x7b, 0x09, 0x9b, 0x73, 0xd3, 0x8d, 0x7e, 0x04, 0xab, 0xf6, 0xe0, 0xea, 0x37, 0xb8,
-	0xe5, 0x28, 0xe1, 0xed, 0x9d, 0xf6, 0xc4, 0x4d, 0x77, 0x97, 0x4f, 0x70, 0xa3, 0x41, 0xdc, 0x33,
-	0x40, 0xb3, 0xd9, 0x9d, 0xaa, 0x7b, 0x91, 0xe9, 0xba, 0xb7, 0x18, 0x6b, 0x36, 0x8d, 0xdf, 0x13,
-	0x2b, 0x07, 0xc9, 0xf1, 0x3a, 0x17, 0xe4, 0xe4, 0x28, 0x06, 0x0c, 0xbe, 0x75, 0x72, 0xff, 0x4e,
-	0x02, 0x4b, 0x16, 0xb9, 0xf8, 0x84, 0x6f, 0x41, 0xdc, 0xc6, 0x2d, 0x0b, 0x3b, 0xd4, 0xc7, 0x8a,
-	0xe4, 0x8d, 0xe8, 0xc9, 0x27, 0xef, 0x4d, 0xde, 0xb5, 0xd5, 0x1d, 0x7c, 0xb2, 0x6e, 0xfa, 0x33,
-	0x58, 0xe9, 0xa9, 0xb6, 0xa3, 0xd8, 0x18, 0x1b, 0x0f, 0xbc, 0x0e, 0x11, 0xfd, 0x06, 0xc6, 0x86,
-	0x6c, 0xa3, 0x9f, 0x03, 0xb4, 0xd4, 0xbe, 0x7a, 0xa5, 0xf5, 0x34, 0x67, 0x24, 0x24, 0xf6, 0x98,
-	0xfd, 0xcc, 0x9c, 0x3b, 0x2e, 0xc9, 0x53, 0xbe, 0x34, 0xd6, 0x93, 0x02, 0x36, 0x28, 0x07, 0x69,
-	0x03, 0x0f, 0x1d, 0xc5, 0x31, 0x6f, 0xb0, 0x31, 0xb9, 0xb5, 0xa7, 0x88, 0x50, 0x26, 0x32, 0xf7,
-	0xea, 0x4e, 0x53, 0x4c, 0x75, 0xbc, 0x9b, 0x74, 0x76, 0xae, 0x17, 0x6a, 0x21, 0x25, 0x07, 0xfe,
-	0x23, 0x7a, 0xe5, 0xf6, 0x12, 0xa0, 0x36, 0xcf, 0xe7, 0x47, 0xf6, 0x31, 0x3b, 0xc8, 0x3f, 0x62,
-	0x00, 0x93, 0x95, 0x87, 0x1b, 0x49, 0x06, 0xa0, 0x5e, 0x29, 0x29, 0x25, 0x49, 0x2c, 0xca, 0x22,
-	0x1f, 0x41, 0x2b, 0xc0, 0x91, 0xb1, 0x24, 0x16, 0xcb, 0x7c, 0x14, 0xa5, 0x21, 0x49, 0x46, 0x95,
-	0x5a, 0x59, 0xfc, 0x86, 0x67, 0xd0, 0x3a, 0xac, 0x92, 0x61, 0xe3, 0xe2, 0x58, 0x56, 0xca, 0x62,
-	0x55, 0x94, 0x45, 0x3e, 0xe6, 0x0b, 0x4f, 0x8b, 0x52, 0xd9, 0x17, 0xc6, 0x7d, 0xc3, 0x7a, 0x53,
-	0x3a, 0x11, 0xf9, 0x04, 0x7a, 0x0a, 0xdb, 0x64, 0xd8, 0xac, 0x97, 0x8b, 0xb2, 0xa8, 0x5c, 0x56,
-	0xc4, 0xf7, 0x4a, 0xe9, 0xa2, 0x59, 0x93, 0x45, 0x89, 0xe7, 0x10, 0x82, 0x0c, 0x99, 0x94, 0x8b,
-	0x27, 0x7e, 0x18, 0x49, 0xb4, 0x05, 0x88, 0x86, 0x75, 0x71, 0x7e, 0x2e, 0xd6, 0x64, 0x5f, 0x0e,
-	0xbe, 0xb3, 0xcb, 0x0b, 0x59, 0xf4, 0x85, 0x29, 0xb4, 0x0a, 0xa9, 0x66, 0x43, 0x94, 0x7c, 0x01,
-	0x8b, 0xb2, 0xb0, 0x45, 0x05, 0x9e, 0xbf, 0x52, 0xb1, 0x5e, 0x3c, 0xaa, 0x54, 0x2b, 0xf2, 0xaf,
-	0xf8, 0x15, 0xe2, 0x8d, 0xce, 0x91, 0x15, 0x2a, 0x0d, 0xb1, 0x7a, 0xcc, 0xa7, 0xd1, 0x1a, 0xa4,
-	0x27, 0xb2, 0x62, 0xb5, 0xca, 0x67, 0x90, 0x00, 0x1b, 0xc4, 0x91, 0xf8, 0x8d, 0x2c, 0xd6, 0x1a,
-	0x95, 0x8b, 0x9a, 0x0f, 0xbe, 0xea, 0x87, 0x36, 0x99, 0xa1, 0xb9, 0xe2, 0xd1, 0x1e, 0x3c, 0x0b,
-	0x86, 0x3c, 0x63, 0xb9, 0x86, 0x9e, 0x43, 0x76, 0xbe, 0x06, 0x45, 0x40, 0xe8, 0x19, 0x08, 0x7e,
-	0x22, 0x66, 0xac, 0xd7, 0xc9, 0xa2, 0x66, 0x67, 0xa9, 0xe5, 0x06, 0xda, 0x85, 0x9d, 0x71, 0x5a,
-	0x66, 0x4c, 0x37, 0xfd, 0xf4, 0x4f, 0x4d, 0x53, 0xdb, 0x2d, 0xb4, 0x01, 0xfc, 0x64, 0xf1, 0xf5,
-	0xe6, 0x51, 0xb5, 0x52, 0xe2, 0xb7, 0xc3, 0x69, 0xaa, 0x57, 0x4a, 0x0d, 0x5e, 0x40, 0x9b, 0xb0,
-	0x16, 0x92, 0x91, 0x58, 0xf8, 0x1d, 0xb4, 0x03, 0x9b, 0x61, 0xb1, 0xb7, 0x40, 0x3e, 0x4b, 0x72,
-	0x15, 0x9e, 0x22, 0x21, 0xf0, 0x4f, 0xfd, 0x80, 0xfc, 0x4c, 0x04, 0xe9, 0x7c, 0x86, 0x7e, 0x08,
-	0x9f, 0xcd, 0x4c, 0xce, 0x2c, 0x6a, 0x37, 0xf7, 0xc7, 0x88, 0x7b, 0xc7, 0x71, 0xcf, 0xd8, 0x0e,
-	0x70, 0xe3, 0xd3, 0xeb, 0x96, 0xc0, 0x84, 0x33, 0x39, 0xb9, 0x81, 0xaa, 0x16, 0x7d, 0x4c, 0x55,
-	0x9b, 0x2e, 0x4c, 0xcc, 0x63, 0x0a, 0x53, 0xee, 0xaf, 0x2b, 0x90, 0x2e, 0x99, 0x46, 0x47, 0xeb,
-	0x7a, 0x1f, 0x6c, 0x50, 0x05, 0x90, 0xae, 0x19, 0x7e, 0x73, 0x56, 0x7a, 0xd8, 0xe8, 0x3a, 0xd7,
-	0xde, 0x17, 0x9f, 0xa7, 0x33, 0xa8, 0x15, 0xc3, 0x79, 0xf7, 0x96, 0x7e, 0x07, 0x93, 0x78, 0x5d,
-	0x33, 0xbc, 0xb6, 0x52, 0xa5, 0x46, 0x14, 0x4a, 0x1d, 0x4e, 0x43, 0x45, 0x1f, 0x02, 0xa5, 0x0e,
-	0xc3, 0x50, 0x22, 0x10, 0x78, 0x85, 0xf6, 0x00, 0x1f, 0x88, 0x59, 0x0e, 0x94, 0xd1, 0x35, 0x83,
-	0x7e, 0x7c, 0x0b, 0xc0, 0xa8, 0xc3, 0x30, 0x0c, 0xfb, 0x10, 0x18, 0x75, 0x18, 0x84, 0xa9, 0xc2,
-	0x06, 0x89, 0xa6, 0xa3, 0xf5, 0xb0, 0x62, 0xa8, 0x3a, 0xf6, 0xa1, 0x62, 0xcb, 0xa1, 0xd6, 0x74,
-	0xcd, 0x38, 0xd6, 0x7a, 0xb8, 0xa6, 0xea, 0x38, 0x80, 0xa6, 0x0e, 0x67, 0xd1, 0xe2, 0x0f, 0x41,
-	0x53, 0x87, 0x53, 0x68, 0x45, 0x20, 0x8b, 0x56, 0x06, 0x56, 0xcf, 0xc7, 0x49, 0x2c, 0xc7, 0x59,
-	0xd1, 0x35, 0xa3, 0x69, 0xf5, 0x02, 0x10, 0xea, 0x30, 0x08, 0xc1, 0x3d, 0x04, 0x42, 0x1d, 0x86,
-	0x21, 0x34, 0x43, 0x71, 0xd4, 0xae, 0x0f, 0x91, 0x7c, 0x58, 0x14, 0xb2, 0xda, 0x0d, 0x47, 0x11,
-	0x80, 0x80, 0x87, 0x45, 0x31, 0x81, 0x50, 0x60, 0x43, 0x35, 0x4c, 0x63, 0xa4, 0x9b, 0x03, 0x5b,
-	0x09, 0x34, 0x60, 0xf7, 0x6f, 0xc4, 0x1f, 0xcf, 0xb4, 0xb9, 0xd0, 0x49, 0x08, 0x74, 0xe2, 0x06,
-	0x76, 0xa4, 0xf5, 0x31, 0x52, 0xa0, 0x4f, 0x7d, 0x0b, 0xeb, 0x06, 0xbe, 0x73, 0xef, 0x76, 0x01,
-	0xfc, 0x95, 0xef, 0x81, 0xbf, 0x66, 0xe0, 0x3b, 0x52, 0x2b, 0x02, 0xe8, 0x12, 0x6c, 0xb7, 0x71,
-	0x47, 0x1d, 0xf4, 0x1c, 0xa5, 0xa3, 0x19, 0x6d, 0x85, 0xbe, 0xfb, 0x90, 0x9b, 0xad, 0x2d, 0xa4,
-	0x97, 0xa7, 0x62, 0xc3, 0xb3, 0x3d, 0xd6, 0x8c, 0x76, 0x85, 0x58, 0xd6, 0xb5, 0x96, 0x8d, 0xce,
-	0x60, 0xdd, 0xdd, 0x6c, 0x61, 0xbc, 0xcc, 0xc3, 0x0e, 0x65, 0x18, 0xeb, 0xc4, 0x3d, 0xdf, 0xb7,
-	0x5a, 0x1b, 0x9b, 0xca, 0xf8, 0xe3, 0xf0, 0xea, 0xb2, 0x8f, 0xc3, 0x04, 0xe8, 0x92, 0xd8, 0xf8,
-	0x12, 0xf4, 0x2d, 0xec, 0x62, 0x43, 0xbd, 0xea, 0xe1, 0xe0, 0x7b, 0x81, 0x62, 0xe3, 0x5e, 0x47,
-	0xb1, 0x70, 0xbf, 0x37, 0x12, 0xf8, 0x05, 0x45, 0xed, 0xc8, 0x34, 0x7b, 0x6e, 0x74, 0x3b, 0x2e,
-	0xc0, 0xe4, 0x6a, 0xdb, 0xc0, 0xbd, 0x8e, 0x44, 0x8c, 0xd1, 0x15, 0xec, 0xcd, 0x43, 0xd7, 0xae,
-	0x7a, 0xe4, 0x4d, 0xc4, 0x75, 0xb0, 0xb6, 0xd4, 0xc1, 0xb3, 0x19, 0x07, 0x2e, 0x80, 0xeb, 0x43,
-	0x06, 0x21, 0x44, 0x15, 0xdd, 0x11, 0x98, 0xbc, 0x63, 0xd8, 0xf4, 0xaf, 0xdb, 0x25, 0xb9, 0xdd,
-	0x0c, 0x70, 0x35, 0x7e, 0x3b, 0xb1, 0x27, 0x95, 0x61, 0x0a, 0x71, 0xfd, 0xa1, 0x95, 0x21, 0x88,
-	0x96, 0xfd, 0x25, 0xa4, 0x43, 0x5b, 0x6e, 0xea, 0x56, 0x1a, 0x79, 0xfc, 0xad, 0x34, 0xf7, 0xe7,
-	0x28, 0x40, 0x69, 0x60, 0x3b, 0xa6, 0x5e, 0x56, 0x1d, 0x95, 0x74, 0xb8, 0x1b, 0x3c, 0x52, 0xe8,
-	0x9f, 0x2f, 0x5e, 0x87, 0xbb, 0xc1, 0x23, 0xfa, 0xc7, 0x04, 0x02, 0xf6, 0x06, 0x8f, 0x5e, 0xfb,
-	0x7f, 0xb0, 0x91, 0x67, 0x4f, 0x56, 0xf0, 0x3e, 0xcf, 0xd0, 0x67, 0x4f, 0xf6, 0xc6, 0xfb, 0x36,
-	0x43, 0x9f, 0x3d, 0xd9, 0x5b, 0xef, 0xcf, 0x33, 0xfa, 0xec, 0xc9, 0x0e, 0x69, 0x91, 0x74, 0x65,
-	0x87, 0x53, 0x5d, 0x34, 0xf1, 0x01, 0xef, 0x06, 0xdc, 0xa3, 0xde, 0x0d, 0xf6, 0x81, 0x6d, 0xab,
-	0x8e, 0xea, 0x95, 0xb8, 0xf9, 0x77, 0x5d, 0xaa, 0x71, 0xf4, 0xf4, 0xd7, 0x3b, 0x6e, 0x7a, 0x4d,
-	0xab, 0x7b, 0x40, 0x9f, 0x0e, 0xae, 0xf0, 0x81, 0x9b, 0xe8, 0xab, 0x38, 0x35, 0x78, 0xf3, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0xa8, 0x34, 0xe6, 0x17, 0x22, 0x00, 0x00,
+	// 4161 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6f, 0xe3, 0x48,
+	0x72, 0x1f, 0x89, 0xd4, 0x57, 0xc9, 0x92, 0xe8, 0xb6, 0x3d, 0x23, 0x6b, 0xbe, 0xbc, 0xda, 0x9d,
+	0x3b, 0x67, 0x72, 0xe3, 0x99, 0xf5, 0x8e, 0xf7, 0x2b, 0x09, 0xf6, 0x64, 0x89, 0xb6, 0xe5, 0x95,
+	0x25, 0x1d, 0x25, 0x79, 0x36, 0xc1, 0x05, 0x0c, 0x2d, 0xb6, 0x65, 0xae, 0x29, 0x52, 0x21, 0x29,
+	0x8f, 0x7d, 0xf9, 0x07, 0xf2, 0x07, 0x1c, 0x10, 0x04, 0x08, 0x12, 0x20, 0xaf, 0x41, 0x1e, 0x82,
+	0xbc, 0xe5, 0x0f, 0x08, 0xf2, 0x94, 0x00, 0x79, 0x4b, 0x80, 0x04, 0x79, 0xd9, 0x87, 0x7b, 0xc8,
+	0xff, 0x10, 0xf4, 0x07, 0x25, 0x52, 0x1f, 0x23, 0x79, 0x26, 0x73, 0xb3, 0x2f, 0xb6, 0xba, 0xba,
+	0xfa, 0xd7, 0xd5, 0x55, 0xd5, 0xd5, 0xd5, 0xc5, 0x86, 0xf4, 0xc0, 0xb8, 0x1e, 0x3a, 0x3b, 0x03,
+	0xc7, 0xf6, 0x6c, 0x94, 0x63, 0x8d, 0x33, 0xbc, 0xe3, 0x76, 0x2f, 0x70, 0x5f, 0x2b, 0x6c, 0xf6,
+	0x6c, 0xbb, 0x67, 0xe2, 0xe7, 0xb4, 0xfb, 0x6c, 0x78, 0xfe, 0x5c, 0xb3, 0x6e, 0x18, 0x6f, 0xe1,
+	0xd1, 0x64, 0x97, 0x3e, 0x74, 0x34, 0xcf, 0xb0, 0x2d, 0xde, 0xff, 0x78, 0xb2, 0xdf, 0x33, 0xfa,
+	0xd8, 0xf5, 0xb4, 0xfe, 0x60, 0x1e, 0xc0, 0x6b, 0x47, 0x1b, 0x0c, 0xb0, 0xe3, 0xb2, 0xfe, 0xe2,
+	0x3f, 0xad, 0x81, 0xd0, 0x34, 0xba, 0x68, 0x03, 0xe2, 0x03, 0xa3, 0xab, 0x1a, 0x7a, 0x3e, 0xb2,
+	0x15, 0xd9, 0x16, 0x94, 0xd8, 0xc0, 0xe8, 0x56, 0x75, 0xf4, 0x0c, 0xc4, 0x73, 0xc3, 0xc4, 0xf9,
+	0xbb, 0x5b, 0x91, 0xed, 0xf4, 0xee, 0xe6, 0xce, 0x84, 0xe8, 0x3b, 0x4d, 0xa3, 0xbb, 0x73, 0x60,
+	0x98, 0x58, 0xa1, 0x6c, 0xe8, 0x2b, 0x80, 0xae, 0x83, 0x35, 0x0f, 0xeb, 0xaa, 0xe7, 0xe6, 0x81,
+	0x0e, 0x2a, 0xec, 0x30, 0x11, 0x76, 0x7c, 0x11, 0x76, 0xda, 0xbe, 0x8c, 0x4a, 0x8a, 0x73, 0xb7,
+	0x5d, 0xf4, 0x7b, 0x90, 0xee, 0xdb, 0xba, 0x71, 0x6e, 0xb0, 0xb1, 0xe9, 0x85, 0x63, 0xc1, 0x67,
+	0x6f, 0xbb, 0xa8, 0x06, 0x39, 0x1d, 0x9b, 0x98, 0x28, 0x46, 0x75, 0x3d, 0xcd, 0x1b, 0xba, 0xf9,
+	0x15, 0x0a, 0xf0, 0xf1, 0x4c, 0x89, 0x2b, 0x9c, 0xb7, 0x45, 0x59, 0x95, 0xac, 0x1e, 0x6a, 0xa3,
+	0x87, 0x00, 0x57, 0x06, 0x7e, 0xad, 0x76, 0xed, 0xa1, 0xe5, 0xe5, 0xb3, 0x54, 0x1f, 0x29, 0x42,
+	0x29, 0x13, 0x02, 0xfa, 0x02, 0xe2, 0xae, 0x3d, 0x74, 0xba, 0x38, 0x9f, 0xdb, 0x12, 0xb6, 0xd3,
+	0xbb, 0x8f, 0xe7, 0x6a, 0xa5, 0x45, 0xd9, 0x14, 0xce, 0x8e, 0xee, 0x41, 0xe2, 0xca, 0xf6, 0xb0,
+	0x3a, 0x1c, 0xe4, 0x57, 0x29, 0x68, 0x9c, 0x34, 0x3b, 0x03, 0x74, 0x1f, 0x52, 0xb4, 0x43, 0xb7,
+	0x5f, 0x5b, 0x79, 0x44, 0xbb, 0x92, 0x84, 0x50, 0xb1, 0x5f, 0x5b, 0xe8, 0x39, 0x08, 0xf8, 0xda,
+	0xcb, 0xaf, 0xd1, 0xb9, 0x1e, 0xce, 0x9c, 0x4b, 0xbe, 0xf6, 0x64, 0xcb, 0x73, 0x6e, 0x14, 0xc2,
+	0x89, 0xbe, 0x80, 0x94, 0x77, 0x31, 0xec, 0x9f, 0x59, 0x9a, 0x61, 0xe6, 0x37, 0xe8, 0xb0, 0x37,
+	0x18, 0x6e, 0xcc, 0x8b, 0x3e, 0x83, 0x84, 0x8e, 0x1d, 0xe3, 0x0a, 0xeb, 0xf9, 0x7b, 0x8b, 0x86,
+	0xf9, 0x9c, 0xa8, 0x01, 0x92, 0x3e, 0x1c, 0x98, 0x46, 0x57, 0xf3, 0xb0, 0xea, 0x60, 0xa2, 0xa6,
+	0x7c, 0x9e, 0xea, 0xfe, 0x93, 0xd9, 0xba, 0xf7, 0x99, 0x15, 0xca, 0xab, 0xe4, 0xf4, 0x30, 0x01,
+	0xed, 0x43, 0xda, 0xc1, 0xae, 0x67, 0x33, 0x3f, 0xcf, 0x6f, 0x52, 0x49, 0xb6, 0x66, 0x62, 0x29,
+	0x63, 0x3e, 0x25, 0x38, 0x08, 0x7d, 0x43, 0x2c, 0xe8, 0x1a, 0x67, 0x86, 0x69, 0x78, 0x37, 0xf9,
+	0x02, 0x15, 0x67, 0xb6, 0x99, 0x4e, 0x47, 0x6c, 0x4a, 0x60, 0x48, 0xe1, 0x3f, 0x04, 0xc8, 0x86,
+	0xbd, 0x04, 0x1d, 0xc0, 0x6a, 0x5f, 0x73, 0x2e, 0xb1, 0xae, 0x52, 0x77, 0x61, 0x6e, 0x1a, 0x59,
+	0xe8, 0xa6, 0x39, 0x36, 0xa8, 0xc2, 0xc6, 0xb4, 0x5d, 0x74, 0x04, 0x68, 0x80, 0x2d, 0xdd, 0xb0,
+	0x7a, 0x41, 0xa0, 0xe8, 0x42, 0x20, 0x89, 0x8f, 0x1a, 0x23, 0x1d, 0xc0, 0xaa, 0xd6, 0xf5, 0x86,
+	0x9a, 0x19, 0x04, 0x12, 0x16, 0x4b, 0xc4, 0x06, 0x8d, 0x71, 0xf2, 0xc4, 0xee, 0x9e, 0x66, 0x98,
+	0x6e, 0x5e, 0xdc, 0x8a, 0x6c, 0xa7, 0x14, 0xbf, 0x89, 0xf6, 0x21, 0xee, 0x60, 0xcd, 0xb5, 0xad,
+	0x7c, 0x6c, 0x2b, 0xb2, 0x9d, 0xdd, 0x7d, 0xba, 0xc4, 0x76, 0xda, 0x51, 0xe8, 0x08, 0x85, 0x8f,
+	0x44, 0x0f, 0x20, 0xe5, 0xe1, 0xfe, 0xc0, 0x76, 0x34, 0xe7, 0x26, 0x1f, 0xdf, 0x8a, 0x6c, 0x27,
+	0x95, 0x31, 0x01, 0x3d, 0x83, 0xb5, 0xb1, 0xfb, 0xd8, 0xe7, 0x2a, 0x0f, 0x42, 0x09, 0xba, 0x09,
+	0xc6, 0x9e, 0xd5, 0x38, 0x6f, 0x92, 0x78, 0x54, 0xdc, 0x87, 0x38, 0x83, 0x47, 0x69, 0x48, 0x74,
+	0xea, 0xdf, 0xd6, 0x1b, 0xaf, 0xea, 0xd2, 0x1d, 0x94, 0x04, 0xb1, 0xde, 0xa8, 0xcb, 0x52, 0x04,
+	0x21, 0xc8, 0x2a, 0x9d, 0x9a, 0xac, 0x9e, 0x56, 0x1b, 0xb5, 0x52, 0xbb, 0xda, 0xa8, 0x4b, 0x51,
+	0x94, 0x81, 0x54, 0xa5, 0xd3, 0xac, 0x55, 0xcb, 0xa5, 0xb6, 0x2c, 0x09, 0x85, 0xbf, 0x8d, 0x00,
+	0x8c, 0x77, 0x27, 0x92, 0x40, 0x18, 0x3a, 0x26, 0xb5, 0x64, 0x4a, 0x21, 0x3f, 0x51, 0x01, 0x92,
+	0x0e, 0x3e, 0xc7, 0x8e, 0x83, 0x1d, 0x6a, 0x97, 0x94, 0x32, 0x6a, 0x4f, 0x44, 0x38, 0xe1, 0x36,
+	0x11, 0xee, 0x1e, 0x24, 0x86, 0x2e, 0x76, 0xc8, 0xf2, 0x44, 0xb6, 0xfd, 0x49, 0xb3, 0xaa, 0x23,
+	0x04, 0xa2, 0xa5, 0xf5, 0x31, 0xd5, 0x71, 0x4a, 0xa1, 0xbf, 0x0b, 0x35, 0x48, 0xfa, 0xbb, 0x9a,
+	0x48, 0x78, 0x89, 0x6f, 0x7c, 0x09, 0x2f, 0xf1, 0x0d, 0x7a, 0x0a, 0xb1, 0x2b, 0xcd, 0x1c, 0x62,
+	0xee, 0x36, 0xeb, 0x53, 0x02, 0x94, 0xac, 0x1b, 0x85, 0xb1, 0x7c, 0x1d, 0xfd, 0x32, 0x52, 0xf8,
+	0x47, 0x01, 0x44, 0xb2, 0x64, 0xb4, 0x0e, 0x31, 0xc3, 0xd2, 0xf1, 0xb5, 0x1f, 0xe5, 0x69, 0x83,
+	0x08, 0xe0, 0x1a, 0xbf, 0x62, 0x68, 0x82, 0x42, 0x7f, 0xa3, 0x5d, 0x10, 0xfb, 0x46, 0x1f, 0xd3,
+	0x25, 0x66, 0x77, 0x1f, 0xcd, 0x8d, 0x04, 0x3b, 0x27, 0x46, 0x1f, 0x2b, 0x94, 0x97, 0xa0, 0xbf,
+	0x36, 0x74, 0xef, 0x82, 0xaf, 0x8f, 0x35, 0xd0, 0x5d, 0x88, 0x5f, 0x60, 0xa3, 0x77, 0xe1, 0xd1,
+	0x05, 0x0a, 0x0a, 0x6f, 0x4d, 0xa8, 0x32, 0xfe, 0x0e, 0x87, 0x45, 0xe2, 0x56, 0x87, 0x85, 0x0c,
+	0x59, 0xcd, 0x32, 0xfa, 0x34, 0x52, 0xa8, 0x86, 0x75, 0x6e, 0xe7, 0x93, 0x74, 0xfc, 0xf4, 0x1a,
+	0x4b, 0x3e, 0x5b, 0xd5, 0x3a, 0xb7, 0x95, 0x8c, 0x16, 0x6c, 0x16, 0xff, 0x04, 0x44, 0xb2, 0xf4,
+	0x29, 0x47, 0x3c, 0x6e, 0xca, 0x87, 0x52, 0x04, 0x25, 0x40, 0x38, 0xac, 0x1e, 0x48, 0x51, 0xf2,
+	0xa3, 0x59, 0x3f, 0x94, 0x04, 0xd2, 0xf7, 0x4a, 0xde, 0x3f, 0x91, 0x44, 0x42, 0x3a, 0x69, 0xbe,
+	0x94, 0x62, 0x9c, 0xd4, 0x94, 0xe2, 0xe4, 0x57, 0xe9, 0xb4, 0x7a, 0x20, 0x25, 0xc8, 0xaf, 0x23,
+	0xb9, 0x5a, 0x96, 0x92, 0x85, 0x2b, 0xc8, 0x4d, 0x44, 0x4b, 0xb4, 0x0d, 0x92, 0x3b, 0x74, 0x07,
+	0xb8, 0x4b, 0xb4, 0x36, 0x3a, 0xb0, 0x85, 0x6d, 0x41, 0xc9, 0x8e, 0xe8, 0x74, 0xa7, 0x4c, 0x68,
+	0x37, 0x7a, 0x0b, 0xed, 0x16, 0xfe, 0x33, 0x02, 0xe9, 0x40, 0x68, 0x0d, 0x3a, 0x6e, 0x24, 0xe4,
+	0xb8, 0x81, 0xc0, 0x11, 0x0d, 0x07, 0x8e, 0x77, 0xd8, 0x26, 0x7f, 0x0c, 0xf9, 0x01, 0x39, 0x47,
+	0xec, 0xa1, 0xab, 0x4e, 0x1e, 0xea, 0xe2, 0xf2, 0x87, 0xfa, 0x5d, 0x1f, 0x24, 0x4c, 0x2f, 0xfc,
+	0x65, 0x04, 0x60, 0x1c, 0xf4, 0xd1, 0x97, 0x20, 0xf6, 0x6d, 0x1d, 0xd3, 0x85, 0x65, 0xe7, 0x1c,
+	0x59, 0x63, 0xf6, 0x9d, 0x13, 0x5b, 0x27, 0xce, 0x6e, 0xeb, 0x38, 0xa8, 0x95, 0x28, 0xb5, 0x80,
+	0xaf, 0x95, 0x7b, 0x90, 0x70, 0x6c, 0x13, 0x93, 0x0e, 0x81, 0x75, 0x90, 0x66, 0x55, 0x2f, 0x3e,
+	0x06, 0x91, 0x8c, 0x47, 0x00, 0xf1, 0x66, 0x67, 0xbf, 0x56, 0x2d, 0x4b, 0x77, 0x88, 0xf7, 0x34,
+	0x95, 0xea, 0x29, 0x89, 0x4c, 0x91, 0x63, 0x31, 0x19, 0x95, 0x84, 0x63, 0x31, 0x29, 0x48, 0xe2,
+	0xb1, 0x98, 0x14, 0xa5, 0xd8, 0xb1, 0x98, 0x8c, 0x49, 0xf1, 0x63, 0x31, 0x99, 0x92, 0xe0, 0x58,
+	0x4c, 0x66, 0xa4, 0xec, 0xb1, 0x98, 0x94, 0xa4, 0xd5, 0x63, 0x31, 0xb9, 0x2e, 0x6d, 0x14, 0x7f,
+	0x88, 0x42, 0x92, 0x5a, 0x1b, 0x5b, 0xde, 0xbc, 0x0c, 0x6e, 0x17, 0x44, 0xef, 0x66, 0xc0, 0xf6,
+	0xf6, 0x9c, 0x7d, 0x4c, 0xc7, 0xef, 0xb4, 0x6f, 0x06, 0x58, 0xa1, 0xbc, 0x64, 0x1f, 0xb3, 0xf0,
+	0x42, 0x0c, 0xb7, 0xc2, 0x03, 0x09, 0xfa, 0x18, 0xd2, 0x7a, 0xd7, 0x7b, 0xa1, 0xd2, 0x16, 0xb1,
+	0x85, 0xb0, 0x1d, 0xdd, 0x8f, 0x4a, 0x11, 0x05, 0x08, 0xf9, 0x94, 0x52, 0xd1, 0x4b, 0x96, 0xad,
+	0xc4, 0xe8, 0xa9, 0x5d, 0x9c, 0x3f, 0x5b, 0x28, 0x65, 0xf9, 0xff, 0x8d, 0x76, 0xc5, 0x06, 0x88,
+	0x64, 0x31, 0x53, 0x3b, 0xb3, 0x75, 0x54, 0xfa, 0x94, 0x6d, 0xc8, 0x93, 0xca, 0x9e, 0x24, 0xa0,
+	0x14, 0xc4, 0x2a, 0xe5, 0xb6, 0xfa, 0x42, 0x12, 0x51, 0x16, 0xa0, 0x75, 0x54, 0xda, 0xfb, 0x74,
+	0x57, 0xdd, 0xdd, 0xfb, 0x5c, 0x8a, 0x15, 0xc5, 0x64, 0x44, 0x8a, 0x3c, 0x8d, 0xb7, 0x8e, 0x4a,
+	0xbb, 0x7b, 0x9f, 0x17, 0x0f, 0x20, 0x13, 0x0a, 0x05, 0x68, 0x0f, 0x92, 0x7e, 0x22, 0xce, 0x53,
+	0x80, 0xcd, 0x29, 0xa1, 0x2a, 0x9c, 0x41, 0x19, 0xb1, 0x16, 0xff, 0x25, 0x0a, 0x42, 0x5b, 0xeb,
+	0x11, 0x53, 0x79, 0x5a, 0x2f, 0x60, 0x2a, 0x4f, 0xeb, 0x05, 0xce, 0x81, 0xe8, 0xf8, 0x1c, 0x40,
+	0x8f, 0x21, 0x3d, 0x74, 0xb5, 0x1e, 0xe6, 0xc9, 0xa8, 0x40, 0xf9, 0x81, 0x92, 0x58, 0x36, 0xfa,
+	0xa1, 0xa2, 0x28, 0x4f, 0x4b, 0x93, 0x73, 0xd2, 0xd2, 0xb6, 0xd6, 0x7b, 0xaf, 0x36, 0xfe, 0xe7,
+	0x08, 0x24, 0xdb, 0x5a, 0xaf, 0x64, 0x1a, 0x9a, 0x3b, 0x52, 0x5c, 0x24, 0xa0, 0xb8, 0xb1, 0x8e,
+	0xa3, 0x41, 0x1d, 0x07, 0x76, 0xad, 0x10, 0x8a, 0x65, 0x61, 0x3d, 0x8a, 0xef, 0xa0, 0xc7, 0xd8,
+	0x6d, 0xf4, 0x58, 0xfc, 0xef, 0x08, 0x64, 0xdb, 0x5a, 0xaf, 0xda, 0x67, 0x81, 0x9e, 0xc4, 0xdb,
+	0x39, 0xee, 0xf1, 0x09, 0x64, 0x0d, 0xc2, 0x45, 0x66, 0x09, 0xae, 0x6c, 0x85, 0x53, 0xdb, 0x3f,
+	0xce, 0x05, 0xfe, 0x57, 0x14, 0xe2, 0x4d, 0xa3, 0xcb, 0xfd, 0x7e, 0x56, 0x88, 0x9a, 0x63, 0x2a,
+	0xdf, 0xaa, 0x42, 0xc0, 0xaa, 0x81, 0xd5, 0x25, 0xdf, 0xb0, 0xba, 0xdf, 0xde, 0x36, 0xd8, 0x65,
+	0xdb, 0x20, 0x35, 0xff, 0x96, 0xf2, 0xbe, 0x77, 0xc2, 0xbf, 0x09, 0x00, 0x4d, 0xa3, 0x5b, 0xb6,
+	0xfb, 0xfd, 0x37, 0x1c, 0x03, 0x0f, 0x01, 0xba, 0x8c, 0x63, 0xac, 0xe7, 0x14, 0xa7, 0x54, 0x75,
+	0xf4, 0x14, 0x56, 0xfd, 0xee, 0x81, 0xe6, 0x70, 0x2e, 0xe6, 0x3f, 0x39, 0xde, 0xd1, 0xa4, 0xf4,
+	0xb0, 0x87, 0x4d, 0xe5, 0xb1, 0x1e, 0x51, 0x46, 0x82, 0x19, 0x8c, 0xfc, 0x0e, 0xde, 0x79, 0x53,
+	0xf3, 0xef, 0xbc, 0x30, 0x71, 0xe7, 0x0d, 0x5b, 0x33, 0xf6, 0x0e, 0xd6, 0x8c, 0xdf, 0xca, 0x9a,
+	0x9f, 0x07, 0x83, 0xda, 0xcc, 0x64, 0x80, 0xab, 0xf9, 0xbd, 0x5a, 0xf4, 0x1f, 0x04, 0x48, 0x34,
+	0x8d, 0xee, 0xa9, 0xed, 0xe1, 0x79, 0xe6, 0x0c, 0x25, 0x1f, 0x41, 0x1b, 0x8c, 0x12, 0xfc, 0x44,
+	0x30, 0xc1, 0xff, 0x14, 0x44, 0xa2, 0x5b, 0x9e, 0xcc, 0xcf, 0x2c, 0x22, 0x90, 0xd9, 0x76, 0xc8,
+	0x1f, 0x85, 0xb2, 0x7e, 0xa8, 0x70, 0x81, 0x3e, 0x63, 0x26, 0x88, 0x53, 0x13, 0x7c, 0x34, 0x57,
+	0xd2, 0xf7, 0xa9, 0xff, 0x5d, 0x10, 0xa9, 0xee, 0x43, 0xf9, 0x43, 0x1c, 0xa2, 0x9d, 0xa6, 0x14,
+	0x21, 0x79, 0x44, 0x85, 0x50, 0xa2, 0xa4, 0xbb, 0x2e, 0x77, 0xda, 0x4a, 0xa9, 0x26, 0x09, 0xc5,
+	0x1f, 0x04, 0xc8, 0x8e, 0xdd, 0xe3, 0x4d, 0xa6, 0x5b, 0xb0, 0x13, 0xe7, 0xc6, 0xef, 0x91, 0x65,
+	0xc5, 0xa0, 0x65, 0xbf, 0xe4, 0x96, 0x8d, 0xcd, 0xcf, 0x5f, 0x03, 0x32, 0xcd, 0x37, 0xf0, 0x6f,
+	0x2f, 0x62, 0x7e, 0x1d, 0xdc, 0x63, 0xdb, 0x8b, 0x04, 0xfe, 0xb1, 0xd9, 0xf9, 0xcf, 0x33, 0x90,
+	0xea, 0xb8, 0xd8, 0x91, 0xaf, 0x48, 0xb0, 0x9d, 0x7b, 0x33, 0x1a, 0x19, 0x2b, 0x1a, 0x34, 0xd6,
+	0x3b, 0xdc, 0x8a, 0x26, 0x54, 0x2e, 0xde, 0x4a, 0xe5, 0x97, 0x90, 0xb7, 0x87, 0x5e, 0xcf, 0x36,
+	0xac, 0x9e, 0x3a, 0x1c, 0xb8, 0xd8, 0xf1, 0xe8, 0xdd, 0x71, 0xe4, 0x38, 0xe9, 0xdd, 0x17, 0x53,
+	0x76, 0x18, 0x2d, 0x72, 0xa7, 0xc1, 0x87, 0x76, 0xe8, 0x48, 0xbe, 0x01, 0x8f, 0xee, 0x28, 0x1b,
+	0xf6, 0xac, 0x0e, 0x32, 0x99, 0x61, 0x75, 0xed, 0xfe, 0xac, 0xc9, 0xe2, 0x0b, 0x27, 0xab, 0xf2,
+	0xa1, 0x53, 0x93, 0x19, 0xb3, 0x3a, 0x90, 0x06, 0xeb, 0xa3, 0x95, 0x91, 0x59, 0xf8, 0x3e, 0xe2,
+	0x2e, 0xf9, 0x6c, 0x89, 0x55, 0x8d, 0xfd, 0xed, 0xe8, 0x8e, 0x82, 0xec, 0x29, 0x2a, 0x99, 0x62,
+	0xb4, 0x9e, 0xe0, 0x14, 0xc9, 0x85, 0x53, 0xf8, 0x6b, 0x09, 0x4f, 0x61, 0x4c, 0x51, 0x91, 0x0c,
+	0x30, 0xd6, 0x14, 0x3d, 0x27, 0x67, 0x9d, 0x3e, 0x63, 0xe0, 0x91, 0x0e, 0x8e, 0xee, 0x28, 0xa9,
+	0xa1, 0xdf, 0x40, 0x0a, 0xe4, 0x1c, 0xdc, 0xb7, 0xaf, 0x30, 0x95, 0xd3, 0xd3, 0x7a, 0x7e, 0x09,
+	0x7e, 0xfb, 0x0d, 0x58, 0x0a, 0x1d, 0xc1, 0xf2, 0x14, 0xf7, 0xe8, 0x8e, 0x92, 0x71, 0x82, 0x04,
+	0x74, 0x04, 0xe9, 0xe1, 0x40, 0xa7, 0xb5, 0x5d, 0xdb, 0xc4, 0xbc, 0x2c, 0xff, 0xe4, 0x8d, 0xb2,
+	0x11, 0x6e, 0xc5, 0x36, 0x89, 0xd5, 0x60, 0x38, 0x6a, 0xa1, 0xef, 0x60, 0x95, 0x23, 0xd1, 0x2d,
+	0x43, 0xe0, 0xfc, 0x2a, 0xfd, 0xd3, 0x85, 0x78, 0xa4, 0x4d, 0x50, 0x88, 0x84, 0xb9, 0x61, 0x98,
+	0x54, 0xd8, 0x81, 0x8d, 0x99, 0x3e, 0x3a, 0x27, 0x02, 0x17, 0x4e, 0x61, 0x63, 0xa6, 0x9b, 0xa1,
+	0x9f, 0x40, 0xce, 0x1d, 0x9e, 0x7d, 0x8f, 0xbb, 0x9e, 0x1a, 0xde, 0xd6, 0x19, 0x4e, 0xee, 0xb0,
+	0xdd, 0x3d, 0xc6, 0x8d, 0x06, 0x71, 0x8f, 0x01, 0x4d, 0x7b, 0xd5, 0x44, 0xbc, 0x8f, 0x4c, 0xc6,
+	0xfb, 0xf9, 0x58, 0xd3, 0xee, 0xf3, 0x96, 0x58, 0x45, 0x48, 0x8d, 0xd6, 0x39, 0x4f, 0x27, 0x25,
+	0xc8, 0x84, 0x3c, 0x61, 0xde, 0xe9, 0xb5, 0x09, 0x49, 0x92, 0xab, 0xf3, 0x7b, 0xaa, 0xb0, 0x9d,
+	0x52, 0x12, 0x9e, 0xd6, 0xab, 0x6b, 0x7d, 0x5c, 0xf8, 0x4d, 0x04, 0x60, 0x6c, 0xfd, 0x60, 0x19,
+	0x84, 0xc7, 0x46, 0x56, 0x06, 0x99, 0x79, 0xcd, 0xcd, 0x43, 0x82, 0xc7, 0x3a, 0x1a, 0x16, 0x93,
+	0x8a, 0xdf, 0x44, 0x87, 0x90, 0x75, 0xb1, 0xa7, 0x76, 0xb5, 0x81, 0xc6, 0xcb, 0xf9, 0xe2, 0x96,
+	0xb0, 0x9d, 0x9d, 0x91, 0x6b, 0x13, 0xe3, 0xec, 0x94, 0x47, 0x7c, 0x4a, 0xc6, 0xc5, 0xde, 0xb8,
+	0x89, 0xbe, 0x05, 0xa9, 0x6b, 0x62, 0xcd, 0x09, 0x42, 0xc5, 0x96, 0x84, 0xca, 0xd1, 0x91, 0x63,
+	0x42, 0xe1, 0xcf, 0x20, 0x37, 0xe1, 0x98, 0xe4, 0x7a, 0x66, 0xcf, 0xf2, 0x9d, 0x15, 0x3b, 0xe8,
+	0x3a, 0x8f, 0x20, 0x4d, 0x96, 0xe3, 0x6b, 0x86, 0x55, 0x8e, 0x52, 0x2e, 0xf6, 0x14, 0xa6, 0x9c,
+	0x22, 0x64, 0x98, 0x94, 0xe1, 0x12, 0x52, 0x9a, 0x12, 0x19, 0xcf, 0x7e, 0x0c, 0x04, 0x7c, 0xe5,
+	0x15, 0xff, 0x67, 0x05, 0x44, 0x82, 0x3a, 0xff, 0x14, 0xba, 0x0b, 0x71, 0x17, 0x77, 0x1d, 0xec,
+	0x51, 0x5d, 0xaf, 0x28, 0xbc, 0x45, 0x4f, 0x27, 0x1d, 0xf3, 0x72, 0x42, 0x4a, 0x61, 0x8d, 0x0f,
+	0x96, 0xf1, 0xfd, 0x3e, 0xac, 0x98, 0x9a, 0xeb, 0xa9, 0x2e, 0xc6, 0xd6, 0x92, 0x29, 0x3b, 0xe1,
+	0x6f, 0x61, 0x6c, 0xb5, 0x5d, 0xf4, 0x73, 0x80, 0x80, 0x41, 0x13, 0x4b, 0x1a, 0x34, 0x30, 0x26,
+	0xe8, 0xa8, 0xe9, 0x60, 0xbd, 0x8e, 0xd8, 0xc2, 0xc2, 0xd7, 0x9e, 0xea, 0xd9, 0x97, 0xd8, 0x1a,
+	0x5f, 0x39, 0xd3, 0x84, 0xd8, 0x26, 0x34, 0x76, 0xef, 0xa4, 0xba, 0xa7, 0x3c, 0xfc, 0x1a, 0x58,
+	0x98, 0x39, 0x3d, 0x1d, 0xa1, 0xa4, 0x86, 0xfe, 0x4f, 0xf4, 0x04, 0x72, 0x14, 0x5e, 0x1b, 0x18,
+	0xea, 0x25, 0xbe, 0x21, 0x13, 0xac, 0x30, 0x8f, 0x21, 0xe4, 0xd2, 0xc0, 0xf8, 0x16, 0xdf, 0x54,
+	0x75, 0xf4, 0x12, 0x12, 0x9c, 0x23, 0x9f, 0xa1, 0xf0, 0xf7, 0x67, 0xc2, 0x33, 0x7e, 0x25, 0xae,
+	0xd1, 0xff, 0xe8, 0x19, 0x88, 0x9e, 0xed, 0x0d, 0xe8, 0xd7, 0xcb, 0x59, 0x1f, 0xf2, 0x98, 0x44,
+	0xde, 0x40, 0xa1, 0x6c, 0xe8, 0x05, 0x4b, 0xca, 0x80, 0x4e, 0xf0, 0x68, 0xb6, 0xfa, 0xde, 0x67,
+	0x2a, 0xf6, 0xeb, 0x04, 0x40, 0x60, 0xaf, 0x86, 0x32, 0xb2, 0x2c, 0x40, 0xb3, 0x5a, 0x56, 0xcb,
+	0x8a, 0x4c, 0xab, 0xa4, 0x68, 0x05, 0x92, 0xa4, 0xad, 0xc8, 0xa5, 0x0a, 0xfb, 0xb8, 0x43, 0x5a,
+	0xd5, 0x7a, 0x45, 0xfe, 0x4e, 0x12, 0xd0, 0x1a, 0xe4, 0x48, 0xb3, 0xd5, 0x38, 0x68, 0xab, 0x15,
+	0xb9, 0x26, 0xb7, 0x65, 0x29, 0xe6, 0x13, 0x8f, 0x4a, 0x4a, 0xc5, 0x27, 0xc6, 0xfd, 0x81, 0xcd,
+	0x8e, 0x72, 0x28, 0x4b, 0x09, 0x74, 0x1f, 0xee, 0x91, 0x66, 0xa7, 0x59, 0x29, 0xb5, 0x65, 0xf5,
+	0xb4, 0x2a, 0xbf, 0x52, 0xcb, 0x8d, 0x4e, 0xbd, 0x2d, 0x2b, 0x52, 0x12, 0x21, 0xc8, 0x92, 0xce,
+	0x76, 0xe9, 0xd0, 0x17, 0x23, 0x85, 0xee, 0x02, 0xa2, 0x62, 0x35, 0x4e, 0x4e, 0xe4, 0x7a, 0xdb,
+	0xa7, 0x83, 0x3f, 0xd9, 0x69, 0xa3, 0x2d, 0xfb, 0xc4, 0x34, 0xca, 0x41, 0xba, 0xd3, 0x92, 0x15,
+	0x9f, 0x20, 0xa2, 0x02, 0xdc, 0xa5, 0x04, 0x3e, 0x5f, 0xb9, 0xd4, 0x2c, 0xed, 0x57, 0x6b, 0xd5,
+	0xf6, 0x1f, 0x4a, 0x2b, 0x64, 0x36, 0xda, 0x47, 0x56, 0xa8, 0xb6, 0xe4, 0xda, 0x81, 0x94, 0x41,
+	0xab, 0x90, 0x19, 0xd3, 0x4a, 0xb5, 0x9a, 0x94, 0x45, 0x79, 0x58, 0x27, 0x13, 0xc9, 0xdf, 0xb5,
+	0xe5, 0x7a, 0xab, 0xda, 0xa8, 0xfb, 0xe0, 0x39, 0x5f, 0xb4, 0x71, 0x0f, 0xd5, 0x95, 0x84, 0xb6,
+	0xe0, 0x41, 0x50, 0xe4, 0xa9, 0x91, 0xab, 0xe8, 0x11, 0x14, 0x66, 0x73, 0x50, 0x04, 0x84, 0x1e,
+	0x40, 0xde, 0x57, 0xc4, 0xd4, 0xe8, 0x35, 0xb2, 0xa8, 0xe9, 0x5e, 0x3a, 0x72, 0x1d, 0x3d, 0x84,
+	0xcd, 0x91, 0x5a, 0xa6, 0x86, 0x6e, 0xf8, 0xea, 0x9f, 0xe8, 0xa6, 0x63, 0xef, 0xa2, 0x75, 0x90,
+	0xc6, 0x8b, 0xe7, 0xa5, 0xf3, 0x7b, 0x61, 0x35, 0x35, 0xab, 0xe5, 0x96, 0x94, 0x47, 0x1b, 0xb0,
+	0x1a, 0xa2, 0x11, 0x59, 0xa4, 0x4d, 0xb4, 0x09, 0x1b, 0x61, 0x32, 0x5f, 0xa0, 0x54, 0x20, 0xba,
+	0x0a, 0x77, 0x11, 0x11, 0xa4, 0xfb, 0xbe, 0x40, 0xbe, 0x26, 0x82, 0xe6, 0x7c, 0x80, 0x9e, 0xc0,
+	0x47, 0x53, 0x9d, 0x53, 0x8b, 0x7a, 0x18, 0x74, 0x1b, 0xee, 0x76, 0x8f, 0xc8, 0x5a, 0x48, 0xbb,
+	0x54, 0xab, 0x96, 0x5a, 0xdc, 0xfa, 0xd2, 0x63, 0xa2, 0x39, 0x42, 0xad, 0x9e, 0xb0, 0xcf, 0x94,
+	0x04, 0x85, 0xf7, 0x6d, 0xf9, 0x8e, 0x7a, 0x22, 0x13, 0x47, 0xfd, 0x88, 0xb8, 0x12, 0x73, 0xff,
+	0x56, 0xbb, 0xa1, 0xc8, 0x52, 0x11, 0xdd, 0x83, 0xb5, 0xfd, 0x52, 0xf9, 0xdb, 0x43, 0xa5, 0xd1,
+	0xa9, 0x57, 0xd4, 0xe3, 0xc6, 0x3e, 0x53, 0xdb, 0xc7, 0x64, 0xd5, 0x13, 0x1d, 0xe5, 0x52, 0xbd,
+	0x2c, 0xd7, 0xa4, 0x4f, 0x08, 0x88, 0xd2, 0xa8, 0xc9, 0xfe, 0x24, 0x4f, 0x88, 0x58, 0xfe, 0xa6,
+	0x52, 0xfd, 0x0f, 0x12, 0x3f, 0x29, 0xfe, 0x6f, 0x04, 0xc4, 0xdb, 0x1f, 0xe6, 0xe1, 0x90, 0x2c,
+	0xbc, 0x45, 0x48, 0xfe, 0x50, 0xb5, 0xca, 0x5f, 0x47, 0x60, 0x85, 0x1d, 0xd4, 0xd8, 0xf2, 0x88,
+	0x20, 0x77, 0x21, 0x6e, 0xb8, 0xee, 0x10, 0x3b, 0x3c, 0xb8, 0xf1, 0x16, 0xc9, 0x57, 0x78, 0x4a,
+	0xe8, 0x7f, 0xf9, 0xe2, 0xcd, 0xf7, 0x51, 0x7f, 0x2d, 0xfe, 0x10, 0x61, 0x97, 0x4e, 0x76, 0x6e,
+	0x90, 0x14, 0xcc, 0x3f, 0x91, 0x98, 0x31, 0x12, 0xde, 0xf8, 0x34, 0x7a, 0xcb, 0x8f, 0x7e, 0x53,
+	0xa7, 0xb0, 0x70, 0xab, 0x53, 0xf8, 0x21, 0x3f, 0x06, 0xb5, 0x1e, 0x49, 0x2b, 0xd8, 0x2b, 0x02,
+	0x7a, 0xd4, 0x95, 0x08, 0x01, 0x3d, 0x81, 0x2c, 0xb9, 0x56, 0x78, 0x58, 0xd5, 0x74, 0xdd, 0xc1,
+	0xae, 0xcb, 0xbf, 0x75, 0x67, 0x18, 0xb5, 0xc4, 0x88, 0xc5, 0xbf, 0x89, 0x02, 0x8c, 0xcf, 0x32,
+	0x92, 0x82, 0xf2, 0x73, 0x91, 0xa7, 0xa0, 0x97, 0xf4, 0x40, 0x9c, 0xe5, 0x72, 0xef, 0x70, 0xb3,
+	0xfe, 0x02, 0x52, 0xf8, 0x7a, 0x60, 0x38, 0x78, 0x39, 0xb3, 0x24, 0x19, 0xf3, 0x54, 0xe6, 0x11,
+	0x7b, 0x0b, 0x37, 0x7f, 0x01, 0xeb, 0x9a, 0x69, 0xda, 0xaf, 0xb1, 0xae, 0x72, 0xf5, 0x38, 0x9a,
+	0xd5, 0xc3, 0xb4, 0xf8, 0x95, 0x52, 0x10, 0xef, 0x53, 0x68, 0x97, 0x42, 0x7a, 0x8a, 0x7f, 0x15,
+	0x85, 0xa4, 0x7f, 0x74, 0x07, 0xd2, 0xbb, 0x48, 0x28, 0xbd, 0xfb, 0x0a, 0x00, 0x5b, 0xda, 0x99,
+	0xb9, 0xb4, 0x17, 0x70, 0xee, 0x36, 0x4d, 0x62, 0xa9, 0x17, 0x0c, 0x5d, 0xac, 0xab, 0xae, 0x87,
+	0x07, 0xdc, 0x89, 0xa9, 0x6f, 0x74, 0x5c, 0xac, 0xb7, 0x3c, 0x3c, 0x40, 0x3f, 0x03, 0xe4, 0xe0,
+	0xae, 0x7d, 0x85, 0x9d, 0x1b, 0xb5, 0x6b, 0xeb, 0x58, 0xbd, 0xd0, 0xdc, 0x0b, 0x9a, 0x97, 0xaf,
+	0x28, 0x92, 0xdf, 0x53, 0xb6, 0x75, 0x7c, 0xa4, 0xb9, 0x17, 0xe8, 0xa7, 0x90, 0x3b, 0xd7, 0x0c,
+	0x22, 0x8d, 0xe6, 0x79, 0xb8, 0x3f, 0xe0, 0xbb, 0x52, 0x50, 0xb2, 0x8c, 0x5c, 0xe2, 0x54, 0xf4,
+	0x73, 0x3e, 0x39, 0xe7, 0x5e, 0x2a, 0x15, 0xa4, 0x82, 0x1d, 0xd0, 0x01, 0x6d, 0xb7, 0xf8, 0x9b,
+	0x55, 0xc8, 0x94, 0x6d, 0xeb, 0xdc, 0xe8, 0xf1, 0x4f, 0x6e, 0xa8, 0x0a, 0xa8, 0x6f, 0x58, 0xfe,
+	0xa5, 0x5d, 0x35, 0xb1, 0xd5, 0xf3, 0x2e, 0xf8, 0x37, 0xbb, 0xfb, 0x53, 0xb8, 0x55, 0xcb, 0xfb,
+	0xfc, 0x25, 0xfd, 0x92, 0xa9, 0x48, 0x7d, 0xc3, 0xe2, 0xd7, 0xae, 0x1a, 0x1d, 0x44, 0xa1, 0xb4,
+	0xeb, 0x49, 0xa8, 0xe8, 0x32, 0x50, 0xda, 0x75, 0x18, 0x4a, 0x06, 0x02, 0xaf, 0xd2, 0xbc, 0xdb,
+	0x07, 0x12, 0x16, 0x03, 0x65, 0xfb, 0x86, 0x45, 0x43, 0x53, 0x00, 0x46, 0xbb, 0x0e, 0xc3, 0x88,
+	0xcb, 0xc0, 0x68, 0xd7, 0x41, 0x98, 0x1a, 0xac, 0x13, 0x69, 0xce, 0x0d, 0x13, 0xd3, 0x8b, 0x9d,
+	0x0f, 0x15, 0x5b, 0x0c, 0xb5, 0xda, 0x37, 0xac, 0x03, 0xc3, 0xc4, 0xe4, 0x02, 0x18, 0x40, 0xd3,
+	0xae, 0xa7, 0xd1, 0xe2, 0xcb, 0xa0, 0x69, 0xd7, 0x13, 0x68, 0x25, 0x20, 0x8b, 0x56, 0x87, 0x8e,
+	0xe9, 0xe3, 0x24, 0x16, 0xe3, 0xac, 0xf4, 0x0d, 0xab, 0xe3, 0x98, 0x01, 0x08, 0xed, 0x3a, 0x08,
+	0x91, 0x5c, 0x06, 0x42, 0xbb, 0x0e, 0x43, 0x18, 0x16, 0xfd, 0xec, 0xc6, 0x21, 0x52, 0xcb, 0x49,
+	0xd1, 0xd6, 0x7a, 0x61, 0x29, 0x02, 0x10, 0xb0, 0x9c, 0x14, 0x63, 0x08, 0x15, 0xd6, 0x35, 0xcb,
+	0xb6, 0x6e, 0xfa, 0xf6, 0xd0, 0x0d, 0xde, 0x62, 0x59, 0x51, 0xe6, 0x67, 0x53, 0xa1, 0x27, 0xb4,
+	0x13, 0x02, 0x31, 0xa8, 0x85, 0x3d, 0x65, 0x6d, 0x84, 0x14, 0x48, 0xbb, 0x7f, 0x09, 0x6b, 0x16,
+	0x7e, 0xcd, 0xee, 0xaf, 0x01, 0xfc, 0x95, 0xb7, 0xc0, 0x5f, 0xb5, 0xf0, 0x6b, 0x12, 0xa8, 0x02,
+	0xe8, 0x0a, 0xdc, 0xd3, 0xf1, 0xb9, 0x36, 0x34, 0x3d, 0xf5, 0xdc, 0xb0, 0x74, 0x95, 0xd6, 0x44,
+	0xd5, 0x81, 0xd1, 0x75, 0xf3, 0x99, 0xc5, 0xaa, 0x58, 0xe7, 0x63, 0x0f, 0x0c, 0x4b, 0xaf, 0x92,
+	0x91, 0x4d, 0xa3, 0xeb, 0xa2, 0x63, 0x58, 0x63, 0xce, 0x16, 0xc6, 0xcb, 0x2e, 0xb7, 0x29, 0xc3,
+	0x58, 0x87, 0x6c, 0x7f, 0x5f, 0x19, 0x3a, 0xb6, 0xd5, 0xd1, 0xe7, 0xfd, 0xdc, 0xa2, 0xcf, 0xfb,
+	0x04, 0xe8, 0x94, 0x8c, 0xf1, 0x29, 0xe8, 0x97, 0xf0, 0x90, 0x45, 0xd4, 0x60, 0xbd, 0x50, 0x75,
+	0xb1, 0x79, 0xae, 0x3a, 0x78, 0x60, 0xde, 0xe4, 0xa5, 0x39, 0x61, 0x6d, 0xdf, 0xb6, 0x4d, 0x26,
+	0xdd, 0x26, 0x03, 0x18, 0x97, 0x7e, 0x5a, 0xd8, 0x3c, 0x57, 0xc8, 0x60, 0x74, 0x06, 0x5b, 0xb3,
+	0xd0, 0x8d, 0x33, 0xd3, 0xb0, 0x7a, 0x7c, 0x82, 0xd5, 0x85, 0x13, 0x3c, 0x98, 0x9a, 0x80, 0x01,
+	0xb0, 0x39, 0xda, 0x90, 0x0f, 0x99, 0x8a, 0x7a, 0x04, 0xbe, 0xc2, 0x96, 0xe7, 0xd2, 0xf7, 0xa9,
+	0x0b, 0x74, 0xbb, 0x11, 0xb0, 0xd5, 0xa8, 0x92, 0xe7, 0x8e, 0x23, 0xc3, 0x04, 0xe2, 0xda, 0xb2,
+	0x91, 0x21, 0x84, 0x76, 0x08, 0xab, 0x21, 0x19, 0x69, 0xbd, 0x73, 0x7d, 0x31, 0x54, 0x2e, 0x20,
+	0x1c, 0xad, 0x74, 0x7d, 0x03, 0x99, 0x91, 0x58, 0x14, 0x64, 0x63, 0x31, 0x48, 0x9a, 0xcb, 0x43,
+	0x01, 0xce, 0x61, 0xc3, 0xc2, 0x9a, 0xa3, 0x8e, 0x1f, 0x32, 0x0e, 0x6c, 0xd3, 0xe8, 0xde, 0xf0,
+	0x57, 0xd3, 0xbb, 0x0b, 0x36, 0x4e, 0x1d, 0x6b, 0xce, 0xe8, 0x9d, 0x57, 0x93, 0x8e, 0x54, 0xd6,
+	0xac, 0x69, 0xe2, 0x58, 0x50, 0x13, 0xab, 0xf4, 0xbd, 0xde, 0xbd, 0x65, 0x05, 0x35, 0x71, 0xcb,
+	0xf8, 0x15, 0x46, 0xdf, 0x40, 0x76, 0x5c, 0xac, 0x50, 0x3d, 0xcf, 0xe4, 0x2f, 0x75, 0xdf, 0xe0,
+	0xdd, 0x2b, 0xa3, 0x7a, 0x45, 0xdb, 0x33, 0xd1, 0x39, 0xe4, 0x3d, 0xdb, 0x1b, 0xa8, 0x0e, 0xfe,
+	0xd3, 0xa1, 0xe1, 0x60, 0x3d, 0x18, 0x25, 0x36, 0xdf, 0x22, 0x4a, 0xdc, 0x25, 0x68, 0x0a, 0x07,
+	0x0b, 0x94, 0xd7, 0x7e, 0x01, 0x99, 0x10, 0xe3, 0x44, 0xae, 0x15, 0xb9, 0x7d, 0xae, 0x55, 0xf8,
+	0xd7, 0x08, 0xac, 0xcd, 0xd0, 0x34, 0xfa, 0x05, 0xc4, 0xb5, 0xee, 0xe8, 0x21, 0x4f, 0x76, 0xf7,
+	0xab, 0xdb, 0x5b, 0x6b, 0xa7, 0x44, 0x01, 0x14, 0x0e, 0x84, 0x3e, 0x02, 0x12, 0xb7, 0x55, 0xdd,
+	0x70, 0x3d, 0xcd, 0xea, 0xfa, 0xcf, 0x2a, 0x89, 0x25, 0x2a, 0x9c, 0x54, 0x2c, 0x41, 0x9c, 0x0d,
+	0x0a, 0x97, 0x3a, 0x52, 0x10, 0x2b, 0xd5, 0x6a, 0x8d, 0x57, 0x52, 0x04, 0x01, 0xc4, 0x15, 0xf9,
+	0x58, 0x2e, 0xb7, 0xa5, 0x28, 0x21, 0xb3, 0xdb, 0x1f, 0x7d, 0x44, 0x78, 0x50, 0x2b, 0x1d, 0x4a,
+	0x62, 0xf1, 0xef, 0xa3, 0x00, 0xe5, 0xa1, 0xeb, 0xd9, 0xfd, 0x8a, 0xe6, 0x69, 0xe4, 0x56, 0x40,
+	0x92, 0x65, 0xfa, 0xd6, 0x8b, 0xdf, 0x0a, 0x2e, 0xf1, 0x0d, 0x7d, 0x07, 0x85, 0x40, 0xbc, 0xc4,
+	0x37, 0x9f, 0xfa, 0xcf, 0x3b, 0xc9, 0x6f, 0x4e, 0xdb, 0xe5, 0xe9, 0x1d, 0xfd, 0xcd, 0x69, 0x9f,
+	0xf1, 0x0f, 0x8c, 0xf4, 0x37, 0xa7, 0xbd, 0xe4, 0x19, 0x1b, 0xfd, 0xcd, 0x69, 0x7b, 0xf4, 0x44,
+	0x67, 0xb4, 0xbd, 0x89, 0x04, 0x3c, 0xf1, 0x0e, 0x37, 0xb6, 0xe4, 0xad, 0x8a, 0x87, 0xdb, 0x20,
+	0xea, 0x9a, 0xa7, 0xf1, 0xf3, 0x78, 0x76, 0x9d, 0x89, 0x72, 0x14, 0xff, 0x2e, 0x05, 0x99, 0x7d,
+	0xad, 0x7b, 0xd9, 0x73, 0xec, 0xa1, 0xa5, 0x1f, 0xdb, 0x67, 0xe4, 0x7e, 0xf1, 0xbd, 0x7d, 0x16,
+	0xb8, 0x5f, 0x7c, 0x6f, 0x9f, 0x55, 0x75, 0xf4, 0x45, 0xe8, 0xc5, 0xdc, 0xf4, 0x63, 0xc3, 0x10,
+	0x48, 0xf0, 0xd9, 0xdc, 0xdc, 0xab, 0xdf, 0xb8, 0x96, 0x2e, 0x06, 0x6b, 0xe9, 0xfc, 0xe5, 0x71,
+	0x6c, 0xf6, 0xcb, 0xe3, 0xd4, 0xc4, 0xcb, 0xe3, 0xfb, 0x90, 0x1a, 0xe5, 0x54, 0x34, 0x77, 0x48,
+	0x29, 0xc9, 0x73, 0x9e, 0x2c, 0x11, 0xeb, 0xf7, 0xf5, 0x3d, 0x96, 0x87, 0xa7, 0xe9, 0x65, 0x20,
+	0xd1, 0xd7, 0xf7, 0x68, 0xfa, 0x1d, 0x7e, 0x0b, 0xbf, 0x72, 0xeb, 0xb7, 0xf0, 0x1f, 0xec, 0x43,
+	0xf1, 0x1f, 0x40, 0x9c, 0x3f, 0xfb, 0x4c, 0xce, 0xf9, 0xea, 0x14, 0xb6, 0x04, 0x7f, 0xf8, 0xc9,
+	0x07, 0x15, 0xfe, 0x5a, 0x80, 0x38, 0x7f, 0xba, 0x5f, 0x82, 0x18, 0x21, 0xfa, 0xaf, 0x3c, 0x7f,
+	0x77, 0x29, 0x20, 0xfa, 0x0f, 0x2b, 0x6c, 0x24, 0xb1, 0xcc, 0xe8, 0xf6, 0xc2, 0xf6, 0xd1, 0xa8,
+	0x4d, 0x2e, 0xbf, 0xf4, 0xde, 0x82, 0x1d, 0xc7, 0x76, 0x78, 0x4d, 0x3d, 0x45, 0x28, 0x32, 0x21,
+	0xa0, 0xaf, 0x81, 0x56, 0x8c, 0x55, 0x67, 0x68, 0x2d, 0x79, 0xf3, 0x27, 0xec, 0xca, 0xd0, 0x62,
+	0x0a, 0x3c, 0x37, 0x2c, 0xc3, 0xbd, 0x58, 0xba, 0x9a, 0xe1, 0xb3, 0xb7, 0x5d, 0x54, 0x86, 0xe4,
+	0xc0, 0xb1, 0x7b, 0xf4, 0xbe, 0xcd, 0xcc, 0xf6, 0xd3, 0x05, 0x2b, 0x6f, 0x72, 0x76, 0x65, 0x34,
+	0xb0, 0xf8, 0x1d, 0xc4, 0xa8, 0x22, 0xc2, 0x81, 0x2a, 0x0d, 0x89, 0xa6, 0x5c, 0xaf, 0x54, 0xeb,
+	0x87, 0x52, 0x84, 0x34, 0x94, 0x4e, 0xbd, 0x4e, 0x1a, 0xb4, 0x1e, 0xdb, 0xea, 0x94, 0xcb, 0xb2,
+	0x5c, 0x91, 0x2b, 0x92, 0x40, 0xc2, 0xd8, 0x41, 0xa9, 0x5a, 0x93, 0x2b, 0x92, 0x48, 0xba, 0x58,
+	0x01, 0x8a, 0x34, 0x63, 0x85, 0x7f, 0x8f, 0x40, 0xd2, 0x9f, 0x10, 0x95, 0xa9, 0x89, 0x7a, 0xbe,
+	0x89, 0x9e, 0x2d, 0x29, 0x28, 0x31, 0x52, 0x8f, 0x19, 0xa9, 0x87, 0xd1, 0xef, 0x80, 0xa4, 0xdb,
+	0xaf, 0x2d, 0xd3, 0xd6, 0x74, 0xac, 0xab, 0x67, 0x37, 0x1e, 0xf6, 0x8d, 0x95, 0x1b, 0xd3, 0xf7,
+	0x09, 0x19, 0x3d, 0x86, 0xb4, 0x67, 0x7b, 0x9a, 0xc9, 0xb9, 0xf8, 0xbb, 0x4a, 0x4a, 0xa2, 0x0c,
+	0xc5, 0x3d, 0xba, 0xee, 0xde, 0xc4, 0xba, 0x73, 0x90, 0xae, 0x34, 0x5e, 0xd5, 0x6b, 0x8d, 0x12,
+	0x5f, 0x7b, 0x16, 0xa0, 0xa9, 0x34, 0xca, 0x72, 0xab, 0x45, 0x97, 0x5f, 0x3c, 0x98, 0xf5, 0xf6,
+	0x34, 0x03, 0xa9, 0xf6, 0x51, 0xe7, 0x64, 0xbf, 0x5e, 0xaa, 0xd6, 0xa4, 0x08, 0x69, 0x1e, 0xc8,
+	0xed, 0xf2, 0x91, 0xda, 0x51, 0x6a, 0x52, 0x14, 0xad, 0x41, 0x2e, 0x50, 0x99, 0x56, 0x9b, 0xd5,
+	0xb2, 0x24, 0x14, 0xff, 0x22, 0x02, 0xb1, 0x1a, 0xd6, 0x5c, 0x4c, 0x9f, 0xcf, 0xdb, 0xa6, 0x3e,
+	0x2e, 0x41, 0xb1, 0x16, 0x71, 0x0d, 0xad, 0xcb, 0x8f, 0xe1, 0xa5, 0xae, 0xf9, 0xe0, 0xb3, 0xb7,
+	0xe9, 0xfb, 0x6c, 0x56, 0xc7, 0x70, 0x97, 0xac, 0x97, 0x70, 0xee, 0xb6, 0xbb, 0x7f, 0xff, 0x8f,
+	0x36, 0x99, 0x6d, 0x6c, 0xa7, 0xf7, 0x9c, 0xfe, 0x7a, 0x7e, 0x86, 0x9f, 0x33, 0x2b, 0x9d, 0xc5,
+	0xe9, 0xd8, 0xcf, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xba, 0xc5, 0x08, 0x47, 0xf1, 0x36, 0x00,
+	0x00,
 }
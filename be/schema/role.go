@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"pixur.org/pixur/be/text"
+)
+
+func (r *Role) IdCol() int64 {
+	return r.RoleId
+}
+
+func (r *Role) NameCol() string {
+	return RoleUniqueName(r.Name)
+}
+
+// RoleUniqueName normalizes a role name for uniqueness constraints
+func RoleUniqueName(s string) string {
+	u, err := text.ToCaselessNFKC(s, "role")
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func (r *Role) SetCreatedTime(now time.Time) {
+	r.CreatedTs = ToTspb(now)
+}
+
+func (r *Role) SetModifiedTime(now time.Time) {
+	r.ModifiedTs = ToTspb(now)
+}
+
+func (r *Role) GetCreatedTime() time.Time {
+	return ToTime(r.CreatedTs)
+}
+
+func (r *Role) GetModifiedTime() time.Time {
+	return ToTime(r.ModifiedTs)
+}
+
+func (r *Role) Version() int64 {
+	return ToTime(r.ModifiedTs).UnixNano()
+}
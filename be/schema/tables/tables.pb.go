@@ -27,6 +27,7 @@ type PicRow struct {
 	IndexOrder           int64       `protobuf:"varint,2,opt,name=index_order,json=indexOrder,proto3" json:"index_order,omitempty"`
 	ScoreOrder           int32       `protobuf:"varint,5,opt,name=score_order,json=scoreOrder,proto3" json:"score_order,omitempty"`
 	SchedOrder           int32       `protobuf:"varint,6,opt,name=sched_order,json=schedOrder,proto3" json:"sched_order,omitempty"`
+	PendingDeletionOrder int64       `protobuf:"varint,7,opt,name=pending_deletion_order,json=pendingDeletionOrder,proto3" json:"pending_deletion_order,omitempty"`
 	Data                 *schema.Pic `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -86,6 +87,13 @@ func (m *PicRow) GetSchedOrder() int32 {
 	return 0
 }
 
+func (m *PicRow) GetPendingDeletionOrder() int64 {
+	if m != nil {
+		return m.PendingDeletionOrder
+	}
+	return 0
+}
+
 func (m *PicRow) GetData() *schema.Pic {
 	if m != nil {
 		return m.Data
@@ -96,6 +104,7 @@ func (m *PicRow) GetData() *schema.Pic {
 type TagRow struct {
 	Id                   int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UsageCount           int64       `protobuf:"varint,4,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
 	Data                 *schema.Tag `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -141,6 +150,13 @@ func (m *TagRow) GetName() string {
 	return ""
 }
 
+func (m *TagRow) GetUsageCount() int64 {
+	if m != nil {
+		return m.UsageCount
+	}
+	return 0
+}
+
 func (m *TagRow) GetData() *schema.Tag {
 	if m != nil {
 		return m.Data
@@ -148,6 +164,116 @@ func (m *TagRow) GetData() *schema.Tag {
 	return nil
 }
 
+type TagAliasRow struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TagId                int64            `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Data                 *schema.TagAlias `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TagAliasRow) Reset()         { *m = TagAliasRow{} }
+func (m *TagAliasRow) String() string { return proto.CompactTextString(m) }
+func (*TagAliasRow) ProtoMessage()    {}
+func (*TagAliasRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{2}
+}
+
+func (m *TagAliasRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagAliasRow.Unmarshal(m, b)
+}
+func (m *TagAliasRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagAliasRow.Marshal(b, m, deterministic)
+}
+func (m *TagAliasRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagAliasRow.Merge(m, src)
+}
+func (m *TagAliasRow) XXX_Size() int {
+	return xxx_messageInfo_TagAliasRow.Size(m)
+}
+func (m *TagAliasRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagAliasRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagAliasRow proto.InternalMessageInfo
+
+func (m *TagAliasRow) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TagAliasRow) GetTagId() int64 {
+	if m != nil {
+		return m.TagId
+	}
+	return 0
+}
+
+func (m *TagAliasRow) GetData() *schema.TagAlias {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type TagImplicationRow struct {
+	TagId                int64                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	ImpliedTagId         int64                  `protobuf:"varint,2,opt,name=implied_tag_id,json=impliedTagId,proto3" json:"implied_tag_id,omitempty"`
+	Data                 *schema.TagImplication `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *TagImplicationRow) Reset()         { *m = TagImplicationRow{} }
+func (m *TagImplicationRow) String() string { return proto.CompactTextString(m) }
+func (*TagImplicationRow) ProtoMessage()    {}
+func (*TagImplicationRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{3}
+}
+
+func (m *TagImplicationRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagImplicationRow.Unmarshal(m, b)
+}
+func (m *TagImplicationRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagImplicationRow.Marshal(b, m, deterministic)
+}
+func (m *TagImplicationRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagImplicationRow.Merge(m, src)
+}
+func (m *TagImplicationRow) XXX_Size() int {
+	return xxx_messageInfo_TagImplicationRow.Size(m)
+}
+func (m *TagImplicationRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagImplicationRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagImplicationRow proto.InternalMessageInfo
+
+func (m *TagImplicationRow) GetTagId() int64 {
+	if m != nil {
+		return m.TagId
+	}
+	return 0
+}
+
+func (m *TagImplicationRow) GetImpliedTagId() int64 {
+	if m != nil {
+		return m.ImpliedTagId
+	}
+	return 0
+}
+
+func (m *TagImplicationRow) GetData() *schema.TagImplication {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type PicTagRow struct {
 	PicId                int64          `protobuf:"varint,1,opt,name=pic_id,json=picId,proto3" json:"pic_id,omitempty"`
 	TagId                int64          `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
@@ -161,7 +287,7 @@ func (m *PicTagRow) Reset()         { *m = PicTagRow{} }
 func (m *PicTagRow) String() string { return proto.CompactTextString(m) }
 func (*PicTagRow) ProtoMessage()    {}
 func (*PicTagRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{2}
+	return fileDescriptor_9e429e24f449e1ed, []int{4}
 }
 
 func (m *PicTagRow) XXX_Unmarshal(b []byte) error {
@@ -217,7 +343,7 @@ func (m *PicIdentRow) Reset()         { *m = PicIdentRow{} }
 func (m *PicIdentRow) String() string { return proto.CompactTextString(m) }
 func (*PicIdentRow) ProtoMessage()    {}
 func (*PicIdentRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{3}
+	return fileDescriptor_9e429e24f449e1ed, []int{5}
 }
 
 func (m *PicIdentRow) XXX_Unmarshal(b []byte) error {
@@ -279,7 +405,7 @@ func (m *PicCommentRow) Reset()         { *m = PicCommentRow{} }
 func (m *PicCommentRow) String() string { return proto.CompactTextString(m) }
 func (*PicCommentRow) ProtoMessage()    {}
 func (*PicCommentRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{4}
+	return fileDescriptor_9e429e24f449e1ed, []int{6}
 }
 
 func (m *PicCommentRow) XXX_Unmarshal(b []byte) error {
@@ -335,7 +461,7 @@ func (m *PicVoteRow) Reset()         { *m = PicVoteRow{} }
 func (m *PicVoteRow) String() string { return proto.CompactTextString(m) }
 func (*PicVoteRow) ProtoMessage()    {}
 func (*PicVoteRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{5}
+	return fileDescriptor_9e429e24f449e1ed, []int{7}
 }
 
 func (m *PicVoteRow) XXX_Unmarshal(b []byte) error {
@@ -399,7 +525,7 @@ func (m *PicVoteCommentRow) Reset()         { *m = PicVoteCommentRow{} }
 func (m *PicVoteCommentRow) String() string { return proto.CompactTextString(m) }
 func (*PicVoteCommentRow) ProtoMessage()    {}
 func (*PicVoteCommentRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{6}
+	return fileDescriptor_9e429e24f449e1ed, []int{8}
 }
 
 func (m *PicVoteCommentRow) XXX_Unmarshal(b []byte) error {
@@ -468,7 +594,7 @@ func (m *UserRow) Reset()         { *m = UserRow{} }
 func (m *UserRow) String() string { return proto.CompactTextString(m) }
 func (*UserRow) ProtoMessage()    {}
 func (*UserRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{7}
+	return fileDescriptor_9e429e24f449e1ed, []int{9}
 }
 
 func (m *UserRow) XXX_Unmarshal(b []byte) error {
@@ -510,6 +636,124 @@ func (m *UserRow) GetData() *schema.User {
 	return nil
 }
 
+type RoleRow struct {
+	Id                   int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 *schema.Role `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RoleRow) Reset()         { *m = RoleRow{} }
+func (m *RoleRow) String() string { return proto.CompactTextString(m) }
+func (*RoleRow) ProtoMessage()    {}
+func (*RoleRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{10}
+}
+
+func (m *RoleRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleRow.Unmarshal(m, b)
+}
+func (m *RoleRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleRow.Marshal(b, m, deterministic)
+}
+func (m *RoleRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleRow.Merge(m, src)
+}
+func (m *RoleRow) XXX_Size() int {
+	return xxx_messageInfo_RoleRow.Size(m)
+}
+func (m *RoleRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleRow proto.InternalMessageInfo
+
+func (m *RoleRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RoleRow) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoleRow) GetData() *schema.Role {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type UserIdentityRow struct {
+	Issuer               string               `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject              string               `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	UserId               int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 *schema.UserIdentity `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UserIdentityRow) Reset()         { *m = UserIdentityRow{} }
+func (m *UserIdentityRow) String() string { return proto.CompactTextString(m) }
+func (*UserIdentityRow) ProtoMessage()    {}
+func (*UserIdentityRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{11}
+}
+
+func (m *UserIdentityRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserIdentityRow.Unmarshal(m, b)
+}
+func (m *UserIdentityRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserIdentityRow.Marshal(b, m, deterministic)
+}
+func (m *UserIdentityRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserIdentityRow.Merge(m, src)
+}
+func (m *UserIdentityRow) XXX_Size() int {
+	return xxx_messageInfo_UserIdentityRow.Size(m)
+}
+func (m *UserIdentityRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserIdentityRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserIdentityRow proto.InternalMessageInfo
+
+func (m *UserIdentityRow) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *UserIdentityRow) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *UserIdentityRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UserIdentityRow) GetData() *schema.UserIdentity {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type UserEventRow struct {
 	UserId               int64             `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CreatedTs            int64             `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
@@ -524,7 +768,7 @@ func (m *UserEventRow) Reset()         { *m = UserEventRow{} }
 func (m *UserEventRow) String() string { return proto.CompactTextString(m) }
 func (*UserEventRow) ProtoMessage()    {}
 func (*UserEventRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{8}
+	return fileDescriptor_9e429e24f449e1ed, []int{12}
 }
 
 func (m *UserEventRow) XXX_Unmarshal(b []byte) error {
@@ -573,6 +817,77 @@ func (m *UserEventRow) GetData() *schema.UserEvent {
 	return nil
 }
 
+type BackgroundJobRow struct {
+	Id                   int64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	State                schema.BackgroundJob_Status_State `protobuf:"varint,2,opt,name=state,proto3,enum=pixur.be.schema.BackgroundJob_Status_State" json:"state,omitempty"`
+	NextRunTs            int64                             `protobuf:"varint,3,opt,name=next_run_ts,json=nextRunTs,proto3" json:"next_run_ts,omitempty"`
+	UserId               int64                             `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data                 *schema.BackgroundJob             `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *BackgroundJobRow) Reset()         { *m = BackgroundJobRow{} }
+func (m *BackgroundJobRow) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJobRow) ProtoMessage()    {}
+func (*BackgroundJobRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e429e24f449e1ed, []int{13}
+}
+
+func (m *BackgroundJobRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJobRow.Unmarshal(m, b)
+}
+func (m *BackgroundJobRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJobRow.Marshal(b, m, deterministic)
+}
+func (m *BackgroundJobRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJobRow.Merge(m, src)
+}
+func (m *BackgroundJobRow) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJobRow.Size(m)
+}
+func (m *BackgroundJobRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJobRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackgroundJobRow proto.InternalMessageInfo
+
+func (m *BackgroundJobRow) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BackgroundJobRow) GetState() schema.BackgroundJob_Status_State {
+	if m != nil {
+		return m.State
+	}
+	return schema.BackgroundJob_Status_UNKNOWN
+}
+
+func (m *BackgroundJobRow) GetNextRunTs() int64 {
+	if m != nil {
+		return m.NextRunTs
+	}
+	return 0
+}
+
+func (m *BackgroundJobRow) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *BackgroundJobRow) GetData() *schema.BackgroundJob {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type CustomDataRow struct {
 	KeyType              int64              `protobuf:"varint,1,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
 	Key1                 int64              `protobuf:"varint,2,opt,name=key1,proto3" json:"key1,omitempty"`
@@ -590,7 +905,7 @@ func (m *CustomDataRow) Reset()         { *m = CustomDataRow{} }
 func (m *CustomDataRow) String() string { return proto.CompactTextString(m) }
 func (*CustomDataRow) ProtoMessage()    {}
 func (*CustomDataRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e429e24f449e1ed, []int{9}
+	return fileDescriptor_9e429e24f449e1ed, []int{14}
 }
 
 func (m *CustomDataRow) XXX_Unmarshal(b []byte) error {
@@ -663,81 +978,116 @@ func (m *CustomDataRow) GetData() *schema.CustomData {
 func init() {
 	proto.RegisterType((*PicRow)(nil), "pixur.be.schema.tables.PicRow")
 	proto.RegisterType((*TagRow)(nil), "pixur.be.schema.tables.TagRow")
+	proto.RegisterType((*TagAliasRow)(nil), "pixur.be.schema.tables.TagAliasRow")
+	proto.RegisterType((*TagImplicationRow)(nil), "pixur.be.schema.tables.TagImplicationRow")
 	proto.RegisterType((*PicTagRow)(nil), "pixur.be.schema.tables.PicTagRow")
 	proto.RegisterType((*PicIdentRow)(nil), "pixur.be.schema.tables.PicIdentRow")
 	proto.RegisterType((*PicCommentRow)(nil), "pixur.be.schema.tables.PicCommentRow")
 	proto.RegisterType((*PicVoteRow)(nil), "pixur.be.schema.tables.PicVoteRow")
 	proto.RegisterType((*PicVoteCommentRow)(nil), "pixur.be.schema.tables.PicVoteCommentRow")
 	proto.RegisterType((*UserRow)(nil), "pixur.be.schema.tables.UserRow")
+	proto.RegisterType((*RoleRow)(nil), "pixur.be.schema.tables.RoleRow")
+	proto.RegisterType((*UserIdentityRow)(nil), "pixur.be.schema.tables.UserIdentityRow")
 	proto.RegisterType((*UserEventRow)(nil), "pixur.be.schema.tables.UserEventRow")
+	proto.RegisterType((*BackgroundJobRow)(nil), "pixur.be.schema.tables.BackgroundJobRow")
 	proto.RegisterType((*CustomDataRow)(nil), "pixur.be.schema.tables.CustomDataRow")
 }
 
 func init() { proto.RegisterFile("tables.proto", fileDescriptor_9e429e24f449e1ed) }
 
 var fileDescriptor_9e429e24f449e1ed = []byte{
-	// 1004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
-	0x17, 0x05, 0xa9, 0x97, 0x75, 0x25, 0xdb, 0xcc, 0x7c, 0x5f, 0x1c, 0x87, 0x41, 0xed, 0x31, 0x51,
-	0x17, 0x6a, 0x93, 0x4a, 0xb5, 0x64, 0x17, 0x6d, 0x91, 0x02, 0xa9, 0x9c, 0x2e, 0x92, 0x14, 0xa9,
-	0xe0, 0x28, 0x59, 0x74, 0x23, 0x50, 0xe4, 0x40, 0x26, 0x6c, 0x89, 0x2a, 0x49, 0x25, 0xd1, 0x6e,
-	0xba, 0x9d, 0x45, 0x97, 0x5d, 0x76, 0xdf, 0x65, 0xff, 0x4e, 0x57, 0xfd, 0x0f, 0x5d, 0x17, 0x28,
-	0xe6, 0xc5, 0x87, 0x23, 0x39, 0x30, 0x50, 0x74, 0x63, 0xcc, 0xdc, 0x39, 0x77, 0xee, 0x39, 0xe7,
-	0x8e, 0xae, 0x09, 0xcd, 0xc4, 0x1d, 0x5f, 0x92, 0xb8, 0x3d, 0x8f, 0xc2, 0x24, 0x44, 0x3b, 0xf3,
-	0xe0, 0xed, 0x22, 0x6a, 0x8f, 0x49, 0x3b, 0xf6, 0xce, 0xc9, 0xd4, 0x6d, 0xcb, 0x53, 0xfb, 0x50,
-	0xc6, 0xc3, 0x68, 0xd2, 0x11, 0xab, 0xce, 0x98, 0x74, 0x24, 0x42, 0xee, 0x65, 0xba, 0xdd, 0x5e,
-	0x0f, 0xf3, 0xc7, 0x9d, 0x69, 0xe8, 0x93, 0x4b, 0xf9, 0x57, 0xe2, 0x9d, 0xbf, 0x4d, 0xa8, 0x0e,
-	0x02, 0xef, 0x2c, 0x7c, 0x83, 0xee, 0x81, 0x19, 0xf8, 0xbb, 0x06, 0x36, 0x5a, 0xa5, 0x7e, 0x83,
-	0x51, 0x5c, 0x83, 0xca, 0x13, 0xff, 0x34, 0xbc, 0x3c, 0x33, 0x03, 0x1f, 0x1d, 0x43, 0x23, 0x98,
-	0xf9, 0xe4, 0xed, 0x28, 0x8c, 0x7c, 0x12, 0xed, 0x9a, 0x02, 0xf5, 0x3f, 0x46, 0xf1, 0x36, 0x6c,
-	0x3e, 0xe1, 0x07, 0xdf, 0xf3, 0x38, 0x47, 0x43, 0x90, 0x6e, 0xd1, 0xe7, 0xd0, 0x88, 0xbd, 0x30,
-	0x22, 0x2a, 0xab, 0x82, 0x8d, 0x56, 0xa5, 0x7f, 0x9b, 0x51, 0x7c, 0x0b, 0xb6, 0xbf, 0x0b, 0xdf,
-	0x90, 0xe8, 0x05, 0x3f, 0xed, 0x87, 0x8b, 0x99, 0x7f, 0x06, 0x02, 0x99, 0xcb, 0x3b, 0x27, 0xbe,
-	0xca, 0xab, 0xe6, 0xf3, 0x5e, 0xce, 0xe7, 0x57, 0xf3, 0xce, 0x89, 0x2f, 0xf3, 0x5a, 0x50, 0xf6,
-	0xdd, 0xc4, 0xdd, 0x2d, 0x63, 0xa3, 0xd5, 0xe8, 0xfe, 0xbf, 0x7d, 0xd5, 0x4b, 0xae, 0x54, 0x20,
-	0xbe, 0x5a, 0x30, 0x8a, 0x7f, 0x84, 0xf2, 0x20, 0xf0, 0x62, 0x54, 0xe5, 0xc2, 0x2d, 0x03, 0xed,
-	0x17, 0x34, 0x8a, 0xa0, 0x69, 0x43, 0xa6, 0x8e, 0x03, 0x72, 0x72, 0x34, 0xe0, 0x45, 0xc6, 0x7b,
-	0xbf, 0xc0, 0x3b, 0x03, 0x68, 0x82, 0x4f, 0xcb, 0x1b, 0x25, 0xab, 0x7c, 0x56, 0x0f, 0xe2, 0xd1,
-	0x79, 0xe0, 0xfb, 0x64, 0xe6, 0xfc, 0x62, 0x40, 0x75, 0xe8, 0x4e, 0xde, 0xeb, 0xff, 0x01, 0x94,
-	0x67, 0xee, 0x94, 0x08, 0xe3, 0xeb, 0xfd, 0x4d, 0x46, 0x71, 0x1d, 0x6a, 0xcf, 0xdd, 0x29, 0xe1,
-	0x00, 0x71, 0x94, 0x8a, 0x2f, 0xad, 0x11, 0xcf, 0xcb, 0x48, 0xf1, 0x0e, 0xa3, 0x78, 0x0f, 0xca,
-	0x43, 0x77, 0x92, 0x89, 0xdf, 0x92, 0x05, 0xac, 0x92, 0x5d, 0xe6, 0xd7, 0x3a, 0xbf, 0x19, 0x50,
-	0x1f, 0x04, 0x9e, 0xe2, 0x76, 0x08, 0xd5, 0x79, 0xe0, 0x8d, 0x52, 0x7e, 0x5b, 0x8c, 0x62, 0x80,
-	0x8d, 0x41, 0xe0, 0x49, 0x8a, 0x95, 0x39, 0x5f, 0x71, 0x58, 0xe2, 0x4e, 0x38, 0xcc, 0xcc, 0xc3,
-	0x86, 0xee, 0x44, 0xc1, 0x12, 0xbe, 0x42, 0xf7, 0x0b, 0x4c, 0xef, 0xac, 0x6a, 0x53, 0x46, 0xf6,
-	0x80, 0x51, 0xfc, 0x01, 0xd4, 0x64, 0x2c, 0x46, 0x48, 0x33, 0xd1, 0xa5, 0x2c, 0xc3, 0xf9, 0xd9,
-	0x84, 0x86, 0xa0, 0x42, 0x66, 0xc9, 0x0d, 0xd8, 0x7e, 0x03, 0xe5, 0x64, 0x39, 0x97, 0x9e, 0x6e,
-	0x75, 0xf7, 0x56, 0xd1, 0x10, 0x57, 0xb6, 0x87, 0xcb, 0x39, 0xd1, 0x9e, 0xf3, 0xb5, 0xf0, 0x9c,
-	0xa7, 0xa2, 0x0f, 0xa1, 0xf2, 0xda, 0xbd, 0x5c, 0x10, 0x21, 0xa5, 0xa9, 0x0b, 0xbd, 0xe2, 0x21,
-	0x51, 0x48, 0x1c, 0xa2, 0x4f, 0x0b, 0xcf, 0xf2, 0xee, 0xda, 0x42, 0x4a, 0xf1, 0x23, 0x46, 0xf1,
-	0x43, 0xe1, 0xbe, 0x88, 0xc6, 0xe8, 0x4e, 0xaa, 0x59, 0x54, 0x55, 0x35, 0x2d, 0x03, 0xed, 0x14,
-	0x03, 0xa6, 0x5d, 0x11, 0x19, 0xce, 0x9f, 0x06, 0x6c, 0x0e, 0x02, 0xef, 0x34, 0x9c, 0x4e, 0x6f,
-	0x66, 0xc9, 0x11, 0x80, 0x27, 0x93, 0xb2, 0x26, 0x22, 0x46, 0xf1, 0x16, 0x34, 0xd5, 0x65, 0x12,
-	0x5e, 0xf7, 0xf4, 0x0e, 0x75, 0x0a, 0xcd, 0xbc, 0xb7, 0x4a, 0x9c, 0xe6, 0x21, 0xe5, 0x3d, 0x66,
-	0x14, 0x3f, 0x12, 0x0d, 0x53, 0xf1, 0x18, 0xed, 0xa4, 0x02, 0x73, 0xe5, 0x2d, 0x03, 0xdd, 0x2d,
-	0xec, 0x4b, 0x76, 0x3d, 0x25, 0xe1, 0xfc, 0x64, 0x02, 0x0c, 0x02, 0xef, 0x55, 0x98, 0x90, 0x1b,
-	0xe8, 0x6b, 0x41, 0x6d, 0x11, 0x93, 0x28, 0x13, 0xb7, 0xcd, 0x28, 0x6e, 0x40, 0xfd, 0x65, 0x4c,
-	0x22, 0x09, 0xac, 0x2e, 0xc4, 0x92, 0x77, 0x56, 0x0c, 0x03, 0xd1, 0xb4, 0xf4, 0x3e, 0x31, 0x0c,
-	0xc4, 0x7d, 0xe2, 0x10, 0x3d, 0x28, 0x88, 0xdf, 0x5d, 0x25, 0x5e, 0x30, 0x94, 0xca, 0x9f, 0x33,
-	0x8a, 0x9f, 0x0a, 0x52, 0x3c, 0x18, 0x23, 0x3b, 0x95, 0xad, 0x59, 0xa9, 0xa2, 0x96, 0x81, 0x9c,
-	0x2c, 0xa6, 0x41, 0xea, 0xac, 0x64, 0x57, 0x25, 0x5d, 0xe7, 0x77, 0x13, 0x6e, 0xa9, 0xcb, 0xfe,
-	0x93, 0x56, 0xe7, 0xdc, 0x2b, 0xfd, 0x1b, 0xee, 0xf5, 0x94, 0x7b, 0x15, 0xe1, 0xde, 0xfe, 0x35,
-	0x4f, 0x27, 0x67, 0xe2, 0xd7, 0x8c, 0xe2, 0x2f, 0x61, 0xbb, 0x78, 0x16, 0xa3, 0x8f, 0x56, 0x3d,
-	0xa1, 0x77, 0x7d, 0x75, 0x7e, 0x35, 0xa0, 0xc6, 0xf9, 0xbe, 0x77, 0xe2, 0x72, 0x09, 0xfc, 0xc7,
-	0xa4, 0x46, 0xae, 0x96, 0xc0, 0x43, 0x52, 0x02, 0x5f, 0xa1, 0x8f, 0x0b, 0x0f, 0xe0, 0xf6, 0x3b,
-	0x12, 0x44, 0x29, 0x49, 0xfc, 0x90, 0x51, 0x7c, 0x00, 0x15, 0x1e, 0xc9, 0xc6, 0xae, 0xa5, 0xaa,
-	0x58, 0x25, 0xfd, 0xdb, 0xfd, 0xcb, 0x80, 0x26, 0xc7, 0x7c, 0xfb, 0x5a, 0xf5, 0x33, 0xe7, 0xba,
-	0x71, 0xbd, 0xeb, 0xbc, 0xa5, 0x11, 0x71, 0x13, 0xe2, 0x8f, 0x92, 0xf8, 0x4a, 0x4b, 0x65, 0x7c,
-	0x18, 0xcb, 0x96, 0xea, 0x5d, 0xd6, 0xa8, 0xd2, 0x75, 0x8d, 0x6a, 0x17, 0x1a, 0x65, 0xaf, 0x54,
-	0x29, 0xf9, 0x4a, 0xa9, 0x9f, 0x31, 0x8a, 0x1f, 0x00, 0xa4, 0xe1, 0x18, 0xed, 0x65, 0xad, 0xc8,
-	0x71, 0xcc, 0xda, 0xf2, 0x87, 0x09, 0x9b, 0xa7, 0x8b, 0x38, 0x09, 0xa7, 0x8f, 0xdd, 0xc4, 0xe5,
-	0xb2, 0xef, 0xc3, 0xc6, 0x05, 0x59, 0x8e, 0xc4, 0x84, 0x96, 0xba, 0x2d, 0x46, 0x71, 0x13, 0xe0,
-	0x19, 0x59, 0xea, 0x21, 0x5c, 0xbb, 0x90, 0x6b, 0xfe, 0xef, 0xf1, 0x82, 0x2c, 0x8f, 0x94, 0x66,
-	0x35, 0xaa, 0x9f, 0x91, 0xe5, 0x91, 0x18, 0xd5, 0xfc, 0x48, 0x41, 0xba, 0x4a, 0x68, 0x06, 0xe9,
-	0x6a, 0x48, 0x57, 0x41, 0x7a, 0xea, 0xd1, 0x66, 0x90, 0x9e, 0x86, 0xf4, 0x14, 0xe4, 0x58, 0x38,
-	0x91, 0x87, 0x1c, 0x6b, 0xc8, 0xb1, 0x82, 0x9c, 0x88, 0xaf, 0x96, 0x3c, 0xe4, 0x44, 0x43, 0x4e,
-	0xd2, 0x99, 0x59, 0x5b, 0x33, 0x33, 0x73, 0x4e, 0x48, 0x43, 0x1f, 0x32, 0x8a, 0xbf, 0x00, 0xc8,
-	0xe2, 0xe8, 0x93, 0xcc, 0x1e, 0xa9, 0x5d, 0xca, 0x93, 0x0a, 0x24, 0x49, 0xc9, 0xc3, 0x32, 0xfa,
-	0xce, 0x0f, 0x78, 0xfd, 0x67, 0xa1, 0xfc, 0xbe, 0x1c, 0x57, 0xc5, 0xf7, 0x60, 0xef, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x2e, 0x70, 0xea, 0x01, 0x8e, 0x0a, 0x00, 0x00,
+	// 1481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x73, 0x1b, 0xc5,
+	0x16, 0xae, 0x19, 0xbd, 0xec, 0x23, 0x59, 0x9e, 0x74, 0x12, 0x3f, 0x94, 0x4a, 0xdc, 0x99, 0x7b,
+	0x7d, 0xaf, 0x49, 0x82, 0x8c, 0x65, 0x87, 0x82, 0x10, 0x20, 0xb1, 0xc3, 0xc2, 0x09, 0x15, 0x84,
+	0xad, 0x04, 0x0a, 0x16, 0xaa, 0xd1, 0x4c, 0x97, 0x3c, 0x58, 0x9a, 0x51, 0xa9, 0x67, 0x12, 0x6b,
+	0x37, 0x14, 0x2b, 0x66, 0xc1, 0x9e, 0x0d, 0x5b, 0x36, 0x6c, 0x58, 0xb3, 0xe5, 0x57, 0xb0, 0xe2,
+	0x0f, 0xc0, 0x86, 0x3f, 0x40, 0xf5, 0x6b, 0x1e, 0x8e, 0x64, 0xe3, 0xaa, 0x14, 0x1b, 0xbb, 0xfb,
+	0xf4, 0xd7, 0x7d, 0xbe, 0xef, 0xeb, 0xd3, 0x33, 0x3d, 0x82, 0x5a, 0x60, 0xf5, 0x06, 0x84, 0x36,
+	0x47, 0x63, 0x3f, 0xf0, 0xd1, 0xd2, 0xc8, 0x3d, 0x09, 0xc7, 0xcd, 0x1e, 0x69, 0x52, 0xfb, 0x88,
+	0x0c, 0xad, 0xa6, 0x18, 0x6d, 0xac, 0x8b, 0xb8, 0x3f, 0xee, 0x6f, 0xf2, 0xd6, 0x66, 0x8f, 0x6c,
+	0x0a, 0x84, 0xe8, 0x8b, 0xe9, 0x8d, 0xe6, 0x6c, 0x98, 0xd3, 0xdb, 0x1c, 0xfa, 0x0e, 0x19, 0x88,
+	0xbf, 0x02, 0x6f, 0xc6, 0x45, 0x28, 0xb7, 0x5d, 0xfb, 0xc0, 0x7f, 0x89, 0xae, 0x81, 0xee, 0x3a,
+	0x2b, 0x1a, 0xd6, 0x36, 0x0a, 0xbb, 0xd5, 0x38, 0xc2, 0x15, 0x28, 0xed, 0x3b, 0x7b, 0xfe, 0xe0,
+	0x40, 0x77, 0x1d, 0xb4, 0x03, 0x55, 0xd7, 0x73, 0xc8, 0x49, 0xd7, 0x1f, 0x3b, 0x64, 0xbc, 0xa2,
+	0x73, 0xd4, 0xe5, 0x38, 0xc2, 0x8b, 0xb0, 0xb0, 0xcf, 0x06, 0x3e, 0x61, 0x71, 0x86, 0x06, 0x37,
+	0xe9, 0xa2, 0xb7, 0xa1, 0x4a, 0x6d, 0x7f, 0x4c, 0xe4, 0xac, 0x12, 0xd6, 0x36, 0x4a, 0xbb, 0x57,
+	0xe3, 0x08, 0x5f, 0x82, 0xc5, 0x8f, 0xfd, 0x97, 0x64, 0x7c, 0xc8, 0x46, 0x77, 0xfd, 0xd0, 0x73,
+	0x0e, 0x80, 0x23, 0x33, 0xf3, 0x8e, 0x88, 0x23, 0xe7, 0x95, 0xb3, 0xf3, 0x9e, 0x8d, 0x46, 0xa7,
+	0xe7, 0x1d, 0x11, 0x47, 0xcc, 0x3b, 0x84, 0xa5, 0x11, 0xf1, 0x1c, 0xd7, 0xeb, 0x77, 0x1d, 0x32,
+	0x20, 0x81, 0xeb, 0x7b, 0x72, 0x89, 0x0a, 0x27, 0x7c, 0x3d, 0x8e, 0xf0, 0x2a, 0x2c, 0xb7, 0x05,
+	0xe6, 0x91, 0x84, 0x24, 0xd4, 0xaf, 0x8c, 0xa6, 0x0c, 0xa0, 0x0d, 0x28, 0x3a, 0x56, 0x60, 0xad,
+	0x14, 0xb1, 0xb6, 0x51, 0x6d, 0x5d, 0x69, 0x9e, 0xde, 0x20, 0x66, 0x1f, 0x47, 0xdc, 0xfb, 0x49,
+	0x8b, 0x23, 0xfc, 0xa3, 0x86, 0xca, 0xcc, 0x49, 0x43, 0x43, 0x6b, 0x39, 0xd3, 0x58, 0xb0, 0x01,
+	0xa9, 0x59, 0x86, 0x8e, 0xd6, 0x0c, 0x3d, 0xe7, 0x90, 0x80, 0x1c, 0xa6, 0x46, 0xac, 0xb1, 0x9e,
+	0x92, 0x27, 0xc0, 0x89, 0x2d, 0xc0, 0xf7, 0xc5, 0xd0, 0x67, 0x69, 0xe6, 0x8b, 0x5d, 0x99, 0xa6,
+	0x15, 0x8a, 0x6d, 0xd7, 0xa6, 0x8f, 0x8b, 0x73, 0x05, 0xa3, 0x78, 0x30, 0xef, 0xd2, 0xee, 0x91,
+	0xeb, 0x38, 0xc4, 0x33, 0xff, 0xd0, 0xa0, 0xdc, 0xb1, 0xfa, 0xe7, 0x16, 0xc3, 0x4d, 0x28, 0x7a,
+	0xd6, 0x90, 0xf0, 0x2a, 0x98, 0xdf, 0x5d, 0x88, 0x23, 0x3c, 0x0f, 0x95, 0xa7, 0xd6, 0x90, 0x30,
+	0x00, 0x1f, 0x62, 0xf5, 0x12, 0x52, 0xab, 0x4f, 0xba, 0xb6, 0x1f, 0x7a, 0x01, 0xf7, 0x2e, 0xa9,
+	0x97, 0x67, 0x6c, 0x60, 0x8f, 0xc5, 0x79, 0xbd, 0x84, 0x49, 0x37, 0xb1, 0xba, 0x30, 0xc3, 0x6a,
+	0x46, 0x4e, 0x58, 0xbd, 0x17, 0x47, 0xf8, 0x43, 0x28, 0x76, 0xac, 0x3e, 0x4d, 0xec, 0xae, 0x0b,
+	0x5a, 0x8d, 0x22, 0xa3, 0x62, 0x14, 0x98, 0x79, 0x69, 0x2a, 0x66, 0x5e, 0x86, 0x11, 0x9b, 0x62,
+	0xfe, 0xa2, 0x41, 0xb5, 0x63, 0xf5, 0x1f, 0x0e, 0x5c, 0x8b, 0x32, 0xd1, 0x4a, 0x97, 0x36, 0x5b,
+	0xd7, 0x3a, 0x94, 0x03, 0xab, 0xdf, 0x75, 0x1d, 0x79, 0x04, 0xea, 0x71, 0x84, 0x01, 0xe6, 0x3a,
+	0x56, 0x5f, 0xd8, 0x53, 0x0a, 0x58, 0x0b, 0xbd, 0x99, 0x13, 0xb2, 0x3a, 0x4d, 0x88, 0xc8, 0x2a,
+	0xd4, 0x6c, 0xc7, 0x11, 0xde, 0x04, 0x50, 0x51, 0x42, 0xd1, 0x9c, 0xa1, 0x09, 0x32, 0x68, 0x59,
+	0x65, 0x94, 0xea, 0x4a, 0x3c, 0x97, 0xa1, 0x9b, 0xdf, 0xea, 0x70, 0x89, 0xb5, 0x87, 0xa3, 0x81,
+	0x6b, 0x5b, 0x6c, 0x73, 0x99, 0x86, 0x94, 0xa0, 0x76, 0x16, 0xc1, 0xf7, 0xa0, 0xee, 0xb2, 0x89,
+	0xc4, 0xe9, 0xe6, 0xf4, 0xc8, 0x43, 0xb6, 0x2f, 0xc6, 0x92, 0x59, 0x35, 0x37, 0x13, 0x40, 0xdb,
+	0x39, 0x75, 0x6b, 0xd3, 0xd4, 0x65, 0x59, 0x09, 0x8d, 0x5f, 0xc6, 0x11, 0xfe, 0x0c, 0x35, 0x0c,
+	0x2d, 0xd1, 0x73, 0x8a, 0x01, 0xda, 0x68, 0xd4, 0xb2, 0x79, 0x0d, 0xfd, 0x34, 0x22, 0x99, 0xb9,
+	0x98, 0xcf, 0x40, 0xcd, 0x5f, 0x35, 0x98, 0x6f, 0xbb, 0xb6, 0x2c, 0xde, 0x75, 0x28, 0x8f, 0x5c,
+	0xfb, 0x15, 0x0f, 0xda, 0xae, 0x2d, 0x3d, 0x18, 0xb1, 0xd6, 0x3f, 0xdd, 0xcb, 0xdb, 0x39, 0xb5,
+	0xcb, 0xd3, 0xce, 0x7f, 0x5a, 0x97, 0xf7, 0xe3, 0x08, 0xbf, 0x03, 0x15, 0x11, 0xa3, 0x08, 0x29,
+	0x26, 0x2a, 0x95, 0xa1, 0xa1, 0xd5, 0x64, 0x0b, 0x13, 0x3d, 0x12, 0x64, 0x7e, 0xa7, 0x43, 0x95,
+	0xb3, 0x24, 0x5e, 0x70, 0x01, 0x21, 0x0f, 0xa1, 0x18, 0x4c, 0x46, 0xe2, 0x3c, 0xd6, 0x5b, 0x37,
+	0xa6, 0x31, 0xe4, 0x4b, 0x36, 0x3b, 0x93, 0x11, 0x51, 0x75, 0xcd, 0xda, 0xbc, 0xae, 0xd9, 0x54,
+	0xf4, 0x5f, 0x28, 0xbd, 0xb0, 0x06, 0x21, 0xe1, 0x2a, 0x6b, 0x2a, 0xd1, 0x73, 0x16, 0xe2, 0x89,
+	0xf8, 0x60, 0x52, 0xd6, 0xc5, 0x19, 0x65, 0x9d, 0x70, 0x17, 0x66, 0x3c, 0x88, 0x23, 0x7c, 0x1f,
+	0x2d, 0xb3, 0x2d, 0x97, 0x2e, 0xf0, 0x64, 0x32, 0x15, 0x5a, 0xca, 0x75, 0x1b, 0x25, 0x3e, 0xd5,
+	0xd0, 0xf9, 0x4e, 0xf2, 0x36, 0x35, 0x7f, 0xd7, 0x60, 0xa1, 0xed, 0xda, 0x7b, 0xfe, 0x70, 0x78,
+	0x31, 0x4b, 0xb6, 0x00, 0x6c, 0x31, 0x29, 0xdd, 0x5f, 0x14, 0x47, 0xb8, 0x0e, 0x35, 0xb9, 0x98,
+	0x80, 0xcf, 0xdb, 0xaa, 0x87, 0x36, 0x73, 0xfb, 0x7c, 0x6d, 0x9a, 0x38, 0xc5, 0x43, 0xc8, 0x7b,
+	0x14, 0x47, 0xf8, 0x01, 0xdf, 0x30, 0x19, 0xa7, 0x68, 0x29, 0xa3, 0x35, 0x43, 0x00, 0xad, 0x66,
+	0x7b, 0x8d, 0xf9, 0x84, 0x82, 0x51, 0x30, 0xbf, 0xd6, 0x01, 0xda, 0xae, 0xfd, 0xdc, 0x0f, 0xc8,
+	0x05, 0xf4, 0x6d, 0x40, 0x25, 0xa4, 0x64, 0x9c, 0x8a, 0x5b, 0x8c, 0x23, 0x5c, 0x85, 0xf9, 0x67,
+	0x94, 0x8c, 0x05, 0xb0, 0x1c, 0xf2, 0x26, 0xdb, 0x59, 0xfe, 0x12, 0x92, 0xcf, 0x60, 0xb9, 0x1e,
+	0x7f, 0x0d, 0xf1, 0xf5, 0xf8, 0x20, 0xba, 0x93, 0x13, 0xbf, 0x32, 0x4d, 0x3c, 0x67, 0x28, 0x94,
+	0x3f, 0x8d, 0x23, 0xfc, 0x58, 0x9c, 0x65, 0x29, 0x56, 0xb1, 0x91, 0xc9, 0x90, 0xd9, 0x28, 0x0b,
+	0x2a, 0x46, 0x21, 0x1d, 0x53, 0x60, 0x81, 0xe1, 0xa2, 0xd8, 0xa2, 0xd4, 0xfc, 0x59, 0x87, 0x4b,
+	0xb2, 0xf3, 0xaf, 0x6c, 0x75, 0xc6, 0xbd, 0xc2, 0xeb, 0x70, 0x4f, 0x3d, 0x10, 0x4b, 0x33, 0x1e,
+	0x88, 0x69, 0x89, 0x64, 0x4c, 0x7c, 0x3f, 0x8e, 0xf0, 0xbb, 0xe8, 0x7f, 0xd3, 0xea, 0xe5, 0xb4,
+	0x9d, 0x86, 0x06, 0x8b, 0xf9, 0x35, 0xa8, 0xf9, 0x83, 0x06, 0x15, 0xc6, 0xf7, 0xdc, 0xb7, 0x35,
+	0x93, 0xc0, 0x4e, 0x93, 0x7c, 0x5d, 0x2b, 0x09, 0x2c, 0x24, 0x24, 0xb0, 0x16, 0x7a, 0x23, 0x57,
+	0x00, 0x57, 0x5f, 0x91, 0xc0, 0x53, 0x09, 0xe2, 0xeb, 0x71, 0x84, 0x6f, 0x42, 0x89, 0x45, 0x28,
+	0x2a, 0x1b, 0x1a, 0xbf, 0x88, 0x18, 0xea, 0xfc, 0x16, 0x64, 0x3a, 0xf3, 0x7b, 0x0d, 0x2a, 0x07,
+	0xfe, 0x80, 0xbc, 0x8e, 0xeb, 0xc4, 0x79, 0xec, 0x78, 0x1e, 0xc1, 0xee, 0x3f, 0x71, 0x84, 0xd7,
+	0xd2, 0x3b, 0x81, 0xba, 0x0d, 0x88, 0x1c, 0x50, 0x62, 0x48, 0x6a, 0x7e, 0xa3, 0xc3, 0xa2, 0xd8,
+	0x6c, 0xe2, 0x05, 0x6e, 0x30, 0x61, 0x1c, 0xff, 0x0f, 0x65, 0x97, 0xd2, 0x90, 0x8c, 0xe5, 0xfb,
+	0x5f, 0xd6, 0xc4, 0x3e, 0x8f, 0xf1, 0x9a, 0x10, 0xc3, 0xe8, 0x16, 0x54, 0x68, 0xd8, 0xfb, 0x8a,
+	0xd8, 0xca, 0x52, 0x23, 0x8e, 0x70, 0x0d, 0xe0, 0x50, 0x04, 0x19, 0x54, 0x01, 0x2e, 0x50, 0x69,
+	0x5b, 0xb9, 0x67, 0xeb, 0xf5, 0xa9, 0x1b, 0x90, 0xd0, 0x15, 0x52, 0x3f, 0x88, 0x23, 0x7c, 0x0f,
+	0x5d, 0x56, 0xbc, 0x13, 0x5a, 0x86, 0x86, 0x2e, 0x27, 0xe7, 0x4f, 0x4f, 0x8b, 0xa9, 0x9e, 0x59,
+	0xc3, 0x25, 0xd4, 0xfc, 0x4b, 0x83, 0x1a, 0x0b, 0x7d, 0xf4, 0x42, 0x9e, 0xb8, 0x0c, 0x5b, 0xed,
+	0x3c, 0xb6, 0x60, 0x8f, 0x89, 0x15, 0xb0, 0x77, 0x33, 0x3d, 0x75, 0xe8, 0x44, 0xbc, 0x43, 0xc5,
+	0xa1, 0x53, 0xbd, 0xf4, 0x28, 0x15, 0xce, 0x3a, 0x4a, 0xcd, 0xdc, 0x51, 0x6a, 0x4c, 0xb5, 0x41,
+	0xf0, 0x15, 0x1e, 0xbc, 0x15, 0x47, 0xf8, 0x0e, 0x40, 0x12, 0xa6, 0xe8, 0x86, 0xa1, 0xa5, 0x92,
+	0x33, 0x2c, 0x65, 0x7a, 0xf3, 0x4f, 0x1d, 0x8c, 0x5d, 0xcb, 0x3e, 0xee, 0x8f, 0xd9, 0xf7, 0xc3,
+	0x63, 0xbf, 0x77, 0x6e, 0x81, 0x7e, 0x0a, 0x25, 0x1a, 0x58, 0x81, 0x7a, 0xc1, 0xde, 0x7e, 0x85,
+	0x54, 0x6e, 0xb9, 0xe6, 0x61, 0x60, 0x05, 0x21, 0xe5, 0xff, 0x88, 0x92, 0xc9, 0x3b, 0x5c, 0x26,
+	0x5f, 0x09, 0xb5, 0xa0, 0xea, 0x91, 0x93, 0xa0, 0x3b, 0x0e, 0x3d, 0x66, 0x60, 0x21, 0x6b, 0xe0,
+	0x53, 0x72, 0x12, 0x1c, 0x84, 0x9e, 0x34, 0xd0, 0x53, 0xbd, 0xec, 0xee, 0x14, 0xcf, 0xde, 0x9d,
+	0x56, 0xce, 0xc4, 0x1b, 0x67, 0xf3, 0x95, 0x46, 0x7e, 0x1e, 0x47, 0xb8, 0x03, 0xf5, 0xdc, 0x50,
+	0x7a, 0xbc, 0x6f, 0x4a, 0x0b, 0x72, 0xb4, 0xf9, 0x17, 0x46, 0x89, 0x4b, 0x33, 0x74, 0xb4, 0x3c,
+	0xad, 0xe4, 0xd8, 0x35, 0xfb, 0x37, 0x1d, 0x16, 0xf6, 0x42, 0x1a, 0xf8, 0xc3, 0x47, 0x56, 0x60,
+	0x31, 0xb7, 0x6f, 0xc3, 0xdc, 0x31, 0x99, 0x74, 0xf9, 0xa5, 0x45, 0x78, 0x2e, 0x8f, 0xd0, 0x13,
+	0x32, 0x51, 0xf7, 0x92, 0xca, 0xb1, 0x68, 0xb3, 0xc7, 0xc3, 0x31, 0x99, 0x6c, 0xc9, 0x22, 0x93,
+	0x8f, 0x87, 0x27, 0x64, 0xb2, 0xc5, 0x1f, 0x0f, 0x6c, 0x48, 0x42, 0x5a, 0xd2, 0xc6, 0x14, 0xd2,
+	0x52, 0x90, 0x96, 0x84, 0x6c, 0x4b, 0xe7, 0x52, 0xc8, 0xb6, 0x82, 0x6c, 0x4b, 0xc8, 0x0e, 0x77,
+	0x2d, 0x0b, 0xd9, 0x51, 0x90, 0x1d, 0x09, 0xb9, 0xcb, 0xbf, 0x48, 0xb3, 0x90, 0xbb, 0x0a, 0x72,
+	0x37, 0xb9, 0x46, 0x54, 0x66, 0x5c, 0x23, 0x32, 0x4e, 0x64, 0xaf, 0x8c, 0x90, 0xc6, 0xd1, 0x2d,
+	0x43, 0x4b, 0x0d, 0x12, 0xea, 0x85, 0x40, 0xa1, 0x41, 0xd0, 0x14, 0x4c, 0x76, 0xcd, 0x2f, 0xf0,
+	0xec, 0x4f, 0x7e, 0xf1, 0xdb, 0x41, 0xaf, 0xcc, 0xbf, 0xf5, 0xb7, 0xff, 0x0e, 0x00, 0x00, 0xff,
+	0xff, 0x17, 0x05, 0x7a, 0xc4, 0x6a, 0x10, 0x00, 0x00,
 }
@@ -36,6 +36,8 @@ var SqlTables = map[string][]string{
 
 			"\"sched_order\" integer NOT NULL, " +
 
+			"\"pending_deletion_order\" bigint NOT NULL, " +
+
 			"\"data\" bytea NOT NULL, " +
 
 			"PRIMARY KEY(\"id\")" +
@@ -48,12 +50,16 @@ var SqlTables = map[string][]string{
 
 		"CREATE INDEX \"PicsSchedOrder\" ON \"Pics\" (\"sched_order\",\"id\");",
 
+		"CREATE INDEX \"PicsPendingDeletionOrder\" ON \"Pics\" (\"pending_deletion_order\",\"id\");",
+
 		"CREATE TABLE \"Tags\" (" +
 
 			"\"id\" bigint NOT NULL, " +
 
 			"\"name\" bytea NOT NULL, " +
 
+			"\"usage_count\" bigint NOT NULL, " +
+
 			"\"data\" bytea NOT NULL, " +
 
 			"UNIQUE(\"name\"), " +
@@ -62,6 +68,36 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX \"TagsUsageCount\" ON \"Tags\" (\"usage_count\",\"id\");",
+
+		"CREATE TABLE \"TagAliases\" (" +
+
+			"\"name\" bytea NOT NULL, " +
+
+			"\"tag_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"name\")" +
+
+			");",
+
+		"CREATE INDEX \"TagAliasesTagId\" ON \"TagAliases\" (\"tag_id\",\"name\");",
+
+		"CREATE TABLE \"TagImplications\" (" +
+
+			"\"tag_id\" bigint NOT NULL, " +
+
+			"\"implied_tag_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"tag_id\",\"implied_tag_id\")" +
+
+			");",
+
+		"CREATE INDEX \"TagImplicationsImpliedTagId\" ON \"TagImplications\" (\"implied_tag_id\",\"tag_id\");",
+
 		"CREATE TABLE \"PicTags\" (" +
 
 			"\"pic_id\" bigint NOT NULL, " +
@@ -74,6 +110,8 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX \"PicTagsTagId\" ON \"PicTags\" (\"tag_id\",\"pic_id\");",
+
 		"CREATE TABLE \"PicIdents\" (" +
 
 			"\"pic_id\" bigint NOT NULL, " +
@@ -150,6 +188,36 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE TABLE \"Roles\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"name\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"name\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"UserIdentities\" (" +
+
+			"\"issuer\" bytea NOT NULL, " +
+
+			"\"subject\" bytea NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"issuer\",\"subject\")" +
+
+			");",
+
+		"CREATE INDEX \"UserIdentitiesUserId\" ON \"UserIdentities\" (\"user_id\");",
+
 		"CREATE TABLE \"UserEvents\" (" +
 
 			"\"user_id\" bigint NOT NULL, " +
@@ -164,6 +232,26 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE TABLE \"BackgroundJobs\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"state\" integer NOT NULL, " +
+
+			"\"next_run_ts\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"BackgroundJobsState\" ON \"BackgroundJobs\" (\"state\",\"next_run_ts\",\"id\");",
+
+		"CREATE INDEX \"BackgroundJobsUserId\" ON \"BackgroundJobs\" (\"user_id\",\"id\");",
+
 		"CREATE TABLE \"CustomData\" (" +
 
 			"\"key_type\" bigint NOT NULL, " +
@@ -199,6 +287,8 @@ var SqlTables = map[string][]string{
 
 			"`sched_order` int NOT NULL, " +
 
+			"`pending_deletion_order` bigint(20) NOT NULL, " +
+
 			"`data` blob NOT NULL, " +
 
 			"PRIMARY KEY(`id`)" +
@@ -211,12 +301,16 @@ var SqlTables = map[string][]string{
 
 		"CREATE INDEX `PicsSchedOrder` ON `Pics` (`sched_order`,`id`);",
 
+		"CREATE INDEX `PicsPendingDeletionOrder` ON `Pics` (`pending_deletion_order`,`id`);",
+
 		"CREATE TABLE `Tags` (" +
 
 			"`id` bigint(20) NOT NULL, " +
 
 			"`name` blob NOT NULL, " +
 
+			"`usage_count` bigint(20) NOT NULL, " +
+
 			"`data` blob NOT NULL, " +
 
 			"UNIQUE(`name`(255)), " +
@@ -225,6 +319,36 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX `TagsUsageCount` ON `Tags` (`usage_count`,`id`);",
+
+		"CREATE TABLE `TagAliases` (" +
+
+			"`name` blob NOT NULL, " +
+
+			"`tag_id` bigint(20) NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`name`(255))" +
+
+			");",
+
+		"CREATE INDEX `TagAliasesTagId` ON `TagAliases` (`tag_id`,`name`(255));",
+
+		"CREATE TABLE `TagImplications` (" +
+
+			"`tag_id` bigint(20) NOT NULL, " +
+
+			"`implied_tag_id` bigint(20) NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`tag_id`,`implied_tag_id`)" +
+
+			");",
+
+		"CREATE INDEX `TagImplicationsImpliedTagId` ON `TagImplications` (`implied_tag_id`,`tag_id`);",
+
 		"CREATE TABLE `PicTags` (" +
 
 			"`pic_id` bigint(20) NOT NULL, " +
@@ -237,6 +361,8 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX `PicTagsTagId` ON `PicTags` (`tag_id`,`pic_id`);",
+
 		"CREATE TABLE `PicIdents` (" +
 
 			"`pic_id` bigint(20) NOT NULL, " +
@@ -313,6 +439,36 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE TABLE `Roles` (" +
+
+			"`id` bigint(20) NOT NULL, " +
+
+			"`name` blob NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"UNIQUE(`name`(255)), " +
+
+			"PRIMARY KEY(`id`)" +
+
+			");",
+
+		"CREATE TABLE `UserIdentities` (" +
+
+			"`issuer` blob NOT NULL, " +
+
+			"`subject` blob NOT NULL, " +
+
+			"`user_id` bigint(20) NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`issuer`(255),`subject`(255))" +
+
+			");",
+
+		"CREATE INDEX `UserIdentitiesUserId` ON `UserIdentities` (`user_id`);",
+
 		"CREATE TABLE `UserEvents` (" +
 
 			"`user_id` bigint(20) NOT NULL, " +
@@ -327,6 +483,26 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE TABLE `BackgroundJobs` (" +
+
+			"`id` bigint(20) NOT NULL, " +
+
+			"`state` int NOT NULL, " +
+
+			"`next_run_ts` bigint(20) NOT NULL, " +
+
+			"`user_id` bigint(20) NOT NULL, " +
+
+			"`data` blob NOT NULL, " +
+
+			"PRIMARY KEY(`id`)" +
+
+			");",
+
+		"CREATE INDEX `BackgroundJobsState` ON `BackgroundJobs` (`state`,`next_run_ts`,`id`);",
+
+		"CREATE INDEX `BackgroundJobsUserId` ON `BackgroundJobs` (`user_id`,`id`);",
+
 		"CREATE TABLE `CustomData` (" +
 
 			"`key_type` bigint(20) NOT NULL, " +
@@ -362,6 +538,8 @@ var SqlTables = map[string][]string{
 
 			"\"sched_order\" integer NOT NULL, " +
 
+			"\"pending_deletion_order\" bigint NOT NULL, " +
+
 			"\"data\" bytea NOT NULL, " +
 
 			"PRIMARY KEY(\"id\")" +
@@ -374,12 +552,16 @@ var SqlTables = map[string][]string{
 
 		"CREATE INDEX \"PicsSchedOrder\" ON \"Pics\" (\"sched_order\",\"id\");",
 
+		"CREATE INDEX \"PicsPendingDeletionOrder\" ON \"Pics\" (\"pending_deletion_order\",\"id\");",
+
 		"CREATE TABLE \"Tags\" (" +
 
 			"\"id\" bigint NOT NULL, " +
 
 			"\"name\" bytea NOT NULL, " +
 
+			"\"usage_count\" bigint NOT NULL, " +
+
 			"\"data\" bytea NOT NULL, " +
 
 			"UNIQUE(\"name\"), " +
@@ -388,6 +570,36 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX \"TagsUsageCount\" ON \"Tags\" (\"usage_count\",\"id\");",
+
+		"CREATE TABLE \"TagAliases\" (" +
+
+			"\"name\" bytea NOT NULL, " +
+
+			"\"tag_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"name\")" +
+
+			");",
+
+		"CREATE INDEX \"TagAliasesTagId\" ON \"TagAliases\" (\"tag_id\",\"name\");",
+
+		"CREATE TABLE \"TagImplications\" (" +
+
+			"\"tag_id\" bigint NOT NULL, " +
+
+			"\"implied_tag_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"tag_id\",\"implied_tag_id\")" +
+
+			");",
+
+		"CREATE INDEX \"TagImplicationsImpliedTagId\" ON \"TagImplications\" (\"implied_tag_id\",\"tag_id\");",
+
 		"CREATE TABLE \"PicTags\" (" +
 
 			"\"pic_id\" bigint NOT NULL, " +
@@ -400,6 +612,8 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX \"PicTagsTagId\" ON \"PicTags\" (\"tag_id\",\"pic_id\");",
+
 		"CREATE TABLE \"PicIdents\" (" +
 
 			"\"pic_id\" bigint NOT NULL, " +
@@ -476,6 +690,36 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE TABLE \"Roles\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"name\" bytea NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"UNIQUE(\"name\"), " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE TABLE \"UserIdentities\" (" +
+
+			"\"issuer\" bytea NOT NULL, " +
+
+			"\"subject\" bytea NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"issuer\",\"subject\")" +
+
+			");",
+
+		"CREATE INDEX \"UserIdentitiesUserId\" ON \"UserIdentities\" (\"user_id\");",
+
 		"CREATE TABLE \"UserEvents\" (" +
 
 			"\"user_id\" bigint NOT NULL, " +
@@ -490,6 +734,26 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE TABLE \"BackgroundJobs\" (" +
+
+			"\"id\" bigint NOT NULL, " +
+
+			"\"state\" integer NOT NULL, " +
+
+			"\"next_run_ts\" bigint NOT NULL, " +
+
+			"\"user_id\" bigint NOT NULL, " +
+
+			"\"data\" bytea NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"BackgroundJobsState\" ON \"BackgroundJobs\" (\"state\",\"next_run_ts\",\"id\");",
+
+		"CREATE INDEX \"BackgroundJobsUserId\" ON \"BackgroundJobs\" (\"user_id\",\"id\");",
+
 		"CREATE TABLE \"CustomData\" (" +
 
 			"\"key_type\" bigint NOT NULL, " +
@@ -525,6 +789,8 @@ var SqlTables = map[string][]string{
 
 			"\"sched_order\" integer NOT NULL, " +
 
+			"\"pending_deletion_order\" integer NOT NULL, " +
+
 			"\"data\" blob NOT NULL, " +
 
 			"PRIMARY KEY(\"id\")" +
@@ -537,12 +803,16 @@ var SqlTables = map[string][]string{
 
 		"CREATE INDEX \"PicsSchedOrder\" ON \"Pics\" (\"sched_order\",\"id\");",
 
+		"CREATE INDEX \"PicsPendingDeletionOrder\" ON \"Pics\" (\"pending_deletion_order\",\"id\");",
+
 		"CREATE TABLE \"Tags\" (" +
 
 			"\"id\" integer NOT NULL, " +
 
 			"\"name\" blob NOT NULL, " +
 
+			"\"usage_count\" integer NOT NULL, " +
+
 			"\"data\" blob NOT NULL, " +
 
 			"UNIQUE(\"name\"), " +
@@ -551,6 +821,36 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX \"TagsUsageCount\" ON \"Tags\" (\"usage_count\",\"id\");",
+
+		"CREATE TABLE \"TagAliases\" (" +
+
+			"\"name\" blob NOT NULL, " +
+
+			"\"tag_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"name\")" +
+
+			");",
+
+		"CREATE INDEX \"TagAliasesTagId\" ON \"TagAliases\" (\"tag_id\",\"name\");",
+
+		"CREATE TABLE \"TagImplications\" (" +
+
+			"\"tag_id\" integer NOT NULL, " +
+
+			"\"implied_tag_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"tag_id\",\"implied_tag_id\")" +
+
+			");",
+
+		"CREATE INDEX \"TagImplicationsImpliedTagId\" ON \"TagImplications\" (\"implied_tag_id\",\"tag_id\");",
+
 		"CREATE TABLE \"PicTags\" (" +
 
 			"\"pic_id\" integer NOT NULL, " +
@@ -563,6 +863,8 @@ var SqlTables = map[string][]string{
 
 			");",
 
+		"CREATE INDEX \"PicTagsTagId\" ON \"PicTags\" (\"tag_id\",\"pic_id\");",
+
 		"CREATE TABLE \"PicIdents\" (" +
 
 			"\"pic_id\" integer NOT NULL, " +
@@ -639,43 +941,93 @@ var SqlTables = map[string][]string{
 
 			");",
 
-		"CREATE TABLE \"UserEvents\" (" +
-
-			"\"user_id\" integer NOT NULL, " +
+		"CREATE TABLE \"Roles\" (" +
 
-			"\"created_ts\" integer NOT NULL, " +
+			"\"id\" integer NOT NULL, " +
 
-			"\"index\" integer NOT NULL, " +
+			"\"name\" blob NOT NULL, " +
 
 			"\"data\" blob NOT NULL, " +
 
-			"PRIMARY KEY(\"user_id\",\"created_ts\",\"index\")" +
+			"UNIQUE(\"name\"), " +
+
+			"PRIMARY KEY(\"id\")" +
 
 			");",
 
-		"CREATE TABLE \"CustomData\" (" +
+		"CREATE TABLE \"UserIdentities\" (" +
 
-			"\"key_type\" integer NOT NULL, " +
+			"\"issuer\" blob NOT NULL, " +
 
-			"\"key1\" integer NOT NULL, " +
+			"\"subject\" blob NOT NULL, " +
 
-			"\"key2\" integer NOT NULL, " +
+			"\"user_id\" integer NOT NULL, " +
 
-			"\"key3\" integer NOT NULL, " +
+			"\"data\" blob NOT NULL, " +
 
-			"\"key4\" integer NOT NULL, " +
+			"PRIMARY KEY(\"issuer\",\"subject\")" +
 
-			"\"key5\" integer NOT NULL, " +
+			");",
+
+		"CREATE INDEX \"UserIdentitiesUserId\" ON \"UserIdentities\" (\"user_id\");",
+
+		"CREATE TABLE \"UserEvents\" (" +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"created_ts\" integer NOT NULL, " +
+
+			"\"index\" integer NOT NULL, " +
 
 			"\"data\" blob NOT NULL, " +
 
-			"PRIMARY KEY(\"key_type\",\"key1\",\"key2\",\"key3\",\"key4\",\"key5\")" +
+			"PRIMARY KEY(\"user_id\",\"created_ts\",\"index\")" +
 
 			");",
 
-		"CREATE TABLE \"_SequenceTable\" (\"the_sequence\" integer NOT NULL);",
-	},
-}
+		"CREATE TABLE \"BackgroundJobs\" (" +
+
+			"\"id\" integer NOT NULL, " +
+
+			"\"state\" integer NOT NULL, " +
+
+			"\"next_run_ts\" integer NOT NULL, " +
+
+			"\"user_id\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"id\")" +
+
+			");",
+
+		"CREATE INDEX \"BackgroundJobsState\" ON \"BackgroundJobs\" (\"state\",\"next_run_ts\",\"id\");",
+
+		"CREATE INDEX \"BackgroundJobsUserId\" ON \"BackgroundJobs\" (\"user_id\",\"id\");",
+
+		"CREATE TABLE \"CustomData\" (" +
+
+			"\"key_type\" integer NOT NULL, " +
+
+			"\"key1\" integer NOT NULL, " +
+
+			"\"key2\" integer NOT NULL, " +
+
+			"\"key3\" integer NOT NULL, " +
+
+			"\"key4\" integer NOT NULL, " +
+
+			"\"key5\" integer NOT NULL, " +
+
+			"\"data\" blob NOT NULL, " +
+
+			"PRIMARY KEY(\"key_type\",\"key1\",\"key2\",\"key3\",\"key4\",\"key5\")" +
+
+			");",
+
+		"CREATE TABLE \"_SequenceTable\" (\"the_sequence\" integer NOT NULL);",
+	},
+}
 
 var SqlInitTables = map[string][]string{
 
@@ -914,6 +1266,44 @@ func (idx PicsSchedOrder) Vals() (vals []interface{}) {
 	return
 }
 
+type PicsPendingDeletionOrder struct {
+	PendingDeletionOrder *int64
+
+	Id *int64
+}
+
+var _ db.Idx = PicsPendingDeletionOrder{}
+
+var colsPicsPendingDeletionOrder = []string{"pending_deletion_order", "id"}
+
+func (idx PicsPendingDeletionOrder) Cols() []string {
+	return colsPicsPendingDeletionOrder
+}
+
+func (idx PicsPendingDeletionOrder) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PendingDeletionOrder != nil {
+		if done {
+			panic("Extra value PendingDeletionOrder")
+		}
+		vals = append(vals, *idx.PendingDeletionOrder)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
 func KeyForPic(pb *schema.Pic) PicsPrimary {
 
 	Id := pb.IdCol()
@@ -924,7 +1314,7 @@ func KeyForPic(pb *schema.Pic) PicsPrimary {
 	}
 }
 
-var colsPics = []string{"id", "index_order", "score_order", "sched_order", "data"}
+var colsPics = []string{"id", "index_order", "score_order", "sched_order", "pending_deletion_order", "data"}
 
 func (j *Job) ScanPics(opts db.Opts, cb func(*schema.Pic) error) error {
 	return db.Scan(j.tx, "Pics", opts, func(data []byte) error {
@@ -952,6 +1342,8 @@ var _ interface{ LowerScoreBound() int32 } = (*schema.Pic)(nil)
 
 var _ interface{ UpperScoreBound() int32 } = (*schema.Pic)(nil)
 
+var _ interface{ PendingDeletionOrderCol() int64 } = (*schema.Pic)(nil)
+
 func (j *Job) InsertPic(pb *schema.Pic) error {
 	return j.InsertPicRow(&PicRow{
 		Data: pb,
@@ -963,6 +1355,8 @@ func (j *Job) InsertPic(pb *schema.Pic) error {
 		ScoreOrder: pb.LowerScoreBound(),
 
 		SchedOrder: pb.UpperScoreBound(),
+
+		PendingDeletionOrder: pb.PendingDeletionOrderCol(),
 	})
 }
 
@@ -977,6 +1371,8 @@ func (j *Job) InsertPicRow(row *PicRow) error {
 
 	vals = append(vals, row.SchedOrder)
 
+	vals = append(vals, row.PendingDeletionOrder)
+
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
 	} else {
@@ -994,6 +1390,8 @@ var _ interface{ LowerScoreBound() int32 } = (*schema.Pic)(nil)
 
 var _ interface{ UpperScoreBound() int32 } = (*schema.Pic)(nil)
 
+var _ interface{ PendingDeletionOrderCol() int64 } = (*schema.Pic)(nil)
+
 func (j *Job) UpdatePic(pb *schema.Pic) error {
 	return j.UpdatePicRow(&PicRow{
 		Data: pb,
@@ -1005,6 +1403,8 @@ func (j *Job) UpdatePic(pb *schema.Pic) error {
 		ScoreOrder: pb.LowerScoreBound(),
 
 		SchedOrder: pb.UpperScoreBound(),
+
+		PendingDeletionOrder: pb.PendingDeletionOrderCol(),
 	})
 }
 
@@ -1021,6 +1421,8 @@ func (j *Job) UpdatePicRow(row *PicRow) error {
 
 	vals = append(vals, row.SchedOrder)
 
+	vals = append(vals, row.PendingDeletionOrder)
+
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
 	} else {
@@ -1092,6 +1494,44 @@ func (idx TagsName) Vals() (vals []interface{}) {
 	return
 }
 
+type TagsUsageCount struct {
+	UsageCount *int64
+
+	Id *int64
+}
+
+var _ db.Idx = TagsUsageCount{}
+
+var colsTagsUsageCount = []string{"usage_count", "id"}
+
+func (idx TagsUsageCount) Cols() []string {
+	return colsTagsUsageCount
+}
+
+func (idx TagsUsageCount) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.UsageCount != nil {
+		if done {
+			panic("Extra value UsageCount")
+		}
+		vals = append(vals, *idx.UsageCount)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
 func KeyForTag(pb *schema.Tag) TagsPrimary {
 
 	Id := pb.IdCol()
@@ -1102,7 +1542,7 @@ func KeyForTag(pb *schema.Tag) TagsPrimary {
 	}
 }
 
-var colsTags = []string{"id", "name", "data"}
+var colsTags = []string{"id", "name", "usage_count", "data"}
 
 func (j *Job) ScanTags(opts db.Opts, cb func(*schema.Tag) error) error {
 	return db.Scan(j.tx, "Tags", opts, func(data []byte) error {
@@ -1126,6 +1566,8 @@ var _ interface{ IdCol() int64 } = (*schema.Tag)(nil)
 
 var _ interface{ NameCol() string } = (*schema.Tag)(nil)
 
+var _ interface{ UsageCountCol() int64 } = (*schema.Tag)(nil)
+
 func (j *Job) InsertTag(pb *schema.Tag) error {
 	return j.InsertTagRow(&TagRow{
 		Data: pb,
@@ -1133,6 +1575,8 @@ func (j *Job) InsertTag(pb *schema.Tag) error {
 		Id: pb.IdCol(),
 
 		Name: pb.NameCol(),
+
+		UsageCount: pb.UsageCountCol(),
 	})
 }
 
@@ -1143,6 +1587,8 @@ func (j *Job) InsertTagRow(row *TagRow) error {
 
 	vals = append(vals, row.Name)
 
+	vals = append(vals, row.UsageCount)
+
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
 	} else {
@@ -1156,6 +1602,8 @@ var _ interface{ IdCol() int64 } = (*schema.Tag)(nil)
 
 var _ interface{ NameCol() string } = (*schema.Tag)(nil)
 
+var _ interface{ UsageCountCol() int64 } = (*schema.Tag)(nil)
+
 func (j *Job) UpdateTag(pb *schema.Tag) error {
 	return j.UpdateTagRow(&TagRow{
 		Data: pb,
@@ -1163,6 +1611,8 @@ func (j *Job) UpdateTag(pb *schema.Tag) error {
 		Id: pb.IdCol(),
 
 		Name: pb.NameCol(),
+
+		UsageCount: pb.UsageCountCol(),
 	})
 }
 
@@ -1175,6 +1625,8 @@ func (j *Job) UpdateTagRow(row *TagRow) error {
 
 	vals = append(vals, row.Name)
 
+	vals = append(vals, row.UsageCount)
+
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
 	} else {
@@ -1188,34 +1640,52 @@ func (j *Job) DeleteTag(key TagsPrimary) error {
 	return db.Delete(j.tx, "Tags", key, j.adap)
 }
 
-type PicTagsPrimary struct {
-	PicId *int64
-
-	TagId *int64
+type TagAliasesPrimary struct {
+	Name *string
 }
 
-func (_ PicTagsPrimary) Unique() {}
+func (_ TagAliasesPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicTagsPrimary{}
+var _ db.UniqueIdx = TagAliasesPrimary{}
 
-var colsPicTagsPrimary = []string{"pic_id", "tag_id"}
+var colsTagAliasesPrimary = []string{"name"}
 
-func (idx PicTagsPrimary) Cols() []string {
-	return colsPicTagsPrimary
+func (idx TagAliasesPrimary) Cols() []string {
+	return colsTagAliasesPrimary
 }
 
-func (idx PicTagsPrimary) Vals() (vals []interface{}) {
+func (idx TagAliasesPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.PicId != nil {
+	if idx.Name != nil {
 		if done {
-			panic("Extra value PicId")
+			panic("Extra value Name")
 		}
-		vals = append(vals, *idx.PicId)
+		vals = append(vals, *idx.Name)
 	} else {
 		done = true
 	}
 
+	return
+}
+
+type TagAliasesTagId struct {
+	TagId *int64
+
+	Name *string
+}
+
+var _ db.Idx = TagAliasesTagId{}
+
+var colsTagAliasesTagId = []string{"tag_id", "name"}
+
+func (idx TagAliasesTagId) Cols() []string {
+	return colsTagAliasesTagId
+}
+
+func (idx TagAliasesTagId) Vals() (vals []interface{}) {
+	var done bool
+
 	if idx.TagId != nil {
 		if done {
 			panic("Extra value TagId")
@@ -1225,28 +1695,33 @@ func (idx PicTagsPrimary) Vals() (vals []interface{}) {
 		done = true
 	}
 
+	if idx.Name != nil {
+		if done {
+			panic("Extra value Name")
+		}
+		vals = append(vals, *idx.Name)
+	} else {
+		done = true
+	}
+
 	return
 }
 
-func KeyForPicTag(pb *schema.PicTag) PicTagsPrimary {
-
-	PicId := pb.PicIdCol()
-
-	TagId := pb.TagIdCol()
+func KeyForTagAlias(pb *schema.TagAlias) TagAliasesPrimary {
 
-	return PicTagsPrimary{
+	Name := pb.NameCol()
 
-		PicId: &PicId,
+	return TagAliasesPrimary{
 
-		TagId: &TagId,
+		Name: &Name,
 	}
 }
 
-var colsPicTags = []string{"pic_id", "tag_id", "data"}
+var colsTagAliases = []string{"name", "tag_id", "data"}
 
-func (j *Job) ScanPicTags(opts db.Opts, cb func(*schema.PicTag) error) error {
-	return db.Scan(j.tx, "PicTags", opts, func(data []byte) error {
-		var pb schema.PicTag
+func (j *Job) ScanTagAliases(opts db.Opts, cb func(*schema.TagAlias) error) error {
+	return db.Scan(j.tx, "TagAliases", opts, func(data []byte) error {
+		var pb schema.TagAlias
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1254,32 +1729,32 @@ func (j *Job) ScanPicTags(opts db.Opts, cb func(*schema.PicTag) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindPicTags(opts db.Opts) (rows []*schema.PicTag, err error) {
-	err = j.ScanPicTags(opts, func(data *schema.PicTag) error {
+func (j *Job) FindTagAliases(opts db.Opts) (rows []*schema.TagAlias, err error) {
+	err = j.ScanTagAliases(opts, func(data *schema.TagAlias) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ NameCol() string } = (*schema.TagAlias)(nil)
 
-var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ TagIdCol() int64 } = (*schema.TagAlias)(nil)
 
-func (j *Job) InsertPicTag(pb *schema.PicTag) error {
-	return j.InsertPicTagRow(&PicTagRow{
+func (j *Job) InsertTagAlias(pb *schema.TagAlias) error {
+	return j.InsertTagAliasRow(&TagAliasRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Name: pb.NameCol(),
 
 		TagId: pb.TagIdCol(),
 	})
 }
 
-func (j *Job) InsertPicTagRow(row *PicTagRow) error {
+func (j *Job) InsertTagAliasRow(row *TagAliasRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Name)
 
 	vals = append(vals, row.TagId)
 
@@ -1289,29 +1764,29 @@ func (j *Job) InsertPicTagRow(row *PicTagRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicTags", colsPicTags, vals, j.adap)
+	return db.Insert(j.tx, "TagAliases", colsTagAliases, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ NameCol() string } = (*schema.TagAlias)(nil)
 
-var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
+var _ interface{ TagIdCol() int64 } = (*schema.TagAlias)(nil)
 
-func (j *Job) UpdatePicTag(pb *schema.PicTag) error {
-	return j.UpdatePicTagRow(&PicTagRow{
+func (j *Job) UpdateTagAlias(pb *schema.TagAlias) error {
+	return j.UpdateTagAliasRow(&TagAliasRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
+		Name: pb.NameCol(),
 
 		TagId: pb.TagIdCol(),
 	})
 }
 
-func (j *Job) UpdatePicTagRow(row *PicTagRow) error {
-	key := KeyForPicTag(row.Data)
+func (j *Job) UpdateTagAliasRow(row *TagAliasRow) error {
+	key := KeyForTagAlias(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
+	vals = append(vals, row.Name)
 
 	vals = append(vals, row.TagId)
 
@@ -1321,57 +1796,46 @@ func (j *Job) UpdatePicTagRow(row *PicTagRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicTags", colsPicTags, vals, key, j.adap)
+	return db.Update(j.tx, "TagAliases", colsTagAliases, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicTag(key PicTagsPrimary) error {
-	return db.Delete(j.tx, "PicTags", key, j.adap)
+func (j *Job) DeleteTagAlias(key TagAliasesPrimary) error {
+	return db.Delete(j.tx, "TagAliases", key, j.adap)
 }
 
-type PicIdentsPrimary struct {
-	PicId *int64
-
-	Type *schema.PicIdent_Type
+type TagImplicationsPrimary struct {
+	TagId *int64
 
-	Value *[]byte
+	ImpliedTagId *int64
 }
 
-func (_ PicIdentsPrimary) Unique() {}
+func (_ TagImplicationsPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicIdentsPrimary{}
+var _ db.UniqueIdx = TagImplicationsPrimary{}
 
-var colsPicIdentsPrimary = []string{"pic_id", "type", "value"}
+var colsTagImplicationsPrimary = []string{"tag_id", "implied_tag_id"}
 
-func (idx PicIdentsPrimary) Cols() []string {
-	return colsPicIdentsPrimary
+func (idx TagImplicationsPrimary) Cols() []string {
+	return colsTagImplicationsPrimary
 }
 
-func (idx PicIdentsPrimary) Vals() (vals []interface{}) {
+func (idx TagImplicationsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.PicId != nil {
-		if done {
-			panic("Extra value PicId")
-		}
-		vals = append(vals, *idx.PicId)
-	} else {
-		done = true
-	}
-
-	if idx.Type != nil {
+	if idx.TagId != nil {
 		if done {
-			panic("Extra value Type")
+			panic("Extra value TagId")
 		}
-		vals = append(vals, *idx.Type)
+		vals = append(vals, *idx.TagId)
 	} else {
 		done = true
 	}
 
-	if idx.Value != nil {
+	if idx.ImpliedTagId != nil {
 		if done {
-			panic("Extra value Value")
+			panic("Extra value ImpliedTagId")
 		}
-		vals = append(vals, *idx.Value)
+		vals = append(vals, *idx.ImpliedTagId)
 	} else {
 		done = true
 	}
@@ -1379,37 +1843,37 @@ func (idx PicIdentsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type PicIdentsIdent struct {
-	Type *schema.PicIdent_Type
+type TagImplicationsImpliedTagId struct {
+	ImpliedTagId *int64
 
-	Value *[]byte
+	TagId *int64
 }
 
-var _ db.Idx = PicIdentsIdent{}
+var _ db.Idx = TagImplicationsImpliedTagId{}
 
-var colsPicIdentsIdent = []string{"type", "value"}
+var colsTagImplicationsImpliedTagId = []string{"implied_tag_id", "tag_id"}
 
-func (idx PicIdentsIdent) Cols() []string {
-	return colsPicIdentsIdent
+func (idx TagImplicationsImpliedTagId) Cols() []string {
+	return colsTagImplicationsImpliedTagId
 }
 
-func (idx PicIdentsIdent) Vals() (vals []interface{}) {
+func (idx TagImplicationsImpliedTagId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.Type != nil {
+	if idx.ImpliedTagId != nil {
 		if done {
-			panic("Extra value Type")
+			panic("Extra value ImpliedTagId")
 		}
-		vals = append(vals, *idx.Type)
+		vals = append(vals, *idx.ImpliedTagId)
 	} else {
 		done = true
 	}
 
-	if idx.Value != nil {
+	if idx.TagId != nil {
 		if done {
-			panic("Extra value Value")
+			panic("Extra value TagId")
 		}
-		vals = append(vals, *idx.Value)
+		vals = append(vals, *idx.TagId)
 	} else {
 		done = true
 	}
@@ -1417,29 +1881,25 @@ func (idx PicIdentsIdent) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicIdent(pb *schema.PicIdent) PicIdentsPrimary {
-
-	PicId := pb.PicIdCol()
-
-	Type := pb.TypeCol()
+func KeyForTagImplication(pb *schema.TagImplication) TagImplicationsPrimary {
 
-	Value := pb.ValueCol()
+	TagId := pb.TagIdCol()
 
-	return PicIdentsPrimary{
+	ImpliedTagId := pb.ImpliedTagIdCol()
 
-		PicId: &PicId,
+	return TagImplicationsPrimary{
 
-		Type: &Type,
+		TagId: &TagId,
 
-		Value: &Value,
+		ImpliedTagId: &ImpliedTagId,
 	}
 }
 
-var colsPicIdents = []string{"pic_id", "type", "value", "data"}
+var colsTagImplications = []string{"tag_id", "implied_tag_id", "data"}
 
-func (j *Job) ScanPicIdents(opts db.Opts, cb func(*schema.PicIdent) error) error {
-	return db.Scan(j.tx, "PicIdents", opts, func(data []byte) error {
-		var pb schema.PicIdent
+func (j *Job) ScanTagImplications(opts db.Opts, cb func(*schema.TagImplication) error) error {
+	return db.Scan(j.tx, "TagImplications", opts, func(data []byte) error {
+		var pb schema.TagImplication
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1447,40 +1907,34 @@ func (j *Job) ScanPicIdents(opts db.Opts, cb func(*schema.PicIdent) error) error
 	}, j.adap)
 }
 
-func (j *Job) FindPicIdents(opts db.Opts) (rows []*schema.PicIdent, err error) {
-	err = j.ScanPicIdents(opts, func(data *schema.PicIdent) error {
+func (j *Job) FindTagImplications(opts db.Opts) (rows []*schema.TagImplication, err error) {
+	err = j.ScanTagImplications(opts, func(data *schema.TagImplication) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
-
-var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
+var _ interface{ TagIdCol() int64 } = (*schema.TagImplication)(nil)
 
-var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
+var _ interface{ ImpliedTagIdCol() int64 } = (*schema.TagImplication)(nil)
 
-func (j *Job) InsertPicIdent(pb *schema.PicIdent) error {
-	return j.InsertPicIdentRow(&PicIdentRow{
+func (j *Job) InsertTagImplication(pb *schema.TagImplication) error {
+	return j.InsertTagImplicationRow(&TagImplicationRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
-
-		Type: pb.TypeCol(),
+		TagId: pb.TagIdCol(),
 
-		Value: pb.ValueCol(),
+		ImpliedTagId: pb.ImpliedTagIdCol(),
 	})
 }
 
-func (j *Job) InsertPicIdentRow(row *PicIdentRow) error {
+func (j *Job) InsertTagImplicationRow(row *TagImplicationRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
-
-	vals = append(vals, row.Type)
+	vals = append(vals, row.TagId)
 
-	vals = append(vals, row.Value)
+	vals = append(vals, row.ImpliedTagId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1488,37 +1942,31 @@ func (j *Job) InsertPicIdentRow(row *PicIdentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicIdents", colsPicIdents, vals, j.adap)
+	return db.Insert(j.tx, "TagImplications", colsTagImplications, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
-
-var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
+var _ interface{ TagIdCol() int64 } = (*schema.TagImplication)(nil)
 
-var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
+var _ interface{ ImpliedTagIdCol() int64 } = (*schema.TagImplication)(nil)
 
-func (j *Job) UpdatePicIdent(pb *schema.PicIdent) error {
-	return j.UpdatePicIdentRow(&PicIdentRow{
+func (j *Job) UpdateTagImplication(pb *schema.TagImplication) error {
+	return j.UpdateTagImplicationRow(&TagImplicationRow{
 		Data: pb,
 
-		PicId: pb.PicIdCol(),
-
-		Type: pb.TypeCol(),
+		TagId: pb.TagIdCol(),
 
-		Value: pb.ValueCol(),
+		ImpliedTagId: pb.ImpliedTagIdCol(),
 	})
 }
 
-func (j *Job) UpdatePicIdentRow(row *PicIdentRow) error {
-	key := KeyForPicIdent(row.Data)
+func (j *Job) UpdateTagImplicationRow(row *TagImplicationRow) error {
+	key := KeyForTagImplication(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.PicId)
-
-	vals = append(vals, row.Type)
+	vals = append(vals, row.TagId)
 
-	vals = append(vals, row.Value)
+	vals = append(vals, row.ImpliedTagId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1526,30 +1974,30 @@ func (j *Job) UpdatePicIdentRow(row *PicIdentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicIdents", colsPicIdents, vals, key, j.adap)
+	return db.Update(j.tx, "TagImplications", colsTagImplications, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicIdent(key PicIdentsPrimary) error {
-	return db.Delete(j.tx, "PicIdents", key, j.adap)
+func (j *Job) DeleteTagImplication(key TagImplicationsPrimary) error {
+	return db.Delete(j.tx, "TagImplications", key, j.adap)
 }
 
-type PicCommentsPrimary struct {
+type PicTagsPrimary struct {
 	PicId *int64
 
-	CommentId *int64
+	TagId *int64
 }
 
-func (_ PicCommentsPrimary) Unique() {}
+func (_ PicTagsPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicCommentsPrimary{}
+var _ db.UniqueIdx = PicTagsPrimary{}
 
-var colsPicCommentsPrimary = []string{"pic_id", "comment_id"}
+var colsPicTagsPrimary = []string{"pic_id", "tag_id"}
 
-func (idx PicCommentsPrimary) Cols() []string {
-	return colsPicCommentsPrimary
+func (idx PicTagsPrimary) Cols() []string {
+	return colsPicTagsPrimary
 }
 
-func (idx PicCommentsPrimary) Vals() (vals []interface{}) {
+func (idx PicTagsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
 	if idx.PicId != nil {
@@ -1561,11 +2009,11 @@ func (idx PicCommentsPrimary) Vals() (vals []interface{}) {
 		done = true
 	}
 
-	if idx.CommentId != nil {
+	if idx.TagId != nil {
 		if done {
-			panic("Extra value CommentId")
+			panic("Extra value TagId")
 		}
-		vals = append(vals, *idx.CommentId)
+		vals = append(vals, *idx.TagId)
 	} else {
 		done = true
 	}
@@ -1573,28 +2021,37 @@ func (idx PicCommentsPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type PicCommentsCommentId struct {
-	CommentId *int64
-}
+type PicTagsTagId struct {
+	TagId *int64
 
-func (_ PicCommentsCommentId) Unique() {}
+	PicId *int64
+}
 
-var _ db.UniqueIdx = PicCommentsCommentId{}
+var _ db.Idx = PicTagsTagId{}
 
-var colsPicCommentsCommentId = []string{"comment_id"}
+var colsPicTagsTagId = []string{"tag_id", "pic_id"}
 
-func (idx PicCommentsCommentId) Cols() []string {
-	return colsPicCommentsCommentId
+func (idx PicTagsTagId) Cols() []string {
+	return colsPicTagsTagId
 }
 
-func (idx PicCommentsCommentId) Vals() (vals []interface{}) {
+func (idx PicTagsTagId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.CommentId != nil {
+	if idx.TagId != nil {
 		if done {
-			panic("Extra value CommentId")
+			panic("Extra value TagId")
 		}
-		vals = append(vals, *idx.CommentId)
+		vals = append(vals, *idx.TagId)
+	} else {
+		done = true
+	}
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
 	} else {
 		done = true
 	}
@@ -1602,25 +2059,25 @@ func (idx PicCommentsCommentId) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicComment(pb *schema.PicComment) PicCommentsPrimary {
+func KeyForPicTag(pb *schema.PicTag) PicTagsPrimary {
 
 	PicId := pb.PicIdCol()
 
-	CommentId := pb.CommentIdCol()
+	TagId := pb.TagIdCol()
 
-	return PicCommentsPrimary{
+	return PicTagsPrimary{
 
 		PicId: &PicId,
 
-		CommentId: &CommentId,
+		TagId: &TagId,
 	}
 }
 
-var colsPicComments = []string{"pic_id", "comment_id", "data"}
+var colsPicTags = []string{"pic_id", "tag_id", "data"}
 
-func (j *Job) ScanPicComments(opts db.Opts, cb func(*schema.PicComment) error) error {
-	return db.Scan(j.tx, "PicComments", opts, func(data []byte) error {
-		var pb schema.PicComment
+func (j *Job) ScanPicTags(opts db.Opts, cb func(*schema.PicTag) error) error {
+	return db.Scan(j.tx, "PicTags", opts, func(data []byte) error {
+		var pb schema.PicTag
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1628,34 +2085,34 @@ func (j *Job) ScanPicComments(opts db.Opts, cb func(*schema.PicComment) error) e
 	}, j.adap)
 }
 
-func (j *Job) FindPicComments(opts db.Opts) (rows []*schema.PicComment, err error) {
-	err = j.ScanPicComments(opts, func(data *schema.PicComment) error {
+func (j *Job) FindPicTags(opts db.Opts) (rows []*schema.PicTag, err error) {
+	err = j.ScanPicTags(opts, func(data *schema.PicTag) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
 
-var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
 
-func (j *Job) InsertPicComment(pb *schema.PicComment) error {
-	return j.InsertPicCommentRow(&PicCommentRow{
+func (j *Job) InsertPicTag(pb *schema.PicTag) error {
+	return j.InsertPicTagRow(&PicTagRow{
 		Data: pb,
 
 		PicId: pb.PicIdCol(),
 
-		CommentId: pb.CommentIdCol(),
+		TagId: pb.TagIdCol(),
 	})
 }
 
-func (j *Job) InsertPicCommentRow(row *PicCommentRow) error {
+func (j *Job) InsertPicTagRow(row *PicTagRow) error {
 	var vals []interface{}
 
 	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.CommentId)
+	vals = append(vals, row.TagId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1663,31 +2120,31 @@ func (j *Job) InsertPicCommentRow(row *PicCommentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicComments", colsPicComments, vals, j.adap)
+	return db.Insert(j.tx, "PicTags", colsPicTags, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicTag)(nil)
 
-var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
+var _ interface{ TagIdCol() int64 } = (*schema.PicTag)(nil)
 
-func (j *Job) UpdatePicComment(pb *schema.PicComment) error {
-	return j.UpdatePicCommentRow(&PicCommentRow{
+func (j *Job) UpdatePicTag(pb *schema.PicTag) error {
+	return j.UpdatePicTagRow(&PicTagRow{
 		Data: pb,
 
 		PicId: pb.PicIdCol(),
 
-		CommentId: pb.CommentIdCol(),
+		TagId: pb.TagIdCol(),
 	})
 }
 
-func (j *Job) UpdatePicCommentRow(row *PicCommentRow) error {
-	key := KeyForPicComment(row.Data)
+func (j *Job) UpdatePicTagRow(row *PicTagRow) error {
+	key := KeyForPicTag(row.Data)
 
 	var vals []interface{}
 
 	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.CommentId)
+	vals = append(vals, row.TagId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1695,32 +2152,32 @@ func (j *Job) UpdatePicCommentRow(row *PicCommentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicComments", colsPicComments, vals, key, j.adap)
+	return db.Update(j.tx, "PicTags", colsPicTags, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicComment(key PicCommentsPrimary) error {
-	return db.Delete(j.tx, "PicComments", key, j.adap)
+func (j *Job) DeletePicTag(key PicTagsPrimary) error {
+	return db.Delete(j.tx, "PicTags", key, j.adap)
 }
 
-type PicVotesPrimary struct {
+type PicIdentsPrimary struct {
 	PicId *int64
 
-	UserId *int64
+	Type *schema.PicIdent_Type
 
-	Index *int64
+	Value *[]byte
 }
 
-func (_ PicVotesPrimary) Unique() {}
+func (_ PicIdentsPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicVotesPrimary{}
+var _ db.UniqueIdx = PicIdentsPrimary{}
 
-var colsPicVotesPrimary = []string{"pic_id", "user_id", "index"}
+var colsPicIdentsPrimary = []string{"pic_id", "type", "value"}
 
-func (idx PicVotesPrimary) Cols() []string {
-	return colsPicVotesPrimary
+func (idx PicIdentsPrimary) Cols() []string {
+	return colsPicIdentsPrimary
 }
 
-func (idx PicVotesPrimary) Vals() (vals []interface{}) {
+func (idx PicIdentsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
 	if idx.PicId != nil {
@@ -1732,20 +2189,20 @@ func (idx PicVotesPrimary) Vals() (vals []interface{}) {
 		done = true
 	}
 
-	if idx.UserId != nil {
+	if idx.Type != nil {
 		if done {
-			panic("Extra value UserId")
+			panic("Extra value Type")
 		}
-		vals = append(vals, *idx.UserId)
+		vals = append(vals, *idx.Type)
 	} else {
 		done = true
 	}
 
-	if idx.Index != nil {
+	if idx.Value != nil {
 		if done {
-			panic("Extra value Index")
+			panic("Extra value Value")
 		}
-		vals = append(vals, *idx.Index)
+		vals = append(vals, *idx.Value)
 	} else {
 		done = true
 	}
@@ -1753,50 +2210,37 @@ func (idx PicVotesPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type PicVotesUserId struct {
-	UserId *int64
-
-	PicId *int64
+type PicIdentsIdent struct {
+	Type *schema.PicIdent_Type
 
-	Index *int64
+	Value *[]byte
 }
 
-func (_ PicVotesUserId) Unique() {}
-
-var _ db.UniqueIdx = PicVotesUserId{}
+var _ db.Idx = PicIdentsIdent{}
 
-var colsPicVotesUserId = []string{"user_id", "pic_id", "index"}
+var colsPicIdentsIdent = []string{"type", "value"}
 
-func (idx PicVotesUserId) Cols() []string {
-	return colsPicVotesUserId
+func (idx PicIdentsIdent) Cols() []string {
+	return colsPicIdentsIdent
 }
 
-func (idx PicVotesUserId) Vals() (vals []interface{}) {
+func (idx PicIdentsIdent) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.UserId != nil {
-		if done {
-			panic("Extra value UserId")
-		}
-		vals = append(vals, *idx.UserId)
-	} else {
-		done = true
-	}
-
-	if idx.PicId != nil {
+	if idx.Type != nil {
 		if done {
-			panic("Extra value PicId")
+			panic("Extra value Type")
 		}
-		vals = append(vals, *idx.PicId)
+		vals = append(vals, *idx.Type)
 	} else {
 		done = true
 	}
 
-	if idx.Index != nil {
+	if idx.Value != nil {
 		if done {
-			panic("Extra value Index")
+			panic("Extra value Value")
 		}
-		vals = append(vals, *idx.Index)
+		vals = append(vals, *idx.Value)
 	} else {
 		done = true
 	}
@@ -1804,29 +2248,29 @@ func (idx PicVotesUserId) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicVote(pb *schema.PicVote) PicVotesPrimary {
+func KeyForPicIdent(pb *schema.PicIdent) PicIdentsPrimary {
 
 	PicId := pb.PicIdCol()
 
-	UserId := pb.UserIdCol()
+	Type := pb.TypeCol()
 
-	Index := pb.IndexCol()
+	Value := pb.ValueCol()
 
-	return PicVotesPrimary{
+	return PicIdentsPrimary{
 
 		PicId: &PicId,
 
-		UserId: &UserId,
+		Type: &Type,
 
-		Index: &Index,
+		Value: &Value,
 	}
 }
 
-var colsPicVotes = []string{"pic_id", "user_id", "index", "data"}
+var colsPicIdents = []string{"pic_id", "type", "value", "data"}
 
-func (j *Job) ScanPicVotes(opts db.Opts, cb func(*schema.PicVote) error) error {
-	return db.Scan(j.tx, "PicVotes", opts, func(data []byte) error {
-		var pb schema.PicVote
+func (j *Job) ScanPicIdents(opts db.Opts, cb func(*schema.PicIdent) error) error {
+	return db.Scan(j.tx, "PicIdents", opts, func(data []byte) error {
+		var pb schema.PicIdent
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -1834,40 +2278,40 @@ func (j *Job) ScanPicVotes(opts db.Opts, cb func(*schema.PicVote) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindPicVotes(opts db.Opts) (rows []*schema.PicVote, err error) {
-	err = j.ScanPicVotes(opts, func(data *schema.PicVote) error {
+func (j *Job) FindPicIdents(opts db.Opts) (rows []*schema.PicIdent, err error) {
+	err = j.ScanPicIdents(opts, func(data *schema.PicIdent) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
 
-var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
 
-func (j *Job) InsertPicVote(pb *schema.PicVote) error {
-	return j.InsertPicVoteRow(&PicVoteRow{
+func (j *Job) InsertPicIdent(pb *schema.PicIdent) error {
+	return j.InsertPicIdentRow(&PicIdentRow{
 		Data: pb,
 
 		PicId: pb.PicIdCol(),
 
-		UserId: pb.UserIdCol(),
+		Type: pb.TypeCol(),
 
-		Index: pb.IndexCol(),
+		Value: pb.ValueCol(),
 	})
 }
 
-func (j *Job) InsertPicVoteRow(row *PicVoteRow) error {
+func (j *Job) InsertPicIdentRow(row *PicIdentRow) error {
 	var vals []interface{}
 
 	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.UserId)
+	vals = append(vals, row.Type)
 
-	vals = append(vals, row.Index)
+	vals = append(vals, row.Value)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1875,37 +2319,37 @@ func (j *Job) InsertPicVoteRow(row *PicVoteRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicVotes", colsPicVotes, vals, j.adap)
+	return db.Insert(j.tx, "PicIdents", colsPicIdents, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicIdent)(nil)
 
-var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ TypeCol() schema.PicIdent_Type } = (*schema.PicIdent)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+var _ interface{ ValueCol() []byte } = (*schema.PicIdent)(nil)
 
-func (j *Job) UpdatePicVote(pb *schema.PicVote) error {
-	return j.UpdatePicVoteRow(&PicVoteRow{
+func (j *Job) UpdatePicIdent(pb *schema.PicIdent) error {
+	return j.UpdatePicIdentRow(&PicIdentRow{
 		Data: pb,
 
 		PicId: pb.PicIdCol(),
 
-		UserId: pb.UserIdCol(),
+		Type: pb.TypeCol(),
 
-		Index: pb.IndexCol(),
+		Value: pb.ValueCol(),
 	})
 }
 
-func (j *Job) UpdatePicVoteRow(row *PicVoteRow) error {
-	key := KeyForPicVote(row.Data)
+func (j *Job) UpdatePicIdentRow(row *PicIdentRow) error {
+	key := KeyForPicIdent(row.Data)
 
 	var vals []interface{}
 
 	vals = append(vals, row.PicId)
 
-	vals = append(vals, row.UserId)
+	vals = append(vals, row.Type)
 
-	vals = append(vals, row.Index)
+	vals = append(vals, row.Value)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -1913,34 +2357,30 @@ func (j *Job) UpdatePicVoteRow(row *PicVoteRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicVotes", colsPicVotes, vals, key, j.adap)
+	return db.Update(j.tx, "PicIdents", colsPicIdents, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicVote(key PicVotesPrimary) error {
-	return db.Delete(j.tx, "PicVotes", key, j.adap)
+func (j *Job) DeletePicIdent(key PicIdentsPrimary) error {
+	return db.Delete(j.tx, "PicIdents", key, j.adap)
 }
 
-type PicCommentVotesPrimary struct {
+type PicCommentsPrimary struct {
 	PicId *int64
 
 	CommentId *int64
-
-	UserId *int64
-
-	Index *int64
 }
 
-func (_ PicCommentVotesPrimary) Unique() {}
+func (_ PicCommentsPrimary) Unique() {}
 
-var _ db.UniqueIdx = PicCommentVotesPrimary{}
+var _ db.UniqueIdx = PicCommentsPrimary{}
 
-var colsPicCommentVotesPrimary = []string{"pic_id", "comment_id", "user_id", "index"}
+var colsPicCommentsPrimary = []string{"pic_id", "comment_id"}
 
-func (idx PicCommentVotesPrimary) Cols() []string {
-	return colsPicCommentVotesPrimary
+func (idx PicCommentsPrimary) Cols() []string {
+	return colsPicCommentsPrimary
 }
 
-func (idx PicCommentVotesPrimary) Vals() (vals []interface{}) {
+func (idx PicCommentsPrimary) Vals() (vals []interface{}) {
 	var done bool
 
 	if idx.PicId != nil {
@@ -1961,20 +2401,31 @@ func (idx PicCommentVotesPrimary) Vals() (vals []interface{}) {
 		done = true
 	}
 
-	if idx.UserId != nil {
-		if done {
-			panic("Extra value UserId")
-		}
-		vals = append(vals, *idx.UserId)
-	} else {
-		done = true
-	}
+	return
+}
 
-	if idx.Index != nil {
+type PicCommentsCommentId struct {
+	CommentId *int64
+}
+
+func (_ PicCommentsCommentId) Unique() {}
+
+var _ db.UniqueIdx = PicCommentsCommentId{}
+
+var colsPicCommentsCommentId = []string{"comment_id"}
+
+func (idx PicCommentsCommentId) Cols() []string {
+	return colsPicCommentsCommentId
+}
+
+func (idx PicCommentsCommentId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.CommentId != nil {
 		if done {
-			panic("Extra value Index")
+			panic("Extra value CommentId")
 		}
-		vals = append(vals, *idx.Index)
+		vals = append(vals, *idx.CommentId)
 	} else {
 		done = true
 	}
@@ -1982,33 +2433,25 @@ func (idx PicCommentVotesPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForPicCommentVote(pb *schema.PicCommentVote) PicCommentVotesPrimary {
+func KeyForPicComment(pb *schema.PicComment) PicCommentsPrimary {
 
 	PicId := pb.PicIdCol()
 
 	CommentId := pb.CommentIdCol()
 
-	UserId := pb.UserIdCol()
-
-	Index := pb.IndexCol()
-
-	return PicCommentVotesPrimary{
+	return PicCommentsPrimary{
 
 		PicId: &PicId,
 
 		CommentId: &CommentId,
-
-		UserId: &UserId,
-
-		Index: &Index,
 	}
 }
 
-var colsPicCommentVotes = []string{"pic_id", "comment_id", "user_id", "index", "data"}
+var colsPicComments = []string{"pic_id", "comment_id", "data"}
 
-func (j *Job) ScanPicCommentVotes(opts db.Opts, cb func(*schema.PicCommentVote) error) error {
-	return db.Scan(j.tx, "PicCommentVotes", opts, func(data []byte) error {
-		var pb schema.PicCommentVote
+func (j *Job) ScanPicComments(opts db.Opts, cb func(*schema.PicComment) error) error {
+	return db.Scan(j.tx, "PicComments", opts, func(data []byte) error {
+		var pb schema.PicComment
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -2016,80 +2459,60 @@ func (j *Job) ScanPicCommentVotes(opts db.Opts, cb func(*schema.PicCommentVote)
 	}, j.adap)
 }
 
-func (j *Job) FindPicCommentVotes(opts db.Opts) (rows []*schema.PicCommentVote, err error) {
-	err = j.ScanPicCommentVotes(opts, func(data *schema.PicCommentVote) error {
+func (j *Job) FindPicComments(opts db.Opts) (rows []*schema.PicComment, err error) {
+	err = j.ScanPicComments(opts, func(data *schema.PicComment) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
 
-func (j *Job) InsertPicCommentVote(pb *schema.PicCommentVote) error {
-	return j.InsertPicVoteCommentRow(&PicVoteCommentRow{
+func (j *Job) InsertPicComment(pb *schema.PicComment) error {
+	return j.InsertPicCommentRow(&PicCommentRow{
 		Data: pb,
 
 		PicId: pb.PicIdCol(),
 
 		CommentId: pb.CommentIdCol(),
-
-		UserId: pb.UserIdCol(),
-
-		Index: pb.IndexCol(),
 	})
 }
 
-func (j *Job) InsertPicVoteCommentRow(row *PicVoteCommentRow) error {
+func (j *Job) InsertPicCommentRow(row *PicCommentRow) error {
 	var vals []interface{}
 
 	vals = append(vals, row.PicId)
 
 	vals = append(vals, row.CommentId)
 
-	vals = append(vals, row.UserId)
-
-	vals = append(vals, row.Index)
-
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
 	} else {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, j.adap)
+	return db.Insert(j.tx, "PicComments", colsPicComments, vals, j.adap)
 }
 
-var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
-
-var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
+var _ interface{ PicIdCol() int64 } = (*schema.PicComment)(nil)
 
-var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+var _ interface{ CommentIdCol() int64 } = (*schema.PicComment)(nil)
 
-func (j *Job) UpdatePicCommentVote(pb *schema.PicCommentVote) error {
-	return j.UpdatePicVoteCommentRow(&PicVoteCommentRow{
+func (j *Job) UpdatePicComment(pb *schema.PicComment) error {
+	return j.UpdatePicCommentRow(&PicCommentRow{
 		Data: pb,
 
 		PicId: pb.PicIdCol(),
 
 		CommentId: pb.CommentIdCol(),
-
-		UserId: pb.UserIdCol(),
-
-		Index: pb.IndexCol(),
 	})
 }
 
-func (j *Job) UpdatePicVoteCommentRow(row *PicVoteCommentRow) error {
-	key := KeyForPicCommentVote(row.Data)
+func (j *Job) UpdatePicCommentRow(row *PicCommentRow) error {
+	key := KeyForPicComment(row.Data)
 
 	var vals []interface{}
 
@@ -2097,9 +2520,725 @@ func (j *Job) UpdatePicVoteCommentRow(row *PicVoteCommentRow) error {
 
 	vals = append(vals, row.CommentId)
 
-	vals = append(vals, row.UserId)
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
 
-	vals = append(vals, row.Index)
+	return db.Update(j.tx, "PicComments", colsPicComments, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicComment(key PicCommentsPrimary) error {
+	return db.Delete(j.tx, "PicComments", key, j.adap)
+}
+
+type PicVotesPrimary struct {
+	PicId *int64
+
+	UserId *int64
+
+	Index *int64
+}
+
+func (_ PicVotesPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicVotesPrimary{}
+
+var colsPicVotesPrimary = []string{"pic_id", "user_id", "index"}
+
+func (idx PicVotesPrimary) Cols() []string {
+	return colsPicVotesPrimary
+}
+
+func (idx PicVotesPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Index != nil {
+		if done {
+			panic("Extra value Index")
+		}
+		vals = append(vals, *idx.Index)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type PicVotesUserId struct {
+	UserId *int64
+
+	PicId *int64
+
+	Index *int64
+}
+
+func (_ PicVotesUserId) Unique() {}
+
+var _ db.UniqueIdx = PicVotesUserId{}
+
+var colsPicVotesUserId = []string{"user_id", "pic_id", "index"}
+
+func (idx PicVotesUserId) Cols() []string {
+	return colsPicVotesUserId
+}
+
+func (idx PicVotesUserId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.Index != nil {
+		if done {
+			panic("Extra value Index")
+		}
+		vals = append(vals, *idx.Index)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPicVote(pb *schema.PicVote) PicVotesPrimary {
+
+	PicId := pb.PicIdCol()
+
+	UserId := pb.UserIdCol()
+
+	Index := pb.IndexCol()
+
+	return PicVotesPrimary{
+
+		PicId: &PicId,
+
+		UserId: &UserId,
+
+		Index: &Index,
+	}
+}
+
+var colsPicVotes = []string{"pic_id", "user_id", "index", "data"}
+
+func (j *Job) ScanPicVotes(opts db.Opts, cb func(*schema.PicVote) error) error {
+	return db.Scan(j.tx, "PicVotes", opts, func(data []byte) error {
+		var pb schema.PicVote
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPicVotes(opts db.Opts) (rows []*schema.PicVote, err error) {
+	err = j.ScanPicVotes(opts, func(data *schema.PicVote) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+
+func (j *Job) InsertPicVote(pb *schema.PicVote) error {
+	return j.InsertPicVoteRow(&PicVoteRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) InsertPicVoteRow(row *PicVoteRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "PicVotes", colsPicVotes, vals, j.adap)
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicVote)(nil)
+
+func (j *Job) UpdatePicVote(pb *schema.PicVote) error {
+	return j.UpdatePicVoteRow(&PicVoteRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) UpdatePicVoteRow(row *PicVoteRow) error {
+	key := KeyForPicVote(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "PicVotes", colsPicVotes, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicVote(key PicVotesPrimary) error {
+	return db.Delete(j.tx, "PicVotes", key, j.adap)
+}
+
+type PicCommentVotesPrimary struct {
+	PicId *int64
+
+	CommentId *int64
+
+	UserId *int64
+
+	Index *int64
+}
+
+func (_ PicCommentVotesPrimary) Unique() {}
+
+var _ db.UniqueIdx = PicCommentVotesPrimary{}
+
+var colsPicCommentVotesPrimary = []string{"pic_id", "comment_id", "user_id", "index"}
+
+func (idx PicCommentVotesPrimary) Cols() []string {
+	return colsPicCommentVotesPrimary
+}
+
+func (idx PicCommentVotesPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.PicId != nil {
+		if done {
+			panic("Extra value PicId")
+		}
+		vals = append(vals, *idx.PicId)
+	} else {
+		done = true
+	}
+
+	if idx.CommentId != nil {
+		if done {
+			panic("Extra value CommentId")
+		}
+		vals = append(vals, *idx.CommentId)
+	} else {
+		done = true
+	}
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Index != nil {
+		if done {
+			panic("Extra value Index")
+		}
+		vals = append(vals, *idx.Index)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForPicCommentVote(pb *schema.PicCommentVote) PicCommentVotesPrimary {
+
+	PicId := pb.PicIdCol()
+
+	CommentId := pb.CommentIdCol()
+
+	UserId := pb.UserIdCol()
+
+	Index := pb.IndexCol()
+
+	return PicCommentVotesPrimary{
+
+		PicId: &PicId,
+
+		CommentId: &CommentId,
+
+		UserId: &UserId,
+
+		Index: &Index,
+	}
+}
+
+var colsPicCommentVotes = []string{"pic_id", "comment_id", "user_id", "index", "data"}
+
+func (j *Job) ScanPicCommentVotes(opts db.Opts, cb func(*schema.PicCommentVote) error) error {
+	return db.Scan(j.tx, "PicCommentVotes", opts, func(data []byte) error {
+		var pb schema.PicCommentVote
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindPicCommentVotes(opts db.Opts) (rows []*schema.PicCommentVote, err error) {
+	err = j.ScanPicCommentVotes(opts, func(data *schema.PicCommentVote) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+
+func (j *Job) InsertPicCommentVote(pb *schema.PicCommentVote) error {
+	return j.InsertPicVoteCommentRow(&PicVoteCommentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		CommentId: pb.CommentIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) InsertPicVoteCommentRow(row *PicVoteCommentRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.CommentId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, j.adap)
+}
+
+var _ interface{ PicIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ CommentIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.PicCommentVote)(nil)
+
+var _ interface{ IndexCol() int64 } = (*schema.PicCommentVote)(nil)
+
+func (j *Job) UpdatePicCommentVote(pb *schema.PicCommentVote) error {
+	return j.UpdatePicVoteCommentRow(&PicVoteCommentRow{
+		Data: pb,
+
+		PicId: pb.PicIdCol(),
+
+		CommentId: pb.CommentIdCol(),
+
+		UserId: pb.UserIdCol(),
+
+		Index: pb.IndexCol(),
+	})
+}
+
+func (j *Job) UpdatePicVoteCommentRow(row *PicVoteCommentRow) error {
+	key := KeyForPicCommentVote(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.PicId)
+
+	vals = append(vals, row.CommentId)
+
+	vals = append(vals, row.UserId)
+
+	vals = append(vals, row.Index)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, key, j.adap)
+}
+
+func (j *Job) DeletePicCommentVote(key PicCommentVotesPrimary) error {
+	return db.Delete(j.tx, "PicCommentVotes", key, j.adap)
+}
+
+type UsersPrimary struct {
+	Id *int64
+}
+
+func (_ UsersPrimary) Unique() {}
+
+var _ db.UniqueIdx = UsersPrimary{}
+
+var colsUsersPrimary = []string{"id"}
+
+func (idx UsersPrimary) Cols() []string {
+	return colsUsersPrimary
+}
+
+func (idx UsersPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type UsersIdent struct {
+	Ident *string
+}
+
+func (_ UsersIdent) Unique() {}
+
+var _ db.UniqueIdx = UsersIdent{}
+
+var colsUsersIdent = []string{"ident"}
+
+func (idx UsersIdent) Cols() []string {
+	return colsUsersIdent
+}
+
+func (idx UsersIdent) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Ident != nil {
+		if done {
+			panic("Extra value Ident")
+		}
+		vals = append(vals, *idx.Ident)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForUser(pb *schema.User) UsersPrimary {
+
+	Id := pb.IdCol()
+
+	return UsersPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsUsers = []string{"id", "ident", "data"}
+
+func (j *Job) ScanUsers(opts db.Opts, cb func(*schema.User) error) error {
+	return db.Scan(j.tx, "Users", opts, func(data []byte) error {
+		var pb schema.User
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindUsers(opts db.Opts) (rows []*schema.User, err error) {
+	err = j.ScanUsers(opts, func(data *schema.User) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+
+var _ interface{ IdentCol() string } = (*schema.User)(nil)
+
+func (j *Job) InsertUser(pb *schema.User) error {
+	return j.InsertUserRow(&UserRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Ident: pb.IdentCol(),
+	})
+}
+
+func (j *Job) InsertUserRow(row *UserRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Ident)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "Users", colsUsers, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+
+var _ interface{ IdentCol() string } = (*schema.User)(nil)
+
+func (j *Job) UpdateUser(pb *schema.User) error {
+	return j.UpdateUserRow(&UserRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Ident: pb.IdentCol(),
+	})
+}
+
+func (j *Job) UpdateUserRow(row *UserRow) error {
+	key := KeyForUser(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Ident)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "Users", colsUsers, vals, key, j.adap)
+}
+
+func (j *Job) DeleteUser(key UsersPrimary) error {
+	return db.Delete(j.tx, "Users", key, j.adap)
+}
+
+type RolesPrimary struct {
+	Id *int64
+}
+
+func (_ RolesPrimary) Unique() {}
+
+var _ db.UniqueIdx = RolesPrimary{}
+
+var colsRolesPrimary = []string{"id"}
+
+func (idx RolesPrimary) Cols() []string {
+	return colsRolesPrimary
+}
+
+func (idx RolesPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type RolesName struct {
+	Name *string
+}
+
+func (_ RolesName) Unique() {}
+
+var _ db.UniqueIdx = RolesName{}
+
+var colsRolesName = []string{"name"}
+
+func (idx RolesName) Cols() []string {
+	return colsRolesName
+}
+
+func (idx RolesName) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Name != nil {
+		if done {
+			panic("Extra value Name")
+		}
+		vals = append(vals, *idx.Name)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForRole(pb *schema.Role) RolesPrimary {
+
+	Id := pb.IdCol()
+
+	return RolesPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsRoles = []string{"id", "name", "data"}
+
+func (j *Job) ScanRoles(opts db.Opts, cb func(*schema.Role) error) error {
+	return db.Scan(j.tx, "Roles", opts, func(data []byte) error {
+		var pb schema.Role
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindRoles(opts db.Opts) (rows []*schema.Role, err error) {
+	err = j.ScanRoles(opts, func(data *schema.Role) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.Role)(nil)
+
+var _ interface{ NameCol() string } = (*schema.Role)(nil)
+
+func (j *Job) InsertRole(pb *schema.Role) error {
+	return j.InsertRoleRow(&RoleRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Name: pb.NameCol(),
+	})
+}
+
+func (j *Job) InsertRoleRow(row *RoleRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Name)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "Roles", colsRoles, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.Role)(nil)
+
+var _ interface{ NameCol() string } = (*schema.Role)(nil)
+
+func (j *Job) UpdateRole(pb *schema.Role) error {
+	return j.UpdateRoleRow(&RoleRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		Name: pb.NameCol(),
+	})
+}
+
+func (j *Job) UpdateRoleRow(row *RoleRow) error {
+	key := KeyForRole(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.Name)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2107,35 +3246,46 @@ func (j *Job) UpdatePicVoteCommentRow(row *PicVoteCommentRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "PicCommentVotes", colsPicCommentVotes, vals, key, j.adap)
+	return db.Update(j.tx, "Roles", colsRoles, vals, key, j.adap)
 }
 
-func (j *Job) DeletePicCommentVote(key PicCommentVotesPrimary) error {
-	return db.Delete(j.tx, "PicCommentVotes", key, j.adap)
+func (j *Job) DeleteRole(key RolesPrimary) error {
+	return db.Delete(j.tx, "Roles", key, j.adap)
 }
 
-type UsersPrimary struct {
-	Id *int64
+type UserIdentitiesPrimary struct {
+	Issuer *string
+
+	Subject *string
 }
 
-func (_ UsersPrimary) Unique() {}
+func (_ UserIdentitiesPrimary) Unique() {}
 
-var _ db.UniqueIdx = UsersPrimary{}
+var _ db.UniqueIdx = UserIdentitiesPrimary{}
 
-var colsUsersPrimary = []string{"id"}
+var colsUserIdentitiesPrimary = []string{"issuer", "subject"}
 
-func (idx UsersPrimary) Cols() []string {
-	return colsUsersPrimary
+func (idx UserIdentitiesPrimary) Cols() []string {
+	return colsUserIdentitiesPrimary
 }
 
-func (idx UsersPrimary) Vals() (vals []interface{}) {
+func (idx UserIdentitiesPrimary) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.Id != nil {
+	if idx.Issuer != nil {
 		if done {
-			panic("Extra value Id")
+			panic("Extra value Issuer")
 		}
-		vals = append(vals, *idx.Id)
+		vals = append(vals, *idx.Issuer)
+	} else {
+		done = true
+	}
+
+	if idx.Subject != nil {
+		if done {
+			panic("Extra value Subject")
+		}
+		vals = append(vals, *idx.Subject)
 	} else {
 		done = true
 	}
@@ -2143,28 +3293,26 @@ func (idx UsersPrimary) Vals() (vals []interface{}) {
 	return
 }
 
-type UsersIdent struct {
-	Ident *string
+type UserIdentitiesUserId struct {
+	UserId *int64
 }
 
-func (_ UsersIdent) Unique() {}
-
-var _ db.UniqueIdx = UsersIdent{}
+var _ db.Idx = UserIdentitiesUserId{}
 
-var colsUsersIdent = []string{"ident"}
+var colsUserIdentitiesUserId = []string{"user_id"}
 
-func (idx UsersIdent) Cols() []string {
-	return colsUsersIdent
+func (idx UserIdentitiesUserId) Cols() []string {
+	return colsUserIdentitiesUserId
 }
 
-func (idx UsersIdent) Vals() (vals []interface{}) {
+func (idx UserIdentitiesUserId) Vals() (vals []interface{}) {
 	var done bool
 
-	if idx.Ident != nil {
+	if idx.UserId != nil {
 		if done {
-			panic("Extra value Ident")
+			panic("Extra value UserId")
 		}
-		vals = append(vals, *idx.Ident)
+		vals = append(vals, *idx.UserId)
 	} else {
 		done = true
 	}
@@ -2172,21 +3320,25 @@ func (idx UsersIdent) Vals() (vals []interface{}) {
 	return
 }
 
-func KeyForUser(pb *schema.User) UsersPrimary {
+func KeyForUserIdentity(pb *schema.UserIdentity) UserIdentitiesPrimary {
 
-	Id := pb.IdCol()
+	Issuer := pb.IssuerCol()
 
-	return UsersPrimary{
+	Subject := pb.SubjectCol()
 
-		Id: &Id,
+	return UserIdentitiesPrimary{
+
+		Issuer: &Issuer,
+
+		Subject: &Subject,
 	}
 }
 
-var colsUsers = []string{"id", "ident", "data"}
+var colsUserIdentities = []string{"issuer", "subject", "user_id", "data"}
 
-func (j *Job) ScanUsers(opts db.Opts, cb func(*schema.User) error) error {
-	return db.Scan(j.tx, "Users", opts, func(data []byte) error {
-		var pb schema.User
+func (j *Job) ScanUserIdentities(opts db.Opts, cb func(*schema.UserIdentity) error) error {
+	return db.Scan(j.tx, "UserIdentities", opts, func(data []byte) error {
+		var pb schema.UserIdentity
 		if err := proto.Unmarshal(data, &pb); err != nil {
 			return err
 		}
@@ -2194,34 +3346,40 @@ func (j *Job) ScanUsers(opts db.Opts, cb func(*schema.User) error) error {
 	}, j.adap)
 }
 
-func (j *Job) FindUsers(opts db.Opts) (rows []*schema.User, err error) {
-	err = j.ScanUsers(opts, func(data *schema.User) error {
+func (j *Job) FindUserIdentities(opts db.Opts) (rows []*schema.UserIdentity, err error) {
+	err = j.ScanUserIdentities(opts, func(data *schema.UserIdentity) error {
 		rows = append(rows, data)
 		return nil
 	})
 	return
 }
 
-var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+var _ interface{ IssuerCol() string } = (*schema.UserIdentity)(nil)
 
-var _ interface{ IdentCol() string } = (*schema.User)(nil)
+var _ interface{ SubjectCol() string } = (*schema.UserIdentity)(nil)
 
-func (j *Job) InsertUser(pb *schema.User) error {
-	return j.InsertUserRow(&UserRow{
+var _ interface{ UserIdCol() int64 } = (*schema.UserIdentity)(nil)
+
+func (j *Job) InsertUserIdentity(pb *schema.UserIdentity) error {
+	return j.InsertUserIdentityRow(&UserIdentityRow{
 		Data: pb,
 
-		Id: pb.IdCol(),
+		Issuer: pb.IssuerCol(),
 
-		Ident: pb.IdentCol(),
+		Subject: pb.SubjectCol(),
+
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) InsertUserRow(row *UserRow) error {
+func (j *Job) InsertUserIdentityRow(row *UserIdentityRow) error {
 	var vals []interface{}
 
-	vals = append(vals, row.Id)
+	vals = append(vals, row.Issuer)
 
-	vals = append(vals, row.Ident)
+	vals = append(vals, row.Subject)
+
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2229,31 +3387,37 @@ func (j *Job) InsertUserRow(row *UserRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Insert(j.tx, "Users", colsUsers, vals, j.adap)
+	return db.Insert(j.tx, "UserIdentities", colsUserIdentities, vals, j.adap)
 }
 
-var _ interface{ IdCol() int64 } = (*schema.User)(nil)
+var _ interface{ IssuerCol() string } = (*schema.UserIdentity)(nil)
 
-var _ interface{ IdentCol() string } = (*schema.User)(nil)
+var _ interface{ SubjectCol() string } = (*schema.UserIdentity)(nil)
 
-func (j *Job) UpdateUser(pb *schema.User) error {
-	return j.UpdateUserRow(&UserRow{
+var _ interface{ UserIdCol() int64 } = (*schema.UserIdentity)(nil)
+
+func (j *Job) UpdateUserIdentity(pb *schema.UserIdentity) error {
+	return j.UpdateUserIdentityRow(&UserIdentityRow{
 		Data: pb,
 
-		Id: pb.IdCol(),
+		Issuer: pb.IssuerCol(),
 
-		Ident: pb.IdentCol(),
+		Subject: pb.SubjectCol(),
+
+		UserId: pb.UserIdCol(),
 	})
 }
 
-func (j *Job) UpdateUserRow(row *UserRow) error {
-	key := KeyForUser(row.Data)
+func (j *Job) UpdateUserIdentityRow(row *UserIdentityRow) error {
+	key := KeyForUserIdentity(row.Data)
 
 	var vals []interface{}
 
-	vals = append(vals, row.Id)
+	vals = append(vals, row.Issuer)
 
-	vals = append(vals, row.Ident)
+	vals = append(vals, row.Subject)
+
+	vals = append(vals, row.UserId)
 
 	if val, err := proto.Marshal(row.Data); err != nil {
 		return err
@@ -2261,11 +3425,11 @@ func (j *Job) UpdateUserRow(row *UserRow) error {
 		vals = append(vals, val)
 	}
 
-	return db.Update(j.tx, "Users", colsUsers, vals, key, j.adap)
+	return db.Update(j.tx, "UserIdentities", colsUserIdentities, vals, key, j.adap)
 }
 
-func (j *Job) DeleteUser(key UsersPrimary) error {
-	return db.Delete(j.tx, "Users", key, j.adap)
+func (j *Job) DeleteUserIdentity(key UserIdentitiesPrimary) error {
+	return db.Delete(j.tx, "UserIdentities", key, j.adap)
 }
 
 type UserEventsPrimary struct {
@@ -2435,6 +3599,246 @@ func (j *Job) DeleteUserEvent(key UserEventsPrimary) error {
 	return db.Delete(j.tx, "UserEvents", key, j.adap)
 }
 
+type BackgroundJobsPrimary struct {
+	Id *int64
+}
+
+func (_ BackgroundJobsPrimary) Unique() {}
+
+var _ db.UniqueIdx = BackgroundJobsPrimary{}
+
+var colsBackgroundJobsPrimary = []string{"id"}
+
+func (idx BackgroundJobsPrimary) Cols() []string {
+	return colsBackgroundJobsPrimary
+}
+
+func (idx BackgroundJobsPrimary) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type BackgroundJobsState struct {
+	State *schema.BackgroundJob_Status_State
+
+	NextRunTs *int64
+
+	Id *int64
+}
+
+var _ db.Idx = BackgroundJobsState{}
+
+var colsBackgroundJobsState = []string{"state", "next_run_ts", "id"}
+
+func (idx BackgroundJobsState) Cols() []string {
+	return colsBackgroundJobsState
+}
+
+func (idx BackgroundJobsState) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.State != nil {
+		if done {
+			panic("Extra value State")
+		}
+		vals = append(vals, *idx.State)
+	} else {
+		done = true
+	}
+
+	if idx.NextRunTs != nil {
+		if done {
+			panic("Extra value NextRunTs")
+		}
+		vals = append(vals, *idx.NextRunTs)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+type BackgroundJobsUserId struct {
+	UserId *int64
+
+	Id *int64
+}
+
+var _ db.Idx = BackgroundJobsUserId{}
+
+var colsBackgroundJobsUserId = []string{"user_id", "id"}
+
+func (idx BackgroundJobsUserId) Cols() []string {
+	return colsBackgroundJobsUserId
+}
+
+func (idx BackgroundJobsUserId) Vals() (vals []interface{}) {
+	var done bool
+
+	if idx.UserId != nil {
+		if done {
+			panic("Extra value UserId")
+		}
+		vals = append(vals, *idx.UserId)
+	} else {
+		done = true
+	}
+
+	if idx.Id != nil {
+		if done {
+			panic("Extra value Id")
+		}
+		vals = append(vals, *idx.Id)
+	} else {
+		done = true
+	}
+
+	return
+}
+
+func KeyForBackgroundJob(pb *schema.BackgroundJob) BackgroundJobsPrimary {
+
+	Id := pb.IdCol()
+
+	return BackgroundJobsPrimary{
+
+		Id: &Id,
+	}
+}
+
+var colsBackgroundJobs = []string{"id", "state", "next_run_ts", "user_id", "data"}
+
+func (j *Job) ScanBackgroundJobs(opts db.Opts, cb func(*schema.BackgroundJob) error) error {
+	return db.Scan(j.tx, "BackgroundJobs", opts, func(data []byte) error {
+		var pb schema.BackgroundJob
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return err
+		}
+		return cb(&pb)
+	}, j.adap)
+}
+
+func (j *Job) FindBackgroundJobs(opts db.Opts) (rows []*schema.BackgroundJob, err error) {
+	err = j.ScanBackgroundJobs(opts, func(data *schema.BackgroundJob) error {
+		rows = append(rows, data)
+		return nil
+	})
+	return
+}
+
+var _ interface{ IdCol() int64 } = (*schema.BackgroundJob)(nil)
+
+var _ interface {
+	StateCol() schema.BackgroundJob_Status_State
+} = (*schema.BackgroundJob)(nil)
+
+var _ interface{ NextRunTsCol() int64 } = (*schema.BackgroundJob)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.BackgroundJob)(nil)
+
+func (j *Job) InsertBackgroundJob(pb *schema.BackgroundJob) error {
+	return j.InsertBackgroundJobRow(&BackgroundJobRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		State: pb.StateCol(),
+
+		NextRunTs: pb.NextRunTsCol(),
+
+		UserId: pb.UserIdCol(),
+	})
+}
+
+func (j *Job) InsertBackgroundJobRow(row *BackgroundJobRow) error {
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.State)
+
+	vals = append(vals, row.NextRunTs)
+
+	vals = append(vals, row.UserId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Insert(j.tx, "BackgroundJobs", colsBackgroundJobs, vals, j.adap)
+}
+
+var _ interface{ IdCol() int64 } = (*schema.BackgroundJob)(nil)
+
+var _ interface {
+	StateCol() schema.BackgroundJob_Status_State
+} = (*schema.BackgroundJob)(nil)
+
+var _ interface{ NextRunTsCol() int64 } = (*schema.BackgroundJob)(nil)
+
+var _ interface{ UserIdCol() int64 } = (*schema.BackgroundJob)(nil)
+
+func (j *Job) UpdateBackgroundJob(pb *schema.BackgroundJob) error {
+	return j.UpdateBackgroundJobRow(&BackgroundJobRow{
+		Data: pb,
+
+		Id: pb.IdCol(),
+
+		State: pb.StateCol(),
+
+		NextRunTs: pb.NextRunTsCol(),
+
+		UserId: pb.UserIdCol(),
+	})
+}
+
+func (j *Job) UpdateBackgroundJobRow(row *BackgroundJobRow) error {
+	key := KeyForBackgroundJob(row.Data)
+
+	var vals []interface{}
+
+	vals = append(vals, row.Id)
+
+	vals = append(vals, row.State)
+
+	vals = append(vals, row.NextRunTs)
+
+	vals = append(vals, row.UserId)
+
+	if val, err := proto.Marshal(row.Data); err != nil {
+		return err
+	} else {
+		vals = append(vals, val)
+	}
+
+	return db.Update(j.tx, "BackgroundJobs", colsBackgroundJobs, vals, key, j.adap)
+}
+
+func (j *Job) DeleteBackgroundJob(key BackgroundJobsPrimary) error {
+	return db.Delete(j.tx, "BackgroundJobs", key, j.adap)
+}
+
 type CustomDataPrimary struct {
 	KeyType *int64
 
@@ -14,6 +14,10 @@ func (t *Tag) NameCol() string {
 	return TagUniqueName(t.Name)
 }
 
+func (t *Tag) UsageCountCol() int64 {
+	return t.UsageCount
+}
+
 // TagUniqueName normalizes a name for uniqueness constraints
 func TagUniqueName(s string) string {
 	u, err := text.ToCaselessNFKC(s, "tag")
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+)
+
+func (ta *TagAlias) NameCol() string {
+	return TagUniqueName(ta.Name)
+}
+
+func (ta *TagAlias) TagIdCol() int64 {
+	return ta.TagId
+}
+
+func (ta *TagAlias) SetCreatedTime(now time.Time) {
+	ta.CreatedTs = ToTspb(now)
+}
+
+func (ta *TagAlias) SetModifiedTime(now time.Time) {
+	ta.ModifiedTs = ToTspb(now)
+}
+
+func (ta *TagAlias) GetCreatedTime() time.Time {
+	return ToTime(ta.CreatedTs)
+}
+
+func (ta *TagAlias) GetModifiedTime() time.Time {
+	return ToTime(ta.ModifiedTs)
+}
+
+func (ta *TagAlias) Version() int64 {
+	return ToTime(ta.ModifiedTs).UnixNano()
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+)
+
+func (ti *TagImplication) TagIdCol() int64 {
+	return ti.TagId
+}
+
+func (ti *TagImplication) ImpliedTagIdCol() int64 {
+	return ti.ImpliedTagId
+}
+
+func (ti *TagImplication) SetCreatedTime(now time.Time) {
+	ti.CreatedTs = ToTspb(now)
+}
+
+func (ti *TagImplication) SetModifiedTime(now time.Time) {
+	ti.ModifiedTs = ToTspb(now)
+}
+
+func (ti *TagImplication) GetCreatedTime() time.Time {
+	return ToTime(ti.CreatedTs)
+}
+
+func (ti *TagImplication) GetModifiedTime() time.Time {
+	return ToTime(ti.ModifiedTs)
+}
+
+func (ti *TagImplication) Version() int64 {
+	return ToTime(ti.ModifiedTs).UnixNano()
+}
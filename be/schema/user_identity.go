@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"time"
+)
+
+func (ui *UserIdentity) IssuerCol() string {
+	return ui.Issuer
+}
+
+func (ui *UserIdentity) SubjectCol() string {
+	return ui.Subject
+}
+
+func (ui *UserIdentity) UserIdCol() int64 {
+	return ui.UserId
+}
+
+func (ui *UserIdentity) SetCreatedTime(now time.Time) {
+	ui.CreatedTs = ToTspb(now)
+}
+
+func (ui *UserIdentity) GetCreatedTime() time.Time {
+	return ToTime(ui.CreatedTs)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"os"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/status"
+)
+
+// NewBlobStore creates the pic file storage described by the config.
+func NewBlobStore(c *config.Config) (blobstore.BlobStore, status.S) {
+	switch c.BlobStore {
+	case config.Config_PIX_PATH:
+		if sts := preparePixPath(c.PixPath); sts != nil {
+			return nil, sts
+		}
+		return blobstore.NewPixPathStore(c.PixPath), nil
+	case config.Config_CONTENT_ADDRESSED:
+		if sts := preparePixPath(c.PixPath); sts != nil {
+			return nil, sts
+		}
+		return blobstore.NewContentAddressedStore(c.PixPath), nil
+	case config.Config_S3:
+		if c.S3 == nil {
+			return nil, status.InvalidArgument(nil, "missing s3 config")
+		}
+		return blobstore.NewS3Store(&blobstore.S3Options{
+			Endpoint:        c.S3.Endpoint,
+			Region:          c.S3.Region,
+			Bucket:          c.S3.Bucket,
+			Prefix:          c.S3.Prefix,
+			AccessKeyId:     c.S3.AccessKeyId,
+			SecretAccessKey: c.S3.SecretAccessKey,
+		})
+	default:
+		return nil, status.InvalidArgument(nil, "unknown blob store", c.BlobStore)
+	}
+}
+
+func preparePixPath(pixPath string) status.S {
+	fi, err := os.Stat(pixPath)
+	if os.IsNotExist(err) {
+		if err := os.MkdirAll(pixPath, os.ModeDir|0775); err != nil {
+			return status.Internal(err, "can't create pix dir")
+		}
+	} else if err != nil {
+		return status.Internal(err, "can't stat pix dir")
+	} else if !fi.IsDir() {
+		return status.InvalidArgument(nil, pixPath, "is not a directory")
+	}
+	return nil
+}
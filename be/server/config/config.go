@@ -1,4 +1,4 @@
-//go:generate protoc -I../../../ -I. config.proto --go_out=paths=source_relative:.
+//go:generate protoc -I../../../../../ -I. config.proto --go_out=paths=source_relative:.
 
 // Package config describes configuration for a backend Pixur Server.
 package config // import "pixur.org/pixur/be/server/config"
@@ -6,6 +6,7 @@ package config
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	math "math"
 	api "pixur.org/pixur/api"
 )
@@ -21,6 +22,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type Config_BlobStore int32
+
+const (
+	// Pic files are stored under pix_path, named by pic id.
+	Config_PIX_PATH Config_BlobStore = 0
+	// Pic files are stored under pix_path, named by the hash of their contents.  Identical files
+	// are only stored once.  Processes sharing pix_path, such as the server and tools, must
+	// run on the same host.
+	Config_CONTENT_ADDRESSED Config_BlobStore = 1
+	// Pic files are stored in an S3 compatible bucket, described by s3.
+	Config_S3 Config_BlobStore = 2
+)
+
+var Config_BlobStore_name = map[int32]string{
+	0: "PIX_PATH",
+	1: "CONTENT_ADDRESSED",
+	2: "S3",
+}
+
+var Config_BlobStore_value = map[string]int32{
+	"PIX_PATH":          0,
+	"CONTENT_ADDRESSED": 1,
+	"S3":                2,
+}
+
+func (x Config_BlobStore) String() string {
+	return proto.EnumName(Config_BlobStore_name, int32(x))
+}
+
+func (Config_BlobStore) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{0, 0}
+}
+
 // Config describes server configuration.
 type Config struct {
 	// Name of the database, like "mysql"
@@ -37,9 +71,22 @@ type Config struct {
 	SessionPrivateKeyPath string                    `protobuf:"bytes,6,opt,name=session_private_key_path,json=sessionPrivateKeyPath,proto3" json:"session_private_key_path,omitempty"`
 	SessionPublicKeyPath  string                    `protobuf:"bytes,7,opt,name=session_public_key_path,json=sessionPublicKeyPath,proto3" json:"session_public_key_path,omitempty"`
 	BackendConfiguration  *api.BackendConfiguration `protobuf:"bytes,10,opt,name=backend_configuration,json=backendConfiguration,proto3" json:"backend_configuration,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
-	XXX_unrecognized      []byte                    `json:"-"`
-	XXX_sizecache         int32                     `json:"-"`
+	// How pic files are stored.
+	BlobStore Config_BlobStore `protobuf:"varint,11,opt,name=blob_store,json=blobStore,proto3,enum=pixur.be.server.Config_BlobStore" json:"blob_store,omitempty"`
+	// Object storage for pic files, used when blob_store is S3.
+	S3 *S3Config `protobuf:"bytes,12,opt,name=s3,proto3" json:"s3,omitempty"`
+	// Background hard deletion of pics past their pending deletion time.
+	Pruner *PrunerConfig `protobuf:"bytes,13,opt,name=pruner,proto3" json:"pruner,omitempty"`
+	// Workers running background jobs.  If unset, a small number of workers are used.
+	BackgroundJobs *BackgroundJobConfig `protobuf:"bytes,14,opt,name=background_jobs,json=backgroundJobs,proto3" json:"background_jobs,omitempty"`
+	// OpenID Connect provider whose ID tokens can be exchanged for auth tokens.  If unset, the
+	// ExchangeIdToken call is disabled.
+	Oidc *OidcConfig `protobuf:"bytes,15,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	// Loading of the in memory index used to find similar pics.  If unset, the defaults are used.
+	Similarity           *SimilarityConfig `protobuf:"bytes,16,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -130,33 +177,418 @@ func (m *Config) GetBackendConfiguration() *api.BackendConfiguration {
 	return nil
 }
 
+func (m *Config) GetBlobStore() Config_BlobStore {
+	if m != nil {
+		return m.BlobStore
+	}
+	return Config_PIX_PATH
+}
+
+func (m *Config) GetS3() *S3Config {
+	if m != nil {
+		return m.S3
+	}
+	return nil
+}
+
+func (m *Config) GetPruner() *PrunerConfig {
+	if m != nil {
+		return m.Pruner
+	}
+	return nil
+}
+
+func (m *Config) GetBackgroundJobs() *BackgroundJobConfig {
+	if m != nil {
+		return m.BackgroundJobs
+	}
+	return nil
+}
+
+func (m *Config) GetOidc() *OidcConfig {
+	if m != nil {
+		return m.Oidc
+	}
+	return nil
+}
+
+func (m *Config) GetSimilarity() *SimilarityConfig {
+	if m != nil {
+		return m.Similarity
+	}
+	return nil
+}
+
+// PrunerConfig describes how pics pending deletion are hard deleted.  If several servers share a
+// database, only the one holding the pruner lease does the work.
+type PrunerConfig struct {
+	// How often to look for pics to hard delete.  If unset, the pruner doesn't run.
+	Interval *duration.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// How many pics are read per transaction.  Defaults to 1000.
+	BatchSize int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// If set, pics are logged rather than hard deleted.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrunerConfig) Reset()         { *m = PrunerConfig{} }
+func (m *PrunerConfig) String() string { return proto.CompactTextString(m) }
+func (*PrunerConfig) ProtoMessage()    {}
+func (*PrunerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{1}
+}
+
+func (m *PrunerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrunerConfig.Unmarshal(m, b)
+}
+func (m *PrunerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrunerConfig.Marshal(b, m, deterministic)
+}
+func (m *PrunerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunerConfig.Merge(m, src)
+}
+func (m *PrunerConfig) XXX_Size() int {
+	return xxx_messageInfo_PrunerConfig.Size(m)
+}
+func (m *PrunerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunerConfig proto.InternalMessageInfo
+
+func (m *PrunerConfig) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *PrunerConfig) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *PrunerConfig) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// SimilarityConfig describes how the similarity index is kept up to date.  The index is loaded in
+// the background at startup, and then the pics created since are added periodically, including
+// those uploaded through other servers sharing the database.
+type SimilarityConfig struct {
+	// How often to add new pics.  Defaults to 1 minute.
+	RefreshInterval *duration.Duration `protobuf:"bytes,1,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	// How many pics are read per transaction.  Defaults to 1000.
+	BatchSize            int32    `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimilarityConfig) Reset()         { *m = SimilarityConfig{} }
+func (m *SimilarityConfig) String() string { return proto.CompactTextString(m) }
+func (*SimilarityConfig) ProtoMessage()    {}
+func (*SimilarityConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{2}
+}
+
+func (m *SimilarityConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimilarityConfig.Unmarshal(m, b)
+}
+func (m *SimilarityConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimilarityConfig.Marshal(b, m, deterministic)
+}
+func (m *SimilarityConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimilarityConfig.Merge(m, src)
+}
+func (m *SimilarityConfig) XXX_Size() int {
+	return xxx_messageInfo_SimilarityConfig.Size(m)
+}
+func (m *SimilarityConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimilarityConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimilarityConfig proto.InternalMessageInfo
+
+func (m *SimilarityConfig) GetRefreshInterval() *duration.Duration {
+	if m != nil {
+		return m.RefreshInterval
+	}
+	return nil
+}
+
+func (m *SimilarityConfig) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+// BackgroundJobConfig describes the workers running background jobs.  Workers on every server
+// sharing a database take jobs from the same queue.
+type BackgroundJobConfig struct {
+	// How many jobs are run at once.  If zero, this server runs no jobs.
+	Workers int32 `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	// How often idle workers look for jobs.  Defaults to 5 seconds.
+	PollInterval *duration.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// How long a job may run before it is given to another worker.  Defaults to 10 minutes.
+	Timeout              *duration.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BackgroundJobConfig) Reset()         { *m = BackgroundJobConfig{} }
+func (m *BackgroundJobConfig) String() string { return proto.CompactTextString(m) }
+func (*BackgroundJobConfig) ProtoMessage()    {}
+func (*BackgroundJobConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{3}
+}
+
+func (m *BackgroundJobConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackgroundJobConfig.Unmarshal(m, b)
+}
+func (m *BackgroundJobConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackgroundJobConfig.Marshal(b, m, deterministic)
+}
+func (m *BackgroundJobConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackgroundJobConfig.Merge(m, src)
+}
+func (m *BackgroundJobConfig) XXX_Size() int {
+	return xxx_messageInfo_BackgroundJobConfig.Size(m)
+}
+func (m *BackgroundJobConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackgroundJobConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackgroundJobConfig proto.InternalMessageInfo
+
+func (m *BackgroundJobConfig) GetWorkers() int32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *BackgroundJobConfig) GetPollInterval() *duration.Duration {
+	if m != nil {
+		return m.PollInterval
+	}
+	return nil
+}
+
+func (m *BackgroundJobConfig) GetTimeout() *duration.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+// S3Config describes an S3 compatible bucket.
+type S3Config struct {
+	// Base URL of the service (e.g. "https://s3.us-east-1.amazonaws.com").  Buckets are addressed
+	// by path, rather than by host name.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Region used for signing requests (e.g. "us-east-1").
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Prepended to the name of every object (e.g. "pix/").
+	Prefix               string   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	AccessKeyId          string   `protobuf:"bytes,5,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	SecretAccessKey      string   `protobuf:"bytes,6,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *S3Config) Reset()         { *m = S3Config{} }
+func (m *S3Config) String() string { return proto.CompactTextString(m) }
+func (*S3Config) ProtoMessage()    {}
+func (*S3Config) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{4}
+}
+
+func (m *S3Config) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_S3Config.Unmarshal(m, b)
+}
+func (m *S3Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_S3Config.Marshal(b, m, deterministic)
+}
+func (m *S3Config) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3Config.Merge(m, src)
+}
+func (m *S3Config) XXX_Size() int {
+	return xxx_messageInfo_S3Config.Size(m)
+}
+func (m *S3Config) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3Config.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_S3Config proto.InternalMessageInfo
+
+func (m *S3Config) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *S3Config) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *S3Config) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *S3Config) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *S3Config) GetAccessKeyId() string {
+	if m != nil {
+		return m.AccessKeyId
+	}
+	return ""
+}
+
+func (m *S3Config) GetSecretAccessKey() string {
+	if m != nil {
+		return m.SecretAccessKey
+	}
+	return ""
+}
+
+// OidcConfig describes a trusted OpenID Connect provider.
+type OidcConfig struct {
+	// Issuer URL of the provider (e.g. "https://accounts.example.com").  Its discovery document is
+	// read from "/.well-known/openid-configuration" under this URL.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The client id registered with the provider.  ID tokens must be issued to this client.
+	ClientId             string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OidcConfig) Reset()         { *m = OidcConfig{} }
+func (m *OidcConfig) String() string { return proto.CompactTextString(m) }
+func (*OidcConfig) ProtoMessage()    {}
+func (*OidcConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{5}
+}
+
+func (m *OidcConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OidcConfig.Unmarshal(m, b)
+}
+func (m *OidcConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OidcConfig.Marshal(b, m, deterministic)
+}
+func (m *OidcConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OidcConfig.Merge(m, src)
+}
+func (m *OidcConfig) XXX_Size() int {
+	return xxx_messageInfo_OidcConfig.Size(m)
+}
+func (m *OidcConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OidcConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OidcConfig proto.InternalMessageInfo
+
+func (m *OidcConfig) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *OidcConfig) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("pixur.be.server.Config_BlobStore", Config_BlobStore_name, Config_BlobStore_value)
 	proto.RegisterType((*Config)(nil), "pixur.be.server.Config")
+	proto.RegisterType((*PrunerConfig)(nil), "pixur.be.server.PrunerConfig")
+	proto.RegisterType((*SimilarityConfig)(nil), "pixur.be.server.SimilarityConfig")
+	proto.RegisterType((*BackgroundJobConfig)(nil), "pixur.be.server.BackgroundJobConfig")
+	proto.RegisterType((*S3Config)(nil), "pixur.be.server.S3Config")
+	proto.RegisterType((*OidcConfig)(nil), "pixur.be.server.OidcConfig")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0x2a, 0x31,
-	0x14, 0x86, 0xc3, 0xe5, 0xde, 0x01, 0x0a, 0x5c, 0x93, 0x09, 0x84, 0xaa, 0x0b, 0xd1, 0xc4, 0x88,
-	0x9b, 0x99, 0x44, 0x63, 0x5c, 0xb8, 0x12, 0x96, 0x26, 0x84, 0xa0, 0x2b, 0x37, 0x4d, 0x3b, 0x3d,
-	0x42, 0x33, 0xd0, 0x36, 0x6d, 0x41, 0x78, 0x0b, 0x1f, 0xd9, 0xd8, 0x03, 0xca, 0xc2, 0xd5, 0x4c,
-	0xfe, 0xff, 0xfb, 0x4e, 0x93, 0x73, 0x48, 0xab, 0x30, 0xfa, 0x4d, 0xcd, 0x32, 0xeb, 0x4c, 0x30,
-	0xe9, 0x91, 0x55, 0x9b, 0x95, 0xcb, 0x04, 0x64, 0x1e, 0xdc, 0x1a, 0xdc, 0x49, 0x9b, 0x5b, 0x95,
-	0x73, 0xab, 0xb0, 0xbf, 0xf8, 0xa8, 0x92, 0x64, 0x14, 0x85, 0xb4, 0x47, 0x6a, 0x52, 0x30, 0xcd,
-	0x97, 0x40, 0x2b, 0xfd, 0xca, 0xa0, 0x31, 0x4d, 0xa4, 0x18, 0xf3, 0x25, 0xa4, 0xa7, 0xa4, 0x21,
-	0x05, 0xc3, 0xb1, 0xf4, 0x4f, 0xac, 0xea, 0x52, 0xec, 0xac, 0x4b, 0xf2, 0x7f, 0xa1, 0x7c, 0x00,
-	0xcd, 0x34, 0x84, 0x77, 0xe3, 0x4a, 0x5a, 0x8d, 0x44, 0x1b, 0xd3, 0x31, 0x86, 0x07, 0x18, 0x97,
-	0xd2, 0x81, 0xf7, 0xb4, 0x71, 0x88, 0x3d, 0x62, 0x98, 0x1e, 0x93, 0xba, 0x55, 0x1b, 0x66, 0x79,
-	0x98, 0xd3, 0xbf, 0x11, 0xa8, 0x59, 0xb5, 0x99, 0xf0, 0x30, 0x4f, 0xcf, 0x49, 0x2b, 0x98, 0x12,
-	0x34, 0xf3, 0x50, 0x38, 0x08, 0xf4, 0x5f, 0xac, 0x9b, 0x31, 0x7b, 0x8e, 0x51, 0x7a, 0x4f, 0xa8,
-	0x07, 0xef, 0x95, 0xd1, 0xcc, 0x3a, 0xb5, 0xe6, 0x01, 0x58, 0x09, 0x5b, 0x9c, 0x96, 0x44, 0xbc,
-	0xbb, 0xeb, 0x27, 0x58, 0x3f, 0xc1, 0x36, 0xce, 0xbe, 0x23, 0xbd, 0x6f, 0x71, 0x25, 0x16, 0xaa,
-	0xf8, 0xf1, 0x6a, 0xd1, 0xeb, 0xec, 0xbd, 0xd8, 0xee, 0xb5, 0x17, 0xd2, 0x15, 0xbc, 0x28, 0x41,
-	0xcb, 0xdd, 0x76, 0x56, 0x8e, 0x07, 0x65, 0x34, 0x25, 0xfd, 0xca, 0xa0, 0x79, 0x73, 0x96, 0xe1,
-	0xf2, 0xbf, 0xb6, 0x3d, 0x44, 0x6e, 0x74, 0x88, 0x4d, 0x3b, 0xe2, 0x97, 0x74, 0x78, 0xfd, 0x7a,
-	0x85, 0x9e, 0x71, 0xb3, 0x3c, 0xfe, 0xe5, 0x02, 0x72, 0x3c, 0x5f, 0x8e, 0xef, 0x3c, 0xe0, 0x47,
-	0x24, 0xf1, 0x88, 0xb7, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x6a, 0x80, 0x94, 0xf4, 0x01,
-	0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xe1, 0x6e, 0xdb, 0x36,
+	0x10, 0x9e, 0xbd, 0x46, 0xb6, 0x2f, 0x4e, 0xec, 0x72, 0xcd, 0xaa, 0xa6, 0x68, 0x97, 0x1a, 0x1b,
+	0x96, 0xee, 0x87, 0x0c, 0xd4, 0x08, 0x36, 0x60, 0xc0, 0x30, 0x27, 0x0e, 0xb0, 0xac, 0x58, 0x6a,
+	0xc8, 0xf9, 0x31, 0xec, 0x8f, 0x20, 0x8a, 0x17, 0x87, 0xb3, 0x42, 0x0a, 0x24, 0x95, 0xc6, 0x05,
+	0xf6, 0x36, 0x7b, 0x81, 0xbd, 0xc0, 0x9e, 0x6d, 0x10, 0x49, 0x39, 0x46, 0x5c, 0xac, 0xf9, 0x65,
+	0xdd, 0x77, 0xdf, 0xc7, 0x3b, 0x1f, 0x79, 0x1f, 0x74, 0x33, 0x29, 0x2e, 0xf9, 0x3c, 0x2a, 0x94,
+	0x34, 0x92, 0xf4, 0x0a, 0x7e, 0x5b, 0xaa, 0x88, 0x62, 0xa4, 0x51, 0xdd, 0xa0, 0xda, 0x7f, 0x39,
+	0x97, 0x72, 0x9e, 0xe3, 0xd0, 0xa6, 0x69, 0x79, 0x39, 0x64, 0xa5, 0x4a, 0x0d, 0x97, 0xc2, 0x09,
+	0xf6, 0x5f, 0x3a, 0x81, 0x54, 0xf3, 0xa1, 0xfd, 0x1a, 0xa6, 0x05, 0x1f, 0xb2, 0xd4, 0xa4, 0x2e,
+	0x3f, 0xf8, 0x27, 0x80, 0xe0, 0xc4, 0x56, 0x20, 0x4f, 0xa1, 0xc5, 0x68, 0x22, 0xd2, 0x6b, 0x0c,
+	0x1b, 0x07, 0x8d, 0xc3, 0x4e, 0x1c, 0x30, 0x7a, 0x9e, 0x5e, 0x23, 0x79, 0x0e, 0x1d, 0x46, 0x13,
+	0xd7, 0x47, 0xd8, 0xb4, 0xa9, 0x36, 0xa3, 0x5e, 0xf5, 0x0d, 0xec, 0xe6, 0x5c, 0x1b, 0x14, 0x89,
+	0x40, 0xf3, 0x5e, 0xaa, 0x45, 0xf8, 0xb9, 0x65, 0xec, 0x38, 0xf4, 0xdc, 0x81, 0x6b, 0xb4, 0x94,
+	0x31, 0x85, 0x5a, 0x87, 0x9d, 0x75, 0xda, 0xd8, 0x81, 0xe4, 0x19, 0xb4, 0x0b, 0x7e, 0x9b, 0x14,
+	0xa9, 0xb9, 0x0a, 0x1f, 0x59, 0x42, 0xab, 0xe0, 0xb7, 0xd3, 0xd4, 0x5c, 0x91, 0x57, 0xd0, 0x35,
+	0x72, 0x81, 0x22, 0xd1, 0x98, 0x29, 0x34, 0xe1, 0x96, 0x4d, 0x6f, 0x5b, 0x6c, 0x66, 0x21, 0xf2,
+	0x3d, 0x84, 0x1a, 0xb5, 0xe6, 0x52, 0x24, 0x85, 0xe2, 0x37, 0xa9, 0xc1, 0x64, 0x81, 0x4b, 0x77,
+	0x5a, 0x60, 0xe9, 0x7b, 0x3e, 0x3f, 0x75, 0xe9, 0xb7, 0xb8, 0xb4, 0x67, 0x1f, 0xc1, 0xd3, 0x95,
+	0xb0, 0xa4, 0x39, 0xcf, 0xee, 0x74, 0x2d, 0xab, 0x7b, 0x52, 0xeb, 0x6c, 0xb6, 0x96, 0x5d, 0xc0,
+	0x1e, 0x4d, 0xb3, 0x05, 0x0a, 0xe6, 0xa7, 0xe3, 0x67, 0x1f, 0xc2, 0x41, 0xe3, 0x70, 0xfb, 0xcd,
+	0x57, 0x91, 0x1b, 0x7e, 0x5a, 0xf0, 0xe8, 0xd8, 0xf1, 0x4e, 0xd6, 0x69, 0xf1, 0x13, 0xfa, 0x11,
+	0x94, 0xfc, 0x0c, 0x40, 0x73, 0x49, 0x13, 0x6d, 0xa4, 0xc2, 0x70, 0xfb, 0xa0, 0x71, 0xb8, 0xfb,
+	0xe6, 0x55, 0x74, 0xef, 0xe2, 0x23, 0xa7, 0x89, 0x8e, 0x73, 0x49, 0x67, 0x15, 0x31, 0xee, 0xd0,
+	0xfa, 0x93, 0xbc, 0x86, 0xa6, 0x1e, 0x85, 0x5d, 0xdb, 0xc4, 0xb3, 0x0d, 0xe5, 0x6c, 0xe4, 0xb4,
+	0x71, 0x53, 0x8f, 0xc8, 0x11, 0x04, 0x85, 0x2a, 0x05, 0xaa, 0x70, 0xc7, 0xd2, 0x5f, 0x6c, 0xd0,
+	0xa7, 0x36, 0xed, 0x25, 0x9e, 0x4c, 0x7e, 0x83, 0x5e, 0xd5, 0xfb, 0x5c, 0xc9, 0x52, 0xb0, 0xe4,
+	0x4f, 0x49, 0x75, 0xb8, 0x6b, 0xf5, 0x5f, 0x6f, 0xe8, 0x8f, 0x57, 0xbc, 0x5f, 0xa5, 0x7f, 0x34,
+	0xf1, 0x2e, 0x5d, 0x07, 0x35, 0x19, 0xc2, 0x23, 0xc9, 0x59, 0x16, 0xf6, 0xec, 0x19, 0xcf, 0x37,
+	0xce, 0x78, 0xc7, 0x59, 0xe6, 0xa5, 0x96, 0x48, 0xc6, 0x00, 0x9a, 0x5f, 0xf3, 0x3c, 0x55, 0xdc,
+	0x2c, 0xc3, 0xbe, 0x95, 0x6d, 0xce, 0x68, 0xb6, 0xa2, 0x78, 0xf1, 0x9a, 0x68, 0xf0, 0x03, 0x74,
+	0x56, 0xc3, 0x23, 0x5d, 0x68, 0x4f, 0xcf, 0x7e, 0x4f, 0xa6, 0xe3, 0x8b, 0x5f, 0xfa, 0x9f, 0x91,
+	0x3d, 0x78, 0x7c, 0xf2, 0xee, 0xfc, 0xe2, 0xf4, 0xfc, 0x22, 0x19, 0x4f, 0x26, 0xf1, 0xe9, 0x6c,
+	0x76, 0x3a, 0xe9, 0x37, 0x48, 0x00, 0xcd, 0xd9, 0xa8, 0xdf, 0x1c, 0xfc, 0x05, 0xdd, 0xf5, 0xa1,
+	0x90, 0x23, 0x68, 0x73, 0x61, 0x50, 0xdd, 0xa4, 0xb9, 0xdd, 0x9c, 0x6a, 0xe8, 0x6e, 0x2d, 0xa3,
+	0x7a, 0x2d, 0xa3, 0x49, 0x7d, 0xe7, 0x2b, 0x2a, 0x79, 0x01, 0x40, 0x53, 0x93, 0x5d, 0x25, 0x9a,
+	0x7f, 0x40, 0xbb, 0x57, 0x5b, 0x71, 0xc7, 0x22, 0x33, 0xfe, 0x01, 0xed, 0x3a, 0xaa, 0x65, 0xa2,
+	0x4a, 0x61, 0x37, 0xaa, 0x1d, 0x07, 0x4c, 0x2d, 0xe3, 0x52, 0x0c, 0xde, 0x43, 0xff, 0xfe, 0x1f,
+	0x23, 0x13, 0xe8, 0x2b, 0xbc, 0x54, 0xa8, 0xaf, 0x92, 0x87, 0xb7, 0xd2, 0xf3, 0x92, 0xb3, 0x87,
+	0x75, 0x34, 0xf8, 0xbb, 0x01, 0x5f, 0x7c, 0xe4, 0x36, 0x49, 0x08, 0xad, 0x6a, 0xc7, 0x51, 0x69,
+	0x5b, 0x73, 0x2b, 0xae, 0x43, 0xf2, 0x13, 0xec, 0x14, 0x32, 0xcf, 0xef, 0x7a, 0x6a, 0x7e, 0xaa,
+	0xa7, 0x6e, 0xc5, 0x5f, 0x35, 0x34, 0x82, 0x96, 0xe1, 0xd7, 0x28, 0x4b, 0x63, 0x67, 0xf0, 0xbf,
+	0xca, 0x9a, 0x39, 0xf8, 0xb7, 0x01, 0xed, 0xfa, 0x8d, 0x93, 0x7d, 0x68, 0xa3, 0x60, 0x85, 0xe4,
+	0xc2, 0x78, 0x57, 0x5b, 0xc5, 0xe4, 0x4b, 0x08, 0x14, 0xce, 0xab, 0x7d, 0x75, 0xa6, 0xe6, 0xa3,
+	0x0a, 0xa7, 0x65, 0xb6, 0x40, 0xe3, 0xad, 0xcc, 0x47, 0x15, 0x5e, 0x28, 0xbc, 0xe4, 0xb7, 0xde,
+	0x9a, 0x7c, 0x44, 0x06, 0xb0, 0x93, 0x66, 0x19, 0x6a, 0x6d, 0x5d, 0x83, 0xb3, 0xda, 0x9a, 0x1c,
+	0xf8, 0x16, 0x97, 0x67, 0x8c, 0x7c, 0x07, 0x8f, 0x9d, 0x6f, 0x25, 0x77, 0x54, 0xef, 0x49, 0x3d,
+	0x97, 0x18, 0xd7, 0xec, 0xc1, 0x18, 0xe0, 0xee, 0xc1, 0x57, 0x55, 0xb9, 0xd6, 0x25, 0xaa, 0xda,
+	0x95, 0x5d, 0x54, 0xb9, 0x72, 0x96, 0x73, 0x14, 0xa6, 0xaa, 0xe8, 0x5d, 0xd9, 0x01, 0x67, 0xec,
+	0xf8, 0xf5, 0x1f, 0xdf, 0xde, 0x37, 0x7e, 0x8a, 0x43, 0xb7, 0x16, 0x43, 0xe7, 0x55, 0x3f, 0xba,
+	0x1f, 0x1a, 0xd8, 0x51, 0x8e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x12, 0xdd, 0xad, 0x69,
+	0x06, 0x00, 0x00,
 }
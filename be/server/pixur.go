@@ -9,23 +9,31 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"time"
 
 	"google.golang.org/grpc"
 
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/handlers"
 	sdb "pixur.org/pixur/be/schema/db"
 	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/similarity"
 	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
 )
 
 type Server struct {
 	db            sdb.DB
 	s             *grpc.Server
 	lnnet, lnaddr string
-	pixPath       string
+	blobs         blobstore.BlobStore
+	similarity    *similarity.Index
 	tokenSecret   []byte
 	publicKey     *rsa.PublicKey
 	privateKey    *rsa.PrivateKey
+	pruner        *pruner
+	workers       *workers
+	loader        *similarityLoader
 }
 
 func (s *Server) setup(ctx context.Context, c *config.Config) (stscap status.S) {
@@ -43,19 +51,21 @@ func (s *Server) setup(ctx context.Context, c *config.Config) (stscap status.S)
 	}()
 
 	// setup storage
-	pixPath := c.PixPath
-	fi, err := os.Stat(pixPath)
-	if os.IsNotExist(err) {
-		if err := os.MkdirAll(pixPath, os.ModeDir|0775); err != nil {
-			return status.Internal(err, "can't create pix dir")
-		}
-		//make it
-	} else if err != nil {
-		return status.Internal(err, "can't stat pix dir")
-	} else if !fi.IsDir() {
-		return status.InvalidArgument(nil, pixPath, "is not a directory")
+	blobs, sts := NewBlobStore(c)
+	if sts != nil {
+		return sts
 	}
 
+	similarityIndex := similarity.NewIndex()
+	sl, sts := newSimilarityLoader(c.Similarity)
+	if sts != nil {
+		return sts
+	}
+	sl.beg = db
+	sl.index = similarityIndex
+	sl.runner = new(tasks.TaskRunner)
+	sl.now = time.Now
+
 	var privKey *rsa.PrivateKey
 	if c.SessionPrivateKeyPath != "" {
 		f, err := os.Open(c.SessionPrivateKeyPath)
@@ -115,23 +125,63 @@ func (s *Server) setup(ctx context.Context, c *config.Config) (stscap status.S)
 		tokenSecret = []byte(c.TokenSecret)
 	}
 
+	var pr *pruner
+	if c.Pruner != nil && c.Pruner.Interval != nil {
+		interval, sts := configDuration(c.Pruner.Interval)
+		if sts != nil {
+			return status.InvalidArgument(sts, "bad pruner interval")
+		}
+		holder, sts := NewLeaseHolder()
+		if sts != nil {
+			return sts
+		}
+		pr = &pruner{
+			beg:        db,
+			blobs:      blobs,
+			similarity: similarityIndex,
+			runner:     new(tasks.TaskRunner),
+			now:        time.Now,
+			holder:     holder,
+			interval:   interval,
+			batchSize:  int(c.Pruner.BatchSize),
+			dryRun:     c.Pruner.DryRun,
+		}
+	}
+
+	ws, sts := newWorkers(c.BackgroundJobs)
+	if sts != nil {
+		return sts
+	}
+	ws.beg = db
+	ws.blobs = blobs
+	ws.similarity = similarityIndex
+	ws.runner = new(tasks.TaskRunner)
+	ws.now = time.Now
+
 	opts, cb := handlers.HandlersInit(ctx, &handlers.ServerConfig{
 		DB:                   db,
-		PixPath:              pixPath,
+		BlobStore:            blobs,
+		SimilarityIndex:      similarityIndex,
 		TokenSecret:          tokenSecret,
 		PrivateKey:           privKey,
 		PublicKey:            pubKey,
 		BackendConfiguration: c.BackendConfiguration,
+		OidcIssuer:           c.Oidc.GetIssuer(),
+		OidcClientId:         c.Oidc.GetClientId(),
 	})
 	grpcServer := grpc.NewServer(opts...)
 	cb(grpcServer)
 
 	closeDbServer = false
 	s.db = db
-	s.pixPath = c.PixPath
+	s.blobs = blobs
+	s.similarity = similarityIndex
 	s.privateKey = privKey
 	s.publicKey = pubKey
 	s.tokenSecret = tokenSecret
+	s.pruner = pr
+	s.workers = ws
+	s.loader = sl
 	s.s = grpcServer
 	s.lnnet, s.lnaddr = c.ListenNetwork, c.ListenAddress
 
@@ -168,6 +218,14 @@ func (s *Server) listenAndServe(ctx context.Context, lnready chan<- struct{}) (s
 		close(lnready)
 	}
 
+	bgCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if s.pruner != nil {
+		go s.pruner.run(bgCtx)
+	}
+	go s.workers.run(bgCtx)
+	go s.loader.run(bgCtx)
+
 	if err := s.s.Serve(ln); err != nil {
 		return status.Internal(err, "failed to serve")
 	}
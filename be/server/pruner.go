@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"expvar"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+var (
+	prunerRunsCounter    = expvar.NewInt("PixurPrunerRuns")
+	prunerScannedCounter = expvar.NewInt("PixurPrunerScanned")
+	prunerPrunedCounter  = expvar.NewInt("PixurPrunerPruned")
+	prunerFailedCounter  = expvar.NewInt("PixurPrunerFailed")
+	prunerLeaderVar      = expvar.NewInt("PixurPrunerLeader")
+)
+
+// pruner periodically hard deletes pics past their pending deletion time.  Only the server holding
+// the pruner lease does any work.
+type pruner struct {
+	beg        tab.JobBeginner
+	blobs      blobstore.BlobStore
+	similarity *similarity.Index
+	runner     *tasks.TaskRunner
+	now        func() time.Time
+
+	// identifies this server to other servers sharing the database.
+	holder    string
+	interval  time.Duration
+	batchSize int
+	dryRun    bool
+}
+
+// NewLeaseHolder returns a name for this process to hold leases under.
+func NewLeaseHolder() (string, status.S) {
+	host, err := os.Hostname()
+	if err != nil {
+		return "", status.Internal(err, "can't get hostname")
+	}
+	var nonce [8]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", status.Internal(err, "can't read random bytes")
+	}
+	return fmt.Sprintf("%s/%d/%s", host, os.Getpid(), hex.EncodeToString(nonce[:])), nil
+}
+
+// run prunes once per interval, until ctx is cancelled.
+func (p *pruner) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		if sts := p.prune(ctx); sts != nil {
+			glog.Warning("can't prune pics: ", sts)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// prune reads the pics past their pending deletion time one batch at a time.  The lease is renewed
+// before each batch, so that a long pass keeps it.
+func (p *pruner) prune(ctx context.Context) status.S {
+	prunerRunsCounter.Add(1)
+	var startPendingDeletionOrder, startPicId int64
+	for ctx.Err() == nil {
+		leaseTask := &tasks.AcquireLeaseTask{
+			Beg:      p.beg,
+			Now:      p.now,
+			LeaseId:  tasks.PrunerLeaseId,
+			Holder:   p.holder,
+			Duration: 2 * p.interval,
+		}
+		if sts := p.runner.Run(ctx, leaseTask); sts != nil {
+			prunerLeaderVar.Set(0)
+			return sts
+		}
+		if !leaseTask.Acquired {
+			prunerLeaderVar.Set(0)
+			return nil
+		}
+		prunerLeaderVar.Set(1)
+
+		task := &tasks.PruneDeletedPicsTask{
+			Beg:                       p.beg,
+			BlobStore:                 p.blobs,
+			Now:                       p.now,
+			SimilarityIndex:           p.similarity,
+			StartPendingDeletionOrder: startPendingDeletionOrder,
+			StartPicId:                startPicId,
+			BatchSize:                 p.batchSize,
+			DryRun:                    p.dryRun,
+		}
+		if sts := p.runner.Run(ctx, task); sts != nil {
+			return sts
+		}
+		prunerScannedCounter.Add(int64(task.Scanned))
+		prunerFailedCounter.Add(int64(len(task.Failures)))
+		for picId, sts := range task.Failures {
+			glog.Warning("can't prune pic ", schema.Varint(picId), ": ", sts)
+		}
+		for _, picId := range task.PrunedPicIds {
+			if p.dryRun {
+				glog.Info("would prune pic ", schema.Varint(picId))
+			} else {
+				glog.Info("pruned pic ", schema.Varint(picId))
+			}
+		}
+		if !p.dryRun {
+			prunerPrunedCounter.Add(int64(len(task.PrunedPicIds)))
+		}
+		if task.Done {
+			break
+		}
+		startPendingDeletionOrder, startPicId = task.NextPendingDeletionOrder, task.NextPicId
+	}
+	return nil
+}
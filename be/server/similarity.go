@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/golang/glog"
+
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/similarity"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+var (
+	similarityLoadedVar = expvar.NewInt("PixurSimilarityLoaded")
+	similaritySizeVar   = expvar.NewInt("PixurSimilaritySize")
+)
+
+const (
+	defaultSimilarityRefreshInterval = time.Minute
+	// similarityRefreshOverlap is how long before the previous pass each refresh starts reading.
+	// Pics are created when their upload starts, but can't be read until it commits, and other
+	// servers' clocks may be a little off.
+	similarityRefreshOverlap = 10 * time.Minute
+)
+
+// similarityLoader fills the similarity index in the background, and then periodically adds the
+// pics created since.  Until the first pass finishes, searches miss the pics not loaded yet.
+type similarityLoader struct {
+	beg    tab.JobBeginner
+	index  *similarity.Index
+	runner *tasks.TaskRunner
+	now    func() time.Time
+
+	interval  time.Duration
+	batchSize int
+}
+
+func newSimilarityLoader(c *config.SimilarityConfig) (*similarityLoader, status.S) {
+	l := &similarityLoader{
+		interval: defaultSimilarityRefreshInterval,
+	}
+	if c == nil {
+		return l, nil
+	}
+	if c.RefreshInterval != nil {
+		d, sts := configDuration(c.RefreshInterval)
+		if sts != nil {
+			return nil, status.InvalidArgument(sts, "bad refresh interval")
+		}
+		l.interval = d
+	}
+	l.batchSize = int(c.BatchSize)
+	return l, nil
+}
+
+// run loads every pic, and then the recently created ones once per interval, until ctx is
+// cancelled.  If a pass fails, the next one starts from the same point.
+func (l *similarityLoader) run(ctx context.Context) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+	// The zero time means every pic is read.
+	var since time.Time
+	for {
+		start := l.now()
+		if sts := l.load(ctx, since); sts != nil {
+			glog.Warning("can't load similarity index: ", sts)
+		} else {
+			similarityLoadedVar.Set(1)
+			since = start.Add(-similarityRefreshOverlap)
+		}
+		similaritySizeVar.Set(int64(l.index.Len()))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// load reads the pics created since the given time one batch at a time.
+func (l *similarityLoader) load(ctx context.Context, since time.Time) status.S {
+	var startPicId, startIndexOrder int64
+	for ctx.Err() == nil {
+		task := &tasks.LoadSimilarityIndexTask{
+			Beg:             l.beg,
+			Index:           l.index,
+			CreatedSince:    since,
+			StartPicId:      startPicId,
+			StartIndexOrder: startIndexOrder,
+			BatchSize:       l.batchSize,
+		}
+		if sts := l.runner.Run(ctx, task); sts != nil {
+			return sts
+		}
+		if task.Done {
+			return nil
+		}
+		startPicId, startIndexOrder = task.NextPicId, task.NextIndexOrder
+	}
+	return status.From(ctx.Err())
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"expvar"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/similarity"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+var (
+	backgroundJobsStartedCounter   = expvar.NewInt("PixurBackgroundJobsStarted")
+	backgroundJobsSucceededCounter = expvar.NewInt("PixurBackgroundJobsSucceeded")
+	backgroundJobsFailedCounter    = expvar.NewInt("PixurBackgroundJobsFailed")
+	backgroundJobsRetriedCounter   = expvar.NewInt("PixurBackgroundJobsRetried")
+)
+
+const (
+	defaultBackgroundJobWorkers      = 2
+	defaultBackgroundJobPollInterval = 5 * time.Second
+	defaultBackgroundJobTimeout      = 10 * time.Minute
+)
+
+// workers run background jobs from the queue.
+type workers struct {
+	beg        tab.JobBeginner
+	blobs      blobstore.BlobStore
+	similarity *similarity.Index
+	runner     *tasks.TaskRunner
+	now        func() time.Time
+
+	count        int
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+func newWorkers(c *config.BackgroundJobConfig) (*workers, status.S) {
+	w := &workers{
+		count:        defaultBackgroundJobWorkers,
+		pollInterval: defaultBackgroundJobPollInterval,
+		timeout:      defaultBackgroundJobTimeout,
+	}
+	if c == nil {
+		return w, nil
+	}
+	w.count = int(c.Workers)
+	if c.PollInterval != nil {
+		d, sts := configDuration(c.PollInterval)
+		if sts != nil {
+			return nil, status.InvalidArgument(sts, "bad poll interval")
+		}
+		w.pollInterval = d
+	}
+	if c.Timeout != nil {
+		d, sts := configDuration(c.Timeout)
+		if sts != nil {
+			return nil, status.InvalidArgument(sts, "bad timeout")
+		}
+		w.timeout = d
+	}
+	return w, nil
+}
+
+// run starts the workers, and waits for them to stop once ctx is cancelled.
+func (w *workers) run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < w.count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (w *workers) work(ctx context.Context) {
+	for {
+		ran, sts := w.runOne(ctx)
+		if sts != nil {
+			glog.Warning("can't run background job: ", sts)
+		}
+		if ran && sts == nil {
+			// There may be more jobs ready, so don't wait.
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// runOne claims and runs a single job, and returns if there was one.
+func (w *workers) runOne(ctx context.Context) (bool, status.S) {
+	claimTask := &tasks.ClaimBackgroundJobTask{
+		Beg:     w.beg,
+		Now:     w.now,
+		Timeout: w.timeout,
+	}
+	if sts := w.runner.Run(ctx, claimTask); sts != nil {
+		return false, sts
+	}
+	if claimTask.BackgroundJob == nil {
+		return false, nil
+	}
+	backgroundJobsStartedCounter.Add(1)
+
+	task := &tasks.RunBackgroundJobTask{
+		Beg:             w.beg,
+		BlobStore:       w.blobs,
+		HTTPClient:      http.DefaultClient,
+		TempFile:        ioutil.TempFile,
+		Now:             w.now,
+		Remove:          os.Remove,
+		SimilarityIndex: w.similarity,
+		Runner:          w.runner,
+		Timeout:         w.timeout,
+		BackgroundJob:   claimTask.BackgroundJob,
+	}
+	if sts := w.runner.Run(ctx, task); sts != nil {
+		return true, sts
+	}
+	bj := task.BackgroundJob
+	switch bj.Status.GetState() {
+	case schema.BackgroundJob_Status_SUCCEEDED:
+		backgroundJobsSucceededCounter.Add(1)
+	case schema.BackgroundJob_Status_PENDING:
+		backgroundJobsRetriedCounter.Add(1)
+		glog.Info("background job ", schema.Varint(bj.JobId), " will be retried: ", task.JobStatus)
+	case schema.BackgroundJob_Status_FAILED:
+		backgroundJobsFailedCounter.Add(1)
+		glog.Warning("background job ", schema.Varint(bj.JobId), " failed: ", task.JobStatus)
+	}
+	return true, nil
+}
+
+// configDuration converts a positive duration from the config.
+func configDuration(d *durpb.Duration) (time.Duration, status.S) {
+	dur, err := ptypes.Duration(d)
+	if err != nil {
+		return 0, status.InvalidArgument(err, "can't parse duration")
+	}
+	if dur <= 0 {
+		return 0, status.InvalidArgument(nil, "duration must be positive", dur)
+	}
+	return dur, nil
+}
@@ -0,0 +1,179 @@
+// Package similarity finds pics with similar perceptual hashes.
+package similarity // import "pixur.org/pixur/be/similarity"
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sort"
+	"sync"
+
+	"pixur.org/pixur/be/status"
+)
+
+const (
+	// chunks is the number of substrings each hash is split into.  Each substring has its own
+	// table, keyed by the exact substring value.
+	chunks    = 4
+	chunkBits = 64 / chunks
+
+	// maxChunkRadius is the largest per chunk radius that is probed.  Beyond this, enumerating
+	// the neighboring substrings is slower than checking every hash.
+	maxChunkRadius = 4
+
+	// MaxRadius is the largest Hamming distance between two hashes.
+	MaxRadius = 64
+)
+
+// Match is a single result of a similarity search.
+type Match struct {
+	PicId int64
+	// Distance is the Hamming distance between the query and pic hashes.
+	Distance int
+}
+
+// Index is an in memory multi-index hash of 64 bit perceptual hashes.  Each hash is split into
+// equal sized chunks.  If two hashes are within radius r of each other, at least one of their
+// chunks must be within r / chunks, so only the nearby entries of each chunk table need to be
+// checked.  It is safe for concurrent use.
+type Index struct {
+	mu     sync.RWMutex
+	hashes map[int64]uint64
+	tables [chunks]map[uint16][]int64
+}
+
+// NewIndex creates an empty Index.
+func NewIndex() *Index {
+	idx := &Index{
+		hashes: make(map[int64]uint64),
+	}
+	for i := range idx.tables {
+		idx.tables[i] = make(map[uint16][]int64)
+	}
+	return idx
+}
+
+// Hash decodes the value of a DCT_0 pic ident.
+func Hash(value []byte) (uint64, status.S) {
+	if len(value) != 8 {
+		return 0, status.InvalidArgument(nil, "bad hash length", len(value))
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
+
+func chunk(hash uint64, i int) uint16 {
+	return uint16(hash >> (uint(i) * chunkBits))
+}
+
+// Add adds or replaces the hash for a pic.
+func (idx *Index) Add(picId int64, hash uint64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if old, present := idx.hashes[picId]; present {
+		if old == hash {
+			return
+		}
+		idx.remove(picId, old)
+	}
+	idx.hashes[picId] = hash
+	for i := range idx.tables {
+		c := chunk(hash, i)
+		idx.tables[i][c] = append(idx.tables[i][c], picId)
+	}
+}
+
+// Remove removes the hash for a pic, if present.
+func (idx *Index) Remove(picId int64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if old, present := idx.hashes[picId]; present {
+		idx.remove(picId, old)
+	}
+}
+
+func (idx *Index) remove(picId int64, hash uint64) {
+	delete(idx.hashes, picId)
+	for i := range idx.tables {
+		c := chunk(hash, i)
+		ids := idx.tables[i][c]
+		for k, id := range ids {
+			if id == picId {
+				ids[k] = ids[len(ids)-1]
+				ids = ids[:len(ids)-1]
+				break
+			}
+		}
+		if len(ids) == 0 {
+			delete(idx.tables[i], c)
+		} else {
+			idx.tables[i][c] = ids
+		}
+	}
+}
+
+// Len returns the number of hashes in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.hashes)
+}
+
+// Search finds all pics whose hash is within radius of hash, closest first.  Ties are broken by
+// pic id.  If limit is positive, at most limit matches are returned.
+func (idx *Index) Search(hash uint64, radius, limit int) []Match {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var matches []Match
+	if chunkRadius := radius / chunks; chunkRadius > maxChunkRadius {
+		for picId, h := range idx.hashes {
+			if d := bits.OnesCount64(hash ^ h); d <= radius {
+				matches = append(matches, Match{PicId: picId, Distance: d})
+			}
+		}
+	} else {
+		seen := make(map[int64]struct{})
+		for i := range idx.tables {
+			table := idx.tables[i]
+			forEachNeighbor(chunk(hash, i), chunkRadius, func(c uint16) {
+				for _, picId := range table[c] {
+					if _, present := seen[picId]; present {
+						continue
+					}
+					seen[picId] = struct{}{}
+					if d := bits.OnesCount64(hash ^ idx.hashes[picId]); d <= radius {
+						matches = append(matches, Match{PicId: picId, Distance: d})
+					}
+				}
+			})
+		}
+	}
+
+	sort.Slice(matches, func(i, k int) bool {
+		if matches[i].Distance != matches[k].Distance {
+			return matches[i].Distance < matches[k].Distance
+		}
+		return matches[i].PicId < matches[k].PicId
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// forEachNeighbor calls fn with every value within radius bits of c, including c itself.
+func forEachNeighbor(c uint16, radius int, fn func(uint16)) {
+	var flip func(c uint16, from uint, left int)
+	flip = func(c uint16, from uint, left int) {
+		fn(c)
+		if left == 0 {
+			return
+		}
+		for b := from; b < chunkBits; b++ {
+			flip(c^(1<<b), b+1, left-1)
+		}
+	}
+	if radius < 0 {
+		return
+	}
+	flip(c, 0, radius)
+}
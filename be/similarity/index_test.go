@@ -0,0 +1,113 @@
+package similarity
+
+import (
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func bruteForce(hashes map[int64]uint64, hash uint64, radius int) []Match {
+	var matches []Match
+	for picId, h := range hashes {
+		if d := bits.OnesCount64(hash ^ h); d <= radius {
+			matches = append(matches, Match{PicId: picId, Distance: d})
+		}
+	}
+	sort.Slice(matches, func(i, k int) bool {
+		if matches[i].Distance != matches[k].Distance {
+			return matches[i].Distance < matches[k].Distance
+		}
+		return matches[i].PicId < matches[k].PicId
+	})
+	return matches
+}
+
+// flipBits flips n distinct random bits of hash.
+func flipBits(rng *rand.Rand, hash uint64, n int) uint64 {
+	for _, b := range rng.Perm(64)[:n] {
+		hash ^= 1 << uint(b)
+	}
+	return hash
+}
+
+func TestSearchMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	idx := NewIndex()
+	hashes := make(map[int64]uint64)
+	base := rng.Uint64()
+	for i := int64(1); i <= 2000; i++ {
+		// Keep many hashes near the base so that there is something to find.
+		h := flipBits(rng, base, rng.Intn(24))
+		if i%2 == 0 {
+			h = rng.Uint64()
+		}
+		hashes[i] = h
+		idx.Add(i, h)
+	}
+	if have, want := idx.Len(), len(hashes); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	for _, radius := range []int{0, 3, 4, 10, 17, 19, 20, 32, 64} {
+		have := idx.Search(base, radius, 0)
+		want := bruteForce(hashes, base, radius)
+		if !reflect.DeepEqual(have, want) {
+			t.Error("radius", radius, "have", len(have), "want", len(want))
+		}
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	idx := NewIndex()
+	idx.Add(3, 0x3)
+	idx.Add(2, 0x1)
+	idx.Add(1, 0x2)
+	idx.Add(4, 0x0)
+
+	have := idx.Search(0, 10, 3)
+	want := []Match{{PicId: 4, Distance: 0}, {PicId: 1, Distance: 1}, {PicId: 2, Distance: 1}}
+	if !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddReplaces(t *testing.T) {
+	idx := NewIndex()
+	idx.Add(1, 0)
+	idx.Add(1, ^uint64(0))
+
+	if have := idx.Search(0, 10, 0); len(have) != 0 {
+		t.Error("have", have, "want none")
+	}
+	have := idx.Search(^uint64(0), 0, 0)
+	want := []Match{{PicId: 1, Distance: 0}}
+	if !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	idx := NewIndex()
+	idx.Add(1, 0xff)
+	idx.Add(2, 0xff)
+	idx.Remove(1)
+	// Removing a missing pic is a no-op.
+	idx.Remove(5)
+
+	have := idx.Search(0xff, 0, 0)
+	want := []Match{{PicId: 2, Distance: 0}}
+	if !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := idx.Len(), 1; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestHashFailsOnBadLength(t *testing.T) {
+	if _, sts := Hash([]byte{1, 2, 3}); sts == nil {
+		t.Error("expected error")
+	}
+}
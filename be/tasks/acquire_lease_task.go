@@ -0,0 +1,106 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// Lease ids used by Pixur.
+const (
+	// PrunerLeaseId is held by the server hard deleting pics.
+	PrunerLeaseId int64 = 1
+)
+
+var _ Task = &AcquireLeaseTask{}
+
+// AcquireLeaseTask takes or renews a lease, so that only one of several servers sharing a database
+// does some work.  It is only meant to be run by the server, so no user is needed.
+type AcquireLeaseTask struct {
+	// deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// input
+	LeaseId int64
+	// Identifies the server taking the lease.
+	Holder string
+	// How long the lease is held for.
+	Duration time.Duration
+
+	// output
+	// Set if Holder holds the lease.
+	Acquired bool
+	Lease    *schema.Lease
+}
+
+func (t *AcquireLeaseTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.Acquired, t.Lease = false, nil
+	if t.Holder == "" {
+		return status.InvalidArgument(nil, "missing lease holder")
+	}
+	if t.Duration <= 0 {
+		return status.InvalidArgument(nil, "bad lease duration", t.Duration)
+	}
+	now := t.Now()
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	cds, sts := findCustomData(j, db.LockWrite, schema.LeaseKeyType, t.LeaseId)
+	if sts != nil {
+		return sts
+	}
+	lease := new(schema.Lease)
+	var cd *schema.CustomData
+	if len(cds) > 0 {
+		cd = cds[0]
+		if err := ptypes.UnmarshalAny(cd.Data, lease); err != nil {
+			return status.Internal(err, "can't unmarshal lease", t.LeaseId)
+		}
+		if lease.Holder != t.Holder && now.Before(schema.ToTime(lease.ExpiresTs)) {
+			t.Lease = lease
+			return nil
+		}
+		if lease.Holder != t.Holder {
+			lease.AcquiredTs = schema.ToTspb(now)
+		}
+	} else {
+		lease.AcquiredTs = schema.ToTspb(now)
+	}
+	lease.Holder = t.Holder
+	lease.ExpiresTs = schema.ToTspb(now.Add(t.Duration))
+	data, err := ptypes.MarshalAny(lease)
+	if err != nil {
+		return status.Internal(err, "can't marshal lease")
+	}
+
+	if cd != nil {
+		cd.Data = data
+		cd.ModifiedTs = schema.ToTspb(now)
+		if err := j.UpdateCustomData(cd); err != nil {
+			return status.Internal(err, "can't update custom data")
+		}
+	} else {
+		if _, sts := createCustomData(j, schema.LeaseKeyType, t.LeaseId, 0, 0, 0, 0, now, data); sts != nil {
+			return sts
+		}
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.Acquired = true
+	t.Lease = lease
+	return nil
+}
@@ -0,0 +1,65 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAcquireLease(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	nowFn := func() time.Time { return now }
+	acquire := func(holder string) *AcquireLeaseTask {
+		task := &AcquireLeaseTask{
+			Beg:      c.DB(),
+			Now:      nowFn,
+			LeaseId:  PrunerLeaseId,
+			Holder:   holder,
+			Duration: time.Minute,
+		}
+		if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+			t.Fatal(sts)
+		}
+		return task
+	}
+
+	if task := acquire("a"); !task.Acquired {
+		t.Error("lease not acquired")
+	}
+	// renewing is okay
+	if task := acquire("a"); !task.Acquired {
+		t.Error("lease not renewed")
+	}
+	task := acquire("b")
+	if task.Acquired {
+		t.Error("lease acquired while held")
+	}
+	if have, want := task.Lease.Holder, "a"; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	now = now.Add(time.Minute + time.Second)
+	if task := acquire("b"); !task.Acquired {
+		t.Error("expired lease not acquired")
+	}
+	if task := acquire("a"); task.Acquired {
+		t.Error("lease acquired while held")
+	}
+}
+
+func TestAcquireLeaseFailsOnMissingHolder(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &AcquireLeaseTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		LeaseId:  PrunerLeaseId,
+		Duration: time.Minute,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts == nil {
+		t.Error("expected error")
+	}
+}
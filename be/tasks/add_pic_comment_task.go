@@ -44,6 +44,10 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
+	ru, sts := resolveUserRoles(j, u)
+	if sts != nil {
+		return sts
+	}
 	var minCommentLen, maxCommentLen int64
 	if conf.MinCommentLength != nil {
 		minCommentLen = conf.MinCommentLength.Value
@@ -60,7 +64,7 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 		return status.From(err)
 	}
 
-	if sts := validateCapability(u, conf, schema.User_PIC_COMMENT_CREATE); sts != nil {
+	if sts := hasCapability(ru, conf, schema.User_PIC_COMMENT_CREATE); sts != nil {
 		return sts
 	}
 	userId := schema.AnonymousUserId
@@ -69,7 +73,7 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 	}
 
 	if len(t.Ext) != 0 {
-		if sts := validateCapability(u, conf, schema.User_PIC_COMMENT_EXTENSION_CREATE); sts != nil {
+		if sts := hasCapability(ru, conf, schema.User_PIC_COMMENT_EXTENSION_CREATE); sts != nil {
 			return sts
 		}
 	}
@@ -80,7 +84,7 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't lookup pic")
 	}
-	if len(pics) != 1 {
+	if len(pics) != 1 || !picVisible(pics[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't find pic")
 	}
 	p := pics[0]
@@ -233,7 +237,7 @@ func (t *AddPicCommentTask) Run(ctx context.Context) (stscap status.S) {
 		return status.Internal(err, "can't commit job")
 	}
 	t.UnfilteredPicComment = pc
-	t.PicComment = filterPicComment(t.UnfilteredPicComment, u, conf)
+	t.PicComment = filterPicComment(t.UnfilteredPicComment, ru, conf)
 
 	// TODO: ratelimit
 	return nil
@@ -272,6 +276,7 @@ func filterPicComment(
 
 type userCred struct {
 	subjectUserId int64
+	roleIds       []int64
 	cs            *schema.CapSet
 }
 
@@ -279,6 +284,7 @@ func userCredOf(su *schema.User, conf *schema.Configuration) *userCred {
 	if su != nil {
 		return &userCred{
 			subjectUserId: su.UserId,
+			roleIds:       su.RoleId,
 			cs:            schema.CapSetOf(su.Capability...),
 		}
 	} else {
@@ -163,6 +163,39 @@ func TestAddPicCommentTask_MissingPic(t *testing.T) {
 	}
 }
 
+func TestAddPicCommentTask_PrivatePicHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+
+	task := &AddPicCommentTask{
+		Text:  "hi",
+		PicId: p.Pic.PicId,
+		Beg:   c.DB(),
+		Now:   time.Now,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if task.PicComment != nil {
+		t.Error("comment created", task.PicComment)
+	}
+}
+
 func TestAddPicCommentTaskWork_MissingPermission(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
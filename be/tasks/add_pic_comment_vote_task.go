@@ -44,7 +44,8 @@ func (t *AddPicCommentVoteTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_COMMENT_VOTE_CREATE); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_COMMENT_VOTE_CREATE)
+	if sts != nil {
 		return sts
 	}
 	userId := schema.AnonymousUserId
@@ -54,8 +55,7 @@ func (t *AddPicCommentVoteTask) Run(ctx context.Context) (stscap status.S) {
 	}
 
 	if len(t.Ext) != 0 {
-		sts := validateCapability(u, conf, schema.User_PIC_COMMENT_VOTE_EXTENSION_CREATE)
-		if sts != nil {
+		if sts := hasCapability(ru, conf, schema.User_PIC_COMMENT_VOTE_EXTENSION_CREATE); sts != nil {
 			return sts
 		}
 	}
@@ -66,7 +66,7 @@ func (t *AddPicCommentVoteTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't lookup pic", t.PicId)
 	}
-	if len(ps) != 1 {
+	if len(ps) != 1 || !picVisible(ps[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't find pic", t.PicId)
 	}
 	p := ps[0]
@@ -231,6 +231,40 @@ func TestAddPicCommentVoteTask_badPicId(t *testing.T) {
 	}
 }
 
+func TestAddPicCommentVoteTask_PrivatePicHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+	pc := p.Comment()
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_COMMENT_VOTE_CREATE)
+	u.Update()
+
+	task := &AddPicCommentVoteTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+		CommentId: pc.PicComment.CommentId,
+		Vote:      schema.PicCommentVote_UP,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if task.PicCommentVote != nil {
+		t.Error("comment voted", task.PicCommentVote)
+	}
+}
+
 func TestAddPicCommentVoteTask_hardDeletedPic(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
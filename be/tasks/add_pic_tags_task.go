@@ -39,7 +39,8 @@ func (t *AddPicTagsTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_TAG_CREATE); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_TAG_CREATE)
+	if sts != nil {
 		return sts
 	}
 
@@ -56,7 +57,7 @@ func (t *AddPicTagsTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't lookup pic")
 	}
-	if len(pics) != 1 {
+	if len(pics) != 1 || !picVisible(pics[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't find pic")
 	}
 	p := pics[0]
@@ -101,12 +102,22 @@ func upsertTags(j *tab.Job, rawTags []string, picId int64, now time.Time,
 	if sts != nil {
 		return nil, sts
 	}
+	if newTagNames, sts = resolveTagAliases(j, newTagNames); sts != nil {
+		return nil, sts
+	}
+	impliedTagNames, sts := findImpliedTagNames(j, newTagNames)
+	if sts != nil {
+		return nil, sts
+	}
 	attachedTags, _, sts := findAttachedPicTags(j, picId)
 	if sts != nil {
 		return nil, sts
 	}
 
-	unattachedTagNames := findUnattachedTagNames(attachedTags, newTagNames)
+	allTagNames := make([]tagNameAndUniq, 0, len(newTagNames)+len(impliedTagNames))
+	allTagNames = append(allTagNames, newTagNames...)
+	allTagNames = append(allTagNames, impliedTagNames...)
+	unattachedTagNames := findUnattachedTagNames(attachedTags, allTagNames)
 	unattachedExistingTags, unknownNames, sts := findExistingTagsByName(j, unattachedTagNames)
 	if sts != nil {
 		return nil, sts
@@ -149,7 +160,7 @@ func buildTagIdList(providedTagNames []tagNameAndUniq, existing, created []*sche
 	for _, providedTagName := range providedTagNames {
 		if tagId, present := tagMap[providedTagName.uniq]; !present {
 			return nil, status.Internal(nil, "tag missing", providedTagName.orig)
-		} else if _, present = nameMap[providedTagName.uniq]; present {
+		} else if _, present = nameMap[providedTagName.orig]; present {
 			return nil, status.Internal(nil, "duplicate tag", providedTagName.orig)
 		} else {
 			nameMap[providedTagName.orig] = tagId
@@ -186,7 +197,8 @@ func findAttachedPicTags(j *tab.Job, picId int64) ([]*schema.Tag, []*schema.PicT
 	return tags, pts, nil
 }
 
-// findUnattachedTagNames finds tag names that are not part of a pic's tags.
+// findUnattachedTagNames finds tag names that are not part of a pic's tags.  Duplicate names are
+// only returned once.
 // While pic tags are the SoT for attachment, only the Tag is the SoT for the name.
 func findUnattachedTagNames(attachedTags []*schema.Tag, newTagNames []tagNameAndUniq) []tagNameAndUniq {
 	attachedTagNames := make(map[string]struct{}, len(attachedTags))
@@ -198,12 +210,125 @@ func findUnattachedTagNames(attachedTags []*schema.Tag, newTagNames []tagNameAnd
 	for _, newTagName := range newTagNames {
 		if _, attached := attachedTagNames[newTagName.uniq]; !attached {
 			unattachedTagNames = append(unattachedTagNames, newTagName)
+			attachedTagNames[newTagName.uniq] = struct{}{}
 		}
 	}
 
 	return unattachedTagNames
 }
 
+// resolveTagAliases replaces aliased tag names with the name of their canonical tag.  The
+// original name is kept.
+func resolveTagAliases(j *tab.Job, names []tagNameAndUniq) ([]tagNameAndUniq, status.S) {
+	resolved := make([]tagNameAndUniq, 0, len(names))
+	for _, name := range names {
+		tag, sts := lookupTagAlias(j, name.uniq)
+		if sts != nil {
+			return nil, sts
+		}
+		if tag != nil {
+			name.name = tag.Name
+			name.uniq = schema.TagUniqueName(tag.Name)
+		}
+		resolved = append(resolved, name)
+	}
+	return resolved, nil
+}
+
+// lookupTagAlias finds the canonical tag for an alias.  It returns nil if uniq is not an alias.
+func lookupTagAlias(j *tab.Job, uniq string) (*schema.Tag, status.S) {
+	tas, err := j.FindTagAliases(db.Opts{
+		Prefix: tab.TagAliasesPrimary{&uniq},
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find tag aliases")
+	}
+	if len(tas) != 1 {
+		return nil, nil
+	}
+	ts, err := j.FindTags(db.Opts{
+		Prefix: tab.TagsPrimary{&tas[0].TagId},
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find tags")
+	}
+	if len(ts) != 1 {
+		return nil, status.Internal(nil, "can't lookup aliased tag", tas[0].TagId)
+	}
+	return ts[0], nil
+}
+
+// findImpliedTagNames finds the names of all tags transitively implied by names, excluding names
+// themselves.  Names of tags that don't exist yet can't imply anything.
+func findImpliedTagNames(j *tab.Job, names []tagNameAndUniq) ([]tagNameAndUniq, status.S) {
+	var tagIds []int64
+	for _, name := range names {
+		ts, err := j.FindTags(db.Opts{
+			Prefix: tab.TagsName{&name.uniq},
+			Limit:  1,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find tags")
+		}
+		if len(ts) == 1 {
+			tagIds = append(tagIds, ts[0].TagId)
+		}
+	}
+	impliedTagIds, sts := findImpliedTagIds(j, tagIds)
+	if sts != nil {
+		return nil, sts
+	}
+	var impliedNames []tagNameAndUniq
+	for _, tagId := range impliedTagIds {
+		ts, err := j.FindTags(db.Opts{
+			Prefix: tab.TagsPrimary{&tagId},
+			Limit:  1,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find tags")
+		}
+		if len(ts) != 1 {
+			return nil, status.Internal(nil, "can't lookup implied tag", tagId)
+		}
+		impliedNames = append(impliedNames, tagNameAndUniq{
+			name: ts[0].Name,
+			orig: ts[0].Name,
+			uniq: schema.TagUniqueName(ts[0].Name),
+		})
+	}
+	return impliedNames, nil
+}
+
+// findImpliedTagIds finds the ids of all tags transitively implied by tagIds, excluding tagIds
+// themselves.
+func findImpliedTagIds(j *tab.Job, tagIds []int64) ([]int64, status.S) {
+	seen := make(map[int64]bool, len(tagIds))
+	for _, tagId := range tagIds {
+		seen[tagId] = true
+	}
+	var impliedTagIds []int64
+	for pending := append([]int64(nil), tagIds...); len(pending) != 0; {
+		tagId := pending[0]
+		pending = pending[1:]
+		tis, err := j.FindTagImplications(db.Opts{
+			Prefix: tab.TagImplicationsPrimary{TagId: &tagId},
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find tag implications")
+		}
+		for _, ti := range tis {
+			if !seen[ti.ImpliedTagId] {
+				seen[ti.ImpliedTagId] = true
+				impliedTagIds = append(impliedTagIds, ti.ImpliedTagId)
+				pending = append(pending, ti.ImpliedTagId)
+			}
+		}
+	}
+	return impliedTagIds, nil
+}
+
 func findExistingTagsByName(j *tab.Job, names []tagNameAndUniq) (
 	tags []*schema.Tag, unknownNames []tagNameAndUniq, _ status.S) {
 	for _, name := range names {
@@ -46,6 +46,120 @@ func TestAddPicTags_TagsCollapsed(t *testing.T) {
 	}
 }
 
+func TestAddPicTags_AliasResolved(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	tt := c.CreateTag()
+	c.CreateTagAlias("kitty", tt)
+
+	task := &AddPicTagsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		PicId:    p.Pic.PicId,
+		TagNames: []string{"Kitty"},
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ts, _ := p.Tags()
+	if len(ts) != 1 || ts[0].Tag.TagId != tt.Tag.TagId {
+		t.Error("bad tags", len(ts), ts)
+	}
+	if have, want := task.TagNameToTagId["Kitty"], tt.Tag.TagId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddPicTags_ImpliedTagsAdded(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	tag1, tag2, tag3 := c.CreateTag(), c.CreateTag(), c.CreateTag()
+	c.CreateTagImplication(tag1, tag2)
+	c.CreateTagImplication(tag2, tag3)
+	c.CreatePicTag(p, tag3)
+
+	task := &AddPicTagsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		PicId:    p.Pic.PicId,
+		TagNames: []string{tag1.Tag.Name},
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ts, _ := p.Tags()
+	if len(ts) != 3 {
+		t.Fatal("bad tags", len(ts), ts)
+	}
+	if !tag2.Refresh() {
+		t.Fatal("tag removed")
+	}
+	if have, want := tag2.Tag.UsageCount, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !tag3.Refresh() {
+		t.Fatal("tag removed")
+	}
+	if have, want := tag3.Tag.UsageCount, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAddPicTags_PrivatePicHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+
+	task := &AddPicTagsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		PicId:    p.Pic.PicId,
+		TagNames: []string{"Blooper"},
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if ts, _ := p.Tags(); len(ts) != 0 {
+		t.Error("pic tagged", ts)
+	}
+}
+
 func TestUpsertTags(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -45,7 +45,8 @@ func (t *AddPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_VOTE_CREATE); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_VOTE_CREATE)
+	if sts != nil {
 		return sts
 	}
 	userId := schema.AnonymousUserId
@@ -55,7 +56,7 @@ func (t *AddPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 	}
 
 	if len(t.Ext) != 0 {
-		if sts := validateCapability(u, conf, schema.User_PIC_VOTE_EXTENSION_CREATE); sts != nil {
+		if sts := hasCapability(ru, conf, schema.User_PIC_VOTE_EXTENSION_CREATE); sts != nil {
 			return sts
 		}
 	}
@@ -67,7 +68,7 @@ func (t *AddPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't lookup pic", t.PicId)
 	}
-	if len(pics) != 1 {
+	if len(pics) != 1 || !picVisible(pics[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't find pic", t.PicId)
 	}
 	p := pics[0]
@@ -198,7 +199,7 @@ func (t *AddPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 		return status.Internal(err, "can't commit job")
 	}
 	t.UnfilteredPicVote = pv
-	t.PicVote = filterPicVote(t.UnfilteredPicVote, u, conf)
+	t.PicVote = filterPicVote(t.UnfilteredPicVote, ru, conf)
 
 	// TODO: ratelimit
 
@@ -132,6 +132,40 @@ func TestAddPicVoteTaskWork_MissingPic(t *testing.T) {
 	}
 }
 
+func TestAddPicVoteTaskWork_PrivatePicHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_VOTE_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+
+	task := &AddPicVoteTask{
+		Vote:  schema.PicVote_UP,
+		PicId: p.Pic.PicId,
+		Beg:   c.DB(),
+		Now:   time.Now,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	p.Refresh()
+	if p.Pic.VoteUp != 0 {
+		t.Error("pic voted", p.Pic.VoteUp)
+	}
+}
+
 func TestAddPicVoteTaskWork_CantVoteOnHardDeleted(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
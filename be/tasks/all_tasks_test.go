@@ -19,9 +19,11 @@ import (
 
 	"golang.org/x/crypto/bcrypt"
 
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
 )
 
 var sqlAdapterName = "sqlite3"
@@ -105,6 +107,19 @@ func (c *TestContainer) TempDir() string {
 	return c.tempdir
 }
 
+func (c *TestContainer) BlobStore() blobstore.BlobStore {
+	return blobstore.NewPixPathStore(c.TempDir())
+}
+
+// failingDeleteBlobStore fails every deletion.
+type failingDeleteBlobStore struct {
+	blobstore.BlobStore
+}
+
+func (s failingDeleteBlobStore) Delete(ctx context.Context, key blobstore.Key) status.S {
+	return status.Internal(nil, "nope")
+}
+
 func (c *TestContainer) TempFile() *os.File {
 	f, err := ioutil.TempFile(c.TempDir(), "__")
 	if err != nil {
@@ -453,6 +468,91 @@ func (pt *TestPicTag) Refresh() (exists bool) {
 	return
 }
 
+type TestTagAlias struct {
+	TagAlias *schema.TagAlias
+	c        *TestContainer
+}
+
+func (c *TestContainer) CreateTagAlias(name string, t *TestTag) *TestTagAlias {
+	now := time.Now()
+	ta := &schema.TagAlias{
+		Name:  name,
+		TagId: t.Tag.TagId,
+	}
+	ta.SetCreatedTime(now)
+	ta.SetModifiedTime(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertTagAlias(ta)
+	})
+	return &TestTagAlias{
+		TagAlias: ta,
+		c:        c,
+	}
+}
+
+func (ta *TestTagAlias) Refresh() (exists bool) {
+	ta.c.AutoJob(func(j *tab.Job) error {
+		uniq := ta.TagAlias.NameCol()
+		tas, err := j.FindTagAliases(db.Opts{
+			Prefix: tab.TagAliasesPrimary{&uniq},
+		})
+		if err != nil {
+			return err
+		}
+		if len(tas) == 1 {
+			ta.TagAlias = tas[0]
+			exists = true
+		} else {
+			ta.TagAlias = nil
+			exists = false
+		}
+		return nil
+	})
+	return
+}
+
+type TestTagImplication struct {
+	TagImplication *schema.TagImplication
+	c              *TestContainer
+}
+
+func (c *TestContainer) CreateTagImplication(t, implied *TestTag) *TestTagImplication {
+	now := time.Now()
+	ti := &schema.TagImplication{
+		TagId:        t.Tag.TagId,
+		ImpliedTagId: implied.Tag.TagId,
+	}
+	ti.SetCreatedTime(now)
+	ti.SetModifiedTime(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertTagImplication(ti)
+	})
+	return &TestTagImplication{
+		TagImplication: ti,
+		c:              c,
+	}
+}
+
+func (ti *TestTagImplication) Refresh() (exists bool) {
+	ti.c.AutoJob(func(j *tab.Job) error {
+		tis, err := j.FindTagImplications(db.Opts{
+			Prefix: tab.TagImplicationsPrimary{&ti.TagImplication.TagId, &ti.TagImplication.ImpliedTagId},
+		})
+		if err != nil {
+			return err
+		}
+		if len(tis) == 1 {
+			ti.TagImplication = tis[0]
+			exists = true
+		} else {
+			ti.TagImplication = nil
+			exists = false
+		}
+		return nil
+	})
+	return
+}
+
 type TestPicComment struct {
 	PicComment *schema.PicComment
 	c          *TestContainer
@@ -2,8 +2,10 @@ package tasks
 
 import (
 	"context"
+	"net"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 
 	"pixur.org/pixur/be/schema"
@@ -31,15 +33,48 @@ func authedJob(ctx context.Context, beg tab.JobBeginner, now time.Time) (
 
 	tok, present := UserTokenFromCtx(ctx)
 	if !present {
+		if key, ok := ApiKeyFromCtx(ctx); ok {
+			u, sts := validateApiKey(j, key, now)
+			if sts != nil {
+				return nil, nil, sts
+			}
+			rollback = false
+			return j, u, nil
+		}
+		// Background jobs run as the user who created them, who has no token.
+		if userId, ok := backgroundJobUserFromCtx(ctx); ok && userId != schema.AnonymousUserId {
+			us, err := j.FindUsers(db.Opts{
+				Prefix: tab.UsersPrimary{&userId},
+				Lock:   db.LockNone,
+			})
+			if err != nil {
+				return nil, nil, status.Internal(err, "can't find users")
+			}
+			if len(us) != 1 {
+				return nil, nil, status.Unauthenticated(nil, "can't lookup user")
+			}
+			rollback = false
+			return j, us[0], nil
+		}
 		rollback = false
 		return j, nil, nil
 	}
-	u, updated, sts := validateAndUpdateUserAndToken(j, tok.UserId, tok.TokenId, db.LockNone, now)
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return nil, nil, sts
+	}
+	ttl, sts := confUserTokenTtl(conf)
+	if sts != nil {
+		return nil, nil, sts
+	}
+	u, updated, sts :=
+		validateAndUpdateUserAndToken(j, tok.UserId, tok.TokenId, db.LockNone, now, ttl)
 	if sts != nil {
 		return nil, nil, sts
 	}
 	if updated {
-		u, updated, sts = validateAndUpdateUserAndToken(j, tok.UserId, tok.TokenId, db.LockWrite, now)
+		u, updated, sts =
+			validateAndUpdateUserAndToken(j, tok.UserId, tok.TokenId, db.LockWrite, now, ttl)
 		if sts != nil {
 			return nil, nil, sts
 		}
@@ -64,7 +99,11 @@ func authedJob(ctx context.Context, beg tab.JobBeginner, now time.Time) (
 	return j, u, nil
 }
 
-func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, lk db.Lock, now time.Time) (
+// validateAndUpdateUserAndToken checks that the token of the user exists and hasn't expired.  The
+// last seen times are refreshed if they are old, in which case expired tokens of the user are
+// removed too, and true is returned.  A ttl of zero means tokens never expire.
+func validateAndUpdateUserAndToken(
+	j *tab.Job, userId, tokenId int64, lk db.Lock, now time.Time, ttl time.Duration) (
 	*schema.User, bool, status.S) {
 	us, err := j.FindUsers(db.Opts{
 		Prefix: tab.UsersPrimary{&userId},
@@ -92,6 +131,9 @@ func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, lk db.Lock
 		return nil, false, status.Internal(err, "can't get now ts")
 	}
 	ut := u.UserToken[tokenIdx]
+	if userTokenExpired(ut, ttl, now) {
+		return nil, false, status.Unauthenticated(nil, "token has expired")
+	}
 	var updated bool
 	if ut.LastSeenTs != nil {
 		utLastSeen, err := ptypes.Timestamp(ut.LastSeenTs)
@@ -114,33 +156,194 @@ func validateAndUpdateUserAndToken(j *tab.Job, userId, tokenId int64, lk db.Lock
 		}
 	}
 	if updated {
+		removeExpiredUserTokens(u, ttl, now)
 		u.ModifiedTs = nowts
 	}
 	return u, updated, nil
 }
 
+// userTokenExpired returns true if the token hasn't been seen within ttl.  A ttl of zero means
+// tokens never expire.
+func userTokenExpired(ut *schema.UserToken, ttl time.Duration, now time.Time) bool {
+	if ttl <= 0 || ut.LastSeenTs == nil {
+		return false
+	}
+	return now.Add(-ttl).After(schema.ToTime(ut.LastSeenTs))
+}
+
+// removeExpiredUserTokens removes the tokens of the user that haven't been seen within ttl.
+func removeExpiredUserTokens(u *schema.User, ttl time.Duration, now time.Time) {
+	var kept []*schema.UserToken
+	for _, ut := range u.UserToken {
+		if !userTokenExpired(ut, ttl, now) {
+			kept = append(kept, ut)
+		}
+	}
+	u.UserToken = kept
+}
+
+// validateApiKey checks that the key of the user exists, hasn't expired, and is used from an
+// allowed address.  The returned user is a copy with only the capabilities that both the key and
+// the user, including the user's roles, have.  The copy has no roles, and must not be written back.
+func validateApiKey(j *tab.Job, key *ApiKey, now time.Time) (*schema.User, status.S) {
+	us, err := j.FindUsers(db.Opts{
+		Prefix: tab.UsersPrimary{&key.UserId},
+		Lock:   db.LockNone,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find users")
+	}
+	if len(us) != 1 {
+		return nil, status.Unauthenticated(nil, "can't lookup user")
+	}
+	u := us[0]
+	var ak *schema.UserApiKey
+	for _, k := range u.ApiKey {
+		if k.KeyId == key.KeyId {
+			ak = k
+			break
+		}
+	}
+	if ak == nil {
+		return nil, status.Unauthenticated(nil, "api key has been revoked")
+	}
+	if ak.ExpireTs != nil && !now.Before(schema.ToTime(ak.ExpireTs)) {
+		return nil, status.Unauthenticated(nil, "api key has expired")
+	}
+	if !remoteAddressAllowed(ak.AllowedRemoteRange, key.RemoteAddress) {
+		return nil, status.PermissionDenied(nil, "api key can't be used from", key.RemoteAddress)
+	}
+	ru, sts := resolveUserRoles(j, u)
+	if sts != nil {
+		return nil, sts
+	}
+	both, _, _ := schema.CapIntersect(
+		schema.CapSetOf(ru.Capability...), schema.CapSetOf(ak.Capability...))
+	ku := proto.Clone(u).(*schema.User)
+	ku.Capability = both.Slice()
+	ku.RoleId = nil
+	return ku, nil
+}
+
+// checkNotApiKey fails if the request was made with an API key.  Credentials can't be managed with
+// a key, so that revoking a leaked key is enough to lock it out.
+func checkNotApiKey(ctx context.Context, action string) status.S {
+	if _, ok := ApiKeyFromCtx(ctx); ok {
+		return status.PermissionDenied(nil, "can't "+action+" with an api key")
+	}
+	return nil
+}
+
+// remoteAddressAllowed returns true if the address, which may include a port, is in one of the
+// CIDR ranges.  No ranges means every address is allowed.
+func remoteAddressAllowed(ranges []string, address string) bool {
+	if len(ranges) == 0 {
+		return true
+	}
+	host := address
+	if h, _, err := net.SplitHostPort(address); err == nil {
+		host = h
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, r := range ranges {
+		if _, ipnet, err := net.ParseCIDR(r); err == nil && ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func confUserTokenTtl(conf *schema.Configuration) (time.Duration, status.S) {
+	if conf.UserTokenTtl == nil {
+		return 0, nil
+	}
+	ttl, err := ptypes.Duration(conf.UserTokenTtl)
+	if err != nil {
+		return 0, status.Internal(err, "can't parse user token ttl")
+	}
+	return ttl, nil
+}
+
 // validateCapability ensures the given user has the requested permissions.  If the user is nil,
 // the anonymous user is used from the given configuration.  At least one of `u` or `conf` must
-// not be nil.
-func validateCapability(
+// not be nil.  The capabilities of the user's roles are looked up in j.
+func validateCapability(j *tab.Job,
 	u *schema.User, conf *schema.Configuration, caps ...schema.User_Capability) status.S {
-	return validateCapSet(u, conf, schema.CapSetOf(caps...))
+	return validateCapSet(j, u, conf, schema.CapSetOf(caps...))
 }
 
 // validateCapSet ensures the given user has the requested permissions.  If the user is nil,
 // the anonymous user is used from the given configuration.  At least one of `u` or `conf` must
-// not be nil.
-func validateCapSet(
+// not be nil.  The capabilities of the user's roles are looked up in j.
+func validateCapSet(j *tab.Job,
 	u *schema.User, conf *schema.Configuration, want *schema.CapSet) status.S {
+	ru, sts := resolveUserRoles(j, u)
+	if sts != nil {
+		return sts
+	}
+	return hasCapSet(ru, conf, want)
+}
+
+// resolveCapability is like validateCapability, but also returns the user with the capabilities
+// of its roles, for the checks and filters that follow.  The roles are only looked up once.
+func resolveCapability(j *tab.Job, u *schema.User, conf *schema.Configuration,
+	caps ...schema.User_Capability) (*schema.User, status.S) {
+	ru, sts := resolveUserRoles(j, u)
+	if sts != nil {
+		return nil, sts
+	}
+	if sts := hasCapSet(ru, conf, schema.CapSetOf(caps...)); sts != nil {
+		return nil, sts
+	}
+	return ru, nil
+}
+
+// hasCapability is like validateCapability, for a user returned by resolveUserRoles.
+func hasCapability(
+	ru *schema.User, conf *schema.Configuration, caps ...schema.User_Capability) status.S {
+	return hasCapSet(ru, conf, schema.CapSetOf(caps...))
+}
+
+func hasCapSet(ru *schema.User, conf *schema.Configuration, want *schema.CapSet) status.S {
 	var have *schema.CapSet
-	if u != nil {
-		have = schema.CapSetOf(u.Capability...)
+	if ru != nil {
+		have = schema.CapSetOf(ru.Capability...)
 	} else {
 		have = schema.CapSetOf(conf.AnonymousCapability.Capability...)
 	}
 	return schema.VerifyCapSubset(have, want)
 }
 
+// resolveUserRoles returns the user with the capabilities of its roles added.  If the user has
+// no roles, it is returned unchanged.  Otherwise, a copy is returned, which must not be written
+// back.  Roles that no longer exist are ignored.
+func resolveUserRoles(j *tab.Job, u *schema.User) (*schema.User, status.S) {
+	if u == nil || len(u.RoleId) == 0 {
+		return u, nil
+	}
+	cs := schema.CapSetOf(u.Capability...)
+	for _, roleId := range u.RoleId {
+		rs, err := j.FindRoles(db.Opts{
+			Prefix: tab.RolesPrimary{&roleId},
+			Lock:   db.LockNone,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find roles")
+		}
+		for _, r := range rs {
+			for _, c := range r.Capability {
+				cs.Add(c)
+			}
+		}
+	}
+	ru := proto.Clone(u).(*schema.User)
+	ru.Capability = cs.Slice()
+	return ru, nil
+}
+
 // deriveObjectUserId combines a requested object user id and a subject user.
 //  objectUserId +  subjectUser => objectUserId
 //  objectUserId + !subjectUser => objectUserId
@@ -158,7 +361,9 @@ func deriveObjectUserId(objectUserId int64, subjectUser *schema.User) (int64, st
 
 // lookupObjectUser returns the user for objectUserId, or subjectUser if it has the same UserId.
 // The subject user may be nil, but the returned object user will not be nil.  If the subject user
-// has the same user id as the object user, the returned user will be identical to subjectUser.
+// has the same user id as the object user and lk is LockNone, the returned user will be identical
+// to subjectUser.  Otherwise the user is read again, since the subject user may be a limited copy
+// that must not be written back.
 func lookupObjectUser(
 	ctx context.Context, j *tab.Job, lk db.Lock, objectUserId int64, subjectUser *schema.User) (
 	*schema.User, status.S) {
@@ -166,7 +371,7 @@ func lookupObjectUser(
 	if sts != nil {
 		return nil, sts
 	}
-	if subjectUser != nil && subjectUser.UserId == objectUserId {
+	if subjectUser != nil && subjectUser.UserId == objectUserId && lk == db.LockNone {
 		return subjectUser, nil
 	}
 	us, err := j.FindUsers(db.Opts{
@@ -0,0 +1,195 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+// AuthExternalUserTask logs in the user linked to an external identity, such as an OpenID Connect
+// account.  If no user is linked yet, one is created with the new user capabilities.  The caller
+// is responsible for verifying the identity.
+type AuthExternalUserTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Issuer  string
+	Subject string
+	// Ident is used for the user if one has to be created, usually the email address of the
+	// external account.
+	Ident string
+	// The client being authenticated.  These are saved with the token.
+	UserAgent     string
+	RemoteAddress string
+
+	// Results
+	User *schema.User
+	// Created is true if User was created for this identity.
+	Created    bool
+	NewTokenId int64
+	// ResolvedCapability is the capabilities of User, including those of its roles.
+	ResolvedCapability []schema.User_Capability
+}
+
+func (t *AuthExternalUserTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, _, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ttl, sts := confUserTokenTtl(conf)
+	if sts != nil {
+		return sts
+	}
+
+	if t.Issuer == "" {
+		return status.InvalidArgument(nil, "missing issuer")
+	}
+	if t.Subject == "" {
+		return status.InvalidArgument(nil, "missing subject")
+	}
+
+	nowts, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return status.Internal(err, "can't create timestamp")
+	}
+
+	identities, err := j.FindUserIdentities(db.Opts{
+		Prefix: tab.UserIdentitiesPrimary{Issuer: &t.Issuer, Subject: &t.Subject},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find user identities")
+	}
+
+	var user *schema.User
+	var created bool
+	if len(identities) == 1 {
+		users, err := j.FindUsers(db.Opts{
+			Prefix: tab.UsersPrimary{&identities[0].UserId},
+			Lock:   db.LockWrite,
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find users")
+		}
+		if len(users) != 1 {
+			return status.Unauthenticated(nil, "can't lookup user")
+		}
+		user = users[0]
+	} else {
+		var minIdentLen, maxIdentLen int64
+		if conf.MinIdentLength != nil {
+			minIdentLen = conf.MinIdentLength.Value
+		} else {
+			minIdentLen = math.MinInt64
+		}
+		if conf.MaxIdentLength != nil {
+			maxIdentLen = conf.MaxIdentLength.Value
+		} else {
+			maxIdentLen = math.MaxInt64
+		}
+		ident, err :=
+			text.DefaultValidateNoNewlineAndNormalize(t.Ident, "ident", minIdentLen, maxIdentLen)
+		if err != nil {
+			return status.From(err)
+		}
+		// Existing users are never linked automatically, since anyone could claim their ident at
+		// the provider.
+		keyident := schema.UserUniqueIdent(ident)
+		users, err := j.FindUsers(db.Opts{
+			Prefix: tab.UsersIdent{&keyident},
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't scan users")
+		}
+		if len(users) != 0 {
+			return status.AlreadyExists(nil, "ident already used")
+		}
+
+		userId, err := j.AllocId()
+		if err != nil {
+			return status.Internal(err, "can't allocate id")
+		}
+		// No secret is set, so the user can only log in through the provider.
+		user = &schema.User{
+			UserId:     userId,
+			Ident:      ident,
+			Capability: conf.NewUserCapability.Capability,
+		}
+		user.SetCreatedTime(now)
+		user.SetModifiedTime(now)
+		if err := j.InsertUser(user); err != nil {
+			return status.Internal(err, "can't create user")
+		}
+
+		identity := &schema.UserIdentity{
+			Issuer:  t.Issuer,
+			Subject: t.Subject,
+			UserId:  userId,
+		}
+		identity.SetCreatedTime(now)
+		if err := j.InsertUserIdentity(identity); err != nil {
+			return status.Internal(err, "can't create user identity")
+		}
+		created = true
+	}
+
+	// The provider's login can't be followed by a two-factor code, so users who need one have to
+	// log in with their secret and code instead.
+	ru, sts := resolveUserRoles(j, user)
+	if sts != nil {
+		return sts
+	}
+	if totpEnabled(user) || totpRequired(ru, conf) {
+		return status.FailedPrecondition(nil, "two-factor authentication required")
+	}
+
+	removeExpiredUserTokens(user, ttl, now)
+	user.NextTokenId++
+	newTokenId := user.NextTokenId
+	user.UserToken = append(user.UserToken, &schema.UserToken{
+		TokenId:       newTokenId,
+		CreatedTs:     nowts,
+		LastSeenTs:    nowts,
+		UserAgent:     t.UserAgent,
+		RemoteAddress: t.RemoteAddress,
+	})
+	if sts := trimUserTokens(user, newTokenId); sts != nil {
+		return sts
+	}
+
+	user.LastSeenTs = nowts
+	user.ModifiedTs = nowts
+
+	if err := j.UpdateUser(user); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = user
+	t.Created = created
+	t.NewTokenId = newTokenId
+	t.ResolvedCapability = ru.Capability
+	return nil
+}
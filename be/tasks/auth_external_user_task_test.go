@@ -0,0 +1,232 @@
+package tasks
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func TestAuthExternalUserTaskCreatesUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &AuthExternalUserTask{
+		Beg:           c.DB(),
+		Now:           time.Now,
+		Issuer:        "https://issuer.example.com",
+		Subject:       "1234",
+		Ident:         "Bob@example.com",
+		UserAgent:     "agent",
+		RemoteAddress: "1.2.3.4",
+	}
+
+	ctx := c.Ctx
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !task.Created {
+		t.Error("expected user to be created")
+	}
+	if have, want := task.User.Ident, "Bob@example.com"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(task.User.Secret) != 0 {
+		t.Error("expected no secret", task.User.Secret)
+	}
+	if !capsEqual(task.User.Capability, conf.NewUserCapability.Capability) {
+		t.Error("have", task.User.Capability, "want", conf.NewUserCapability.Capability)
+	}
+	if have, want := task.NewTokenId, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(task.User.UserToken) != 1 || task.User.UserToken[0].UserAgent != "agent" ||
+		task.User.UserToken[0].RemoteAddress != "1.2.3.4" {
+		t.Error("bad tokens", task.User.UserToken)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	identities, err := j.FindUserIdentities(db.Opts{
+		Prefix: tab.UserIdentitiesUserId{&task.User.UserId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(identities) != 1 {
+		t.Fatal("expected one identity", identities)
+	}
+	if identities[0].Issuer != task.Issuer || identities[0].Subject != task.Subject {
+		t.Error("wrong identity", identities[0])
+	}
+}
+
+func TestAuthExternalUserTaskFindsLinkedUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	c.AutoJob(func(j *tab.Job) error {
+		identity := &schema.UserIdentity{
+			Issuer:  "https://issuer.example.com",
+			Subject: "1234",
+			UserId:  u.User.UserId,
+		}
+		identity.SetCreatedTime(time.Now())
+		return j.InsertUserIdentity(identity)
+	})
+
+	task := &AuthExternalUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Issuer:  "https://issuer.example.com",
+		Subject: "1234",
+		// Ignored for existing users.
+		Ident: "other",
+	}
+
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.Created {
+		t.Error("expected existing user")
+	}
+	if have, want := task.User.UserId, u.User.UserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	u.Refresh()
+	if have, want := u.User.Ident, task.User.Ident; have != want {
+		t.Error("have", have, "want", want)
+	}
+	var found bool
+	for _, ut := range u.User.UserToken {
+		if ut.TokenId == task.NewTokenId {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("missing new token", task.NewTokenId, u.User.UserToken)
+	}
+}
+
+func TestAuthExternalUserTaskFailsOnTotp(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	enabled := c.CreateUser()
+	enabled.User.Totp = &schema.UserTotp{
+		Secret:    []byte("12345678901234567890"),
+		EnabledTs: schema.ToTspb(time.Now()),
+	}
+	enabled.Update()
+	required := c.CreateUser()
+	required.User.Capability = append(required.User.Capability, schema.User_PIC_PURGE)
+	required.Update()
+
+	conf := schema.GetDefaultConfiguration()
+	conf.TotpRequiredCapability.Capability = []schema.User_Capability{schema.User_PIC_PURGE}
+	ctx := CtxFromTestConfig(c.Ctx, conf)
+
+	for i, u := range []*TestUser{enabled, required} {
+		subject := fmt.Sprint("subject", i)
+		c.AutoJob(func(j *tab.Job) error {
+			identity := &schema.UserIdentity{
+				Issuer:  "https://issuer.example.com",
+				Subject: subject,
+				UserId:  u.User.UserId,
+			}
+			identity.SetCreatedTime(time.Now())
+			return j.InsertUserIdentity(identity)
+		})
+		tokens := len(u.User.UserToken)
+
+		task := &AuthExternalUserTask{
+			Beg:     c.DB(),
+			Now:     time.Now,
+			Issuer:  "https://issuer.example.com",
+			Subject: subject,
+		}
+		sts := new(TaskRunner).Run(ctx, task)
+		if sts == nil {
+			t.Fatal("expected error")
+		}
+		if have, want := sts.Code(), codes.FailedPrecondition; have != want {
+			t.Error("have", have, "want", want)
+		}
+		u.Refresh()
+		if have, want := len(u.User.UserToken), tokens; have != want {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
+
+func TestAuthExternalUserTaskFailsOnUsedIdent(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &AuthExternalUserTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Issuer:  "https://issuer.example.com",
+		Subject: "1234",
+		Ident:   u.User.Ident,
+	}
+
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.AlreadyExists; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "ident already used"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	identities, err := j.FindUserIdentities(db.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(identities) != 0 {
+		t.Error("expected no identities", identities)
+	}
+}
+
+func TestAuthExternalUserTaskFailsOnMissingSubject(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	task := &AuthExternalUserTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Issuer: "https://issuer.example.com",
+		Ident:  "bob",
+	}
+
+	sts := new(TaskRunner).Run(c.Ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing subject"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"context"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// AuthReadPicTask checks if a user can read the files of a pic.  Pic files are read using a pix
+// token rather than an auth token, so the user is passed in rather than taken from the context.
+type AuthReadPicTask struct {
+	// Deps
+	Beg tab.JobBeginner
+
+	// Inputs
+	PicId int64
+	// UserId is the subject of the pix token, or AnonymousUserId if there is none.
+	UserId int64
+
+	// Results
+	// Private is true if the pic can't be seen by everyone, and so shouldn't be publicly cached.
+	Private bool
+}
+
+func (t *AuthReadPicTask) Run(ctx context.Context) (stscap status.S) {
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&t.PicId},
+		Limit:  1,
+		Lock:   db.LockNone,
+	})
+	if err != nil {
+		return status.Internal(err, "can't lookup pic")
+	}
+	if len(pics) != 1 {
+		return status.NotFound(nil, "can't find pic")
+	}
+	p := pics[0]
+
+	if p.Private() {
+		var su *schema.User
+		if t.UserId != schema.AnonymousUserId {
+			us, err := j.FindUsers(db.Opts{
+				Prefix: tab.UsersPrimary{&t.UserId},
+				Limit:  1,
+				Lock:   db.LockNone,
+			})
+			if err != nil {
+				return status.Internal(err, "can't find users")
+			}
+			if len(us) != 1 {
+				return status.Unauthenticated(nil, "can't lookup user")
+			}
+			if su, sts = resolveUserRoles(j, us[0]); sts != nil {
+				return sts
+			}
+		}
+		// Private pics look the same as missing ones to users who can't see them.
+		if !picVisible(p, userCredOf(su, conf)) {
+			return status.NotFound(nil, "can't find pic")
+		}
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.Private = p.Private()
+	return nil
+}
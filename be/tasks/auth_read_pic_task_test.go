@@ -0,0 +1,78 @@
+package tasks
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestAuthReadPicTask_public(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+
+	task := &AuthReadPicTask{
+		Beg:    c.DB(),
+		PicId:  p.Pic.PicId,
+		UserId: schema.AnonymousUserId,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.Private {
+		t.Error("pic is private")
+	}
+}
+
+func TestAuthReadPicTask_privateUploader(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+
+	task := &AuthReadPicTask{
+		Beg:    c.DB(),
+		PicId:  p.Pic.PicId,
+		UserId: p.Pic.UploaderUserId(),
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if !task.Private {
+		t.Error("pic is public")
+	}
+}
+
+func TestAuthReadPicTask_failsOnPrivate(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+	u := c.CreateUser()
+
+	for _, userId := range []int64{u.User.UserId, schema.AnonymousUserId} {
+		task := &AuthReadPicTask{
+			Beg:    c.DB(),
+			PicId:  p.Pic.PicId,
+			UserId: userId,
+		}
+		sts := new(TaskRunner).Run(c.Ctx, task)
+		if sts == nil {
+			t.Fatal("expected error")
+		}
+		if have, want := sts.Code(), codes.NotFound; have != want {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
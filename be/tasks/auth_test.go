@@ -119,6 +119,90 @@ func TestAuthedJob_userUpdate(t *testing.T) {
 	}
 }
 
+func TestAuthedJob_apiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	beg := c.DB()
+	r := c.CreateRole("readers", schema.User_PIC_INDEX)
+	u := c.CreateUser()
+	u.User.Capability = []schema.User_Capability{schema.User_PIC_READ, schema.User_PIC_CREATE}
+	u.User.RoleId = []int64{r.RoleId}
+	u.User.NextApiKeyId = 1
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{
+		KeyId:              1,
+		CreatedTs:          schema.ToTspb(now),
+		Capability:         []schema.User_Capability{schema.User_PIC_READ, schema.User_PIC_INDEX},
+		AllowedRemoteRange: []string{"10.0.0.0/8"},
+	})
+	u.Update()
+
+	ctx := CtxFromApiKey(c.Ctx, u.User.UserId, 1, "10.1.2.3:4567")
+
+	j, u2, sts := authedJob(ctx, beg, now)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	defer j.Rollback()
+
+	// The key limits the user's capabilities, including those of the user's roles.
+	want := []schema.User_Capability{schema.User_PIC_READ, schema.User_PIC_INDEX}
+	if !capsEqual(u2.Capability, want) {
+		t.Error("wrong caps", u2.Capability)
+	}
+	if len(u2.RoleId) != 0 {
+		t.Error("expected no roles", u2.RoleId)
+	}
+}
+
+func TestAuthedJob_apiKeyFailures(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	beg := c.DB()
+	u := c.CreateUser()
+	u.User.NextApiKeyId = 2
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{
+		KeyId:     1,
+		CreatedTs: schema.ToTspb(now.Add(-time.Hour)),
+		ExpireTs:  schema.ToTspb(now.Add(-time.Minute)),
+	}, &schema.UserApiKey{
+		KeyId:              2,
+		CreatedTs:          schema.ToTspb(now),
+		AllowedRemoteRange: []string{"10.0.0.0/8"},
+	})
+	u.Update()
+
+	cases := []struct {
+		name    string
+		keyId   int64
+		address string
+		code    codes.Code
+		msg     string
+	}{
+		{"expired", 1, "10.0.0.1:1", codes.Unauthenticated, "api key has expired"},
+		{"revoked", 3, "10.0.0.1:1", codes.Unauthenticated, "api key has been revoked"},
+		{"out of range", 2, "192.168.0.1:1", codes.PermissionDenied, "can't be used from"},
+		{"no address", 2, "", codes.PermissionDenied, "can't be used from"},
+	}
+	for _, tc := range cases {
+		ctx := CtxFromApiKey(c.Ctx, u.User.UserId, tc.keyId, tc.address)
+		_, _, sts := authedJob(ctx, beg, now)
+		if sts == nil {
+			t.Error(tc.name, "expected error")
+			continue
+		}
+		if have, want := sts.Code(), tc.code; have != want {
+			t.Error(tc.name, "have", have, "want", want)
+		}
+		if have, want := sts.Message(), tc.msg; !strings.Contains(have, want) {
+			t.Error(tc.name, "have", have, "want", want)
+		}
+	}
+}
+
 func TestValidateAndUpdateUserAndToken_badJob(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -127,7 +211,7 @@ func TestValidateAndUpdateUserAndToken_badJob(t *testing.T) {
 	if err := j.Rollback(); err != nil {
 		t.Fatal(err)
 	}
-	_, _, sts := validateAndUpdateUserAndToken(j, 1, 2, db.LockNone, time.Now())
+	_, _, sts := validateAndUpdateUserAndToken(j, 1, 2, db.LockNone, time.Now(), 0)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -147,7 +231,7 @@ func TestValidateAndUpdateUserAndToken_noUser(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, 1, 2, db.LockNone, time.Now())
+	_, _, sts := validateAndUpdateUserAndToken(j, 1, 2, db.LockNone, time.Now(), 0)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -169,7 +253,7 @@ func TestValidateAndUpdateUserAndToken_noToken(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, -1, db.LockNone, time.Now())
+	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, -1, db.LockNone, time.Now(), 0)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -200,7 +284,8 @@ func TestValidateAndUpdateUserAndToken_badNow(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, time.Unix(1<<62, 0))
+	_, _, sts :=
+		validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, time.Unix(1<<62, 0), 0)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -231,7 +316,7 @@ func TestValidateAndUpdateUserAndToken_badUtLastSeen(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now, 0)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -262,7 +347,7 @@ func TestValidateAndUpdateUserAndToken_badULastSeen(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now, 0)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -293,7 +378,7 @@ func TestValidateAndUpdateUserAndToken_utNotUpdated(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now, 0)
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -324,7 +409,7 @@ func TestValidateAndUpdateUserAndToken_updated(t *testing.T) {
 	j := c.Job()
 	defer j.Rollback()
 
-	u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now)
+	u2, updated, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now, 0)
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -349,6 +434,69 @@ func TestValidateAndUpdateUserAndToken_updated(t *testing.T) {
 	t.Error("can't find user token")
 }
 
+func TestValidateAndUpdateUserAndToken_expired(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+
+	u := c.CreateUser()
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		CreatedTs:  schema.ToTspb(now.Add(-2 * time.Hour)),
+		LastSeenTs: schema.ToTspb(now.Add(-2 * time.Hour)),
+	})
+	u.Update()
+
+	j := c.Job()
+	defer j.Rollback()
+
+	_, _, sts := validateAndUpdateUserAndToken(j, u.User.UserId, 2, db.LockNone, now, time.Hour)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "token has expired"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestValidateAndUpdateUserAndToken_removesExpired(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	ttl := 2 * lastSeenUpdateThreshold
+
+	u := c.CreateUser()
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		CreatedTs:  schema.ToTspb(now.Add(-2 * ttl)),
+		LastSeenTs: schema.ToTspb(now.Add(-2 * ttl)),
+	})
+	u.User.UserToken[0].LastSeenTs = schema.ToTspb(now.Add(-lastSeenUpdateThreshold - 1))
+	u.User.LastSeenTs = schema.ToTspb(now.Add(-lastSeenUpdateThreshold - 1))
+	u.Update()
+
+	j := c.Job()
+	defer j.Rollback()
+
+	u2, updated, sts := validateAndUpdateUserAndToken(
+		j, u.User.UserId, u.User.UserToken[0].TokenId, db.LockNone, now, ttl)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if !updated {
+		t.Error("should have updated")
+	}
+	if len(u2.UserToken) != 1 || u2.UserToken[0].TokenId != u.User.UserToken[0].TokenId {
+		t.Error("expected expired token to be removed", u2.UserToken)
+	}
+}
+
 func TestValidateCapability_worksOnNilUser(t *testing.T) {
 	conf := &schema.Configuration{
 		AnonymousCapability: &schema.Configuration_CapabilitySet{
@@ -357,7 +505,7 @@ func TestValidateCapability_worksOnNilUser(t *testing.T) {
 			},
 		},
 	}
-	sts := validateCapability(nil, conf, schema.User_PIC_READ)
+	sts := validateCapability(nil, nil, conf, schema.User_PIC_READ)
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -371,7 +519,7 @@ func TestValidateCapability_failOnNilUserWithoutCap(t *testing.T) {
 			},
 		},
 	}
-	sts := validateCapability(nil, conf, schema.User_PIC_READ)
+	sts := validateCapability(nil, nil, conf, schema.User_PIC_READ)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -390,7 +538,7 @@ func TestValidateCapability_worksOnPresentUser(t *testing.T) {
 			schema.User_PIC_CREATE,
 		},
 	}
-	sts := validateCapability(u, nil, schema.User_PIC_CREATE)
+	sts := validateCapability(nil, u, nil, schema.User_PIC_CREATE)
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -402,7 +550,7 @@ func TestValidateCapability_failsOnPresentUserWithoutCap(t *testing.T) {
 			schema.User_PIC_READ,
 		},
 	}
-	sts := validateCapability(u, nil, schema.User_PIC_CREATE)
+	sts := validateCapability(nil, u, nil, schema.User_PIC_CREATE)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -430,7 +578,7 @@ func TestValidateCapability_capabilitiesNotMerged(t *testing.T) {
 	}
 	// Even though the anonymous user has the cap, don't allow it.  This prevents
 	// accidental privelege access for limited users.
-	sts := validateCapability(u, conf, schema.User_PIC_CREATE)
+	sts := validateCapability(nil, u, conf, schema.User_PIC_CREATE)
 	if sts == nil {
 		t.Fatal("expected error")
 	}
@@ -442,3 +590,47 @@ func TestValidateCapability_capabilitiesNotMerged(t *testing.T) {
 		t.Error("have", have, "want", want)
 	}
 }
+
+func TestValidateCapability_usesRoles(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	r := c.CreateRole("Moderators", schema.User_PIC_SOFT_DELETE)
+	u := &schema.User{
+		Capability: []schema.User_Capability{schema.User_PIC_READ},
+		RoleId:     []int64{r.RoleId},
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	if sts := validateCapability(j, u, nil, schema.User_PIC_SOFT_DELETE, schema.User_PIC_READ); sts != nil {
+		t.Fatal(sts)
+	}
+	sts := validateCapability(j, u, nil, schema.User_PIC_HARD_DELETE)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	// Role capabilities are not merged into the user itself.
+	if have, want := len(u.Capability), 1; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestValidateCapability_ignoresMissingRoles(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := &schema.User{
+		Capability: []schema.User_Capability{schema.User_PIC_READ},
+		RoleId:     []int64{c.Id()},
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+	if sts := validateCapability(j, u, nil, schema.User_PIC_READ); sts != nil {
+		t.Fatal(sts)
+	}
+}
@@ -21,11 +21,16 @@ type AuthUserTask struct {
 	Beg                    tab.JobBeginner
 	Now                    func() time.Time
 	CompareHashAndPassword func(hashed, password []byte) error
-	// TODO: GC tokens after a handler provided timeout
 
 	// Inputs
 	Ident  string
 	Secret string
+	// A code from the user's authenticator app, or a recovery code.  Only needed with Ident, if the
+	// user has two-factor authentication enabled.
+	TotpCode string
+	// The client being authenticated.  These are saved with the token.
+	UserAgent     string
+	RemoteAddress string
 
 	// Alt inputs
 	UserId  int64
@@ -34,6 +39,8 @@ type AuthUserTask struct {
 	// Results
 	User       *schema.User
 	NewTokenId int64
+	// ResolvedCapability is the capabilities of User, including those of its roles.
+	ResolvedCapability []schema.User_Capability
 }
 
 const (
@@ -48,6 +55,15 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 	}
 	defer revert(j, &stscap)
 
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ttl, sts := confUserTokenTtl(conf)
+	if sts != nil {
+		return sts
+	}
+
 	var user *schema.User
 	nowts, err := ptypes.TimestampProto(now)
 	if err != nil {
@@ -55,10 +71,6 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 	}
 	var newTokenId int64
 	if t.Ident != "" {
-		conf, sts := GetConfiguration(ctx)
-		if sts != nil {
-			return sts
-		}
 		var minIdentLen, maxIdentLen int64
 		if conf.MinIdentLength != nil {
 			minIdentLen = conf.MinIdentLength.Value
@@ -99,12 +111,47 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 		if err := t.compareHashAndPassword(user.Secret, []byte(t.Secret)); err != nil {
 			return status.Unauthenticated(err, "can't lookup user")
 		}
+		if totpEnabled(user) {
+			if t.TotpCode == "" {
+				return status.Unauthenticated(nil, "missing totp code")
+			}
+			if totpLocked(user.Totp, now) {
+				return status.ResourceExhausted(nil, "too many bad totp codes, try again later")
+			}
+			if !useTotpCode(user.Totp, t.TotpCode, now) {
+				// The failure is committed even though logging in fails, so guesses are limited.
+				user.Totp.FailedAttempts++
+				user.Totp.LastFailedTs = nowts
+				user.ModifiedTs = nowts
+				if err := j.UpdateUser(user); err != nil {
+					return status.Internal(err, "can't update user")
+				}
+				if err := j.Commit(); err != nil {
+					return status.Internal(err, "can't commit job")
+				}
+				return status.Unauthenticated(nil, "bad totp code")
+			}
+			user.Totp.FailedAttempts = 0
+			user.Totp.LastFailedTs = nil
+		} else {
+			ru, sts := resolveUserRoles(j, user)
+			if sts != nil {
+				return sts
+			}
+			// Existing sessions can still be refreshed, so users can enroll after this is turned on.
+			if totpRequired(ru, conf) {
+				return status.FailedPrecondition(nil, "two-factor authentication required")
+			}
+		}
+		removeExpiredUserTokens(user, ttl, now)
 		user.NextTokenId++
 		newTokenId = user.NextTokenId
 		user.UserToken = append(user.UserToken, &schema.UserToken{
-			TokenId:    user.NextTokenId,
-			CreatedTs:  nowts,
-			LastSeenTs: nowts,
+			TokenId:       user.NextTokenId,
+			CreatedTs:     nowts,
+			LastSeenTs:    nowts,
+			UserAgent:     t.UserAgent,
+			RemoteAddress: t.RemoteAddress,
 		})
 
 	} else if t.UserId != 0 {
@@ -120,9 +167,14 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 			return status.Unauthenticated(nil, "can't lookup user")
 		}
 		user = users[0]
+		// Expired tokens can't be refreshed.
+		removeExpiredUserTokens(user, ttl, now)
 		for _, ut := range user.UserToken {
 			if ut.TokenId == t.TokenId {
 				ut.LastSeenTs = nowts
+				if t.UserAgent != "" || t.RemoteAddress != "" {
+					ut.UserAgent, ut.RemoteAddress = t.UserAgent, t.RemoteAddress
+				}
 				newTokenId = t.TokenId
 				break
 			}
@@ -135,17 +187,8 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 		return status.InvalidArgument(nil, "no user identifier provided")
 	}
 
-	sort.Sort(sort.Reverse(userTokens(user.UserToken)))
-	if len(user.UserToken) > maxUserTokens {
-		for i := maxUserTokens; i < len(user.UserToken); i++ {
-			// TODO: log deleted tokens?
-			if user.UserToken[i].TokenId == newTokenId {
-				// this could theoretically happen if all the tokens are newer than the one we just
-				// created, perhaps due to clock skew between servers.
-				return status.Internal(nil, "new token no longer valid")
-			}
-		}
-		user.UserToken = user.UserToken[:maxUserTokens]
+	if sts := trimUserTokens(user, newTokenId); sts != nil {
+		return sts
 	}
 
 	user.LastSeenTs = nowts
@@ -154,12 +197,17 @@ func (t *AuthUserTask) Run(ctx context.Context) (stscap status.S) {
 	if err := j.UpdateUser(user); err != nil {
 		return status.Internal(err, "can't update user")
 	}
+	ru, sts := resolveUserRoles(j, user)
+	if sts != nil {
+		return sts
+	}
 
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "can' commit job")
 	}
 	t.User = user
 	t.NewTokenId = newTokenId
+	t.ResolvedCapability = ru.Capability
 	return nil
 }
 
@@ -171,6 +219,23 @@ func (t *AuthUserTask) compareHashAndPassword(hashed, password []byte) error {
 	}
 }
 
+// trimUserTokens drops the least recently seen tokens of user, keeping at most maxUserTokens.
+func trimUserTokens(user *schema.User, newTokenId int64) status.S {
+	sort.Sort(sort.Reverse(userTokens(user.UserToken)))
+	if len(user.UserToken) > maxUserTokens {
+		for i := maxUserTokens; i < len(user.UserToken); i++ {
+			// TODO: log deleted tokens?
+			if user.UserToken[i].TokenId == newTokenId {
+				// this could theoretically happen if all the tokens are newer than the one we just
+				// created, perhaps due to clock skew between servers.
+				return status.Internal(nil, "new token no longer valid")
+			}
+		}
+		user.UserToken = user.UserToken[:maxUserTokens]
+	}
+	return nil
+}
+
 type userTokens []*schema.UserToken
 
 func (uts userTokens) Len() int {
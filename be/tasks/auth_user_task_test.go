@@ -212,13 +212,13 @@ func TestAuthUserTaskCreatesNewToken(t *testing.T) {
 	u := c.CreateUser()
 	u.User.UserToken = nil
 
+	// Tokens have to be recent, or they would be removed for being expired instead.
+	now := time.Now()
 	for i := 0; i < maxUserTokens; i++ {
 		u.User.NextTokenId++
 		u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
-			TokenId: u.User.NextTokenId,
-			LastSeenTs: &tspb.Timestamp{
-				Seconds: int64(i),
-			},
+			TokenId:    u.User.NextTokenId,
+			LastSeenTs: schema.ToTspb(now.Add(time.Duration(i-maxUserTokens) * time.Minute)),
 		})
 	}
 
@@ -261,6 +261,222 @@ func TestAuthUserTaskCreatesNewToken(t *testing.T) {
 	}
 }
 
+func TestAuthUserTaskRemovesExpiredTokens(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.NextTokenId = 2
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		LastSeenTs: schema.ToTspb(time.Now().AddDate(-1, 0, 0)),
+	})
+	u.Update()
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+		UserAgent:              "Mozilla/5.0",
+		RemoteAddress:          "127.0.0.1:1234",
+	}
+
+	ctx := c.Ctx
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if len(u.User.UserToken) != 2 {
+		t.Fatal("expected expired token to be removed", u.User.UserToken)
+	}
+	for _, ut := range u.User.UserToken {
+		if ut.TokenId == 2 {
+			t.Error("expected expired token to be removed", ut)
+		}
+	}
+	token := u.User.UserToken[0]
+	if token.TokenId != task.NewTokenId {
+		t.Fatal("expected new token first", token)
+	}
+	if token.UserAgent != "Mozilla/5.0" || token.RemoteAddress != "127.0.0.1:1234" {
+		t.Error("expected client info to be saved", token)
+	}
+}
+
+func TestAuthUserTaskFailsOnExpiredToken(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.UserToken[0].LastSeenTs = schema.ToTspb(time.Now().AddDate(-1, 0, 0))
+	u.Update()
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		UserId:                 u.User.UserId,
+		TokenId:                u.User.UserToken[0].TokenId,
+	}
+
+	ctx := c.Ctx
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected non-nil status")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "can't find token"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthUserTaskTotp(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Totp = &schema.UserTotp{
+		Secret:    []byte("12345678901234567890"),
+		EnabledTs: schema.ToTspb(now),
+	}
+	u.Update()
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    func() time.Time { return now },
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+	}
+
+	ctx := c.Ctx
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.Unauthenticated; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "missing totp code"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+
+	task.TotpCode = totpCode(u.User.Totp.Secret, totpStepOf(now))
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if have, want := u.User.Totp.LastUsedStep, totpStepOf(now); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthUserTaskTotpLockout(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Totp = &schema.UserTotp{
+		Secret:    []byte("12345678901234567890"),
+		EnabledTs: schema.ToTspb(now),
+	}
+	u.Update()
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    func() time.Time { return now },
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+		TotpCode:               "bad",
+	}
+
+	ctx := c.Ctx
+	for i := 0; i < maxTotpFailedAttempts; i++ {
+		sts := new(TaskRunner).Run(ctx, task)
+		if sts == nil {
+			t.Fatal("expected error")
+		}
+		if have, want := sts.Code(), codes.Unauthenticated; have != want {
+			t.Error("have", have, "want", want)
+		}
+	}
+	u.Refresh()
+	if have, want := u.User.Totp.FailedAttempts, int64(maxTotpFailedAttempts); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// Even a good code is refused while locked.
+	task.TotpCode = totpCode(u.User.Totp.Secret, totpStepOf(now))
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.ResourceExhausted; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	later := now.Add(totpLockout + time.Second)
+	task.Now = func() time.Time { return later }
+	task.TotpCode = totpCode(u.User.Totp.Secret, totpStepOf(later))
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	u.Refresh()
+	if have, want := u.User.Totp.FailedAttempts, int64(0); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestAuthUserTaskTotpRequired(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_PURGE)
+	u.Update()
+
+	conf := schema.GetDefaultConfiguration()
+	conf.TotpRequiredCapability.Capability = []schema.User_Capability{schema.User_PIC_PURGE}
+	ctx := CtxFromTestConfig(c.Ctx, conf)
+
+	task := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		Ident:                  u.User.Ident,
+		Secret:                 "secret",
+	}
+
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.FailedPrecondition; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// Existing sessions still work, so the user can enroll.
+	refresh := &AuthUserTask{
+		Beg:                    c.DB(),
+		Now:                    time.Now,
+		CompareHashAndPassword: bcrypt.CompareHashAndPassword,
+		UserId:                 u.User.UserId,
+		TokenId:                u.User.UserToken[0].TokenId,
+	}
+	if sts := new(TaskRunner).Run(ctx, refresh); sts != nil {
+		t.Fatal(sts)
+	}
+}
+
 func TestAuthUserTask_PreferIdent(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
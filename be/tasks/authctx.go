@@ -13,6 +13,17 @@ type UserToken struct {
 
 type authTokenKey struct{}
 
+type apiKeyKey struct{}
+
+// ApiKey identifies the API key a request was made with, and the address it was made from.
+type ApiKey struct {
+	UserId        int64
+	KeyId         int64
+	RemoteAddress string
+}
+
+type backgroundJobUserKey struct{}
+
 func CtxFromUserToken(ctx context.Context, userId, tokenId int64) context.Context {
 	return context.WithValue(ctx, userTokenKey{}, &UserToken{
 		UserId:  userId,
@@ -25,6 +36,19 @@ func UserTokenFromCtx(ctx context.Context) (tok *UserToken, ok bool) {
 	return
 }
 
+func CtxFromApiKey(ctx context.Context, userId, keyId int64, remoteAddress string) context.Context {
+	return context.WithValue(ctx, apiKeyKey{}, &ApiKey{
+		UserId:        userId,
+		KeyId:         keyId,
+		RemoteAddress: remoteAddress,
+	})
+}
+
+func ApiKeyFromCtx(ctx context.Context) (key *ApiKey, ok bool) {
+	key, ok = ctx.Value(apiKeyKey{}).(*ApiKey)
+	return
+}
+
 func CtxFromAuthToken(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, authTokenKey{}, token)
 }
@@ -33,3 +57,14 @@ func AuthTokenFromCtx(ctx context.Context) (token string, ok bool) {
 	token, ok = ctx.Value(authTokenKey{}).(string)
 	return
 }
+
+// ctxFromBackgroundJobUser makes tasks run as the given user, without a token.  It is only used to
+// run background jobs.  The user's capabilities are still checked by each task.
+func ctxFromBackgroundJobUser(ctx context.Context, userId int64) context.Context {
+	return context.WithValue(ctx, backgroundJobUserKey{}, userId)
+}
+
+func backgroundJobUserFromCtx(ctx context.Context) (userId int64, ok bool) {
+	userId, ok = ctx.Value(backgroundJobUserKey{}).(int64)
+	return
+}
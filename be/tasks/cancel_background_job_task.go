@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &CancelBackgroundJobTask{}
+
+// CancelBackgroundJobTask stops a job from being run again.  A job that is already running is
+// allowed to finish, but its outcome is discarded.  Users may always cancel their own jobs.
+type CancelBackgroundJobTask struct {
+	// deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// input
+	JobId int64
+
+	// output
+	BackgroundJob *schema.BackgroundJob
+}
+
+func (t *CancelBackgroundJobTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.BackgroundJob = nil
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+
+	bj, sts := findBackgroundJob(j, t.JobId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateBackgroundJobAccess(j, u, conf, bj, schema.User_BACKGROUND_JOB_CANCEL); sts != nil {
+		return sts
+	}
+	if bj.Finished() {
+		return status.FailedPrecondition(nil, "background job already finished", bj.Status.State)
+	}
+
+	bj.Status.State = schema.BackgroundJob_Status_CANCELLED
+	bj.Status.NextRunTs = nil
+	bj.Status.FinishedTs = schema.ToTspb(now)
+	bj.SetModifiedTime(now)
+	if err := j.UpdateBackgroundJob(bj); err != nil {
+		return status.Internal(err, "can't update background job")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.BackgroundJob = bj
+	return nil
+}
@@ -0,0 +1,81 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestCancelBackgroundJobWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	bj := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &CancelBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		JobId: bj.JobId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	bj = c.RefreshBackgroundJob(bj)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_CANCELLED; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if bj.Status.NextRunTs != nil {
+		t.Error("unexpected next run time", bj.Status.NextRunTs)
+	}
+}
+
+func TestCancelBackgroundJobFailsOnFinishedJob(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	bj := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+	bj.Status.State = schema.BackgroundJob_Status_SUCCEEDED
+	bj.Status.NextRunTs = nil
+	bj.Status.FinishedTs = schema.ToTspb(time.Now())
+	c.UpdateBackgroundJob(bj)
+
+	task := &CancelBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		JobId: bj.JobId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.FailedPrecondition {
+		t.Error("have", sts, "want", codes.FailedPrecondition)
+	}
+}
+
+func TestCancelBackgroundJobFailsOnOtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	owner, other := c.CreateUser(), c.CreateUser()
+	bj := c.CreateBackgroundJob(owner, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &CancelBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		JobId: bj.JobId,
+	}
+	sts := new(TaskRunner).Run(other.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.PermissionDenied {
+		t.Error("have", sts, "want", codes.PermissionDenied)
+	}
+
+	other.User.Capability = append(other.User.Capability, schema.User_BACKGROUND_JOB_CANCEL)
+	other.Update()
+	if sts := new(TaskRunner).Run(other.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Error(sts)
+	}
+}
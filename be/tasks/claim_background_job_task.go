@@ -0,0 +1,103 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// maxBackgroundJobAttempts is how many times a job is started before it is given up on.
+var maxBackgroundJobAttempts = int64(taskMaxRetries)
+
+var _ Task = &ClaimBackgroundJobTask{}
+
+// ClaimBackgroundJobTask takes the next job that is ready to run, so that no other worker runs it.
+// Running jobs whose worker took too long are taken again.  It is only meant to be run by the
+// server, so no user is needed.
+type ClaimBackgroundJobTask struct {
+	// deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// input
+	// How long the worker has to finish the job, before another worker may take it.
+	Timeout time.Duration
+
+	// output
+	// The claimed job, or nil if no job is ready.
+	BackgroundJob *schema.BackgroundJob
+}
+
+func (t *ClaimBackgroundJobTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.BackgroundJob = nil
+	if t.Timeout <= 0 {
+		return status.InvalidArgument(nil, "bad timeout", t.Timeout)
+	}
+	now := t.Now()
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	nowCol := schema.BackgroundJobNextRunTsCol(schema.ToTspb(now))
+	var bj *schema.BackgroundJob
+	for _, state := range []schema.BackgroundJob_Status_State{
+		schema.BackgroundJob_Status_PENDING, schema.BackgroundJob_Status_RUNNING} {
+		state := state
+		for bj == nil {
+			bjs, err := j.FindBackgroundJobs(db.Opts{
+				StartInc: tab.BackgroundJobsState{State: &state},
+				StopInc:  tab.BackgroundJobsState{State: &state, NextRunTs: &nowCol},
+				Lock:     db.LockWrite,
+				Limit:    1,
+			})
+			if err != nil {
+				return status.Internal(err, "can't find background jobs")
+			}
+			if len(bjs) == 0 {
+				break
+			}
+			if bjs[0].Status.Attempts < maxBackgroundJobAttempts {
+				bj = bjs[0]
+				break
+			}
+			// The worker running it never finished, and there are no attempts left.
+			failed := bjs[0]
+			failed.Status.State = schema.BackgroundJob_Status_FAILED
+			failed.Status.LastError = "timed out"
+			failed.Status.NextRunTs = nil
+			failed.Status.FinishedTs = schema.ToTspb(now)
+			failed.SetModifiedTime(now)
+			if err := j.UpdateBackgroundJob(failed); err != nil {
+				return status.Internal(err, "can't update background job")
+			}
+		}
+		if bj != nil {
+			break
+		}
+	}
+
+	if bj != nil {
+		bj.Status.State = schema.BackgroundJob_Status_RUNNING
+		bj.Status.Attempts++
+		bj.Status.NextRunTs = schema.ToTspb(now.Add(t.Timeout))
+		bj.Status.Progress = nil
+		bj.SetModifiedTime(now)
+		if err := j.UpdateBackgroundJob(bj); err != nil {
+			return status.Internal(err, "can't update background job")
+		}
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.BackgroundJob = bj
+	return nil
+}
@@ -0,0 +1,129 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestClaimBackgroundJobWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	bj := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &ClaimBackgroundJobTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Timeout: time.Minute,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.BackgroundJob == nil {
+		t.Fatal("no job claimed")
+	}
+	if have, want := task.BackgroundJob.JobId, bj.JobId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	bj = c.RefreshBackgroundJob(bj)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_RUNNING; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := bj.Status.Attempts, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// Running jobs aren't claimed again until they time out.
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.BackgroundJob != nil {
+		t.Error("claimed running job", task.BackgroundJob)
+	}
+}
+
+func TestClaimBackgroundJobSkipsFutureJobs(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &ClaimBackgroundJobTask{
+		Beg: c.DB(),
+		Now: func() time.Time {
+			return time.Now().Add(-time.Hour)
+		},
+		Timeout: time.Minute,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.BackgroundJob != nil {
+		t.Error("claimed future job", task.BackgroundJob)
+	}
+}
+
+func TestClaimBackgroundJobReclaimsTimedOutJob(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	bj := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &ClaimBackgroundJobTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Timeout: time.Minute,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	task.Now = func() time.Time {
+		return time.Now().Add(time.Hour)
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.BackgroundJob == nil {
+		t.Fatal("no job claimed")
+	}
+	bj = c.RefreshBackgroundJob(bj)
+	if have, want := bj.Status.Attempts, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestClaimBackgroundJobFailsExhaustedJob(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	bj := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+	bj.Status.State = schema.BackgroundJob_Status_RUNNING
+	bj.Status.Attempts = maxBackgroundJobAttempts
+	c.UpdateBackgroundJob(bj)
+
+	task := &ClaimBackgroundJobTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Timeout: time.Minute,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.BackgroundJob != nil {
+		t.Error("claimed exhausted job", task.BackgroundJob)
+	}
+	bj = c.RefreshBackgroundJob(bj)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_FAILED; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if bj.Status.FinishedTs == nil {
+		t.Error("missing finished time")
+	}
+}
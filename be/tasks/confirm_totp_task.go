@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// ConfirmTotpTask enables two-factor authentication for the current user, once they show they can
+// make codes from the secret given by EnrollTotpTask.
+type ConfirmTotpTask struct {
+	// Deps
+	Beg  tab.JobBeginner
+	Now  func() time.Time
+	Rand io.Reader
+
+	// Inputs
+	Code string
+
+	// Results
+	RecoveryCodes []string
+}
+
+func (t *ConfirmTotpTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "can't confirm without user")
+	}
+	if sts := checkNotApiKey(ctx, "confirm"); sts != nil {
+		return sts
+	}
+	u, sts := lookupObjectUser(ctx, j, db.LockWrite, su.UserId, su)
+	if sts != nil {
+		return sts
+	}
+	if u.Totp == nil {
+		return status.FailedPrecondition(nil, "not enrolled")
+	}
+	if totpEnabled(u) {
+		return status.AlreadyExists(nil, "two-factor authentication already enabled")
+	}
+	if !useTotpCode(u.Totp, t.Code, now) {
+		return status.InvalidArgument(nil, "bad totp code")
+	}
+
+	codes, hashes, err := newTotpRecoveryCodes(t.Rand)
+	if err != nil {
+		return status.Internal(err, "can't create recovery codes")
+	}
+	u.Totp.RecoveryCodeHash = hashes
+	u.Totp.EnabledTs = schema.ToTspb(now)
+	u.SetModifiedTime(now)
+
+	if err := j.UpdateUser(u); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.RecoveryCodes = codes
+	return nil
+}
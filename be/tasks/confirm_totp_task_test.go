@@ -0,0 +1,79 @@
+package tasks
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestConfirmTotp(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Totp = &schema.UserTotp{
+		Secret: []byte("12345678901234567890"),
+	}
+	u.Update()
+
+	task := &ConfirmTotpTask{
+		Beg:  c.DB(),
+		Now:  func() time.Time { return now },
+		Rand: rand.Reader,
+		Code: "bogus",
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	task.Code = totpCode(u.User.Totp.Secret, totpStepOf(now))
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if !totpEnabled(u.User) {
+		t.Error("expected enabled", u.User.Totp)
+	}
+	if len(task.RecoveryCodes) != totpRecoveryCodes ||
+		len(u.User.Totp.RecoveryCodeHash) != totpRecoveryCodes {
+		t.Error("wrong recovery codes", task.RecoveryCodes, u.User.Totp.RecoveryCodeHash)
+	}
+	if !useTotpCode(u.User.Totp, task.RecoveryCodes[0], now) {
+		t.Error("recovery code not accepted")
+	}
+}
+
+func TestConfirmTotpFailsIfNotEnrolled(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &ConfirmTotpTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Rand: rand.Reader,
+		Code: "123456",
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.FailedPrecondition; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,128 @@
+package tasks
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+const (
+	maxUserApiKeys       = 20
+	maxApiKeyNameLength  = 64
+	maxApiKeyRemoteRange = 16
+)
+
+// CreateApiKeyTask creates a long lived key for the current user, limited to some of their
+// capabilities.  Keys can't be created using another key, so that revoking a leaked key is enough
+// to lock it out.
+type CreateApiKeyTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Name       string
+	Capability []schema.User_Capability
+	// If zero, the key doesn't expire.
+	ExpireTime time.Time
+	// CIDR ranges the key may be used from.  If empty, the key may be used from anywhere.
+	AllowedRemoteRange []string
+
+	// Outputs
+	User   *schema.User
+	ApiKey *schema.UserApiKey
+}
+
+func (t *CreateApiKeyTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "can't create api key without user")
+	}
+	if sts := checkNotApiKey(ctx, "create api key"); sts != nil {
+		return sts
+	}
+
+	validators := []text.TextValidator{
+		text.DefaultValidator(0, maxApiKeyNameLength), text.ValidateNoNewlines}
+	normalizers := []text.TextNormalizer{text.ToNFC, func(txt, _ string) (string, error) {
+		return strings.TrimSpace(txt), nil
+	}}
+	name, err := text.ValidateAndNormalizeMulti(t.Name, "name", normalizers, validators...)
+	if err != nil {
+		return status.From(err)
+	}
+	if len(t.Capability) == 0 {
+		return status.InvalidArgument(nil, "no capabilities")
+	}
+	caps, _, sts := changeCapabilities(nil, t.Capability, nil)
+	if sts != nil {
+		return sts
+	}
+	// Keys can't have capabilities the user doesn't.
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
+	if sts := schema.VerifyCapSubset(
+		schema.CapSetOf(ru.Capability...), schema.CapSetOf(caps...)); sts != nil {
+		return sts
+	}
+	if len(t.AllowedRemoteRange) > maxApiKeyRemoteRange {
+		return status.InvalidArgument(nil, "too many remote ranges")
+	}
+	var ranges []string
+	for _, r := range t.AllowedRemoteRange {
+		_, ipnet, err := net.ParseCIDR(r)
+		if err != nil {
+			return status.InvalidArgument(err, "bad remote range", r)
+		}
+		ranges = append(ranges, ipnet.String())
+	}
+	if !t.ExpireTime.IsZero() && !t.ExpireTime.After(now) {
+		return status.InvalidArgument(nil, "expire time has passed")
+	}
+
+	u, sts := lookupObjectUser(ctx, j, db.LockWrite, su.UserId, su)
+	if sts != nil {
+		return sts
+	}
+	if len(u.ApiKey) >= maxUserApiKeys {
+		return status.ResourceExhausted(nil, "too many api keys")
+	}
+	u.NextApiKeyId++
+	key := &schema.UserApiKey{
+		KeyId:              u.NextApiKeyId,
+		Name:               name,
+		CreatedTs:          schema.ToTspb(now),
+		Capability:         caps,
+		AllowedRemoteRange: ranges,
+	}
+	if !t.ExpireTime.IsZero() {
+		key.ExpireTs = schema.ToTspb(t.ExpireTime)
+	}
+	u.ApiKey = append(u.ApiKey, key)
+	u.SetModifiedTime(now)
+
+	if err := j.UpdateUser(u); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = u
+	t.ApiKey = key
+	return nil
+}
@@ -0,0 +1,142 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Capability = []schema.User_Capability{schema.User_PIC_READ, schema.User_PIC_CREATE}
+	u.Update()
+
+	task := &CreateApiKeyTask{
+		Beg:                c.DB(),
+		Now:                func() time.Time { return now },
+		Name:               " uploader ",
+		Capability:         []schema.User_Capability{schema.User_PIC_CREATE},
+		ExpireTime:         now.Add(time.Hour),
+		AllowedRemoteRange: []string{"10.1.2.3/8"},
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if len(u.User.ApiKey) != 1 || u.User.NextApiKeyId != 1 {
+		t.Fatal("expected key", u.User)
+	}
+	key := u.User.ApiKey[0]
+	if key.KeyId != 1 || key.KeyId != task.ApiKey.KeyId {
+		t.Error("wrong key id", key, task.ApiKey)
+	}
+	if have, want := key.Name, "uploader"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	want := []schema.User_Capability{schema.User_PIC_CREATE}
+	if !capsEqual(key.Capability, want) {
+		t.Error("wrong caps", key.Capability)
+	}
+	if len(key.AllowedRemoteRange) != 1 || key.AllowedRemoteRange[0] != "10.0.0.0/8" {
+		t.Error("wrong ranges", key.AllowedRemoteRange)
+	}
+	if !schema.ToTime(key.ExpireTs).Equal(now.Add(time.Hour)) {
+		t.Error("wrong expire time", key.ExpireTs)
+	}
+	// Login sessions are left alone.
+	if len(u.User.UserToken) != 1 {
+		t.Error("expected token to remain", u.User.UserToken)
+	}
+}
+
+func TestCreateApiKeyFailsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = []schema.User_Capability{schema.User_PIC_READ}
+	u.Update()
+
+	task := &CreateApiKeyTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Capability: []schema.User_Capability{schema.User_PIC_CREATE},
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateApiKeyFailsOnBadRange(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = []schema.User_Capability{schema.User_PIC_READ}
+	u.Update()
+
+	task := &CreateApiKeyTask{
+		Beg:                c.DB(),
+		Now:                time.Now,
+		Capability:         []schema.User_Capability{schema.User_PIC_READ},
+		AllowedRemoteRange: []string{"10.0.0.1"},
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad remote range"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateApiKeyFailsWithApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = []schema.User_Capability{schema.User_PIC_READ}
+	u.User.NextApiKeyId = 1
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{
+		KeyId:      1,
+		Capability: []schema.User_Capability{schema.User_PIC_READ},
+	})
+	u.Update()
+
+	task := &CreateApiKeyTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Capability: []schema.User_Capability{schema.User_PIC_READ},
+	}
+
+	ctx := CtxFromApiKey(c.Ctx, u.User.UserId, 1, "")
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
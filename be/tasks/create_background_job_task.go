@@ -0,0 +1,161 @@
+package tasks
+
+import (
+	"context"
+	"crypto/md5"
+	"net/url"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &CreateBackgroundJobTask{}
+
+// CreateBackgroundJobTask queues a job to be run later by the server's workers.  The job runs as
+// the current user.
+type CreateBackgroundJobTask struct {
+	// deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// input
+	Type schema.BackgroundJob_Type
+	// Required for THUMBNAIL and HARD_DELETE_PIC jobs.
+	PicId int64
+	// Required for FETCH_URL jobs.
+	Url string
+	// Optional for FETCH_URL jobs.  These are passed along to UpsertPicTask.
+	Referrer   string
+	FileName   string
+	Md5Hash    []byte
+	Visibility *schema.Pic_Visibility
+
+	// output
+	BackgroundJob *schema.BackgroundJob
+}
+
+func (t *CreateBackgroundJobTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.BackgroundJob = nil
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	cap, sts := backgroundJobCapability(t.Type)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, u, conf, cap); sts != nil {
+		return sts
+	}
+
+	userId := schema.AnonymousUserId
+	if u != nil {
+		userId = u.UserId
+	}
+	var visibility *schema.Pic_Visibility
+	switch t.Type {
+	case schema.BackgroundJob_THUMBNAIL, schema.BackgroundJob_HARD_DELETE_PIC:
+		pics, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&t.PicId},
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find pics")
+		}
+		if len(pics) != 1 {
+			return status.NotFound(nil, "can't lookup pic", t.PicId)
+		}
+		if pics[0].HardDeleted() {
+			return status.InvalidArgument(nil, "pic already hard deleted", t.PicId)
+		}
+	case schema.BackgroundJob_FETCH_URL:
+		if sts := validateBackgroundJobUrl(t.Url); sts != nil {
+			return sts
+		}
+		if len(t.Md5Hash) != 0 && len(t.Md5Hash) != md5.Size {
+			return status.InvalidArgument(nil, "bad md5 hash")
+		}
+		if visibility, sts = cleanPicVisibility(j, t.Visibility, userId); sts != nil {
+			return sts
+		}
+	}
+
+	bj := &schema.BackgroundJob{
+		Type:   t.Type,
+		UserId: userId,
+		PicId:  t.PicId,
+		Url:    t.Url,
+	}
+	if t.Type == schema.BackgroundJob_FETCH_URL {
+		bj.Referrer = t.Referrer
+		bj.FileName = t.FileName
+		bj.Md5Hash = t.Md5Hash
+		bj.Visibility = visibility
+	}
+	if sts := createBackgroundJob(j, bj, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.BackgroundJob = bj
+	return nil
+}
+
+// backgroundJobCapability is the capability needed to create, and run, a job of the given type.
+func backgroundJobCapability(typ schema.BackgroundJob_Type) (schema.User_Capability, status.S) {
+	switch typ {
+	case schema.BackgroundJob_THUMBNAIL, schema.BackgroundJob_FETCH_URL:
+		return schema.User_PIC_CREATE, nil
+	case schema.BackgroundJob_HARD_DELETE_PIC:
+		return schema.User_PIC_HARD_DELETE, nil
+	default:
+		return schema.User_UNKNOWN, status.InvalidArgument(nil, "bad background job type", typ)
+	}
+}
+
+func validateBackgroundJobUrl(rawurl string) status.S {
+	if rawurl == "" {
+		return status.InvalidArgument(nil, "missing url")
+	}
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return status.InvalidArgument(err, "can't parse url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return status.InvalidArgument(nil, "can't use non HTTP url", rawurl)
+	}
+	return nil
+}
+
+// createBackgroundJob inserts bj as a pending job, ready to run now.
+func createBackgroundJob(j *tab.Job, bj *schema.BackgroundJob, now time.Time) status.S {
+	id, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	bj.JobId = id
+	bj.SetCreatedTime(now)
+	bj.SetModifiedTime(now)
+	bj.Status = &schema.BackgroundJob_Status{
+		State:     schema.BackgroundJob_Status_PENDING,
+		NextRunTs: schema.ToTspb(now),
+	}
+	if err := j.InsertBackgroundJob(bj); err != nil {
+		return status.Internal(err, "can't create background job")
+	}
+	return nil
+}
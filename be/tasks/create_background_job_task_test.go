@@ -0,0 +1,123 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func (c *TestContainer) CreateBackgroundJob(
+	u *TestUser, typ schema.BackgroundJob_Type, picId int64) *schema.BackgroundJob {
+	bj := &schema.BackgroundJob{
+		Type:   typ,
+		UserId: u.User.UserId,
+		PicId:  picId,
+	}
+	c.AutoJob(func(j *tab.Job) error {
+		if sts := createBackgroundJob(j, bj, time.Now()); sts != nil {
+			return sts
+		}
+		return nil
+	})
+	return bj
+}
+
+func (c *TestContainer) RefreshBackgroundJob(bj *schema.BackgroundJob) *schema.BackgroundJob {
+	var updated *schema.BackgroundJob
+	c.AutoJob(func(j *tab.Job) error {
+		var sts status.S
+		updated, sts = findBackgroundJob(j, bj.JobId, db.LockNone)
+		if sts != nil {
+			return sts
+		}
+		return nil
+	})
+	return updated
+}
+
+func (c *TestContainer) UpdateBackgroundJob(bj *schema.BackgroundJob) {
+	c.AutoJob(func(j *tab.Job) error {
+		return j.UpdateBackgroundJob(bj)
+	})
+}
+
+func TestCreateBackgroundJobWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_HARD_DELETE)
+	u.Update()
+	p := c.CreatePic()
+
+	task := &CreateBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Type:  schema.BackgroundJob_HARD_DELETE_PIC,
+		PicId: p.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	bj := c.RefreshBackgroundJob(task.BackgroundJob)
+	if have, want := bj.UserId, u.User.UserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := bj.PicId, p.Pic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_PENDING; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if bj.Status.NextRunTs == nil {
+		t.Error("missing next run time")
+	}
+}
+
+func TestCreateBackgroundJobFailsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	p := c.CreatePic()
+
+	task := &CreateBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Type:  schema.BackgroundJob_HARD_DELETE_PIC,
+		PicId: p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.PermissionDenied {
+		t.Error("have", sts, "want", codes.PermissionDenied)
+	}
+}
+
+func TestCreateBackgroundJobFailsOnBadUrl(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	task := &CreateBackgroundJobTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Type: schema.BackgroundJob_FETCH_URL,
+		Url:  "file:///etc/passwd",
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.InvalidArgument {
+		t.Error("have", sts, "want", codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,141 @@
+package tasks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+const (
+	minRoleNameLength = 1
+	maxRoleNameLength = 64
+)
+
+// CreateRoleTask creates a named group of capabilities, which can then be given to users.
+type CreateRoleTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Name       string
+	Capability []schema.User_Capability
+
+	// Outputs
+	CreatedRole *schema.Role
+}
+
+func (t *CreateRoleTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, su, conf, schema.User_ROLE_UPDATE); sts != nil {
+		return sts
+	}
+
+	name, sts := cleanRoleName(j, t.Name, 0)
+	if sts != nil {
+		return sts
+	}
+	caps, _, sts := changeCapabilities(nil, t.Capability, nil)
+	if sts != nil {
+		return sts
+	}
+
+	roleId, err := j.AllocId()
+	if err != nil {
+		return status.Internal(err, "can't allocate id")
+	}
+	role := &schema.Role{
+		RoleId:     roleId,
+		Name:       name,
+		Capability: caps,
+	}
+	role.SetCreatedTime(now)
+	role.SetModifiedTime(now)
+	if err := j.InsertRole(role); err != nil {
+		return status.Internal(err, "can't create role")
+	}
+
+	if sts := insertUpdateRoleEvent(j, su, now, &schema.UserEvent_UpdateRole{
+		RoleId:        roleId,
+		Name:          name,
+		Created:       true,
+		SetCapability: caps,
+	}); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.CreatedRole = role
+	return nil
+}
+
+// cleanRoleName normalizes the name, and checks that no role other than roleId is using it.
+func cleanRoleName(j *tab.Job, rawName string, roleId int64) (string, status.S) {
+	validators := []text.TextValidator{
+		text.DefaultValidator(minRoleNameLength, maxRoleNameLength), text.ValidateNoNewlines}
+	normalizers := []text.TextNormalizer{text.ToNFC, func(txt, _ string) (string, error) {
+		return strings.TrimSpace(txt), nil
+	}}
+	name, err := text.ValidateAndNormalizeMulti(rawName, "role", normalizers, validators...)
+	if err != nil {
+		return "", status.From(err)
+	}
+	uniq := schema.RoleUniqueName(name)
+	rs, err := j.FindRoles(db.Opts{
+		Prefix: tab.RolesName{&uniq},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return "", status.Internal(err, "can't find roles")
+	}
+	if len(rs) == 1 && rs[0].RoleId != roleId {
+		return "", status.AlreadyExists(nil, "role name already used")
+	}
+	return name, nil
+}
+
+// insertUpdateRoleEvent records the role change in the events of the user who made it.
+func insertUpdateRoleEvent(
+	j *tab.Job, su *schema.User, now time.Time, evt *schema.UserEvent_UpdateRole) status.S {
+	if su == nil {
+		return nil
+	}
+	nowts := schema.ToTspb(now)
+	createdTs := schema.UserEventCreatedTsCol(nowts)
+	idx, sts := nextUserEventIndex(j, su.UserId, createdTs)
+	if sts != nil {
+		return sts
+	}
+	ue := &schema.UserEvent{
+		UserId:     su.UserId,
+		Index:      idx,
+		CreatedTs:  nowts,
+		ModifiedTs: nowts,
+		Evt: &schema.UserEvent_UpdateRole_{
+			UpdateRole: evt,
+		},
+	}
+	if err := j.InsertUserEvent(ue); err != nil {
+		return status.Internal(err, "can't create user event")
+	}
+	return nil
+}
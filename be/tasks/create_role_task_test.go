@@ -0,0 +1,180 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func (c *TestContainer) CreateRole(name string, caps ...schema.User_Capability) *schema.Role {
+	now := time.Now()
+	r := &schema.Role{
+		RoleId:     c.Id(),
+		Name:       name,
+		Capability: caps,
+	}
+	r.SetCreatedTime(now)
+	r.SetModifiedTime(now)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertRole(r)
+	})
+	return r
+}
+
+func (c *TestContainer) RefreshRole(r *schema.Role) *schema.Role {
+	var rs []*schema.Role
+	c.AutoJob(func(j *tab.Job) error {
+		var err error
+		rs, err = j.FindRoles(db.Opts{
+			Prefix: tab.RolesPrimary{&r.RoleId},
+		})
+		return err
+	})
+	if len(rs) != 1 {
+		return nil
+	}
+	return rs[0]
+}
+
+func TestCreateRoleTask(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_ROLE_UPDATE)
+	u.Update()
+
+	task := &CreateRoleTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Name:       " Moderators ",
+		Capability: []schema.User_Capability{schema.User_PIC_TAG_DELETE, schema.User_PIC_SOFT_DELETE},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	r := c.RefreshRole(task.CreatedRole)
+	if r == nil {
+		t.Fatal("role not created")
+	}
+	if !proto.Equal(r, task.CreatedRole) {
+		t.Error("have", r, "want", task.CreatedRole)
+	}
+	if have, want := r.Name, "Moderators"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	wantCaps := []schema.User_Capability{schema.User_PIC_SOFT_DELETE, schema.User_PIC_TAG_DELETE}
+	if have, want := r.Capability, wantCaps; !capsEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+
+	var evts []*schema.UserEvent
+	c.AutoJob(func(j *tab.Job) error {
+		var err error
+		evts, err = j.FindUserEvents(db.Opts{
+			Prefix: tab.UserEventsPrimary{UserId: &u.User.UserId},
+		})
+		return err
+	})
+	if len(evts) != 1 {
+		t.Fatal("expected one event", evts)
+	}
+	wantEvt := &schema.UserEvent_UpdateRole{
+		RoleId:        r.RoleId,
+		Name:          "Moderators",
+		Created:       true,
+		SetCapability: wantCaps,
+	}
+	if have, want := evts[0].GetUpdateRole(), wantEvt; !proto.Equal(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateRoleTask_missingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &CreateRoleTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Name: "Moderators",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateRoleTask_nameInUse(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_ROLE_UPDATE)
+	u.Update()
+	c.CreateRole("Moderators")
+
+	task := &CreateRoleTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Name: "MODERATORS",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.AlreadyExists; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCreateRoleTask_unknownCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_ROLE_UPDATE)
+	u.Update()
+
+	task := &CreateRoleTask{
+		Beg:        c.DB(),
+		Now:        time.Now,
+		Name:       "Moderators",
+		Capability: []schema.User_Capability{schema.User_UNKNOWN},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func capsEqual(a, b []schema.User_Capability) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
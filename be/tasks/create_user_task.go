@@ -51,7 +51,7 @@ func (t *CreateUserTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(su, conf, schema.User_USER_CREATE); sts != nil {
+	if sts := validateCapability(j, su, conf, schema.User_USER_CREATE); sts != nil {
 		return sts
 	}
 
@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+type DeleteTagAliasTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Alias string
+}
+
+func (t *DeleteTagAliasTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, u, conf, schema.User_TAG_ALIAS_UPDATE); sts != nil {
+		return sts
+	}
+
+	uniq, err := text.ToCaselessNFKC(strings.TrimSpace(t.Alias), "alias")
+	if err != nil {
+		return status.From(err)
+	}
+
+	tas, err := j.FindTagAliases(db.Opts{
+		Prefix: tab.TagAliasesPrimary{&uniq},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tag aliases")
+	}
+	if len(tas) != 1 {
+		return status.NotFound(nil, "can't find tag alias")
+	}
+
+	if err := j.DeleteTagAlias(tab.KeyForTagAlias(tas[0])); err != nil {
+		return status.Internal(err, "can't delete tag alias")
+	}
+
+	tags, err := j.FindTags(db.Opts{
+		Prefix: tab.TagsPrimary{&tas[0].TagId},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tags")
+	}
+	if len(tags) != 1 {
+		return status.Internal(nil, "can't lookup aliased tag", tas[0].TagId)
+	}
+	// The tag may have been kept around only for the alias.
+	if sts := deleteUnusedTag(j, tags[0]); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	return nil
+}
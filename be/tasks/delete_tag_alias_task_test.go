@@ -0,0 +1,116 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestDeleteTagAliasTaskWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	tag := c.CreateTag()
+	tag.Tag.UsageCount = 1
+	tag.Update()
+	ta := c.CreateTagAlias("Kitty", tag)
+
+	task := &DeleteTagAliasTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Alias: "kitty",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if ta.Refresh() {
+		t.Error("alias not removed")
+	}
+	if !tag.Refresh() {
+		t.Error("tag removed")
+	}
+}
+
+func TestDeleteTagAliasTask_DeletesUnusedTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	tag := c.CreateTag()
+	c.CreateTagAlias("Kitty", tag)
+
+	task := &DeleteTagAliasTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Alias: "kitty",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if tag.Refresh() {
+		t.Error("unused tag not removed", tag.Tag)
+	}
+}
+
+func TestDeleteTagAliasTask_Missing(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	task := &DeleteTagAliasTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Alias: "kitty",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeleteTagAliasTask_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	tag := c.CreateTag()
+	ta := c.CreateTagAlias("kitty", tag)
+
+	task := &DeleteTagAliasTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Alias: "kitty",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !ta.Refresh() {
+		t.Error("alias removed")
+	}
+}
@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+type DeleteTagImplicationTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	TagName        string
+	ImpliedTagName string
+}
+
+func (t *DeleteTagImplicationTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, u, conf, schema.User_TAG_IMPLICATION_UPDATE); sts != nil {
+		return sts
+	}
+
+	tag, impliedTag, sts := lookupTagImplicationTags(j, t.TagName, t.ImpliedTagName)
+	if sts != nil {
+		return sts
+	}
+
+	tis, err := j.FindTagImplications(db.Opts{
+		Prefix: tab.TagImplicationsPrimary{TagId: &tag.TagId, ImpliedTagId: &impliedTag.TagId},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tag implications")
+	}
+	if len(tis) != 1 {
+		return status.NotFound(nil, "can't find tag implication")
+	}
+
+	if err := j.DeleteTagImplication(tab.KeyForTagImplication(tis[0])); err != nil {
+		return status.Internal(err, "can't delete tag implication")
+	}
+	// Either tag may have been kept around only for the implication.
+	if sts := deleteUnusedTag(j, tag); sts != nil {
+		return sts
+	}
+	if sts := deleteUnusedTag(j, impliedTag); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	return nil
+}
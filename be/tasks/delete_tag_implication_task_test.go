@@ -0,0 +1,130 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestDeleteTagImplicationTaskWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_IMPLICATION_UPDATE)
+	u.Update()
+
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	tag1.Tag.UsageCount = 1
+	tag1.Update()
+	tag2.Tag.UsageCount = 1
+	tag2.Update()
+	ti := c.CreateTagImplication(tag1, tag2)
+
+	task := &DeleteTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag1.Tag.Name,
+		ImpliedTagName: tag2.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if ti.Refresh() {
+		t.Error("implication not removed")
+	}
+	if !tag1.Refresh() || !tag2.Refresh() {
+		t.Error("tag removed")
+	}
+}
+
+func TestDeleteTagImplicationTask_DeletesUnusedTags(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_IMPLICATION_UPDATE)
+	u.Update()
+
+	tag1, tag2, tag3 := c.CreateTag(), c.CreateTag(), c.CreateTag()
+	c.CreateTagImplication(tag1, tag2)
+	c.CreateTagImplication(tag3, tag2)
+
+	task := &DeleteTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag1.Tag.Name,
+		ImpliedTagName: tag2.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if tag1.Refresh() {
+		t.Error("unused tag not removed", tag1.Tag)
+	}
+	// Still implied by tag3.
+	if !tag2.Refresh() {
+		t.Error("implied tag removed")
+	}
+}
+
+func TestDeleteTagImplicationTask_Missing(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_IMPLICATION_UPDATE)
+	u.Update()
+
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	c.CreateTagImplication(tag1, tag2)
+
+	task := &DeleteTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag2.Tag.Name,
+		ImpliedTagName: tag1.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestDeleteTagImplicationTask_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	ti := c.CreateTagImplication(tag1, tag2)
+
+	task := &DeleteTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag1.Tag.Name,
+		ImpliedTagName: tag2.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !ti.Refresh() {
+		t.Error("implication removed")
+	}
+}
@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// DisableTotpTask turns off two-factor authentication for a user, or cancels their enrollment.
+// Users need a current code to disable their own, so that a stolen session isn't enough.  Disabling
+// it for other users, such as ones who lost their device, requires USER_UPDATE_CAPABILITY.
+type DisableTotpTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// If zero, the current user is used.
+	ObjectUserId int64
+	Code         string
+}
+
+func (t *DisableTotpTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if sts := checkNotApiKey(ctx, "disable"); sts != nil {
+		return sts
+	}
+	ou, sts := lookupObjectUser(ctx, j, db.LockWrite, t.ObjectUserId, su)
+	if sts != nil {
+		return sts
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if su == nil || su.UserId != ou.UserId {
+		if sts := validateCapability(j, su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
+			return sts
+		}
+	} else if totpEnabled(ou) && !useTotpCode(ou.Totp, t.Code, now) {
+		return status.InvalidArgument(nil, "bad totp code")
+	}
+	if ou.Totp == nil {
+		return status.FailedPrecondition(nil, "not enrolled")
+	}
+
+	ou.Totp = nil
+	ou.SetModifiedTime(now)
+
+	if err := j.UpdateUser(ou); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestDisableTotpSelf(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Totp = &schema.UserTotp{
+		Secret:    []byte("12345678901234567890"),
+		EnabledTs: schema.ToTspb(now),
+	}
+	u.Update()
+
+	task := &DisableTotpTask{
+		Beg: c.DB(),
+		Now: func() time.Time { return now },
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	task.Code = totpCode(u.User.Totp.Secret, totpStepOf(now))
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if u.User.Totp != nil {
+		t.Error("expected totp to be removed", u.User.Totp)
+	}
+}
+
+func TestDisableTotpOther(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u1.User.Totp = &schema.UserTotp{
+		Secret:    []byte("12345678901234567890"),
+		EnabledTs: schema.ToTspb(time.Now()),
+	}
+	u1.Update()
+	u2 := c.CreateUser()
+
+	task := &DisableTotpTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+	}
+
+	ctx := u2.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	u2.User.Capability = append(u2.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	u2.Update()
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u1.Refresh()
+	if u1.User.Totp != nil {
+		t.Error("expected totp to be removed", u1.User.Totp)
+	}
+}
@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// EnrollTotpTask creates a new two-factor authentication secret for the current user.  The secret
+// isn't needed to log in until it is confirmed with ConfirmTotpTask.
+type EnrollTotpTask struct {
+	// Deps
+	Beg  tab.JobBeginner
+	Now  func() time.Time
+	Rand io.Reader
+
+	// Results
+	User   *schema.User
+	Secret []byte
+}
+
+func (t *EnrollTotpTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if su == nil {
+		return status.Unauthenticated(nil, "can't enroll without user")
+	}
+	if sts := checkNotApiKey(ctx, "enroll"); sts != nil {
+		return sts
+	}
+	u, sts := lookupObjectUser(ctx, j, db.LockWrite, su.UserId, su)
+	if sts != nil {
+		return sts
+	}
+	if totpEnabled(u) {
+		return status.AlreadyExists(nil, "two-factor authentication already enabled")
+	}
+
+	secret := make([]byte, totpSecretSize)
+	if _, err := io.ReadFull(t.Rand, secret); err != nil {
+		return status.Internal(err, "can't create secret")
+	}
+	u.Totp = &schema.UserTotp{
+		Secret: secret,
+	}
+	u.SetModifiedTime(now)
+
+	if err := j.UpdateUser(u); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.User = u
+	t.Secret = secret
+	return nil
+}
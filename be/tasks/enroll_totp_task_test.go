@@ -0,0 +1,68 @@
+package tasks
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestEnrollTotp(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &EnrollTotpTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Rand: rand.Reader,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if u.User.Totp == nil || len(u.User.Totp.Secret) != totpSecretSize {
+		t.Fatal("expected secret", u.User.Totp)
+	}
+	if string(u.User.Totp.Secret) != string(task.Secret) {
+		t.Error("secrets don't match")
+	}
+	// It isn't needed to log in until confirmed.
+	if totpEnabled(u.User) {
+		t.Error("unexpected enabled")
+	}
+}
+
+func TestEnrollTotpFailsIfEnabled(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Totp = &schema.UserTotp{
+		Secret:    []byte("12345678901234567890"),
+		EnabledTs: schema.ToTspb(time.Now()),
+	}
+	u.Update()
+
+	task := &EnrollTotpTask{
+		Beg:  c.DB(),
+		Now:  time.Now,
+		Rand: rand.Reader,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.AlreadyExists; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,99 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+const (
+	// MaxFindBackgroundJobs is the most jobs returned at once.
+	MaxFindBackgroundJobs = 50
+)
+
+var _ Task = &FindBackgroundJobsTask{}
+
+// FindBackgroundJobsTask finds jobs, newest first.  Users may always see their own jobs.
+type FindBackgroundJobsTask struct {
+	// deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// input
+	// Uses the current user if absent.
+	ObjectUserId int64
+	// If set, jobs of every user are found, and ObjectUserId is ignored.
+	All bool
+	// If present, only jobs at or before this id are found.
+	StartJobId int64
+
+	// output
+	BackgroundJobs []*schema.BackgroundJob
+	// If present, the StartJobId of the next page.
+	NextJobId int64
+}
+
+func (t *FindBackgroundJobsTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.BackgroundJobs, t.NextJobId = nil, 0
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+
+	stopJobId := int64(math.MaxInt64)
+	if t.StartJobId != 0 {
+		stopJobId = t.StartJobId
+	}
+	opts := db.Opts{
+		Reverse: true,
+		Limit:   MaxFindBackgroundJobs + 1,
+		Lock:    db.LockNone,
+	}
+	if t.All {
+		if sts := validateCapability(j, su, conf, schema.User_BACKGROUND_JOB_READ); sts != nil {
+			return sts
+		}
+		opts.StopInc = tab.BackgroundJobsPrimary{&stopJobId}
+	} else {
+		objectUserId, sts := deriveObjectUserId(t.ObjectUserId, su)
+		if sts != nil {
+			return sts
+		}
+		if su == nil || su.UserId != objectUserId {
+			if sts := validateCapability(j, su, conf, schema.User_BACKGROUND_JOB_READ); sts != nil {
+				return sts
+			}
+		}
+		opts.StartInc = tab.BackgroundJobsUserId{UserId: &objectUserId}
+		opts.StopInc = tab.BackgroundJobsUserId{UserId: &objectUserId, Id: &stopJobId}
+	}
+
+	bjs, err := j.FindBackgroundJobs(opts)
+	if err != nil {
+		return status.Internal(err, "can't find background jobs")
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback")
+	}
+
+	if len(bjs) > MaxFindBackgroundJobs {
+		t.NextJobId = bjs[MaxFindBackgroundJobs].JobId
+		bjs = bjs[:MaxFindBackgroundJobs]
+	}
+	t.BackgroundJobs = bjs
+	return nil
+}
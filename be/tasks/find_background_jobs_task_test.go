@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindBackgroundJobsWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u, other := c.CreateUser(), c.CreateUser()
+	picId := c.CreatePic().Pic.PicId
+	bj1 := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, picId)
+	bj2 := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, picId)
+	c.CreateBackgroundJob(other, schema.BackgroundJob_THUMBNAIL, picId)
+
+	task := &FindBackgroundJobsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := len(task.BackgroundJobs), 2; have != want {
+		t.Fatal("have", have, "want", want)
+	}
+	// newest first
+	if have, want := task.BackgroundJobs[0].JobId, bj2.JobId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := task.BackgroundJobs[1].JobId, bj1.JobId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if task.NextJobId != 0 {
+		t.Error("unexpected next job", task.NextJobId)
+	}
+}
+
+func TestFindBackgroundJobsFailsOnOtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u, other := c.CreateUser(), c.CreateUser()
+	c.CreateBackgroundJob(other, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &FindBackgroundJobsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+		All: true,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.PermissionDenied {
+		t.Error("have", sts, "want", codes.PermissionDenied)
+	}
+
+	u.User.Capability = append(u.User.Capability, schema.User_BACKGROUND_JOB_READ)
+	u.Update()
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := len(task.BackgroundJobs), 1; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -33,7 +33,7 @@ func (t *FindCustomDataTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, t.Capability...); sts != nil {
+	if sts := validateCapability(j, u, conf, t.Capability...); sts != nil {
 		return sts
 	}
 
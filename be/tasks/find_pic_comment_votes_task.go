@@ -38,6 +38,10 @@ func (t *FindPicCommentVotesTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
 
 	k := tab.PicCommentVotesPrimary{}
 
@@ -47,7 +51,7 @@ func (t *FindPicCommentVotesTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't lookup pic")
 	}
-	if len(pics) != 1 {
+	if len(pics) != 1 || !picVisible(pics[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't find pic")
 	}
 	k.PicId = &t.PicId
@@ -86,7 +90,7 @@ func (t *FindPicCommentVotesTask) Run(ctx context.Context) (stscap status.S) {
 		return status.Internal(err, "can't rollback job")
 	}
 
-	t.PicCommentVote = filterPicCommentVotes(picCommentVotes, su, conf)
+	t.PicCommentVote = filterPicCommentVotes(picCommentVotes, ru, conf)
 	return nil
 }
 
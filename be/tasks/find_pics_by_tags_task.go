@@ -0,0 +1,305 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+// findPicsByTagsBatchSize is the number of pic tags read at a time per tag.
+const findPicsByTagsBatchSize = 100
+
+// FindPicsByTagsTask finds pics matching a tag expression.  Pics are returned in descending
+// pic id order.
+type FindPicsByTagsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// AllTagNames are tags that each pic must have.
+	AllTagNames []string
+	// AnyTagNames are tags that each pic must have at least one of, if present.
+	AnyTagNames []string
+	// NoneTagNames are tags that each pic must not have.
+	NoneTagNames []string
+	// Only get pics with Pic Id <= than this.  If unset, the latest pics will be returned.
+	StartId int64
+	// MaxPics is the maximum number of pics to return.  Note that the number of pictures returned
+	// may be less than the number requested.  If unset, a default is used.
+	MaxPics int64
+
+	// Results
+	UnfilteredPics []*schema.Pic
+	// Same as pics, but with User info removed based on capability
+	Pics []*schema.Pic
+
+	NextId int64
+}
+
+func (t *FindPicsByTagsTask) Run(ctx context.Context) (stscap status.S) {
+	if len(t.AllTagNames) == 0 && len(t.AnyTagNames) == 0 {
+		return status.InvalidArgument(nil, "no tags to search for")
+	}
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_INDEX)
+	if sts != nil {
+		return sts
+	}
+
+	_, overmax, sts := getAndValidateMaxPics(conf, t.MaxPics)
+	if sts != nil {
+		return sts
+	}
+
+	allTags, allUnknown, sts := findTagsByRawName(j, t.AllTagNames)
+	if sts != nil {
+		return sts
+	}
+	anyTags, _, sts := findTagsByRawName(j, t.AnyTagNames)
+	if sts != nil {
+		return sts
+	}
+	noneTags, _, sts := findTagsByRawName(j, t.NoneTagNames)
+	if sts != nil {
+		return sts
+	}
+	if allUnknown || (len(t.AnyTagNames) != 0 && len(anyTags) == 0) {
+		// No pic can match.
+		return nil
+	}
+
+	startId := int64(math.MaxInt64)
+	if t.StartId != 0 {
+		startId = t.StartId
+	}
+
+	var drivers []*picTagCursor
+	var required, optional []*schema.Tag
+	if len(allTags) != 0 {
+		// Walk the least used tag, and check the rest on each pic.
+		least := 0
+		for i, tag := range allTags {
+			if tag.UsageCount < allTags[least].UsageCount {
+				least = i
+			}
+		}
+		drivers = append(drivers, &picTagCursor{tagId: allTags[least].TagId, next: startId})
+		required = append(required, allTags[:least]...)
+		required = append(required, allTags[least+1:]...)
+		optional = anyTags
+	} else {
+		for _, tag := range anyTags {
+			drivers = append(drivers, &picTagCursor{tagId: tag.TagId, next: startId})
+		}
+	}
+
+	var pics []*schema.Pic
+	for int64(len(pics)) < overmax {
+		picId, ok, sts := nextPicTagCursors(j, drivers)
+		if sts != nil {
+			return sts
+		}
+		if !ok {
+			break
+		}
+		match, sts := picMatchesTags(j, picId, required, optional, noneTags)
+		if sts != nil {
+			return sts
+		}
+		if !match {
+			continue
+		}
+		ps, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&picId},
+			Lock:   db.LockNone,
+		})
+		if err != nil {
+			return status.Internal(err, "can't lookup pic")
+		}
+		if len(ps) != 1 {
+			// Pic tags of purged pics are removed with the pic, but be lenient anyways.
+			continue
+		}
+		if ps[0].HardDeleted() {
+			continue
+		}
+		pics = append(pics, ps[0])
+	}
+
+	if n := len(pics); n > 0 && int64(n) == overmax {
+		t.UnfilteredPics = pics[:n-1]
+		t.NextId = pics[n-1].PicId
+	} else {
+		t.UnfilteredPics = pics
+	}
+	t.Pics = filterPics(t.UnfilteredPics, ru, conf)
+
+	return nil
+}
+
+// findTagsByRawName looks up tags by their user provided name.  Aliases are resolved to their
+// canonical tag.  Tags that don't exist are skipped, and unknown is set.
+func findTagsByRawName(j *tab.Job, rawNames []string) (
+	tags []*schema.Tag, unknown bool, _ status.S) {
+	seen := make(map[int64]struct{}, len(rawNames))
+	for _, rawName := range rawNames {
+		uniq, err := text.ToCaselessNFKC(strings.TrimSpace(rawName), "tag")
+		if err != nil {
+			return nil, false, status.From(err)
+		}
+		tag, sts := lookupTagAlias(j, uniq)
+		if sts != nil {
+			return nil, false, sts
+		}
+		if tag == nil {
+			ts, err := j.FindTags(db.Opts{
+				Prefix: tab.TagsName{&uniq},
+				Limit:  1,
+				Lock:   db.LockNone,
+			})
+			if err != nil {
+				return nil, false, status.Internal(err, "can't find tags")
+			}
+			if len(ts) != 1 {
+				unknown = true
+				continue
+			}
+			tag = ts[0]
+		}
+		if _, present := seen[tag.TagId]; present {
+			continue
+		}
+		seen[tag.TagId] = struct{}{}
+		tags = append(tags, tag)
+	}
+	return tags, unknown, nil
+}
+
+// picTagCursor walks the pic ids of a tag in descending order.
+type picTagCursor struct {
+	tagId int64
+	// next is the largest pic id that has not been read yet.
+	next   int64
+	picIds []int64
+	done   bool
+}
+
+func (c *picTagCursor) fill(j *tab.Job) status.S {
+	if len(c.picIds) != 0 || c.done {
+		return nil
+	}
+	minPicId := int64(0)
+	pts, err := j.FindPicTags(db.Opts{
+		Limit: findPicsByTagsBatchSize,
+		Lock:  db.LockNone,
+		StartInc: tab.PicTagsTagId{
+			TagId: &c.tagId,
+			PicId: &minPicId,
+		},
+		StopInc: tab.PicTagsTagId{
+			TagId: &c.tagId,
+			PicId: &c.next,
+		},
+		Reverse: true,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic tags")
+	}
+	for _, pt := range pts {
+		c.picIds = append(c.picIds, pt.PicId)
+	}
+	if n := len(pts); n < findPicsByTagsBatchSize || pts[n-1].PicId == minPicId {
+		c.done = true
+	} else {
+		c.next = pts[n-1].PicId - 1
+	}
+	return nil
+}
+
+// nextPicTagCursors returns the largest pic id of all cursors, and advances each cursor past it.
+func nextPicTagCursors(j *tab.Job, cs []*picTagCursor) (int64, bool, status.S) {
+	var picId int64
+	var found bool
+	for _, c := range cs {
+		if sts := c.fill(j); sts != nil {
+			return 0, false, sts
+		}
+		if len(c.picIds) != 0 && (!found || c.picIds[0] > picId) {
+			picId, found = c.picIds[0], true
+		}
+	}
+	if !found {
+		return 0, false, nil
+	}
+	for _, c := range cs {
+		if len(c.picIds) != 0 && c.picIds[0] == picId {
+			c.picIds = c.picIds[1:]
+		}
+	}
+	return picId, true, nil
+}
+
+// picMatchesTags checks that the pic has all required tags, at least one optional tag if present,
+// and none of the excluded tags.
+func picMatchesTags(j *tab.Job, picId int64, required, optional, excluded []*schema.Tag) (
+	bool, status.S) {
+	for _, tag := range required {
+		has, sts := picHasTag(j, picId, tag.TagId)
+		if sts != nil || !has {
+			return false, sts
+		}
+	}
+	if len(optional) != 0 {
+		var hasAny bool
+		for _, tag := range optional {
+			has, sts := picHasTag(j, picId, tag.TagId)
+			if sts != nil {
+				return false, sts
+			}
+			if has {
+				hasAny = true
+				break
+			}
+		}
+		if !hasAny {
+			return false, nil
+		}
+	}
+	for _, tag := range excluded {
+		has, sts := picHasTag(j, picId, tag.TagId)
+		if sts != nil || has {
+			return false, sts
+		}
+	}
+	return true, nil
+}
+
+func picHasTag(j *tab.Job, picId, tagId int64) (bool, status.S) {
+	pts, err := j.FindPicTags(db.Opts{
+		Prefix: tab.PicTagsPrimary{PicId: &picId, TagId: &tagId},
+		Limit:  1,
+		Lock:   db.LockNone,
+	})
+	if err != nil {
+		return false, status.Internal(err, "can't find pic tags")
+	}
+	return len(pts) == 1, nil
+}
@@ -0,0 +1,320 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func picIdsOf(ps []*schema.Pic) []int64 {
+	var ids []int64
+	for _, p := range ps {
+		ids = append(ids, p.PicId)
+	}
+	return ids
+}
+
+func equalPicIds(have []*schema.Pic, want ...*TestPic) bool {
+	if len(have) != len(want) {
+		return false
+	}
+	for i := range have {
+		if have[i].PicId != want[i].Pic.PicId {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFindPicsByTagsTask_All(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.CreatePicTag(p1, tag1)
+	c.CreatePicTag(p1, tag2)
+	c.CreatePicTag(p2, tag1)
+	c.CreatePicTag(p3, tag1)
+	c.CreatePicTag(p3, tag2)
+
+	task := &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{tag1.Tag.Name, " " + strings.ToUpper(tag2.Tag.Name)},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalPicIds(task.UnfilteredPics, p3, p1) {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want", p3.Pic.PicId, p1.Pic.PicId)
+	}
+	if task.NextId != 0 {
+		t.Error("have", task.NextId, "want", 0)
+	}
+}
+
+func TestFindPicsByTagsTask_Alias(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	tag := c.CreateTag()
+	c.CreateTagAlias("kitty", tag)
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	c.CreatePicTag(p1, tag)
+	c.CreatePicTag(p2, c.CreateTag())
+
+	task := &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{"Kitty", tag.Tag.Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalPicIds(task.UnfilteredPics, p1) {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want", p1.Pic.PicId)
+	}
+}
+
+func TestFindPicsByTagsTask_AnyAndNone(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	tag1, tag2, tag3 := c.CreateTag(), c.CreateTag(), c.CreateTag()
+	p1, p2, p3, p4 := c.CreatePic(), c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.CreatePicTag(p1, tag1)
+	c.CreatePicTag(p2, tag2)
+	c.CreatePicTag(p3, tag1)
+	c.CreatePicTag(p3, tag3)
+	c.CreatePicTag(p4, tag3)
+
+	task := &FindPicsByTagsTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		AnyTagNames:  []string{tag1.Tag.Name, tag2.Tag.Name},
+		NoneTagNames: []string{tag3.Tag.Name, "unknown"},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalPicIds(task.UnfilteredPics, p2, p1) {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want", p2.Pic.PicId, p1.Pic.PicId)
+	}
+}
+
+func TestFindPicsByTagsTask_AllAndAny(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	tag1, tag2, tag3 := c.CreateTag(), c.CreateTag(), c.CreateTag()
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.CreatePicTag(p1, tag1)
+	c.CreatePicTag(p1, tag2)
+	c.CreatePicTag(p2, tag1)
+	c.CreatePicTag(p3, tag3)
+
+	task := &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{tag1.Tag.Name},
+		AnyTagNames: []string{tag2.Tag.Name, tag3.Tag.Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalPicIds(task.UnfilteredPics, p1) {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want", p1.Pic.PicId)
+	}
+}
+
+func TestFindPicsByTagsTask_UnknownAllTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	tag := c.CreateTag()
+	p := c.CreatePic()
+	c.CreatePicTag(p, tag)
+
+	task := &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{tag.Tag.Name, "unknown"},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if len(task.UnfilteredPics) != 0 {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want none")
+	}
+}
+
+func TestFindPicsByTagsTask_Paging(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	tag := c.CreateTag()
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.CreatePicTag(p1, tag)
+	c.CreatePicTag(p2, tag)
+	c.CreatePicTag(p3, tag)
+
+	task := &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{tag.Tag.Name},
+		MaxPics:     2,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalPicIds(task.UnfilteredPics, p3, p2) {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want", p3.Pic.PicId, p2.Pic.PicId)
+	}
+	if have, want := task.NextId, p1.Pic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	task = &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{tag.Tag.Name},
+		MaxPics:     2,
+		StartId:     p1.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalPicIds(task.UnfilteredPics, p1) {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want", p1.Pic.PicId)
+	}
+	if task.NextId != 0 {
+		t.Error("have", task.NextId, "want", 0)
+	}
+}
+
+func TestFindPicsByTagsTask_SkipsHardDeleted(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	tag := c.CreateTag()
+	p1, p2, p3 := c.CreatePic(), c.CreatePic(), c.CreatePic()
+	c.CreatePicTag(p1, tag)
+	c.CreatePicTag(p2, tag)
+	c.CreatePicTag(p3, tag)
+
+	nowTs := schema.ToTspb(time.Now())
+	p2.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: nowTs,
+	}
+	p2.Update()
+	p3.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs:  nowTs,
+		PendingDeletedTs: nowTs,
+		ActualDeletedTs:  nowTs,
+	}
+	p3.Update()
+
+	task := &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{tag.Tag.Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalPicIds(task.UnfilteredPics, p2, p1) {
+		t.Error("have", picIdsOf(task.UnfilteredPics), "want", p2.Pic.PicId, p1.Pic.PicId)
+	}
+}
+
+func TestFindPicsByTagsTask_NoTags(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &FindPicsByTagsTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		NoneTagNames: []string{"foo"},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindPicsByTagsTask_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &FindPicsByTagsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		AllTagNames: []string{"foo"},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,52 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// FindRolesTask finds every role, ordered by name.
+type FindRolesTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Outputs
+	Roles []*schema.Role
+}
+
+func (t *FindRolesTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, su, conf, schema.User_USER_READ_ALL); sts != nil {
+		return sts
+	}
+
+	rs, err := j.FindRoles(db.Opts{
+		Prefix: tab.RolesName{},
+		Lock:   db.LockNone,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find roles")
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	t.Roles = rs
+	return nil
+}
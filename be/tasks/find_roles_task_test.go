@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindRolesTask(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_READ_ALL)
+	u.Update()
+	r1 := c.CreateRole("b")
+	r2 := c.CreateRole("a")
+
+	task := &FindRolesTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if len(task.Roles) != 2 {
+		t.Fatal("wrong roles", task.Roles)
+	}
+	if have, want := task.Roles[0].RoleId, r2.RoleId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := task.Roles[1].RoleId, r1.RoleId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindRolesTask_missingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &FindRolesTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -37,6 +37,10 @@ func (t *FindSchedPicsTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
 
 	ou, sts := lookupObjectUser(ctx, j, db.LockNone, t.ObjectUserId, su)
 	if sts != nil {
@@ -52,7 +56,7 @@ func (t *FindSchedPicsTask) Run(ctx context.Context) (stscap status.S) {
 		cs.Add(schema.User_USER_READ_ALL)
 	}
 
-	if sts := validateCapSet(su, conf, cs); sts != nil {
+	if sts := hasCapSet(ru, conf, cs); sts != nil {
 		return sts
 	}
 
@@ -99,7 +103,7 @@ func (t *FindSchedPicsTask) Run(ctx context.Context) (stscap status.S) {
 			}
 		}
 	}
-	t.Pics = filterPics(t.UnfilteredPics, su, conf)
+	t.Pics = filterPics(t.UnfilteredPics, ru, conf)
 
 	return nil
 }
@@ -2,27 +2,45 @@ package tasks
 
 import (
 	"context"
-	"encoding/binary"
 	"time"
 
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
 	"pixur.org/pixur/be/status"
 )
 
-// TODO: add tests
+const (
+	// DefaultSimilarPicsRadius is the Hamming distance used if no radius is requested.
+	DefaultSimilarPicsRadius = 10
+	// DefaultSimilarPicsLimit is the number of results returned if no limit is requested.
+	DefaultSimilarPicsLimit = 100
+	// MaxSimilarPicsLimit is the most results that can be returned at once.
+	MaxSimilarPicsLimit = 1000
+	// DefaultLoadSimilarityBatchSize is how many pics LoadSimilarityIndexTask reads at once.
+	DefaultLoadSimilarityBatchSize = 1000
+)
 
 type FindSimilarPicsTask struct {
 	// Deps
 	Beg tab.JobBeginner
 	Now func() time.Time
+	// If absent, all perceptual hashes are scanned.
+	Index *similarity.Index
 
 	// Inputs
 	PicId int64
+	// Radius is the max Hamming distance between perceptual hashes.  If zero,
+	// DefaultSimilarPicsRadius is used.
+	Radius int
+	// Limit is the max number of results.  If zero, DefaultSimilarPicsLimit is used.
+	Limit int
 
 	// Results
 	SimilarPicIds []int64
+	// Distances is parallel to SimilarPicIds.
+	Distances []int
 }
 
 func (t *FindSimilarPicsTask) Run(ctx context.Context) (stscap status.S) {
@@ -37,9 +55,25 @@ func (t *FindSimilarPicsTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_INDEX); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_INDEX)
+	if sts != nil {
 		return sts
 	}
+	uc := userCredOf(ru, conf)
+
+	radius, limit := t.Radius, t.Limit
+	if radius == 0 {
+		radius = DefaultSimilarPicsRadius
+	}
+	if radius < 0 || radius > similarity.MaxRadius {
+		return status.InvalidArgument(nil, "bad radius", radius)
+	}
+	if limit == 0 {
+		limit = DefaultSimilarPicsLimit
+	}
+	if limit < 0 || limit > MaxSimilarPicsLimit {
+		return status.InvalidArgument(nil, "bad limit", limit)
+	}
 
 	pics, err := j.FindPics(db.Opts{
 		Prefix: tab.PicsPrimary{&t.PicId},
@@ -48,61 +82,186 @@ func (t *FindSimilarPicsTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't lookup pic")
 	}
-	if len(pics) != 1 {
+	if len(pics) != 1 || !picVisible(pics[0], uc) {
 		return status.NotFound(nil, "can't lookup pic", len(pics))
 	}
 	pic := pics[0]
 
-	dctIdentType := schema.PicIdent_DCT_0
-
-	picIdents, err := j.FindPicIdents(db.Opts{
-		Prefix: tab.PicIdentsPrimary{PicId: &t.PicId, Type: &dctIdentType},
-		Limit:  1,
-	})
-	if err != nil {
-		return status.Internal(err, "can't lookup pic ident")
+	value, sts := findPerceptualHash(j, pic.PicId)
+	if sts != nil {
+		return sts
+	}
+	if value == nil {
+		return status.InvalidArgument(nil, "can't lookup pic ident")
 	}
-	if len(picIdents) != 1 {
-		return status.InvalidArgument(nil, "can't lookup pic ident", len(picIdents))
+	match, sts := similarity.Hash(value)
+	if sts != nil {
+		return sts
 	}
-	targetIdent := picIdents[0]
-	match := binary.BigEndian.Uint64(targetIdent.Value)
 
-	scanOpts := db.Opts{
-		StartInc: tab.PicIdentsIdent{Type: &dctIdentType},
+	matches, sts := findSimilarPics(j, t.Index, match, radius, limit, pic.PicId, uc)
+	if sts != nil {
+		return sts
 	}
 	var similarPicIds []int64
+	var distances []int
+	for _, m := range matches {
+		similarPicIds = append(similarPicIds, m.PicId)
+		distances = append(distances, m.Distance)
+	}
 
-	err = j.ScanPicIdents(scanOpts, func(pi *schema.PicIdent) error {
-		if pi.PicId == pic.PicId {
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+	// Only set results on success
+	t.SimilarPicIds = similarPicIds
+	t.Distances = distances
+
+	return nil
+}
+
+// findSimilarPics finds pics that are not hard deleted within radius of hash, excluding the pic
+// with id exclude and the pics uc can't see.  If idx is nil, all perceptual hashes are scanned.
+func findSimilarPics(j *tab.Job, idx *similarity.Index, hash uint64, radius, limit int,
+	exclude int64, uc *userCred) ([]similarity.Match, status.S) {
+	if idx == nil {
+		idx = similarity.NewIndex()
+		dctIdentType := schema.PicIdent_DCT_0
+		err := j.ScanPicIdents(db.Opts{
+			Prefix: tab.PicIdentsIdent{Type: &dctIdentType},
+		}, func(pi *schema.PicIdent) error {
+			hash, sts := similarity.Hash(pi.Value)
+			if sts != nil {
+				return sts
+			}
+			idx.Add(pi.PicId, hash)
 			return nil
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't scan pic idents")
 		}
-		guess := binary.BigEndian.Uint64(pi.Value)
-		bits := guess ^ match
-		bitCount := 0
-		// replace this with something that isn't hideously slow.  Hamming distance would be
-		// better served by a look up table or some 64 bit specific bit magic.  Cosine similarity
-		// on the attached floats would also work.
-		for i := uint(0); i < 64; i++ {
-			if ((1 << i) & bits) > 0 {
-				bitCount++
-			}
+	}
+
+	var matches []similarity.Match
+	// The index isn't aware of pics hard deleted by other processes, so each match is checked.
+	for _, m := range idx.Search(hash, radius, 0) {
+		if len(matches) == limit {
+			break
+		}
+		if m.PicId == exclude {
+			continue
+		}
+		pics, err := j.FindPics(db.Opts{
+			Prefix: tab.PicsPrimary{&m.PicId},
+			Limit:  1,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't lookup pic")
+		}
+		if len(pics) != 1 || pics[0].HardDeleted() {
+			idx.Remove(m.PicId)
+			continue
 		}
-		if bitCount <= 10 {
-			similarPicIds = append(similarPicIds, pi.PicId)
+		if !picVisible(pics[0], uc) {
+			continue
 		}
+		matches = append(matches, m)
+	}
+	return matches, nil
+}
 
-		return nil
-	})
+// LoadSimilarityIndexTask adds the perceptual hashes of a batch of pics that are not hard deleted
+// to an index.  Each batch is read in its own transaction.  To read every pic, run it again with
+// the Next fields of the previous batch until Done is set.
+type LoadSimilarityIndexTask struct {
+	// Deps
+	Beg   tab.JobBeginner
+	Index *similarity.Index
 
+	// Inputs
+	// If set, only pics created at or after this time are read, oldest first, so that the pics
+	// uploaded since an earlier load can be added.  Otherwise, pics are read in id order.
+	CreatedSince time.Time
+	// Only pics after this one are read.  StartIndexOrder is only used with CreatedSince.
+	StartPicId      int64
+	StartIndexOrder int64
+	// How many pics to read.  Defaults to DefaultLoadSimilarityBatchSize.
+	BatchSize int
+
+	// Outputs
+	// The number of hashes added, including ones that were already in the index.
+	Added int
+	// The last pic read, to be used as the start of the next batch.
+	NextPicId      int64
+	NextIndexOrder int64
+	// Set if there are no more pics to read.
+	Done bool
+}
+
+func (t *LoadSimilarityIndexTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.Added, t.NextPicId, t.NextIndexOrder, t.Done = 0, 0, 0, false
+	batchSize := t.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultLoadSimilarityBatchSize
+	}
+
+	j, err := tab.NewJob(ctx, t.Beg)
 	if err != nil {
-		return status.Internal(err, "can't scan pic idents")
+		return status.Internal(err, "can't create job")
 	}
+	defer revert(j, &stscap)
+
+	opts := db.Opts{
+		StartEx: tab.PicsPrimary{&t.StartPicId},
+		Limit:   batchSize,
+		Lock:    db.LockNone,
+	}
+	if !t.CreatedSince.IsZero() {
+		startIndexOrder, startPicId := t.StartIndexOrder, t.StartPicId
+		if since := t.CreatedSince.UnixNano(); startIndexOrder < since {
+			startIndexOrder, startPicId = since, 0
+		}
+		opts.StartEx = tab.PicsIndexOrder{
+			IndexOrder: &startIndexOrder,
+			Id:         &startPicId,
+		}
+	}
+	pics, err := j.FindPics(opts)
+	if err != nil {
+		return status.Internal(err, "can't find pics")
+	}
+	for _, p := range pics {
+		// Hard deleted pics keep their idents, so they are skipped here.
+		if p.HardDeleted() {
+			t.Index.Remove(p.PicId)
+			continue
+		}
+		value, sts := findPerceptualHash(j, p.PicId)
+		if sts != nil {
+			return sts
+		}
+		if value == nil {
+			continue
+		}
+		hash, sts := similarity.Hash(value)
+		if sts != nil {
+			return sts
+		}
+		t.Index.Add(p.PicId, hash)
+		t.Added++
+	}
+
 	if err := j.Rollback(); err != nil {
 		return status.Internal(err, "can't rollback job")
 	}
-	// Only set results on success
-	t.SimilarPicIds = similarPicIds
 
+	if len(pics) > 0 {
+		last := pics[len(pics)-1]
+		t.NextPicId, t.NextIndexOrder = last.PicId, last.IndexOrder()
+	} else {
+		t.NextPicId, t.NextIndexOrder = t.StartPicId, t.StartIndexOrder
+	}
+	t.Done = len(pics) < batchSize
 	return nil
 }
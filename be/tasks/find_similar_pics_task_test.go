@@ -0,0 +1,300 @@
+package tasks
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
+)
+
+func (c *TestContainer) createPicWithDct0(hash uint64) *TestPic {
+	p := c.CreatePic()
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, hash)
+	c.AutoJob(func(j *tab.Job) error {
+		return j.InsertPicIdent(&schema.PicIdent{
+			PicId: p.Pic.PicId,
+			Type:  schema.PicIdent_DCT_0,
+			Value: value,
+		})
+	})
+	return p
+}
+
+func hardDeletePic(p *TestPic) {
+	nowTs := schema.ToTspb(time.Now())
+	p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs:  nowTs,
+		PendingDeletedTs: nowTs,
+		ActualDeletedTs:  nowTs,
+	}
+	p.Update()
+}
+
+func TestFindSimilarPics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	p1 := c.createPicWithDct0(0x0)
+	p2 := c.createPicWithDct0(0x7)
+	p3 := c.createPicWithDct0(0x1)
+	c.createPicWithDct0(0xffffff)
+	p5 := c.createPicWithDct0(0x3)
+	hardDeletePic(p5)
+
+	for _, idx := range []*similarity.Index{nil, similarity.NewIndex()} {
+		if idx != nil {
+			if sts := new(TaskRunner).Run(c.Ctx, &LoadSimilarityIndexTask{
+				Beg:   c.DB(),
+				Index: idx,
+			}); sts != nil {
+				t.Fatal(sts)
+			}
+			if have, want := idx.Len(), 4; have != want {
+				t.Error("have", have, "want", want)
+			}
+		}
+
+		task := &FindSimilarPicsTask{
+			Beg:   c.DB(),
+			Now:   time.Now,
+			Index: idx,
+			PicId: p1.Pic.PicId,
+		}
+		if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+			t.Fatal(sts)
+		}
+		if have, want := task.SimilarPicIds, []int64{p3.Pic.PicId, p2.Pic.PicId}; !reflect.DeepEqual(have, want) {
+			t.Error("have", have, "want", want)
+		}
+		if have, want := task.Distances, []int{1, 3}; !reflect.DeepEqual(have, want) {
+			t.Error("have", have, "want", want)
+		}
+	}
+}
+
+func TestFindSimilarPics_omitsPrivatePics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	p1 := c.createPicWithDct0(0x0)
+	p2 := c.createPicWithDct0(0x1)
+	p3 := c.createPicWithDct0(0x3)
+	for _, p := range []*TestPic{p1, p3} {
+		p.Pic.Visibility = &schema.Pic_Visibility{
+			Mode: schema.Pic_Visibility_PRIVATE,
+		}
+		p.Update()
+	}
+
+	task := &FindSimilarPicsTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p2.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.SimilarPicIds) != 0 {
+		t.Error("have", task.SimilarPicIds, "want none")
+	}
+
+	task = &FindSimilarPicsTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p1.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.NotFound {
+		t.Error("have", sts, "want", codes.NotFound)
+	}
+}
+
+func TestFindSimilarPics_RadiusAndLimit(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	p1 := c.createPicWithDct0(0x0)
+	c.createPicWithDct0(0x7)
+	p3 := c.createPicWithDct0(0x1)
+	p4 := c.createPicWithDct0(0xffffff)
+
+	task := &FindSimilarPicsTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PicId:  p1.Pic.PicId,
+		Radius: 2,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := task.SimilarPicIds, []int64{p3.Pic.PicId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+
+	task = &FindSimilarPicsTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PicId:  p4.Pic.PicId,
+		Radius: similarity.MaxRadius,
+		Limit:  1,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := task.Distances, []int{21}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindSimilarPicsFailsOnBadRadius(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	p := c.createPicWithDct0(0x0)
+
+	task := &FindSimilarPicsTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		PicId:  p.Pic.PicId,
+		Radius: similarity.MaxRadius + 1,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.InvalidArgument {
+		t.Error("have", sts, "want", codes.InvalidArgument)
+	}
+}
+
+func TestFindSimilarPicsDropsHardDeletedFromIndex(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	p1 := c.createPicWithDct0(0x0)
+	p2 := c.createPicWithDct0(0x1)
+	idx := similarity.NewIndex()
+	idx.Add(p1.Pic.PicId, 0x0)
+	idx.Add(p2.Pic.PicId, 0x1)
+	// Deleted elsewhere, so the index is stale.
+	hardDeletePic(p2)
+
+	task := &FindSimilarPicsTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		Index: idx,
+		PicId: p1.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if len(task.SimilarPicIds) != 0 {
+		t.Error("have", task.SimilarPicIds, "want none")
+	}
+	if have, want := idx.Len(), 1; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLoadSimilarityIndexBatches(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p1 := c.createPicWithDct0(0x1)
+	p2 := c.createPicWithDct0(0x2)
+	hardDeletePic(p2)
+	c.CreatePic()
+	p4 := c.createPicWithDct0(0x4)
+
+	idx := similarity.NewIndex()
+	var startPicId int64
+	var batches int
+	for {
+		task := &LoadSimilarityIndexTask{
+			Beg:        c.DB(),
+			Index:      idx,
+			StartPicId: startPicId,
+			BatchSize:  2,
+		}
+		if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+			t.Fatal(sts)
+		}
+		batches++
+		if task.Done {
+			break
+		}
+		startPicId = task.NextPicId
+	}
+	if have, want := batches, 3; have != want {
+		t.Error("have", have, "want", want)
+	}
+	var found []int64
+	for _, m := range idx.Search(0, similarity.MaxRadius, 0) {
+		found = append(found, m.PicId)
+	}
+	if have, want := found, []int64{p1.Pic.PicId, p4.Pic.PicId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLoadSimilarityIndexCreatedSince(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	old := c.createPicWithDct0(0x1)
+	old.Pic.SetCreatedTime(now.Add(-time.Hour))
+	old.Update()
+	recent := c.createPicWithDct0(0x2)
+
+	idx := similarity.NewIndex()
+	task := &LoadSimilarityIndexTask{
+		Beg:          c.DB(),
+		Index:        idx,
+		CreatedSince: now.Add(-time.Minute),
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if !task.Done {
+		t.Error("expected done")
+	}
+	matches := idx.Search(0, similarity.MaxRadius, 0)
+	if len(matches) != 1 || matches[0].PicId != recent.Pic.PicId {
+		t.Error("wrong matches", matches)
+	}
+
+	// The next batch starts after the last pic read.
+	task.StartPicId, task.StartIndexOrder = task.NextPicId, task.NextIndexOrder
+	task.Index = similarity.NewIndex()
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := task.Index.Len(), 0; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,187 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+// findTagsMaxScan is the most tags read when matching a prefix.  Only these are ranked by usage
+// count.
+var findTagsMaxScan = 2000
+
+// FindTagsTask finds tags, ordered by descending usage count.
+type FindTagsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// Prefix is the start of the tag names to find.  It is normalized the same way tag names are.
+	Prefix string
+	// All lists every tag, and ignores Prefix.
+	All bool
+	// If set, only get tags at or after this usage count and tag id.
+	StartUsageCount, StartTagId int64
+	// MaxTags is the maximum number of tags to return.  If unset, a default is used.
+	MaxTags int64
+
+	// Results
+	Tags                      []*schema.Tag
+	NextUsageCount, NextTagId int64
+}
+
+func (t *FindTagsTask) Run(ctx context.Context) (stscap status.S) {
+	var uniqPrefix string
+	if !t.All {
+		var err error
+		uniqPrefix, err = text.ToCaselessNFKC(strings.TrimSpace(t.Prefix), "tag prefix")
+		if err != nil {
+			return status.From(err)
+		}
+		if uniqPrefix == "" {
+			return status.InvalidArgument(nil, "missing tag prefix")
+		}
+	}
+
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, u, conf, schema.User_PIC_INDEX); sts != nil {
+		return sts
+	}
+
+	_, overmax, sts := getAndValidateMaxTags(conf, t.MaxTags)
+	if sts != nil {
+		return sts
+	}
+
+	maxUsageCount, maxTagId := int64(math.MaxInt64), int64(math.MaxInt64)
+	if t.StartUsageCount != 0 || t.StartTagId != 0 {
+		maxUsageCount, maxTagId = t.StartUsageCount, t.StartTagId
+	}
+
+	var tags []*schema.Tag
+	if t.All {
+		minUsageCount, minTagId := int64(math.MinInt64), int64(0)
+		ts, err := j.FindTags(db.Opts{
+			Limit: int(overmax),
+			Lock:  db.LockNone,
+			StartInc: tab.TagsUsageCount{
+				UsageCount: &minUsageCount,
+				Id:         &minTagId,
+			},
+			StopInc: tab.TagsUsageCount{
+				UsageCount: &maxUsageCount,
+				Id:         &maxTagId,
+			},
+			Reverse: true,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find tags")
+		}
+		tags = ts
+	} else {
+		ts, sts := findTagsByPrefix(j, uniqPrefix, maxUsageCount, maxTagId, overmax)
+		if sts != nil {
+			return sts
+		}
+		tags = ts
+	}
+
+	if n := len(tags); n > 0 && int64(n) == overmax {
+		t.Tags = tags[:n-1]
+		t.NextUsageCount, t.NextTagId = tags[n-1].UsageCount, tags[n-1].TagId
+	} else {
+		t.Tags = tags
+	}
+
+	return nil
+}
+
+// findTagsByPrefix finds up to max tags whose unique name starts with uniqPrefix, in descending
+// usage count order, starting at maxUsageCount and maxTagId.  The matching tags are read in name
+// order, and at most findTagsMaxScan of them are ranked, so a very common prefix only sees the
+// tags that come first by name.
+func findTagsByPrefix(j *tab.Job, uniqPrefix string, maxUsageCount, maxTagId, max int64) (
+	[]*schema.Tag, status.S) {
+	opts := db.Opts{
+		Lock:     db.LockNone,
+		Limit:    findTagsMaxScan,
+		StartInc: tab.TagsName{&uniqPrefix},
+	}
+	if stop, ok := prefixSuccessor(uniqPrefix); ok {
+		opts.StopEx = tab.TagsName{&stop}
+	}
+	ts, err := j.FindTags(opts)
+	if err != nil {
+		return nil, status.Internal(err, "can't find tags")
+	}
+	var tags []*schema.Tag
+	for _, tag := range ts {
+		if tag.UsageCount > maxUsageCount ||
+			(tag.UsageCount == maxUsageCount && tag.TagId > maxTagId) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, k int) bool {
+		if tags[i].UsageCount != tags[k].UsageCount {
+			return tags[i].UsageCount > tags[k].UsageCount
+		}
+		return tags[i].TagId > tags[k].TagId
+	})
+	if int64(len(tags)) > max {
+		tags = tags[:max]
+	}
+	return tags, nil
+}
+
+// prefixSuccessor returns the smallest string greater than every string starting with prefix, by
+// incrementing its last rune.  It returns false if there is no such string.
+func prefixSuccessor(prefix string) (string, bool) {
+	for prefix != "" {
+		r, size := utf8.DecodeLastRuneInString(prefix)
+		prefix = prefix[:len(prefix)-size]
+		switch {
+		case r == utf8.MaxRune:
+			continue
+		case r+1 == 0xD800:
+			// Surrogates can't be encoded.
+			return prefix + string(rune(0xE000)), true
+		default:
+			return prefix + string(r+1), true
+		}
+	}
+	return "", false
+}
+
+func getAndValidateMaxTags(conf *schema.Configuration, requestedMax int64) (
+	max, overmax int64, _ status.S) {
+	if requestedMax < 0 {
+		return 0, 0, status.InvalidArgument(nil, "negative max tags")
+	}
+	maxTags, overMaxTags := getMaxTags(requestedMax, conf)
+	return maxTags, overMaxTags, nil
+}
+
+func getMaxTags(requestedMax int64, conf *schema.Configuration) (max, overmax int64) {
+	return getMaxConf(requestedMax, conf.DefaultFindTags, conf.MaxFindTags)
+}
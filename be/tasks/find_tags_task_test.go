@@ -0,0 +1,267 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func tagIdsOf(ts []*schema.Tag) []int64 {
+	var ids []int64
+	for _, t := range ts {
+		ids = append(ids, t.TagId)
+	}
+	return ids
+}
+
+func equalTagIds(have []*schema.Tag, want ...*TestTag) bool {
+	if len(have) != len(want) {
+		return false
+	}
+	for i := range have {
+		if have[i].TagId != want[i].Tag.TagId {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *TestContainer) createNamedTag(name string, usageCount int64) *TestTag {
+	t := c.CreateTag()
+	t.Tag.Name = name
+	t.Tag.UsageCount = usageCount
+	t.Update()
+	return t
+}
+
+func TestFindTagsTask_Prefix(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	t1 := c.createNamedTag("Cat", 1)
+	t2 := c.createNamedTag("catapult", 5)
+	t3 := c.createNamedTag("Category", 3)
+	c.createNamedTag("dog", 10)
+	c.createNamedTag("ca", 10)
+
+	task := &FindTagsTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Prefix: " CAT",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalTagIds(task.Tags, t2, t3, t1) {
+		t.Error("have", tagIdsOf(task.Tags), "want", t2.Tag.TagId, t3.Tag.TagId, t1.Tag.TagId)
+	}
+	if task.NextTagId != 0 || task.NextUsageCount != 0 {
+		t.Error("have", task.NextUsageCount, task.NextTagId, "want", 0, 0)
+	}
+}
+
+func TestFindTagsTask_PrefixPaging(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	t1 := c.createNamedTag("cat", 1)
+	t2 := c.createNamedTag("catapult", 5)
+	t3 := c.createNamedTag("category", 3)
+
+	task := &FindTagsTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Prefix:  "cat",
+		MaxTags: 2,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalTagIds(task.Tags, t2, t3) {
+		t.Error("have", tagIdsOf(task.Tags), "want", t2.Tag.TagId, t3.Tag.TagId)
+	}
+	if task.NextUsageCount != 1 || task.NextTagId != t1.Tag.TagId {
+		t.Error("have", task.NextUsageCount, task.NextTagId, "want", 1, t1.Tag.TagId)
+	}
+
+	task = &FindTagsTask{
+		Beg:             c.DB(),
+		Now:             time.Now,
+		Prefix:          "cat",
+		MaxTags:         2,
+		StartUsageCount: task.NextUsageCount,
+		StartTagId:      task.NextTagId,
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalTagIds(task.Tags, t1) {
+		t.Error("have", tagIdsOf(task.Tags), "want", t1.Tag.TagId)
+	}
+}
+
+func TestFindTagsTask_PrefixScanLimit(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	// Tags outside the prefix don't count against the scan limit.
+	c.createNamedTag("ant", 10)
+	t1 := c.createNamedTag("cat", 1)
+	t2 := c.createNamedTag("catapult", 5)
+	c.createNamedTag("category", 7)
+	c.createNamedTag("dog", 10)
+
+	defer func(old int) {
+		findTagsMaxScan = old
+	}(findTagsMaxScan)
+	findTagsMaxScan = 2
+
+	task := &FindTagsTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Prefix: "cat",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	// Only the first tags by name are ranked.
+	if !equalTagIds(task.Tags, t2, t1) {
+		t.Error("have", tagIdsOf(task.Tags), "want", t2.Tag.TagId, t1.Tag.TagId)
+	}
+	if task.NextTagId != 0 || task.NextUsageCount != 0 {
+		t.Error("have", task.NextUsageCount, task.NextTagId, "want", 0, 0)
+	}
+}
+
+func TestPrefixSuccessor(t *testing.T) {
+	cases := []struct {
+		prefix, want string
+		ok           bool
+	}{
+		{"cat", "cau", true},
+		{"caz", "ca{", true},
+		{"a\U0010FFFF", "b", true},
+		{"\U0010FFFF", "", false},
+		{"a\uD7FF", "a\uE000", true},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		have, ok := prefixSuccessor(c.prefix)
+		if have != c.want || ok != c.ok {
+			t.Errorf("%q: have %q %v want %q %v", c.prefix, have, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestFindTagsTask_All(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	t1 := c.createNamedTag("a", 2)
+	t2 := c.createNamedTag("b", 7)
+	t3 := c.createNamedTag("c", 2)
+
+	task := &FindTagsTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		All:     true,
+		MaxTags: 2,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalTagIds(task.Tags, t2, t3) {
+		t.Error("have", tagIdsOf(task.Tags), "want", t2.Tag.TagId, t3.Tag.TagId)
+	}
+	if task.NextUsageCount != 2 || task.NextTagId != t1.Tag.TagId {
+		t.Error("have", task.NextUsageCount, task.NextTagId, "want", 2, t1.Tag.TagId)
+	}
+
+	task = &FindTagsTask{
+		Beg:             c.DB(),
+		Now:             time.Now,
+		All:             true,
+		MaxTags:         2,
+		StartUsageCount: task.NextUsageCount,
+		StartTagId:      task.NextTagId,
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !equalTagIds(task.Tags, t1) {
+		t.Error("have", tagIdsOf(task.Tags), "want", t1.Tag.TagId)
+	}
+}
+
+func TestFindTagsTask_MissingPrefix(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &FindTagsTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		Prefix: " ",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindTagsTask_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &FindTagsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+		All: true,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// FindUserApiKeysTask finds the API keys of a user, oldest first.
+type FindUserApiKeysTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// If zero, the keys of the current user are found.
+	ObjectUserId int64
+
+	// Outputs
+	ApiKeys []*schema.UserApiKey
+}
+
+func (t *FindUserApiKeysTask) Run(ctx context.Context) (stscap status.S) {
+	j, su, sts := authedJob(ctx, t.Beg, t.Now())
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	ou, sts := lookupObjectUser(ctx, j, db.LockNone, t.ObjectUserId, su)
+	if sts != nil {
+		return sts
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
+
+	uc := userCredOf(ru, conf)
+	switch {
+	case uc.cs.Has(schema.User_USER_READ_ALL):
+	case uc.subjectUserId == ou.UserId && uc.cs.Has(schema.User_USER_READ_SELF):
+	default:
+		return status.PermissionDenied(nil, "missing capability")
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback")
+	}
+	t.ApiKeys = ou.ApiKey
+	return nil
+}
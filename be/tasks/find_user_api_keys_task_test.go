@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindUserApiKeysSelf(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_READ_SELF)
+	u.User.NextApiKeyId = 1
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{KeyId: 1, Name: "backups"})
+	u.Update()
+
+	task := &FindUserApiKeysTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if len(task.ApiKeys) != 1 || task.ApiKeys[0].Name != "backups" {
+		t.Error("wrong keys", task.ApiKeys)
+	}
+}
+
+func TestFindUserApiKeysCantFindOther(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u2 := c.CreateUser()
+	u2.User.Capability = append(u2.User.Capability, schema.User_USER_READ_SELF)
+	u2.Update()
+
+	task := &FindUserApiKeysTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+	}
+
+	ctx := u2.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
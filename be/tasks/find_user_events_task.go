@@ -44,6 +44,10 @@ func (t *FindUserEventsTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
 
 	minCreatedTs := int64(math.MinInt64)
 	minIndex := int64(0)
@@ -91,7 +95,7 @@ func (t *FindUserEventsTask) Run(ctx context.Context) (stscap status.S) {
 		if err != nil {
 			return status.Internal(err, "can't find user events")
 		}
-		ues = append(ues, filterUserEvents(events, su, conf)...)
+		ues = append(ues, filterUserEvents(events, ru, conf)...)
 		if len(ues) >= int(overmax) {
 			break
 		}
@@ -211,6 +215,12 @@ loop:
 				default:
 					continue loop
 				}
+			case *schema.UserEvent_RemovePicTags_:
+				switch {
+				case uc.cs.Has(schema.User_USER_READ_PUBLIC) && uc.cs.Has(schema.User_USER_READ_PIC_TAG):
+				default:
+					continue loop
+				}
 			default:
 				continue loop
 			}
@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// FindUserTokensTask finds the unexpired auth tokens of a user, most recently seen first.
+type FindUserTokensTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// If zero, the tokens of the current user are found.
+	ObjectUserId int64
+
+	// Outputs
+	UserTokens []*schema.UserToken
+}
+
+func (t *FindUserTokensTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if sts := checkNotApiKey(ctx, "find tokens"); sts != nil {
+		return sts
+	}
+	ou, sts := lookupObjectUser(ctx, j, db.LockNone, t.ObjectUserId, su)
+	if sts != nil {
+		return sts
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
+
+	uc := userCredOf(ru, conf)
+	switch {
+	case uc.cs.Has(schema.User_USER_READ_ALL):
+	case uc.subjectUserId == ou.UserId && uc.cs.Has(schema.User_USER_READ_SELF):
+	default:
+		return status.PermissionDenied(nil, "missing capability")
+	}
+
+	ttl, sts := confUserTokenTtl(conf)
+	if sts != nil {
+		return sts
+	}
+	var uts []*schema.UserToken
+	for _, ut := range ou.UserToken {
+		if !userTokenExpired(ut, ttl, now) {
+			uts = append(uts, ut)
+		}
+	}
+	sort.Sort(sort.Reverse(userTokens(uts)))
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback")
+	}
+	t.UserTokens = uts
+	return nil
+}
@@ -0,0 +1,136 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestFindUserTokensSelf(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	now := time.Now()
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_READ_SELF)
+	u.User.NextTokenId = 3
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		CreatedTs:  schema.ToTspb(now.Add(-time.Hour)),
+		LastSeenTs: schema.ToTspb(now.Add(time.Hour)),
+		UserAgent:  "Mozilla/5.0",
+	}, &schema.UserToken{
+		TokenId:    3,
+		CreatedTs:  schema.ToTspb(now.AddDate(-1, 0, 0)),
+		LastSeenTs: schema.ToTspb(now.AddDate(-1, 0, 0)),
+	})
+	u.Update()
+
+	task := &FindUserTokensTask{
+		Beg: c.DB(),
+		Now: func() time.Time { return now },
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	// The expired token is left out, and the most recently seen comes first.
+	if len(task.UserTokens) != 2 {
+		t.Fatal("wrong tokens", task.UserTokens)
+	}
+	if have, want := task.UserTokens[0].TokenId, int64(2); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := task.UserTokens[0].UserAgent, "Mozilla/5.0"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := task.UserTokens[1].TokenId, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindUserTokensOther(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u2 := c.CreateUser()
+	u2.User.Capability = append(u2.User.Capability, schema.User_USER_READ_ALL)
+	u2.Update()
+
+	task := &FindUserTokensTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+	}
+
+	ctx := u2.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if len(task.UserTokens) != 1 || task.UserTokens[0].TokenId != u1.User.UserToken[0].TokenId {
+		t.Error("wrong tokens", task.UserTokens)
+	}
+}
+
+func TestFindUserTokensCantFindOther(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u2 := c.CreateUser()
+	u2.User.Capability = append(u2.User.Capability, schema.User_USER_READ_SELF)
+	u2.Update()
+
+	task := &FindUserTokensTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+	}
+
+	ctx := u2.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestFindUserTokensFailsWithApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_READ_SELF)
+	u.User.NextApiKeyId = 1
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{
+		KeyId:      1,
+		Capability: []schema.User_Capability{schema.User_USER_READ_SELF},
+	})
+	u.Update()
+
+	task := &FindUserTokensTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+
+	ctx := CtxFromApiKey(c.Ctx, u.User.UserId, 1, "")
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if len(task.UserTokens) != 0 {
+		t.Error("expected no tokens", task.UserTokens)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
 	"pixur.org/pixur/be/status"
 )
 
@@ -14,10 +16,11 @@ var _ Task = &HardDeletePicTask{}
 
 type HardDeletePicTask struct {
 	// deps
-	Beg     tab.JobBeginner
-	PixPath string
-	Remove  func(name string) error
-	Now     func() time.Time
+	Beg       tab.JobBeginner
+	BlobStore blobstore.BlobStore
+	Now       func() time.Time
+	// If present, the pic is removed from the index.
+	SimilarityIndex *similarity.Index
 
 	// input
 	PicId int64
@@ -35,7 +38,7 @@ func (t *HardDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_HARD_DELETE); sts != nil {
+	if sts := validateCapability(j, u, conf, schema.User_PIC_HARD_DELETE); sts != nil {
 		return sts
 	}
 
@@ -52,6 +55,12 @@ func (t *HardDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	}
 	p := pics[0]
 
+	return commitHardDelete(ctx, j, t.BlobStore, t.SimilarityIndex, p, now)
+}
+
+// commitHardDelete marks p as hard deleted, commits j, and then releases the pic files.
+func commitHardDelete(ctx context.Context, j *tab.Job, blobs blobstore.BlobStore,
+	idx *similarity.Index, p *schema.Pic, now time.Time) (stscap status.S) {
 	nowpb := schema.ToTspb(now)
 
 	if p.DeletionStatus == nil {
@@ -79,22 +88,22 @@ func (t *HardDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "can't commit job")
 	}
+	if idx != nil {
+		idx.Remove(p.PicId)
+	}
 
 	// At this point we actually release the file and thumbnail.  It would be better to remove
 	// these after the commit, since a cron job can clean up refs after the fact.
-	oldpath, sts := schema.PicFilePath(t.PixPath, p.PicId, p.File.Mime)
-	if sts != nil {
-		defer status.ReplaceOrSuppress(&stscap, sts)
-	} else if err := t.Remove(oldpath); err != nil {
-		defer status.ReplaceOrSuppress(&stscap, status.DataLoss(err, "unable to delete pic data", oldpath))
+	key := blobstore.PicFileKey(p.PicId, p.File.Mime)
+	if sts := blobs.Delete(ctx, key); sts != nil {
+		defer status.ReplaceOrSuppress(&stscap, status.DataLoss(sts, "unable to delete pic data", key))
 	}
 
 	for _, th := range oldthumbs {
-		oldthumbpath, sts := schema.PicFileDerivedPath(t.PixPath, p.PicId, th.Index, th.Mime)
-		if sts != nil {
-			defer status.ReplaceOrSuppress(&stscap, sts)
-		} else if err := t.Remove(oldthumbpath); err != nil {
-			defer status.ReplaceOrSuppress(&stscap, status.DataLoss(err, "unable to delete pic data", oldthumbpath))
+		thumbKey := blobstore.PicFileDerivedKey(p.PicId, th.Index, th.Mime)
+		if sts := blobs.Delete(ctx, thumbKey); sts != nil {
+			defer status.ReplaceOrSuppress(
+				&stscap, status.DataLoss(sts, "unable to delete pic data", thumbKey))
 		}
 	}
 
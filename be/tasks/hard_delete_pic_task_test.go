@@ -1,12 +1,13 @@
 package tasks
 
 import (
-	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/similarity"
 )
 
 func TestHardDeleteWorkflow(t *testing.T) {
@@ -18,12 +19,14 @@ func TestHardDeleteWorkflow(t *testing.T) {
 	u.Update()
 
 	p := c.CreatePic()
+	idx := similarity.NewIndex()
+	idx.Add(p.Pic.PicId, 0)
 
 	task := &HardDeletePicTask{
-		Beg:     c.DB(),
-		Remove:  os.Remove,
-		PixPath: c.TempDir(),
-		Now:     time.Now,
+		Beg:             c.DB(),
+		BlobStore:       c.BlobStore(),
+		Now:             time.Now,
+		SimilarityIndex: idx,
 
 		PicId: p.Pic.PicId,
 	}
@@ -31,6 +34,9 @@ func TestHardDeleteWorkflow(t *testing.T) {
 	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
 		t.Fatal(sts)
 	}
+	if have, want := idx.Len(), 0; have != want {
+		t.Error("have", have, "want", want)
+	}
 
 	path, sts := schema.PicFilePath(c.TempDir(), p.Pic.PicId, p.Pic.File.Mime)
 	if sts != nil {
@@ -89,10 +95,9 @@ func TestHardDeleteFromSoftDeleted(t *testing.T) {
 	p.Update()
 
 	task := &HardDeletePicTask{
-		Beg:     c.DB(),
-		Remove:  os.Remove,
-		PixPath: c.TempDir(),
-		Now:     func() time.Time { return now },
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       func() time.Time { return now },
 
 		PicId: p.Pic.PicId,
 	}
@@ -167,10 +172,9 @@ func TestHardDeleteFails(t *testing.T) {
 	}()
 
 	task := &HardDeletePicTask{
-		Beg:     c.DB(),
-		PixPath: c.TempDir(),
-		Remove:  func(name string) error { return errors.New("nope") },
-		Now:     time.Now,
+		Beg:       c.DB(),
+		BlobStore: failingDeleteBlobStore{c.BlobStore()},
+		Now:       time.Now,
 
 		PicId: p.Pic.PicId,
 	}
@@ -180,7 +184,7 @@ func TestHardDeleteFails(t *testing.T) {
 	if sts == nil {
 		t.Fatal("Expected error")
 	}
-	if sts.Cause() == nil || sts.Cause().Error() != "nope" {
+	if sts.Cause() == nil || !strings.Contains(sts.Cause().Error(), "nope") {
 		t.Error("wrong status", sts)
 	}
 	p.Refresh()
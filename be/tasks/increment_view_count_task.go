@@ -31,7 +31,8 @@ func (t *IncrementViewCountTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_UPDATE_VIEW_COUNTER); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_UPDATE_VIEW_COUNTER)
+	if sts != nil {
 		return sts
 	}
 
@@ -43,7 +44,7 @@ func (t *IncrementViewCountTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't find pics")
 	}
-	if len(pics) != 1 {
+	if len(pics) != 1 || !picVisible(pics[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't lookup pic")
 	}
 	p := pics[0]
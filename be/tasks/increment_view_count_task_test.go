@@ -77,3 +77,37 @@ func TestPicViewCountFailsIfDeleted(t *testing.T) {
 		t.Fatalf("Expected view count %v but was %v", 0, p.Pic.ViewCount)
 	}
 }
+
+func TestPicViewCountFailsIfPrivate(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_UPDATE_VIEW_COUNTER)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+
+	task := &IncrementViewCountTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts == nil {
+		t.Fatal("Expected an error")
+	} else {
+		if sts.Code() != codes.NotFound {
+			t.Fatalf("Expected code %v but was %v", codes.NotFound, sts.Code())
+		}
+	}
+
+	p.Refresh()
+	if p.Pic.ViewCount != 0 {
+		t.Fatalf("Expected view count %v but was %v", 0, p.Pic.ViewCount)
+	}
+}
@@ -0,0 +1,81 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &LookupBackgroundJobTask{}
+
+// LookupBackgroundJobTask finds a single job.  Users may always see their own jobs.
+type LookupBackgroundJobTask struct {
+	// deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// input
+	JobId int64
+
+	// output
+	BackgroundJob *schema.BackgroundJob
+}
+
+func (t *LookupBackgroundJobTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.BackgroundJob = nil
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+
+	bj, sts := findBackgroundJob(j, t.JobId, db.LockNone)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateBackgroundJobAccess(j, u, conf, bj, schema.User_BACKGROUND_JOB_READ); sts != nil {
+		return sts
+	}
+
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback")
+	}
+
+	t.BackgroundJob = bj
+	return nil
+}
+
+func findBackgroundJob(j *tab.Job, jobId int64, lk db.Lock) (*schema.BackgroundJob, status.S) {
+	bjs, err := j.FindBackgroundJobs(db.Opts{
+		Prefix: tab.BackgroundJobsPrimary{&jobId},
+		Lock:   lk,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find background jobs")
+	}
+	if len(bjs) != 1 {
+		return nil, status.NotFound(nil, "can't lookup background job", jobId)
+	}
+	return bjs[0], nil
+}
+
+// validateBackgroundJobAccess checks that u created bj, or else has the given capability.
+func validateBackgroundJobAccess(j *tab.Job, u *schema.User, conf *schema.Configuration,
+	bj *schema.BackgroundJob, cap schema.User_Capability) status.S {
+	if u != nil && u.UserId == bj.UserId {
+		return nil
+	}
+	return validateCapability(j, u, conf, cap)
+}
@@ -0,0 +1,64 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestLookupBackgroundJobWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	bj := c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &LookupBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		JobId: bj.JobId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := task.BackgroundJob.JobId, bj.JobId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupBackgroundJobFailsOnOtherUser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	owner, other := c.CreateUser(), c.CreateUser()
+	bj := c.CreateBackgroundJob(owner, schema.BackgroundJob_THUMBNAIL, c.CreatePic().Pic.PicId)
+
+	task := &LookupBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		JobId: bj.JobId,
+	}
+	sts := new(TaskRunner).Run(other.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.PermissionDenied {
+		t.Error("have", sts, "want", codes.PermissionDenied)
+	}
+}
+
+func TestLookupBackgroundJobFailsOnMissingJob(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	task := &LookupBackgroundJobTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		JobId: c.Id(),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.NotFound {
+		t.Error("have", sts, "want", codes.NotFound)
+	}
+}
@@ -0,0 +1,86 @@
+package tasks
+
+import (
+	"context"
+	"crypto/sha512"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &LookupPicByHashTask{}
+
+// LookupPicByHashTask finds the pic whose contents have the given hash.  It is used by clients
+// to avoid uploading files the server already has.
+type LookupPicByHashTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Sha512_256Hash []byte
+
+	// Results
+	Pic *schema.Pic
+}
+
+func (t *LookupPicByHashTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.Pic = nil
+	if len(t.Sha512_256Hash) != sha512.Size256 {
+		return status.InvalidArgument(nil, "bad sha512_256 hash length", len(t.Sha512_256Hash))
+	}
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_INDEX)
+	if sts != nil {
+		return sts
+	}
+
+	typ := schema.PicIdent_SHA512_256
+	pis, err := j.FindPicIdents(db.Opts{
+		Prefix: tab.PicIdentsIdent{
+			Type:  &typ,
+			Value: &t.Sha512_256Hash,
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic idents")
+	}
+	if len(pis) != 1 {
+		return status.NotFound(nil, "can't find pic with hash")
+	}
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&pis[0].PicId},
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't lookup pic")
+	}
+	if len(pics) != 1 {
+		return status.Internal(nil, "can't find pic for ident", pis[0].PicId)
+	}
+	filteredPic := filterPic(pics[0], ru, conf)
+	if filteredPic == nil {
+		return status.NotFound(nil, "can't find pic with hash")
+	}
+	if err := j.Rollback(); err != nil {
+		return status.Internal(err, "can't rollback job")
+	}
+
+	t.Pic = filteredPic
+	return nil
+}
@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func sha512_256HashOf(p *TestPic) []byte {
+	for _, ident := range p.Idents() {
+		if ident.PicIdent.Type == schema.PicIdent_SHA512_256 {
+			return ident.PicIdent.Value
+		}
+	}
+	p.c.T.Fatal("Can't find SHA512_256")
+	return nil
+}
+
+func TestLookupPicByHashTaskWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	c.CreatePic()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &LookupPicByHashTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		Sha512_256Hash: sha512_256HashOf(p),
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if task.Pic == nil || task.Pic.PicId != p.Pic.PicId {
+		t.Error("have", task.Pic, "want", p.Pic)
+	}
+}
+
+func TestLookupPicByHashTask_notFound(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	hash := sha512_256HashOf(p)
+	hash[0]++
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &LookupPicByHashTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		Sha512_256Hash: hash,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if task.Pic != nil {
+		t.Error("have", task.Pic, "want nil")
+	}
+}
+
+func TestLookupPicByHashTask_badHash(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	task := &LookupPicByHashTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		Sha512_256Hash: []byte("short"),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "bad sha512_256 hash"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupPicByHashTask_failsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	u := c.CreateUser()
+
+	task := &LookupPicByHashTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		Sha512_256Hash: sha512_256HashOf(p),
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -47,17 +47,18 @@ func (t *LookupPicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_INDEX); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_INDEX)
+	if sts != nil {
 		return sts
 	}
 
 	if t.CheckReadPicCommentExtCap {
-		if sts := validateCapability(u, conf, schema.User_PIC_COMMENT_EXTENSION_READ); sts != nil {
+		if sts := hasCapability(ru, conf, schema.User_PIC_COMMENT_EXTENSION_READ); sts != nil {
 			return sts
 		}
 	}
 	if t.CheckReadPicTagExtCap {
-		if sts := validateCapability(u, conf, schema.User_PIC_TAG_EXTENSION_READ); sts != nil {
+		if sts := hasCapability(ru, conf, schema.User_PIC_TAG_EXTENSION_READ); sts != nil {
 			return sts
 		}
 	}
@@ -72,6 +73,11 @@ func (t *LookupPicTask) Run(ctx context.Context) (stscap status.S) {
 	if len(pics) != 1 {
 		return status.NotFound(nil, "can't find pic")
 	}
+	// Private pics look the same as missing ones to users who can't see them.
+	filteredPic := filterPic(pics[0], ru, conf)
+	if filteredPic == nil {
+		return status.NotFound(nil, "can't find pic")
+	}
 	picTags, err := j.FindPicTags(db.Opts{
 		Prefix: tab.PicTagsPrimary{PicId: &t.PicId},
 	})
@@ -90,9 +96,9 @@ func (t *LookupPicTask) Run(ctx context.Context) (stscap status.S) {
 	}
 
 	t.UnfilteredPic = pics[0]
-	t.Pic = filterPic(t.UnfilteredPic, u, conf)
-	t.PicTags = filterPicTags(picTags, u, conf)
-	filteredPicComments := filterPicComments(picComments, u, conf)
+	t.Pic = filteredPic
+	t.PicTags = filterPicTags(picTags, ru, conf)
+	filteredPicComments := filterPicComments(picComments, ru, conf)
 	t.PicCommentTree = buildCommentTree(filteredPicComments)
 
 	return nil
@@ -277,6 +277,68 @@ func TestLookupPicTask_failsOnMissingPic(t *testing.T) {
 	}
 }
 
+func TestLookupPicTask_failsOnPrivatePic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+	ctx := u.AuthedCtx(c.Ctx)
+
+	task := &LookupPicTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestLookupPicTask_succeedsOnPrivatePicOfRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	r := c.CreateRole("Friends")
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode:   schema.Pic_Visibility_PRIVATE,
+		RoleId: []int64{r.RoleId},
+	}
+	p.Update()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.User.RoleId = append(u.User.RoleId, r.RoleId)
+	u.Update()
+	ctx := u.AuthedCtx(c.Ctx)
+
+	task := &LookupPicTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.Pic == nil || task.Pic.PicId != p.Pic.PicId {
+		t.Error("wrong pic", task.Pic)
+	}
+}
+
 func TestPicCommentTree(t *testing.T) {
 	pcs := []*schema.PicComment{
 		{
@@ -42,11 +42,15 @@ func (t *LookupPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
 	neededCapability := schema.User_USER_READ_ALL
 	if su == ou {
 		neededCapability = schema.User_USER_READ_SELF
 	}
-	if sts := validateCapability(su, conf, neededCapability); sts != nil {
+	if sts := hasCapability(ru, conf, neededCapability); sts != nil {
 		return sts
 	}
 
@@ -57,7 +61,7 @@ func (t *LookupPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't lookup pic")
 	}
-	if len(pics) != 1 {
+	if len(pics) != 1 || !picVisible(pics[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't find pic")
 	}
 
@@ -83,7 +87,7 @@ func (t *LookupPicVoteTask) Run(ctx context.Context) (stscap status.S) {
 		return status.Internal(err, "can't rollback job")
 	}
 	if thevote != nil {
-		t.PicVote = filterPicVote(thevote, su, conf)
+		t.PicVote = filterPicVote(thevote, ru, conf)
 	}
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
 
 	"pixur.org/pixur/be/schema"
 )
@@ -34,3 +35,37 @@ func TestLookupPicVoteTaskWorkFlow(t *testing.T) {
 		t.Error("have", task.PicVote, "want", pv.PicVote)
 	}
 }
+
+func TestLookupPicVoteTask_PrivatePicHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_USER_READ_SELF)
+	u.Update()
+
+	c.CreatePicVote(p, u)
+
+	ctx := u.AuthedCtx(c.Ctx)
+	task := &LookupPicVoteTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		PicId: p.Pic.PicId,
+	}
+
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if task.PicVote != nil {
+		t.Error("found vote", task.PicVote)
+	}
+}
@@ -40,8 +40,12 @@ func (t *LookupUserTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
+	ru, sts := resolveUserRoles(j, su)
+	if sts != nil {
+		return sts
+	}
 
-	uc := userCredOf(su, conf)
+	uc := userCredOf(ru, conf)
 	switch {
 	case uc.cs.Has(schema.User_USER_READ_ALL):
 	case uc.subjectUserId == ou.UserId && uc.cs.Has(schema.User_USER_READ_SELF):
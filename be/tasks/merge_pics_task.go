@@ -0,0 +1,333 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &MergePicsTask{}
+
+// MergePicsTask moves everything about the loser pic onto the winner pic, and soft deletes the
+// loser as a duplicate of the winner.
+type MergePicsTask struct {
+	// deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+	// If present, the loser is removed from the index.
+	SimilarityIndex *similarity.Index
+
+	// input
+	WinnerPicId int64
+	LoserPicId  int64
+
+	// output
+	UnfilteredPic *schema.Pic
+	Pic           *schema.Pic
+}
+
+func (t *MergePicsTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.Pic, t.UnfilteredPic = nil, nil
+	if t.WinnerPicId == t.LoserPicId {
+		return status.InvalidArgument(nil, "can't merge pic with itself", t.WinnerPicId)
+	}
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_MERGE)
+	if sts != nil {
+		return sts
+	}
+
+	winner, sts := findMergePic(j, t.WinnerPicId)
+	if sts != nil {
+		return sts
+	}
+	if winner.SoftDeleted() {
+		return status.InvalidArgument(nil, "can't merge into deleted pic", t.WinnerPicId)
+	}
+	loser, sts := findMergePic(j, t.LoserPicId)
+	if sts != nil {
+		return sts
+	}
+	if loser.SoftDeleted() {
+		return status.InvalidArgument(nil, "can't merge deleted pic", t.LoserPicId)
+	}
+
+	if sts := mergePicTags(j, winner.PicId, loser.PicId, now); sts != nil {
+		return sts
+	}
+	if sts := mergePicComments(j, winner.PicId, loser.PicId); sts != nil {
+		return sts
+	}
+	if sts := mergePicVotes(j, winner, loser.PicId); sts != nil {
+		return sts
+	}
+	if sts := mergePicIdents(j, winner.PicId, loser.PicId); sts != nil {
+		return sts
+	}
+
+	for _, ls := range loser.Source {
+		exists := false
+		for _, ws := range winner.Source {
+			if ls.Url != "" && ls.Url == ws.Url {
+				exists = true
+				break
+			}
+			if ls.Url == "" && ls.UserId == ws.UserId && ls.Name == ws.Name {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			winner.Source = append(winner.Source, ls)
+		}
+	}
+	loser.Source = nil
+
+	if winner.ViewCount > math.MaxInt64-loser.ViewCount {
+		return status.Internal(nil, "overflow of view count")
+	}
+	winner.ViewCount += loser.ViewCount
+	loser.ViewCount = 0
+	loser.VoteUp, loser.VoteDown = 0, 0
+
+	nowts := schema.ToTspb(now)
+	loser.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs:  nowts,
+		Reason:           schema.Pic_DeletionStatus_DUPLICATE,
+		DuplicateOfPicId: winner.PicId,
+	}
+	loser.SetModifiedTime(now)
+	if err := j.UpdatePic(loser); err != nil {
+		return status.Internal(err, "can't update pic")
+	}
+	winner.SetModifiedTime(now)
+	if err := j.UpdatePic(winner); err != nil {
+		return status.Internal(err, "can't update pic")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	if t.SimilarityIndex != nil {
+		t.SimilarityIndex.Remove(loser.PicId)
+	}
+
+	t.UnfilteredPic = winner
+	t.Pic = filterPic(winner, ru, conf)
+	return nil
+}
+
+func findMergePic(j *tab.Job, picId int64) (*schema.Pic, status.S) {
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&picId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pics")
+	}
+	if len(pics) != 1 {
+		return nil, status.NotFound(nil, "can't lookup pic", picId)
+	}
+	return pics[0], nil
+}
+
+// mergePicTags moves the loser's pic tags to the winner.  If both pics have the same tag, the
+// winner's pic tag is kept.
+func mergePicTags(j *tab.Job, winnerPicId, loserPicId int64, now time.Time) status.S {
+	wpts, err := j.FindPicTags(db.Opts{
+		Prefix: tab.PicTagsPrimary{PicId: &winnerPicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic tags")
+	}
+	winnerTags := make(map[int64]bool, len(wpts))
+	for _, pt := range wpts {
+		winnerTags[pt.TagId] = true
+	}
+	lpts, err := j.FindPicTags(db.Opts{
+		Prefix: tab.PicTagsPrimary{PicId: &loserPicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic tags")
+	}
+	for _, pt := range lpts {
+		if err := j.DeletePicTag(tab.KeyForPicTag(pt)); err != nil {
+			return status.Internal(err, "can't delete pic tag")
+		}
+		if !winnerTags[pt.TagId] {
+			pt.PicId = winnerPicId
+			pt.SetModifiedTime(now)
+			if err := j.InsertPicTag(pt); err != nil {
+				return status.Internal(err, "can't create pic tag")
+			}
+			continue
+		}
+		// The tag is now used one less time.  It is still in use by the winner.
+		tags, err := j.FindTags(db.Opts{
+			Prefix: tab.TagsPrimary{&pt.TagId},
+			Lock:   db.LockWrite,
+			Limit:  1,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find tag")
+		}
+		if len(tags) != 1 {
+			return status.Internal(nil, "can't lookup tag", pt.TagId)
+		}
+		tag := tags[0]
+		tag.UsageCount--
+		tag.SetModifiedTime(now)
+		if err := j.UpdateTag(tag); err != nil {
+			return status.Internal(err, "can't update tag")
+		}
+	}
+	return nil
+}
+
+// mergePicComments moves the loser's comments and comment votes to the winner.  Comment ids are
+// unique across pics, so the comment tree is unchanged.
+func mergePicComments(j *tab.Job, winnerPicId, loserPicId int64) status.S {
+	pcs, err := j.FindPicComments(db.Opts{
+		Prefix: tab.PicCommentsPrimary{PicId: &loserPicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic comments")
+	}
+	for _, pc := range pcs {
+		if err := j.DeletePicComment(tab.KeyForPicComment(pc)); err != nil {
+			return status.Internal(err, "can't delete pic comment")
+		}
+		pc.PicId = winnerPicId
+		if err := j.InsertPicComment(pc); err != nil {
+			return status.Internal(err, "can't create pic comment")
+		}
+	}
+
+	pcvs, err := j.FindPicCommentVotes(db.Opts{
+		Prefix: tab.PicCommentVotesPrimary{PicId: &loserPicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic comment votes")
+	}
+	for _, pcv := range pcvs {
+		if err := j.DeletePicCommentVote(tab.KeyForPicCommentVote(pcv)); err != nil {
+			return status.Internal(err, "can't delete pic comment vote")
+		}
+		pcv.PicId = winnerPicId
+		if err := j.InsertPicCommentVote(pcv); err != nil {
+			return status.Internal(err, "can't create pic comment vote")
+		}
+	}
+	return nil
+}
+
+// mergePicVotes moves the loser's votes to the winner.  Users may only vote once, so votes from
+// users who already voted on the winner are dropped.  Anonymous votes are always kept.
+func mergePicVotes(j *tab.Job, winner *schema.Pic, loserPicId int64) status.S {
+	wpvs, err := j.FindPicVotes(db.Opts{
+		Prefix: tab.PicVotesPrimary{PicId: &winner.PicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic votes")
+	}
+	voted := make(map[int64]bool, len(wpvs))
+	nextAnonIndex := int64(0)
+	for _, pv := range wpvs {
+		voted[pv.UserId] = true
+		if pv.UserId == schema.AnonymousUserId && pv.Index >= nextAnonIndex {
+			if pv.Index == math.MaxInt64 {
+				return status.Internal(nil, "overflow of pic vote index")
+			}
+			nextAnonIndex = pv.Index + 1
+		}
+	}
+	lpvs, err := j.FindPicVotes(db.Opts{
+		Prefix: tab.PicVotesPrimary{PicId: &loserPicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic votes")
+	}
+	for _, pv := range lpvs {
+		if err := j.DeletePicVote(tab.KeyForPicVote(pv)); err != nil {
+			return status.Internal(err, "can't delete pic vote")
+		}
+		if pv.UserId != schema.AnonymousUserId && voted[pv.UserId] {
+			continue
+		}
+		pv.PicId = winner.PicId
+		if pv.UserId == schema.AnonymousUserId {
+			if nextAnonIndex == math.MaxInt64 {
+				return status.Internal(nil, "overflow of pic vote index")
+			}
+			pv.Index = nextAnonIndex
+			nextAnonIndex++
+		}
+		if err := j.InsertPicVote(pv); err != nil {
+			return status.Internal(err, "can't create pic vote")
+		}
+		switch pv.Vote {
+		case schema.PicVote_UP:
+			winner.VoteUp++
+		case schema.PicVote_DOWN:
+			winner.VoteDown++
+		}
+	}
+	return nil
+}
+
+// mergePicIdents moves the loser's idents to the winner, so that uploads of the loser's file
+// find the winner.  Idents the winner already has, such as a shared perceptual hash, are dropped.
+func mergePicIdents(j *tab.Job, winnerPicId, loserPicId int64) status.S {
+	pis, err := j.FindPicIdents(db.Opts{
+		Prefix: tab.PicIdentsPrimary{PicId: &loserPicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pic idents")
+	}
+	for _, pi := range pis {
+		if err := j.DeletePicIdent(tab.KeyForPicIdent(pi)); err != nil {
+			return status.Internal(err, "can't delete pic ident")
+		}
+		existing, err := j.FindPicIdents(db.Opts{
+			Prefix: tab.PicIdentsPrimary{PicId: &winnerPicId, Type: &pi.Type, Value: &pi.Value},
+			Limit:  1,
+			Lock:   db.LockWrite,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find pic idents")
+		}
+		if len(existing) != 0 {
+			continue
+		}
+		pi.PicId = winnerPicId
+		if err := j.InsertPicIdent(pi); err != nil {
+			return status.Internal(err, "can't create pic ident")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func TestMergePicsWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_MERGE)
+	u.Update()
+
+	winner, loser := c.CreatePic(), c.CreatePic()
+	winner.Pic.ViewCount = 3
+	winner.Update()
+	loser.Pic.ViewCount = 4
+	loser.Pic.Source[0].Url = "http://foo/"
+	loser.Update()
+
+	shared, loserOnly := c.CreateTag(), c.CreateTag()
+	c.CreatePicTag(winner, shared)
+	c.CreatePicTag(loser, shared)
+	c.CreatePicTag(loser, loserOnly)
+
+	pc := loser.Comment()
+
+	voter, otherVoter := c.CreateUser(), c.CreateUser()
+	c.CreatePicVote(winner, voter)
+	c.CreatePicVote(loser, voter)
+	pv := c.CreatePicVote(loser, otherVoter)
+	pv.PicVote.Vote = schema.PicVote_UP
+	pv.Update()
+
+	loserIdents := loser.Idents()
+
+	task := &MergePicsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		WinnerPicId: winner.Pic.PicId,
+		LoserPicId:  loser.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := task.UnfilteredPic.PicId, winner.Pic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	winner.Refresh()
+	loser.Refresh()
+	if have, want := winner.Pic.ViewCount, int64(7); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := winner.Pic.VoteUp, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := len(winner.Pic.Source), 2; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if winner.Pic.SoftDeleted() {
+		t.Error("winner deleted", winner.Pic.DeletionStatus)
+	}
+
+	ds := loser.Pic.DeletionStatus
+	if ds == nil {
+		t.Fatal("loser not deleted")
+	}
+	if have, want := ds.Reason, schema.Pic_DeletionStatus_DUPLICATE; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ds.DuplicateOfPicId, winner.Pic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if loser.Pic.HardDeleted() {
+		t.Error("loser hard deleted")
+	}
+
+	winnerTags, _ := winner.Tags()
+	if have, want := len(winnerTags), 2; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if loserTags, _ := loser.Tags(); len(loserTags) != 0 {
+		t.Error("loser still has tags", loserTags)
+	}
+	shared.Refresh()
+	if have, want := shared.Tag.UsageCount, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	loserOnly.Refresh()
+	if have, want := loserOnly.Tag.UsageCount, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	pc.PicComment.PicId = winner.Pic.PicId
+	if !pc.Refresh() {
+		t.Error("comment not moved")
+	}
+
+	var votes []*schema.PicVote
+	c.AutoJob(func(j *tab.Job) error {
+		var err error
+		votes, err = j.FindPicVotes(db.Opts{
+			Prefix: tab.PicVotesPrimary{PicId: &winner.Pic.PicId},
+		})
+		return err
+	})
+	if have, want := len(votes), 2; have != want {
+		t.Error("have", have, "want", want, votes)
+	}
+
+	if have, want := len(winner.Idents()), 2*len(loserIdents); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have := loser.Idents(); len(have) != 0 {
+		t.Error("have", have, "want none")
+	}
+}
+
+func TestMergePicsSharedIdent(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_MERGE)
+	u.Update()
+
+	winner, loser := c.CreatePic(), c.CreatePic()
+	// Near identical pics have the same perceptual hash.
+	dct := &schema.PicIdent{
+		PicId:      loser.Pic.PicId,
+		Type:       schema.PicIdent_DCT_0,
+		Value:      []byte("12345678"),
+		Dct0Values: []float32{1, 2},
+	}
+	winnerDct := &schema.PicIdent{
+		PicId:      winner.Pic.PicId,
+		Type:       dct.Type,
+		Value:      dct.Value,
+		Dct0Values: dct.Dct0Values,
+	}
+	c.AutoJob(func(j *tab.Job) error {
+		if err := j.InsertPicIdent(dct); err != nil {
+			return err
+		}
+		return j.InsertPicIdent(winnerDct)
+	})
+	winnerIdents, loserIdents := winner.Idents(), loser.Idents()
+
+	task := &MergePicsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		WinnerPicId: winner.Pic.PicId,
+		LoserPicId:  loser.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if have, want := len(winner.Idents()), len(winnerIdents)+len(loserIdents)-1; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have := loser.Idents(); len(have) != 0 {
+		t.Error("have", have, "want none")
+	}
+}
+
+func TestMergePicsFailsOnSamePic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_MERGE)
+	u.Update()
+
+	p := c.CreatePic()
+	task := &MergePicsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		WinnerPicId: p.Pic.PicId,
+		LoserPicId:  p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.InvalidArgument {
+		t.Error("have", sts, "want", codes.InvalidArgument)
+	}
+}
+
+func TestMergePicsFailsOnDeletedLoser(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_MERGE)
+	u.Update()
+
+	winner, loser := c.CreatePic(), c.CreatePic()
+	loser.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: schema.ToTspb(time.Now()),
+	}
+	loser.Update()
+
+	task := &MergePicsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		WinnerPicId: winner.Pic.PicId,
+		LoserPicId:  loser.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.InvalidArgument {
+		t.Error("have", sts, "want", codes.InvalidArgument)
+	}
+}
+
+func TestMergePicsFailsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	winner, loser := c.CreatePic(), c.CreatePic()
+
+	task := &MergePicsTask{
+		Beg:         c.DB(),
+		Now:         time.Now,
+		WinnerPicId: winner.Pic.PicId,
+		LoserPicId:  loser.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.PermissionDenied {
+		t.Error("have", sts, "want", codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,167 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
+	"pixur.org/pixur/be/status"
+)
+
+const (
+	DefaultPruneBatchSize = 1000
+)
+
+var _ Task = &PruneDeletedPicsTask{}
+
+// PruneDeletedPicsTask hard deletes pics past their pending deletion time.  It reads one batch of
+// pics waiting to be hard deleted, in pending deletion order, and hard deletes each in its own
+// transaction.  It is only meant to be run by the server, so no user is needed.
+type PruneDeletedPicsTask struct {
+	// deps
+	Beg       tab.JobBeginner
+	BlobStore blobstore.BlobStore
+	Now       func() time.Time
+	// If present, pruned pics are removed from the index.
+	SimilarityIndex *similarity.Index
+
+	// input
+	// Only pics after this pending deletion order and id are read.  Both are 0 to start from the
+	// beginning.
+	StartPendingDeletionOrder int64
+	StartPicId                int64
+	// How many pics to read.  Defaults to DefaultPruneBatchSize.
+	BatchSize int
+	// If set, pics are found but not hard deleted.
+	DryRun bool
+
+	// output
+	Scanned int
+	// The pics that were hard deleted, or would have been in a dry run.
+	PrunedPicIds []int64
+	// The pics that couldn't be hard deleted.
+	Failures map[int64]status.S
+	// The last pic read, to be used as the start of the next batch.
+	NextPendingDeletionOrder int64
+	NextPicId                int64
+	// Set if there are no more pics to read.
+	Done bool
+}
+
+func (t *PruneDeletedPicsTask) Run(ctx context.Context) status.S {
+	// destroy outputs incase this is a retry
+	t.Scanned, t.PrunedPicIds, t.Failures, t.Done = 0, nil, nil, false
+	t.NextPendingDeletionOrder, t.NextPicId = 0, 0
+	batchSize := t.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultPruneBatchSize
+	}
+	now := t.Now()
+
+	pics, sts := t.findPics(ctx, batchSize, now)
+	if sts != nil {
+		return sts
+	}
+	for _, p := range pics {
+		if !pendingHardDeletion(p, now) {
+			continue
+		}
+		if t.DryRun {
+			t.PrunedPicIds = append(t.PrunedPicIds, p.PicId)
+			continue
+		}
+		pruned, sts := t.prunePic(ctx, p.PicId, now)
+		if sts != nil {
+			if t.Failures == nil {
+				t.Failures = make(map[int64]status.S)
+			}
+			t.Failures[p.PicId] = sts
+			continue
+		}
+		if pruned {
+			t.PrunedPicIds = append(t.PrunedPicIds, p.PicId)
+		}
+	}
+
+	t.Scanned = len(pics)
+	if len(pics) > 0 {
+		last := pics[len(pics)-1]
+		t.NextPendingDeletionOrder, t.NextPicId = last.PendingDeletionOrderCol(), last.PicId
+	} else {
+		t.NextPendingDeletionOrder, t.NextPicId = t.StartPendingDeletionOrder, t.StartPicId
+	}
+	t.Done = len(pics) < batchSize
+	return nil
+}
+
+// findPics reads the pics whose pending deletion time is before now.  Pics that aren't waiting to
+// be hard deleted have a pending deletion order of 0, and are skipped.
+func (t *PruneDeletedPicsTask) findPics(ctx context.Context, batchSize int, now time.Time) (
+	_ []*schema.Pic, stscap status.S) {
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return nil, status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	var notPending int64
+	stop := now.UnixNano()
+	opts := db.Opts{
+		StartEx: tab.PicsPendingDeletionOrder{PendingDeletionOrder: &notPending},
+		StopEx:  tab.PicsPendingDeletionOrder{PendingDeletionOrder: &stop},
+		Limit:   batchSize,
+		Lock:    db.LockNone,
+	}
+	if t.StartPendingDeletionOrder > 0 {
+		opts.StartEx = tab.PicsPendingDeletionOrder{
+			PendingDeletionOrder: &t.StartPendingDeletionOrder,
+			Id:                   &t.StartPicId,
+		}
+	}
+	pics, err := j.FindPics(opts)
+	if err != nil {
+		return nil, status.Internal(err, "can't find pics")
+	}
+	return pics, nil
+}
+
+// prunePic hard deletes the pic, unless it was changed since it was read.
+func (t *PruneDeletedPicsTask) prunePic(ctx context.Context, picId int64, now time.Time) (
+	_ bool, stscap status.S) {
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return false, status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&picId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return false, status.Internal(err, "can't find pics")
+	}
+	if len(pics) != 1 || !pendingHardDeletion(pics[0], now) {
+		return false, nil
+	}
+	if sts := commitHardDelete(ctx, j, t.BlobStore, t.SimilarityIndex, pics[0], now); sts != nil {
+		return false, sts
+	}
+	return true, nil
+}
+
+// pendingHardDeletion returns if the pic is soft deleted, and past its pending deletion time.
+func pendingHardDeletion(p *schema.Pic, now time.Time) bool {
+	if p.DeletionStatus == nil || p.DeletionStatus.PendingDeletedTs == nil {
+		return false
+	}
+	if p.HardDeleted() {
+		return false
+	}
+	return now.After(schema.ToTime(p.DeletionStatus.PendingDeletedTs))
+}
@@ -0,0 +1,140 @@
+package tasks
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestPruneDeletedPics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	past := schema.ToTspb(time.Now().Add(-time.Hour))
+	longPast := schema.ToTspb(time.Now().Add(-2 * time.Hour))
+	future := schema.ToTspb(time.Now().Add(time.Hour))
+
+	p1 := c.CreatePic()
+	p2 := c.CreatePic()
+	p2.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs:  past,
+		PendingDeletedTs: past,
+	}
+	p2.Update()
+	p3 := c.CreatePic()
+	p3.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs:  past,
+		PendingDeletedTs: future,
+	}
+	p3.Update()
+	p4 := c.CreatePic()
+	p4.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: past,
+	}
+	p4.Update()
+	p5 := c.CreatePic()
+	p5.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs:  longPast,
+		PendingDeletedTs: longPast,
+	}
+	p5.Update()
+
+	var pruned []int64
+	var startPendingDeletionOrder, startPicId int64
+	var batches, scanned int
+	for {
+		task := &PruneDeletedPicsTask{
+			Beg:                       c.DB(),
+			BlobStore:                 c.BlobStore(),
+			Now:                       time.Now,
+			StartPendingDeletionOrder: startPendingDeletionOrder,
+			StartPicId:                startPicId,
+			BatchSize:                 1,
+		}
+		if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+			t.Fatal(sts)
+		}
+		if len(task.Failures) != 0 {
+			t.Error("have", task.Failures, "want none")
+		}
+		batches++
+		scanned += task.Scanned
+		pruned = append(pruned, task.PrunedPicIds...)
+		if task.Done {
+			break
+		}
+		startPendingDeletionOrder, startPicId = task.NextPendingDeletionOrder, task.NextPicId
+	}
+	if have, want := batches, 3; have != want {
+		t.Error("have", have, "want", want)
+	}
+	// Only pics past their pending deletion time are read.
+	if have, want := scanned, 2; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := pruned, []int64{p5.Pic.PicId, p2.Pic.PicId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+
+	for _, p := range []*TestPic{p1, p3, p4} {
+		p.Refresh()
+		if p.Pic.HardDeleted() {
+			t.Error("pic hard deleted", p.Pic.PicId)
+		}
+	}
+	for _, p := range []*TestPic{p2, p5} {
+		p.Refresh()
+		if !p.Pic.HardDeleted() {
+			t.Error("pic not hard deleted", p.Pic.PicId)
+		}
+	}
+}
+
+func TestPruneDeletedPics_DryRun(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	past := schema.ToTspb(time.Now().Add(-time.Hour))
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	for _, p := range []*TestPic{p1, p2} {
+		p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+			MarkedDeletedTs:  past,
+			PendingDeletedTs: past,
+		}
+		p.Update()
+	}
+
+	// Dry runs leave the pics in place, so later batches have to start after them.
+	var pruned []int64
+	var startPendingDeletionOrder, startPicId int64
+	for {
+		task := &PruneDeletedPicsTask{
+			Beg:                       c.DB(),
+			BlobStore:                 c.BlobStore(),
+			Now:                       time.Now,
+			StartPendingDeletionOrder: startPendingDeletionOrder,
+			StartPicId:                startPicId,
+			BatchSize:                 1,
+			DryRun:                    true,
+		}
+		if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+			t.Fatal(sts)
+		}
+		pruned = append(pruned, task.PrunedPicIds...)
+		if task.Done {
+			break
+		}
+		startPendingDeletionOrder, startPicId = task.NextPendingDeletionOrder, task.NextPicId
+	}
+	if have, want := pruned, []int64{p1.Pic.PicId, p2.Pic.PicId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	for _, p := range []*TestPic{p1, p2} {
+		p.Refresh()
+		if p.Pic.HardDeleted() {
+			t.Error("pic hard deleted in dry run")
+		}
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
 	"pixur.org/pixur/be/status"
 )
 
@@ -14,10 +16,11 @@ var _ Task = &PurgePicTask{}
 
 type PurgePicTask struct {
 	// deps
-	PixPath string
-	Beg     tab.JobBeginner
-	Remove  func(name string) error
-	Now     func() time.Time
+	BlobStore blobstore.BlobStore
+	Beg       tab.JobBeginner
+	Now       func() time.Time
+	// If present, the pic is removed from the index.
+	SimilarityIndex *similarity.Index
 
 	// input
 	PicId int64
@@ -35,7 +38,7 @@ func (t *PurgePicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_PURGE); sts != nil {
+	if sts := validateCapability(j, u, conf, schema.User_PIC_PURGE); sts != nil {
 		return sts
 	}
 
@@ -101,16 +104,8 @@ func (t *PurgePicTask) Run(ctx context.Context) (stscap status.S) {
 	}
 
 	for _, t := range ts {
-		if t.UsageCount > 1 {
-			t.UsageCount--
-			t.SetModifiedTime(now)
-			if err := j.UpdateTag(t); err != nil {
-				return status.Internal(err, "can't update tag")
-			}
-		} else {
-			if err := j.DeleteTag(tab.KeyForTag(t)); err != nil {
-				return status.Internal(err, "can't delete tag")
-			}
+		if sts := releaseTag(j, t, now); sts != nil {
+			return sts
 		}
 	}
 
@@ -145,20 +140,20 @@ func (t *PurgePicTask) Run(ctx context.Context) (stscap status.S) {
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "Unable to Commit")
 	}
+	if t.SimilarityIndex != nil {
+		t.SimilarityIndex.Remove(p.PicId)
+	}
 
-	oldpath, sts := schema.PicFilePath(t.PixPath, p.PicId, p.File.Mime)
-	if sts != nil {
-		defer status.ReplaceOrSuppress(&stscap, sts)
-	} else if err := t.Remove(oldpath); err != nil {
-		defer status.ReplaceOrSuppress(&stscap, status.DataLoss(err, "unable to delete pic data", oldpath))
+	key := blobstore.PicFileKey(p.PicId, p.File.Mime)
+	if sts := t.BlobStore.Delete(ctx, key); sts != nil {
+		defer status.ReplaceOrSuppress(&stscap, status.DataLoss(sts, "unable to delete pic data", key))
 	}
 
 	for _, th := range p.Thumbnail {
-		oldthumbpath, sts := schema.PicFileDerivedPath(t.PixPath, p.PicId, th.Index, th.Mime)
-		if sts != nil {
-			defer status.ReplaceOrSuppress(&stscap, sts)
-		} else if err := t.Remove(oldthumbpath); err != nil {
-			defer status.ReplaceOrSuppress(&stscap, status.DataLoss(err, "unable to delete pic data", oldthumbpath))
+		thumbKey := blobstore.PicFileDerivedKey(p.PicId, th.Index, th.Mime)
+		if sts := t.BlobStore.Delete(ctx, thumbKey); sts != nil {
+			defer status.ReplaceOrSuppress(
+				&stscap, status.DataLoss(sts, "unable to delete pic data", thumbKey))
 		}
 	}
 
@@ -1,8 +1,8 @@
 package tasks
 
 import (
-	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,11 +36,10 @@ func TestPurgeWorkflow(t *testing.T) {
 	pc2 := pc.Comment()
 
 	task := &PurgePicTask{
-		Beg:     c.DB(),
-		PixPath: c.TempDir(),
-		Now:     time.Now,
-		Remove:  os.Remove,
-		PicId:   p.Pic.PicId,
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
 	}
 
 	ctx := u.AuthedCtx(c.Ctx)
@@ -120,11 +119,10 @@ func TestPurge_TagsDecremented(t *testing.T) {
 	c.CreatePicTag(p2, tag)
 
 	task := &PurgePicTask{
-		Beg:     c.DB(),
-		PixPath: c.TempDir(),
-		Remove:  os.Remove,
-		PicId:   p.Pic.PicId,
-		Now:     time.Now,
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		PicId:     p.Pic.PicId,
+		Now:       time.Now,
 	}
 
 	ctx := u.AuthedCtx(c.Ctx)
@@ -140,6 +138,39 @@ func TestPurge_TagsDecremented(t *testing.T) {
 	}
 }
 
+func TestPurge_AliasedTagKept(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_PURGE)
+	u.Update()
+
+	p := c.CreatePic()
+	tag := c.CreateTag()
+	c.CreatePicTag(p, tag)
+	c.CreateTagAlias("kitty", tag)
+
+	task := &PurgePicTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		PicId:     p.Pic.PicId,
+		Now:       time.Now,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if err := new(TaskRunner).Run(ctx, task); err != nil {
+		t.Fatal(err)
+	}
+
+	if !tag.Refresh() {
+		t.Fatal("Expected Tag to exist")
+	}
+	if tag.Tag.UsageCount != 0 {
+		t.Fatal("Incorrect Tag Count", tag)
+	}
+}
+
 func TestPurgeDeleteFails(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -161,11 +192,10 @@ func TestPurgeDeleteFails(t *testing.T) {
 	}()
 
 	task := &PurgePicTask{
-		Beg:     c.DB(),
-		PixPath: c.TempDir(),
-		Remove:  func(name string) error { return errors.New("nope") },
-		PicId:   p.Pic.PicId,
-		Now:     time.Now,
+		Beg:       c.DB(),
+		BlobStore: failingDeleteBlobStore{c.BlobStore()},
+		PicId:     p.Pic.PicId,
+		Now:       time.Now,
 	}
 
 	ctx := u.AuthedCtx(c.Ctx)
@@ -173,7 +203,7 @@ func TestPurgeDeleteFails(t *testing.T) {
 	if sts == nil {
 		t.Fatal("Expected error")
 	}
-	if sts.Cause() == nil || sts.Cause().Error() != "nope" {
+	if sts.Cause() == nil || !strings.Contains(sts.Cause().Error(), "nope") {
 		t.Error("wrong status", sts)
 	}
 	p.Refresh()
@@ -125,7 +125,8 @@ func (t *ReadIndexPicsTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_INDEX); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_INDEX)
+	if sts != nil {
 		return sts
 	}
 
@@ -212,57 +213,47 @@ func (t *ReadIndexPicsTask) Run(ctx context.Context) (stscap status.S) {
 		t.UnfilteredPics = pics
 	}
 	t.PrevId = prevPicId
-	t.Pics = filterPics(t.UnfilteredPics, u, conf)
+	t.Pics = filterPics(t.UnfilteredPics, ru, conf)
 
 	return nil
 }
 
+// filterPic returns p with the fields su can't see removed, or nil if su can't see p.
 func filterPic(p *schema.Pic, su *schema.User, conf *schema.Configuration) *schema.Pic {
-	var cs *schema.CapSet
-	var subjectUserId int64
-	if su != nil {
-		cs = schema.CapSetOf(su.Capability...)
-		subjectUserId = su.UserId
-	} else {
-		cs = schema.CapSetOf(conf.AnonymousCapability.Capability...)
-		subjectUserId = schema.AnonymousUserId
-	}
-
-	return filterPicInternal(p, subjectUserId, cs)
+	uc := userCredOf(su, conf)
+	return filterPicInternal(p, uc)
 }
 
+// filterPics is like filterPic, but omits the pics su can't see.
 func filterPics(ps []*schema.Pic, su *schema.User, conf *schema.Configuration) []*schema.Pic {
-	var cs *schema.CapSet
-	var subjectUserId int64
-	if su != nil {
-		cs = schema.CapSetOf(su.Capability...)
-		subjectUserId = su.UserId
-	} else {
-		cs = schema.CapSetOf(conf.AnonymousCapability.Capability...)
-		subjectUserId = schema.AnonymousUserId
-	}
+	uc := userCredOf(su, conf)
 	dst := make([]*schema.Pic, 0, len(ps))
 	for _, p := range ps {
-		dst = append(dst, filterPicInternal(p, subjectUserId, cs))
+		if dp := filterPicInternal(p, uc); dp != nil {
+			dst = append(dst, dp)
+		}
 	}
 	return dst
 }
 
 // TODO: test
-func filterPicInternal(p *schema.Pic, subjectUserId int64, cs *schema.CapSet) *schema.Pic {
+func filterPicInternal(p *schema.Pic, uc *userCred) *schema.Pic {
+	if !picVisible(p, uc) {
+		return nil
+	}
 	dp := *p
-	if !cs.Has(schema.User_PIC_EXTENSION_READ) {
+	if !uc.cs.Has(schema.User_PIC_EXTENSION_READ) {
 		dp.Ext = nil
 	}
 	switch {
-	case cs.Has(schema.User_USER_READ_ALL):
-	case cs.Has(schema.User_USER_READ_PUBLIC) && cs.Has(schema.User_USER_READ_PICS):
+	case uc.cs.Has(schema.User_USER_READ_ALL):
+	case uc.cs.Has(schema.User_USER_READ_PUBLIC) && uc.cs.Has(schema.User_USER_READ_PICS):
 	default:
 		dp.Source = nil
 		for _, s := range p.Source {
 			ds := *s
 			switch {
-			case subjectUserId == ds.UserId && cs.Has(schema.User_USER_READ_SELF):
+			case uc.subjectUserId == ds.UserId && uc.cs.Has(schema.User_USER_READ_SELF):
 			default:
 				ds.UserId = schema.AnonymousUserId
 			}
@@ -271,3 +262,30 @@ func filterPicInternal(p *schema.Pic, subjectUserId int64, cs *schema.CapSet) *s
 	}
 	return &dp
 }
+
+// picVisible checks if the user can see p at all.  Private pics can only be seen by the
+// uploader, the users and roles in the pic visibility, and users who can read all private pics.
+func picVisible(p *schema.Pic, uc *userCred) bool {
+	if !p.Private() || uc.cs.Has(schema.User_PIC_READ_PRIVATE) {
+		return true
+	}
+	if uc.subjectUserId == schema.AnonymousUserId {
+		return false
+	}
+	if uc.subjectUserId == p.UploaderUserId() {
+		return true
+	}
+	for _, id := range p.Visibility.UserId {
+		if id == uc.subjectUserId {
+			return true
+		}
+	}
+	for _, id := range p.Visibility.RoleId {
+		for _, rid := range uc.roleIds {
+			if id == rid {
+				return true
+			}
+		}
+	}
+	return false
+}
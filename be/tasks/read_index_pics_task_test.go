@@ -271,6 +271,71 @@ func TestReadIndexTaskWorkflow_userLacksPermission(t *testing.T) {
 	}
 }
 
+func TestReadIndexTask_omitsPrivatePics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_INDEX)
+	u.Update()
+
+	p1 := c.CreatePic()
+	p2 := c.CreatePic()
+	p2.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p2.Update()
+
+	task := &ReadIndexPicsTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if len(task.UnfilteredPics) != 2 {
+		t.Error("wrong unfiltered pics", task.UnfilteredPics)
+	}
+	if len(task.Pics) != 1 || task.Pics[0].PicId != p1.Pic.PicId {
+		t.Error("wrong pics", task.Pics)
+	}
+}
+
+func TestPicVisible(t *testing.T) {
+	const uploader, listed, other = 2, 3, 4
+	p := &schema.Pic{
+		Source: []*schema.Pic_FileSource{{UserId: uploader}},
+		Visibility: &schema.Pic_Visibility{
+			Mode:   schema.Pic_Visibility_PRIVATE,
+			UserId: []int64{listed},
+			RoleId: []int64{5},
+		},
+	}
+	cases := []struct {
+		name string
+		uc   *userCred
+		want bool
+	}{
+		{"uploader", &userCred{subjectUserId: uploader, cs: schema.CapSetOf()}, true},
+		{"listed", &userCred{subjectUserId: listed, cs: schema.CapSetOf()}, true},
+		{"role", &userCred{subjectUserId: other, roleIds: []int64{5}, cs: schema.CapSetOf()}, true},
+		{"other", &userCred{subjectUserId: other, cs: schema.CapSetOf()}, false},
+		{"anonymous", &userCred{subjectUserId: schema.AnonymousUserId, cs: schema.CapSetOf()}, false},
+		{"readPrivate", &userCred{
+			subjectUserId: other, cs: schema.CapSetOf(schema.User_PIC_READ_PRIVATE)}, true},
+	}
+	for _, c := range cases {
+		if have := picVisible(p, c.uc); have != c.want {
+			t.Error(c.name, "have", have, "want", c.want)
+		}
+	}
+	if !picVisible(&schema.Pic{}, &userCred{cs: schema.CapSetOf()}) {
+		t.Error("public pic not visible")
+	}
+}
+
 func TestReadIndexTask_IgnoreHiddenPics(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -0,0 +1,245 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/imaging"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RegeneratePicThumbnailsTask{}
+
+// RegeneratePicThumbnailsTask replaces the thumbnails of a pic with new ones made from its file.
+type RegeneratePicThumbnailsTask struct {
+	// deps
+	Beg       tab.JobBeginner
+	BlobStore blobstore.BlobStore
+	Now       func() time.Time
+
+	// input
+	PicId int64
+	// IfOutdated only replaces the thumbnails if one is missing from storage or the wrong size, or
+	// if one of the thumbnail formats is missing.
+	IfOutdated bool
+
+	// output
+	UnfilteredPic *schema.Pic
+	// Regenerated is set if the thumbnails were replaced.
+	Regenerated bool
+}
+
+func (t *RegeneratePicThumbnailsTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.UnfilteredPic, t.Regenerated = nil, false
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, u, conf, schema.User_PIC_CREATE); sts != nil {
+		return sts
+	}
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&t.PicId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pics")
+	}
+	if len(pics) != 1 {
+		return status.NotFound(nil, "can't lookup pic", t.PicId)
+	}
+	p := pics[0]
+	if p.HardDeleted() {
+		return status.InvalidArgument(nil, "pic already hard deleted", t.PicId)
+	}
+	if t.IfOutdated {
+		outdated, sts := t.thumbnailsOutdated(ctx, p)
+		if sts != nil {
+			return sts
+		}
+		if !outdated {
+			t.UnfilteredPic = p
+			return nil
+		}
+	}
+
+	thumbs, sts := t.generateThumbnails(ctx, p)
+	if sts != nil {
+		return sts
+	}
+	defer func() {
+		for _, th := range thumbs {
+			th.Close()
+		}
+	}()
+
+	nowts := schema.ToTspb(now)
+	var newKeys []blobstore.Key
+	defer func() {
+		if stscap != nil {
+			for _, key := range newKeys {
+				if sts := t.BlobStore.Delete(ctx, key); sts != nil {
+					status.ReplaceOrSuppress(&stscap, sts)
+				}
+			}
+		}
+	}()
+	var newThumbnails []*schema.Pic_File
+	for _, th := range thumbs {
+		mime, sts := imageFormatToMime(th.Format())
+		if sts != nil {
+			return sts
+		}
+		var anim *schema.AnimationInfo
+		if dur, sts := th.Duration(); sts != nil {
+			return sts
+		} else if dur != nil {
+			anim = &schema.AnimationInfo{
+				Duration: ptypes.DurationProto(*dur),
+			}
+		}
+		var buf bytes.Buffer
+		if sts := th.Write(&buf); sts != nil {
+			return sts
+		}
+		width, height := th.Dimensions()
+		pf := &schema.Pic_File{
+			Index:         nextPicFileIndex(append(p.Thumbnail, newThumbnails...), p.Derived),
+			Size:          int64(buf.Len()),
+			Mime:          mime,
+			Width:         int64(width),
+			Height:        int64(height),
+			AnimationInfo: anim,
+			CreatedTs:     nowts,
+			ModifiedTs:    nowts,
+		}
+		key := blobstore.PicFileDerivedKey(p.PicId, pf.Index, pf.Mime)
+		if sts := t.BlobStore.Put(ctx, key, &buf); sts != nil {
+			return sts
+		}
+		newKeys = append(newKeys, key)
+		newThumbnails = append(newThumbnails, pf)
+	}
+
+	oldThumbnails := p.Thumbnail
+	p.Thumbnail = newThumbnails
+	p.SetModifiedTime(now)
+	if err := j.UpdatePic(p); err != nil {
+		return status.Internal(err, "can't update pic")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	for _, th := range oldThumbnails {
+		key := blobstore.PicFileDerivedKey(p.PicId, th.Index, th.Mime)
+		// Missing thumbnails are a common reason to regenerate them.
+		if sts := t.BlobStore.Delete(ctx, key); sts != nil && sts.Code() != codes.NotFound {
+			defer status.ReplaceOrSuppress(
+				&stscap, status.DataLoss(sts, "unable to delete pic data", key))
+		}
+	}
+
+	t.UnfilteredPic = p
+	t.Regenerated = true
+	return nil
+}
+
+// thumbnailsOutdated checks if any thumbnail is missing or the wrong size, or if any of the
+// thumbnail formats is missing.
+func (t *RegeneratePicThumbnailsTask) thumbnailsOutdated(ctx context.Context, p *schema.Pic) (
+	bool, status.S) {
+	if len(p.Thumbnail) == 0 {
+		return true, nil
+	}
+	mimes := make(map[schema.Pic_File_Mime]bool)
+	for _, pf := range p.Thumbnail {
+		if pf.Width != imaging.ThumbnailSquareSize || pf.Height != imaging.ThumbnailSquareSize {
+			return true, nil
+		}
+		key := blobstore.PicFileDerivedKey(p.PicId, pf.Index, pf.Mime)
+		if _, sts := t.BlobStore.Stat(ctx, key); sts != nil {
+			if sts.Code() == codes.NotFound {
+				return true, nil
+			}
+			return false, sts
+		}
+		mimes[pf.Mime] = true
+	}
+	for _, format := range imaging.ExtraThumbnailFormats() {
+		mime, sts := imageFormatToMime(format)
+		if sts != nil {
+			return false, sts
+		}
+		if !mimes[mime] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// generateThumbnails makes a thumbnail in each of the thumbnail formats.  The caller must close
+// them.
+func (t *RegeneratePicThumbnailsTask) generateThumbnails(ctx context.Context, p *schema.Pic) (
+	_ []imaging.PixurImage, stscap status.S) {
+	f, sts := t.BlobStore.Get(ctx, blobstore.PicFileKey(p.PicId, p.File.Mime))
+	if sts != nil {
+		return nil, sts
+	}
+	defer f.Close()
+	im, sts := imaging.ReadImage(ctx, f)
+	if sts != nil {
+		return nil, sts
+	}
+	defer im.Close()
+
+	thumb, sts := im.Thumbnail()
+	if sts != nil {
+		return nil, sts
+	}
+	thumbs := []imaging.PixurImage{thumb}
+	defer func() {
+		if stscap != nil {
+			for _, th := range thumbs {
+				th.Close()
+			}
+		}
+	}()
+	thumbs = append(thumbs, convertThumbnail(thumb)...)
+	return thumbs, nil
+}
+
+// convertThumbnail converts thumb to each of the extra thumbnail formats.  Conversions that fail
+// are logged and left out, since the thumbnail is still usable without them.
+func convertThumbnail(thumb imaging.PixurImage) []imaging.PixurImage {
+	var extras []imaging.PixurImage
+	for _, format := range imaging.ExtraThumbnailFormats() {
+		extra, sts := thumb.Convert(format)
+		if sts != nil {
+			glog.Warning("can't convert thumbnail to ", format, ": ", sts)
+			continue
+		}
+		extras = append(extras, extra)
+	}
+	return extras
+}
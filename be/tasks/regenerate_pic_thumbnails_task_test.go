@@ -0,0 +1,136 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/imaging"
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRegeneratePicThumbnailsWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	old := p.Pic.Thumbnail[0]
+
+	task := &RegeneratePicThumbnailsTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if !task.Regenerated {
+		t.Error("thumbnails not regenerated")
+	}
+
+	p.Refresh()
+	if len(p.Pic.Thumbnail) == 0 {
+		t.Fatal("no thumbnails")
+	}
+	for _, th := range p.Pic.Thumbnail {
+		if th.Index == old.Index {
+			t.Error("thumbnail index reused", th)
+		}
+		if th.Width != imaging.ThumbnailSquareSize || th.Height != imaging.ThumbnailSquareSize {
+			t.Error("bad dims", th)
+		}
+		key := blobstore.PicFileDerivedKey(p.Pic.PicId, th.Index, th.Mime)
+		info, sts := c.BlobStore().Stat(c.Ctx, key)
+		if sts != nil {
+			t.Fatal(sts)
+		}
+		if have, want := info.Size, th.Size; have != want {
+			t.Error("have", have, "want", want)
+		}
+	}
+	oldKey := blobstore.PicFileDerivedKey(p.Pic.PicId, old.Index, old.Mime)
+	if _, sts := c.BlobStore().Stat(c.Ctx, oldKey); sts == nil || sts.Code() != codes.NotFound {
+		t.Error("old thumbnail not removed", sts)
+	}
+}
+
+func TestRegeneratePicThumbnailsIfOutdated(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	ctx := u.AuthedCtx(c.Ctx)
+
+	p := c.CreatePic()
+	newTask := func() *RegeneratePicThumbnailsTask {
+		return &RegeneratePicThumbnailsTask{
+			Beg:        c.DB(),
+			BlobStore:  c.BlobStore(),
+			Now:        time.Now,
+			PicId:      p.Pic.PicId,
+			IfOutdated: true,
+		}
+	}
+
+	// The test pic's thumbnail is the wrong size.
+	task := newTask()
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if !task.Regenerated {
+		t.Error("outdated thumbnails not regenerated")
+	}
+
+	task = newTask()
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.Regenerated {
+		t.Error("current thumbnails regenerated")
+	}
+
+	p.Refresh()
+	th := p.Pic.Thumbnail[0]
+	sts := c.BlobStore().Delete(c.Ctx, blobstore.PicFileDerivedKey(p.Pic.PicId, th.Index, th.Mime))
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	task = newTask()
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if !task.Regenerated {
+		t.Error("missing thumbnails not regenerated")
+	}
+}
+
+func TestRegeneratePicThumbnailsFailsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	p := c.CreatePic()
+
+	task := &RegeneratePicThumbnailsTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,230 @@
+package tasks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+type RemovePicTagsTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	PicId    int64
+	TagNames []string
+}
+
+func (t *RemovePicTagsTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_TAG_DELETE)
+	if sts != nil {
+		return sts
+	}
+
+	userId := schema.AnonymousUserId
+	if u != nil {
+		userId = u.UserId
+	}
+
+	if len(t.TagNames) == 0 {
+		return status.InvalidArgument(nil, "no tags to remove")
+	}
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&t.PicId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't lookup pic")
+	}
+	if len(pics) != 1 || !picVisible(pics[0], userCredOf(ru, conf)) {
+		return status.NotFound(nil, "can't find pic")
+	}
+	p := pics[0]
+
+	if p.HardDeleted() {
+		return status.InvalidArgument(nil, "can't untag deleted pic")
+	}
+
+	names := make([]tagNameAndUniq, 0, len(t.TagNames))
+	for _, rawName := range t.TagNames {
+		uniq, err := text.ToCaselessNFKC(strings.TrimSpace(rawName), "tag")
+		if err != nil {
+			return status.From(err)
+		}
+		names = append(names, tagNameAndUniq{orig: rawName, uniq: uniq})
+	}
+	if names, sts = resolveTagAliases(j, names); sts != nil {
+		return sts
+	}
+
+	seen := make(map[string]int, len(names))
+	var removedNames []string
+	for i, name := range names {
+		if pos, present := seen[name.uniq]; present {
+			return status.InvalidArgumentf(
+				nil, "duplicate tag '%s' at position %d and %d", name.orig, pos, i)
+		}
+		seen[name.uniq] = i
+
+		tags, err := j.FindTags(db.Opts{
+			Prefix: tab.TagsName{&name.uniq},
+			Limit:  1,
+			Lock:   db.LockWrite,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find tags")
+		}
+		if len(tags) != 1 {
+			return status.NotFound(nil, "can't find tag", name.orig)
+		}
+		tag := tags[0]
+
+		pts, err := j.FindPicTags(db.Opts{
+			Prefix: tab.PicTagsPrimary{PicId: &p.PicId, TagId: &tag.TagId},
+			Limit:  1,
+			Lock:   db.LockWrite,
+		})
+		if err != nil {
+			return status.Internal(err, "can't find pic tags")
+		}
+		if len(pts) != 1 {
+			return status.NotFound(nil, "can't find pic tag", name.orig)
+		}
+		pt := pts[0]
+
+		if err := j.DeletePicTag(tab.KeyForPicTag(pt)); err != nil {
+			return status.Internal(err, "can't delete pic tag")
+		}
+		if sts := releaseTag(j, tag, now); sts != nil {
+			return sts
+		}
+		removedNames = append(removedNames, pt.Name)
+	}
+
+	if userId != schema.AnonymousUserId {
+		nowts := schema.ToTspb(now)
+		createdTs := schema.UserEventCreatedTsCol(nowts)
+		idx, sts := nextUserEventIndex(j, userId, createdTs)
+		if sts != nil {
+			return sts
+		}
+		oue := &schema.UserEvent{
+			UserId:     userId,
+			Index:      idx,
+			CreatedTs:  nowts,
+			ModifiedTs: nowts,
+			Evt: &schema.UserEvent_RemovePicTags_{
+				RemovePicTags: &schema.UserEvent_RemovePicTags{
+					PicId:   p.PicId,
+					TagName: removedNames,
+				},
+			},
+		}
+		if err := j.InsertUserEvent(oue); err != nil {
+			return status.Internal(err, "can't create user event")
+		}
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	return nil
+}
+
+// releaseTag drops one use of tag.  The last use deletes the tag, unless an alias or implication
+// still refers to it, in which case it is kept with no uses.
+func releaseTag(j *tab.Job, tag *schema.Tag, now time.Time) status.S {
+	if tag.UsageCount <= 1 {
+		referenced, sts := tagReferenced(j, tag.TagId)
+		if sts != nil {
+			return sts
+		}
+		if !referenced {
+			if err := j.DeleteTag(tab.KeyForTag(tag)); err != nil {
+				return status.Internal(err, "can't delete tag")
+			}
+			return nil
+		}
+	}
+	if tag.UsageCount > 0 {
+		tag.UsageCount--
+	}
+	tag.SetModifiedTime(now)
+	if err := j.UpdateTag(tag); err != nil {
+		return status.Internal(err, "can't update tag")
+	}
+	return nil
+}
+
+// deleteUnusedTag deletes tag if it has no uses and no alias or implication refers to it.
+func deleteUnusedTag(j *tab.Job, tag *schema.Tag) status.S {
+	if tag.UsageCount > 0 {
+		return nil
+	}
+	referenced, sts := tagReferenced(j, tag.TagId)
+	if sts != nil {
+		return sts
+	}
+	if referenced {
+		return nil
+	}
+	if err := j.DeleteTag(tab.KeyForTag(tag)); err != nil {
+		return status.Internal(err, "can't delete tag")
+	}
+	return nil
+}
+
+// tagReferenced returns if any alias or implication refers to the tag.
+func tagReferenced(j *tab.Job, tagId int64) (bool, status.S) {
+	tas, err := j.FindTagAliases(db.Opts{
+		Prefix: tab.TagAliasesTagId{TagId: &tagId},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return false, status.Internal(err, "can't find tag aliases")
+	}
+	if len(tas) != 0 {
+		return true, nil
+	}
+	implying, err := j.FindTagImplications(db.Opts{
+		Prefix: tab.TagImplicationsPrimary{TagId: &tagId},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return false, status.Internal(err, "can't find tag implications")
+	}
+	if len(implying) != 0 {
+		return true, nil
+	}
+	implied, err := j.FindTagImplications(db.Opts{
+		Prefix: tab.TagImplicationsImpliedTagId{ImpliedTagId: &tagId},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return false, status.Internal(err, "can't find tag implications")
+	}
+	return len(implied) != 0, nil
+}
@@ -0,0 +1,316 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+)
+
+func TestRemovePicTagsTaskWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_DELETE)
+	u.Update()
+
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	pt1 := c.CreatePicTag(p1, tag1)
+	pt2 := c.CreatePicTag(p1, tag2)
+	c.CreatePicTag(p2, tag2)
+
+	tm := time.Now()
+	now := func() time.Time { return tm }
+	tag1Name, tag2Name := tag1.Tag.Name, tag2.Tag.Name
+
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      now,
+		PicId:    p1.Pic.PicId,
+		TagNames: []string{strings.ToUpper(tag1Name), tag2Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if pt1.Refresh() || pt2.Refresh() {
+		t.Error("pic tags not removed")
+	}
+	if tag1.Refresh() {
+		t.Error("unused tag not removed", tag1.Tag)
+	}
+	if !tag2.Refresh() {
+		t.Fatal("tag removed")
+	}
+	if have, want := tag2.Tag.UsageCount, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	j := c.Job()
+	defer j.Rollback()
+
+	ues, err := j.FindUserEvents(db.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ues) != 1 {
+		t.Fatal("wrong number of events", ues)
+	}
+	expect := &schema.UserEvent{
+		UserId:     u.User.UserId,
+		CreatedTs:  schema.ToTspb(now()),
+		ModifiedTs: schema.ToTspb(now()),
+		Evt: &schema.UserEvent_RemovePicTags_{
+			RemovePicTags: &schema.UserEvent_RemovePicTags{
+				PicId:   p1.Pic.PicId,
+				TagName: []string{tag1Name, tag2Name},
+			},
+		},
+	}
+	if !proto.Equal(expect, ues[0]) {
+		t.Error("have", ues[0], "want", expect)
+	}
+}
+
+func TestRemovePicTagsTask_KeepsAliasedTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability,
+		schema.User_PIC_TAG_DELETE, schema.User_PIC_TAG_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	tag := c.CreateTag()
+	c.CreatePicTag(p, tag)
+	c.CreateTagAlias("kitty", tag)
+
+	ctx := u.AuthedCtx(c.Ctx)
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p.Pic.PicId,
+		TagNames: []string{tag.Tag.Name},
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if !tag.Refresh() {
+		t.Fatal("aliased tag removed")
+	}
+	if have, want := tag.Tag.UsageCount, int64(0); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	// The alias still works.
+	addTask := &AddPicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p.Pic.PicId,
+		TagNames: []string{"kitty"},
+	}
+	if sts := new(TaskRunner).Run(ctx, addTask); sts != nil {
+		t.Fatal(sts)
+	}
+	if !tag.Refresh() {
+		t.Fatal("tag removed")
+	}
+	if have, want := tag.Tag.UsageCount, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRemovePicTagsTask_ByAlias(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_DELETE)
+	u.Update()
+
+	p := c.CreatePic()
+	tag := c.CreateTag()
+	pt := c.CreatePicTag(p, tag)
+	c.CreateTagAlias("kitty", tag)
+
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p.Pic.PicId,
+		TagNames: []string{"Kitty"},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if pt.Refresh() {
+		t.Error("pic tag not removed")
+	}
+}
+
+func TestRemovePicTagsTask_KeepsImpliedTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_DELETE)
+	u.Update()
+
+	p := c.CreatePic()
+	tag, implied := c.CreateTag(), c.CreateTag()
+	c.CreatePicTag(p, implied)
+	c.CreateTagImplication(tag, implied)
+
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p.Pic.PicId,
+		TagNames: []string{implied.Tag.Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if !implied.Refresh() {
+		t.Fatal("implied tag removed")
+	}
+	if have, want := implied.Tag.UsageCount, int64(0); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRemovePicTagsTask_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	p := c.CreatePic()
+	tag := c.CreateTag()
+	pt := c.CreatePicTag(p, tag)
+
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p.Pic.PicId,
+		TagNames: []string{tag.Tag.Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !pt.Refresh() {
+		t.Error("pic tag removed")
+	}
+}
+
+func TestRemovePicTagsTask_PrivatePicHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_DELETE)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+	tag := c.CreateTag()
+	pt := c.CreatePicTag(p, tag)
+
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p.Pic.PicId,
+		TagNames: []string{tag.Tag.Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if !pt.Refresh() {
+		t.Error("pic tag removed")
+	}
+}
+
+func TestRemovePicTagsTask_MissingPicTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_DELETE)
+	u.Update()
+
+	p1, p2 := c.CreatePic(), c.CreatePic()
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	pt1 := c.CreatePicTag(p1, tag1)
+	c.CreatePicTag(p2, tag2)
+
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p1.Pic.PicId,
+		TagNames: []string{tag1.Tag.Name, tag2.Tag.Name},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := sts.Message(), "can't find pic tag"; !strings.Contains(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if !pt1.Refresh() {
+		t.Error("pic tag removed")
+	}
+}
+
+func TestRemovePicTagsTask_DuplicateTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_DELETE)
+	u.Update()
+
+	p := c.CreatePic()
+	tag := c.CreateTag()
+	c.CreatePicTag(p, tag)
+
+	task := &RemovePicTagsTask{
+		Beg:      c.DB(),
+		Now:      time.Now,
+		PicId:    p.Pic.PicId,
+		TagNames: []string{tag.Tag.Name, strings.ToUpper(tag.Tag.Name)},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RestorePicTask{}
+
+// RestorePicTask undoes a soft deletion.  The restoring user and their reason are recorded on the
+// pic.
+type RestorePicTask struct {
+	// deps
+	Beg       tab.JobBeginner
+	BlobStore blobstore.BlobStore
+	Now       func() time.Time
+
+	// input
+	PicId int64
+	// Why is this being restored
+	Details string
+
+	// output
+	UnfilteredPic *schema.Pic
+	Pic           *schema.Pic
+}
+
+func (t *RestorePicTask) Run(ctx context.Context) (stscap status.S) {
+	// destroy outputs incase this is a retry
+	t.Pic, t.UnfilteredPic = nil, nil
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_RESTORE)
+	if sts != nil {
+		return sts
+	}
+
+	pics, err := j.FindPics(db.Opts{
+		Prefix: tab.PicsPrimary{&t.PicId},
+		Lock:   db.LockWrite,
+		Limit:  1,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pics")
+	}
+	if len(pics) != 1 {
+		return status.NotFound(nil, "can't lookup pic")
+	}
+	p := pics[0]
+
+	if p.DeletionStatus == nil {
+		return status.FailedPrecondition(nil, "pic is not deleted", t.PicId)
+	}
+	if p.HardDeleted() {
+		return status.FailedPrecondition(nil, "pic already hard deleted", t.PicId)
+	}
+	if p.DeletionStatus.Reason == schema.Pic_DeletionStatus_DUPLICATE {
+		// The idents, tags, and comments were moved to the other pic, and can't be split back out.
+		return status.FailedPrecondition(
+			nil, "pic was merged into", p.DeletionStatus.DuplicateOfPicId)
+	}
+
+	keys := []blobstore.Key{blobstore.PicFileKey(p.PicId, p.File.Mime)}
+	for _, th := range p.Thumbnail {
+		keys = append(keys, blobstore.PicFileDerivedKey(p.PicId, th.Index, th.Mime))
+	}
+	for _, key := range keys {
+		if _, sts := t.BlobStore.Stat(ctx, key); sts != nil {
+			return status.FailedPrecondition(sts, "can't find pic data", key)
+		}
+	}
+
+	userId := schema.AnonymousUserId
+	if u != nil {
+		userId = u.UserId
+	}
+	p.Restoration = append(p.Restoration, &schema.Pic_Restoration{
+		UserId:                 userId,
+		Details:                t.Details,
+		CreatedTs:              schema.ToTspb(now),
+		PreviousDeletionStatus: p.DeletionStatus,
+	})
+	p.DeletionStatus = nil
+	p.SetModifiedTime(now)
+	// Updating the pic recomputes its index order.
+	if err := j.UpdatePic(p); err != nil {
+		return status.Internal(err, "can't update pic")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.UnfilteredPic = p
+	t.Pic = filterPic(p, ru, conf)
+	return nil
+}
@@ -0,0 +1,200 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRestorePicWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_RESTORE)
+	u.Update()
+
+	p := c.CreatePic()
+	indexOrder := p.Pic.IndexOrder()
+	p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs:  schema.ToTspb(time.Now()),
+		PendingDeletedTs: schema.ToTspb(time.Now().AddDate(0, 0, 7)),
+		Reason:           schema.Pic_DeletionStatus_RULE_VIOLATION,
+	}
+	p.Update()
+
+	task := &RestorePicTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+		Details:   "oops",
+	}
+	if sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task); sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := task.Pic.PicId, p.Pic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	p.Refresh()
+	if p.Pic.DeletionStatus != nil {
+		t.Error("have", p.Pic.DeletionStatus, "want nil")
+	}
+	if have, want := p.Pic.IndexOrder(), indexOrder; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := len(p.Pic.Restoration), 1; have != want {
+		t.Fatal("have", have, "want", want)
+	}
+	r := p.Pic.Restoration[0]
+	if have, want := r.UserId, u.User.UserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := r.Details, "oops"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := r.PreviousDeletionStatus.Reason, schema.Pic_DeletionStatus_RULE_VIOLATION; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRestorePicAnonymous(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	conf := schema.GetDefaultConfiguration()
+	conf.AnonymousCapability.Capability =
+		append(conf.AnonymousCapability.Capability, schema.User_PIC_RESTORE)
+	ctx := CtxFromTestConfig(c.Ctx, conf)
+
+	p := c.CreatePic()
+	p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: schema.ToTspb(time.Now()),
+		Reason:          schema.Pic_DeletionStatus_RULE_VIOLATION,
+	}
+	p.Update()
+
+	task := &RestorePicTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	p.Refresh()
+	if have, want := len(p.Pic.Restoration), 1; have != want {
+		t.Fatal("have", have, "want", want)
+	}
+	if have, want := p.Pic.Restoration[0].UserId, schema.AnonymousUserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRestorePicFailsOnHardDeleted(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_RESTORE)
+	u.Update()
+
+	p := c.CreatePic()
+	hardDeletePic(p)
+
+	task := &RestorePicTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.FailedPrecondition {
+		t.Error("have", sts, "want", codes.FailedPrecondition)
+	}
+}
+
+func TestRestorePicFailsOnNotDeleted(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_RESTORE)
+	u.Update()
+
+	p := c.CreatePic()
+
+	task := &RestorePicTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.FailedPrecondition {
+		t.Error("have", sts, "want", codes.FailedPrecondition)
+	}
+}
+
+func TestRestorePicFailsOnMissingFile(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_RESTORE)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: schema.ToTspb(time.Now()),
+	}
+	p.Update()
+	if sts := c.BlobStore().Delete(c.Ctx, blobstore.PicFileKey(p.Pic.PicId, p.Pic.File.Mime)); sts != nil {
+		t.Fatal(sts)
+	}
+
+	task := &RestorePicTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.FailedPrecondition {
+		t.Error("have", sts, "want", codes.FailedPrecondition)
+	}
+	p.Refresh()
+	if !p.Pic.SoftDeleted() {
+		t.Error("pic restored without file")
+	}
+}
+
+func TestRestorePicFailsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	p := c.CreatePic()
+	p.Pic.DeletionStatus = &schema.Pic_DeletionStatus{
+		MarkedDeletedTs: schema.ToTspb(time.Now()),
+	}
+	p.Update()
+
+	task := &RestorePicTask{
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		Now:       time.Now,
+		PicId:     p.Pic.PicId,
+	}
+	sts := new(TaskRunner).Run(u.AuthedCtx(c.Ctx), task)
+	if sts == nil || sts.Code() != codes.PermissionDenied {
+		t.Error("have", sts, "want", codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// RevokeApiKeyTask removes an API key of a user, so that it can't be used.  The user's login
+// sessions are unaffected.  Users can revoke their own keys, but revoking the keys of others
+// requires USER_UPDATE_CAPABILITY.
+type RevokeApiKeyTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// If zero, the key of the current user is revoked.
+	ObjectUserId int64
+	KeyId        int64
+}
+
+func (t *RevokeApiKeyTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if sts := checkNotApiKey(ctx, "revoke api key"); sts != nil {
+		return sts
+	}
+	ou, sts := lookupObjectUser(ctx, j, db.LockWrite, t.ObjectUserId, su)
+	if sts != nil {
+		return sts
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if su == nil || su.UserId != ou.UserId {
+		if sts := validateCapability(j, su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
+			return sts
+		}
+	}
+
+	pos := -1
+	for i, ak := range ou.ApiKey {
+		if ak.KeyId == t.KeyId {
+			pos = i
+			break
+		}
+	}
+	if pos == -1 {
+		return status.NotFound(nil, "can't find api key", t.KeyId)
+	}
+	ou.ApiKey = append(ou.ApiKey[:pos], ou.ApiKey[pos+1:]...)
+	ou.SetModifiedTime(now)
+
+	if err := j.UpdateUser(ou); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
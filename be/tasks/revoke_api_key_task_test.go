@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRevokeApiKeySelf(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.NextApiKeyId = 2
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{KeyId: 1}, &schema.UserApiKey{KeyId: 2})
+	u.Update()
+
+	task := &RevokeApiKeyTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		KeyId: 1,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if len(u.User.ApiKey) != 1 || u.User.ApiKey[0].KeyId != 2 {
+		t.Error("expected key to be revoked", u.User.ApiKey)
+	}
+	// Login sessions are left alone.
+	if len(u.User.UserToken) != 1 {
+		t.Error("expected token to remain", u.User.UserToken)
+	}
+}
+
+func TestRevokeApiKeyMissing(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &RevokeApiKeyTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		KeyId: 1,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeApiKeyCantRevokeOther(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u1.User.NextApiKeyId = 1
+	u1.User.ApiKey = append(u1.User.ApiKey, &schema.UserApiKey{KeyId: 1})
+	u1.Update()
+	u2 := c.CreateUser()
+
+	task := &RevokeApiKeyTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+		KeyId:        1,
+	}
+
+	ctx := u2.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeApiKeyFailsWithApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.NextApiKeyId = 2
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{
+		KeyId:      1,
+		Capability: []schema.User_Capability{schema.User_PIC_READ},
+	}, &schema.UserApiKey{KeyId: 2})
+	u.Update()
+
+	task := &RevokeApiKeyTask{
+		Beg:   c.DB(),
+		Now:   time.Now,
+		KeyId: 2,
+	}
+
+	ctx := CtxFromApiKey(c.Ctx, u.User.UserId, 1, "")
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	u.Refresh()
+	if len(u.User.ApiKey) != 2 {
+		t.Error("expected keys to remain", u.User.ApiKey)
+	}
+}
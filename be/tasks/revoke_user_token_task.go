@@ -0,0 +1,78 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// RevokeUserTokenTask removes auth tokens of a user, so that they can't be used or refreshed.
+// Users can revoke their own tokens, but revoking the tokens of others requires
+// USER_UPDATE_CAPABILITY.
+type RevokeUserTokenTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	// If zero, the tokens of the current user are revoked.
+	ObjectUserId int64
+	TokenId      int64
+	// If true, every token of the user is revoked, and TokenId is ignored.
+	All bool
+}
+
+func (t *RevokeUserTokenTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	if sts := checkNotApiKey(ctx, "revoke token"); sts != nil {
+		return sts
+	}
+	ou, sts := lookupObjectUser(ctx, j, db.LockWrite, t.ObjectUserId, su)
+	if sts != nil {
+		return sts
+	}
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if su == nil || su.UserId != ou.UserId {
+		if sts := validateCapability(j, su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
+			return sts
+		}
+	}
+
+	if t.All {
+		ou.UserToken = nil
+	} else {
+		pos := -1
+		for i, ut := range ou.UserToken {
+			if ut.TokenId == t.TokenId {
+				pos = i
+				break
+			}
+		}
+		if pos == -1 {
+			return status.NotFound(nil, "can't find token", t.TokenId)
+		}
+		ou.UserToken = append(ou.UserToken[:pos], ou.UserToken[pos+1:]...)
+	}
+	ou.SetModifiedTime(now)
+
+	if err := j.UpdateUser(ou); err != nil {
+		return status.Internal(err, "can't update user")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
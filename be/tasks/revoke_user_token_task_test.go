@@ -0,0 +1,159 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestRevokeUserTokenSelf(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.NextTokenId = 2
+	u.User.UserToken = append(u.User.UserToken, &schema.UserToken{
+		TokenId:    2,
+		CreatedTs:  u.User.UserToken[0].CreatedTs,
+		LastSeenTs: u.User.UserToken[0].LastSeenTs,
+	})
+	u.Update()
+
+	task := &RevokeUserTokenTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TokenId: 2,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u.Refresh()
+	if len(u.User.UserToken) != 1 || u.User.UserToken[0].TokenId != 1 {
+		t.Error("expected token to be revoked", u.User.UserToken)
+	}
+}
+
+func TestRevokeUserTokenMissing(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+
+	task := &RevokeUserTokenTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		TokenId: 2,
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRevokeUserTokenAllOther(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u2 := c.CreateUser()
+	u2.User.Capability = append(u2.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	u2.Update()
+
+	task := &RevokeUserTokenTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+		All:          true,
+	}
+
+	ctx := u2.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	u1.Refresh()
+	if len(u1.User.UserToken) != 0 {
+		t.Error("expected tokens to be revoked", u1.User.UserToken)
+	}
+	// The revoked user can no longer make requests.
+	lookup := &LookupUserTask{
+		Beg: c.DB(),
+		Now: time.Now,
+	}
+	sts := new(TaskRunner).Run(CtxFromUserToken(c.Ctx, u1.User.UserId, 1), lookup)
+	if sts == nil || sts.Code() != codes.Unauthenticated {
+		t.Error("expected unauthenticated", sts)
+	}
+}
+
+func TestRevokeUserTokenCantRevokeOther(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u1 := c.CreateUser()
+	u2 := c.CreateUser()
+
+	task := &RevokeUserTokenTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: u1.User.UserId,
+		All:          true,
+	}
+
+	ctx := u2.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	u1.Refresh()
+	if len(u1.User.UserToken) != 1 {
+		t.Error("expected tokens to remain", u1.User.UserToken)
+	}
+}
+
+func TestRevokeUserTokenFailsWithApiKey(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.NextApiKeyId = 1
+	u.User.ApiKey = append(u.User.ApiKey, &schema.UserApiKey{
+		KeyId:      1,
+		Capability: []schema.User_Capability{schema.User_PIC_READ},
+	})
+	u.Update()
+
+	task := &RevokeUserTokenTask{
+		Beg: c.DB(),
+		Now: time.Now,
+		All: true,
+	}
+
+	ctx := CtxFromApiKey(c.Ctx, u.User.UserId, 1, "")
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+	u.Refresh()
+	if len(u.User.UserToken) != 1 {
+		t.Error("expected token to remain", u.User.UserToken)
+	}
+}
@@ -0,0 +1,274 @@
+package tasks
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
+	"pixur.org/pixur/be/status"
+)
+
+var _ Task = &RunBackgroundJobTask{}
+
+// RunBackgroundJobTask runs a claimed job as the user who created it, and records the outcome.
+// Jobs that fail in a way that may succeed later are tried again after a backoff.  It is only
+// meant to be run by the server.
+type RunBackgroundJobTask struct {
+	// deps
+	Beg        tab.JobBeginner
+	BlobStore  blobstore.BlobStore
+	HTTPClient *http.Client
+	TempFile   func(dir, prefix string) (*os.File, error)
+	Now        func() time.Time
+	Remove     func(name string) error
+	// If present, passed to the tasks run by jobs.
+	SimilarityIndex *similarity.Index
+	// Runs the task of the job.
+	Runner *TaskRunner
+
+	// input
+	// A job from ClaimBackgroundJobTask.
+	BackgroundJob *schema.BackgroundJob
+	// If present, how long the task of the job may run.  The outcome is recorded either way.
+	Timeout time.Duration
+
+	// output
+	// Why the job failed, if it did.
+	JobStatus status.S
+
+	// set once the task of the job has run, so that retries only record the outcome.
+	jobRan      bool
+	resultPicId int64
+
+	// when progress was last saved, to avoid saving it too often.
+	lastProgressTime  time.Time
+	lastProgressStage schema.BackgroundJob_Progress_Stage
+}
+
+// backgroundJobProgressInterval is the least time between saving progress of a job, unless it moved
+// to a new stage.
+var backgroundJobProgressInterval = time.Second
+
+func (t *RunBackgroundJobTask) Run(ctx context.Context) (stscap status.S) {
+	claimed := t.BackgroundJob
+	if !t.jobRan {
+		t.resultPicId, t.JobStatus = t.runJob(ctx, claimed)
+		t.jobRan = true
+	}
+
+	now := t.Now()
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	bj, sts := findBackgroundJob(j, claimed.JobId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	if bj.Status.GetState() != schema.BackgroundJob_Status_RUNNING ||
+		bj.Status.Attempts != claimed.Status.Attempts {
+		// Cancelled, or taken by another worker after this one timed out.
+		t.BackgroundJob = bj
+		return nil
+	}
+
+	switch {
+	case t.JobStatus == nil:
+		bj.Status.State = schema.BackgroundJob_Status_SUCCEEDED
+		bj.Status.LastError = ""
+		bj.Status.NextRunTs = nil
+		bj.Status.FinishedTs = schema.ToTspb(now)
+		if t.resultPicId != 0 {
+			bj.PicId = t.resultPicId
+		}
+	case retryableBackgroundJobStatus(t.JobStatus) && bj.Status.Attempts < maxBackgroundJobAttempts:
+		rng := func() *rand.Rand {
+			return rand.New(rand.NewSource(now.UnixNano()))
+		}
+		bj.Status.State = schema.BackgroundJob_Status_PENDING
+		bj.Status.LastError = t.JobStatus.Message()
+		bj.Status.NextRunTs = schema.ToTspb(now.Add(backgroundJobBackoff(bj.Status.Attempts, rng)))
+		bj.Status.Progress = nil
+	default:
+		bj.Status.State = schema.BackgroundJob_Status_FAILED
+		bj.Status.LastError = t.JobStatus.Message()
+		bj.Status.NextRunTs = nil
+		bj.Status.FinishedTs = schema.ToTspb(now)
+	}
+	bj.SetModifiedTime(now)
+	if err := j.UpdateBackgroundJob(bj); err != nil {
+		return status.Internal(err, "can't update background job")
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	t.BackgroundJob = bj
+	return nil
+}
+
+// runJob runs the task for the job, and returns the pic it created, if any.
+func (t *RunBackgroundJobTask) runJob(ctx context.Context, bj *schema.BackgroundJob) (
+	int64, status.S) {
+	if t.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.Timeout)
+		defer cancel()
+	}
+	ctx = ctxFromBackgroundJobUser(ctx, bj.UserId)
+	switch bj.Type {
+	case schema.BackgroundJob_THUMBNAIL:
+		return 0, t.Runner.Run(ctx, &RegeneratePicThumbnailsTask{
+			Beg:       t.Beg,
+			BlobStore: t.BlobStore,
+			Now:       t.Now,
+			PicId:     bj.PicId,
+		})
+	case schema.BackgroundJob_FETCH_URL:
+		return t.fetchUrl(ctx, bj)
+	case schema.BackgroundJob_HARD_DELETE_PIC:
+		return 0, t.Runner.Run(ctx, &HardDeletePicTask{
+			Beg:             t.Beg,
+			BlobStore:       t.BlobStore,
+			Now:             t.Now,
+			SimilarityIndex: t.SimilarityIndex,
+			PicId:           bj.PicId,
+		})
+	default:
+		return 0, status.InvalidArgument(nil, "bad background job type", bj.Type)
+	}
+}
+
+// fetchUrl downloads the file before creating the pic, rather than letting UpsertPicTask do it.
+// This keeps the download out of the pic transaction, so that its progress can be saved.
+func (t *RunBackgroundJobTask) fetchUrl(ctx context.Context, bj *schema.BackgroundJob) (
+	_ int64, stscap status.S) {
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return 0, sts
+	}
+	loc, ref, sts := checkUrls(bj.Url, bj.Referrer, conf)
+	if sts != nil {
+		return 0, sts
+	}
+	task := &UpsertPicTask{
+		BlobStore:       t.BlobStore,
+		Beg:             t.Beg,
+		HTTPClient:      t.HTTPClient,
+		TempFile:        t.TempFile,
+		Now:             t.Now,
+		Remove:          t.Remove,
+		SimilarityIndex: t.SimilarityIndex,
+		Progress: func(pr *schema.BackgroundJob_Progress) {
+			t.recordProgress(ctx, bj, pr)
+		},
+		FileURL:         bj.Url,
+		FileURLReferrer: bj.Referrer,
+		FileName:        bj.FileName,
+		Md5Hash:         bj.Md5Hash,
+		Visibility:      bj.Visibility,
+	}
+	f, cleanup, size, disName, sts := task.prepareRemoteFile(ctx, loc, ref, confMaxFileSize(conf))
+	if sts != nil {
+		return 0, sts
+	}
+	defer cleanup(&stscap)
+
+	// UpsertPicTask only derives names for files it downloads itself.
+	if task.FileName == "" {
+		var names []string
+		if disName != nil && disName.sts == nil {
+			names = append(names, disName.name)
+		}
+		if urlName, sts := parseUrlName(loc); sts == nil {
+			names = append(names, urlName)
+		}
+		minFileNameLen, maxFileNameLen := confFileNameLen(conf)
+		for _, name := range names {
+			if name, sts := validateAndNormalizeFileName(
+				name, "filename", minFileNameLen, maxFileNameLen); sts == nil {
+				task.FileName = name
+				break
+			}
+		}
+	}
+
+	t.recordProgress(ctx, bj, &schema.BackgroundJob_Progress{
+		Stage:           schema.BackgroundJob_Progress_PROCESSING,
+		DownloadedBytes: size,
+		TotalBytes:      size,
+	})
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, status.Internal(err, "can't seek")
+	}
+	task.File = f
+	task.Progress = nil
+	if sts := t.Runner.Run(ctx, task); sts != nil {
+		return 0, sts
+	}
+	return task.UnfilteredCreatedPic.PicId, nil
+}
+
+// recordProgress saves how far the job has gotten, so that it can be watched.  Saving is best
+// effort, since the job can finish without it.
+func (t *RunBackgroundJobTask) recordProgress(
+	ctx context.Context, claimed *schema.BackgroundJob, pr *schema.BackgroundJob_Progress) {
+	now := t.Now()
+	if pr.Stage == t.lastProgressStage && now.Sub(t.lastProgressTime) < backgroundJobProgressInterval {
+		return
+	}
+	t.lastProgressTime, t.lastProgressStage = now, pr.Stage
+	_ = t.saveProgress(ctx, claimed, pr, now)
+}
+
+func (t *RunBackgroundJobTask) saveProgress(ctx context.Context, claimed *schema.BackgroundJob,
+	pr *schema.BackgroundJob_Progress, now time.Time) (stscap status.S) {
+	j, err := tab.NewJob(ctx, t.Beg)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer revert(j, &stscap)
+
+	bj, sts := findBackgroundJob(j, claimed.JobId, db.LockWrite)
+	if sts != nil {
+		return sts
+	}
+	if bj.Status.GetState() != schema.BackgroundJob_Status_RUNNING ||
+		bj.Status.Attempts != claimed.Status.Attempts {
+		return nil
+	}
+	bj.Status.Progress = pr
+	bj.SetModifiedTime(now)
+	if err := j.UpdateBackgroundJob(bj); err != nil {
+		return status.Internal(err, "can't update background job")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
+
+// retryableBackgroundJobStatus returns if a failed job may succeed if run again.
+func retryableBackgroundJobStatus(sts status.S) bool {
+	if retryable, ok := unwrapTaskStatus(sts).(db.Retryable); ok && retryable.CanRetry() {
+		return true
+	}
+	switch sts.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,247 @@
+package tasks
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+func claimTestBackgroundJob(c *TestContainer) *schema.BackgroundJob {
+	task := &ClaimBackgroundJobTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Timeout: time.Minute,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		c.T.Fatal(sts)
+	}
+	if task.BackgroundJob == nil {
+		c.T.Fatal("no job claimed")
+	}
+	return task.BackgroundJob
+}
+
+func TestRunBackgroundJobWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_HARD_DELETE)
+	u.Update()
+	p := c.CreatePic()
+	c.CreateBackgroundJob(u, schema.BackgroundJob_HARD_DELETE_PIC, p.Pic.PicId)
+
+	task := &RunBackgroundJobTask{
+		Beg:           c.DB(),
+		BlobStore:     c.BlobStore(),
+		Now:           time.Now,
+		Runner:        new(TaskRunner),
+		BackgroundJob: claimTestBackgroundJob(c),
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.JobStatus != nil {
+		t.Error("job failed", task.JobStatus)
+	}
+
+	bj := c.RefreshBackgroundJob(task.BackgroundJob)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_SUCCEEDED; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if bj.Status.FinishedTs == nil {
+		t.Error("missing finished time")
+	}
+	if bj.Status.NextRunTs != nil {
+		t.Error("unexpected next run time", bj.Status.NextRunTs)
+	}
+	p.Refresh()
+	if !p.Pic.HardDeleted() {
+		t.Error("pic not hard deleted", p.Pic)
+	}
+}
+
+func TestRunBackgroundJobRegeneratesThumbnails(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	p := c.CreatePic()
+	oldIndex := p.Pic.Thumbnail[0].Index
+	c.CreateBackgroundJob(u, schema.BackgroundJob_THUMBNAIL, p.Pic.PicId)
+
+	task := &RunBackgroundJobTask{
+		Beg:           c.DB(),
+		BlobStore:     c.BlobStore(),
+		Now:           time.Now,
+		Runner:        new(TaskRunner),
+		BackgroundJob: claimTestBackgroundJob(c),
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.JobStatus != nil {
+		t.Fatal("job failed", task.JobStatus)
+	}
+
+	bj := c.RefreshBackgroundJob(task.BackgroundJob)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_SUCCEEDED; have != want {
+		t.Error("have", have, "want", want)
+	}
+	p.Refresh()
+	if len(p.Pic.Thumbnail) == 0 || p.Pic.Thumbnail[0].Index == oldIndex {
+		t.Error("thumbnails not regenerated", p.Pic.Thumbnail)
+	}
+}
+
+func TestRunBackgroundJobFetchesUrl(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+
+	data, err := ioutil.ReadAll(makeImageData(makeImage(c.Id()), c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write(data); err != nil {
+			t.Error(err)
+		}
+	}
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	c.AutoJob(func(j *tab.Job) error {
+		bj := &schema.BackgroundJob{
+			Type:   schema.BackgroundJob_FETCH_URL,
+			UserId: u.User.UserId,
+			Url:    serv.URL + "/foo/bar.png",
+		}
+		if sts := createBackgroundJob(j, bj, time.Now()); sts != nil {
+			return sts
+		}
+		return nil
+	})
+
+	task := &RunBackgroundJobTask{
+		Beg:        c.DB(),
+		BlobStore:  c.BlobStore(),
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Now:        time.Now,
+		Remove:     os.Remove,
+		Runner:     new(TaskRunner),
+
+		BackgroundJob: claimTestBackgroundJob(c),
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.JobStatus != nil {
+		t.Fatal("job failed", task.JobStatus)
+	}
+
+	bj := c.RefreshBackgroundJob(task.BackgroundJob)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_SUCCEEDED; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if bj.PicId == 0 {
+		t.Error("missing pic id")
+	}
+	pr := bj.Status.Progress
+	if have, want := pr.GetStage(), schema.BackgroundJob_Progress_PROCESSING; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := pr.GetDownloadedBytes(), int64(len(data)); have != want {
+		t.Error("have", have, "want", want)
+	}
+
+	p := c.WrapPic(&schema.Pic{PicId: bj.PicId})
+	p.Refresh()
+	if have, want := p.Pic.Source[0].Url, serv.URL+"/foo/bar.png"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := p.Pic.Source[0].Name, "bar.png"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestRunBackgroundJobFailsOnMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	// The user lost the capability after creating the job.
+	u := c.CreateUser()
+	p := c.CreatePic()
+	c.CreateBackgroundJob(u, schema.BackgroundJob_HARD_DELETE_PIC, p.Pic.PicId)
+
+	task := &RunBackgroundJobTask{
+		Beg:           c.DB(),
+		BlobStore:     c.BlobStore(),
+		Now:           time.Now,
+		Runner:        new(TaskRunner),
+		BackgroundJob: claimTestBackgroundJob(c),
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+	if task.JobStatus == nil {
+		t.Error("expected job to fail")
+	}
+
+	bj := c.RefreshBackgroundJob(task.BackgroundJob)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_FAILED; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if bj.Status.LastError == "" {
+		t.Error("missing last error")
+	}
+	p.Refresh()
+	if p.Pic.HardDeleted() {
+		t.Error("pic hard deleted", p.Pic)
+	}
+}
+
+func TestRunBackgroundJobDiscardsCancelledOutcome(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_HARD_DELETE)
+	u.Update()
+	p := c.CreatePic()
+	c.CreateBackgroundJob(u, schema.BackgroundJob_HARD_DELETE_PIC, p.Pic.PicId)
+	claimed := claimTestBackgroundJob(c)
+
+	cancelled := c.RefreshBackgroundJob(claimed)
+	cancelled.Status.State = schema.BackgroundJob_Status_CANCELLED
+	cancelled.Status.NextRunTs = nil
+	c.UpdateBackgroundJob(cancelled)
+
+	task := &RunBackgroundJobTask{
+		Beg:           c.DB(),
+		BlobStore:     c.BlobStore(),
+		Now:           time.Now,
+		Runner:        new(TaskRunner),
+		BackgroundJob: claimed,
+	}
+	if sts := new(TaskRunner).Run(c.Ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	bj := c.RefreshBackgroundJob(claimed)
+	if have, want := bj.Status.State, schema.BackgroundJob_Status_CANCELLED; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
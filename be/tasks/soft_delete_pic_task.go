@@ -34,6 +34,9 @@ func (t *SoftDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	if t.Reason == schema.Pic_DeletionStatus_UNKNOWN {
 		return status.Internal(nil, "Invalid deletion reason", t.Reason)
 	}
+	if t.Reason == schema.Pic_DeletionStatus_DUPLICATE {
+		return status.InvalidArgument(nil, "duplicate pics must be merged", t.Reason)
+	}
 	now := t.Now()
 	j, u, sts := authedJob(ctx, t.Beg, now)
 	if sts != nil {
@@ -45,7 +48,7 @@ func (t *SoftDeletePicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_SOFT_DELETE); sts != nil {
+	if sts := validateCapability(j, u, conf, schema.User_PIC_SOFT_DELETE); sts != nil {
 		return sts
 	}
 
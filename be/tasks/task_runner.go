@@ -95,6 +95,23 @@ func (r *TaskRunner) Run(ctx context.Context, task Task) status.S {
 	return sts
 }
 
+// backgroundJobBackoff is how long to wait before starting a background job again, after it has
+// been tried the given number of times.  It grows the same way as task retries.
+func backgroundJobBackoff(attempts int64, rng func() *rand.Rand) time.Duration {
+	retry := &taskRetryAdapter{
+		maxTries:     int((^uint(0)) >> 1),
+		initialDelay: taskInitialBackoffDelay,
+		rng:          rng,
+		multiplier:   taskBackoffMultiplier,
+		jitter:       taskBackoffJitter,
+	}
+	var backoff time.Duration
+	for i := int64(0); i < attempts; i++ {
+		backoff, _ = retry.Next()
+	}
+	return backoff
+}
+
 func stsSliceToErrSlice(ss []status.S) []error {
 	es := make([]error, 0, len(ss))
 	for _, s := range ss {
@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+const (
+	totpStep = 30 * time.Second
+	// totpSkew is how many steps away from now a code may be, to allow for clock drift.
+	totpSkew          = 1
+	totpSecretSize    = 20
+	totpRecoveryCodes = 10
+	// recovery codes are 16 base32 characters, which is 80 bits.
+	totpRecoveryCodeSize = 10
+	// maxTotpFailedAttempts is how many bad codes in a row lock out logging in for totpLockout.
+	maxTotpFailedAttempts = 5
+	totpLockout           = 15 * time.Minute
+)
+
+var totpRecoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// totpCode returns the RFC 6238 code for the secret at the given time step.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	off := sum[len(sum)-1] & 0xf
+	bin := binary.BigEndian.Uint32(sum[off:off+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", bin%1000000)
+}
+
+func totpStepOf(now time.Time) int64 {
+	return now.Unix() / int64(totpStep/time.Second)
+}
+
+// totpEnabled returns true if the user has confirmed two-factor authentication.
+func totpEnabled(u *schema.User) bool {
+	return u.Totp != nil && u.Totp.EnabledTs != nil
+}
+
+// totpRequired returns true if the user has one of the capabilities that need two-factor
+// authentication.  The user's roles should already be resolved.
+func totpRequired(ru *schema.User, conf *schema.Configuration) bool {
+	if conf.TotpRequiredCapability == nil {
+		return false
+	}
+	both, _, _ := schema.CapIntersect(
+		schema.CapSetOf(ru.Capability...), schema.CapSetOf(conf.TotpRequiredCapability.Capability...))
+	return both.Size() != 0
+}
+
+// totpLocked returns true if too many bad codes were given in a row, and the last one was recent.
+// Once locked, each further bad code locks again, until a good one resets the count.
+func totpLocked(ut *schema.UserTotp, now time.Time) bool {
+	return ut.FailedAttempts >= maxTotpFailedAttempts &&
+		now.Before(schema.ToTime(ut.LastFailedTs).Add(totpLockout))
+}
+
+// useTotpCode checks the code against the secret, and then the recovery codes.  If it matches, it
+// is used up so it can't be accepted again, and true is returned.
+func useTotpCode(ut *schema.UserTotp, code string, now time.Time) bool {
+	code = normalizeTotpCode(code)
+	if code == "" {
+		return false
+	}
+	step := totpStepOf(now)
+	for s := step - totpSkew; s <= step+totpSkew; s++ {
+		if s > ut.LastUsedStep && hmac.Equal([]byte(totpCode(ut.Secret, s)), []byte(code)) {
+			ut.LastUsedStep = s
+			return true
+		}
+	}
+	hash := totpRecoveryCodeHash(code)
+	for i, rch := range ut.RecoveryCodeHash {
+		if hmac.Equal(rch, hash) {
+			ut.RecoveryCodeHash = append(ut.RecoveryCodeHash[:i], ut.RecoveryCodeHash[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTotpCode removes the spaces and dashes people tend to type.
+func normalizeTotpCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+func totpRecoveryCodeHash(code string) []byte {
+	sum := sha256.Sum256([]byte(normalizeTotpCode(code)))
+	return sum[:]
+}
+
+// newTotpRecoveryCodes returns new recovery codes, and their hashes.
+func newTotpRecoveryCodes(rand io.Reader) ([]string, [][]byte, error) {
+	var codes []string
+	var hashes [][]byte
+	for i := 0; i < totpRecoveryCodes; i++ {
+		raw := make([]byte, totpRecoveryCodeSize)
+		if _, err := io.ReadFull(rand, raw); err != nil {
+			return nil, nil, err
+		}
+		code := totpRecoveryEncoding.EncodeToString(raw)
+		codes = append(codes, code[:8]+"-"+code[8:])
+		hashes = append(hashes, totpRecoveryCodeHash(code))
+	}
+	return codes, hashes, nil
+}
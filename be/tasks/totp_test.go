@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestTotpCode(t *testing.T) {
+	// From RFC 6238, Appendix B, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{20000000000, "353130"},
+	}
+	for _, tc := range cases {
+		if have, want := totpCode(secret, totpStepOf(time.Unix(tc.unix, 0))), tc.code; have != want {
+			t.Error(tc.unix, "have", have, "want", want)
+		}
+	}
+}
+
+func TestUseTotpCode(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	ut := &schema.UserTotp{
+		Secret: []byte("12345678901234567890"),
+	}
+
+	if useTotpCode(ut, "000000", now) {
+		t.Error("wrong code accepted")
+	}
+	if !useTotpCode(ut, "081 804", now) {
+		t.Error("code not accepted")
+	}
+	if useTotpCode(ut, "081804", now) {
+		t.Error("code reused")
+	}
+	// Codes from just before now are too old, since a later one was used.
+	if useTotpCode(ut, totpCode(ut.Secret, totpStepOf(now)-1), now) {
+		t.Error("old code accepted")
+	}
+	if !useTotpCode(ut, totpCode(ut.Secret, totpStepOf(now)+1), now) {
+		t.Error("next code not accepted")
+	}
+}
+
+func TestUseTotpCode_recovery(t *testing.T) {
+	codes, hashes, err := newTotpRecoveryCodes(bytes.NewReader(make([]byte, 1000)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != totpRecoveryCodes || len(hashes) != totpRecoveryCodes {
+		t.Fatal("wrong number of codes", codes)
+	}
+	ut := &schema.UserTotp{
+		Secret:           []byte("12345678901234567890"),
+		RecoveryCodeHash: hashes,
+	}
+	if !useTotpCode(ut, codes[0], time.Now()) {
+		t.Error("recovery code not accepted")
+	}
+	// The codes are all the same, since the random source was zeros.
+	if len(ut.RecoveryCodeHash) != totpRecoveryCodes-1 {
+		t.Error("recovery code not used up", len(ut.RecoveryCodeHash))
+	}
+}
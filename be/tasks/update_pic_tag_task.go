@@ -36,7 +36,8 @@ func (t *UpdatePicTagTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_TAG_EXTENSION_CREATE); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_TAG_EXTENSION_CREATE)
+	if sts != nil {
 		return sts
 	}
 
@@ -47,7 +48,7 @@ func (t *UpdatePicTagTask) Run(ctx context.Context) (stscap status.S) {
 	if err != nil {
 		return status.Internal(err, "can't find pics")
 	}
-	if len(ps) != 1 {
+	if len(ps) != 1 || !picVisible(ps[0], userCredOf(ru, conf)) {
 		return status.NotFound(nil, "can't lookup pic")
 	}
 
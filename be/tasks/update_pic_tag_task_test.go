@@ -5,6 +5,7 @@ import (
 	"time"
 
 	anypb "github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
 
 	"pixur.org/pixur/be/schema"
 )
@@ -43,3 +44,44 @@ func TestUpdatePicTagWorkflow(t *testing.T) {
 		t.Error("missing ext")
 	}
 }
+
+func TestUpdatePicTag_PrivatePicHidden(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_TAG_EXTENSION_CREATE)
+	u.Update()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+	tag := c.CreateTag()
+	pt := c.CreatePicTag(p, tag)
+
+	ctx := u.AuthedCtx(c.Ctx)
+	task := &UpdatePicTagTask{
+		Beg: c.DB(),
+		Now: time.Now,
+
+		PicId:   pt.PicTag.PicId,
+		TagId:   pt.PicTag.TagId,
+		Version: pt.PicTag.Version(),
+
+		Ext: map[string]*anypb.Any{"foo": nil},
+	}
+
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+	pt.Refresh()
+	if _, present := pt.PicTag.Ext["foo"]; present {
+		t.Error("ext added")
+	}
+}
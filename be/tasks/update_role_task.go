@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// UpdateRoleTask renames a role, or changes its capabilities.  Users with the role are affected
+// immediately.
+type UpdateRoleTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	RoleId  int64
+	Version int64
+
+	// Name is the new name of the role.  Unchanged if empty.
+	Name string
+	// Capabilities to add
+	SetCapability []schema.User_Capability
+	// Capabilities to remove
+	ClearCapability []schema.User_Capability
+
+	// Outputs
+	Role *schema.Role
+}
+
+func (t *UpdateRoleTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, su, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, su, conf, schema.User_ROLE_UPDATE); sts != nil {
+		return sts
+	}
+
+	rs, err := j.FindRoles(db.Opts{
+		Prefix: tab.RolesPrimary{&t.RoleId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find roles")
+	}
+	if len(rs) != 1 {
+		return status.NotFound(nil, "can't find role")
+	}
+	role := rs[0]
+	if role.Version() != t.Version {
+		return status.Aborted(nil, "version mismatch")
+	}
+
+	var changed bool
+	if t.Name != "" {
+		name, sts := cleanRoleName(j, t.Name, role.RoleId)
+		if sts != nil {
+			return sts
+		}
+		if name != role.Name {
+			role.Name = name
+			changed = true
+		}
+	}
+	caps, capchanged, sts := changeCapabilities(role.Capability, t.SetCapability, t.ClearCapability)
+	if sts != nil {
+		return sts
+	}
+	if capchanged {
+		role.Capability = caps
+		changed = true
+	}
+
+	if !changed {
+		if err := j.Rollback(); err != nil {
+			return status.Internal(err, "can't rollback")
+		}
+		t.Role = role
+		return nil
+	}
+
+	role.SetModifiedTime(now)
+	if err := j.UpdateRole(role); err != nil {
+		return status.Internal(err, "can't update role")
+	}
+	if sts := insertUpdateRoleEvent(j, su, now, &schema.UserEvent_UpdateRole{
+		RoleId:          role.RoleId,
+		Name:            role.Name,
+		SetCapability:   t.SetCapability,
+		ClearCapability: t.ClearCapability,
+	}); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	t.Role = role
+	return nil
+}
@@ -0,0 +1,132 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestUpdateRoleTask(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_ROLE_UPDATE)
+	u.Update()
+	r := c.CreateRole("Mods", schema.User_PIC_SOFT_DELETE, schema.User_PIC_TAG_DELETE)
+
+	task := &UpdateRoleTask{
+		Beg:             c.DB(),
+		Now:             time.Now,
+		RoleId:          r.RoleId,
+		Version:         r.Version(),
+		Name:            "Moderators",
+		SetCapability:   []schema.User_Capability{schema.User_PIC_MERGE},
+		ClearCapability: []schema.User_Capability{schema.User_PIC_TAG_DELETE},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	r = c.RefreshRole(r)
+	if have, want := r.Name, "Moderators"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	wantCaps := []schema.User_Capability{schema.User_PIC_SOFT_DELETE, schema.User_PIC_MERGE}
+	if have, want := r.Capability, wantCaps; !capsEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateRoleTask_versionMismatch(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_ROLE_UPDATE)
+	u.Update()
+	r := c.CreateRole("Mods")
+
+	task := &UpdateRoleTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		RoleId:  r.RoleId,
+		Version: r.Version() - 1,
+		Name:    "Moderators",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.Aborted; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateRoleTask_missingRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_ROLE_UPDATE)
+	u.Update()
+
+	task := &UpdateRoleTask{
+		Beg:    c.DB(),
+		Now:    time.Now,
+		RoleId: c.Id(),
+		Name:   "Moderators",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateRoleTask_appliesToMembers(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_ROLE_UPDATE)
+	u.Update()
+	r := c.CreateRole("Mods")
+
+	member := c.CreateUser()
+	member.User.RoleId = append(member.User.RoleId, r.RoleId)
+	member.Update()
+
+	j := c.Job()
+	sts := validateCapability(j, member.User, nil, schema.User_PIC_MERGE)
+	j.Rollback()
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+
+	task := &UpdateRoleTask{
+		Beg:           c.DB(),
+		Now:           time.Now,
+		RoleId:        r.RoleId,
+		Version:       r.Version(),
+		SetCapability: []schema.User_Capability{schema.User_PIC_MERGE},
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	j = c.Job()
+	defer j.Rollback()
+	if sts := validateCapability(j, member.User, nil, schema.User_PIC_MERGE); sts != nil {
+		t.Error(sts)
+	}
+}
@@ -25,6 +25,11 @@ type UpdateUserTask struct {
 	// Capabilities to remove
 	ClearCapability []schema.User_Capability
 
+	// Roles to add.  The roles must exist.
+	SetRoleId []int64
+	// Roles to remove
+	ClearRoleId []int64
+
 	// Outputs
 	ObjectUser *schema.User
 }
@@ -53,52 +58,41 @@ func (t *UpdateUserTask) Run(ctx context.Context) (stscap status.S) {
 	}
 
 	var changed bool
-	if capchange := len(t.SetCapability) + len(t.ClearCapability); capchange > 0 {
-		if sts := validateCapability(su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
+	if len(t.SetCapability)+len(t.ClearCapability) > 0 {
+		if sts := validateCapability(j, su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
 			return sts
 		}
-		both := make(map[schema.User_Capability]struct{}, capchange)
-		for _, c := range t.SetCapability {
-			if _, ok := schema.User_Capability_name[int32(c)]; !ok || c == schema.User_UNKNOWN {
-				return status.InvalidArgument(nil, "unknown cap", c)
-			}
-			both[c] = struct{}{}
-		}
-		for _, c := range t.ClearCapability {
-			if _, ok := schema.User_Capability_name[int32(c)]; !ok || c == schema.User_UNKNOWN {
-				return status.InvalidArgument(nil, "unknown cap", c)
-			}
-			both[c] = struct{}{}
-		}
-		if len(both) != capchange {
-			return status.InvalidArgument(nil, "cap change overlap")
-		}
-		oldcap := ou.Capability
-		allcaps := make(map[schema.User_Capability]struct{}, len(oldcap)+len(t.SetCapability)-len(t.ClearCapability))
-		for _, c := range oldcap {
-			allcaps[c] = struct{}{}
+		caps, capchanged, sts := changeCapabilities(ou.Capability, t.SetCapability, t.ClearCapability)
+		if sts != nil {
+			return sts
 		}
-		for _, c := range t.SetCapability {
-			allcaps[c] = struct{}{}
+		if capchanged {
+			ou.Capability = caps
+			changed = true
 		}
-		for _, c := range t.ClearCapability {
-			delete(allcaps, c)
+	}
+
+	var rolechanged bool
+	if len(t.SetRoleId)+len(t.ClearRoleId) > 0 {
+		if sts := validateCapability(j, su, conf, schema.User_USER_UPDATE_CAPABILITY); sts != nil {
+			return sts
 		}
-		ou.Capability = make([]schema.User_Capability, 0, len(allcaps))
-		for c := range allcaps {
-			ou.Capability = append(ou.Capability, c)
+		roleIds, sts := changeRoles(j, ou.RoleId, t.SetRoleId, t.ClearRoleId)
+		if sts != nil {
+			return sts
 		}
-
-		sort.Sort(userCaps(ou.Capability))
-		if len(ou.Capability) == len(oldcap) {
-			sort.Sort(userCaps(oldcap))
-			for i := 0; i < len(oldcap); i++ {
-				if ou.Capability[i] != oldcap[i] {
-					changed = true
+		if len(roleIds) != len(ou.RoleId) {
+			rolechanged = true
+		} else {
+			for i := range roleIds {
+				if roleIds[i] != ou.RoleId[i] {
+					rolechanged = true
 					break
 				}
 			}
-		} else {
+		}
+		if rolechanged {
+			ou.RoleId = roleIds
 			changed = true
 		}
 	}
@@ -109,6 +103,35 @@ func (t *UpdateUserTask) Run(ctx context.Context) (stscap status.S) {
 		if err := j.UpdateUser(ou); err != nil {
 			return status.Internal(err, "can't update user")
 		}
+		if rolechanged {
+			// Role changes are always audited, even when made without a user.
+			userId := schema.AnonymousUserId
+			if su != nil {
+				userId = su.UserId
+			}
+			nowts := schema.ToTspb(now)
+			createdTs := schema.UserEventCreatedTsCol(nowts)
+			idx, sts := nextUserEventIndex(j, userId, createdTs)
+			if sts != nil {
+				return sts
+			}
+			ue := &schema.UserEvent{
+				UserId:     userId,
+				Index:      idx,
+				CreatedTs:  nowts,
+				ModifiedTs: nowts,
+				Evt: &schema.UserEvent_UpdateUserRoles_{
+					UpdateUserRoles: &schema.UserEvent_UpdateUserRoles{
+						ObjectUserId: ou.UserId,
+						SetRoleId:    t.SetRoleId,
+						ClearRoleId:  t.ClearRoleId,
+					},
+				},
+			}
+			if err := j.InsertUserEvent(ue); err != nil {
+				return status.Internal(err, "can't create user event")
+			}
+		}
 
 		if err := j.Commit(); err != nil {
 			return status.Internal(err, "can't commit")
@@ -123,6 +146,98 @@ func (t *UpdateUserTask) Run(ctx context.Context) (stscap status.S) {
 	return nil
 }
 
+// changeCapabilities returns oldcap with setcap added and clearcap removed, in sorted order.  The
+// returned bool is true if the capabilities are different from oldcap.
+func changeCapabilities(oldcap, setcap, clearcap []schema.User_Capability) (
+	[]schema.User_Capability, bool, status.S) {
+	capchange := len(setcap) + len(clearcap)
+	both := make(map[schema.User_Capability]struct{}, capchange)
+	for _, c := range setcap {
+		if _, ok := schema.User_Capability_name[int32(c)]; !ok || c == schema.User_UNKNOWN {
+			return nil, false, status.InvalidArgument(nil, "unknown cap", c)
+		}
+		both[c] = struct{}{}
+	}
+	for _, c := range clearcap {
+		if _, ok := schema.User_Capability_name[int32(c)]; !ok || c == schema.User_UNKNOWN {
+			return nil, false, status.InvalidArgument(nil, "unknown cap", c)
+		}
+		both[c] = struct{}{}
+	}
+	if len(both) != capchange {
+		return nil, false, status.InvalidArgument(nil, "cap change overlap")
+	}
+	allcaps := make(map[schema.User_Capability]struct{}, len(oldcap)+len(setcap))
+	for _, c := range oldcap {
+		allcaps[c] = struct{}{}
+	}
+	for _, c := range setcap {
+		allcaps[c] = struct{}{}
+	}
+	for _, c := range clearcap {
+		delete(allcaps, c)
+	}
+	newcap := make([]schema.User_Capability, 0, len(allcaps))
+	for c := range allcaps {
+		newcap = append(newcap, c)
+	}
+
+	sort.Sort(userCaps(newcap))
+	if len(newcap) != len(oldcap) {
+		return newcap, true, nil
+	}
+	sortedold := append([]schema.User_Capability(nil), oldcap...)
+	sort.Sort(userCaps(sortedold))
+	for i := 0; i < len(sortedold); i++ {
+		if newcap[i] != sortedold[i] {
+			return newcap, true, nil
+		}
+	}
+	return newcap, false, nil
+}
+
+// changeRoles returns oldroleid with setroleid added and clearroleid removed, in sorted order.
+// Added roles must exist, but removed roles need not.
+func changeRoles(j *tab.Job, oldroleid, setroleid, clearroleid []int64) ([]int64, status.S) {
+	both := make(map[int64]struct{}, len(setroleid)+len(clearroleid))
+	for _, id := range setroleid {
+		both[id] = struct{}{}
+	}
+	for _, id := range clearroleid {
+		both[id] = struct{}{}
+	}
+	if len(both) != len(setroleid)+len(clearroleid) {
+		return nil, status.InvalidArgument(nil, "role change overlap")
+	}
+	allroles := make(map[int64]struct{}, len(oldroleid)+len(setroleid))
+	for _, id := range oldroleid {
+		allroles[id] = struct{}{}
+	}
+	for _, id := range setroleid {
+		roleId := id
+		rs, err := j.FindRoles(db.Opts{
+			Prefix: tab.RolesPrimary{&roleId},
+			Lock:   db.LockRead,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find roles")
+		}
+		if len(rs) != 1 {
+			return nil, status.NotFound(nil, "can't find role", id)
+		}
+		allroles[id] = struct{}{}
+	}
+	for _, id := range clearroleid {
+		delete(allroles, id)
+	}
+	newroleid := make([]int64, 0, len(allroles))
+	for id := range allroles {
+		newroleid = append(newroleid, id)
+	}
+	sort.Slice(newroleid, func(i, k int) bool { return newroleid[i] < newroleid[k] })
+	return newroleid, nil
+}
+
 type userCaps []schema.User_Capability
 
 func (uc userCaps) Len() int {
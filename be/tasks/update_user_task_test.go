@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
 )
 
 func TestUpdateUserTaskDifferentUser(t *testing.T) {
@@ -312,3 +314,188 @@ func TestUpdateUserTaskMissingObject(t *testing.T) {
 		t.Error("have", have, "want", want)
 	}
 }
+
+func TestUpdateUserTaskSetRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	su := c.CreateUser()
+	su.User.Capability = append(su.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	su.Update()
+
+	ou := c.CreateUser()
+	r := c.CreateRole("Moderators", schema.User_PIC_SOFT_DELETE)
+
+	task := &UpdateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: ou.User.UserId,
+		Version:      ou.User.Version(),
+		SetRoleId:    []int64{r.RoleId},
+	}
+	ctx := su.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ou.Refresh()
+	if have, want := ou.User.RoleId, []int64{r.RoleId}; len(have) != 1 || have[0] != want[0] {
+		t.Error("have", have, "want", want)
+	}
+	// Role capabilities are not copied onto the user.
+	if len(ou.User.Capability) != 0 {
+		t.Error("unexpected capability", ou.User.Capability)
+	}
+
+	var evts []*schema.UserEvent
+	c.AutoJob(func(j *tab.Job) error {
+		var err error
+		evts, err = j.FindUserEvents(db.Opts{
+			Prefix: tab.UserEventsPrimary{UserId: &su.User.UserId},
+		})
+		return err
+	})
+	var found bool
+	for _, ue := range evts {
+		if evt := ue.GetUpdateUserRoles(); evt != nil {
+			found = true
+			want := &schema.UserEvent_UpdateUserRoles{
+				ObjectUserId: ou.User.UserId,
+				SetRoleId:    []int64{r.RoleId},
+			}
+			if !proto.Equal(evt, want) {
+				t.Error("have", evt, "want", want)
+			}
+		}
+	}
+	if !found {
+		t.Error("missing role event", evts)
+	}
+}
+
+func TestUpdateUserTaskSetRoleAnonymous(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	conf := schema.GetDefaultConfiguration()
+	conf.AnonymousCapability.Capability =
+		append(conf.AnonymousCapability.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	ctx := CtxFromTestConfig(c.Ctx, conf)
+
+	ou := c.CreateUser()
+	r := c.CreateRole("Moderators", schema.User_PIC_SOFT_DELETE)
+
+	task := &UpdateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: ou.User.UserId,
+		Version:      ou.User.Version(),
+		SetRoleId:    []int64{r.RoleId},
+	}
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	var evts []*schema.UserEvent
+	c.AutoJob(func(j *tab.Job) error {
+		anonymousUserId := schema.AnonymousUserId
+		var err error
+		evts, err = j.FindUserEvents(db.Opts{
+			Prefix: tab.UserEventsPrimary{UserId: &anonymousUserId},
+		})
+		return err
+	})
+	if len(evts) != 1 {
+		t.Fatal("wrong number of events", evts)
+	}
+	want := &schema.UserEvent_UpdateUserRoles{
+		ObjectUserId: ou.User.UserId,
+		SetRoleId:    []int64{r.RoleId},
+	}
+	if have := evts[0].GetUpdateUserRoles(); !proto.Equal(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserTaskClearRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	su := c.CreateUser()
+	su.User.Capability = append(su.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	su.Update()
+
+	r1, r2 := c.CreateRole("Moderators"), c.CreateRole("Uploaders")
+	ou := c.CreateUser()
+	ou.User.RoleId = []int64{r1.RoleId, r2.RoleId}
+	ou.Update()
+
+	task := &UpdateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: ou.User.UserId,
+		Version:      ou.User.Version(),
+		ClearRoleId:  []int64{r1.RoleId},
+	}
+	ctx := su.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ou.Refresh()
+	if have, want := ou.User.RoleId, []int64{r2.RoleId}; len(have) != 1 || have[0] != want[0] {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserTaskMissingRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	su := c.CreateUser()
+	su.User.Capability = append(su.User.Capability, schema.User_USER_UPDATE_CAPABILITY)
+	su.Update()
+
+	ou := c.CreateUser()
+
+	task := &UpdateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: ou.User.UserId,
+		Version:      ou.User.Version(),
+		SetRoleId:    []int64{c.Id()},
+	}
+	ctx := su.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpdateUserTaskSetRoleMissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	su := c.CreateUser()
+	ou := c.CreateUser()
+	r := c.CreateRole("Moderators")
+
+	task := &UpdateUserTask{
+		Beg:          c.DB(),
+		Now:          time.Now,
+		ObjectUserId: ou.User.UserId,
+		Version:      ou.User.Version(),
+		SetRoleId:    []int64{r.RoleId},
+	}
+	ctx := su.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
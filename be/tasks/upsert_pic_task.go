@@ -15,16 +15,19 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	any "github.com/golang/protobuf/ptypes/any"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/imaging"
 	"pixur.org/pixur/be/schema"
 	"pixur.org/pixur/be/schema/db"
 	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/similarity"
 	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/text"
 )
@@ -37,15 +40,17 @@ type readerAtReadSeeker interface {
 // UpsertPicTask inserts or updates a pic with the provided information.
 type UpsertPicTask struct {
 	// Deps
-	PixPath    string
+	BlobStore  blobstore.BlobStore
 	Beg        tab.JobBeginner
 	HTTPClient *http.Client
-	// os functions
+	// os functions, for scratch files used while processing the pic.
 	TempFile func(dir, prefix string) (*os.File, error)
-	Rename   func(oldpath, newpath string) error
-	MkdirAll func(path string, perm os.FileMode) error
 	Now      func() time.Time
 	Remove   func(name string) error
+	// If present, new pics are added to the index after they are created.
+	SimilarityIndex *similarity.Index
+	// If present, called as FileURL is downloaded.
+	Progress func(*schema.BackgroundJob_Progress)
 
 	// Inputs
 	FileURL, FileURLReferrer string
@@ -58,14 +63,25 @@ type UpsertPicTask struct {
 	// new pic and the existing pic, Upsert will fail.
 	Ext map[string]*any.Any
 
+	// Visibility restricts who can see the pic.  If absent, the pic is public.  It is only used if
+	// the pic is new.
+	Visibility *schema.Pic_Visibility
+
 	// Results
 	UnfilteredCreatedPic *schema.Pic
 	CreatedPic           *schema.Pic
+	// SuspectedDuplicatePicIds are existing pics that are perceptually similar to the file, closest
+	// first.
+	SuspectedDuplicatePicIds []int64
 }
 
+// maxNearDuplicates is the most suspected duplicates found for a single upload.
+const maxNearDuplicates = 10
+
 func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	// destroy outputs incase this is a retry
 	t.CreatedPic, t.UnfilteredCreatedPic = nil, nil
+	t.SuspectedDuplicatePicIds = nil
 	now := t.Now()
 	j, u, sts := authedJob(ctx, t.Beg, now)
 	if sts != nil {
@@ -77,7 +93,8 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
-	if sts := validateCapability(u, conf, schema.User_PIC_CREATE); sts != nil {
+	ru, sts := resolveCapability(j, u, conf, schema.User_PIC_CREATE)
+	if sts != nil {
 		return sts
 	}
 	var userId = schema.AnonymousUserId
@@ -87,12 +104,17 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 
 	var ext map[string]*any.Any
 	if len(t.Ext) != 0 {
-		if sts := validateCapability(u, conf, schema.User_PIC_EXTENSION_CREATE); sts != nil {
+		if sts := hasCapability(ru, conf, schema.User_PIC_EXTENSION_CREATE); sts != nil {
 			return sts
 		}
 		ext = t.Ext
 	}
 
+	visibility, sts := cleanPicVisibility(j, t.Visibility, userId)
+	if sts != nil {
+		return sts
+	}
+
 	var loc, ref *url.URL
 	var urlsts status.S
 	if loc, ref, urlsts = checkUrls(t.FileURL, t.FileURLReferrer, conf); urlsts != nil {
@@ -121,6 +143,7 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	var f *os.File
 	var size int64
 	var fileCleanup func(*status.S)
+	maxFileSize := confMaxFileSize(conf)
 	if t.File != nil {
 		var sts status.S
 		if f, fileCleanup, size, sts = t.prepareLocalFile(ctx, t.File); sts != nil {
@@ -129,7 +152,7 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	} else if loc != nil {
 		var disName *dispositionName
 		var sts status.S
-		f, fileCleanup, size, disName, sts = t.prepareRemoteFile(ctx, loc, ref)
+		f, fileCleanup, size, disName, sts = t.prepareRemoteFile(ctx, loc, ref, maxFileSize)
 		if sts != nil {
 			return sts
 		}
@@ -156,12 +179,10 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	} else {
 		return status.InvalidArgument(nil, "no pic specified")
 	}
-	destroyTempFile := true
-	defer func() {
-		if destroyTempFile {
-			fileCleanup(&stscap)
-		}
-	}()
+	defer fileCleanup(&stscap)
+	if size > maxFileSize {
+		return status.InvalidArgumentf(nil, "file size %d exceeds max %d", size, maxFileSize)
+	}
 
 	nowts := schema.ToTspb(now)
 	// TODO: test this
@@ -224,12 +245,20 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 	if sts != nil {
 		return sts
 	}
+	// The perceptual hash of the pic, if it needs to be added to the similarity index.
+	var dct0Hash []byte
+	var duplicatePicIds []int64
 	if p != nil {
 		if p.HardDeleted() {
 			ds := p.DeletionStatus
 			if !ds.Temporary {
 				return status.InvalidArgument(nil, "can't upload deleted pic")
 			}
+			if t.SimilarityIndex != nil {
+				if dct0Hash, sts = findPerceptualHash(j, p.PicId); sts != nil {
+					return sts
+				}
+			}
 			//  fall through, picture needs to be undeleted.
 		} else {
 			if sts := mergePic(j, p, nowts, pfs, userId, ext); sts != nil {
@@ -239,10 +268,53 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 				return status.Internal(err, "can't commit")
 			}
 			t.UnfilteredCreatedPic = p
-			t.CreatedPic = filterPic(t.UnfilteredCreatedPic, u, conf)
+			t.CreatedPic = filterPic(t.UnfilteredCreatedPic, ru, conf)
 			return nil
 		}
 	} else {
+		hash, inputs, sts := im.PerceptualHash0()
+		if sts != nil {
+			return sts
+		}
+		if duplicatePicIds, sts = t.findNearDuplicates(j, conf, ru, hash); sts != nil {
+			return sts
+		}
+		var duplicateReview *schema.Pic_DuplicateReview
+		if len(duplicatePicIds) != 0 {
+			switch conf.NearDuplicatePolicy.Action {
+			case schema.Configuration_NearDuplicatePolicy_REJECT:
+				return status.AlreadyExists(nil, "pic is a near duplicate of", duplicatePicIds)
+			case schema.Configuration_NearDuplicatePolicy_MERGE:
+				pics, err := j.FindPics(db.Opts{
+					Prefix: tab.PicsPrimary{&duplicatePicIds[0]},
+					Lock:   db.LockWrite,
+					Limit:  1,
+				})
+				if err != nil {
+					return status.Internal(err, "can't find pics")
+				}
+				if len(pics) != 1 {
+					return status.Internal(nil, "can't lookup pic", duplicatePicIds[0])
+				}
+				p = pics[0]
+				if sts := mergePic(j, p, nowts, pfs, userId, ext); sts != nil {
+					return sts
+				}
+				if err := j.Commit(); err != nil {
+					return status.Internal(err, "can't commit")
+				}
+				t.UnfilteredCreatedPic = p
+				t.CreatedPic = filterPic(t.UnfilteredCreatedPic, ru, conf)
+				t.SuspectedDuplicatePicIds = duplicatePicIds
+				return nil
+			case schema.Configuration_NearDuplicatePolicy_FLAG:
+				duplicateReview = &schema.Pic_DuplicateReview{
+					SuspectedPicId: duplicatePicIds,
+					CreatedTs:      nowts,
+				}
+			}
+		}
+
 		picId, err := j.AllocId()
 		if err != nil {
 			return status.Internal(err, "can't allocate id")
@@ -260,8 +332,10 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 				CreatedTs:     nowts,
 				ModifiedTs:    nowts,
 			},
-			CreatedTs:  nowts,
-			ModifiedTs: nowts,
+			CreatedTs:       nowts,
+			ModifiedTs:      nowts,
+			DuplicateReview: duplicateReview,
+			Visibility:      visibility,
 		}
 		if err := j.InsertPic(p); err != nil {
 			return status.Internal(err, "can't insert")
@@ -269,25 +343,32 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 		if sts := insertPicHashes(j, p.PicId, md5Hash, sha1Hash, sha512_256Hash); sts != nil {
 			return sts
 		}
-		if sts := insertPerceptualHash(j, p.PicId, im); sts != nil {
+		if sts := insertPerceptualHash(j, p.PicId, hash, inputs); sts != nil {
 			return sts
 		}
+		dct0Hash = hash
 	}
 
-	destroyTempDerivedFile := true
 	var derivedFile *os.File
-	if immime == schema.Pic_File_WEBM || immime == schema.Pic_File_MP4 {
-		var deriveFormat imaging.ImageFormat
-		if immime == schema.Pic_File_WEBM {
-			deriveFormat = imaging.DefaultMp4Format
-		} else if immime == schema.Pic_File_MP4 {
-			deriveFormat = imaging.DefaultWebmFormat
-		}
+	if immime == schema.Pic_File_WEBM || immime == schema.Pic_File_MP4 ||
+		immime == schema.Pic_File_HEIC {
 		var derived imaging.PixurImage
 		fd, cleanupDerived, sts := t.prepareFile(func(w io.Writer) status.S {
 			var sts status.S
-			derived, sts =
-				imaging.ConvertVideo(ctx, deriveFormat, w.(*os.File), io.NewSectionReader(f, 0, size))
+			switch immime {
+			case schema.Pic_File_WEBM:
+				derived, sts = imaging.ConvertVideo(
+					ctx, imaging.DefaultMp4Format, w.(*os.File), io.NewSectionReader(f, 0, size))
+			case schema.Pic_File_MP4:
+				derived, sts = imaging.ConvertVideo(
+					ctx, imaging.DefaultWebmFormat, w.(*os.File), io.NewSectionReader(f, 0, size))
+			case schema.Pic_File_HEIC:
+				// Few browsers can show HEIC, so keep a JPEG copy too.
+				if derived, sts = im.Convert(imaging.DefaultJpegFormat); sts != nil {
+					return sts
+				}
+				sts = derived.Write(w)
+			}
 			if sts != nil {
 				return sts
 			}
@@ -297,11 +378,7 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 			return sts
 		}
 		derivedFile = fd
-		defer func() {
-			if destroyTempDerivedFile {
-				cleanupDerived(&stscap)
-			}
-		}()
+		defer cleanupDerived(&stscap)
 
 		derivedfi, err := derivedFile.Stat()
 		if err != nil {
@@ -339,165 +416,160 @@ func (t *UpsertPicTask) Run(ctx context.Context) (stscap status.S) {
 		return sts
 	}
 	defer thumb.Close()
-	imtmime, sts := imageFormatToMime(thumb.Format())
-	if sts != nil {
-		return sts
+	thumbs := []imaging.PixurImage{thumb}
+	for _, extra := range convertThumbnail(thumb) {
+		defer extra.Close()
+		thumbs = append(thumbs, extra)
 	}
-	var imtanim *schema.AnimationInfo
-	if dur, sts := thumb.Duration(); sts != nil {
-		return sts
-	} else if dur != nil {
-		imtanim = &schema.AnimationInfo{
-			Duration: ptypes.DurationProto(*dur),
+
+	firstNewThumbnail := len(p.Thumbnail)
+	var thumbFiles []*os.File
+	for _, th := range thumbs {
+		imtmime, sts := imageFormatToMime(th.Format())
+		if sts != nil {
+			return sts
 		}
-	}
-	ft, cleanupThumbnail, sts := t.prepareFile(func(w io.Writer) status.S {
-		if sts := thumb.Write(w); sts != nil {
+		var imtanim *schema.AnimationInfo
+		if dur, sts := th.Duration(); sts != nil {
 			return sts
+		} else if dur != nil {
+			imtanim = &schema.AnimationInfo{
+				Duration: ptypes.DurationProto(*dur),
+			}
 		}
-		return nil
-	})
-	if sts != nil {
-		return sts
-	}
-	destroyTempThumbFile := true
-	defer func() {
-		if destroyTempThumbFile {
-			cleanupThumbnail(&stscap)
+		ft, cleanupThumbnail, sts := t.prepareFile(func(w io.Writer) status.S {
+			if sts := th.Write(w); sts != nil {
+				return sts
+			}
+			return nil
+		})
+		if sts != nil {
+			return sts
 		}
-	}()
+		defer cleanupThumbnail(&stscap)
+		thumbFiles = append(thumbFiles, ft)
 
-	thumbfi, err := ft.Stat()
-	if err != nil {
-		return status.Internal(err, "unable to stat thumbnail")
-	}
-
-	twidth, theight := thumb.Dimensions()
-	p.Thumbnail = append(p.Thumbnail, &schema.Pic_File{
-		Index:         nextPicFileIndex(p.Thumbnail, p.Derived),
-		Size:          thumbfi.Size(),
-		Mime:          imtmime,
-		Width:         int64(twidth),
-		Height:        int64(theight),
-		AnimationInfo: imtanim,
-		CreatedTs:     nowts,
-		ModifiedTs:    nowts,
-	})
+		thumbfi, err := ft.Stat()
+		if err != nil {
+			return status.Internal(err, "unable to stat thumbnail")
+		}
 
-	if sts := mergePic(j, p, nowts, pfs, userId, ext); sts != nil {
-		return sts
+		twidth, theight := th.Dimensions()
+		p.Thumbnail = append(p.Thumbnail, &schema.Pic_File{
+			Index:         nextPicFileIndex(p.Thumbnail, p.Derived),
+			Size:          thumbfi.Size(),
+			Mime:          imtmime,
+			Width:         int64(twidth),
+			Height:        int64(theight),
+			AnimationInfo: imtanim,
+			CreatedTs:     nowts,
+			ModifiedTs:    nowts,
+		})
 	}
 
-	if err := t.MkdirAll(schema.PicBaseDir(t.PixPath, p.PicId), 0770); err != nil {
-		return status.Internal(err, "Can't prepare pic dir")
-	}
-	newpath, sts := schema.PicFilePath(t.PixPath, p.PicId, p.File.Mime)
-	if sts != nil {
-		return sts
-	}
-	destroyTempFile = false
-	if err := f.Close(); err != nil {
-		sts := status.Internal(err, "can't close", f.Name())
-		if err2 := t.Remove(f.Name()); err2 != nil {
-			sts = status.WithSuppressed(sts, status.Internal(err2, "can't remove", f.Name()))
-		}
+	if sts := mergePic(j, p, nowts, pfs, userId, ext); sts != nil {
 		return sts
 	}
-	if err := t.Rename(f.Name(), newpath); err != nil {
-		sts := status.Internalf(err, "can't rename %v to %v", f.Name(), newpath)
-		if err2 := t.Remove(f.Name()); err2 != nil {
-			sts = status.WithSuppressed(sts, status.Internal(err2, "can't remove", f.Name()))
-		}
+
+	newKey := blobstore.PicFileKey(p.PicId, p.File.Mime)
+	if sts := t.BlobStore.Put(ctx, newKey, io.NewSectionReader(f, 0, size)); sts != nil {
 		return sts
 	}
 	destroyNewFile := true
 	defer func() {
 		if destroyNewFile {
-			if err := t.Remove(newpath); err != nil {
-				status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", newpath))
+			if sts := t.BlobStore.Delete(ctx, newKey); sts != nil {
+				status.ReplaceOrSuppress(&stscap, sts)
 			}
 		}
 	}()
 
-	lastthumbnail := p.Thumbnail[len(p.Thumbnail)-1]
-	newthumbpath, sts := schema.PicFileDerivedPath(
-		t.PixPath, p.PicId, lastthumbnail.Index, lastthumbnail.Mime)
-	if sts != nil {
-		return sts
-	}
-	destroyTempThumbFile = false
-	if err := ft.Close(); err != nil {
-		sts := status.Internal(err, "can't close", ft.Name())
-		if err2 := t.Remove(ft.Name()); err2 != nil {
-			sts = status.WithSuppressed(sts, status.Internal(err2, "can't remove", ft.Name()))
-		}
-		return sts
-	}
-	if err := t.Rename(ft.Name(), newthumbpath); err != nil {
-		sts := status.Internalf(err, "can't rename %v to %v", ft.Name(), newthumbpath)
-		if err2 := t.Remove(ft.Name()); err2 != nil {
-			sts = status.WithSuppressed(sts, status.Internal(err2, "can't remove", ft.Name()))
+	destroyNewThumbnails := true
+	for i, ft := range thumbFiles {
+		newthumbnail := p.Thumbnail[firstNewThumbnail+i]
+		newThumbKey :=
+			blobstore.PicFileDerivedKey(p.PicId, newthumbnail.Index, newthumbnail.Mime)
+		if sts := t.BlobStore.Put(
+			ctx, newThumbKey, io.NewSectionReader(ft, 0, newthumbnail.Size)); sts != nil {
+			return sts
 		}
-		return sts
-	}
-	destroyNewThumbnail := true
-	defer func() {
-		if destroyNewThumbnail {
-			if err := t.Remove(newthumbpath); err != nil {
-				status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", newthumbpath))
+		defer func() {
+			if destroyNewThumbnails {
+				if sts := t.BlobStore.Delete(ctx, newThumbKey); sts != nil {
+					status.ReplaceOrSuppress(&stscap, sts)
+				}
 			}
-		}
-	}()
+		}()
+	}
 
 	destroyNewDerived := true
 	if len(p.Derived) != 0 {
 		lastderived := p.Derived[len(p.Derived)-1]
-
-		newderivedpath, sts := schema.PicFileDerivedPath(
-			t.PixPath, p.PicId, lastderived.Index, lastderived.Mime)
-		if sts != nil {
-			return sts
-		}
-		destroyTempDerivedFile = false
-		if err := derivedFile.Close(); err != nil {
-			sts := status.Internal(err, "can't close", derivedFile.Name())
-			if err2 := t.Remove(derivedFile.Name()); err2 != nil {
-				sts = status.WithSuppressed(sts, status.Internal(err2, "can't remove", derivedFile.Name()))
-			}
-			return sts
-		}
-		if err := t.Rename(derivedFile.Name(), newderivedpath); err != nil {
-			sts := status.Internalf(err, "can't rename %v to %v", derivedFile.Name(), newderivedpath)
-			if err2 := t.Remove(derivedFile.Name()); err2 != nil {
-				sts = status.WithSuppressed(sts, status.Internal(err2, "can't remove", derivedFile.Name()))
-			}
+		newDerivedKey := blobstore.PicFileDerivedKey(p.PicId, lastderived.Index, lastderived.Mime)
+		if sts := t.BlobStore.Put(
+			ctx, newDerivedKey, io.NewSectionReader(derivedFile, 0, lastderived.Size)); sts != nil {
 			return sts
 		}
 		defer func() {
 			if destroyNewDerived {
-				if err := t.Remove(newderivedpath); err != nil {
-					status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove", newderivedpath))
+				if sts := t.BlobStore.Delete(ctx, newDerivedKey); sts != nil {
+					status.ReplaceOrSuppress(&stscap, sts)
 				}
 			}
 		}()
-
 	}
 
 	// Keep the files, even if commit fails.  It's possible the commit actually succeeded, in which
 	// case deleting the files would be corruption.  Better to have occasional bad files in the
-	// directory than data corruption.
+	// blob store than data corruption.
 	destroyNewFile = false
-	destroyNewThumbnail = false
+	destroyNewThumbnails = false
 	destroyNewDerived = false
 	if err := j.Commit(); err != nil {
 		return status.Internal(err, "can't commit")
 	}
+	if t.SimilarityIndex != nil && dct0Hash != nil {
+		hash, sts := similarity.Hash(dct0Hash)
+		if sts != nil {
+			return sts
+		}
+		t.SimilarityIndex.Add(p.PicId, hash)
+	}
 
 	t.UnfilteredCreatedPic = p
-	t.CreatedPic = filterPic(t.UnfilteredCreatedPic, u, conf)
+	t.CreatedPic = filterPic(t.UnfilteredCreatedPic, ru, conf)
+	t.SuspectedDuplicatePicIds = duplicatePicIds
 	return nil
 }
 
+// findNearDuplicates finds existing pics that are perceptually similar to the DCT_0 hash, and
+// that su can see.
+func (t *UpsertPicTask) findNearDuplicates(
+	j *tab.Job, conf *schema.Configuration, su *schema.User, value []byte) ([]int64, status.S) {
+	policy := conf.NearDuplicatePolicy
+	if policy == nil {
+		return nil, nil
+	}
+	if policy.MaxDistance < 0 || policy.MaxDistance > similarity.MaxRadius {
+		return nil, status.Internal(nil, "bad near duplicate max distance", policy.MaxDistance)
+	}
+	hash, sts := similarity.Hash(value)
+	if sts != nil {
+		return nil, sts
+	}
+	matches, sts := findSimilarPics(
+		j, t.SimilarityIndex, hash, int(policy.MaxDistance), maxNearDuplicates, 0,
+		userCredOf(su, conf))
+	if sts != nil {
+		return nil, sts
+	}
+	var picIds []int64
+	for _, m := range matches {
+		picIds = append(picIds, m.PicId)
+	}
+	return picIds, nil
+}
+
 func confUrlLen(conf *schema.Configuration) (int64, int64) {
 	var minUrlLen, maxUrlLen int64
 	if conf.MinUrlLength != nil {
@@ -528,6 +600,39 @@ func confFileNameLen(conf *schema.Configuration) (int64, int64) {
 	return minFileNameLen, maxFileNameLen
 }
 
+func confMaxFileSize(conf *schema.Configuration) int64 {
+	if conf.MaxFileSize != nil {
+		return conf.MaxFileSize.Value
+	}
+	return math.MaxInt64
+}
+
+func (t *UpsertPicTask) reportProgress(
+	stage schema.BackgroundJob_Progress_Stage, downloaded, total int64) {
+	if t.Progress == nil {
+		return
+	}
+	t.Progress(&schema.BackgroundJob_Progress{
+		Stage:           stage,
+		DownloadedBytes: downloaded,
+		TotalBytes:      total,
+	})
+}
+
+// progressWriter reports how many bytes have been written to w.
+type progressWriter struct {
+	w       io.Writer
+	n       int64
+	written func(n int64)
+}
+
+func (pw *progressWriter) Write(data []byte) (int, error) {
+	n, err := pw.w.Write(data)
+	pw.n += int64(n)
+	pw.written(pw.n)
+	return n, err
+}
+
 // TODO: test
 func nextPicFileIndex(thumbs, derived []*schema.Pic_File) int64 {
 	used := make(map[int64]bool)
@@ -559,6 +664,12 @@ func imageFormatToMime(f imaging.ImageFormat) (schema.Pic_File_Mime, status.S) {
 		return schema.Pic_File_WEBM, nil
 	case f.IsMp4():
 		return schema.Pic_File_MP4, nil
+	case f.IsWebp():
+		return schema.Pic_File_WEBP, nil
+	case f.IsAvif():
+		return schema.Pic_File_AVIF, nil
+	case f.IsHeic():
+		return schema.Pic_File_HEIC, nil
 	default:
 		return schema.Pic_File_UNKNOWN, status.InvalidArgument(nil, "unknown image type", f)
 	}
@@ -615,6 +726,22 @@ func mergePic(j *tab.Job, p *schema.Pic, nowts *tspb.Timestamp, pfs *schema.Pic_
 			}
 		}
 	}
+	// The user already has the file, so there is no point in hiding the private pic from them.
+	if p.Private() && userId != schema.AnonymousUserId && userId != p.UploaderUserId() {
+		var visible bool
+		for _, id := range p.Visibility.UserId {
+			if id == userId {
+				visible = true
+				break
+			}
+		}
+		if !visible {
+			p.Visibility.UserId = append(p.Visibility.UserId, userId)
+			sort.Slice(p.Visibility.UserId, func(i, k int) bool {
+				return p.Visibility.UserId[i] < p.Visibility.UserId[k]
+			})
+		}
+	}
 	if len(ext) != 0 && len(p.Ext) == 0 {
 		p.Ext = make(map[string]*any.Any)
 	}
@@ -632,6 +759,73 @@ func mergePic(j *tab.Job, p *schema.Pic, nowts *tspb.Timestamp, pfs *schema.Pic_
 	return nil
 }
 
+// cleanPicVisibility checks that the users and roles of v exist, and sorts and dedupes them.
+// Public visibility is returned as nil, since that is the default.
+func cleanPicVisibility(j *tab.Job, v *schema.Pic_Visibility, userId int64) (
+	*schema.Pic_Visibility, status.S) {
+	if v == nil {
+		return nil, nil
+	}
+	switch v.Mode {
+	case schema.Pic_Visibility_PUBLIC:
+		if len(v.UserId) != 0 || len(v.RoleId) != 0 {
+			return nil, status.InvalidArgument(nil, "can't list users or roles of public pic")
+		}
+		return nil, nil
+	case schema.Pic_Visibility_PRIVATE:
+	default:
+		return nil, status.InvalidArgument(nil, "bad visibility mode", v.Mode)
+	}
+	if userId == schema.AnonymousUserId {
+		return nil, status.InvalidArgument(nil, "anonymous users can't upload private pics")
+	}
+
+	dst := &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	seenUsers := make(map[int64]struct{}, len(v.UserId))
+	for _, id := range v.UserId {
+		if _, present := seenUsers[id]; present {
+			continue
+		}
+		seenUsers[id] = struct{}{}
+		visUserId := id
+		us, err := j.FindUsers(db.Opts{
+			Prefix: tab.UsersPrimary{&visUserId},
+			Lock:   db.LockRead,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find users")
+		}
+		if len(us) != 1 {
+			return nil, status.NotFound(nil, "can't find user", id)
+		}
+		dst.UserId = append(dst.UserId, id)
+	}
+	seenRoles := make(map[int64]struct{}, len(v.RoleId))
+	for _, id := range v.RoleId {
+		if _, present := seenRoles[id]; present {
+			continue
+		}
+		seenRoles[id] = struct{}{}
+		roleId := id
+		rs, err := j.FindRoles(db.Opts{
+			Prefix: tab.RolesPrimary{&roleId},
+			Lock:   db.LockRead,
+		})
+		if err != nil {
+			return nil, status.Internal(err, "can't find roles")
+		}
+		if len(rs) != 1 {
+			return nil, status.NotFound(nil, "can't find role", id)
+		}
+		dst.RoleId = append(dst.RoleId, id)
+	}
+	sort.Slice(dst.UserId, func(i, k int) bool { return dst.UserId[i] < dst.UserId[k] })
+	sort.Slice(dst.RoleId, func(i, k int) bool { return dst.RoleId[i] < dst.RoleId[k] })
+	return dst, nil
+}
+
 func findExistingPic(j *tab.Job, typ schema.PicIdent_Type, hash []byte) (*schema.Pic, status.S) {
 	pis, err := j.FindPicIdents(db.Opts{
 		Prefix: tab.PicIdentsIdent{
@@ -690,11 +884,7 @@ func insertPicHashes(j *tab.Job, picId int64, md5Hash, sha1Hash, sha512_256Hash
 	return nil
 }
 
-func insertPerceptualHash(j *tab.Job, picId int64, im imaging.PixurImage) status.S {
-	hash, inputs, sts := im.PerceptualHash0()
-	if sts != nil {
-		return sts
-	}
+func insertPerceptualHash(j *tab.Job, picId int64, hash []byte, inputs []float32) status.S {
 	dct0Ident := &schema.PicIdent{
 		PicId:      picId,
 		Type:       schema.PicIdent_DCT_0,
@@ -707,6 +897,22 @@ func insertPerceptualHash(j *tab.Job, picId int64, im imaging.PixurImage) status
 	return nil
 }
 
+// findPerceptualHash returns the DCT_0 hash of a pic, or nil if it has none.
+func findPerceptualHash(j *tab.Job, picId int64) ([]byte, status.S) {
+	typ := schema.PicIdent_DCT_0
+	pis, err := j.FindPicIdents(db.Opts{
+		Prefix: tab.PicIdentsPrimary{PicId: &picId, Type: &typ},
+		Limit:  1,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pic idents")
+	}
+	if len(pis) == 0 {
+		return nil, nil
+	}
+	return pis[0].Value, nil
+}
+
 // TODO: test
 func (t *UpsertPicTask) prepareLocalFile(ctx context.Context, r io.ReadSeeker) (
 	_ *os.File, _ func(*status.S), _ int64, stscap status.S) {
@@ -761,7 +967,7 @@ func (t *UpsertPicTask) prepareFile(move func(io.Writer) status.S) (
 
 // TODO: test
 func (t *UpsertPicTask) tempFile() (*os.File, func(*status.S), status.S) {
-	f, err := t.TempFile(t.PixPath, "__")
+	f, err := t.TempFile("", "__")
 	if err != nil {
 		return nil, nil, status.Internal(err, "can't create tempfile")
 	}
@@ -839,7 +1045,7 @@ func validateAndNormalizeURL(rawurl string, minLen, maxLen int64) (*url.URL, sta
 	return loc, nil
 }
 
-func (t *UpsertPicTask) prepareRemoteFile(ctx context.Context, loc, ref *url.URL) (
+func (t *UpsertPicTask) prepareRemoteFile(ctx context.Context, loc, ref *url.URL, maxSize int64) (
 	_ *os.File, _ func(*status.S), _ int64, _ *dispositionName, stscap status.S) {
 	if loc == nil {
 		return nil, nil, 0, nil, status.InvalidArgument(nil, "missing URL")
@@ -872,12 +1078,35 @@ func (t *UpsertPicTask) prepareRemoteFile(ctx context.Context, loc, ref *url.URL
 			status.InvalidArgumentf(nil, "can't download %s [%d]", loc, resp.StatusCode)
 	}
 
+	if resp.ContentLength > maxSize {
+		return nil, nil, 0, nil,
+			status.InvalidArgumentf(nil, "file size %d exceeds max %d", resp.ContentLength, maxSize)
+	}
+	var total int64
+	if resp.ContentLength > 0 {
+		total = resp.ContentLength
+	}
+	t.reportProgress(schema.BackgroundJob_Progress_DOWNLOADING, 0, total)
+
 	var size int64
 	f, cleanup, sts := t.prepareFile(func(w io.Writer) status.S {
-		if n, err := io.Copy(w, resp.Body); err != nil {
+		pw := &progressWriter{
+			w: w,
+			written: func(n int64) {
+				t.reportProgress(schema.BackgroundJob_Progress_DOWNLOADING, n, total)
+			},
+		}
+		body := io.Reader(resp.Body)
+		if maxSize < math.MaxInt64 {
+			// Read one extra byte, to tell if the file is too large.
+			body = io.LimitReader(resp.Body, maxSize+1)
+		}
+		if n, err := io.Copy(pw, body); err != nil {
 			// This could either be because the remote hung up or a file error on our side.  Assume that
 			// our system is okay, making this an InvalidArgument
 			return status.InvalidArgument(err, "can't copy file", loc)
+		} else if n > maxSize {
+			return status.InvalidArgumentf(nil, "file size exceeds max %d", maxSize)
 		} else {
 			size = n
 			return nil
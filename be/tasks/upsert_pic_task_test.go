@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha512"
@@ -10,10 +11,12 @@ import (
 	"image/gif"
 	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -21,6 +24,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	any "github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
 
 	"pixur.org/pixur/be/imaging"
 	"pixur.org/pixur/be/schema"
@@ -75,10 +79,8 @@ func TestUpsertPicTask_URL(t *testing.T) {
 	task := &UpsertPicTask{
 		Beg:        c.DB(),
 		Now:        func() time.Time { return now },
-		PixPath:    c.TempDir(),
+		BlobStore:  c.BlobStore(),
 		TempFile:   func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll:   os.MkdirAll,
-		Rename:     os.Rename,
 		Remove:     os.Remove,
 		HTTPClient: http.DefaultClient,
 
@@ -150,13 +152,11 @@ func TestUpsertPicTask_BadFileName(t *testing.T) {
 	now := time.Unix(100, 0)
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return now },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return now },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
 
 		File:     f,
 		FileName: "/root",
@@ -329,13 +329,11 @@ func TestUpsertPicTask_PresentPicExtCap(t *testing.T) {
 	ext := map[string]*any.Any{"foo": a}
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
-		Now:      time.Now,
+		Beg:       c.DB(),
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
+		Now:       time.Now,
 
 		File: f,
 		Ext:  ext,
@@ -485,13 +483,11 @@ func TestUpsertPicTask_Md5PresentHardTempDeleted(t *testing.T) {
 	md5Hash := p.Md5()
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return time.Unix(100, 0) },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Unix(100, 0) },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
 
 		File:    f,
 		Md5Hash: md5Hash,
@@ -561,12 +557,10 @@ func TestUpsertPicTask_Md5Mismatch(t *testing.T) {
 	md5Hash[0] = md5Hash[0] + 0x10
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return time.Unix(100, 0) },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Unix(100, 0) },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
 
 		File:    f,
 		Md5Hash: md5Hash,
@@ -592,13 +586,11 @@ func TestUpsertPicTask_BadImage(t *testing.T) {
 	u.Update()
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return time.Unix(100, 0) },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Unix(100, 0) },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
 
 		// empty
 		File: c.TempFile(),
@@ -632,13 +624,11 @@ func TestUpsertPicTask_Duplicate(t *testing.T) {
 	defer f.Close()
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return time.Unix(100, 0) },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Unix(100, 0) },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
 
 		File:     f,
 		FileName: "orig",
@@ -688,13 +678,11 @@ func TestUpsertPicTask_DuplicateHardPermanentDeleted(t *testing.T) {
 	p.Update()
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return time.Unix(100, 0) },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Unix(100, 0) },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
 
 		File: f,
 	}
@@ -750,13 +738,11 @@ func TestUpsertPicTask_DuplicateHardTempDeleted(t *testing.T) {
 	p.Update()
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return time.Unix(100, 0) },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Unix(100, 0) },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
 
 		File: f,
 	}
@@ -813,13 +799,11 @@ func TestUpsertPicTask_NewPic(t *testing.T) {
 	}
 
 	task := &UpsertPicTask{
-		Beg:      c.DB(),
-		Now:      func() time.Time { return time.Unix(100, 0) },
-		PixPath:  c.TempDir(),
-		TempFile: func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
-		MkdirAll: os.MkdirAll,
-		Rename:   os.Rename,
-		Remove:   os.Remove,
+		Beg:       c.DB(),
+		Now:       func() time.Time { return time.Unix(100, 0) },
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
 
 		File: f,
 	}
@@ -912,6 +896,117 @@ func TestUpsertPicTask_NewPic(t *testing.T) {
 	}
 }
 
+func TestUpsertPicTask_NewPrivatePic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	viewer := c.CreateUser()
+	r := c.CreateRole("Friends")
+
+	f := c.TempFile()
+	defer f.Close()
+	img := image.NewGray(image.Rect(0, 0, 8, 10))
+	if err := gif.Encode(f, img, &gif.Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(0, os.SEEK_SET); err != nil {
+		t.Fatal(err)
+	}
+
+	task := &UpsertPicTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
+
+		File: f,
+		Visibility: &schema.Pic_Visibility{
+			Mode:   schema.Pic_Visibility_PRIVATE,
+			UserId: []int64{viewer.User.UserId, viewer.User.UserId},
+			RoleId: []int64{r.RoleId},
+		},
+	}
+
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	tp := c.WrapPic(task.UnfilteredCreatedPic)
+	tp.Refresh()
+	want := &schema.Pic_Visibility{
+		Mode:   schema.Pic_Visibility_PRIVATE,
+		UserId: []int64{viewer.User.UserId},
+		RoleId: []int64{r.RoleId},
+	}
+	if have := tp.Pic.Visibility; !proto.Equal(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if task.CreatedPic == nil {
+		t.Error("uploader can't see own pic")
+	}
+}
+
+func TestCleanPicVisibility_public(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	j := c.Job()
+	defer j.Rollback()
+
+	v, sts := cleanPicVisibility(j, &schema.Pic_Visibility{}, u.User.UserId)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if v != nil {
+		t.Error("have", v, "want", nil)
+	}
+}
+
+func TestCleanPicVisibility_failsOnAnonymous(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	j := c.Job()
+	defer j.Rollback()
+
+	_, sts := cleanPicVisibility(j, &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}, schema.AnonymousUserId)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestCleanPicVisibility_failsOnMissingRole(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	roleId := c.Id()
+	j := c.Job()
+	defer j.Rollback()
+
+	_, sts := cleanPicVisibility(j, &schema.Pic_Visibility{
+		Mode:   schema.Pic_Visibility_PRIVATE,
+		RoleId: []int64{roleId},
+	}, u.User.UserId)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
 func TestMerge(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -1094,6 +1189,42 @@ func TestMergeAddsSource(t *testing.T) {
 	}
 }
 
+func TestMergeAddsUserToPrivatePic(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	p := c.CreatePic()
+	p.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	p.Update()
+
+	u := c.CreateUser()
+
+	j := c.Job()
+	defer j.Rollback()
+
+	nowts := schema.ToTspb(time.Now())
+	pfs := &schema.Pic_FileSource{
+		Url:       "http://foo/",
+		UserId:    u.User.UserId,
+		CreatedTs: nowts,
+	}
+
+	if sts := mergePic(j, p.Pic, nowts, pfs, u.User.UserId, nil); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if err := j.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	p.Refresh()
+	if have, want := p.Pic.Visibility.UserId, []int64{u.User.UserId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
 func TestMergeIgnoresDuplicateSource(t *testing.T) {
 	c := Container(t)
 	defer c.Close()
@@ -1228,6 +1359,186 @@ func TestMergeIgnoresEmptySourceExceptForFirst(t *testing.T) {
 	}
 }
 
+func (c *TestContainer) upsertGrayGif(ctx context.Context, width, height int) (
+	*UpsertPicTask, status.S) {
+	f := c.TempFile()
+	defer f.Close()
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	if err := gif.Encode(f, img, &gif.Options{}); err != nil {
+		c.T.Fatal(err)
+	}
+	if _, err := f.Seek(0, os.SEEK_SET); err != nil {
+		c.T.Fatal(err)
+	}
+
+	task := &UpsertPicTask{
+		Beg:       c.DB(),
+		Now:       time.Now,
+		BlobStore: c.BlobStore(),
+		TempFile:  func(dir, prefix string) (*os.File, error) { return c.TempFile(), nil },
+		Remove:    os.Remove,
+
+		File: f,
+	}
+	return task, new(TaskRunner).Run(ctx, task)
+}
+
+func nearDuplicateCtx(t *testing.T, c *TestContainer, u *TestUser,
+	action schema.Configuration_NearDuplicatePolicy_Action) context.Context {
+	conf, sts := GetConfiguration(c.Ctx)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	conf.NearDuplicatePolicy = &schema.Configuration_NearDuplicatePolicy{
+		Action:      action,
+		MaxDistance: 4,
+	}
+	return CtxFromTestConfig(u.AuthedCtx(c.Ctx), conf)
+}
+
+func TestUpsertPicTask_NearDuplicateAllowed(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	ctx := nearDuplicateCtx(t, c, u, schema.Configuration_NearDuplicatePolicy_ALLOW)
+
+	first, sts := c.upsertGrayGif(ctx, 8, 10)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(first.SuspectedDuplicatePicIds) != 0 {
+		t.Error("have", first.SuspectedDuplicatePicIds, "want none")
+	}
+	// Different bytes, but looks the same.
+	second, sts := c.upsertGrayGif(ctx, 16, 20)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := second.CreatedPic.PicId, first.CreatedPic.PicId; have == want {
+		t.Error("expected a new pic", have)
+	}
+	if have, want := second.SuspectedDuplicatePicIds, []int64{first.CreatedPic.PicId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	if second.CreatedPic.DuplicateReview != nil {
+		t.Error("unexpected review", second.CreatedPic.DuplicateReview)
+	}
+}
+
+func TestUpsertPicTask_NearDuplicateIgnoresPrivatePics(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	first, sts := c.upsertGrayGif(
+		nearDuplicateCtx(t, c, u, schema.Configuration_NearDuplicatePolicy_ALLOW), 8, 10)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	fp := c.WrapPic(first.UnfilteredCreatedPic)
+	fp.Pic.Visibility = &schema.Pic_Visibility{
+		Mode: schema.Pic_Visibility_PRIVATE,
+	}
+	fp.Update()
+
+	other := c.CreateUser()
+	other.User.Capability = append(other.User.Capability, schema.User_PIC_CREATE)
+	other.Update()
+	second, sts := c.upsertGrayGif(
+		nearDuplicateCtx(t, c, other, schema.Configuration_NearDuplicatePolicy_REJECT), 16, 20)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(second.SuspectedDuplicatePicIds) != 0 {
+		t.Error("have", second.SuspectedDuplicatePicIds, "want none")
+	}
+}
+
+func TestUpsertPicTask_NearDuplicateRejected(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	ctx := nearDuplicateCtx(t, c, u, schema.Configuration_NearDuplicatePolicy_REJECT)
+
+	if _, sts := c.upsertGrayGif(ctx, 8, 10); sts != nil {
+		t.Fatal(sts)
+	}
+	_, sts := c.upsertGrayGif(ctx, 16, 20)
+	if sts == nil || sts.Code() != codes.AlreadyExists {
+		t.Error("have", sts, "want", codes.AlreadyExists)
+	}
+}
+
+func TestUpsertPicTask_NearDuplicateMerged(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	ctx := nearDuplicateCtx(t, c, u, schema.Configuration_NearDuplicatePolicy_MERGE)
+
+	first, sts := c.upsertGrayGif(ctx, 8, 10)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	second, sts := c.upsertGrayGif(ctx, 16, 20)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := second.CreatedPic.PicId, first.CreatedPic.PicId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := second.SuspectedDuplicatePicIds, []int64{first.CreatedPic.PicId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+	// The file stays the same.
+	if have, want := second.CreatedPic.File.Width, int64(8); have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertPicTask_NearDuplicateFlagged(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_PIC_CREATE)
+	u.Update()
+	ctx := nearDuplicateCtx(t, c, u, schema.Configuration_NearDuplicatePolicy_FLAG)
+
+	first, sts := c.upsertGrayGif(ctx, 8, 10)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if first.UnfilteredCreatedPic.DuplicateReview != nil {
+		t.Error("unexpected review", first.UnfilteredCreatedPic.DuplicateReview)
+	}
+	second, sts := c.upsertGrayGif(ctx, 16, 20)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := second.CreatedPic.PicId, first.CreatedPic.PicId; have == want {
+		t.Error("expected a new pic", have)
+	}
+	tp := c.WrapPic(second.UnfilteredCreatedPic)
+	tp.Refresh()
+	if tp.Pic.DuplicateReview == nil {
+		t.Fatal("missing review")
+	}
+	if have, want := tp.Pic.DuplicateReview.SuspectedPicId, []int64{first.CreatedPic.PicId}; !reflect.DeepEqual(have, want) {
+		t.Error("have", have, "want", want)
+	}
+}
+
 /*
 func TestUpsertPicTask_CreateTempFileFails(t *testing.T) {
 	c := Container(t)
@@ -1560,8 +1871,8 @@ func TestInsertPerceptualHash(t *testing.T) {
 		Dct0Values: inputs,
 	}
 
-	if err := insertPerceptualHash(j, 1234, im); err != nil {
-		t.Fatal(err)
+	if sts := insertPerceptualHash(j, 1234, hash, inputs); sts != nil {
+		t.Fatal(sts)
 	}
 
 	idents, err := j.FindPicIdents(db.Opts{})
@@ -1594,7 +1905,11 @@ func TestInsertPerceptualHash_Failure(t *testing.T) {
 		t.Fatal(sts)
 	}
 	defer im.Close()
-	sts = insertPerceptualHash(j, 1234, im)
+	hash, inputs, sts := im.PerceptualHash0()
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	sts = insertPerceptualHash(j, 1234, hash, inputs)
 	expected := status.Internal(nil, "can't create dct0")
 	compareStatus(t, sts, expected)
 
@@ -1617,13 +1932,13 @@ func TestUpsertPicTask_prepareRemoteFile_badAddress(t *testing.T) {
 		HTTPClient: http.DefaultClient,
 		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
 		Remove:     os.Remove,
-		PixPath:    c.TempDir(),
+		BlobStore:  c.BlobStore(),
 	}
 	loc, err := url.Parse("http://")
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil)
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, math.MaxInt64)
 	expected := status.InvalidArgument(nil, "can't download http:")
 	compareStatus(t, sts, expected)
 }
@@ -1643,13 +1958,13 @@ func TestUpsertPicTask_prepareRemoteFile_badStatus(t *testing.T) {
 		HTTPClient: http.DefaultClient,
 		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
 		Remove:     os.Remove,
-		PixPath:    c.TempDir(),
+		BlobStore:  c.BlobStore(),
 	}
 	loc, err := url.Parse(serv.URL + "/foo/bar.jpg?ignore=true#content")
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil)
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, math.MaxInt64)
 	expected := status.InvalidArgument(nil, "can't download")
 
 	compareStatus(t, sts, expected)
@@ -1679,13 +1994,13 @@ func TestUpsertPicTask_prepareRemoteFile_badTransfer(t *testing.T) {
 		HTTPClient: http.DefaultClient,
 		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
 		Remove:     os.Remove,
-		PixPath:    c.TempDir(),
+		BlobStore:  c.BlobStore(),
 	}
 	loc, err := url.Parse(serv.URL + "/foo/bar.jpg?ignore=true#content")
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil)
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, math.MaxInt64)
 	expected := status.InvalidArgument(nil, "can't copy file")
 
 	compareStatus(t, sts, expected)
@@ -1717,13 +2032,13 @@ func TestUpsertPicTask_prepareRemoteFile(t *testing.T) {
 		HTTPClient: http.DefaultClient,
 		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
 		Remove:     os.Remove,
-		PixPath:    c.TempDir(),
+		BlobStore:  c.BlobStore(),
 	}
 	loc, err := url.Parse(serv.URL + "/foo/bar.jpg?ignore=true#content")
 	if err != nil {
 		t.Fatal(err)
 	}
-	f, cleanup, size, disponame, sts := task.prepareRemoteFile(c.Ctx, loc, ref)
+	f, cleanup, size, disponame, sts := task.prepareRemoteFile(c.Ctx, loc, ref, math.MaxInt64)
 	if sts != nil {
 		t.Fatal(sts)
 	}
@@ -1743,6 +2058,110 @@ func TestUpsertPicTask_prepareRemoteFile(t *testing.T) {
 	}
 }
 
+func TestUpsertPicTask_prepareRemoteFileReportsProgress(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "4")
+		if _, err := w.Write([]byte("good")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	var last *schema.BackgroundJob_Progress
+	task := &UpsertPicTask{
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:     os.Remove,
+		BlobStore:  c.BlobStore(),
+		Progress: func(pr *schema.BackgroundJob_Progress) {
+			last = pr
+		},
+	}
+	loc, err := url.Parse(serv.URL + "/foo/bar.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, cleanup, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, math.MaxInt64)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	defer cleanup(new(status.S))
+
+	expected := &schema.BackgroundJob_Progress{
+		Stage:           schema.BackgroundJob_Progress_DOWNLOADING,
+		DownloadedBytes: 4,
+		TotalBytes:      4,
+	}
+	if !proto.Equal(last, expected) {
+		t.Error("have", last, "want", expected)
+	}
+}
+
+func TestUpsertPicTask_prepareRemoteFileFailsOnLargeFile(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		// Don't set the length, so that it can only be found by reading.
+		w.Header().Set("Transfer-Encoding", "chunked")
+		if _, err := w.Write([]byte("toolarge")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	task := &UpsertPicTask{
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:     os.Remove,
+		BlobStore:  c.BlobStore(),
+	}
+	loc, err := url.Parse(serv.URL + "/foo/bar.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, 4)
+	expected := status.InvalidArgument(nil, "file size exceeds max 4")
+
+	compareStatus(t, sts, expected)
+}
+
+func TestUpsertPicTask_prepareRemoteFileFailsOnLargeContentLength(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte("toolarge")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	serv := httptest.NewServer(http.HandlerFunc(handler))
+	defer serv.Close()
+
+	task := &UpsertPicTask{
+		HTTPClient: http.DefaultClient,
+		TempFile:   func(_, _ string) (*os.File, error) { return ioutil.TempFile(c.TempDir(), "") },
+		Remove:     os.Remove,
+		BlobStore:  c.BlobStore(),
+	}
+	loc, err := url.Parse(serv.URL + "/foo/bar.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, _, sts := task.prepareRemoteFile(c.Ctx, loc, nil, 4)
+	expected := status.InvalidArgument(nil, "file size 8 exceeds max 4")
+
+	compareStatus(t, sts, expected)
+}
+
 func TestUpsertPicTask_parseUrlName(t *testing.T) {
 	maps := [][2]string{
 		{"http://foo.com", ""},
@@ -0,0 +1,168 @@
+package tasks
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+// UpsertTagAliasTask creates or updates an alias to redirect to a canonical tag.  Existing pic tags
+// using the alias name are not changed.
+type UpsertTagAliasTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	Alias   string
+	TagName string
+
+	// Outputs
+	TagAlias *schema.TagAlias
+}
+
+func (t *UpsertTagAliasTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, u, conf, schema.User_TAG_ALIAS_UPDATE); sts != nil {
+		return sts
+	}
+
+	userId := schema.AnonymousUserId
+	if u != nil {
+		userId = u.UserId
+	}
+
+	var minTagLen, maxTagLen int64
+	if conf.MinTagLength != nil {
+		minTagLen = conf.MinTagLength.Value
+	} else {
+		minTagLen = math.MinInt64
+	}
+	if conf.MaxTagLength != nil {
+		maxTagLen = conf.MaxTagLength.Value
+	} else {
+		maxTagLen = math.MaxInt64
+	}
+
+	names, sts := cleanTagNames([]string{t.Alias, t.TagName}, minTagLen, maxTagLen)
+	if sts != nil {
+		return sts
+	}
+	alias := names[0]
+	tag, sts := lookupTagByName(j, names[1].uniq)
+	if sts != nil {
+		return sts
+	}
+	if schema.TagUniqueName(tag.Name) == alias.uniq {
+		return status.InvalidArgument(nil, "can't alias tag to itself")
+	}
+
+	tas, err := j.FindTagAliases(db.Opts{
+		Prefix: tab.TagAliasesPrimary{&alias.uniq},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tag aliases")
+	}
+	if len(tas) == 1 {
+		ta := tas[0]
+		ta.TagId = tag.TagId
+		ta.SetModifiedTime(now)
+		if err := j.UpdateTagAlias(ta); err != nil {
+			return status.Internal(err, "can't update tag alias")
+		}
+		t.TagAlias = ta
+	} else {
+		ta := &schema.TagAlias{
+			Name:   alias.name,
+			TagId:  tag.TagId,
+			UserId: userId,
+		}
+		ta.SetCreatedTime(now)
+		ta.SetModifiedTime(now)
+		if err := j.InsertTagAlias(ta); err != nil {
+			return status.Internal(err, "can't create tag alias")
+		}
+		t.TagAlias = ta
+	}
+
+	// Don't leave aliases pointing at a tag that is itself an alias.
+	if sts := redirectTagAliases(j, alias.uniq, tag.TagId, now); sts != nil {
+		return sts
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	return nil
+}
+
+// lookupTagByName finds a tag by its unique name, following any alias.
+func lookupTagByName(j *tab.Job, uniq string) (*schema.Tag, status.S) {
+	tag, sts := lookupTagAlias(j, uniq)
+	if sts != nil {
+		return nil, sts
+	}
+	if tag != nil {
+		return tag, nil
+	}
+	ts, err := j.FindTags(db.Opts{
+		Prefix: tab.TagsName{&uniq},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find tags")
+	}
+	if len(ts) != 1 {
+		return nil, status.NotFound(nil, "can't find tag", uniq)
+	}
+	return ts[0], nil
+}
+
+// redirectTagAliases points aliases of the tag named uniq at tagId instead.
+func redirectTagAliases(j *tab.Job, uniq string, tagId int64, now time.Time) status.S {
+	ts, err := j.FindTags(db.Opts{
+		Prefix: tab.TagsName{&uniq},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tags")
+	}
+	if len(ts) != 1 {
+		return nil
+	}
+	tas, err := j.FindTagAliases(db.Opts{
+		Prefix: tab.TagAliasesTagId{TagId: &ts[0].TagId},
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tag aliases")
+	}
+	for _, ta := range tas {
+		ta.TagId = tagId
+		ta.SetModifiedTime(now)
+		if err := j.UpdateTagAlias(ta); err != nil {
+			return status.Internal(err, "can't update tag alias")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestUpsertTagAliasTask_Create(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	tag := c.CreateTag()
+
+	task := &UpsertTagAliasTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Alias:   " Kitty ",
+		TagName: tag.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ta := &TestTagAlias{TagAlias: task.TagAlias, c: c}
+	if !ta.Refresh() {
+		t.Fatal("alias not created")
+	}
+	if have, want := ta.TagAlias.Name, "Kitty"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ta.TagAlias.TagId, tag.Tag.TagId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ta.TagAlias.UserId, u.User.UserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagAliasTask_Update(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	ta := c.CreateTagAlias("kitty", tag1)
+
+	task := &UpsertTagAliasTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Alias:   "KITTY",
+		TagName: tag2.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !ta.Refresh() {
+		t.Fatal("alias removed")
+	}
+	if have, want := ta.TagAlias.Name, "kitty"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ta.TagAlias.TagId, tag2.Tag.TagId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagAliasTask_TargetIsAlias(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	tag := c.CreateTag()
+	c.CreateTagAlias("kitty", tag)
+
+	task := &UpsertTagAliasTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Alias:   "kitten",
+		TagName: "kitty",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if have, want := task.TagAlias.TagId, tag.Tag.TagId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagAliasTask_RedirectsAliasesOfAliasedTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	ta := c.CreateTagAlias("kitty", tag1)
+
+	task := &UpsertTagAliasTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Alias:   tag1.Tag.Name,
+		TagName: tag2.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	if !ta.Refresh() {
+		t.Fatal("alias removed")
+	}
+	if have, want := ta.TagAlias.TagId, tag2.Tag.TagId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagAliasTask_MissingTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	task := &UpsertTagAliasTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Alias:   "kitty",
+		TagName: "cat",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagAliasTask_Self(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_ALIAS_UPDATE)
+	u.Update()
+
+	tag := c.CreateTag()
+
+	task := &UpsertTagAliasTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Alias:   tag.Tag.Name,
+		TagName: tag.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagAliasTask_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	tag := c.CreateTag()
+
+	task := &UpsertTagAliasTask{
+		Beg:     c.DB(),
+		Now:     time.Now,
+		Alias:   "kitty",
+		TagName: tag.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
@@ -0,0 +1,124 @@
+package tasks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	"pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/text"
+)
+
+// UpsertTagImplicationTask makes tagging a pic with one tag also tag it with another.  Existing
+// pic tags are not changed.
+type UpsertTagImplicationTask struct {
+	// Deps
+	Beg tab.JobBeginner
+	Now func() time.Time
+
+	// Inputs
+	TagName        string
+	ImpliedTagName string
+
+	// Outputs
+	TagImplication *schema.TagImplication
+}
+
+func (t *UpsertTagImplicationTask) Run(ctx context.Context) (stscap status.S) {
+	now := t.Now()
+	j, u, sts := authedJob(ctx, t.Beg, now)
+	if sts != nil {
+		return sts
+	}
+	defer revert(j, &stscap)
+
+	conf, sts := GetConfiguration(ctx)
+	if sts != nil {
+		return sts
+	}
+	if sts := validateCapability(j, u, conf, schema.User_TAG_IMPLICATION_UPDATE); sts != nil {
+		return sts
+	}
+
+	userId := schema.AnonymousUserId
+	if u != nil {
+		userId = u.UserId
+	}
+
+	tag, impliedTag, sts := lookupTagImplicationTags(j, t.TagName, t.ImpliedTagName)
+	if sts != nil {
+		return sts
+	}
+	if tag.TagId == impliedTag.TagId {
+		return status.InvalidArgument(nil, "tag can't imply itself")
+	}
+	// If the implied tag already implies the tag, they are really the same tag.
+	reverseIds, sts := findImpliedTagIds(j, []int64{impliedTag.TagId})
+	if sts != nil {
+		return sts
+	}
+	for _, id := range reverseIds {
+		if id == tag.TagId {
+			return status.InvalidArgument(nil, "tag implication would create a cycle")
+		}
+	}
+
+	tis, err := j.FindTagImplications(db.Opts{
+		Prefix: tab.TagImplicationsPrimary{TagId: &tag.TagId, ImpliedTagId: &impliedTag.TagId},
+		Limit:  1,
+		Lock:   db.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find tag implications")
+	}
+	if len(tis) == 1 {
+		ti := tis[0]
+		ti.SetModifiedTime(now)
+		if err := j.UpdateTagImplication(ti); err != nil {
+			return status.Internal(err, "can't update tag implication")
+		}
+		t.TagImplication = ti
+	} else {
+		ti := &schema.TagImplication{
+			TagId:        tag.TagId,
+			ImpliedTagId: impliedTag.TagId,
+			UserId:       userId,
+		}
+		ti.SetCreatedTime(now)
+		ti.SetModifiedTime(now)
+		if err := j.InsertTagImplication(ti); err != nil {
+			return status.Internal(err, "can't create tag implication")
+		}
+		t.TagImplication = ti
+	}
+
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+
+	return nil
+}
+
+func lookupTagImplicationTags(j *tab.Job, rawTagName, rawImpliedTagName string) (
+	tag, impliedTag *schema.Tag, _ status.S) {
+	uniq, err := text.ToCaselessNFKC(strings.TrimSpace(rawTagName), "tag")
+	if err != nil {
+		return nil, nil, status.From(err)
+	}
+	impliedUniq, err := text.ToCaselessNFKC(strings.TrimSpace(rawImpliedTagName), "implied tag")
+	if err != nil {
+		return nil, nil, status.From(err)
+	}
+	tag, sts := lookupTagByName(j, uniq)
+	if sts != nil {
+		return nil, nil, sts
+	}
+	impliedTag, sts = lookupTagByName(j, impliedUniq)
+	if sts != nil {
+		return nil, nil, sts
+	}
+	return tag, impliedTag, nil
+}
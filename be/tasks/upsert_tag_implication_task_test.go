@@ -0,0 +1,150 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/schema"
+)
+
+func TestUpsertTagImplicationTaskWorkflow(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_IMPLICATION_UPDATE)
+	u.Update()
+
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+	c.CreateTagAlias("kitty", tag1)
+
+	task := &UpsertTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        "KITTY",
+		ImpliedTagName: tag2.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	if sts := new(TaskRunner).Run(ctx, task); sts != nil {
+		t.Fatal(sts)
+	}
+
+	ti := &TestTagImplication{TagImplication: task.TagImplication, c: c}
+	if !ti.Refresh() {
+		t.Fatal("implication not created")
+	}
+	if have, want := ti.TagImplication.TagId, tag1.Tag.TagId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ti.TagImplication.ImpliedTagId, tag2.Tag.TagId; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := ti.TagImplication.UserId, u.User.UserId; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagImplicationTask_Cycle(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_IMPLICATION_UPDATE)
+	u.Update()
+
+	tag1, tag2, tag3 := c.CreateTag(), c.CreateTag(), c.CreateTag()
+	c.CreateTagImplication(tag1, tag2)
+	c.CreateTagImplication(tag2, tag3)
+
+	task := &UpsertTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag3.Tag.Name,
+		ImpliedTagName: tag1.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagImplicationTask_Self(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_IMPLICATION_UPDATE)
+	u.Update()
+
+	tag := c.CreateTag()
+
+	task := &UpsertTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag.Tag.Name,
+		ImpliedTagName: tag.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.InvalidArgument; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagImplicationTask_MissingTag(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	u.User.Capability = append(u.User.Capability, schema.User_TAG_IMPLICATION_UPDATE)
+	u.Update()
+
+	tag := c.CreateTag()
+
+	task := &UpsertTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag.Tag.Name,
+		ImpliedTagName: "missing",
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.NotFound; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestUpsertTagImplicationTask_MissingCap(t *testing.T) {
+	c := Container(t)
+	defer c.Close()
+
+	u := c.CreateUser()
+	tag1, tag2 := c.CreateTag(), c.CreateTag()
+
+	task := &UpsertTagImplicationTask{
+		Beg:            c.DB(),
+		Now:            time.Now,
+		TagName:        tag1.Tag.Name,
+		ImpliedTagName: tag2.Tag.Name,
+	}
+	ctx := u.AuthedCtx(c.Ctx)
+	sts := new(TaskRunner).Run(ctx, task)
+	if sts == nil {
+		t.Fatal("expected error")
+	}
+	if have, want := sts.Code(), codes.PermissionDenied; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
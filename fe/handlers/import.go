@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	ptpl "pixur.org/pixur/fe/tpl"
+)
+
+var importTpl = parseTpl(ptpl.Base, ptpl.Pane, ptpl.Import)
+
+type importData struct {
+	*paneData
+
+	BackgroundJob *api.BackgroundJob
+}
+
+// Finished returns if the page no longer needs to refresh.
+func (d *importData) Finished() bool {
+	switch d.BackgroundJob.Status.GetState() {
+	case api.BackgroundJob_Status_PENDING, api.BackgroundJob_Status_RUNNING:
+		return false
+	}
+	return true
+}
+
+type importHandler struct {
+	pt *paths
+	c  api.PixurServiceClient
+}
+
+// static shows the progress of an import.  The page refreshes itself until the import finishes.
+func (h *importHandler) static(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	// The auth interceptor only applies to unary calls.
+	if atv, present := authTokenFromCtx(ctx); present {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(authPwtHeaderKey, atv.Token))
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wbjc, err := h.c.WatchBackgroundJob(ctx, &api.WatchBackgroundJobRequest{
+		BackgroundJobId: r.FormValue(h.pt.pr.ImportId()),
+	})
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+	// Only the current state is needed.
+	resp, err := wbjc.Recv()
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+	bj := resp.BackgroundJob
+
+	if bj.Status.GetState() == api.BackgroundJob_Status_SUCCEEDED && bj.PicId != "" {
+		http.Redirect(w, r, h.pt.Viewer(bj.PicId).String(), http.StatusSeeOther)
+		return
+	}
+
+	data := &importData{
+		paneData:      newPaneData(ctx, "Import", h.pt),
+		BackgroundJob: bj,
+	}
+	if err := importTpl.Execute(w, data); err != nil {
+		httpCleanupError(w, err)
+		return
+	}
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		h := importHandler{
+			c:  s.Client,
+			pt: &paths{r: s.HTTPRoot},
+		}
+
+		s.HTTPMux.Handle(h.pt.Import("").Path, compressHtmlHandler(&methodHandler{
+			Get: readWrapper(s)(http.HandlerFunc(h.static)),
+		}))
+		return nil
+	})
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"pixur.org/pixur/api"
+)
+
+func TestImportTplStates(t *testing.T) {
+	cases := []struct {
+		status *api.BackgroundJob_Status
+		want   string
+	}{{
+		status: &api.BackgroundJob_Status{State: api.BackgroundJob_Status_PENDING},
+		want:   "Waiting to start",
+	}, {
+		status: &api.BackgroundJob_Status{State: api.BackgroundJob_Status_RUNNING},
+		want:   "Downloaded 0 bytes",
+	}, {
+		status: &api.BackgroundJob_Status{
+			State: api.BackgroundJob_Status_RUNNING,
+			Progress: &api.BackgroundJob_Progress{
+				Stage:           api.BackgroundJob_Progress_DOWNLOADING,
+				DownloadedBytes: 5,
+				TotalBytes:      10,
+			},
+		},
+		want: "Downloaded 5 of 10 bytes",
+	}, {
+		status: &api.BackgroundJob_Status{
+			State:     api.BackgroundJob_Status_FAILED,
+			LastError: "can't download",
+		},
+		want: "can&#39;t download",
+	}}
+
+	for i, c := range cases {
+		data := &importData{
+			paneData: newPaneData(context.Background(), "Import", &paths{}),
+			BackgroundJob: &api.BackgroundJob{
+				Id:     "1",
+				Url:    "http://foo/bar.jpg",
+				Status: c.status,
+			},
+		}
+		var buf bytes.Buffer
+		if err := importTpl.Execute(&buf, data); err != nil {
+			t.Fatal(i, err)
+		}
+		if !strings.Contains(buf.String(), c.want) {
+			t.Error(i, "missing", c.want, buf.String())
+		}
+		if have, want := strings.Contains(buf.String(), "refresh"), !data.Finished(); have != want {
+			t.Error(i, "have refresh", have, "want", want)
+		}
+	}
+}
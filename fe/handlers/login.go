@@ -17,16 +17,20 @@ type loginData struct {
 	*paneData
 
 	Next string
+	// Oidc is true if users can log in through an OpenID Connect provider.
+	Oidc bool
 }
 
 type loginDisplayHandler struct {
-	pt *paths
+	pt   *paths
+	oidc bool
 }
 
 func (h *loginDisplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	data := &loginData{
 		paneData: newPaneData(ctx, "Login", h.pt),
+		Oidc:     h.oidc,
 	}
 
 	if err := loginDisplayTpl.Execute(w, data); err != nil {
@@ -168,7 +172,10 @@ func (h *loginActionHandler) login(w http.ResponseWriter, r *http.Request) {
 	req := &api.GetRefreshTokenRequest{
 		Ident:             r.PostFormValue(h.pt.pr.Ident()),
 		Secret:            r.PostFormValue(h.pt.pr.Secret()),
+		TotpCode:          r.PostFormValue(h.pt.pr.TotpCode()),
 		PreviousAuthToken: authToken,
+		UserAgent:         r.UserAgent(),
+		RemoteAddress:     r.RemoteAddr,
 	}
 	ctx := r.Context()
 	res, err := h.c.GetRefreshToken(ctx, req)
@@ -242,7 +249,8 @@ func init() {
 	register(func(s *server.Server) error {
 		pt := &paths{r: s.HTTPRoot}
 		ldh := readWrapper(s)(&loginDisplayHandler{
-			pt: pt,
+			pt:   pt,
+			oidc: s.Oidc != nil && s.Oidc.Issuer != "",
 		})
 		lah := &loginActionHandler{
 			c:       s.Client,
@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server"
+	"pixur.org/pixur/fe/server/config"
+)
+
+const (
+	// oidcStateCookieName holds the state and nonce of an in progress OpenID Connect login.
+	oidcStateCookieName = "os"
+	// oidcStateDuration is how long the user has to log in at the provider.
+	oidcStateDuration = 10 * time.Minute
+	oidcStateSize     = 16
+)
+
+// oidcProvider is the OpenID Connect provider used for login.  Its endpoints are found through the
+// discovery document, and cached.
+type oidcProvider struct {
+	conf   *config.OidcConfig
+	client *http.Client
+
+	mu   sync.Mutex
+	disc *oidcDiscovery
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+}
+
+func (p *oidcProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.disc != nil {
+		return p.disc, nil
+	}
+	issuer := strings.TrimSuffix(p.conf.Issuer, "/")
+	req, err := http.NewRequest(http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, &HTTPErr{
+			Message: "Can't reach login provider",
+			Code:    http.StatusBadGateway,
+			Cause:   err,
+		}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPErr{
+			Message: "Bad login provider response " + resp.Status,
+			Code:    http.StatusBadGateway,
+		}
+	}
+	disc := new(oidcDiscovery)
+	if err := json.NewDecoder(resp.Body).Decode(disc); err != nil {
+		return nil, err
+	}
+	if disc.Issuer != issuer || disc.AuthorizationEndpoint == "" || disc.TokenEndpoint == "" {
+		return nil, &HTTPErr{
+			Message: "Bad login provider configuration",
+			Code:    http.StatusBadGateway,
+		}
+	}
+	p.disc = disc
+	return disc, nil
+}
+
+// redirectUrl is the callback url given to the provider.  It has to match exactly in the
+// authorization and token requests.
+func (p *oidcProvider) redirectUrl(r *http.Request, pt *paths, secure bool) string {
+	if p.conf.RedirectUrl != "" {
+		return p.conf.RedirectUrl
+	}
+	base := &url.URL{Scheme: "http", Host: r.Host}
+	if secure {
+		base.Scheme = "https"
+	}
+	return base.ResolveReference(pt.OidcCallback()).String()
+}
+
+// exchangeCode trades an authorization code for an ID token at the token endpoint.  The ID token is
+// verified by the backend.
+func (p *oidcProvider) exchangeCode(ctx context.Context, code, redirectUrl string) (string, error) {
+	disc, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectUrl)
+	req, err := http.NewRequest(http.MethodPost, disc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(p.conf.ClientId), url.QueryEscape(p.conf.ClientSecret))
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", &HTTPErr{
+			Message: "Can't reach login provider",
+			Code:    http.StatusBadGateway,
+			Cause:   err,
+		}
+	}
+	defer resp.Body.Close()
+	var tokens struct {
+		IdToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return "", &HTTPErr{
+			Message: "Can't parse login provider response",
+			Code:    http.StatusBadGateway,
+			Cause:   err,
+		}
+	}
+	if resp.StatusCode != http.StatusOK || tokens.IdToken == "" {
+		return "", &HTTPErr{
+			Message: "Login provider rejected code: " + tokens.Error + " " + tokens.ErrorDescription,
+			Code:    http.StatusUnauthorized,
+		}
+	}
+	return tokens.IdToken, nil
+}
+
+func newOidcState(random io.Reader) (string, error) {
+	b := make([]byte, oidcStateSize)
+	if _, err := io.ReadFull(random, b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+type oidcLoginHandler struct {
+	pt     *paths
+	p      *oidcProvider
+	now    func() time.Time
+	random io.Reader
+	secure bool
+}
+
+func (h *oidcLoginHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	disc, err := h.p.discover(ctx)
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+	state, err := newOidcState(h.random)
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+	nonce, err := newOidcState(h.random)
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    state + "." + nonce,
+		Path:     h.pt.OidcCallback().EscapedPath(),
+		Expires:  h.now().Add(oidcStateDuration),
+		Secure:   h.secure,
+		HttpOnly: true,
+	})
+
+	authUrl, err := url.Parse(disc.AuthorizationEndpoint)
+	if err != nil {
+		httpReadError(ctx, w, err)
+		return
+	}
+	v := authUrl.Query()
+	v.Set("response_type", "code")
+	v.Set("client_id", h.p.conf.ClientId)
+	v.Set("redirect_uri", h.p.redirectUrl(r, h.pt, h.secure))
+	v.Set("scope", "openid email profile")
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+	authUrl.RawQuery = v.Encode()
+	http.Redirect(w, r, authUrl.String(), http.StatusSeeOther)
+}
+
+type oidcCallbackHandler struct {
+	pt      *paths
+	p       *oidcProvider
+	c       api.PixurServiceClient
+	login   *loginActionHandler
+	display http.Handler
+	now     func() time.Time
+	secure  bool
+}
+
+func (h *oidcCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The state is only good for one attempt.
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    "",
+		Path:     h.pt.OidcCallback().EscapedPath(),
+		Expires:  h.now().Add(-time.Hour),
+		Secure:   h.secure,
+		HttpOnly: true,
+	})
+
+	res, err := h.exchange(r)
+	if err != nil {
+		httpWriteError(w, err)
+		ctx := ctxFromWriteErr(r.Context(), err)
+		r = r.WithContext(ctx)
+		h.display.ServeHTTP(w, r)
+		return
+	}
+	refreshRes := &api.GetRefreshTokenResponse{
+		AuthToken:   res.AuthToken,
+		PixToken:    res.PixToken,
+		AuthPayload: res.AuthPayload,
+		PixPayload:  res.PixPayload,
+	}
+	if h.login.refresh0(w, refreshRes, r) {
+		http.Redirect(w, r, h.pt.Root().String(), http.StatusSeeOther)
+	}
+}
+
+func (h *oidcCallbackHandler) exchange(r *http.Request) (*api.ExchangeIdTokenResponse, error) {
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		return nil, &HTTPErr{
+			Message: "Login provider failed: " + e + " " + q.Get("error_description"),
+			Code:    http.StatusUnauthorized,
+		}
+	}
+	c, err := r.Cookie(oidcStateCookieName)
+	if err != nil {
+		return nil, &HTTPErr{
+			Message: "Login expired, try again",
+			Code:    http.StatusBadRequest,
+			Cause:   err,
+		}
+	}
+	parts := strings.SplitN(c.Value, ".", 2)
+	state := q.Get("state")
+	if len(parts) != 2 || state == "" ||
+		subtle.ConstantTimeCompare([]byte(parts[0]), []byte(state)) != 1 {
+		return nil, &HTTPErr{
+			Message: "Login state doesn't match",
+			Code:    http.StatusBadRequest,
+		}
+	}
+	nonce := parts[1]
+	code := q.Get("code")
+	if code == "" {
+		return nil, &HTTPErr{
+			Message: "Missing code",
+			Code:    http.StatusBadRequest,
+		}
+	}
+
+	ctx := r.Context()
+	idToken, err := h.p.exchangeCode(ctx, code, h.p.redirectUrl(r, h.pt, h.secure))
+	if err != nil {
+		return nil, err
+	}
+	return h.c.ExchangeIdToken(ctx, &api.ExchangeIdTokenRequest{
+		IdToken:       idToken,
+		Nonce:         nonce,
+		UserAgent:     r.UserAgent(),
+		RemoteAddress: r.RemoteAddr,
+	})
+}
+
+func init() {
+	register(func(s *server.Server) error {
+		if s.Oidc == nil || s.Oidc.Issuer == "" {
+			return nil
+		}
+		pt := &paths{r: s.HTTPRoot}
+		p := &oidcProvider{
+			conf:   s.Oidc,
+			client: http.DefaultClient,
+		}
+		ldh := readWrapper(s)(&loginDisplayHandler{
+			pt:   pt,
+			oidc: true,
+		})
+		olh := &oidcLoginHandler{
+			pt:     pt,
+			p:      p,
+			now:    s.Now,
+			random: s.Random,
+			secure: s.Secure,
+		}
+		och := &oidcCallbackHandler{
+			pt: pt,
+			p:  p,
+			c:  s.Client,
+			login: &loginActionHandler{
+				c:       s.Client,
+				secure:  s.Secure,
+				pt:      pt,
+				now:     s.Now,
+				display: ldh,
+			},
+			display: ldh,
+			now:     s.Now,
+			secure:  s.Secure,
+		}
+
+		s.HTTPMux.Handle(pt.OidcLogin().Path, compressHtmlHandler(&methodHandler{
+			Get: olh,
+		}))
+		s.HTTPMux.Handle(pt.OidcCallback().Path, compressHtmlHandler(&methodHandler{
+			Get: och,
+		}))
+		return nil
+	})
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/fe/server/config"
+)
+
+// oidcTestClient only implements ExchangeIdToken.
+type oidcTestClient struct {
+	api.PixurServiceClient
+
+	req *api.ExchangeIdTokenRequest
+}
+
+func (c *oidcTestClient) ExchangeIdToken(
+	ctx context.Context, req *api.ExchangeIdTokenRequest, opts ...grpc.CallOption) (
+	*api.ExchangeIdTokenResponse, error) {
+	c.req = req
+	return &api.ExchangeIdTokenResponse{
+		AuthToken: "authtoken",
+		AuthPayload: &api.PwtPayload{
+			NotAfter: &tspb.Timestamp{Seconds: 2e9},
+		},
+	}, nil
+}
+
+func newOidcTestProvider(t *testing.T) *httptest.Server {
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 srv.URL,
+			"authorization_endpoint": srv.URL + "/authorize",
+			"token_endpoint":         srv.URL + "/token",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if user != "client" || pass != "secret" || r.PostFormValue("code") != "code" ||
+			r.PostFormValue("redirect_uri") != "https://example.com/u/oidc/callback" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": "idtoken"})
+	})
+	srv = httptest.NewServer(mux)
+	return srv
+}
+
+func TestOidcLoginFlow(t *testing.T) {
+	provider := newOidcTestProvider(t)
+	defer provider.Close()
+
+	pt := &paths{}
+	p := &oidcProvider{
+		conf: &config.OidcConfig{
+			Issuer:       provider.URL,
+			ClientId:     "client",
+			ClientSecret: "secret",
+		},
+		client: provider.Client(),
+	}
+	now := func() time.Time { return time.Unix(1e9, 0) }
+
+	lh := &oidcLoginHandler{
+		pt:     pt,
+		p:      p,
+		now:    now,
+		random: bytes.NewReader(make([]byte, 2*oidcStateSize)),
+		secure: true,
+	}
+	w := httptest.NewRecorder()
+	lh.ServeHTTP(w, httptest.NewRequest("GET", "https://example.com/u/oidc", nil))
+	if have, want := w.Code, http.StatusSeeOther; have != want {
+		t.Fatal("have", have, "want", want, w.Body.String())
+	}
+	authUrl, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(authUrl.String(), provider.URL+"/authorize?") {
+		t.Error("wrong redirect", authUrl)
+	}
+	q := authUrl.Query()
+	if q.Get("client_id") != "client" || q.Get("response_type") != "code" ||
+		q.Get("redirect_uri") != "https://example.com/u/oidc/callback" || q.Get("state") == "" {
+		t.Error("wrong auth params", q)
+	}
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != oidcStateCookieName {
+		t.Fatal("missing state cookie", cookies)
+	}
+
+	c := new(oidcTestClient)
+	ch := &oidcCallbackHandler{
+		pt: pt,
+		p:  p,
+		c:  c,
+		login: &loginActionHandler{
+			pt:     pt,
+			now:    now,
+			secure: true,
+		},
+		display: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("unexpected error", writeErrOrNilFromCtx(r.Context()))
+		}),
+		now:    now,
+		secure: true,
+	}
+	v := url.Values{}
+	v.Set("code", "code")
+	v.Set("state", q.Get("state"))
+	r := httptest.NewRequest("GET", "https://example.com/u/oidc/callback?"+v.Encode(), nil)
+	r.AddCookie(cookies[0])
+	w = httptest.NewRecorder()
+	ch.ServeHTTP(w, r)
+
+	if have, want := w.Code, http.StatusSeeOther; have != want {
+		t.Fatal("have", have, "want", want, w.Body.String())
+	}
+	if c.req == nil {
+		t.Fatal("ExchangeIdToken not called")
+	}
+	if c.req.IdToken != "idtoken" || c.req.Nonce != q.Get("nonce") {
+		t.Error("wrong exchange request", c.req)
+	}
+	var found bool
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == authPwtCookieName && cookie.Value == "authtoken" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("missing auth cookie", w.Result().Cookies())
+	}
+}
+
+func TestOidcCallbackFailsOnWrongState(t *testing.T) {
+	pt := &paths{}
+	c := new(oidcTestClient)
+	var displayErr error
+	ch := &oidcCallbackHandler{
+		pt: pt,
+		p:  &oidcProvider{conf: &config.OidcConfig{}},
+		c:  c,
+		display: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			displayErr = writeErrOrNilFromCtx(r.Context())
+		}),
+		now: time.Now,
+	}
+	r := httptest.NewRequest("GET", "/u/oidc/callback?code=code&state=other", nil)
+	r.AddCookie(&http.Cookie{Name: oidcStateCookieName, Value: "state.nonce"})
+	w := httptest.NewRecorder()
+	ch.ServeHTTP(w, r)
+
+	if have, want := w.Code, http.StatusBadRequest; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if displayErr == nil || !strings.Contains(displayErr.Error(), "state doesn't match") {
+		t.Error("wrong error", displayErr)
+	}
+	if c.req != nil {
+		t.Error("ExchangeIdToken shouldn't be called")
+	}
+}
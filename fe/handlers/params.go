@@ -31,6 +31,10 @@ func (p params) Secret() string {
 	return "secret"
 }
 
+func (p params) TotpCode() string {
+	return "totp_code"
+}
+
 func (p params) Logout() string {
 	return "is_logout"
 }
@@ -91,6 +95,14 @@ func (p params) Url() string {
 	return "url"
 }
 
+func (p params) Private() string {
+	return "private"
+}
+
+func (p params) ImportId() string {
+	return "import_id"
+}
+
 func (p params) Tag() string {
 	return "tag"
 }
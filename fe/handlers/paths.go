@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/url"
+	"sort"
 
 	"pixur.org/pixur/api"
 )
@@ -63,6 +64,16 @@ func (p *paths) Logout() *url.URL {
 	return p.User().ResolveReference(&url.URL{Path: "logout"})
 }
 
+// OidcLogin starts logging in through the OpenID Connect provider.
+func (p *paths) OidcLogin() *url.URL {
+	return p.User().ResolveReference(&url.URL{Path: "oidc"})
+}
+
+// OidcCallback is where the OpenID Connect provider sends the user back to.
+func (p *paths) OidcCallback() *url.URL {
+	return p.User().ResolveReference(&url.URL{Path: "oidc/callback"})
+}
+
 func (p *paths) UserEdit(userID string) *url.URL {
 	u := url.URL{Path: "edit"}
 	if userID != "" {
@@ -115,6 +126,37 @@ func (p *paths) PicFileFirst(pf []*api.PicFile) *url.URL {
 	return p.PicFile(pf[0])
 }
 
+// picSource is an alternate format of a pic file, for a <source> in a <picture>.
+type picSource struct {
+	Type   string
+	Srcset *url.URL
+}
+
+// PicFileSources returns the pic files that are smaller than the first, smallest first.  Listed
+// ahead of the first one in a <picture>, the browser uses the smallest format it accepts.
+func (p *paths) PicFileSources(pf []*api.PicFile) []picSource {
+	if len(pf) == 0 {
+		return nil
+	}
+	var smaller []*api.PicFile
+	for _, f := range pf[1:] {
+		if _, ok := picFileFormatMime[f.Format]; ok && f.Size < pf[0].Size {
+			smaller = append(smaller, f)
+		}
+	}
+	sort.SliceStable(smaller, func(i, k int) bool {
+		return smaller[i].Size < smaller[k].Size
+	})
+	srcs := make([]picSource, 0, len(smaller))
+	for _, f := range smaller {
+		srcs = append(srcs, picSource{
+			Type:   picFileFormatMime[f.Format],
+			Srcset: p.PicFile(f),
+		})
+	}
+	return srcs
+}
+
 func (p *paths) pic(id string, f api.PicFile_Format) *url.URL {
 	return p.PixDir().ResolveReference(&url.URL{Path: id + picFileFormatExt[f]})
 }
@@ -150,6 +192,16 @@ func (p *paths) UpsertPicAction() *url.URL {
 	return p.ActionDir().ResolveReference(&url.URL{Path: "upsertPic"})
 }
 
+func (p *paths) Import(importID string) *url.URL {
+	u := url.URL{Path: "import"}
+	if importID != "" {
+		v := url.Values{}
+		v.Add(p.pr.ImportId(), importID)
+		u.RawQuery = v.Encode()
+	}
+	return p.Root().ResolveReference(&u)
+}
+
 func (p *paths) SoftDeletePicAction() *url.URL {
 	return p.ActionDir().ResolveReference(&url.URL{Path: "softDeletePic"})
 }
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/url"
+	"testing"
+
+	"pixur.org/pixur/api"
+)
+
+func TestPicFileSourcesSmallestFirst(t *testing.T) {
+	pt := &paths{r: &url.URL{Path: "/"}}
+	pfs := []*api.PicFile{
+		{Id: "1", Format: api.PicFile_JPEG, Size: 1000},
+		{Id: "1", Format: api.PicFile_WEBP, Size: 800},
+		{Id: "1", Format: api.PicFile_PNG, Size: 2000},
+		{Id: "1", Format: api.PicFile_AVIF, Size: 500},
+	}
+	srcs := pt.PicFileSources(pfs)
+	if len(srcs) != 2 {
+		t.Fatal("wrong sources", srcs)
+	}
+	if have, want := srcs[0].Type, "image/avif"; have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := srcs[0].Srcset.String(), pt.PicFile(pfs[3]).String(); have != want {
+		t.Error("have", have, "want", want)
+	}
+	if have, want := srcs[1].Type, "image/webp"; have != want {
+		t.Error("have", have, "want", want)
+	}
+}
+
+func TestPicFileSourcesEmpty(t *testing.T) {
+	pt := &paths{r: &url.URL{Path: "/"}}
+	if srcs := pt.PicFileSources(nil); len(srcs) != 0 {
+		t.Error("expected no sources", srcs)
+	}
+}
@@ -22,6 +22,9 @@ var picFileFormatMime = map[api.PicFile_Format]string{
 	api.PicFile_PNG:  "image/png",
 	api.PicFile_WEBM: "video/webm",
 	api.PicFile_MP4:  "video/mp4",
+	api.PicFile_WEBP: "image/webp",
+	api.PicFile_AVIF: "image/avif",
+	api.PicFile_HEIC: "image/heic",
 }
 
 var picFileFormatExt = map[api.PicFile_Format]string{
@@ -30,6 +33,9 @@ var picFileFormatExt = map[api.PicFile_Format]string{
 	api.PicFile_PNG:  ".png",
 	api.PicFile_WEBM: ".webm",
 	api.PicFile_MP4:  ".mp4",
+	api.PicFile_WEBP: ".webp",
+	api.PicFile_AVIF: ".avif",
+	api.PicFile_HEIC: ".heic",
 }
 
 var picFileFormatTypes = map[string]api.PicFile_Format{
@@ -38,6 +44,9 @@ var picFileFormatTypes = map[string]api.PicFile_Format{
 	".png":  api.PicFile_PNG,
 	".webm": api.PicFile_WEBM,
 	".mp4":  api.PicFile_MP4,
+	".webp": api.PicFile_WEBP,
+	".avif": api.PicFile_AVIF,
+	".heic": api.PicFile_HEIC,
 }
 
 func init() {
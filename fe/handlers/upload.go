@@ -59,11 +59,22 @@ func (h *upsertPicHandler) upsert(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	fileURL := r.FormValue(h.pt.pr.Url())
+	// Downloads can be slow, so let the backend do them in the background.
+	async := fileURL != "" && len(data) == 0
+	var visibility *api.PicVisibility
+	if r.FormValue(h.pt.pr.Private()) != "" {
+		visibility = &api.PicVisibility{
+			Mode: api.PicVisibility_PRIVATE,
+		}
+	}
 	resp, sts := h.c.UpsertPic(ctx, &api.UpsertPicRequest{
-		Url:     r.FormValue(h.pt.pr.Url()),
-		Name:    filename,
-		Data:    data,
-		Md5Hash: md5Hash,
+		Url:        fileURL,
+		Name:       filename,
+		Data:       data,
+		Md5Hash:    md5Hash,
+		Async:      async,
+		Visibility: visibility,
 	})
 
 	if sts != nil {
@@ -71,6 +82,10 @@ func (h *upsertPicHandler) upsert(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if async {
+		http.Redirect(w, r, h.pt.Import(resp.ImportId).String(), http.StatusSeeOther)
+		return
+	}
 	http.Redirect(w, r, h.pt.Viewer(resp.Pic.Id).String(), http.StatusSeeOther)
 }
 
@@ -93,6 +93,10 @@ func (h *viewerHandler) static(w http.ResponseWriter, r *http.Request) {
 		httpReadError(ctx, w, err)
 		return
 	}
+	if dup := details.Pic.DuplicateOfPicId; dup != "" {
+		http.Redirect(w, r, h.pt.Viewer(dup).String(), http.StatusSeeOther)
+		return
+	}
 
 	var puismu sync.Mutex
 	puis := make(map[string]*api.PublicUserInfo)
@@ -32,10 +32,12 @@ type Config struct {
 	// describes the root url to serve from.
 	HttpRoot string `protobuf:"bytes,4,opt,name=http_root,json=httpRoot,proto3" json:"http_root,omitempty"`
 	// The name to show for this site.
-	SiteName             string   `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SiteName string `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	// OpenID Connect provider users may sign in with.  If unset, only local login is offered.
+	Oidc                 *OidcConfig `protobuf:"bytes,6,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -98,24 +100,106 @@ func (m *Config) GetSiteName() string {
 	return ""
 }
 
+func (m *Config) GetOidc() *OidcConfig {
+	if m != nil {
+		return m.Oidc
+	}
+	return nil
+}
+
+// OidcConfig describes the OpenID Connect client used for login.  The backend must be configured
+// with the same issuer and client id.
+type OidcConfig struct {
+	// Issuer URL of the provider (e.g. "https://accounts.example.com").
+	Issuer       string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// Absolute URL of the callback page, as registered with the provider.  If unset, it is built
+	// from the request host and http_root.
+	RedirectUrl          string   `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OidcConfig) Reset()         { *m = OidcConfig{} }
+func (m *OidcConfig) String() string { return proto.CompactTextString(m) }
+func (*OidcConfig) ProtoMessage()    {}
+func (*OidcConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eaf2c85e69e9ea4, []int{1}
+}
+
+func (m *OidcConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OidcConfig.Unmarshal(m, b)
+}
+func (m *OidcConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OidcConfig.Marshal(b, m, deterministic)
+}
+func (m *OidcConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OidcConfig.Merge(m, src)
+}
+func (m *OidcConfig) XXX_Size() int {
+	return xxx_messageInfo_OidcConfig.Size(m)
+}
+func (m *OidcConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OidcConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OidcConfig proto.InternalMessageInfo
+
+func (m *OidcConfig) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *OidcConfig) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *OidcConfig) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+func (m *OidcConfig) GetRedirectUrl() string {
+	if m != nil {
+		return m.RedirectUrl
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "pixur.fe.server.Config")
+	proto.RegisterType((*OidcConfig)(nil), "pixur.fe.server.OidcConfig")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x4b,
-	0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2f, 0xc8, 0xac, 0x28, 0x2d, 0xd2,
-	0x4b, 0x4b, 0xd5, 0x2b, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0x52, 0x9a, 0xc5, 0xc8, 0xc5, 0xe6, 0x0c,
-	0x56, 0x21, 0x24, 0xcd, 0xc5, 0x99, 0x51, 0x52, 0x52, 0x10, 0x5f, 0x5c, 0x90, 0x9a, 0x2c, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x01, 0x12, 0x08, 0x2e, 0x48, 0x4d, 0x16, 0x92, 0xe5, 0xe2,
-	0x02, 0x6b, 0x85, 0xc8, 0x32, 0x81, 0x65, 0x39, 0xc1, 0x22, 0x60, 0x69, 0x29, 0x2e, 0x8e, 0xcc,
-	0xbc, 0xe2, 0xd4, 0xe4, 0xd2, 0xa2, 0x54, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x38, 0x1f,
-	0x6e, 0x6e, 0x51, 0x7e, 0x7e, 0x89, 0x04, 0x0b, 0xc2, 0xdc, 0xa0, 0xfc, 0xfc, 0x12, 0x90, 0x64,
-	0x71, 0x66, 0x49, 0x6a, 0x7c, 0x5e, 0x62, 0x6e, 0xaa, 0x04, 0x2b, 0x44, 0x12, 0x24, 0xe0, 0x97,
-	0x98, 0x9b, 0xea, 0xa4, 0x19, 0xa5, 0x0e, 0x71, 0x6f, 0x7e, 0x51, 0xba, 0x3e, 0x98, 0xa5, 0x9f,
-	0x96, 0xaa, 0x0f, 0x71, 0xb9, 0x3e, 0xc4, 0x5f, 0xd6, 0x10, 0x2a, 0x89, 0x0d, 0xec, 0x3f, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xa7, 0x6b, 0x0f, 0xef, 0x00, 0x00, 0x00,
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4a, 0xc4, 0x30,
+	0x10, 0x86, 0x89, 0xae, 0xa5, 0x9d, 0xad, 0x08, 0x39, 0x48, 0x71, 0x11, 0xea, 0x7a, 0xb0, 0x5e,
+	0x5a, 0xd0, 0xa3, 0x37, 0x3d, 0x79, 0x51, 0xe8, 0xe2, 0xc5, 0x4b, 0x59, 0xd3, 0xe9, 0x1a, 0xe8,
+	0x36, 0x65, 0x92, 0x8a, 0xaf, 0xe0, 0xb3, 0xf9, 0x52, 0xd2, 0x49, 0xdd, 0x85, 0x3d, 0x25, 0xf3,
+	0xfd, 0x93, 0xc9, 0xff, 0x33, 0x10, 0x2b, 0xd3, 0x35, 0x7a, 0x93, 0xf7, 0x64, 0x9c, 0x91, 0x67,
+	0xbd, 0xfe, 0x1e, 0x28, 0x6f, 0x30, 0xb7, 0x48, 0x5f, 0x48, 0xcb, 0x5f, 0x01, 0xc1, 0x13, 0x77,
+	0xc8, 0x05, 0x44, 0x9f, 0xce, 0xf5, 0x95, 0xed, 0x51, 0x25, 0x22, 0x15, 0x59, 0x54, 0x86, 0x23,
+	0x58, 0xf5, 0xa8, 0xe4, 0x25, 0x00, 0x3f, 0xf5, 0xea, 0x11, 0xab, 0x11, 0x13, 0x96, 0x2f, 0x20,
+	0xd4, 0x9d, 0x45, 0x35, 0x10, 0x26, 0xc7, 0xa9, 0xc8, 0xc2, 0x72, 0x57, 0xef, 0xe6, 0x92, 0x31,
+	0x2e, 0x99, 0xed, 0xe7, 0x96, 0xc6, 0xb8, 0x51, 0xb4, 0xda, 0x61, 0xd5, 0xad, 0xb7, 0x98, 0x9c,
+	0x78, 0x71, 0x04, 0x2f, 0xeb, 0x2d, 0xca, 0x02, 0x66, 0x46, 0xd7, 0x2a, 0x09, 0x52, 0x91, 0xcd,
+	0xef, 0x16, 0xf9, 0x81, 0xf9, 0xfc, 0x55, 0xd7, 0xca, 0x9b, 0x2f, 0xb9, 0x71, 0xf9, 0x23, 0x00,
+	0xf6, 0x50, 0x9e, 0x43, 0xa0, 0xad, 0x1d, 0x90, 0xa6, 0x38, 0x53, 0x35, 0x7e, 0xaa, 0x5a, 0x8d,
+	0x9d, 0xab, 0x74, 0x3d, 0x65, 0x09, 0x3d, 0x78, 0xae, 0xe5, 0x35, 0x9c, 0x4e, 0xa2, 0x45, 0x45,
+	0xe8, 0x38, 0x4f, 0x54, 0xc6, 0x1e, 0xae, 0x98, 0xc9, 0x2b, 0x88, 0x09, 0x6b, 0x4d, 0xa8, 0x5c,
+	0x35, 0x50, 0x3b, 0xc5, 0x9a, 0xff, 0xb3, 0x37, 0x6a, 0x1f, 0x6f, 0xdf, 0x6f, 0xbc, 0x5f, 0x43,
+	0x9b, 0x82, 0x6f, 0x45, 0x83, 0x85, 0x77, 0x5e, 0xf8, 0xa5, 0x3c, 0xf8, 0xe3, 0x23, 0xe0, 0xe5,
+	0xdc, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x9a, 0x20, 0x85, 0xac, 0x01, 0x00, 0x00,
 }
@@ -29,6 +29,8 @@ type Server struct {
 	Secure   bool
 	HTTPRoot *url.URL
 	SiteName string
+	// Oidc is the OpenID Connect provider users may log in with, or nil if there isn't one.
+	Oidc *config.OidcConfig
 
 	httpSpec    string
 	lnAddr      net.Addr
@@ -56,6 +58,7 @@ func (s *Server) GetAndSetInterceptor(in grpc.UnaryClientInterceptor) grpc.Unary
 func (s *Server) Init(ctx context.Context, c *config.Config) (errCap error) {
 	s.httpSpec = c.HttpSpec
 	s.SiteName = c.SiteName
+	s.Oidc = c.Oidc
 	if s.Client == nil {
 		channel, err := newPixurChannel(ctx, s.interceptor, c.PixurSpec)
 		if err != nil {
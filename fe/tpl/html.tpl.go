@@ -10,15 +10,17 @@ const (
 
 	CommentReply = "{{define \"commentstyle\"}}\n<style>\n.comment .comment-links {\n  font-size: smaller;\n}\n.comment .comment-links a:link {\n  color: #777;\n  text-decoration: none;\n}\n.comment .comment-links a:visited {\n  color: #777;\n  text-decoration: none;\n}\n.comment .comment-links a:hover {\n  color: #777;\n  text-decoration: underline;\n}\n</style>\n{{end}}\n\n{{define \"commentreply\" }}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n{{if .PicComment.CommentId }}\n{{template \"commenttext\" .PicComment}}\n{{end}}\n<form action=\"{{$pt.CommentReply .PicComment.PicId .PicComment.CommentId}}\" method=\"post\">\n  <textarea name=\"{{$pr.CommentText}}\">{{.CommentText}}</textarea>\n  <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n  <input type=\"hidden\" name=\"{{$pr.PicId}}\" value=\"{{.PicComment.PicId}}\" />\n  <input type=\"hidden\" name=\"{{$pr.CommentParentId}}\" value=\"{{.PicComment.CommentId}}\" />\n  <input type=\"submit\" value=\"Reply\" />\n</form>\n{{end}}\n\n{{define \"commenttext\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"comment\">\n  <tr>\n    <td>▲</td>\n    <td class=\"comment-links\">\n      {{if .UserId}}\n        <a href=\"{{$pt.UserEvents .UserId \"\" false}}\">{{.Ident}}</a>\n      {{else}}\n        Anonymous\n      {{end}}\n      <a \n          href=\"{{$pt.ViewerComment .PicId .CommentId}}\" \n          id=\"{{($pt.ViewerComment .PicId .CommentId).Fragment}}\">\n        Some time ago\n      </a>\n    </td>\n  </tr>\n  <tr>\n    <td></td>\n    <td>{{.Text}}</td>\n  </tr>\n  <tr>\n    <td></td>\n    <td class=\"comment-links\"><a href=\"{{$pt.CommentReply .PicId .CommentId}}\">reply</a></td>\n  </tr>\n</table>\n{{end}}\n"
 
-	Index = "{{define \"panestyle\"}}\n<style>\n  .index {\n    text-align: center;\n  }\n\n  .index ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  \n  .index ul.thumbnail-list li {\n    display: inline;\n  }\n  \n  .index .thumbnail-cntr {\n    background-color: #FFFFEE;\n    border-style: solid;\n    border-width: 2px;\n    border-color: #2c1fc0;\n    border-radius: 10px;\n    display: inline-block;\n    height: 192px;\n    margin: 6px;\n    padding: 0;\n    text-align: center;\n    width: 192px;\n  }\n  \n  .index .thumbnail-cntr:hover {\n    border-color: #9c99bf;\n  }\n  \n  .index img.thumbnail {\n    width: 192px;\n    height: 192px;\n    border-radius: 8px;\n  }\n\n  .index img.deleted {\n    filter: blur(5px) grayscale(5%);\n    -webkit-filter: blur(5px) grayscale(5%);\n  }\n  \n  .index .nav-home {\n    text-align: center;\n  }\n  .index .nav-prev {\n    float: left;\n  }\n  .index .nav-next {\n    float: right;\n  }\n  .index .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- $pt := .Paths -}}\n{{if .PrevID}}<link rel=\"prev\" href=\"{{$pt.IndexPrev .PrevID}}\">{{end}}\n{{if .NextID}}<link rel=\"next\" href=\"{{$pt.Index .NextID}}\">{{end}}\n{{end}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .PrevID}}<span class=\"nav-prev\"><a href=\"{{$pt.IndexPrev .PrevID}}\">Previous</a></span>{{end}}\n    {{if .NextID}}<span class=\"nav-next\"><a href=\"{{$pt.Index .NextID}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"pane\"}}\n<div class=\"index\">\n  {{ $pt := .Paths}}\n  {{- $pr := $pt.Params -}}\n  {{- template \"nav\" . -}}\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <div class=\"thumbnail-cntr\">{{- /**/ -}}\n        <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n          <img {{/**/ -}}\n\t          class=\"thumbnail{{if .Pic.PendingDeletion}} deleted{{end}}\" {{/**/ -}}\n\t          src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n\t      </a>{{- /**/ -}}\n      </div>{{- /**/ -}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{if .CanUpload}}\n<div style=\"margin-bottom: 2em; margin-top: 2em;\">\n  <fieldset>\n    <legend>Pic Upload</legend>\n    <form action=\"{{$pt.UpsertPicAction}}\" method=\"post\" enctype=\"multipart/form-data\">\n      <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n      <dl>\n        <dt style=\"display:inline-block\">File Upload (option 1)</dt>\n        <dd style=\"display:inline-block\"><input type=\"file\" name=\"{{$pr.File}}\" /></dd>\n      </dl>\n      <dl>\n        <dt style=\"display:inline-block\">URL Upload (option 2)</dt>\n        <dd style=\"display:inline-block\"><input placeholder=\"File URL\" name=\"{{$pr.Url}}\" /></dd>\n      </dl>\n      <input type=\"submit\" value=\"Submit\" />\n    </form>\n  </fieldset>\n</div>\n{{end}}\n{{end}}\n"
+	Import = "{{define \"panestyle\"}}\n{{if not .Finished}}\n<meta http-equiv=\"refresh\" content=\"1\">\n{{end}}\n<style>\n  .import {\n    text-align: center;\n  }\n  .import progress {\n    width: 50%;\n  }\n  .import .failed {\n    color: red;\n  }\n</style>\n{{end}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $status := .BackgroundJob.Status -}}\n<div class=\"import\">\n  <h2>Importing {{.BackgroundJob.Url}}</h2>\n  {{if eq $status.GetState.String \"PENDING\"}}\n    <p>Waiting to start{{if $status.GetLastError}}, after: {{$status.GetLastError}}{{end}}</p>\n  {{else if eq $status.GetState.String \"RUNNING\"}}\n    {{if eq $status.Progress.GetStage.String \"PROCESSING\"}}\n      <p>Processing</p>\n      <progress></progress>\n    {{else if $status.Progress.GetTotalBytes}}\n      <p>Downloaded {{$status.Progress.GetDownloadedBytes}} of {{$status.Progress.GetTotalBytes}} bytes</p>\n      <progress value=\"{{$status.Progress.GetDownloadedBytes}}\" max=\"{{$status.Progress.GetTotalBytes}}\"></progress>\n    {{else}}\n      <p>Downloaded {{$status.Progress.GetDownloadedBytes}} bytes</p>\n      <progress></progress>\n    {{end}}\n  {{else if eq $status.GetState.String \"SUCCEEDED\"}}\n    <p>Done: <a href=\"{{$pt.Viewer .BackgroundJob.PicId}}\">{{.BackgroundJob.PicId}}</a></p>\n  {{else}}\n    <p class=\"failed\">Import {{$status.GetState.String}}{{if $status.GetLastError}}: {{$status.GetLastError}}{{end}}</p>\n  {{end}}\n</div>\n{{end}}\n"
 
-	Login = "{{define \"panestyle\"}}\n<style>\ntable.create-login {\n  margin: auto;\n  width: 66.67%; width: calc(100%/3*2);\n  background-color: #fcfcf7;\n  border-width: 1px;\n  border-color: #eeeeee;\n  border-style: solid;\n  padding: 1em;\n}\n.create-login th {\n  text-align: left;\n  padding: 1em;\n}\n.create-login td {\n  text-align: left;\n  padding: 1em;\n}\n.create-login label div {\n  line-height: 2em;\n}\n.create-login label input {\n  padding: 0.5em;\n  margin-bottom: 1em;\n  border-radius: 0.25em;\n  border-width: 1px;\n}\n.create-login td.thin-line, .create-login th.thin-line {\n  width: 1px;\n  padding: 0px;\n  margin: 0px;\n  background-color: #eeeeee;\n}\n</style>\n{{end}}\n\n{{define \"pane\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"create-login\">\n  <tr>\n    <th>Create User</th>\n    <th class=\"thin-line\"></th>\n    <th>Login</th>\n  <tr>\n  <tr>\n    <td>\n      <form action=\"{{$pt.CreateUserAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"An Example Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"CreateUser\" />\n        </div>\n      </form>\n    </td>\n    <td class=\"thin-line\"></td>\n    <td>\n      <form action=\"{{$pt.LoginAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"Your User Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"Login\" />\n        </div>\n      </form>\n    </td>\n  </tr>\n</table>\n{{end}}\n"
+	Index = "{{define \"panestyle\"}}\n<style>\n  .index {\n    text-align: center;\n  }\n\n  .index ul.thumbnail-list {\n    list-style-type: none;\n    padding: 0;\n  }\n  \n  .index ul.thumbnail-list li {\n    display: inline;\n  }\n  \n  .index .thumbnail-cntr {\n    background-color: #FFFFEE;\n    border-style: solid;\n    border-width: 2px;\n    border-color: #2c1fc0;\n    border-radius: 10px;\n    display: inline-block;\n    height: 192px;\n    margin: 6px;\n    padding: 0;\n    text-align: center;\n    width: 192px;\n  }\n  \n  .index .thumbnail-cntr:hover {\n    border-color: #9c99bf;\n  }\n  \n  .index img.thumbnail {\n    width: 192px;\n    height: 192px;\n    border-radius: 8px;\n  }\n\n  .index img.deleted {\n    filter: blur(5px) grayscale(5%);\n    -webkit-filter: blur(5px) grayscale(5%);\n  }\n  \n  .index .nav-home {\n    text-align: center;\n  }\n  .index .nav-prev {\n    float: left;\n  }\n  .index .nav-next {\n    float: right;\n  }\n  .index .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- $pt := .Paths -}}\n{{if .PrevID}}<link rel=\"prev\" href=\"{{$pt.IndexPrev .PrevID}}\">{{end}}\n{{if .NextID}}<link rel=\"next\" href=\"{{$pt.Index .NextID}}\">{{end}}\n{{end}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .PrevID}}<span class=\"nav-prev\"><a href=\"{{$pt.IndexPrev .PrevID}}\">Previous</a></span>{{end}}\n    {{if .NextID}}<span class=\"nav-next\"><a href=\"{{$pt.Index .NextID}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"pane\"}}\n<div class=\"index\">\n  {{ $pt := .Paths}}\n  {{- $pr := $pt.Params -}}\n  {{- template \"nav\" . -}}\n  {{if .Pic}}\n  <ul class=\"thumbnail-list\">\n    {{- range .Pic -}}\n    <li>{{- /**/ -}}\n      <div class=\"thumbnail-cntr\">{{- /**/ -}}\n        <a href=\"{{$pt.Viewer .Pic.Id}}\">{{- /**/ -}}\n          <picture>{{- /**/ -}}\n            {{- range $pt.PicFileSources .Thumbnail -}}\n            <source type=\"{{.Type}}\" srcset=\"{{.Srcset}}\" />{{- /**/ -}}\n            {{- end -}}\n            <img {{/**/ -}}\n\t            class=\"thumbnail{{if .Pic.PendingDeletion}} deleted{{end}}\" {{/**/ -}}\n\t            src=\"{{$pt.PicFileFirst .Thumbnail}}\" />{{- /**/ -}}\n          </picture>{{- /**/ -}}\n\t      </a>{{- /**/ -}}\n      </div>{{- /**/ -}}\n    </li>{{- /**/ -}}\n    {{- end -}}\n  </ul>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{if .CanUpload}}\n<div style=\"margin-bottom: 2em; margin-top: 2em;\">\n  <fieldset>\n    <legend>Pic Upload</legend>\n    <form action=\"{{$pt.UpsertPicAction}}\" method=\"post\" enctype=\"multipart/form-data\">\n      <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n      <dl>\n        <dt style=\"display:inline-block\">File Upload (option 1)</dt>\n        <dd style=\"display:inline-block\"><input type=\"file\" name=\"{{$pr.File}}\" /></dd>\n      </dl>\n      <dl>\n        <dt style=\"display:inline-block\">URL Upload (option 2)</dt>\n        <dd style=\"display:inline-block\"><input placeholder=\"File URL\" name=\"{{$pr.Url}}\" /></dd>\n      </dl>\n      <dl>\n        <dt style=\"display:inline-block\">Only visible to me</dt>\n        <dd style=\"display:inline-block\"><input type=\"checkbox\" name=\"{{$pr.Private}}\" /></dd>\n      </dl>\n      <input type=\"submit\" value=\"Submit\" />\n    </form>\n  </fieldset>\n</div>\n{{end}}\n{{end}}\n"
+
+	Login = "{{define \"panestyle\"}}\n<style>\ntable.create-login {\n  margin: auto;\n  width: 66.67%; width: calc(100%/3*2);\n  background-color: #fcfcf7;\n  border-width: 1px;\n  border-color: #eeeeee;\n  border-style: solid;\n  padding: 1em;\n}\n.create-login th {\n  text-align: left;\n  padding: 1em;\n}\n.create-login td {\n  text-align: left;\n  padding: 1em;\n}\n.create-login label div {\n  line-height: 2em;\n}\n.create-login label input {\n  padding: 0.5em;\n  margin-bottom: 1em;\n  border-radius: 0.25em;\n  border-width: 1px;\n}\n.create-login td.thin-line, .create-login th.thin-line {\n  width: 1px;\n  padding: 0px;\n  margin: 0px;\n  background-color: #eeeeee;\n}\n</style>\n{{end}}\n\n{{define \"pane\"}}\n{{$pt := .Paths}}\n{{$pr := $pt.Params}}\n<table class=\"create-login\">\n  <tr>\n    <th>Create User</th>\n    <th class=\"thin-line\"></th>\n    <th>Login</th>\n  <tr>\n  <tr>\n    <td>\n      <form action=\"{{$pt.CreateUserAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"An Example Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"CreateUser\" />\n        </div>\n      </form>\n    </td>\n    <td class=\"thin-line\"></td>\n    <td>\n      <form action=\"{{$pt.LoginAction}}\" method=\"post\">\n        <label>\n          <div>User Name:</div> \n          <input type=\"text\" name=\"{{$pr.Ident}}\" placeholder=\"Your User Name\" />\n        </label>\n        <label>\n          <div>Password</div>\n          <input type=\"password\" name=\"{{$pr.Secret}}\" />\n        </label>\n        <label>\n          <div>Two-Factor Code (if enabled)</div>\n          <input type=\"text\" name=\"{{$pr.TotpCode}}\" autocomplete=\"one-time-code\" />\n        </label>\n        <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n        <input type=\"hidden\" name=\"{{$pr.Next}}\" value=\"{{.Next}}\" />\n        <div>\n          <input type=\"submit\" value=\"Login\" />\n        </div>\n      </form>\n    </td>\n  </tr>\n  {{if .Oidc}}\n  <tr>\n    <td></td>\n    <td class=\"thin-line\"></td>\n    <td>\n      <a href=\"{{$pt.OidcLogin}}\">Login with your organization account</a>\n    </td>\n  </tr>\n  {{end}}\n</table>\n{{end}}\n"
 
 	Pane = "{{block \"basestyle\" .}}\n<style>\n  .pane {\n    margin-left: auto;\n    margin-right: auto;\n    max-width: 960px;\n  }\n  .pane-header {\n    margin-top: 6px;\n    position: relative;\n    margin-bottom: 1em;\n  }\n  .pane-header a {\n    text-decoration: none;\n  }\n  .pane-user-box {\n    background-color: white;\n    border: 1px solid black;\n    float: right;\n    padding: 4px;\n    position: absolute;\n    right: 0%;\n  }\n  .pane-header .site-name {\n    text-align: center;\n  }\n  .pane-header .site-name h1 {\n    display: inline;\n  }\n  .pane-header .site-name a:link {\n    color: #000;\n  }\n  .pane-header .site-name a:visited {\n    color: #000;\n  }\n  .pane-header .site-name a:hover {\n    color: #000;\n  }\n  .pane .error {\n    color: red;\n    margin: 1em;\n    text-align: center;\n  }\n  .pane-logged-in-user {\n    text-align: right;\n  }\n  .pane-logged-in-user .dropdown-user-menu {\n    display: none;\n  }\n  .pane-user-box:hover .dropdown-user-menu {\n    display: block;\n    margin-top: 1em;\n  } \n  .pane-user-link:hover {\n    background-color: #f7f7f7;\n  }\n</style>  \n{{block \"panestyle\" .}}{{end}}\n{{end}}\n{{define \"body\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"pane\">\n  <div class=\"pane-header\">\n    <div class=\"pane-user-box\">\n      {{if .SubjectUser}}\n        <div class=\"pane-logged-in-user\">\n          <div class=\"pane-user-link\">\n            <a href=\"{{$pt.UserEvents \"\" \"\" false }}\">{{.SubjectUser.Ident}}</a>\n          </div>\n          <div class=\"dropdown-user-menu\">\n            <hr>\n            <form action=\"{{$pt.LoginAction}}\" method=\"post\">\n              <input type=\"hidden\" name=\"{{$pr.Logout}}\" value=\"non-empty string\" />\n              <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\" />\n              <input type=\"submit\" value=\"Logout\" />\n            </form>\n          </div>\n        </div>\n      {{else}}\n        <a href=\"{{$pt.Login}}\">Login</a>\n      {{end}}\n    </div>\n    <div class=\"site-name\">\n      <a href=\"{{$pt.Root}}\"><h1>{{.SiteName}}</h1></a>\n    </div>\n  </div>\n  {{if .Err}}\n    {{- template \"error\" . -}}\n  {{end}}\n  {{template \"pane\" .}}\n</div>\n{{end}}\n{{define \"error\"}}\n<div class=\"error\">\n  <strong style=\"\">{{.Err.Error}}{{if .ErrShouldLogin}} - <a href=\"{{.Paths.Login}}\">Login</a>?{{end}}</strong>\n</div>\n{{end}}\n\n"
 
 	UserEdit = "{{- define \"userpanestyle\" -}}\n<style>\n\n</style>\n{{- end -}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>User Edit</h2>\n  <form method=\"post\" action=\"{{$pt.UpdateUserAction}}\">\n    <input type=\"hidden\" name=\"{{$pr.Xsrf}}\" value=\"{{.XsrfToken}}\">\n    <input type=\"hidden\" name=\"{{$pr.UserId}}\" value=\"{{.ObjectUser.UserId}}\">\n    <input type=\"hidden\" name=\"{{$pr.Version}}\" value=\"{{.ObjectUser.Version}}\">\n  <dl>\n    <dt>Ident</dt>\n    <dd>{{ .ObjectUser.Ident }}</dd>\n    <dt>Created</dt>\n    <dd>{{ .ObjectUser.CreatedTime }}</dd>\n    <fieldset>\n      <legend>Capabilities</legend>\n      <div style=\"display: table\">\n      {{- $canedit := .CanEditCap -}}\n      {{ range .Cap }}\n        <input \n            type=\"hidden\" \n            name=\"{{.Cap | $pr.OldUserCapability}}\" \n            value=\"{{if .Has}}{{$pr.True}}{{else}}{{$pr.False}}{{end}}\"\n            {{if not $canedit}}disabled{{end}}>\n        <div style=\"display:table-row\">\n          <div style=\"display:table-cell\">{{.Cap}}</div>\n          <div style=\"display:table-cell\">\n            <label for=\"{{.Cap | $pr.NewUserCapability}}-yes\">Yes<label>\n            <input \n                type=\"radio\"\n                id=\"{{.Cap | $pr.NewUserCapability}}-yes\" \n                name=\"{{.Cap | $pr.NewUserCapability}}\" \n                value=\"{{$pr.True}}\"\n                {{if not $canedit}}disabled{{end}}\n                {{if .Has}}checked{{end}}>\n          </div>\n          <div style=\"display:table-cell\">\n            <label for=\"{{.Cap | $pr.NewUserCapability}}-no\">No<label>\n              <input \n                  type=\"radio\"\n                  id=\"{{.Cap | $pr.NewUserCapability}}-no\" \n                  name=\"{{.Cap | $pr.NewUserCapability}}\" \n                  value=\"{{$pr.False}}\"\n                  {{if not $canedit}}disabled{{end}}\n                  {{if not .Has}}checked{{end}}>\n          </div>\n        </div>\n      {{ end }}\n      </div>\n    </fieldset>\n  </dl>\n  <input type=\"submit\" {{if not $canedit}}disabled{{end}}>\n  </form>\n</div>\n{{end}}\n"
 
-	UserEvents = "{{- define \"userpanestyle\" -}}\n<style>\n  .nav-prev {\n    float: left;\n  }\n  .nav-next {\n    float: right;\n  }\n  .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- end -}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .Prev}}<span class=\"nav-prev\"><a href=\"{{$pt.UserEvents .ObjectUserId .Prev true}}\">Previous</a></span>{{end}}\n    {{if .Next}}<span class=\"nav-next\"><a href=\"{{$pt.UserEvents .ObjectUserId .Next false}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>Recent Activity</h2>\n  {{- template \"nav\" . -}}\n  {{if .UserEvents}}\n    <table>\n    {{range .UserEvents}}\n      <tr>\n        <td>{{.CreatedTime}}</td>\n        <td>\n          {{$evt := .GetOutgoingUpsertPicVote}}{{if $evt}}\n            This user upvoted <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingUpsertPicVote}}{{if $evt}}\n            User {{$evt.SubjectUserId}} upvoted \n            <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetOutgoingPicComment}}{{if $evt}}\n            This user commented on \n            <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.CommentId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingPicComment}}{{if $evt}}\n            Some user commented on \n            {{if $evt.CommentParentId}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentParentId}}\"\n                  >{{$evt.CommentParentId}}</a>\n            {{else}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.PicId}}</a>\n            {{end}}\n          {{end}}\n          {{$evt := .GetUpsertPic}}{{if $evt}}\n            This user uploaded pic <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n        </td>\n      </tr>\n    {{end}}\n    </table>\n  {{else}}\n    <p>No more events.</p>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{end}}\n"
+	UserEvents = "{{- define \"userpanestyle\" -}}\n<style>\n  .nav-prev {\n    float: left;\n  }\n  .nav-next {\n    float: right;\n  }\n  .nav:after {\n    clear: both;\n    content: \"\";\n    display: block;\n  }\n</style>\n{{- end -}}\n{{define \"nav\"}}\n  {{- $pt := .Paths -}}\n  {{- $pr := $pt.Params -}}\n  <div class=\"nav\">\n    {{if .Prev}}<span class=\"nav-prev\"><a href=\"{{$pt.UserEvents .ObjectUserId .Prev true}}\">Previous</a></span>{{end}}\n    {{if .Next}}<span class=\"nav-next\"><a href=\"{{$pt.UserEvents .ObjectUserId .Next false}}\">Next</a></span>{{end}}\n  </div>\n{{end}}\n{{define \"userpane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div>\n  <h2>Recent Activity</h2>\n  {{- template \"nav\" . -}}\n  {{if .UserEvents}}\n    <table>\n    {{range .UserEvents}}\n      <tr>\n        <td>{{.CreatedTime}}</td>\n        <td>\n          {{$evt := .GetOutgoingUpsertPicVote}}{{if $evt}}\n            This user upvoted <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingUpsertPicVote}}{{if $evt}}\n            User {{$evt.SubjectUserId}} upvoted \n            <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetOutgoingPicComment}}{{if $evt}}\n            This user commented on \n            <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.CommentId}}</a>\n          {{end}}\n          {{$evt := .GetIncomingPicComment}}{{if $evt}}\n            Some user commented on \n            {{if $evt.CommentParentId}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentParentId}}\"\n                  >{{$evt.CommentParentId}}</a>\n            {{else}}\n              <a href=\"{{$pt.ViewerComment $evt.PicId $evt.CommentId}}\">{{$evt.PicId}}</a>\n            {{end}}\n          {{end}}\n          {{$evt := .GetUpsertPic}}{{if $evt}}\n            This user uploaded pic <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetRemovePicTags}}{{if $evt}}\n            This user removed tags {{range $i, $name := $evt.TagName}}{{if $i}}, {{end}}{{$name}}{{end}}\n            from <a href=\"{{$pt.Viewer $evt.PicId}}\">{{$evt.PicId}}</a>\n          {{end}}\n          {{$evt := .GetUpdateRole}}{{if $evt}}\n            This user {{if $evt.Created}}created{{else}}changed{{end}} role {{$evt.Name}}\n            {{- if $evt.SetCapability}}, adding {{range $i, $c := $evt.SetCapability}}{{if $i}}, {{end}}{{$c}}{{end}}{{end}}\n            {{- if $evt.ClearCapability}}, removing {{range $i, $c := $evt.ClearCapability}}{{if $i}}, {{end}}{{$c}}{{end}}{{end}}\n          {{end}}\n          {{$evt := .GetUpdateUserRoles}}{{if $evt}}\n            This user changed the roles of user\n            <a href=\"{{$pt.UserEvents $evt.ObjectUserId \"\" false}}\">{{$evt.ObjectUserId}}</a>\n            {{- if $evt.SetRoleId}}, adding {{range $i, $id := $evt.SetRoleId}}{{if $i}}, {{end}}{{$id}}{{end}}{{end}}\n            {{- if $evt.ClearRoleId}}, removing {{range $i, $id := $evt.ClearRoleId}}{{if $i}}, {{end}}{{$id}}{{end}}{{end}}\n          {{end}}\n        </td>\n      </tr>\n    {{end}}\n    </table>\n  {{else}}\n    <p>No more events.</p>\n  {{end}}\n  {{- template \"nav\" . -}}\n</div>\n{{end}}\n"
 
 	Userpane = "{{block \"panestyle\" .}}\n<style>\n.row {\n  margin-left: auto;\n  margin-right: auto;\n}\n\n.row:after {\n  clear: both;\n  content: \"\";\n  display: table;\n}\n\n.row .col {\n  float: left;\n  min-height: 1px;\n}\n\n.row .col.s1 {\n  width: 8.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s2 {\n  width: 16.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s3 {\n  width: 25%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s4 {\n  width: 33.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s5 {\n  width: 41.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s6 {\n  width: 50%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s7 {\n  width: 58.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s8 {\n  width: 66.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s9 {\n  width: 75%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s10 {\n  width: 83.3333333333%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s11 {\n  width: 91.6666666667%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.row .col.s12 {\n  width: 100%;\n  margin-left: auto;\n  left: auto;\n  right: auto;\n}\n\n.user-side-nav {\n  width: 25%;\n  left: auto;\n  right: auto;\n}\n.user-pane {\n  width: 75%;\n  left: auto;\n  right: auto;\n}\n</style>\n{{block \"userpanestyle\" .}}{{end}}\n{{end}}\n{{define \"pane\"}}\n{{- $pt := .Paths -}}\n{{- $pr := $pt.Params -}}\n<div class=\"row\">\n  <div class=\"col s2\">\n    <ul>\n      <li><a href=\"{{$pt.UserEvents .ObjectUserId \"\" false }}\">Activity</a></li>\n      <li><a href=\"{{$pt.UserEdit .ObjectUserId}}\">Account</a></li>\n    </ul>\n  </div>{{- /**/ -}}\n  <div class=\"col s8\">\n    {{template \"userpane\" .}}\n  </div>\n</div>\n{{end}}\n"
 
@@ -37,7 +37,9 @@ func Auth(ctx context.Context, cc **grpc.ClientConn) (context.Context, status.S)
 	}
 
 	md := make(metadata.MD, 2)
-	if conf.AuthToken != "" {
+	if conf.ApiKey != "" {
+		md.Set(authPwtHeaderKey, conf.ApiKey)
+	} else if conf.AuthToken != "" {
 		md.Set(authPwtHeaderKey, conf.AuthToken)
 	}
 	if conf.PixToken != "" {
@@ -125,6 +127,10 @@ func updateConfig(ctx context.Context, cc **grpc.ClientConn, conf *config.Config
 			}()
 		}
 	}
+	// API keys don't need refreshing, and are checked by the server on use.
+	if conf.ApiKey != "" {
+		return nil
+	}
 	var (
 		useToken bool
 		useCreds bool = true
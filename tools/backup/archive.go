@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/status"
+)
+
+// The archive is a directory holding one subdirectory per generation, named by a zero padded
+// number.  Each generation is laid out as:
+//
+//	manifest.json        describes the generation, and checksums every file in it
+//	tables/Pics.rows     length delimited rows that changed since the base generation
+//	tables/Pics.keys     the key of every row in the table, one per line
+//	blobs/...            pic files that changed, in the pix path layout
+//
+// A generation is built in a directory ending in ".partial", and renamed when it is complete.
+const (
+	manifestVersion = 1
+	manifestName    = "manifest.json"
+	partialSuffix   = ".partial"
+	tablesDir       = "tables"
+	blobsDir        = "blobs"
+)
+
+var generationPattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+type manifest struct {
+	Version    int    `json:"version"`
+	Generation string `json:"generation"`
+	// Base is the previous generation, or empty if this is a full backup.
+	Base string `json:"base,omitempty"`
+	// Since is the modified time that rows must be at or after to be included.  Zero for full
+	// backups.
+	Since time.Time `json:"since"`
+	// SnapshotTime is when the tables were read.
+	SnapshotTime time.Time `json:"snapshot_time"`
+	// DbName is the adapter the rows were read from.
+	DbName string `json:"db_name"`
+	// Sequence is the next id to allocate.
+	Sequence int64         `json:"sequence"`
+	Tables   []*tableEntry `json:"tables"`
+	// Blobs lists every pic file of the site, including those stored in earlier generations.
+	Blobs []*blobEntry `json:"blobs"`
+}
+
+type tableEntry struct {
+	Name string `json:"name"`
+	// Rows is the number of rows in this generation.
+	Rows       int64  `json:"rows"`
+	RowsSha256 string `json:"rows_sha256"`
+	// Keys is the number of rows in the table.
+	Keys       int64  `json:"keys"`
+	KeysSha256 string `json:"keys_sha256"`
+}
+
+type blobEntry struct {
+	Key  blobstore.Key `json:"key"`
+	Path string        `json:"path"`
+	// Generation holds the contents of the blob.
+	Generation string `json:"generation"`
+	Size       int64  `json:"size"`
+	// Sha256 is empty until the blob has been copied.
+	Sha256 string `json:"sha256,omitempty"`
+}
+
+func (m *manifest) table(name string) *tableEntry {
+	for _, te := range m.Tables {
+		if te.Name == name {
+			return te
+		}
+	}
+	return nil
+}
+
+func generationName(n int) string {
+	return strconv.Itoa(1000000 + n)[1:]
+}
+
+// generations returns the complete generations in the archive, oldest first.
+func generations(archive string) ([]string, status.S) {
+	fis, err := ioutil.ReadDir(archive)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, status.Internal(err, "can't read archive")
+	}
+	var gens []string
+	for _, fi := range fis {
+		if fi.IsDir() && generationPattern.MatchString(fi.Name()) {
+			gens = append(gens, fi.Name())
+		}
+	}
+	sort.Strings(gens)
+	return gens, nil
+}
+
+func readManifest(dir string) (*manifest, status.S) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, manifestName))
+	if os.IsNotExist(err) {
+		return nil, status.NotFound(err, "no manifest in", dir)
+	} else if err != nil {
+		return nil, status.Internal(err, "can't read manifest")
+	}
+	m := new(manifest)
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, status.DataLoss(err, "can't parse manifest in", dir)
+	}
+	if m.Version != manifestVersion {
+		return nil, status.Unimplemented(nil, "unknown manifest version", m.Version)
+	}
+	return m, nil
+}
+
+func writeManifest(dir string, m *manifest) status.S {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return status.Internal(err, "can't encode manifest")
+	}
+	tmp := filepath.Join(dir, manifestName+".tmp")
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return status.Internal(err, "can't write manifest")
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, manifestName)); err != nil {
+		return status.Internal(err, "can't write manifest")
+	}
+	return nil
+}
+
+// chain returns the manifests needed to restore gen, newest first.
+func chain(archive, gen string) ([]*manifest, status.S) {
+	var ms []*manifest
+	for gen != "" {
+		m, sts := readManifest(filepath.Join(archive, gen))
+		if sts != nil {
+			return nil, sts
+		}
+		ms = append(ms, m)
+		gen = m.Base
+	}
+	return ms, nil
+}
+
+// hashingWriter writes a file, keeping track of its checksum.
+type hashingWriter struct {
+	f *os.File
+	w *bufio.Writer
+	h hash.Hash
+}
+
+func createHashingWriter(name string) (*hashingWriter, status.S) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, status.Internal(err, "can't create", name)
+	}
+	h := sha256.New()
+	return &hashingWriter{
+		f: f,
+		w: bufio.NewWriter(io.MultiWriter(f, h)),
+		h: h,
+	}, nil
+}
+
+func (hw *hashingWriter) Write(data []byte) (int, error) {
+	return hw.w.Write(data)
+}
+
+func (hw *hashingWriter) writeRow(row proto.Message) status.S {
+	data, err := proto.Marshal(row)
+	if err != nil {
+		return status.Internal(err, "can't encode row")
+	}
+	var buf [binary.MaxVarintLen64]byte
+	if _, err := hw.w.Write(buf[:binary.PutUvarint(buf[:], uint64(len(data)))]); err != nil {
+		return status.Internal(err, "can't write row")
+	}
+	if _, err := hw.w.Write(data); err != nil {
+		return status.Internal(err, "can't write row")
+	}
+	return nil
+}
+
+// close finishes the file, and returns its checksum.
+func (hw *hashingWriter) close() (string, status.S) {
+	if err := hw.w.Flush(); err != nil {
+		hw.f.Close()
+		return "", status.Internal(err, "can't flush", hw.f.Name())
+	}
+	if err := hw.f.Close(); err != nil {
+		return "", status.Internal(err, "can't close", hw.f.Name())
+	}
+	return hex.EncodeToString(hw.h.Sum(nil)), nil
+}
+
+// readRows calls fn for each row in the file, after checking its checksum.
+func readRows(name, sum string, newRow func() proto.Message, fn func(proto.Message) status.S) status.S {
+	if sts := checkFile(name, sum); sts != nil {
+		return sts
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return status.Internal(err, "can't open", name)
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var data []byte
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return status.DataLoss(err, "can't read row size", name)
+		}
+		if uint64(cap(data)) < size {
+			data = make([]byte, size)
+		}
+		data = data[:size]
+		if _, err := io.ReadFull(r, data); err != nil {
+			return status.DataLoss(err, "can't read row", name)
+		}
+		row := newRow()
+		if err := proto.Unmarshal(data, row); err != nil {
+			return status.DataLoss(err, "can't decode row", name)
+		}
+		if sts := fn(row); sts != nil {
+			return sts
+		}
+	}
+}
+
+// readKeys reads the keys file, after checking its checksum.
+func readKeys(name, sum string) (map[string]bool, status.S) {
+	if sts := checkFile(name, sum); sts != nil {
+		return nil, sts
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, status.Internal(err, "can't open", name)
+	}
+	defer f.Close()
+	keys := make(map[string]bool)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		keys[s.Text()] = true
+	}
+	if err := s.Err(); err != nil {
+		return nil, status.DataLoss(err, "can't read keys", name)
+	}
+	return keys, nil
+}
+
+func fileSha256(name string) (string, int64, status.S) {
+	f, err := os.Open(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", 0, status.NotFound(err, "missing", name)
+		}
+		return "", 0, status.Internal(err, "can't open", name)
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, status.Internal(err, "can't read", name)
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+func checkFile(name, sum string) status.S {
+	have, _, sts := fileSha256(name)
+	if sts != nil {
+		return sts
+	}
+	if have != sum {
+		return status.DataLoss(nil, "checksum mismatch for", name, "have", have, "want", sum)
+	}
+	return nil
+}
@@ -0,0 +1,426 @@
+// backup copies a whole site, including pic files, into an archive directory, and restores it.
+// The first backup is full, and later ones only include rows and pic files that changed since
+// the previous one.  An interrupted backup resumes where it stopped when rerun.
+//
+// Usage:
+//
+//	backup -archive=DIR [-full] create
+//	backup -archive=DIR [-generation=N] verify
+//	backup -archive=DIR -dest_dbname=... -dest_dbconfig=... -dest_pix_path=... restore
+package main // import "pixur.org/pixur/tools/backup"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server"
+	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/status"
+)
+
+var (
+	archivePath = flag.String("archive", "", "directory holding the backup generations")
+	full        = flag.Bool("full", false, "back up everything, rather than what changed")
+	generation  = flag.String("generation", "", "generation to verify or restore.  Default latest.")
+	concurrency = flag.Int("concurrency", runtime.NumCPU(), "number of pic files to copy at once")
+)
+
+// dumpTables writes every table into dir, and lists the pic files that need to be copied.
+func dumpTables(ctx context.Context, db sdb.DB, dir, gen string, prev *manifest) (
+	_ *manifest, stscap status.S) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, status.Internal(err, "can't clear", dir)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, tablesDir), 0755); err != nil {
+		return nil, status.Internal(err, "can't create", dir)
+	}
+	m := &manifest{
+		Version:      manifestVersion,
+		Generation:   gen,
+		SnapshotTime: time.Now(),
+		DbName:       db.Adapter().Name(),
+	}
+	prevBlobs := make(map[string]*blobEntry)
+	if prev != nil {
+		m.Base = prev.Generation
+		m.Since = prev.SnapshotTime
+		for _, be := range prev.Blobs {
+			prevBlobs[be.Path] = be
+		}
+	}
+
+	j, err := tab.NewJob(ctx, db)
+	if err != nil {
+		return nil, status.Internal(err, "can't create job")
+	}
+	defer j.Rollback()
+
+	for _, t := range tables {
+		te, sts := dumpTable(j, t, dir, m, prevBlobs)
+		if sts != nil {
+			return nil, sts
+		}
+		m.Tables = append(m.Tables, te)
+		log.Println("backed up", te.Rows, "of", te.Keys, t.name)
+	}
+	if err := j.Rollback(); err != nil {
+		return nil, status.Internal(err, "can't rollback job")
+	}
+	// Read after the tables, so that it is past every id in them.
+	seq, sts := readSequence(ctx, db)
+	if sts != nil {
+		return nil, sts
+	}
+	m.Sequence = seq
+	if sts := writeManifest(dir, m); sts != nil {
+		return nil, sts
+	}
+	return m, nil
+}
+
+func dumpTable(j *tab.Job, t *table, dir string, m *manifest, prevBlobs map[string]*blobEntry) (
+	_ *tableEntry, stscap status.S) {
+	te := &tableEntry{
+		Name: t.name,
+	}
+	rows, sts := createHashingWriter(filepath.Join(dir, tablesDir, t.name+".rows"))
+	if sts != nil {
+		return nil, sts
+	}
+	keys, sts := createHashingWriter(filepath.Join(dir, tablesDir, t.name+".keys"))
+	if sts != nil {
+		rows.close()
+		return nil, sts
+	}
+	err := t.scan(j, func(row proto.Message) error {
+		te.Keys++
+		if _, err := io.WriteString(keys, keyString(t, row)+"\n"); err != nil {
+			return status.Internal(err, "can't write key")
+		}
+		changed := true
+		if mod, ok := row.(modified); ok && !m.Since.IsZero() && mod.GetModifiedTs() != nil {
+			changed = !schema.ToTime(mod.GetModifiedTs()).Before(m.Since)
+		}
+		if p, ok := row.(*schema.Pic); ok {
+			if sts := addPicBlobs(m, p, changed, prevBlobs); sts != nil {
+				return sts
+			}
+		}
+		if !changed {
+			return nil
+		}
+		te.Rows++
+		return rows.writeRow(row)
+	})
+	if err != nil {
+		rows.close()
+		keys.close()
+		return nil, status.From(err)
+	}
+	if te.RowsSha256, sts = rows.close(); sts != nil {
+		keys.close()
+		return nil, sts
+	}
+	if te.KeysSha256, sts = keys.close(); sts != nil {
+		return nil, sts
+	}
+	return te, nil
+}
+
+// addPicBlobs lists the files of the pic.  Unchanged files are kept in their earlier generation.
+func addPicBlobs(m *manifest, p *schema.Pic, changed bool, prevBlobs map[string]*blobEntry) status.S {
+	if p.HardDeleted() || p.File == nil {
+		return nil
+	}
+	keys := []blobstore.Key{blobstore.PicFileKey(p.PicId, p.File.Mime)}
+	for _, pfs := range [][]*schema.Pic_File{p.Thumbnail, p.Derived} {
+		for _, pf := range pfs {
+			keys = append(keys, blobstore.PicFileDerivedKey(p.PicId, pf.Index, pf.Mime))
+		}
+	}
+	for _, key := range keys {
+		path, sts := key.Path("")
+		if sts != nil {
+			return sts
+		}
+		if prev, present := prevBlobs[path]; present && !changed {
+			m.Blobs = append(m.Blobs, prev)
+			continue
+		}
+		m.Blobs = append(m.Blobs, &blobEntry{
+			Key:        key,
+			Path:       path,
+			Generation: m.Generation,
+		})
+	}
+	return nil
+}
+
+func readSequence(ctx context.Context, db sdb.DB) (int64, status.S) {
+	tx, err := db.Begin(ctx, true)
+	if err != nil {
+		return 0, status.Internal(err, "can't begin")
+	}
+	defer tx.Rollback()
+	adap := db.Adapter()
+	rows, err := tx.Query("SELECT " + adap.Quote(sdb.SequenceColName) + " FROM " +
+		adap.Quote(sdb.SequenceTableName) + ";")
+	if err != nil {
+		return 0, status.Internal(err, "can't read sequence")
+	}
+	defer rows.Close()
+	var seq int64
+	if !rows.Next() {
+		return 0, status.Internal(rows.Err(), "missing sequence")
+	}
+	if err := rows.Scan(&seq); err != nil {
+		return 0, status.Internal(err, "can't scan sequence")
+	}
+	return seq, nil
+}
+
+// copyBlobs copies the pic files that aren't in the archive yet.  Files missing from the blob
+// store are left out of the manifest.
+func copyBlobs(ctx context.Context, blobs blobstore.BlobStore, dir string, m *manifest) status.S {
+	work := make(chan *blobEntry)
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		missing  = make(map[*blobEntry]bool)
+		firstSts status.S
+	)
+	for i := 0; i < *concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for be := range work {
+				sts := copyBlob(ctx, blobs, dir, be)
+				if sts != nil && sts.Code() == codes.NotFound {
+					log.Println("skipping missing pic file", be.Path)
+					mu.Lock()
+					missing[be] = true
+					mu.Unlock()
+				} else if sts != nil {
+					mu.Lock()
+					status.ReplaceOrSuppress(&firstSts, sts)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, be := range m.Blobs {
+		if be.Generation == m.Generation && be.Sha256 == "" {
+			work <- be
+		}
+	}
+	close(work)
+	wg.Wait()
+	if firstSts != nil {
+		return firstSts
+	}
+	var kept []*blobEntry
+	for _, be := range m.Blobs {
+		if !missing[be] {
+			kept = append(kept, be)
+		}
+	}
+	m.Blobs = kept
+	return nil
+}
+
+func copyBlob(ctx context.Context, blobs blobstore.BlobStore, dir string, be *blobEntry) (
+	stscap status.S) {
+	dst := filepath.Join(dir, blobsDir, be.Path)
+	// Left over from an earlier attempt.  Files are renamed into place once complete.
+	if sum, size, sts := fileSha256(dst); sts == nil {
+		be.Sha256, be.Size = sum, size
+		return nil
+	} else if sts.Code() != codes.NotFound {
+		return sts
+	}
+	src, sts := blobs.Get(ctx, be.Key)
+	if sts != nil {
+		return sts
+	}
+	defer src.Close()
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return status.Internal(err, "can't create dir for", dst)
+	}
+	f, err := ioutil.TempFile(filepath.Dir(dst), "__")
+	if err != nil {
+		return status.Internal(err, "can't create tempfile")
+	}
+	defer func() {
+		if stscap != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, h), src)
+	if err != nil {
+		return status.Internal(err, "can't copy", be.Path)
+	}
+	if err := f.Close(); err != nil {
+		return status.Internal(err, "can't close", f.Name())
+	}
+	if err := os.Rename(f.Name(), dst); err != nil {
+		return status.Internal(err, "can't rename", f.Name())
+	}
+	be.Sha256, be.Size = hex.EncodeToString(h.Sum(nil)), size
+	return nil
+}
+
+func create(ctx context.Context) status.S {
+	db, err := sdb.Open(ctx, config.Conf.DbName, config.Conf.DbConfig)
+	if err != nil {
+		return status.From(err)
+	}
+	defer db.Close()
+	blobs, sts := server.NewBlobStore(config.Conf)
+	if sts != nil {
+		return sts
+	}
+	return createGeneration(ctx, db, blobs)
+}
+
+// createGeneration backs up db and blobs into the next generation of the archive.
+func createGeneration(ctx context.Context, db sdb.DB, blobs blobstore.BlobStore) status.S {
+	gens, sts := generations(*archivePath)
+	if sts != nil {
+		return sts
+	}
+	var prev *manifest
+	next := 1
+	if len(gens) != 0 {
+		last := gens[len(gens)-1]
+		n, err := strconv.Atoi(last)
+		if err != nil {
+			return status.Internal(err, "bad generation", last)
+		}
+		next = n + 1
+		if !*full {
+			if prev, sts = readManifest(filepath.Join(*archivePath, last)); sts != nil {
+				return sts
+			}
+		}
+	}
+	gen := generationName(next)
+	dir := filepath.Join(*archivePath, gen+partialSuffix)
+
+	m, sts := readManifest(dir)
+	if sts == nil && (prev == nil) == (m.Base == "") {
+		log.Println("resuming generation", gen)
+	} else if sts != nil && sts.Code() != codes.NotFound {
+		return sts
+	} else if m, sts = dumpTables(ctx, db, dir, gen, prev); sts != nil {
+		return sts
+	}
+
+	if sts := copyBlobs(ctx, blobs, dir, m); sts != nil {
+		return sts
+	}
+	if sts := writeManifest(dir, m); sts != nil {
+		return sts
+	}
+	if err := os.Rename(dir, filepath.Join(*archivePath, gen)); err != nil {
+		return status.Internal(err, "can't finish generation", gen)
+	}
+	log.Println("created generation", gen, "with", len(m.Blobs), "pic files")
+	return nil
+}
+
+// targetGeneration picks the generation named by the flag, or else the latest one.
+func targetGeneration() (string, status.S) {
+	if *generation != "" {
+		return *generation, nil
+	}
+	gens, sts := generations(*archivePath)
+	if sts != nil {
+		return "", sts
+	}
+	if len(gens) == 0 {
+		return "", status.NotFound(nil, "no generations in", *archivePath)
+	}
+	return gens[len(gens)-1], nil
+}
+
+// verify checks every file needed to restore the generation against its checksum.
+func verify(ctx context.Context) status.S {
+	gen, sts := targetGeneration()
+	if sts != nil {
+		return sts
+	}
+	ms, sts := chain(*archivePath, gen)
+	if sts != nil {
+		return sts
+	}
+	for i, m := range ms {
+		dir := filepath.Join(*archivePath, m.Generation)
+		for _, te := range m.Tables {
+			if sts := checkFile(filepath.Join(dir, tablesDir, te.Name+".rows"), te.RowsSha256); sts != nil {
+				return sts
+			}
+			if i != 0 {
+				continue
+			}
+			if sts := checkFile(filepath.Join(dir, tablesDir, te.Name+".keys"), te.KeysSha256); sts != nil {
+				return sts
+			}
+		}
+	}
+	for _, be := range ms[0].Blobs {
+		if err := ctx.Err(); err != nil {
+			return status.From(err)
+		}
+		name := filepath.Join(*archivePath, be.Generation, blobsDir, be.Path)
+		if sts := checkFile(name, be.Sha256); sts != nil {
+			return sts
+		}
+	}
+	log.Println("verified generation", gen, "and", len(ms)-1, "earlier generations")
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	ctx := context.Background()
+	var sts status.S
+	if *archivePath == "" || *concurrency < 1 {
+		sts = status.InvalidArgument(nil, "archive must be set, and concurrency must be positive")
+	} else {
+		switch flag.Arg(0) {
+		case "create":
+			sts = create(ctx)
+		case "verify":
+			sts = verify(ctx)
+		case "restore":
+			sts = restore(ctx)
+		default:
+			sts = status.InvalidArgument(nil, "usage: backup [flags] create|verify|restore")
+		}
+	}
+	if sts != nil {
+		log.Println(sts)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+func openTestDB(t *testing.T, ctx context.Context) sdb.DB {
+	t.Helper()
+	db, err := sdb.OpenForTest(ctx, "sqlite3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stmts []string
+	stmts = append(stmts, tab.SqlTables[db.Adapter().Name()]...)
+	stmts = append(stmts, tab.SqlInitTables[db.Adapter().Name()]...)
+	if err := db.InitSchema(ctx, stmts); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "backuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func autoJob(t *testing.T, ctx context.Context, db sdb.DB, fn func(j *tab.Job) error) {
+	t.Helper()
+	j, err := tab.NewJob(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fn(j); err != nil {
+		j.Rollback()
+		t.Fatal(err)
+	}
+	if err := j.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// insertPic adds a pic with a file and a thumbnail to the db and blob store.
+func insertPic(t *testing.T, ctx context.Context, db sdb.DB, blobs blobstore.BlobStore,
+	picId int64, now time.Time) *schema.Pic {
+	t.Helper()
+	p := &schema.Pic{
+		PicId: picId,
+		File:  &schema.Pic_File{Mime: schema.Pic_File_JPEG},
+		Thumbnail: []*schema.Pic_File{{
+			Index: 0,
+			Mime:  schema.Pic_File_PNG,
+		}},
+	}
+	p.SetCreatedTime(now)
+	p.SetModifiedTime(now)
+	autoJob(t, ctx, db, func(j *tab.Job) error {
+		return j.InsertPic(p)
+	})
+	vid := schema.Varint(picId).Encode()
+	files := map[blobstore.Key]string{
+		blobstore.PicFileKey(picId, schema.Pic_File_JPEG):          "pic" + vid,
+		blobstore.PicFileDerivedKey(picId, 0, schema.Pic_File_PNG): "thumb" + vid,
+	}
+	for key, data := range files {
+		if sts := blobs.Put(ctx, key, strings.NewReader(data)); sts != nil {
+			t.Fatal(sts)
+		}
+	}
+	return p
+}
+
+// readTables returns every row of db, by table and then key.
+func readTables(t *testing.T, ctx context.Context, db sdb.DB) map[string]map[string]proto.Message {
+	t.Helper()
+	all := make(map[string]map[string]proto.Message)
+	autoJob(t, ctx, db, func(j *tab.Job) error {
+		for _, tb := range tables {
+			rows := make(map[string]proto.Message)
+			if err := tb.scan(j, func(row proto.Message) error {
+				rows[keyString(tb, row)] = row
+				return nil
+			}); err != nil {
+				return err
+			}
+			all[tb.name] = rows
+		}
+		return nil
+	})
+	return all
+}
+
+// readBlobs returns the contents of every blob in the store, by path.
+func readBlobs(t *testing.T, ctx context.Context, blobs blobstore.BlobStore) map[string]string {
+	t.Helper()
+	all := make(map[string]string)
+	sts := blobs.List(ctx, func(info *blobstore.Info) status.S {
+		r, sts := blobs.Get(ctx, info.Key)
+		if sts != nil {
+			return sts
+		}
+		defer r.Close()
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return status.Internal(err, "can't read blob")
+		}
+		path, sts := info.Key.Path("")
+		if sts != nil {
+			return sts
+		}
+		all[path] = string(data)
+		return nil
+	})
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	return all
+}
+
+func TestBackupRestoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	archive := tempDir(t)
+	defer os.RemoveAll(archive)
+	srcPixPath, destPixPath := tempDir(t), tempDir(t)
+	defer os.RemoveAll(srcPixPath)
+	defer os.RemoveAll(destPixPath)
+
+	oldArchivePath := *archivePath
+	*archivePath = archive
+	defer func() {
+		*archivePath = oldArchivePath
+	}()
+
+	src := openTestDB(t, ctx)
+	defer src.Close()
+	srcBlobs := blobstore.NewPixPathStore(srcPixPath)
+
+	now := time.Now()
+	p1 := insertPic(t, ctx, src, srcBlobs, 10, now)
+	p2 := insertPic(t, ctx, src, srcBlobs, 11, now)
+	tag := &schema.Tag{
+		TagId:      20,
+		Name:       "cat",
+		UsageCount: 2,
+	}
+	tag.SetCreatedTime(now)
+	tag.SetModifiedTime(now)
+	pts := []*schema.PicTag{{
+		PicId: p1.PicId,
+		TagId: tag.TagId,
+		Name:  tag.Name,
+	}, {
+		PicId: p2.PicId,
+		TagId: tag.TagId,
+		Name:  tag.Name,
+	}}
+	autoJob(t, ctx, src, func(j *tab.Job) error {
+		if err := j.InsertTag(tag); err != nil {
+			return err
+		}
+		for _, pt := range pts {
+			pt.SetCreatedTime(now)
+			pt.SetModifiedTime(now)
+			if err := j.InsertPicTag(pt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if sts := createGeneration(ctx, src, srcBlobs); sts != nil {
+		t.Fatal(sts)
+	}
+
+	// Change, delete, and add rows and pic files.
+	later := time.Now()
+	p3 := insertPic(t, ctx, src, srcBlobs, 12, later)
+	autoJob(t, ctx, src, func(j *tab.Job) error {
+		tag.UsageCount = 1
+		tag.SetModifiedTime(later)
+		if err := j.UpdateTag(tag); err != nil {
+			return err
+		}
+		if err := j.DeletePicTag(tab.KeyForPicTag(pts[1])); err != nil {
+			return err
+		}
+		return j.DeletePic(tab.KeyForPic(p2))
+	})
+	for _, key := range []blobstore.Key{
+		blobstore.PicFileKey(p2.PicId, schema.Pic_File_JPEG),
+		blobstore.PicFileDerivedKey(p2.PicId, 0, schema.Pic_File_PNG),
+	} {
+		if sts := srcBlobs.Delete(ctx, key); sts != nil {
+			t.Fatal(sts)
+		}
+	}
+
+	if sts := createGeneration(ctx, src, srcBlobs); sts != nil {
+		t.Fatal(sts)
+	}
+
+	gen := generationName(2)
+	ms, sts := chain(archive, gen)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if have, want := len(ms), 2; have != want {
+		t.Fatal("have", have, "want", want, "generations")
+	}
+	// Only the changed rows are in the incremental generation.
+	if have, want := ms[0].table("Pics").Rows, int64(1); have != want {
+		t.Error("have", have, "want", want)
+	}
+	var copied []string
+	for _, be := range ms[0].Blobs {
+		if be.Generation == gen {
+			copied = append(copied, be.Path)
+		}
+	}
+	if have, want := len(copied), 2; have != want {
+		t.Error("have", copied, "want", want, "pic files of", p3.PicId)
+	}
+
+	dest := openTestDB(t, ctx)
+	defer dest.Close()
+	destBlobs := blobstore.NewPixPathStore(destPixPath)
+	if sts := restoreGeneration(ctx, dest, destBlobs, gen, ms); sts != nil {
+		t.Fatal(sts)
+	}
+
+	srcRows, destRows := readTables(t, ctx, src), readTables(t, ctx, dest)
+	for _, tb := range tables {
+		have, want := destRows[tb.name], srcRows[tb.name]
+		if len(have) != len(want) {
+			t.Error(tb.name, "have", len(have), "want", len(want), "rows")
+		}
+		for key, row := range want {
+			if !proto.Equal(have[key], row) {
+				t.Error(tb.name, "have", have[key], "want", row)
+			}
+		}
+	}
+
+	srcData, destData := readBlobs(t, ctx, srcBlobs), readBlobs(t, ctx, destBlobs)
+	if have, want := len(destData), 4; have != want {
+		t.Error("have", have, "want", want, "pic files")
+	}
+	for path, data := range srcData {
+		if have := destData[path]; have != data {
+			t.Error(filepath.Join(destPixPath, path), "have", have, "want", data)
+		}
+	}
+
+	srcSeq, sts := readSequence(ctx, src)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	destSeq, sts := readSequence(ctx, dest)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if destSeq != srcSeq {
+		t.Error("have", destSeq, "want", srcSeq)
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"pixur.org/pixur/be/blobstore"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/status"
+)
+
+var (
+	destDbName     = flag.String("dest_dbname", "", "dest sql name")
+	destDbConfig   = flag.String("dest_dbconfig", "", "dest sql config")
+	destPixPath    = flag.String("dest_pix_path", "", "dest directory for pic files.  Must be empty.")
+	initDestTables = flag.Bool("dest_inittables", true, "create tables before restoring")
+	batchSize      = flag.Int("batch_size", 1000, "number of rows to insert per transaction")
+)
+
+// batchInserter commits rows in batches, to keep transactions small.
+type batchInserter struct {
+	ctx context.Context
+	db  sdb.DB
+	j   *tab.Job
+	n   int
+}
+
+func (bi *batchInserter) insert(t *table, row proto.Message) status.S {
+	if bi.j == nil {
+		j, err := tab.NewJob(bi.ctx, bi.db)
+		if err != nil {
+			return status.Internal(err, "can't create job")
+		}
+		bi.j = j
+	}
+	if err := t.insert(bi.j, row); err != nil {
+		return status.Internal(err, "can't insert into", t.name)
+	}
+	if bi.n++; bi.n >= *batchSize {
+		return bi.commit()
+	}
+	return nil
+}
+
+func (bi *batchInserter) commit() status.S {
+	if bi.j == nil {
+		return nil
+	}
+	j := bi.j
+	bi.j, bi.n = nil, 0
+	if err := j.Commit(); err != nil {
+		j.Rollback()
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
+
+// restoreTable inserts the newest version of every row in the table.  ms is newest first.
+func restoreTable(ctx context.Context, db sdb.DB, t *table, ms []*manifest) status.S {
+	te := ms[0].table(t.name)
+	if te == nil {
+		return status.DataLoss(nil, "table missing from manifest", t.name)
+	}
+	// Rows not in the newest key set were deleted.  Keys are removed once restored, so older
+	// versions of the row are skipped.
+	live, sts := readKeys(filepath.Join(*archivePath, ms[0].Generation, tablesDir, t.name+".keys"),
+		te.KeysSha256)
+	if sts != nil {
+		return sts
+	}
+	bi := &batchInserter{ctx: ctx, db: db}
+	for _, m := range ms {
+		mte := m.table(t.name)
+		if mte == nil {
+			return status.DataLoss(nil, "table missing from generation", m.Generation, t.name)
+		}
+		name := filepath.Join(*archivePath, m.Generation, tablesDir, t.name+".rows")
+		sts := readRows(name, mte.RowsSha256, t.newRow, func(row proto.Message) status.S {
+			key := keyString(t, row)
+			if !live[key] {
+				return nil
+			}
+			delete(live, key)
+			return bi.insert(t, row)
+		})
+		if sts != nil {
+			if bi.j != nil {
+				bi.j.Rollback()
+			}
+			return sts
+		}
+	}
+	if sts := bi.commit(); sts != nil {
+		return sts
+	}
+	if len(live) != 0 {
+		return status.DataLoss(nil, len(live), "rows missing from archive for", t.name)
+	}
+	log.Println("restored", te.Keys, t.name)
+	return nil
+}
+
+func writeSequence(ctx context.Context, db sdb.DB, seq int64) status.S {
+	tx, err := db.Begin(ctx, false)
+	if err != nil {
+		return status.Internal(err, "can't begin")
+	}
+	defer tx.Rollback()
+	adap := db.Adapter()
+	_, err = tx.Exec("UPDATE " + adap.Quote(sdb.SequenceTableName) + " SET " +
+		adap.Quote(sdb.SequenceColName) + " = " + strconv.FormatInt(seq, 10) + ";")
+	if err != nil {
+		return status.Internal(err, "can't write sequence")
+	}
+	if err := tx.Commit(); err != nil {
+		return status.Internal(err, "can't commit sequence")
+	}
+	return nil
+}
+
+// restoreBlob copies the pic file into the store, checking its contents along the way.
+func restoreBlob(ctx context.Context, blobs blobstore.BlobStore, be *blobEntry) status.S {
+	name := filepath.Join(*archivePath, be.Generation, blobsDir, be.Path)
+	f, err := os.Open(name)
+	if err != nil {
+		return status.DataLoss(err, "can't open", name)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if sts := blobs.Put(ctx, be.Key, io.TeeReader(f, h)); sts != nil {
+		return sts
+	}
+	if have := hex.EncodeToString(h.Sum(nil)); have != be.Sha256 {
+		if sts := blobs.Delete(ctx, be.Key); sts != nil {
+			log.Println("can't remove bad pic file", be.Path, sts)
+		}
+		return status.DataLoss(nil, "checksum mismatch for", name, "have", have, "want", be.Sha256)
+	}
+	return nil
+}
+
+func restoreBlobs(ctx context.Context, blobs blobstore.BlobStore, m *manifest) status.S {
+	work := make(chan *blobEntry)
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstSts status.S
+	)
+	for i := 0; i < *concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for be := range work {
+				if sts := restoreBlob(ctx, blobs, be); sts != nil {
+					mu.Lock()
+					status.ReplaceOrSuppress(&firstSts, sts)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, be := range m.Blobs {
+		work <- be
+	}
+	close(work)
+	wg.Wait()
+	return firstSts
+}
+
+// verifyRestore checks that the dest has every row and pic file from the manifest.
+func verifyRestore(ctx context.Context, db sdb.DB, blobs blobstore.BlobStore, m *manifest) status.S {
+	j, err := tab.NewJob(ctx, db)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer j.Rollback()
+	for _, t := range tables {
+		var count int64
+		if err := t.scan(j, func(proto.Message) error {
+			count++
+			return nil
+		}); err != nil {
+			return status.Internal(err, "can't scan", t.name)
+		}
+		if want := m.table(t.name).Keys; count != want {
+			return status.DataLoss(nil, t.name, "has", count, "rows, want", want)
+		}
+	}
+	for _, be := range m.Blobs {
+		info, sts := blobs.Stat(ctx, be.Key)
+		if sts != nil {
+			return sts
+		}
+		if info.Size != be.Size {
+			return status.DataLoss(nil, be.Path, "has size", info.Size, "want", be.Size)
+		}
+	}
+	return nil
+}
+
+func restore(ctx context.Context) status.S {
+	if *destDbName == "" || *destPixPath == "" {
+		return status.InvalidArgument(nil, "dest_dbname and dest_pix_path must be set")
+	}
+	gen, sts := targetGeneration()
+	if sts != nil {
+		return sts
+	}
+	ms, sts := chain(*archivePath, gen)
+	if sts != nil {
+		return sts
+	}
+
+	db, err := sdb.Open(ctx, *destDbName, *destDbConfig)
+	if err != nil {
+		return status.From(err)
+	}
+	defer db.Close()
+	if *initDestTables {
+		var stmts []string
+		stmts = append(stmts, tab.SqlTables[db.Adapter().Name()]...)
+		stmts = append(stmts, tab.SqlInitTables[db.Adapter().Name()]...)
+		if err := db.InitSchema(ctx, stmts); err != nil {
+			return status.From(err)
+		}
+	}
+	if err := os.MkdirAll(*destPixPath, 0775); err != nil {
+		return status.Internal(err, "can't create pix dir")
+	}
+	blobs := blobstore.NewPixPathStore(*destPixPath)
+	return restoreGeneration(ctx, db, blobs, gen, ms)
+}
+
+// restoreGeneration copies the generation into db and blobs, which are expected to be empty.  ms
+// is the chain of manifests for gen, newest first.
+func restoreGeneration(ctx context.Context, db sdb.DB, blobs blobstore.BlobStore, gen string,
+	ms []*manifest) status.S {
+	for _, t := range tables {
+		if sts := restoreTable(ctx, db, t, ms); sts != nil {
+			return sts
+		}
+	}
+	if sts := writeSequence(ctx, db, ms[0].Sequence); sts != nil {
+		return sts
+	}
+	if sts := restoreBlobs(ctx, blobs, ms[0]); sts != nil {
+		return sts
+	}
+	if sts := verifyRestore(ctx, db, blobs, ms[0]); sts != nil {
+		return sts
+	}
+	log.Println("restored and verified generation", gen)
+	return nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+
+	"pixur.org/pixur/be/schema"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+)
+
+// table describes how to copy the rows of a single table.
+type table struct {
+	name   string
+	newRow func() proto.Message
+	scan   func(j *tab.Job, cb func(proto.Message) error) error
+	insert func(j *tab.Job, row proto.Message) error
+	key    func(row proto.Message) sdb.Idx
+}
+
+// modified is implemented by rows with a modified time.  Tables of rows that don't implement it
+// are fully backed up each time.
+type modified interface {
+	GetModifiedTs() *tspb.Timestamp
+}
+
+// keyString identifies a row within its table.
+func keyString(t *table, row proto.Message) string {
+	return fmt.Sprintf("%#v", t.key(row).Vals())
+}
+
+var tables = []*table{{
+	name:   "Users",
+	newRow: func() proto.Message { return new(schema.User) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanUsers(sdb.Opts{}, func(r *schema.User) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertUser(r.(*schema.User)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForUser(r.(*schema.User)) },
+}, {
+	name:   "UserEvents",
+	newRow: func() proto.Message { return new(schema.UserEvent) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanUserEvents(sdb.Opts{}, func(r *schema.UserEvent) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertUserEvent(r.(*schema.UserEvent)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForUserEvent(r.(*schema.UserEvent)) },
+}, {
+	name:   "Roles",
+	newRow: func() proto.Message { return new(schema.Role) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanRoles(sdb.Opts{}, func(r *schema.Role) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertRole(r.(*schema.Role)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForRole(r.(*schema.Role)) },
+}, {
+	name:   "UserIdentities",
+	newRow: func() proto.Message { return new(schema.UserIdentity) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanUserIdentities(sdb.Opts{}, func(r *schema.UserIdentity) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertUserIdentity(r.(*schema.UserIdentity)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForUserIdentity(r.(*schema.UserIdentity)) },
+}, {
+	name:   "Tags",
+	newRow: func() proto.Message { return new(schema.Tag) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanTags(sdb.Opts{}, func(r *schema.Tag) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertTag(r.(*schema.Tag)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForTag(r.(*schema.Tag)) },
+}, {
+	name:   "TagAliases",
+	newRow: func() proto.Message { return new(schema.TagAlias) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanTagAliases(sdb.Opts{}, func(r *schema.TagAlias) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertTagAlias(r.(*schema.TagAlias)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForTagAlias(r.(*schema.TagAlias)) },
+}, {
+	name:   "TagImplications",
+	newRow: func() proto.Message { return new(schema.TagImplication) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanTagImplications(sdb.Opts{}, func(r *schema.TagImplication) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error {
+		return j.InsertTagImplication(r.(*schema.TagImplication))
+	},
+	key: func(r proto.Message) sdb.Idx {
+		return tab.KeyForTagImplication(r.(*schema.TagImplication))
+	},
+}, {
+	name:   "Pics",
+	newRow: func() proto.Message { return new(schema.Pic) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanPics(sdb.Opts{}, func(r *schema.Pic) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertPic(r.(*schema.Pic)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForPic(r.(*schema.Pic)) },
+}, {
+	name:   "PicIdents",
+	newRow: func() proto.Message { return new(schema.PicIdent) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanPicIdents(sdb.Opts{}, func(r *schema.PicIdent) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertPicIdent(r.(*schema.PicIdent)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForPicIdent(r.(*schema.PicIdent)) },
+}, {
+	name:   "PicTags",
+	newRow: func() proto.Message { return new(schema.PicTag) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanPicTags(sdb.Opts{}, func(r *schema.PicTag) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertPicTag(r.(*schema.PicTag)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForPicTag(r.(*schema.PicTag)) },
+}, {
+	name:   "PicComments",
+	newRow: func() proto.Message { return new(schema.PicComment) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanPicComments(sdb.Opts{}, func(r *schema.PicComment) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertPicComment(r.(*schema.PicComment)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForPicComment(r.(*schema.PicComment)) },
+}, {
+	name:   "PicVotes",
+	newRow: func() proto.Message { return new(schema.PicVote) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanPicVotes(sdb.Opts{}, func(r *schema.PicVote) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertPicVote(r.(*schema.PicVote)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForPicVote(r.(*schema.PicVote)) },
+}, {
+	name:   "PicCommentVotes",
+	newRow: func() proto.Message { return new(schema.PicCommentVote) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanPicCommentVotes(sdb.Opts{}, func(r *schema.PicCommentVote) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error {
+		return j.InsertPicCommentVote(r.(*schema.PicCommentVote))
+	},
+	key: func(r proto.Message) sdb.Idx {
+		return tab.KeyForPicCommentVote(r.(*schema.PicCommentVote))
+	},
+}, {
+	name:   "BackgroundJobs",
+	newRow: func() proto.Message { return new(schema.BackgroundJob) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanBackgroundJobs(sdb.Opts{}, func(r *schema.BackgroundJob) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error {
+		return j.InsertBackgroundJob(r.(*schema.BackgroundJob))
+	},
+	key: func(r proto.Message) sdb.Idx { return tab.KeyForBackgroundJob(r.(*schema.BackgroundJob)) },
+}, {
+	name:   "CustomData",
+	newRow: func() proto.Message { return new(schema.CustomData) },
+	scan: func(j *tab.Job, cb func(proto.Message) error) error {
+		return j.ScanCustomData(sdb.Opts{}, func(r *schema.CustomData) error { return cb(r) })
+	},
+	insert: func(j *tab.Job, r proto.Message) error { return j.InsertCustomData(r.(*schema.CustomData)) },
+	key:    func(r proto.Message) sdb.Idx { return tab.KeyForCustomData(r.(*schema.CustomData)) },
+}}
@@ -19,19 +19,22 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // Config describes configuration used for command line tools.
 type Config struct {
 	// Pixur API server target
-	PixurTarget          string          `protobuf:"bytes,1,opt,name=pixur_target,json=pixurTarget,proto3" json:"pixur_target,omitempty"`
-	AuthToken            string          `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
-	PixToken             string          `protobuf:"bytes,3,opt,name=pix_token,json=pixToken,proto3" json:"pix_token,omitempty"`
-	AuthPayload          *api.PwtPayload `protobuf:"bytes,4,opt,name=auth_payload,json=authPayload,proto3" json:"auth_payload,omitempty"`
-	PixPayload           *api.PwtPayload `protobuf:"bytes,5,opt,name=pix_payload,json=pixPayload,proto3" json:"pix_payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	PixurTarget string          `protobuf:"bytes,1,opt,name=pixur_target,json=pixurTarget,proto3" json:"pixur_target,omitempty"`
+	AuthToken   string          `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	PixToken    string          `protobuf:"bytes,3,opt,name=pix_token,json=pixToken,proto3" json:"pix_token,omitempty"`
+	AuthPayload *api.PwtPayload `protobuf:"bytes,4,opt,name=auth_payload,json=authPayload,proto3" json:"auth_payload,omitempty"`
+	PixPayload  *api.PwtPayload `protobuf:"bytes,5,opt,name=pix_payload,json=pixPayload,proto3" json:"pix_payload,omitempty"`
+	// If set, api_key is sent in place of auth_token, and no ident or secret
+	// is asked for.  Keys are created with the CreateApiKey RPC.
+	ApiKey               string   `protobuf:"bytes,6,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -94,6 +97,13 @@ func (m *Config) GetPixPayload() *api.PwtPayload {
 	return nil
 }
 
+func (m *Config) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "pixur.tools.config.Config")
 }
@@ -101,19 +111,20 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptor_3eaf2c85e69e9ea4) }
 
 var fileDescriptor_3eaf2c85e69e9ea4 = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x4b,
-	0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2a, 0xc8, 0xac, 0x28, 0x2d, 0xd2,
-	0x2b, 0xc9, 0xcf, 0xcf, 0x29, 0xd6, 0x83, 0xc8, 0x48, 0xf1, 0x25, 0x16, 0x64, 0xea, 0xa7, 0x24,
-	0x96, 0x24, 0x42, 0xd4, 0x28, 0xdd, 0x64, 0xe4, 0x62, 0x73, 0x06, 0x4b, 0x09, 0x29, 0x72, 0xf1,
-	0x80, 0x35, 0xc4, 0x97, 0x24, 0x16, 0xa5, 0xa7, 0x96, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06,
-	0x71, 0x83, 0xc5, 0x42, 0xc0, 0x42, 0x42, 0xb2, 0x5c, 0x5c, 0x89, 0xa5, 0x25, 0x19, 0xf1, 0x25,
-	0xf9, 0xd9, 0xa9, 0x79, 0x12, 0x4c, 0x60, 0x05, 0x9c, 0x20, 0x91, 0x10, 0x90, 0x80, 0x90, 0x34,
-	0x17, 0x67, 0x41, 0x66, 0x05, 0x54, 0x96, 0x19, 0x2c, 0xcb, 0x51, 0x90, 0x59, 0x01, 0x91, 0xb4,
-	0xe0, 0xe2, 0x01, 0xeb, 0x2d, 0x48, 0xac, 0xcc, 0xc9, 0x4f, 0x4c, 0x91, 0x60, 0x51, 0x60, 0xd4,
-	0xe0, 0x36, 0x12, 0xd5, 0x83, 0x38, 0x32, 0xb1, 0x20, 0x53, 0x2f, 0xa0, 0xbc, 0x24, 0x00, 0x22,
-	0x19, 0xc4, 0x0d, 0x52, 0x0a, 0xe5, 0x08, 0x99, 0x71, 0x81, 0x1c, 0x01, 0xd7, 0xc8, 0x8a, 0x4f,
-	0x23, 0x57, 0x41, 0x66, 0x05, 0x94, 0xed, 0xa4, 0x1a, 0xa5, 0x0c, 0x51, 0x93, 0x5f, 0x94, 0xae,
-	0x0f, 0x66, 0xe9, 0x83, 0xc3, 0x42, 0x1f, 0x12, 0x16, 0xd6, 0x10, 0x2a, 0x89, 0x0d, 0x1c, 0x12,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0x36, 0x0b, 0x33, 0x3d, 0x01, 0x00, 0x00,
+	// 240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x4f, 0x4b, 0xc3, 0x40,
+	0x10, 0xc5, 0x89, 0x7f, 0xa2, 0x99, 0x04, 0x0f, 0x0b, 0x62, 0x50, 0x84, 0xaa, 0x08, 0x3d, 0x6d,
+	0x40, 0x41, 0x04, 0x6f, 0x7a, 0xf4, 0x52, 0x4a, 0x4f, 0x5e, 0xc2, 0x68, 0x63, 0x1c, 0x5a, 0xba,
+	0x43, 0x9c, 0x62, 0xf2, 0xb5, 0xfd, 0x04, 0xb2, 0x33, 0xd1, 0xa3, 0xa7, 0x64, 0xdf, 0x6f, 0x7e,
+	0xf0, 0x78, 0x50, 0xbc, 0x85, 0xcd, 0x3b, 0xb5, 0x9e, 0xbb, 0x20, 0xc1, 0x39, 0xa6, 0x7e, 0xdb,
+	0x79, 0x09, 0x61, 0xfd, 0xe9, 0x8d, 0x9c, 0x1e, 0x21, 0x53, 0xb5, 0x44, 0x41, 0xbb, 0xb9, 0xfc,
+	0x4e, 0x20, 0x7d, 0x52, 0xe4, 0x2e, 0xa0, 0x50, 0xa1, 0x16, 0xec, 0xda, 0x46, 0xca, 0x64, 0x92,
+	0x4c, 0xb3, 0x79, 0xae, 0xd9, 0x42, 0x23, 0x77, 0x0e, 0x80, 0x5b, 0xf9, 0xa8, 0x25, 0xac, 0x9a,
+	0x4d, 0xb9, 0xa3, 0x07, 0x59, 0x4c, 0x16, 0x31, 0x70, 0x67, 0x90, 0x31, 0xf5, 0x23, 0xdd, 0x55,
+	0x7a, 0xc8, 0xd4, 0x1b, 0xbc, 0x87, 0x42, 0x5d, 0xc6, 0x61, 0x1d, 0x70, 0x59, 0xee, 0x4d, 0x92,
+	0x69, 0x7e, 0x73, 0xec, 0xad, 0x24, 0x32, 0xf9, 0xd9, 0x97, 0xcc, 0x0c, 0xce, 0xf3, 0x78, 0x3a,
+	0x3e, 0xdc, 0x1d, 0xc4, 0x12, 0x7f, 0xe2, 0xfe, 0x7f, 0x22, 0x30, 0xf5, 0xbf, 0xde, 0x09, 0x1c,
+	0x20, 0x53, 0xbd, 0x6a, 0x86, 0x32, 0xd5, 0x32, 0x29, 0x32, 0x3d, 0x37, 0xc3, 0xe3, 0xf5, 0xcb,
+	0x95, 0xc9, 0xa1, 0x6b, 0x2b, 0xfd, 0xab, 0x74, 0xa4, 0xca, 0x46, 0x7a, 0xb0, 0xcf, 0x6b, 0xaa,
+	0x13, 0xdd, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x2c, 0x19, 0x82, 0x56, 0x01, 0x00, 0x00,
 }
@@ -6,13 +6,23 @@ import (
 	"log"
 	"time"
 
-	"pixur.org/pixur/be/schema"
 	sdb "pixur.org/pixur/be/schema/db"
-	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server"
 	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/status"
 	"pixur.org/pixur/be/tasks"
 )
 
+var (
+	dryRun        = flag.Bool("dry_run", false, "Log pics instead of hard deleting them")
+	batchSize     = flag.Int("batch_size", tasks.DefaultPruneBatchSize, "Pics read per transaction")
+	leaseDuration = flag.Duration("lease_duration", 10*time.Minute,
+		"How long the pruner lease is held for after each batch")
+)
+
+// run prunes pics once.  Servers configured with a pruner do this periodically, so this is only
+// needed when none are.  Like the servers, it takes the pruner lease before each batch, and stops
+// if another process holds it.
 func run(ctx context.Context) error {
 	db, err := sdb.Open(ctx, config.Conf.DbName, config.Conf.DbConfig)
 	if err != nil {
@@ -20,56 +30,60 @@ func run(ctx context.Context) error {
 	}
 	defer db.Close()
 
-	j, err := tab.NewJob(ctx, db)
-	if err != nil {
-		return err
-	}
-	defer j.Rollback()
-
-	perPicFn := func(p *schema.Pic) error {
-		return perPic(p, db, config.Conf.PixPath)
-	}
-
-	return j.ScanPics(sdb.Opts{
-		Prefix: tab.PicsPrimary{},
-		Lock:   sdb.LockNone,
-	}, perPicFn)
-}
-
-func perPic(p *schema.Pic, db sdb.DB, pixPath string) error {
-	now := time.Now()
-	// No deletion info
-	if p.DeletionStatus == nil {
-		return nil
-	}
-	// Some deletion info, but it isn't on the chopping block.
-	if p.DeletionStatus.PendingDeletedTs == nil {
-		return nil
-	}
-	// It was already hard deleted, ignore it
-	if p.DeletionStatus.ActualDeletedTs != nil {
-		return nil
+	blobs, sts := server.NewBlobStore(config.Conf)
+	if sts != nil {
+		return sts
 	}
 
-	pendingTime := schema.ToTime(p.DeletionStatus.PendingDeletedTs)
-	// It is pending deletion, just not yet.
-	if !now.After(pendingTime) {
-		return nil
+	holder, sts := server.NewLeaseHolder()
+	if sts != nil {
+		return sts
 	}
 
-	log.Println("Preparing to delete", p.GetVarPicId(), pendingTime)
-	var task = &tasks.HardDeletePicTask{
-		Beg:     db,
-		PixPath: pixPath,
-		PicId:   p.PicId,
-	}
 	runner := new(tasks.TaskRunner)
-	// TODO: use real userid
-	if err := runner.Run(tasks.CtxFromUserToken(context.TODO(), -12345, -12345), task); err != nil {
-		return err
-	}
+	var startPendingDeletionOrder, startPicId int64
+	for {
+		leaseTask := &tasks.AcquireLeaseTask{
+			Beg:      db,
+			Now:      time.Now,
+			LeaseId:  tasks.PrunerLeaseId,
+			Holder:   holder,
+			Duration: *leaseDuration,
+		}
+		if sts := runner.Run(ctx, leaseTask); sts != nil {
+			return sts
+		}
+		if !leaseTask.Acquired {
+			return status.FailedPrecondition(nil, "pruner lease held by", leaseTask.Lease.Holder)
+		}
 
-	return nil
+		task := &tasks.PruneDeletedPicsTask{
+			Beg:                       db,
+			BlobStore:                 blobs,
+			Now:                       time.Now,
+			StartPendingDeletionOrder: startPendingDeletionOrder,
+			StartPicId:                startPicId,
+			BatchSize:                 *batchSize,
+			DryRun:                    *dryRun,
+		}
+		if sts := runner.Run(ctx, task); sts != nil {
+			return sts
+		}
+		for picId, sts := range task.Failures {
+			log.Println("Unable to delete", picId, sts)
+		}
+		for _, picId := range task.PrunedPicIds {
+			if *dryRun {
+				log.Println("Would delete", picId)
+			} else {
+				log.Println("Deleted", picId)
+			}
+		}
+		if task.Done {
+			return nil
+		}
+		startPendingDeletionOrder, startPicId = task.NextPendingDeletionOrder, task.NextPicId
+	}
 }
 
 func main() {
@@ -68,6 +68,26 @@ func export(ctx context.Context) error {
 	log.Println("inserted", rowcount, "tags")
 	rowcount = 0
 
+	err = oldj.ScanTagAliases(sdb.Opts{}, func(ta *schema.TagAlias) error {
+		rowcount++
+		return newj.InsertTagAlias(ta)
+	})
+	if err != nil {
+		return err
+	}
+	log.Println("inserted", rowcount, "tag aliases")
+	rowcount = 0
+
+	err = oldj.ScanTagImplications(sdb.Opts{}, func(ti *schema.TagImplication) error {
+		rowcount++
+		return newj.InsertTagImplication(ti)
+	})
+	if err != nil {
+		return err
+	}
+	log.Println("inserted", rowcount, "tag implications")
+	rowcount = 0
+
 	err = oldj.ScanPics(sdb.Opts{}, func(p *schema.Pic) error {
 		rowcount++
 		return newj.InsertPic(p)
@@ -0,0 +1,391 @@
+// fsck checks that the pic files in storage match the database.
+package main // import "pixur.org/pixur/tools/fsck"
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha512"
+	"flag"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/schema"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server"
+	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+var (
+	rehash      = flag.Bool("rehash", false, "check the contents of pic files against their stored hashes")
+	repair      = flag.Bool("repair", false, "regenerate missing thumbnails")
+	orphanGrace = flag.Duration("orphan_grace", 24*time.Hour,
+		"how old orphaned files must be before they are listed in -orphan_list.  Newer files may "+
+			"belong to pics that are still being uploaded, and are left for a later run.")
+	orphanList = flag.String("orphan_list", "",
+		"file to write old orphaned files to, or with -delete_orphans, to read them from")
+	deleteOrphans = flag.Bool("delete_orphans", false,
+		"delete the files in -orphan_list that are still old orphans.  The list should be "+
+			"reviewed after the run that wrote it.")
+)
+
+type fileKind int
+
+const (
+	mainFile fileKind = iota
+	thumbnailFile
+	derivedFile
+)
+
+func (k fileKind) String() string {
+	switch k {
+	case mainFile:
+		return "file"
+	case thumbnailFile:
+		return "thumbnail"
+	case derivedFile:
+		return "derived"
+	default:
+		return "unknown"
+	}
+}
+
+// expectedFile is a pic file the database says should be in storage.
+type expectedFile struct {
+	pic  *schema.Pic
+	pf   *schema.Pic_File
+	kind fileKind
+}
+
+type fsck struct {
+	db    sdb.DB
+	blobs blobstore.BlobStore
+	now   time.Time
+
+	expected map[blobstore.Key]*expectedFile
+	seen     map[blobstore.Key]bool
+
+	// oldOrphans are orphaned files past the grace period.
+	oldOrphans []blobstore.Key
+
+	missing, orphaned, corrupted, repaired int
+}
+
+func (f *fsck) scanPics(ctx context.Context) status.S {
+	j, err := tab.NewJob(ctx, f.db)
+	if err != nil {
+		return status.From(err)
+	}
+	defer j.Rollback()
+
+	err = j.ScanPics(sdb.Opts{
+		Prefix: tab.PicsPrimary{},
+		Lock:   sdb.LockNone,
+	}, func(p *schema.Pic) error {
+		// Files of hard deleted pics should be gone, so they are reported as orphans.
+		if p.HardDeleted() {
+			return nil
+		}
+		f.expected[blobstore.PicFileKey(p.PicId, p.File.Mime)] = &expectedFile{
+			pic:  p,
+			pf:   p.File,
+			kind: mainFile,
+		}
+		for _, pf := range p.Thumbnail {
+			f.expected[blobstore.PicFileDerivedKey(p.PicId, pf.Index, pf.Mime)] = &expectedFile{
+				pic:  p,
+				pf:   pf,
+				kind: thumbnailFile,
+			}
+		}
+		for _, pf := range p.Derived {
+			f.expected[blobstore.PicFileDerivedKey(p.PicId, pf.Index, pf.Mime)] = &expectedFile{
+				pic:  p,
+				pf:   pf,
+				kind: derivedFile,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return status.From(err)
+	}
+	return nil
+}
+
+func (f *fsck) scanBlobs(ctx context.Context) status.S {
+	var orphans []*blobstore.Info
+	sts := f.blobs.List(ctx, func(info *blobstore.Info) status.S {
+		ef, present := f.expected[info.Key]
+		if !present {
+			orphans = append(orphans, info)
+			return nil
+		}
+		f.seen[info.Key] = true
+		if info.Size != ef.pf.Size {
+			f.corrupted++
+			log.Printf("corrupt %v %v of pic %v: size is %d, want %d",
+				ef.kind, ef.pf.Index, ef.pic.GetVarPicId(), info.Size, ef.pf.Size)
+		}
+		return nil
+	})
+	if sts != nil {
+		return sts
+	}
+	for _, info := range orphans {
+		f.orphaned++
+		log.Printf("orphan %+v, modified %v", info.Key, info.ModTime)
+		if f.now.Sub(info.ModTime) >= *orphanGrace {
+			f.oldOrphans = append(f.oldOrphans, info.Key)
+		}
+	}
+	return nil
+}
+
+// writeOrphans saves the old orphans to path, one per line, for a later run to delete.
+func (f *fsck) writeOrphans(path string) status.S {
+	var buf bytes.Buffer
+	for _, key := range f.oldOrphans {
+		fmt.Fprintf(&buf, "%d %t %d %v\n", key.PicId, key.Derived, key.Index, key.Mime)
+	}
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return status.Internal(err, "can't write orphan list")
+	}
+	log.Printf("wrote %d old orphans to %s", len(f.oldOrphans), path)
+	return nil
+}
+
+// deleteListedOrphans deletes the files listed in path by an earlier run.  Files that are no longer
+// old orphans are kept.
+func (f *fsck) deleteListedOrphans(ctx context.Context, path string) status.S {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return status.Internal(err, "can't read orphan list")
+	}
+	old := make(map[blobstore.Key]bool, len(f.oldOrphans))
+	for _, key := range f.oldOrphans {
+		old[key] = true
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var key blobstore.Key
+		var mime string
+		_, err := fmt.Sscanf(line, "%d %t %d %s", &key.PicId, &key.Derived, &key.Index, &mime)
+		if err != nil {
+			return status.InvalidArgumentf(err, "bad orphan list line %q", line)
+		}
+		m, present := schema.Pic_File_Mime_value[mime]
+		if !present {
+			return status.InvalidArgumentf(nil, "bad orphan list mime %q", mime)
+		}
+		key.Mime = schema.Pic_File_Mime(m)
+		if !old[key] {
+			log.Printf("keeping %+v, no longer an old orphan", key)
+			continue
+		}
+		if sts := f.blobs.Delete(ctx, key); sts != nil {
+			return sts
+		}
+		f.repaired++
+		log.Printf("deleted orphan %+v", key)
+	}
+	return nil
+}
+
+func (f *fsck) checkMissing(ctx context.Context) status.S {
+	// Thumbnails are regenerated together, so pics are only repaired once.
+	missingThumbnails := make(map[int64][]*expectedFile)
+	for key, ef := range f.expected {
+		if f.seen[key] {
+			continue
+		}
+		f.missing++
+		log.Printf("missing %v %v of pic %v", ef.kind, ef.pf.Index, ef.pic.GetVarPicId())
+		if ef.kind == thumbnailFile {
+			missingThumbnails[ef.pic.PicId] = append(missingThumbnails[ef.pic.PicId], ef)
+		}
+	}
+	if !*repair {
+		return nil
+	}
+	runner := new(tasks.TaskRunner)
+	for _, efs := range missingThumbnails {
+		p := efs[0].pic
+		if !f.seen[blobstore.PicFileKey(p.PicId, p.File.Mime)] {
+			log.Printf("can't regenerate thumbnails of pic %v: missing pic file", p.GetVarPicId())
+			continue
+		}
+		task := &tasks.RegeneratePicThumbnailsTask{
+			Beg:       f.db,
+			BlobStore: f.blobs,
+			Now:       func() time.Time { return f.now },
+			PicId:     p.PicId,
+		}
+		if sts := runner.Run(taskCtx(ctx), task); sts != nil {
+			log.Printf("can't regenerate thumbnails of pic %v: %v", p.GetVarPicId(), sts)
+			continue
+		}
+		f.repaired += len(efs)
+		log.Printf("regenerated thumbnails of pic %v", p.GetVarPicId())
+	}
+	return nil
+}
+
+// taskCtx lets tasks run without a user.  The tool has direct access to the database and storage,
+// so it doesn't need to be authorized like a user would.
+func taskCtx(ctx context.Context) context.Context {
+	conf := schema.GetDefaultConfiguration()
+	conf.AnonymousCapability = &schema.Configuration_CapabilitySet{
+		Capability: []schema.User_Capability{schema.User_PIC_CREATE},
+	}
+	return tasks.CtxFromTestConfig(ctx, conf)
+}
+
+// checkHashes compares the contents of each main pic file with the hashes recorded for it.
+func (f *fsck) checkHashes(ctx context.Context) status.S {
+	hashFns := map[schema.PicIdent_Type]func() hash.Hash{
+		schema.PicIdent_MD5:        md5.New,
+		schema.PicIdent_SHA1:       sha1.New,
+		schema.PicIdent_SHA512_256: sha512.New512_256,
+	}
+	for key, ef := range f.expected {
+		if ef.kind != mainFile || !f.seen[key] {
+			continue
+		}
+		idents, sts := f.findPicIdents(ctx, ef.pic.PicId)
+		if sts != nil {
+			return sts
+		}
+		hs := make(map[schema.PicIdent_Type]hash.Hash)
+		var ws []io.Writer
+		for _, ident := range idents {
+			if fn, present := hashFns[ident.Type]; present {
+				if _, present := hs[ident.Type]; !present {
+					hs[ident.Type] = fn()
+					ws = append(ws, hs[ident.Type])
+				}
+			}
+		}
+		if len(ws) == 0 {
+			log.Printf("no hashes for pic %v", ef.pic.GetVarPicId())
+			continue
+		}
+		r, sts := f.blobs.Get(ctx, key)
+		if sts != nil {
+			return sts
+		}
+		_, err := io.Copy(io.MultiWriter(ws...), r)
+		r.Close()
+		if err != nil {
+			return status.Internal(err, "can't read pic file", key)
+		}
+		// Merged pics keep the idents of their duplicates, so only one ident of each type has to
+		// match.
+		matched := make(map[schema.PicIdent_Type]bool)
+		for _, ident := range idents {
+			if h, present := hs[ident.Type]; present && bytes.Equal(h.Sum(nil), ident.Value) {
+				matched[ident.Type] = true
+			}
+		}
+		for typ, h := range hs {
+			if !matched[typ] {
+				f.corrupted++
+				log.Printf("corrupt file of pic %v: %v is %x", ef.pic.GetVarPicId(), typ, h.Sum(nil))
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fsck) findPicIdents(ctx context.Context, picId int64) ([]*schema.PicIdent, status.S) {
+	j, err := tab.NewJob(ctx, f.db)
+	if err != nil {
+		return nil, status.From(err)
+	}
+	defer j.Rollback()
+
+	idents, err := j.FindPicIdents(sdb.Opts{
+		Prefix: tab.PicIdentsPrimary{PicId: &picId},
+		Lock:   sdb.LockNone,
+	})
+	if err != nil {
+		return nil, status.From(err)
+	}
+	return idents, nil
+}
+
+func run(ctx context.Context) status.S {
+	if *deleteOrphans && *orphanList == "" {
+		return status.InvalidArgument(nil, "-delete_orphans needs -orphan_list")
+	}
+	db, err := sdb.Open(ctx, config.Conf.DbName, config.Conf.DbConfig)
+	if err != nil {
+		return status.From(err)
+	}
+	defer db.Close()
+
+	blobs, sts := server.NewBlobStore(config.Conf)
+	if sts != nil {
+		return sts
+	}
+
+	f := &fsck{
+		db:       db,
+		blobs:    blobs,
+		now:      time.Now(),
+		expected: make(map[blobstore.Key]*expectedFile),
+		seen:     make(map[blobstore.Key]bool),
+	}
+	if sts := f.scanPics(ctx); sts != nil {
+		return sts
+	}
+	if sts := f.scanBlobs(ctx); sts != nil {
+		return sts
+	}
+	if sts := f.checkMissing(ctx); sts != nil {
+		return sts
+	}
+	if *deleteOrphans {
+		if sts := f.deleteListedOrphans(ctx, *orphanList); sts != nil {
+			return sts
+		}
+	} else if *orphanList != "" {
+		if sts := f.writeOrphans(*orphanList); sts != nil {
+			return sts
+		}
+	}
+	if *rehash {
+		if sts := f.checkHashes(ctx); sts != nil {
+			return sts
+		}
+	}
+
+	log.Printf("checked %d files: %d missing, %d orphaned, %d corrupted, %d repaired",
+		len(f.expected), f.missing, f.orphaned, f.corrupted, f.repaired)
+	if left := f.missing + f.orphaned + f.corrupted - f.repaired; left > 0 {
+		return status.DataLossf(nil, "%d problems left", left)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	if sts := run(context.Background()); sts != nil {
+		log.Println(sts)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,563 @@
+// import uploads a collection of pics from directories, or zip and tar archives, to a Pixur
+// server.  Tags come from a sidecar JSON file next to each pic (e.g. "cat.jpg.json"), or else
+// from the names of the directories containing it.  Files the server already has are skipped.
+// Each result is appended to a manifest, so an interrupted import can be rerun to resume.
+package main // import "pixur.org/pixur/tools/import"
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	gstatus "google.golang.org/grpc/status"
+
+	"pixur.org/pixur/api"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/tools/auth"
+)
+
+var (
+	manifestPath = flag.String("manifest", "import.manifest",
+		"file recording the result of each imported file.  Deleting it starts over.")
+	concurrency = flag.Int("concurrency", runtime.NumCPU(), "number of files to upload at once")
+	dirTags     = flag.Bool("dir_tags", true,
+		"tag pics with the names of their directories, if there is no sidecar file")
+)
+
+const (
+	// sidecarExt is appended to the name of a pic to find its sidecar file.
+	sidecarExt = ".json"
+	// maxSidecarSize bounds how much of a sidecar file is read.
+	maxSidecarSize = 1 << 20
+	// maxUploadSize matches the largest message the server accepts.
+	maxUploadSize = 512 * 1024 * 1024
+)
+
+const (
+	resultImported = "imported"
+	resultExists   = "exists"
+	resultFailed   = "failed"
+)
+
+// record is a single line of the manifest.
+type record struct {
+	// Source identifies the file, such as "pics/cat.jpg" or "pics.zip:cat.jpg".
+	Source string `json:"source"`
+	// Sha512_256Hash is the hex encoded hash of the file, if it was read.
+	Sha512_256Hash string `json:"sha512_256_hash,omitempty"`
+	// PicId is the pic the file was uploaded to, if any.
+	PicId  string `json:"pic_id,omitempty"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// done is true if the file doesn't need to be imported again.
+func (r *record) done() bool {
+	return r.Result == resultImported || r.Result == resultExists
+}
+
+// sidecar is the optional metadata file for a pic.
+type sidecar struct {
+	Tags []string `json:"tags"`
+}
+
+// entry is a file read from a directory or archive, waiting to be imported.
+type entry struct {
+	source string
+	// name is the base name of the file.
+	name string
+	// dirs are the directory names between the root and the file.
+	dirs []string
+	data []byte
+	// sidecar is the contents of the sidecar file, or nil if there isn't one.
+	sidecar []byte
+}
+
+type importer struct {
+	c api.PixurServiceClient
+
+	mu sync.Mutex
+	w  io.Writer
+	// prev holds the last record for each source from earlier runs.
+	prev map[string]*record
+	// hashes maps the hash of each file already imported to its pic id.
+	hashes                   map[string]string
+	imported, exists, failed int64
+}
+
+// newImporter writes records to w, and skips the files already imported according to prev.
+func newImporter(c api.PixurServiceClient, w io.Writer, prev map[string]*record) *importer {
+	im := &importer{
+		c:      c,
+		w:      w,
+		prev:   prev,
+		hashes: make(map[string]string),
+	}
+	for _, r := range prev {
+		if r.done() && r.Sha512_256Hash != "" {
+			im.hashes[r.Sha512_256Hash] = r.PicId
+		}
+	}
+	return im
+}
+
+func readManifest(path string) (map[string]*record, status.S) {
+	prev := make(map[string]*record)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return prev, nil
+	} else if err != nil {
+		return nil, status.Internal(err, "can't open manifest")
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		r := new(record)
+		if err := json.Unmarshal(s.Bytes(), r); err != nil {
+			// The last line may be partially written if the import was interrupted.
+			log.Println("skipping bad manifest line", err)
+			continue
+		}
+		prev[r.Source] = r
+	}
+	if err := s.Err(); err != nil {
+		return nil, status.Internal(err, "can't read manifest")
+	}
+	return prev, nil
+}
+
+// skip is true if the source was successfully imported in an earlier run.
+func (im *importer) skip(source string) bool {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	r, present := im.prev[source]
+	return present && r.done()
+}
+
+// claim reserves the hash for the caller.  If the hash was already imported, it returns false
+// and the pic id, which may be empty if another worker is still uploading it.
+func (im *importer) claim(hash string) (string, bool) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	if picId, present := im.hashes[hash]; present {
+		return picId, false
+	}
+	im.hashes[hash] = ""
+	return "", true
+}
+
+// release gives up a claimed hash that couldn't be imported.
+func (im *importer) release(hash string) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	delete(im.hashes, hash)
+}
+
+func (im *importer) write(r *record) status.S {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	switch r.Result {
+	case resultImported:
+		im.imported++
+	case resultExists:
+		im.exists++
+	case resultFailed:
+		im.failed++
+	}
+	if r.done() && r.Sha512_256Hash != "" {
+		im.hashes[r.Sha512_256Hash] = r.PicId
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return status.Internal(err, "can't encode manifest record")
+	}
+	if _, err := im.w.Write(append(data, '\n')); err != nil {
+		return status.Internal(err, "can't write manifest")
+	}
+	return nil
+}
+
+func tagsFor(e *entry) ([]string, status.S) {
+	var tags []string
+	if e.sidecar != nil {
+		var sc sidecar
+		if err := json.Unmarshal(e.sidecar, &sc); err != nil {
+			return nil, status.InvalidArgument(err, "can't parse sidecar")
+		}
+		tags = sc.Tags
+	} else if *dirTags {
+		tags = e.dirs
+	}
+	var nonEmpty []string
+	for _, t := range tags {
+		if t = strings.TrimSpace(t); t != "" {
+			nonEmpty = append(nonEmpty, t)
+		}
+	}
+	return nonEmpty, nil
+}
+
+// perEntry uploads and tags the file, unless the server already has it.
+func (im *importer) perEntry(ctx context.Context, e *entry) *record {
+	r := &record{
+		Source: e.source,
+	}
+	fail := func(sts status.S) *record {
+		r.Result = resultFailed
+		r.Error = sts.Error()
+		return r
+	}
+	tags, sts := tagsFor(e)
+	if sts != nil {
+		return fail(sts)
+	}
+	sha512_256Hash := sha512.Sum512_256(e.data)
+	md5Hash := md5.Sum(e.data)
+	r.Sha512_256Hash = hex.EncodeToString(sha512_256Hash[:])
+
+	im.mu.Lock()
+	prev := im.prev[e.source]
+	im.mu.Unlock()
+	// The upload worked last time, but not the tagging.
+	if prev != nil && prev.PicId != "" && prev.Sha512_256Hash == r.Sha512_256Hash {
+		r.PicId = prev.PicId
+		if sts := im.addTags(ctx, r.PicId, tags); sts != nil {
+			return fail(sts)
+		}
+		r.Result = resultImported
+		return r
+	}
+
+	if picId, ok := im.claim(r.Sha512_256Hash); !ok {
+		r.PicId = picId
+		r.Result = resultExists
+		return r
+	}
+	failClaimed := func(sts status.S) *record {
+		im.release(r.Sha512_256Hash)
+		return fail(sts)
+	}
+	lookupResp, err := im.c.LookupPicByHash(ctx, &api.LookupPicByHashRequest{
+		Sha512_256Hash: sha512_256Hash[:],
+	})
+	if err == nil {
+		r.PicId = lookupResp.Pic.Id
+		r.Result = resultExists
+		return r
+	} else if gstatus.Code(err) != codes.NotFound {
+		return failClaimed(status.From(err))
+	}
+
+	upsertResp, err := im.c.UpsertPic(ctx, &api.UpsertPicRequest{
+		Name:    e.name,
+		Data:    e.data,
+		Md5Hash: md5Hash[:],
+	}, grpc.MaxCallSendMsgSize(maxUploadSize))
+	if err != nil {
+		return failClaimed(status.From(err))
+	}
+	r.PicId = upsertResp.Pic.Id
+	if sts := im.addTags(ctx, r.PicId, tags); sts != nil {
+		return fail(sts)
+	}
+	r.Result = resultImported
+	return r
+}
+
+func (im *importer) addTags(ctx context.Context, picId string, tags []string) status.S {
+	if len(tags) == 0 {
+		return nil
+	}
+	if _, err := im.c.AddPicTags(ctx, &api.AddPicTagsRequest{
+		PicId: picId,
+		Tag:   tags,
+	}); err != nil {
+		return status.From(err)
+	}
+	return nil
+}
+
+// walkDir sends each file under root that hasn't already been imported.
+func (im *importer) walkDir(ctx context.Context, root string, work chan<- *entry) status.S {
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || strings.HasSuffix(p, sidecarExt) || im.skip(p) {
+			return nil
+		}
+		e := &entry{
+			source: p,
+			name:   info.Name(),
+		}
+		if rel, err := filepath.Rel(root, filepath.Dir(p)); err == nil && rel != "." {
+			e.dirs = strings.Split(rel, string(filepath.Separator))
+		}
+		if e.data, err = ioutil.ReadFile(p); err != nil {
+			return err
+		}
+		if sc, err := ioutil.ReadFile(p + sidecarExt); err == nil {
+			e.sidecar = sc
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+		return send(ctx, work, e)
+	})
+	if err != nil {
+		return status.From(err)
+	}
+	return nil
+}
+
+func (im *importer) walkZip(ctx context.Context, name string, work chan<- *entry) status.S {
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		return status.InvalidArgument(err, "can't open zip", name)
+	}
+	defer zr.Close()
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, zf := range zr.File {
+		files[zf.Name] = zf
+	}
+	readZipFile := func(zf *zip.File, limit int64) ([]byte, error) {
+		rc, err := zf.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(io.LimitReader(rc, limit))
+	}
+	for _, zf := range zr.File {
+		source := name + ":" + zf.Name
+		if zf.FileInfo().IsDir() || strings.HasSuffix(zf.Name, sidecarExt) || im.skip(source) {
+			continue
+		}
+		e := &entry{
+			source: source,
+			name:   path.Base(zf.Name),
+			dirs:   archiveDirs(zf.Name),
+		}
+		if e.data, err = readZipFile(zf, maxUploadSize); err != nil {
+			return status.InvalidArgument(err, "can't read zip file", source)
+		}
+		if sc, present := files[zf.Name+sidecarExt]; present {
+			if e.sidecar, err = readZipFile(sc, maxSidecarSize); err != nil {
+				return status.InvalidArgument(err, "can't read zip file", source+sidecarExt)
+			}
+		}
+		if err := send(ctx, work, e); err != nil {
+			return status.From(err)
+		}
+	}
+	return nil
+}
+
+func openTar(name string) (*tar.Reader, io.Closer, status.S) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, status.InvalidArgument(err, "can't open tar", name)
+	}
+	if strings.HasSuffix(name, ".gz") || strings.HasSuffix(name, ".tgz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, nil, status.InvalidArgument(err, "can't open gzip", name)
+		}
+		return tar.NewReader(gr), f, nil
+	}
+	return tar.NewReader(f), f, nil
+}
+
+// walkTar reads the archive twice, since the sidecar files may come after the pics.
+func (im *importer) walkTar(ctx context.Context, name string, work chan<- *entry) status.S {
+	sidecars := make(map[string][]byte)
+	tr, closer, sts := openTar(name)
+	if sts != nil {
+		return sts
+	}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			closer.Close()
+			return status.InvalidArgument(err, "can't read tar", name)
+		}
+		if hdr.Typeflag != tar.TypeReg || !strings.HasSuffix(hdr.Name, sidecarExt) {
+			continue
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(tr, maxSidecarSize))
+		if err != nil {
+			closer.Close()
+			return status.InvalidArgument(err, "can't read tar", name)
+		}
+		sidecars[hdr.Name] = data
+	}
+	if err := closer.Close(); err != nil {
+		return status.Internal(err, "can't close tar", name)
+	}
+
+	tr, closer, sts = openTar(name)
+	if sts != nil {
+		return sts
+	}
+	defer closer.Close()
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return status.InvalidArgument(err, "can't read tar", name)
+		}
+		source := name + ":" + hdr.Name
+		if hdr.Typeflag != tar.TypeReg || strings.HasSuffix(hdr.Name, sidecarExt) || im.skip(source) {
+			continue
+		}
+		e := &entry{
+			source:  source,
+			name:    path.Base(hdr.Name),
+			dirs:    archiveDirs(hdr.Name),
+			sidecar: sidecars[hdr.Name+sidecarExt],
+		}
+		if e.data, err = ioutil.ReadAll(io.LimitReader(tr, maxUploadSize)); err != nil {
+			return status.InvalidArgument(err, "can't read tar file", source)
+		}
+		if err := send(ctx, work, e); err != nil {
+			return status.From(err)
+		}
+	}
+	return nil
+}
+
+// archiveDirs splits the directory part of a slash separated archive path.
+func archiveDirs(name string) []string {
+	dir := path.Dir(path.Clean("/" + name))
+	if dir == "/" {
+		return nil
+	}
+	return strings.Split(strings.TrimPrefix(dir, "/"), "/")
+}
+
+func send(ctx context.Context, work chan<- *entry, e *entry) error {
+	select {
+	case work <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (im *importer) walk(ctx context.Context, name string, work chan<- *entry) status.S {
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return im.walkZip(ctx, name, work)
+	case strings.HasSuffix(name, ".tar"), strings.HasSuffix(name, ".tar.gz"),
+		strings.HasSuffix(name, ".tgz"):
+		return im.walkTar(ctx, name, work)
+	default:
+		return im.walkDir(ctx, name, work)
+	}
+}
+
+func run(ctx context.Context, roots []string) (stscap status.S) {
+	if len(roots) == 0 {
+		return status.InvalidArgument(nil, "no directories or archives to import")
+	}
+	if *concurrency < 1 {
+		return status.InvalidArgument(nil, "concurrency must be positive")
+	}
+	prev, sts := readManifest(*manifestPath)
+	if sts != nil {
+		return sts
+	}
+	mf, err := os.OpenFile(*manifestPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return status.Internal(err, "can't open manifest")
+	}
+	defer func() {
+		if err := mf.Close(); err != nil {
+			status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't close manifest"))
+		}
+	}()
+
+	var cc *grpc.ClientConn
+	ctx, sts = auth.Auth(ctx, &cc)
+	if sts != nil {
+		return sts
+	}
+	defer cc.Close()
+
+	im := newImporter(api.NewPixurServiceClient(cc), mf, prev)
+	if len(prev) != 0 {
+		log.Println("resuming from", len(prev), "manifest records")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	work := make(chan *entry)
+	var wg sync.WaitGroup
+	var writeSts status.S
+	var writeStsOnce sync.Once
+	for i := 0; i < *concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for e := range work {
+				r := im.perEntry(ctx, e)
+				if r.Result == resultFailed {
+					log.Println("can't import", r.Source, r.Error)
+				}
+				if sts := im.write(r); sts != nil {
+					writeStsOnce.Do(func() {
+						writeSts = sts
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	var walkSts status.S
+	for _, root := range roots {
+		if walkSts = im.walk(ctx, root, work); walkSts != nil {
+			break
+		}
+	}
+	close(work)
+	wg.Wait()
+	if writeSts != nil {
+		return writeSts
+	}
+	if walkSts != nil {
+		return walkSts
+	}
+
+	log.Println("imported", im.imported, "exists", im.exists, "failed", im.failed)
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	if sts := run(context.Background(), flag.Args()); sts != nil {
+		log.Println(sts)
+		os.Exit(1)
+	}
+}
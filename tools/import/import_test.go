@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestReadManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "importtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "import.manifest")
+
+	// A missing manifest starts over.
+	prev, sts := readManifest(name)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	if len(prev) != 0 {
+		t.Error("have", prev, "want none")
+	}
+
+	var buf bytes.Buffer
+	im := newImporter(nil, &buf, prev)
+	records := []*record{{
+		Source:         "pics/cat.jpg",
+		Sha512_256Hash: "aa",
+		Result:         resultFailed,
+		Error:          "timeout",
+	}, {
+		Source:         "pics/dog.jpg",
+		Sha512_256Hash: "bb",
+		PicId:          "2",
+		Result:         resultImported,
+	}, {
+		// Retried in a later run.
+		Source:         "pics/cat.jpg",
+		Sha512_256Hash: "aa",
+		PicId:          "1",
+		Result:         resultImported,
+	}, {
+		Source: "pics/bad.jpg",
+		Result: resultFailed,
+		Error:  "can't read",
+	}}
+	for _, r := range records {
+		if sts := im.write(r); sts != nil {
+			t.Fatal(sts)
+		}
+	}
+	// The last line was cut off by an interrupted run.
+	buf.WriteString(`{"source":"pics/bir`)
+	if err := ioutil.WriteFile(name, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	prev, sts = readManifest(name)
+	if sts != nil {
+		t.Fatal(sts)
+	}
+	want := map[string]*record{
+		"pics/cat.jpg": records[2],
+		"pics/dog.jpg": records[1],
+		"pics/bad.jpg": records[3],
+	}
+	if !reflect.DeepEqual(prev, want) {
+		t.Error("have", prev, "want", want)
+	}
+
+	resumed := newImporter(nil, ioutil.Discard, prev)
+	for source, skip := range map[string]bool{
+		"pics/cat.jpg":  true,
+		"pics/dog.jpg":  true,
+		"pics/bad.jpg":  false,
+		"pics/bird.jpg": false,
+	} {
+		if have := resumed.skip(source); have != skip {
+			t.Error(source, "have", have, "want", skip)
+		}
+	}
+	// Copies of imported files under another name aren't uploaded again.
+	if picId, ok := resumed.claim("aa"); ok || picId != "1" {
+		t.Error("have", picId, ok, "want 1 false")
+	}
+}
+
+func TestClaim(t *testing.T) {
+	im := newImporter(nil, ioutil.Discard, nil)
+
+	if _, ok := im.claim("aa"); !ok {
+		t.Fatal("can't claim unseen hash")
+	}
+	// Another worker is still uploading it.
+	if picId, ok := im.claim("aa"); ok || picId != "" {
+		t.Error("have", picId, ok, "want empty false")
+	}
+
+	im.release("aa")
+	if _, ok := im.claim("aa"); !ok {
+		t.Fatal("can't claim released hash")
+	}
+	r := &record{
+		Source:         "pics/cat.jpg",
+		Sha512_256Hash: "aa",
+		PicId:          "1",
+		Result:         resultImported,
+	}
+	if sts := im.write(r); sts != nil {
+		t.Fatal(sts)
+	}
+	if picId, ok := im.claim("aa"); ok || picId != "1" {
+		t.Error("have", picId, ok, "want 1 false")
+	}
+
+	// Failed files may be claimed again.
+	if _, ok := im.claim("bb"); !ok {
+		t.Fatal("can't claim unseen hash")
+	}
+	im.release("bb")
+	r = &record{
+		Source:         "pics/dog.jpg",
+		Sha512_256Hash: "bb",
+		Result:         resultFailed,
+	}
+	if sts := im.write(r); sts != nil {
+		t.Fatal(sts)
+	}
+	if _, ok := im.claim("bb"); !ok {
+		t.Error("can't claim failed hash")
+	}
+}
+
+func TestTagsFor(t *testing.T) {
+	cases := []struct {
+		name    string
+		e       *entry
+		dirTags bool
+		want    []string
+	}{{
+		name: "sidecar",
+		e: &entry{
+			dirs:    []string{"animals"},
+			sidecar: []byte(`{"tags": [" cat ", "", "fluffy"]}`),
+		},
+		dirTags: true,
+		want:    []string{"cat", "fluffy"},
+	}, {
+		name: "sidecar without tags",
+		e: &entry{
+			dirs:    []string{"animals"},
+			sidecar: []byte(`{}`),
+		},
+		dirTags: true,
+	}, {
+		name: "dirs",
+		e: &entry{
+			dirs: []string{"animals", " cats "},
+		},
+		dirTags: true,
+		want:    []string{"animals", "cats"},
+	}, {
+		name: "no dir tags",
+		e: &entry{
+			dirs: []string{"animals"},
+		},
+	}}
+	oldDirTags := *dirTags
+	defer func() {
+		*dirTags = oldDirTags
+	}()
+	for _, c := range cases {
+		*dirTags = c.dirTags
+		have, sts := tagsFor(c.e)
+		if sts != nil {
+			t.Error(c.name, sts)
+			continue
+		}
+		if !reflect.DeepEqual(have, c.want) {
+			t.Error(c.name, "have", have, "want", c.want)
+		}
+	}
+}
+
+func TestTagsFor_BadSidecar(t *testing.T) {
+	e := &entry{
+		sidecar: []byte(`{"tags": "cat"}`),
+	}
+	if _, sts := tagsFor(e); sts == nil || sts.Code() != codes.InvalidArgument {
+		t.Error("have", sts, "want", codes.InvalidArgument)
+	}
+}
+
+func TestArchiveDirs(t *testing.T) {
+	cases := map[string][]string{
+		"cat.jpg":              nil,
+		"/cat.jpg":             nil,
+		"animals/cat.jpg":      {"animals"},
+		"animals/cats/cat.jpg": {"animals", "cats"},
+		"./animals/../cat.jpg": nil,
+	}
+	for name, want := range cases {
+		if have := archiveDirs(name); !reflect.DeepEqual(have, want) {
+			t.Error(name, "have", have, "want", want)
+		}
+	}
+}
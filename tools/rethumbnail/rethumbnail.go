@@ -0,0 +1,541 @@
+// rethumbnail regenerates missing or outdated thumbnails and derived files for all pics.  It can
+// be stopped at any time, and resumes from the last checkpoint.  Pics that fail are recorded, and
+// can be retried with -retry_failed.
+package main // import "pixur.org/pixur/tools/rethumbnail"
+
+import (
+	"bytes"
+	"context"
+	"expvar"
+	"flag"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+
+	"pixur.org/pixur/be/blobstore"
+	"pixur.org/pixur/be/imaging"
+	"pixur.org/pixur/be/schema"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server"
+	"pixur.org/pixur/be/server/config"
+	"pixur.org/pixur/be/status"
+	"pixur.org/pixur/be/tasks"
+)
+
+var (
+	checkpointPath = flag.String("checkpoint", "rethumbnail.checkpoint",
+		"file recording the last pic id processed.  Deleting it starts over.")
+	concurrency = flag.Int("concurrency", runtime.NumCPU(), "number of pics to process at once")
+	batchSize   = flag.Int("batch_size", 100, "number of pics to process between checkpoints")
+	force       = flag.Bool("force", false, "regenerate thumbnails even if they are up to date")
+	httpAddr    = flag.String("http", "", "if set, serve progress at /debug/vars on this address")
+	failedPath  = flag.String("failed", "rethumbnail.failed",
+		"file recording the ids of pics that failed, one per line")
+	retryFailed = flag.Bool("retry_failed", false,
+		"only process the pics in -failed, and keep the ones that fail again")
+)
+
+var (
+	scannedCounter     = expvar.NewInt("PixurRethumbnailScanned")
+	regeneratedCounter = expvar.NewInt("PixurRethumbnailRegenerated")
+	failedCounter      = expvar.NewInt("PixurRethumbnailFailed")
+	checkpointVar      = expvar.NewInt("PixurRethumbnailCheckpoint")
+)
+
+type rethumbnailer struct {
+	db     sdb.DB
+	blobs  blobstore.BlobStore
+	now    func() time.Time
+	runner *tasks.TaskRunner
+}
+
+// taskCtx lets tasks run without a user.  The tool has direct access to the database and storage,
+// so it doesn't need to be authorized like a user would.
+func taskCtx(ctx context.Context) context.Context {
+	conf := schema.GetDefaultConfiguration()
+	conf.AnonymousCapability = &schema.Configuration_CapabilitySet{
+		Capability: []schema.User_Capability{schema.User_PIC_CREATE},
+	}
+	return tasks.CtxFromTestConfig(ctx, conf)
+}
+
+func (r *rethumbnailer) exists(ctx context.Context, key blobstore.Key) (bool, status.S) {
+	if _, sts := r.blobs.Stat(ctx, key); sts != nil {
+		if sts.Code() == codes.NotFound {
+			return false, nil
+		}
+		return false, sts
+	}
+	return true, nil
+}
+
+// perPic regenerates the files of the pic that need it.  It returns true if anything changed.
+func (r *rethumbnailer) perPic(ctx context.Context, p *schema.Pic) (bool, status.S) {
+	if p.HardDeleted() {
+		return false, nil
+	}
+	task := &tasks.RegeneratePicThumbnailsTask{
+		Beg:        r.db,
+		BlobStore:  r.blobs,
+		Now:        r.now,
+		PicId:      p.PicId,
+		IfOutdated: !*force,
+	}
+	if sts := r.runner.Run(taskCtx(ctx), task); sts != nil {
+		return false, sts
+	}
+
+	var missingDerived []*schema.Pic_File
+	for _, pf := range p.Derived {
+		ok, sts := r.exists(ctx, blobstore.PicFileDerivedKey(p.PicId, pf.Index, pf.Mime))
+		if sts != nil {
+			return false, sts
+		}
+		if !ok {
+			missingDerived = append(missingDerived, pf)
+		}
+	}
+	if len(missingDerived) == 0 {
+		return task.Regenerated, nil
+	}
+	derived := make(map[int64]*generatedFile)
+	for _, pf := range missingDerived {
+		gf, sts := r.generateDerived(ctx, p, pf)
+		if sts != nil {
+			return false, sts
+		}
+		derived[pf.Index] = gf
+	}
+	return true, r.updatePic(ctx, p.PicId, derived)
+}
+
+// generatedFile is a newly made derived file, not yet in storage.
+type generatedFile struct {
+	data          []byte
+	width, height uint
+	animationInfo *schema.AnimationInfo
+}
+
+func (r *rethumbnailer) openPicFile(ctx context.Context, p *schema.Pic) (io.ReadCloser, status.S) {
+	return r.blobs.Get(ctx, blobstore.PicFileKey(p.PicId, p.File.Mime))
+}
+
+func newGeneratedFile(im imaging.PixurImage, data []byte) (*generatedFile, status.S) {
+	gf := &generatedFile{
+		data: data,
+	}
+	gf.width, gf.height = im.Dimensions()
+	if dur, sts := im.Duration(); sts != nil {
+		return nil, sts
+	} else if dur != nil {
+		gf.animationInfo = &schema.AnimationInfo{
+			Duration: ptypes.DurationProto(*dur),
+		}
+	}
+	return gf, nil
+}
+
+func (r *rethumbnailer) generateDerived(ctx context.Context, p *schema.Pic, pf *schema.Pic_File) (
+	_ *generatedFile, stscap status.S) {
+	var format imaging.ImageFormat
+	switch pf.Mime {
+	case schema.Pic_File_MP4:
+		format = imaging.DefaultMp4Format
+	case schema.Pic_File_WEBM:
+		format = imaging.DefaultWebmFormat
+	case schema.Pic_File_JPEG:
+		return r.generateDerivedImage(ctx, p, imaging.DefaultJpegFormat)
+	default:
+		return nil, status.InvalidArgument(nil, "can't derive", pf.Mime)
+	}
+	src, sts := r.openPicFile(ctx, p)
+	if sts != nil {
+		return nil, sts
+	}
+	defer src.Close()
+	dst, err := ioutil.TempFile("", "__")
+	if err != nil {
+		return nil, status.Internal(err, "can't create tempfile")
+	}
+	defer func() {
+		if err := dst.Close(); err != nil {
+			status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't close tempfile"))
+		}
+		if err := os.Remove(dst.Name()); err != nil {
+			status.ReplaceOrSuppress(&stscap, status.Internal(err, "can't remove tempfile"))
+		}
+	}()
+	im, sts := imaging.ConvertVideo(ctx, format, dst, src)
+	if sts != nil {
+		return nil, sts
+	}
+	defer im.Close()
+	if _, err := dst.Seek(0, io.SeekStart); err != nil {
+		return nil, status.Internal(err, "can't seek tempfile")
+	}
+	data, err := ioutil.ReadAll(dst)
+	if err != nil {
+		return nil, status.Internal(err, "can't read tempfile")
+	}
+	return newGeneratedFile(im, data)
+}
+
+// generateDerivedImage converts the pic to another image format, such as for HEIC pics.
+func (r *rethumbnailer) generateDerivedImage(
+	ctx context.Context, p *schema.Pic, format imaging.ImageFormat) (*generatedFile, status.S) {
+	src, sts := r.openPicFile(ctx, p)
+	if sts != nil {
+		return nil, sts
+	}
+	defer src.Close()
+	im, sts := imaging.ReadImage(ctx, src)
+	if sts != nil {
+		return nil, sts
+	}
+	defer im.Close()
+	converted, sts := im.Convert(format)
+	if sts != nil {
+		return nil, sts
+	}
+	defer converted.Close()
+	var buf bytes.Buffer
+	if sts := converted.Write(&buf); sts != nil {
+		return nil, sts
+	}
+	return newGeneratedFile(converted, buf.Bytes())
+}
+
+// updatePic stores the regenerated derived files, and updates their descriptions in the pic.
+func (r *rethumbnailer) updatePic(ctx context.Context, picId int64,
+	derived map[int64]*generatedFile) (stscap status.S) {
+	j, err := tab.NewJob(ctx, r.db)
+	if err != nil {
+		return status.Internal(err, "can't create job")
+	}
+	defer func() {
+		if stscap != nil {
+			if err := j.Rollback(); err != nil {
+				status.ReplaceOrSuppress(&stscap, status.From(err))
+			}
+		}
+	}()
+
+	pics, err := j.FindPics(sdb.Opts{
+		Prefix: tab.PicsPrimary{&picId},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return status.Internal(err, "can't find pics")
+	}
+	if len(pics) != 1 {
+		return status.NotFound(nil, "can't find pic")
+	}
+	p := pics[0]
+	if p.HardDeleted() {
+		if err := j.Rollback(); err != nil {
+			return status.From(err)
+		}
+		return nil
+	}
+	nowts := schema.ToTspb(r.now())
+
+	for _, pf := range p.Derived {
+		gf, present := derived[pf.Index]
+		if !present {
+			continue
+		}
+		// The key is the same, so there is nothing to clean up on failure.
+		sts := r.blobs.Put(ctx, blobstore.PicFileDerivedKey(p.PicId, pf.Index, pf.Mime),
+			bytes.NewReader(gf.data))
+		if sts != nil {
+			return sts
+		}
+		pf.Size = int64(len(gf.data))
+		pf.Width = int64(gf.width)
+		pf.Height = int64(gf.height)
+		pf.AnimationInfo = gf.animationInfo
+		pf.ModifiedTs = nowts
+	}
+
+	p.ModifiedTs = nowts
+	if err := j.UpdatePic(p); err != nil {
+		return status.Internal(err, "can't update pic")
+	}
+	if err := j.Commit(); err != nil {
+		return status.Internal(err, "can't commit job")
+	}
+	return nil
+}
+
+// findBatch reads the next pics, starting at startId.
+func (r *rethumbnailer) findBatch(ctx context.Context, startId int64) ([]*schema.Pic, status.S) {
+	j, err := tab.NewJob(ctx, r.db)
+	if err != nil {
+		return nil, status.Internal(err, "can't create job")
+	}
+	defer j.Rollback()
+
+	pics, err := j.FindPics(sdb.Opts{
+		StartInc: tab.PicsPrimary{&startId},
+		Limit:    *batchSize,
+		Lock:     sdb.LockNone,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pics")
+	}
+	return pics, nil
+}
+
+// findPic reads a single pic, returning nil if it doesn't exist.
+func (r *rethumbnailer) findPic(ctx context.Context, picId int64) (*schema.Pic, status.S) {
+	j, err := tab.NewJob(ctx, r.db)
+	if err != nil {
+		return nil, status.Internal(err, "can't create job")
+	}
+	defer j.Rollback()
+
+	pics, err := j.FindPics(sdb.Opts{
+		Prefix: tab.PicsPrimary{&picId},
+		Lock:   sdb.LockNone,
+	})
+	if err != nil {
+		return nil, status.Internal(err, "can't find pics")
+	}
+	if len(pics) != 1 {
+		return nil, nil
+	}
+	return pics[0], nil
+}
+
+// runBatch processes the pics concurrently, and waits for all of them to finish.  It returns the
+// ids of the pics that failed.
+func (r *rethumbnailer) runBatch(ctx context.Context, pics []*schema.Pic) []int64 {
+	work := make(chan *schema.Pic)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var failed []int64
+	for i := 0; i < *concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range work {
+				changed, sts := r.perPic(ctx, p)
+				scannedCounter.Add(1)
+				if sts != nil {
+					failedCounter.Add(1)
+					log.Println("can't regenerate files for", p.GetVarPicId(), sts)
+					mu.Lock()
+					failed = append(failed, p.PicId)
+					mu.Unlock()
+				} else if changed {
+					regeneratedCounter.Add(1)
+				}
+			}
+		}()
+	}
+	for _, p := range pics {
+		work <- p
+	}
+	close(work)
+	wg.Wait()
+	return failed
+}
+
+func readCheckpoint(path string) (int64, status.S) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, status.Internal(err, "can't read checkpoint")
+	}
+	id, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, status.InvalidArgument(err, "bad checkpoint")
+	}
+	return id, nil
+}
+
+func writeCheckpoint(path string, picId int64) status.S {
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatInt(picId, 10)+"\n"), 0644); err != nil {
+		return status.Internal(err, "can't write checkpoint")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return status.Internal(err, "can't write checkpoint")
+	}
+	return nil
+}
+
+func readFailed(path string) ([]int64, status.S) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, status.Internal(err, "can't read failed pics")
+	}
+	var ids []int64
+	for _, line := range strings.Fields(string(data)) {
+		id, err := strconv.ParseInt(line, 10, 64)
+		if err != nil {
+			return nil, status.InvalidArgument(err, "bad failed pic id")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func formatFailed(ids []int64) []byte {
+	var buf bytes.Buffer
+	for _, id := range ids {
+		buf.WriteString(strconv.FormatInt(id, 10) + "\n")
+	}
+	return buf.Bytes()
+}
+
+// appendFailed adds ids to path.  It must finish before the checkpoint moves past them.
+func appendFailed(path string, ids []int64) status.S {
+	if len(ids) == 0 {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return status.Internal(err, "can't open failed pics")
+	}
+	if _, err := f.Write(formatFailed(ids)); err != nil {
+		f.Close()
+		return status.Internal(err, "can't write failed pics")
+	}
+	if err := f.Close(); err != nil {
+		return status.Internal(err, "can't write failed pics")
+	}
+	return nil
+}
+
+// writeFailed replaces the contents of path with ids.
+func writeFailed(path string, ids []int64) status.S {
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, formatFailed(ids), 0644); err != nil {
+		return status.Internal(err, "can't write failed pics")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return status.Internal(err, "can't write failed pics")
+	}
+	return nil
+}
+
+// retry processes the pics that failed before, and replaces the failed list with the ones that
+// fail again.
+func (r *rethumbnailer) retry(ctx context.Context) status.S {
+	ids, sts := readFailed(*failedPath)
+	if sts != nil {
+		return sts
+	}
+	var pics []*schema.Pic
+	seen := make(map[int64]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		p, sts := r.findPic(ctx, id)
+		if sts != nil {
+			return sts
+		}
+		if p != nil {
+			pics = append(pics, p)
+		}
+	}
+	failed := r.runBatch(ctx, pics)
+	if err := ctx.Err(); err != nil {
+		return status.From(err)
+	}
+	if sts := writeFailed(*failedPath, failed); sts != nil {
+		return sts
+	}
+	log.Println("retried", len(pics), "failed", len(failed))
+	return nil
+}
+
+func run(ctx context.Context) status.S {
+	if *concurrency < 1 || *batchSize < 1 {
+		return status.InvalidArgument(nil, "concurrency and batch_size must be positive")
+	}
+	db, err := sdb.Open(ctx, config.Conf.DbName, config.Conf.DbConfig)
+	if err != nil {
+		return status.From(err)
+	}
+	defer db.Close()
+
+	blobs, sts := server.NewBlobStore(config.Conf)
+	if sts != nil {
+		return sts
+	}
+	r := &rethumbnailer{
+		db:     db,
+		blobs:  blobs,
+		now:    time.Now,
+		runner: new(tasks.TaskRunner),
+	}
+	if *retryFailed {
+		return r.retry(ctx)
+	}
+
+	lastId, sts := readCheckpoint(*checkpointPath)
+	if sts != nil {
+		return sts
+	}
+	if lastId != 0 {
+		log.Println("resuming after", schema.Varint(lastId))
+	}
+	checkpointVar.Set(lastId)
+	for {
+		pics, sts := r.findBatch(ctx, lastId+1)
+		if sts != nil {
+			return sts
+		}
+		if len(pics) == 0 {
+			break
+		}
+		failed := r.runBatch(ctx, pics)
+		if err := ctx.Err(); err != nil {
+			return status.From(err)
+		}
+		if sts := appendFailed(*failedPath, failed); sts != nil {
+			return sts
+		}
+		lastId = pics[len(pics)-1].PicId
+		if sts := writeCheckpoint(*checkpointPath, lastId); sts != nil {
+			return sts
+		}
+		checkpointVar.Set(lastId)
+		log.Println("checkpoint", schema.Varint(lastId), "scanned", scannedCounter.Value(),
+			"regenerated", regeneratedCounter.Value(), "failed", failedCounter.Value())
+	}
+	log.Println("done")
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	if *httpAddr != "" {
+		go func() {
+			log.Println(http.ListenAndServe(*httpAddr, nil))
+		}()
+	}
+	if sts := run(context.Background()); sts != nil {
+		log.Println(sts)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,380 @@
+package main // import "pixur.org/pixur/tools/tagbackfill"
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"pixur.org/pixur/be/schema"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server/config"
+)
+
+var (
+	skipAliases      = flag.Bool("skip_aliases", false, "don't merge aliased tags into their canonical tag")
+	skipImplications = flag.Bool("skip_implications", false, "don't add implied tags to tagged pics")
+	skipUsageCounts  = flag.Bool("skip_usage_counts", false, "don't recount how many pics use each tag")
+)
+
+// recountBatchSize is the number of tags recounted per transaction.
+const recountBatchSize = 100
+
+// mergeAliasedTag moves the pic tags of the tag named by the alias onto the canonical tag, and
+// removes the aliased tag.  It returns the number of pic tags moved.
+func mergeAliasedTag(ctx context.Context, db sdb.DB, ta *schema.TagAlias, now time.Time) (
+	int64, error) {
+	j, err := tab.NewJob(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	defer j.Rollback()
+
+	uniq := ta.NameCol()
+	oldTags, err := j.FindTags(sdb.Opts{
+		Prefix: tab.TagsName{&uniq},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(oldTags) != 1 || oldTags[0].TagId == ta.TagId {
+		return 0, nil
+	}
+	oldTag := oldTags[0]
+
+	tags, err := j.FindTags(sdb.Opts{
+		Prefix: tab.TagsPrimary{&ta.TagId},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(tags) != 1 {
+		return 0, fmt.Errorf("can't find tag %d for alias %s", ta.TagId, ta.Name)
+	}
+	tag := tags[0]
+
+	pts, err := j.FindPicTags(sdb.Opts{
+		Prefix: tab.PicTagsTagId{TagId: &oldTag.TagId},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var moved int64
+	for _, pt := range pts {
+		if err := j.DeletePicTag(tab.KeyForPicTag(pt)); err != nil {
+			return 0, err
+		}
+		existing, err := j.FindPicTags(sdb.Opts{
+			Prefix: tab.PicTagsPrimary{PicId: &pt.PicId, TagId: &tag.TagId},
+			Lock:   sdb.LockWrite,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(existing) != 0 {
+			continue
+		}
+		newPt := &schema.PicTag{
+			PicId:     pt.PicId,
+			TagId:     tag.TagId,
+			Name:      tag.Name,
+			UserId:    pt.UserId,
+			CreatedTs: pt.CreatedTs,
+		}
+		newPt.SetModifiedTime(now)
+		if err := j.InsertPicTag(newPt); err != nil {
+			return 0, err
+		}
+		tag.UsageCount++
+		moved++
+	}
+
+	tas, err := j.FindTagAliases(sdb.Opts{
+		Prefix: tab.TagAliasesTagId{TagId: &oldTag.TagId},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, other := range tas {
+		other.TagId = tag.TagId
+		other.SetModifiedTime(now)
+		if err := j.UpdateTagAlias(other); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := moveTagImplications(j, oldTag.TagId, tag.TagId, now); err != nil {
+		return 0, err
+	}
+
+	if err := j.DeleteTag(tab.KeyForTag(oldTag)); err != nil {
+		return 0, err
+	}
+	tag.SetModifiedTime(now)
+	if err := j.UpdateTag(tag); err != nil {
+		return 0, err
+	}
+
+	return moved, j.Commit()
+}
+
+// moveTagImplications rewrites implications involving oldTagId to use tagId instead.
+func moveTagImplications(j *tab.Job, oldTagId, tagId int64, now time.Time) error {
+	implying, err := j.FindTagImplications(sdb.Opts{
+		Prefix: tab.TagImplicationsPrimary{TagId: &oldTagId},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return err
+	}
+	implied, err := j.FindTagImplications(sdb.Opts{
+		Prefix: tab.TagImplicationsImpliedTagId{ImpliedTagId: &oldTagId},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return err
+	}
+	for _, ti := range append(implying, implied...) {
+		if err := j.DeleteTagImplication(tab.KeyForTagImplication(ti)); err != nil {
+			return err
+		}
+		if ti.TagId == oldTagId {
+			ti.TagId = tagId
+		}
+		if ti.ImpliedTagId == oldTagId {
+			ti.ImpliedTagId = tagId
+		}
+		if ti.TagId == ti.ImpliedTagId {
+			continue
+		}
+		existing, err := j.FindTagImplications(sdb.Opts{
+			Prefix: tab.TagImplicationsPrimary{TagId: &ti.TagId, ImpliedTagId: &ti.ImpliedTagId},
+			Lock:   sdb.LockWrite,
+		})
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 {
+			continue
+		}
+		ti.SetModifiedTime(now)
+		if err := j.InsertTagImplication(ti); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addImpliedTag tags every pic tagged with the implying tag with the implied tag.  It returns the
+// number of pic tags added.
+func addImpliedTag(ctx context.Context, db sdb.DB, ti *schema.TagImplication, now time.Time) (
+	int64, error) {
+	j, err := tab.NewJob(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	defer j.Rollback()
+
+	tags, err := j.FindTags(sdb.Opts{
+		Prefix: tab.TagsPrimary{&ti.ImpliedTagId},
+		Lock:   sdb.LockWrite,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(tags) != 1 {
+		return 0, fmt.Errorf("can't find implied tag %d", ti.ImpliedTagId)
+	}
+	tag := tags[0]
+
+	pts, err := j.FindPicTags(sdb.Opts{
+		Prefix: tab.PicTagsTagId{TagId: &ti.TagId},
+		Lock:   sdb.LockRead,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var added int64
+	for _, pt := range pts {
+		existing, err := j.FindPicTags(sdb.Opts{
+			Prefix: tab.PicTagsPrimary{PicId: &pt.PicId, TagId: &tag.TagId},
+			Lock:   sdb.LockWrite,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(existing) != 0 {
+			continue
+		}
+		newPt := &schema.PicTag{
+			PicId:  pt.PicId,
+			TagId:  tag.TagId,
+			Name:   tag.Name,
+			UserId: pt.UserId,
+		}
+		newPt.SetCreatedTime(now)
+		newPt.SetModifiedTime(now)
+		if err := j.InsertPicTag(newPt); err != nil {
+			return 0, err
+		}
+		added++
+	}
+	if added == 0 {
+		return 0, nil
+	}
+	tag.UsageCount += added
+	tag.SetModifiedTime(now)
+	if err := j.UpdateTag(tag); err != nil {
+		return 0, err
+	}
+
+	return added, j.Commit()
+}
+
+// recountTagUsage sets the usage count of each tag after startTagId to the number of pics tagged
+// with it.  Tags are always rewritten, which fills in the usage count column of databases created
+// before it existed.  It returns the last tag id recounted, or 0 if there are none left.
+func recountTagUsage(ctx context.Context, db sdb.DB, startTagId int64, now time.Time) (
+	int64, error) {
+	j, err := tab.NewJob(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	defer j.Rollback()
+
+	tags, err := j.FindTags(sdb.Opts{
+		StartEx: tab.TagsPrimary{&startTagId},
+		Lock:    sdb.LockWrite,
+		Limit:   recountBatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(tags) == 0 {
+		return 0, nil
+	}
+	for _, tag := range tags {
+		var count int64
+		err := j.ScanPicTags(sdb.Opts{
+			Prefix: tab.PicTagsTagId{TagId: &tag.TagId},
+			Lock:   sdb.LockRead,
+		}, func(*schema.PicTag) error {
+			count++
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+		if count != tag.UsageCount {
+			log.Println("tag", tag.Name, "usage count", tag.UsageCount, "is really", count)
+			tag.UsageCount = count
+			tag.SetModifiedTime(now)
+		}
+		if err := j.UpdateTag(tag); err != nil {
+			return 0, err
+		}
+	}
+
+	return tags[len(tags)-1].TagId, j.Commit()
+}
+
+func run(ctx context.Context) error {
+	db, err := sdb.Open(ctx, config.Conf.DbName, config.Conf.DbConfig)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	now := time.Now()
+	if !*skipAliases {
+		var tas []*schema.TagAlias
+		err := func() error {
+			j, err := tab.NewJob(ctx, db)
+			if err != nil {
+				return err
+			}
+			defer j.Rollback()
+			return j.ScanTagAliases(sdb.Opts{Lock: sdb.LockNone}, func(ta *schema.TagAlias) error {
+				tas = append(tas, ta)
+				return nil
+			})
+		}()
+		if err != nil {
+			return err
+		}
+		var moved int64
+		for _, ta := range tas {
+			n, err := mergeAliasedTag(ctx, db, ta, now)
+			if err != nil {
+				return err
+			}
+			moved += n
+		}
+		log.Println("moved", moved, "pic tags for", len(tas), "tag aliases")
+	}
+
+	if !*skipImplications {
+		// Implied tags can imply other tags, so keep going until nothing changes.
+		for {
+			var tis []*schema.TagImplication
+			err := func() error {
+				j, err := tab.NewJob(ctx, db)
+				if err != nil {
+					return err
+				}
+				defer j.Rollback()
+				return j.ScanTagImplications(sdb.Opts{Lock: sdb.LockNone}, func(ti *schema.TagImplication) error {
+					tis = append(tis, ti)
+					return nil
+				})
+			}()
+			if err != nil {
+				return err
+			}
+			var added int64
+			for _, ti := range tis {
+				n, err := addImpliedTag(ctx, db, ti, now)
+				if err != nil {
+					return err
+				}
+				added += n
+			}
+			log.Println("added", added, "pic tags for", len(tis), "tag implications")
+			if added == 0 {
+				break
+			}
+		}
+	}
+
+	// Runs last, since the steps above change how many pics use each tag.
+	if !*skipUsageCounts {
+		var recounted int
+		for startTagId := int64(0); ; recounted++ {
+			lastTagId, err := recountTagUsage(ctx, db, startTagId, now)
+			if err != nil {
+				return err
+			}
+			if lastTagId == 0 {
+				break
+			}
+			startTagId = lastTagId
+		}
+		log.Println("recounted usage in", recounted, "batches of tags")
+	}
+
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(context.Background()); err != nil {
+		log.Println(err)
+	}
+}
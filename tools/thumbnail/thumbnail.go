@@ -3,22 +3,81 @@ package main
 import (
 	"context"
 	"flag"
+	"io"
 	"log"
 	"os"
 
+	"pixur.org/pixur/be/blobstore"
 	"pixur.org/pixur/be/imaging"
+	"pixur.org/pixur/be/schema"
+	sdb "pixur.org/pixur/be/schema/db"
+	tab "pixur.org/pixur/be/schema/tables"
+	"pixur.org/pixur/be/server"
+	"pixur.org/pixur/be/server/config"
 	"pixur.org/pixur/be/status"
 )
 
 var (
 	infile  = flag.String("in", "", "The source file to make a thumbnail from")
+	picId   = flag.String("pic_id", "", "The pic to make a thumbnail from, instead of -in")
 	outfile = flag.String("out", "", "The destination file to write a thumbnail to")
 )
 
-func run(ctx context.Context, in, out string) status.S {
-	fin, err := os.Open(in)
+// openPic opens the main file of the pic from the configured blob store.
+func openPic(ctx context.Context, rawPicId string) (io.ReadCloser, status.S) {
+	var vid schema.Varint
+	if err := vid.DecodeAll(rawPicId); err != nil {
+		return nil, status.InvalidArgument(err, "bad pic id")
+	}
+	db, err := sdb.Open(ctx, config.Conf.DbName, config.Conf.DbConfig)
+	if err != nil {
+		return nil, status.From(err)
+	}
+	defer db.Close()
+
+	j, err := tab.NewJob(ctx, db)
 	if err != nil {
-		return status.InvalidArgument(err, "can't open file")
+		return nil, status.From(err)
+	}
+	defer j.Rollback()
+
+	id := int64(vid)
+	pics, err := j.FindPics(sdb.Opts{
+		Prefix: tab.PicsPrimary{&id},
+		Lock:   sdb.LockNone,
+	})
+	if err != nil {
+		return nil, status.From(err)
+	}
+	if len(pics) != 1 {
+		return nil, status.NotFound(nil, "can't find pic", rawPicId)
+	}
+	p := pics[0]
+	if p.HardDeleted() {
+		return nil, status.InvalidArgument(nil, "pic is deleted", rawPicId)
+	}
+
+	blobs, sts := server.NewBlobStore(config.Conf)
+	if sts != nil {
+		return nil, sts
+	}
+	return blobs.Get(ctx, blobstore.PicFileKey(p.PicId, p.File.Mime))
+}
+
+func run(ctx context.Context, in, rawPicId, out string) status.S {
+	var fin io.ReadCloser
+	if rawPicId != "" {
+		r, sts := openPic(ctx, rawPicId)
+		if sts != nil {
+			return sts
+		}
+		fin = r
+	} else {
+		f, err := os.Open(in)
+		if err != nil {
+			return status.InvalidArgument(err, "can't open file")
+		}
+		fin = f
 	}
 	defer fin.Close()
 
@@ -48,7 +107,7 @@ func run(ctx context.Context, in, out string) status.S {
 
 func main() {
 	flag.Parse()
-	if sts := run(context.Background(), *infile, *outfile); sts != nil {
+	if sts := run(context.Background(), *infile, *picId, *outfile); sts != nil {
 		log.Println(sts)
 		os.Exit(1)
 	}